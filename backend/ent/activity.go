@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"stride-wars-app/ent/activity"
+	"stride-wars-app/ent/model"
 	"stride-wars-app/ent/user"
 	"strings"
 	"time"
@@ -30,6 +31,16 @@ type Activity struct {
 	H3Indexes []string `json:"h3_indexes,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Track holds the value of the "track" field.
+	Track []model.TrackPoint `json:"track,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	// EndedAt holds the value of the "ended_at" field.
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+	// ActivityType holds the value of the "activity_type" field.
+	ActivityType activity.ActivityType `json:"activity_type,omitempty"`
+	// NewHexes holds the value of the "new_hexes" field.
+	NewHexes int `json:"new_hexes,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ActivityQuery when eager-loading is set.
 	Edges        ActivityEdges `json:"edges"`
@@ -40,9 +51,15 @@ type Activity struct {
 type ActivityEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Hexes holds the value of the hexes edge.
+	Hexes []*ActivityHex `json:"hexes,omitempty"`
+	// SegmentEfforts holds the value of the segment_efforts edge.
+	SegmentEfforts []*SegmentEffort `json:"segment_efforts,omitempty"`
+	// Jobs holds the value of the jobs edge.
+	Jobs []*ActivityJob `json:"jobs,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [4]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -56,16 +73,47 @@ func (e ActivityEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// HexesOrErr returns the Hexes value or an error if the edge
+// was not loaded in eager-loading.
+func (e ActivityEdges) HexesOrErr() ([]*ActivityHex, error) {
+	if e.loadedTypes[1] {
+		return e.Hexes, nil
+	}
+	return nil, &NotLoadedError{edge: "hexes"}
+}
+
+// SegmentEffortsOrErr returns the SegmentEfforts value or an error if the edge
+// was not loaded in eager-loading.
+func (e ActivityEdges) SegmentEffortsOrErr() ([]*SegmentEffort, error) {
+	if e.loadedTypes[2] {
+		return e.SegmentEfforts, nil
+	}
+	return nil, &NotLoadedError{edge: "segment_efforts"}
+}
+
+// JobsOrErr returns the Jobs value or an error if the edge
+// was not loaded in eager-loading.
+func (e ActivityEdges) JobsOrErr() ([]*ActivityJob, error) {
+	if e.loadedTypes[3] {
+		return e.Jobs, nil
+	}
+	return nil, &NotLoadedError{edge: "jobs"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Activity) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case activity.FieldH3Indexes:
+		case activity.FieldH3Indexes, activity.FieldTrack:
 			values[i] = new([]byte)
 		case activity.FieldDurationSeconds, activity.FieldDistanceMeters:
 			values[i] = new(sql.NullFloat64)
-		case activity.FieldCreatedAt:
+		case activity.FieldNewHexes:
+			values[i] = new(sql.NullInt64)
+		case activity.FieldActivityType:
+			values[i] = new(sql.NullString)
+		case activity.FieldCreatedAt, activity.FieldStartedAt, activity.FieldEndedAt:
 			values[i] = new(sql.NullTime)
 		case activity.FieldID, activity.FieldUserID:
 			values[i] = new(uuid.UUID)
@@ -122,6 +170,40 @@ func (a *Activity) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.CreatedAt = value.Time
 			}
+		case activity.FieldTrack:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field track", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.Track); err != nil {
+					return fmt.Errorf("unmarshal field track: %w", err)
+				}
+			}
+		case activity.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				a.StartedAt = new(time.Time)
+				*a.StartedAt = value.Time
+			}
+		case activity.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				a.EndedAt = new(time.Time)
+				*a.EndedAt = value.Time
+			}
+		case activity.FieldActivityType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field activity_type", values[i])
+			} else if value.Valid {
+				a.ActivityType = activity.ActivityType(value.String)
+			}
+		case activity.FieldNewHexes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field new_hexes", values[i])
+			} else if value.Valid {
+				a.NewHexes = int(value.Int64)
+			}
 		default:
 			a.selectValues.Set(columns[i], values[i])
 		}
@@ -140,6 +222,21 @@ func (a *Activity) QueryUser() *UserQuery {
 	return NewActivityClient(a.config).QueryUser(a)
 }
 
+// QueryHexes queries the "hexes" edge of the Activity entity.
+func (a *Activity) QueryHexes() *ActivityHexQuery {
+	return NewActivityClient(a.config).QueryHexes(a)
+}
+
+// QuerySegmentEfforts queries the "segment_efforts" edge of the Activity entity.
+func (a *Activity) QuerySegmentEfforts() *SegmentEffortQuery {
+	return NewActivityClient(a.config).QuerySegmentEfforts(a)
+}
+
+// QueryJobs queries the "jobs" edge of the Activity entity.
+func (a *Activity) QueryJobs() *ActivityJobQuery {
+	return NewActivityClient(a.config).QueryJobs(a)
+}
+
 // Update returns a builder for updating this Activity.
 // Note that you need to call Activity.Unwrap() before calling this method if this Activity
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -177,6 +274,25 @@ func (a *Activity) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(a.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("track=")
+	builder.WriteString(fmt.Sprintf("%v", a.Track))
+	builder.WriteString(", ")
+	if v := a.StartedAt; v != nil {
+		builder.WriteString("started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := a.EndedAt; v != nil {
+		builder.WriteString("ended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("activity_type=")
+	builder.WriteString(fmt.Sprintf("%v", a.ActivityType))
+	builder.WriteString(", ")
+	builder.WriteString("new_hexes=")
+	builder.WriteString(fmt.Sprintf("%v", a.NewHexes))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,7 @@
 package activity
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -25,8 +26,24 @@ const (
 	FieldH3Indexes = "h3_indexes"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// FieldTrack holds the string denoting the track field in the database.
+	FieldTrack = "track"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldEndedAt holds the string denoting the ended_at field in the database.
+	FieldEndedAt = "ended_at"
+	// FieldActivityType holds the string denoting the activity_type field in the database.
+	FieldActivityType = "activity_type"
+	// FieldNewHexes holds the string denoting the new_hexes field in the database.
+	FieldNewHexes = "new_hexes"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
+	// EdgeHexes holds the string denoting the hexes edge name in mutations.
+	EdgeHexes = "hexes"
+	// EdgeSegmentEfforts holds the string denoting the segment_efforts edge name in mutations.
+	EdgeSegmentEfforts = "segment_efforts"
+	// EdgeJobs holds the string denoting the jobs edge name in mutations.
+	EdgeJobs = "jobs"
 	// Table holds the table name of the activity in the database.
 	Table = "activities"
 	// UserTable is the table that holds the user relation/edge.
@@ -36,6 +53,27 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "user_id"
+	// HexesTable is the table that holds the hexes relation/edge.
+	HexesTable = "activity_hexes"
+	// HexesInverseTable is the table name for the ActivityHex entity.
+	// It exists in this package in order to avoid circular dependency with the "activityhex" package.
+	HexesInverseTable = "activity_hexes"
+	// HexesColumn is the table column denoting the hexes relation/edge.
+	HexesColumn = "activity_id"
+	// SegmentEffortsTable is the table that holds the segment_efforts relation/edge.
+	SegmentEffortsTable = "segment_efforts"
+	// SegmentEffortsInverseTable is the table name for the SegmentEffort entity.
+	// It exists in this package in order to avoid circular dependency with the "segmenteffort" package.
+	SegmentEffortsInverseTable = "segment_efforts"
+	// SegmentEffortsColumn is the table column denoting the segment_efforts relation/edge.
+	SegmentEffortsColumn = "activity_id"
+	// JobsTable is the table that holds the jobs relation/edge.
+	JobsTable = "activity_jobs"
+	// JobsInverseTable is the table name for the ActivityJob entity.
+	// It exists in this package in order to avoid circular dependency with the "activityjob" package.
+	JobsInverseTable = "activity_jobs"
+	// JobsColumn is the table column denoting the jobs relation/edge.
+	JobsColumn = "activity_id"
 )
 
 // Columns holds all SQL columns for activity fields.
@@ -46,6 +84,11 @@ var Columns = []string{
 	FieldDistanceMeters,
 	FieldH3Indexes,
 	FieldCreatedAt,
+	FieldTrack,
+	FieldStartedAt,
+	FieldEndedAt,
+	FieldActivityType,
+	FieldNewHexes,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -60,11 +103,40 @@ func ValidColumn(column string) bool {
 
 var (
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
-	DefaultCreatedAt time.Time
+	DefaultCreatedAt func() time.Time
+	// DefaultNewHexes holds the default value on creation for the "new_hexes" field.
+	DefaultNewHexes int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
 
+// ActivityType defines the type for the "activity_type" enum field.
+type ActivityType string
+
+// ActivityTypeRun is the default value of the ActivityType enum.
+const DefaultActivityType = ActivityTypeRun
+
+// ActivityType values.
+const (
+	ActivityTypeRun  ActivityType = "run"
+	ActivityTypeWalk ActivityType = "walk"
+	ActivityTypeRide ActivityType = "ride"
+)
+
+func (at ActivityType) String() string {
+	return string(at)
+}
+
+// ActivityTypeValidator is a validator for the "activity_type" field enum values. It is called by the builders before save.
+func ActivityTypeValidator(at ActivityType) error {
+	switch at {
+	case ActivityTypeRun, ActivityTypeWalk, ActivityTypeRide:
+		return nil
+	default:
+		return fmt.Errorf("activity: invalid enum value for activity_type field: %q", at)
+	}
+}
+
 // OrderOption defines the ordering options for the Activity queries.
 type OrderOption func(*sql.Selector)
 
@@ -93,12 +165,74 @@ func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
 
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByEndedAt orders the results by the ended_at field.
+func ByEndedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndedAt, opts...).ToFunc()
+}
+
+// ByActivityType orders the results by the activity_type field.
+func ByActivityType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActivityType, opts...).ToFunc()
+}
+
+// ByNewHexes orders the results by the new_hexes field.
+func ByNewHexes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNewHexes, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByHexesCount orders the results by hexes count.
+func ByHexesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newHexesStep(), opts...)
+	}
+}
+
+// ByHexes orders the results by hexes terms.
+func ByHexes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHexesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySegmentEffortsCount orders the results by segment_efforts count.
+func BySegmentEffortsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSegmentEffortsStep(), opts...)
+	}
+}
+
+// BySegmentEfforts orders the results by segment_efforts terms.
+func BySegmentEfforts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSegmentEffortsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByJobsCount orders the results by jobs count.
+func ByJobsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newJobsStep(), opts...)
+	}
+}
+
+// ByJobs orders the results by jobs terms.
+func ByJobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newJobsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -106,3 +240,24 @@ func newUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
 	)
 }
+func newHexesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HexesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, HexesTable, HexesColumn),
+	)
+}
+func newSegmentEffortsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SegmentEffortsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, SegmentEffortsTable, SegmentEffortsColumn),
+	)
+}
+func newJobsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(JobsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, JobsTable, JobsColumn),
+	)
+}
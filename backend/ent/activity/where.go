@@ -76,6 +76,21 @@ func CreatedAt(v time.Time) predicate.Activity {
 	return predicate.Activity(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// EndedAt applies equality check predicate on the "ended_at" field. It's identical to EndedAtEQ.
+func EndedAt(v time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// NewHexes applies equality check predicate on the "new_hexes" field. It's identical to NewHexesEQ.
+func NewHexes(v int) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldNewHexes, v))
+}
+
 // UserIDEQ applies the EQ predicate on the "user_id" field.
 func UserIDEQ(v uuid.UUID) predicate.Activity {
 	return predicate.Activity(sql.FieldEQ(FieldUserID, v))
@@ -216,6 +231,176 @@ func CreatedAtLTE(v time.Time) predicate.Activity {
 	return predicate.Activity(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// TrackIsNil applies the IsNil predicate on the "track" field.
+func TrackIsNil() predicate.Activity {
+	return predicate.Activity(sql.FieldIsNull(FieldTrack))
+}
+
+// TrackNotNil applies the NotNil predicate on the "track" field.
+func TrackNotNil() predicate.Activity {
+	return predicate.Activity(sql.FieldNotNull(FieldTrack))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.Activity {
+	return predicate.Activity(sql.FieldIsNull(FieldStartedAt))
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.Activity {
+	return predicate.Activity(sql.FieldNotNull(FieldStartedAt))
+}
+
+// EndedAtEQ applies the EQ predicate on the "ended_at" field.
+func EndedAtEQ(v time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// EndedAtNEQ applies the NEQ predicate on the "ended_at" field.
+func EndedAtNEQ(v time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldNEQ(FieldEndedAt, v))
+}
+
+// EndedAtIn applies the In predicate on the "ended_at" field.
+func EndedAtIn(vs ...time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldIn(FieldEndedAt, vs...))
+}
+
+// EndedAtNotIn applies the NotIn predicate on the "ended_at" field.
+func EndedAtNotIn(vs ...time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldNotIn(FieldEndedAt, vs...))
+}
+
+// EndedAtGT applies the GT predicate on the "ended_at" field.
+func EndedAtGT(v time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldGT(FieldEndedAt, v))
+}
+
+// EndedAtGTE applies the GTE predicate on the "ended_at" field.
+func EndedAtGTE(v time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldGTE(FieldEndedAt, v))
+}
+
+// EndedAtLT applies the LT predicate on the "ended_at" field.
+func EndedAtLT(v time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldLT(FieldEndedAt, v))
+}
+
+// EndedAtLTE applies the LTE predicate on the "ended_at" field.
+func EndedAtLTE(v time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldLTE(FieldEndedAt, v))
+}
+
+// EndedAtIsNil applies the IsNil predicate on the "ended_at" field.
+func EndedAtIsNil() predicate.Activity {
+	return predicate.Activity(sql.FieldIsNull(FieldEndedAt))
+}
+
+// EndedAtNotNil applies the NotNil predicate on the "ended_at" field.
+func EndedAtNotNil() predicate.Activity {
+	return predicate.Activity(sql.FieldNotNull(FieldEndedAt))
+}
+
+// ActivityTypeEQ applies the EQ predicate on the "activity_type" field.
+func ActivityTypeEQ(v ActivityType) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldActivityType, v))
+}
+
+// ActivityTypeNEQ applies the NEQ predicate on the "activity_type" field.
+func ActivityTypeNEQ(v ActivityType) predicate.Activity {
+	return predicate.Activity(sql.FieldNEQ(FieldActivityType, v))
+}
+
+// ActivityTypeIn applies the In predicate on the "activity_type" field.
+func ActivityTypeIn(vs ...ActivityType) predicate.Activity {
+	return predicate.Activity(sql.FieldIn(FieldActivityType, vs...))
+}
+
+// ActivityTypeNotIn applies the NotIn predicate on the "activity_type" field.
+func ActivityTypeNotIn(vs ...ActivityType) predicate.Activity {
+	return predicate.Activity(sql.FieldNotIn(FieldActivityType, vs...))
+}
+
+// NewHexesEQ applies the EQ predicate on the "new_hexes" field.
+func NewHexesEQ(v int) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldNewHexes, v))
+}
+
+// NewHexesNEQ applies the NEQ predicate on the "new_hexes" field.
+func NewHexesNEQ(v int) predicate.Activity {
+	return predicate.Activity(sql.FieldNEQ(FieldNewHexes, v))
+}
+
+// NewHexesIn applies the In predicate on the "new_hexes" field.
+func NewHexesIn(vs ...int) predicate.Activity {
+	return predicate.Activity(sql.FieldIn(FieldNewHexes, vs...))
+}
+
+// NewHexesNotIn applies the NotIn predicate on the "new_hexes" field.
+func NewHexesNotIn(vs ...int) predicate.Activity {
+	return predicate.Activity(sql.FieldNotIn(FieldNewHexes, vs...))
+}
+
+// NewHexesGT applies the GT predicate on the "new_hexes" field.
+func NewHexesGT(v int) predicate.Activity {
+	return predicate.Activity(sql.FieldGT(FieldNewHexes, v))
+}
+
+// NewHexesGTE applies the GTE predicate on the "new_hexes" field.
+func NewHexesGTE(v int) predicate.Activity {
+	return predicate.Activity(sql.FieldGTE(FieldNewHexes, v))
+}
+
+// NewHexesLT applies the LT predicate on the "new_hexes" field.
+func NewHexesLT(v int) predicate.Activity {
+	return predicate.Activity(sql.FieldLT(FieldNewHexes, v))
+}
+
+// NewHexesLTE applies the LTE predicate on the "new_hexes" field.
+func NewHexesLTE(v int) predicate.Activity {
+	return predicate.Activity(sql.FieldLTE(FieldNewHexes, v))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Activity {
 	return predicate.Activity(func(s *sql.Selector) {
@@ -239,6 +424,75 @@ func HasUserWith(preds ...predicate.User) predicate.Activity {
 	})
 }
 
+// HasHexes applies the HasEdge predicate on the "hexes" edge.
+func HasHexes() predicate.Activity {
+	return predicate.Activity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, HexesTable, HexesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHexesWith applies the HasEdge predicate on the "hexes" edge with a given conditions (other predicates).
+func HasHexesWith(preds ...predicate.ActivityHex) predicate.Activity {
+	return predicate.Activity(func(s *sql.Selector) {
+		step := newHexesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSegmentEfforts applies the HasEdge predicate on the "segment_efforts" edge.
+func HasSegmentEfforts() predicate.Activity {
+	return predicate.Activity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, SegmentEffortsTable, SegmentEffortsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSegmentEffortsWith applies the HasEdge predicate on the "segment_efforts" edge with a given conditions (other predicates).
+func HasSegmentEffortsWith(preds ...predicate.SegmentEffort) predicate.Activity {
+	return predicate.Activity(func(s *sql.Selector) {
+		step := newSegmentEffortsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasJobs applies the HasEdge predicate on the "jobs" edge.
+func HasJobs() predicate.Activity {
+	return predicate.Activity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, JobsTable, JobsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasJobsWith applies the HasEdge predicate on the "jobs" edge with a given conditions (other predicates).
+func HasJobsWith(preds ...predicate.ActivityJob) predicate.Activity {
+	return predicate.Activity(func(s *sql.Selector) {
+		step := newJobsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Activity) predicate.Activity {
 	return predicate.Activity(sql.AndPredicates(predicates...))
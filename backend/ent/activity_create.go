@@ -7,9 +7,15 @@ import (
 	"errors"
 	"fmt"
 	"stride-wars-app/ent/activity"
+	"stride-wars-app/ent/activityhex"
+	"stride-wars-app/ent/activityjob"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/ent/segmenteffort"
 	"stride-wars-app/ent/user"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -20,6 +26,7 @@ type ActivityCreate struct {
 	config
 	mutation *ActivityMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUserID sets the "user_id" field.
@@ -60,6 +67,68 @@ func (ac *ActivityCreate) SetNillableCreatedAt(t *time.Time) *ActivityCreate {
 	return ac
 }
 
+// SetTrack sets the "track" field.
+func (ac *ActivityCreate) SetTrack(mp []model.TrackPoint) *ActivityCreate {
+	ac.mutation.SetTrack(mp)
+	return ac
+}
+
+// SetStartedAt sets the "started_at" field.
+func (ac *ActivityCreate) SetStartedAt(t time.Time) *ActivityCreate {
+	ac.mutation.SetStartedAt(t)
+	return ac
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (ac *ActivityCreate) SetNillableStartedAt(t *time.Time) *ActivityCreate {
+	if t != nil {
+		ac.SetStartedAt(*t)
+	}
+	return ac
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (ac *ActivityCreate) SetEndedAt(t time.Time) *ActivityCreate {
+	ac.mutation.SetEndedAt(t)
+	return ac
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (ac *ActivityCreate) SetNillableEndedAt(t *time.Time) *ActivityCreate {
+	if t != nil {
+		ac.SetEndedAt(*t)
+	}
+	return ac
+}
+
+// SetActivityType sets the "activity_type" field.
+func (ac *ActivityCreate) SetActivityType(at activity.ActivityType) *ActivityCreate {
+	ac.mutation.SetActivityType(at)
+	return ac
+}
+
+// SetNillableActivityType sets the "activity_type" field if the given value is not nil.
+func (ac *ActivityCreate) SetNillableActivityType(at *activity.ActivityType) *ActivityCreate {
+	if at != nil {
+		ac.SetActivityType(*at)
+	}
+	return ac
+}
+
+// SetNewHexes sets the "new_hexes" field.
+func (ac *ActivityCreate) SetNewHexes(i int) *ActivityCreate {
+	ac.mutation.SetNewHexes(i)
+	return ac
+}
+
+// SetNillableNewHexes sets the "new_hexes" field if the given value is not nil.
+func (ac *ActivityCreate) SetNillableNewHexes(i *int) *ActivityCreate {
+	if i != nil {
+		ac.SetNewHexes(*i)
+	}
+	return ac
+}
+
 // SetID sets the "id" field.
 func (ac *ActivityCreate) SetID(u uuid.UUID) *ActivityCreate {
 	ac.mutation.SetID(u)
@@ -79,6 +148,51 @@ func (ac *ActivityCreate) SetUser(u *User) *ActivityCreate {
 	return ac.SetUserID(u.ID)
 }
 
+// AddHexIDs adds the "hexes" edge to the ActivityHex entity by IDs.
+func (ac *ActivityCreate) AddHexIDs(ids ...uuid.UUID) *ActivityCreate {
+	ac.mutation.AddHexIDs(ids...)
+	return ac
+}
+
+// AddHexes adds the "hexes" edges to the ActivityHex entity.
+func (ac *ActivityCreate) AddHexes(a ...*ActivityHex) *ActivityCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ac.AddHexIDs(ids...)
+}
+
+// AddSegmentEffortIDs adds the "segment_efforts" edge to the SegmentEffort entity by IDs.
+func (ac *ActivityCreate) AddSegmentEffortIDs(ids ...uuid.UUID) *ActivityCreate {
+	ac.mutation.AddSegmentEffortIDs(ids...)
+	return ac
+}
+
+// AddSegmentEfforts adds the "segment_efforts" edges to the SegmentEffort entity.
+func (ac *ActivityCreate) AddSegmentEfforts(s ...*SegmentEffort) *ActivityCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ac.AddSegmentEffortIDs(ids...)
+}
+
+// AddJobIDs adds the "jobs" edge to the ActivityJob entity by IDs.
+func (ac *ActivityCreate) AddJobIDs(ids ...uuid.UUID) *ActivityCreate {
+	ac.mutation.AddJobIDs(ids...)
+	return ac
+}
+
+// AddJobs adds the "jobs" edges to the ActivityJob entity.
+func (ac *ActivityCreate) AddJobs(a ...*ActivityJob) *ActivityCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ac.AddJobIDs(ids...)
+}
+
 // Mutation returns the ActivityMutation object of the builder.
 func (ac *ActivityCreate) Mutation() *ActivityMutation {
 	return ac.mutation
@@ -115,9 +229,17 @@ func (ac *ActivityCreate) ExecX(ctx context.Context) {
 // defaults sets the default values of the builder before save.
 func (ac *ActivityCreate) defaults() {
 	if _, ok := ac.mutation.CreatedAt(); !ok {
-		v := activity.DefaultCreatedAt
+		v := activity.DefaultCreatedAt()
 		ac.mutation.SetCreatedAt(v)
 	}
+	if _, ok := ac.mutation.ActivityType(); !ok {
+		v := activity.DefaultActivityType
+		ac.mutation.SetActivityType(v)
+	}
+	if _, ok := ac.mutation.NewHexes(); !ok {
+		v := activity.DefaultNewHexes
+		ac.mutation.SetNewHexes(v)
+	}
 	if _, ok := ac.mutation.ID(); !ok {
 		v := activity.DefaultID()
 		ac.mutation.SetID(v)
@@ -141,6 +263,17 @@ func (ac *ActivityCreate) check() error {
 	if _, ok := ac.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Activity.created_at"`)}
 	}
+	if _, ok := ac.mutation.ActivityType(); !ok {
+		return &ValidationError{Name: "activity_type", err: errors.New(`ent: missing required field "Activity.activity_type"`)}
+	}
+	if v, ok := ac.mutation.ActivityType(); ok {
+		if err := activity.ActivityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "activity_type", err: fmt.Errorf(`ent: validator failed for field "Activity.activity_type": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.NewHexes(); !ok {
+		return &ValidationError{Name: "new_hexes", err: errors.New(`ent: missing required field "Activity.new_hexes"`)}
+	}
 	if len(ac.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Activity.user"`)}
 	}
@@ -175,6 +308,7 @@ func (ac *ActivityCreate) createSpec() (*Activity, *sqlgraph.CreateSpec) {
 		_node = &Activity{config: ac.config}
 		_spec = sqlgraph.NewCreateSpec(activity.Table, sqlgraph.NewFieldSpec(activity.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = ac.conflict
 	if id, ok := ac.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -195,6 +329,26 @@ func (ac *ActivityCreate) createSpec() (*Activity, *sqlgraph.CreateSpec) {
 		_spec.SetField(activity.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := ac.mutation.Track(); ok {
+		_spec.SetField(activity.FieldTrack, field.TypeJSON, value)
+		_node.Track = value
+	}
+	if value, ok := ac.mutation.StartedAt(); ok {
+		_spec.SetField(activity.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := ac.mutation.EndedAt(); ok {
+		_spec.SetField(activity.FieldEndedAt, field.TypeTime, value)
+		_node.EndedAt = &value
+	}
+	if value, ok := ac.mutation.ActivityType(); ok {
+		_spec.SetField(activity.FieldActivityType, field.TypeEnum, value)
+		_node.ActivityType = value
+	}
+	if value, ok := ac.mutation.NewHexes(); ok {
+		_spec.SetField(activity.FieldNewHexes, field.TypeInt, value)
+		_node.NewHexes = value
+	}
 	if nodes := ac.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -212,14 +366,536 @@ func (ac *ActivityCreate) createSpec() (*Activity, *sqlgraph.CreateSpec) {
 		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ac.mutation.HexesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   activity.HexesTable,
+			Columns: []string{activity.HexesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activityhex.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.SegmentEffortsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   activity.SegmentEffortsTable,
+			Columns: []string{activity.SegmentEffortsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(segmenteffort.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   activity.JobsTable,
+			Columns: []string{activity.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activityjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Activity.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ActivityUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (ac *ActivityCreate) OnConflict(opts ...sql.ConflictOption) *ActivityUpsertOne {
+	ac.conflict = opts
+	return &ActivityUpsertOne{
+		create: ac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Activity.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ac *ActivityCreate) OnConflictColumns(columns ...string) *ActivityUpsertOne {
+	ac.conflict = append(ac.conflict, sql.ConflictColumns(columns...))
+	return &ActivityUpsertOne{
+		create: ac,
+	}
+}
+
+type (
+	// ActivityUpsertOne is the builder for "upsert"-ing
+	//  one Activity node.
+	ActivityUpsertOne struct {
+		create *ActivityCreate
+	}
+
+	// ActivityUpsert is the "OnConflict" setter.
+	ActivityUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *ActivityUpsert) SetUserID(v uuid.UUID) *ActivityUpsert {
+	u.Set(activity.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateUserID() *ActivityUpsert {
+	u.SetExcluded(activity.FieldUserID)
+	return u
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (u *ActivityUpsert) SetDurationSeconds(v float64) *ActivityUpsert {
+	u.Set(activity.FieldDurationSeconds, v)
+	return u
+}
+
+// UpdateDurationSeconds sets the "duration_seconds" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateDurationSeconds() *ActivityUpsert {
+	u.SetExcluded(activity.FieldDurationSeconds)
+	return u
+}
+
+// AddDurationSeconds adds v to the "duration_seconds" field.
+func (u *ActivityUpsert) AddDurationSeconds(v float64) *ActivityUpsert {
+	u.Add(activity.FieldDurationSeconds, v)
+	return u
+}
+
+// SetDistanceMeters sets the "distance_meters" field.
+func (u *ActivityUpsert) SetDistanceMeters(v float64) *ActivityUpsert {
+	u.Set(activity.FieldDistanceMeters, v)
+	return u
+}
+
+// UpdateDistanceMeters sets the "distance_meters" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateDistanceMeters() *ActivityUpsert {
+	u.SetExcluded(activity.FieldDistanceMeters)
+	return u
+}
+
+// AddDistanceMeters adds v to the "distance_meters" field.
+func (u *ActivityUpsert) AddDistanceMeters(v float64) *ActivityUpsert {
+	u.Add(activity.FieldDistanceMeters, v)
+	return u
+}
+
+// SetH3Indexes sets the "h3_indexes" field.
+func (u *ActivityUpsert) SetH3Indexes(v []string) *ActivityUpsert {
+	u.Set(activity.FieldH3Indexes, v)
+	return u
+}
+
+// UpdateH3Indexes sets the "h3_indexes" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateH3Indexes() *ActivityUpsert {
+	u.SetExcluded(activity.FieldH3Indexes)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ActivityUpsert) SetCreatedAt(v time.Time) *ActivityUpsert {
+	u.Set(activity.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateCreatedAt() *ActivityUpsert {
+	u.SetExcluded(activity.FieldCreatedAt)
+	return u
+}
+
+// SetTrack sets the "track" field.
+func (u *ActivityUpsert) SetTrack(v []model.TrackPoint) *ActivityUpsert {
+	u.Set(activity.FieldTrack, v)
+	return u
+}
+
+// UpdateTrack sets the "track" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateTrack() *ActivityUpsert {
+	u.SetExcluded(activity.FieldTrack)
+	return u
+}
+
+// ClearTrack clears the value of the "track" field.
+func (u *ActivityUpsert) ClearTrack() *ActivityUpsert {
+	u.SetNull(activity.FieldTrack)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *ActivityUpsert) SetStartedAt(v time.Time) *ActivityUpsert {
+	u.Set(activity.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateStartedAt() *ActivityUpsert {
+	u.SetExcluded(activity.FieldStartedAt)
+	return u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *ActivityUpsert) ClearStartedAt() *ActivityUpsert {
+	u.SetNull(activity.FieldStartedAt)
+	return u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *ActivityUpsert) SetEndedAt(v time.Time) *ActivityUpsert {
+	u.Set(activity.FieldEndedAt, v)
+	return u
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateEndedAt() *ActivityUpsert {
+	u.SetExcluded(activity.FieldEndedAt)
+	return u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (u *ActivityUpsert) ClearEndedAt() *ActivityUpsert {
+	u.SetNull(activity.FieldEndedAt)
+	return u
+}
+
+// SetActivityType sets the "activity_type" field.
+func (u *ActivityUpsert) SetActivityType(v activity.ActivityType) *ActivityUpsert {
+	u.Set(activity.FieldActivityType, v)
+	return u
+}
+
+// UpdateActivityType sets the "activity_type" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateActivityType() *ActivityUpsert {
+	u.SetExcluded(activity.FieldActivityType)
+	return u
+}
+
+// SetNewHexes sets the "new_hexes" field.
+func (u *ActivityUpsert) SetNewHexes(v int) *ActivityUpsert {
+	u.Set(activity.FieldNewHexes, v)
+	return u
+}
+
+// UpdateNewHexes sets the "new_hexes" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateNewHexes() *ActivityUpsert {
+	u.SetExcluded(activity.FieldNewHexes)
+	return u
+}
+
+// AddNewHexes adds v to the "new_hexes" field.
+func (u *ActivityUpsert) AddNewHexes(v int) *ActivityUpsert {
+	u.Add(activity.FieldNewHexes, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Activity.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(activity.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ActivityUpsertOne) UpdateNewValues() *ActivityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(activity.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Activity.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ActivityUpsertOne) Ignore() *ActivityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ActivityUpsertOne) DoNothing() *ActivityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ActivityCreate.OnConflict
+// documentation for more info.
+func (u *ActivityUpsertOne) Update(set func(*ActivityUpsert)) *ActivityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ActivityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ActivityUpsertOne) SetUserID(v uuid.UUID) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateUserID() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (u *ActivityUpsertOne) SetDurationSeconds(v float64) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetDurationSeconds(v)
+	})
+}
+
+// AddDurationSeconds adds v to the "duration_seconds" field.
+func (u *ActivityUpsertOne) AddDurationSeconds(v float64) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.AddDurationSeconds(v)
+	})
+}
+
+// UpdateDurationSeconds sets the "duration_seconds" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateDurationSeconds() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateDurationSeconds()
+	})
+}
+
+// SetDistanceMeters sets the "distance_meters" field.
+func (u *ActivityUpsertOne) SetDistanceMeters(v float64) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetDistanceMeters(v)
+	})
+}
+
+// AddDistanceMeters adds v to the "distance_meters" field.
+func (u *ActivityUpsertOne) AddDistanceMeters(v float64) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.AddDistanceMeters(v)
+	})
+}
+
+// UpdateDistanceMeters sets the "distance_meters" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateDistanceMeters() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateDistanceMeters()
+	})
+}
+
+// SetH3Indexes sets the "h3_indexes" field.
+func (u *ActivityUpsertOne) SetH3Indexes(v []string) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetH3Indexes(v)
+	})
+}
+
+// UpdateH3Indexes sets the "h3_indexes" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateH3Indexes() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateH3Indexes()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ActivityUpsertOne) SetCreatedAt(v time.Time) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateCreatedAt() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetTrack sets the "track" field.
+func (u *ActivityUpsertOne) SetTrack(v []model.TrackPoint) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetTrack(v)
+	})
+}
+
+// UpdateTrack sets the "track" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateTrack() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateTrack()
+	})
+}
+
+// ClearTrack clears the value of the "track" field.
+func (u *ActivityUpsertOne) ClearTrack() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.ClearTrack()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *ActivityUpsertOne) SetStartedAt(v time.Time) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateStartedAt() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *ActivityUpsertOne) ClearStartedAt() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *ActivityUpsertOne) SetEndedAt(v time.Time) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetEndedAt(v)
+	})
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateEndedAt() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateEndedAt()
+	})
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (u *ActivityUpsertOne) ClearEndedAt() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.ClearEndedAt()
+	})
+}
+
+// SetActivityType sets the "activity_type" field.
+func (u *ActivityUpsertOne) SetActivityType(v activity.ActivityType) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetActivityType(v)
+	})
+}
+
+// UpdateActivityType sets the "activity_type" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateActivityType() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateActivityType()
+	})
+}
+
+// SetNewHexes sets the "new_hexes" field.
+func (u *ActivityUpsertOne) SetNewHexes(v int) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetNewHexes(v)
+	})
+}
+
+// AddNewHexes adds v to the "new_hexes" field.
+func (u *ActivityUpsertOne) AddNewHexes(v int) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.AddNewHexes(v)
+	})
+}
+
+// UpdateNewHexes sets the "new_hexes" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateNewHexes() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateNewHexes()
+	})
+}
+
+// Exec executes the query.
+func (u *ActivityUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ActivityCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ActivityUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ActivityUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ActivityUpsertOne.ID is not supported by MySQL driver. Use ActivityUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ActivityUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // ActivityCreateBulk is the builder for creating many Activity entities in bulk.
 type ActivityCreateBulk struct {
 	config
 	err      error
 	builders []*ActivityCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Activity entities in the database.
@@ -249,6 +925,7 @@ func (acb *ActivityCreateBulk) Save(ctx context.Context) ([]*Activity, error) {
 					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = acb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -298,3 +975,299 @@ func (acb *ActivityCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Activity.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ActivityUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (acb *ActivityCreateBulk) OnConflict(opts ...sql.ConflictOption) *ActivityUpsertBulk {
+	acb.conflict = opts
+	return &ActivityUpsertBulk{
+		create: acb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Activity.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (acb *ActivityCreateBulk) OnConflictColumns(columns ...string) *ActivityUpsertBulk {
+	acb.conflict = append(acb.conflict, sql.ConflictColumns(columns...))
+	return &ActivityUpsertBulk{
+		create: acb,
+	}
+}
+
+// ActivityUpsertBulk is the builder for "upsert"-ing
+// a bulk of Activity nodes.
+type ActivityUpsertBulk struct {
+	create *ActivityCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Activity.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(activity.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ActivityUpsertBulk) UpdateNewValues() *ActivityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(activity.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Activity.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ActivityUpsertBulk) Ignore() *ActivityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ActivityUpsertBulk) DoNothing() *ActivityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ActivityCreateBulk.OnConflict
+// documentation for more info.
+func (u *ActivityUpsertBulk) Update(set func(*ActivityUpsert)) *ActivityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ActivityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ActivityUpsertBulk) SetUserID(v uuid.UUID) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateUserID() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (u *ActivityUpsertBulk) SetDurationSeconds(v float64) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetDurationSeconds(v)
+	})
+}
+
+// AddDurationSeconds adds v to the "duration_seconds" field.
+func (u *ActivityUpsertBulk) AddDurationSeconds(v float64) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.AddDurationSeconds(v)
+	})
+}
+
+// UpdateDurationSeconds sets the "duration_seconds" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateDurationSeconds() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateDurationSeconds()
+	})
+}
+
+// SetDistanceMeters sets the "distance_meters" field.
+func (u *ActivityUpsertBulk) SetDistanceMeters(v float64) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetDistanceMeters(v)
+	})
+}
+
+// AddDistanceMeters adds v to the "distance_meters" field.
+func (u *ActivityUpsertBulk) AddDistanceMeters(v float64) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.AddDistanceMeters(v)
+	})
+}
+
+// UpdateDistanceMeters sets the "distance_meters" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateDistanceMeters() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateDistanceMeters()
+	})
+}
+
+// SetH3Indexes sets the "h3_indexes" field.
+func (u *ActivityUpsertBulk) SetH3Indexes(v []string) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetH3Indexes(v)
+	})
+}
+
+// UpdateH3Indexes sets the "h3_indexes" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateH3Indexes() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateH3Indexes()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ActivityUpsertBulk) SetCreatedAt(v time.Time) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateCreatedAt() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetTrack sets the "track" field.
+func (u *ActivityUpsertBulk) SetTrack(v []model.TrackPoint) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetTrack(v)
+	})
+}
+
+// UpdateTrack sets the "track" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateTrack() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateTrack()
+	})
+}
+
+// ClearTrack clears the value of the "track" field.
+func (u *ActivityUpsertBulk) ClearTrack() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.ClearTrack()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *ActivityUpsertBulk) SetStartedAt(v time.Time) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateStartedAt() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *ActivityUpsertBulk) ClearStartedAt() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *ActivityUpsertBulk) SetEndedAt(v time.Time) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetEndedAt(v)
+	})
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateEndedAt() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateEndedAt()
+	})
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (u *ActivityUpsertBulk) ClearEndedAt() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.ClearEndedAt()
+	})
+}
+
+// SetActivityType sets the "activity_type" field.
+func (u *ActivityUpsertBulk) SetActivityType(v activity.ActivityType) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetActivityType(v)
+	})
+}
+
+// UpdateActivityType sets the "activity_type" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateActivityType() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateActivityType()
+	})
+}
+
+// SetNewHexes sets the "new_hexes" field.
+func (u *ActivityUpsertBulk) SetNewHexes(v int) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetNewHexes(v)
+	})
+}
+
+// AddNewHexes adds v to the "new_hexes" field.
+func (u *ActivityUpsertBulk) AddNewHexes(v int) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.AddNewHexes(v)
+	})
+}
+
+// UpdateNewHexes sets the "new_hexes" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateNewHexes() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateNewHexes()
+	})
+}
+
+// Exec executes the query.
+func (u *ActivityUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ActivityCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ActivityCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ActivityUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
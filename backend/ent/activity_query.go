@@ -4,10 +4,14 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 	"stride-wars-app/ent/activity"
+	"stride-wars-app/ent/activityhex"
+	"stride-wars-app/ent/activityjob"
 	"stride-wars-app/ent/predicate"
+	"stride-wars-app/ent/segmenteffort"
 	"stride-wars-app/ent/user"
 
 	"entgo.io/ent"
@@ -20,11 +24,15 @@ import (
 // ActivityQuery is the builder for querying Activity entities.
 type ActivityQuery struct {
 	config
-	ctx        *QueryContext
-	order      []activity.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Activity
-	withUser   *UserQuery
+	ctx                *QueryContext
+	order              []activity.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.Activity
+	withUser           *UserQuery
+	withHexes          *ActivityHexQuery
+	withSegmentEfforts *SegmentEffortQuery
+	withJobs           *ActivityJobQuery
+	modifiers          []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +91,72 @@ func (aq *ActivityQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QueryHexes chains the current query on the "hexes" edge.
+func (aq *ActivityQuery) QueryHexes() *ActivityHexQuery {
+	query := (&ActivityHexClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(activity.Table, activity.FieldID, selector),
+			sqlgraph.To(activityhex.Table, activityhex.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, activity.HexesTable, activity.HexesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySegmentEfforts chains the current query on the "segment_efforts" edge.
+func (aq *ActivityQuery) QuerySegmentEfforts() *SegmentEffortQuery {
+	query := (&SegmentEffortClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(activity.Table, activity.FieldID, selector),
+			sqlgraph.To(segmenteffort.Table, segmenteffort.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, activity.SegmentEffortsTable, activity.SegmentEffortsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryJobs chains the current query on the "jobs" edge.
+func (aq *ActivityQuery) QueryJobs() *ActivityJobQuery {
+	query := (&ActivityJobClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(activity.Table, activity.FieldID, selector),
+			sqlgraph.To(activityjob.Table, activityjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, activity.JobsTable, activity.JobsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Activity entity from the query.
 // Returns a *NotFoundError when no Activity was found.
 func (aq *ActivityQuery) First(ctx context.Context) (*Activity, error) {
@@ -270,15 +344,19 @@ func (aq *ActivityQuery) Clone() *ActivityQuery {
 		return nil
 	}
 	return &ActivityQuery{
-		config:     aq.config,
-		ctx:        aq.ctx.Clone(),
-		order:      append([]activity.OrderOption{}, aq.order...),
-		inters:     append([]Interceptor{}, aq.inters...),
-		predicates: append([]predicate.Activity{}, aq.predicates...),
-		withUser:   aq.withUser.Clone(),
+		config:             aq.config,
+		ctx:                aq.ctx.Clone(),
+		order:              append([]activity.OrderOption{}, aq.order...),
+		inters:             append([]Interceptor{}, aq.inters...),
+		predicates:         append([]predicate.Activity{}, aq.predicates...),
+		withUser:           aq.withUser.Clone(),
+		withHexes:          aq.withHexes.Clone(),
+		withSegmentEfforts: aq.withSegmentEfforts.Clone(),
+		withJobs:           aq.withJobs.Clone(),
 		// clone intermediate query.
-		sql:  aq.sql.Clone(),
-		path: aq.path,
+		sql:       aq.sql.Clone(),
+		path:      aq.path,
+		modifiers: append([]func(*sql.Selector){}, aq.modifiers...),
 	}
 }
 
@@ -293,6 +371,39 @@ func (aq *ActivityQuery) WithUser(opts ...func(*UserQuery)) *ActivityQuery {
 	return aq
 }
 
+// WithHexes tells the query-builder to eager-load the nodes that are connected to
+// the "hexes" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *ActivityQuery) WithHexes(opts ...func(*ActivityHexQuery)) *ActivityQuery {
+	query := (&ActivityHexClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withHexes = query
+	return aq
+}
+
+// WithSegmentEfforts tells the query-builder to eager-load the nodes that are connected to
+// the "segment_efforts" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *ActivityQuery) WithSegmentEfforts(opts ...func(*SegmentEffortQuery)) *ActivityQuery {
+	query := (&SegmentEffortClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withSegmentEfforts = query
+	return aq
+}
+
+// WithJobs tells the query-builder to eager-load the nodes that are connected to
+// the "jobs" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *ActivityQuery) WithJobs(opts ...func(*ActivityJobQuery)) *ActivityQuery {
+	query := (&ActivityJobClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withJobs = query
+	return aq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -371,8 +482,11 @@ func (aq *ActivityQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Act
 	var (
 		nodes       = []*Activity{}
 		_spec       = aq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [4]bool{
 			aq.withUser != nil,
+			aq.withHexes != nil,
+			aq.withSegmentEfforts != nil,
+			aq.withJobs != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -384,6 +498,9 @@ func (aq *ActivityQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Act
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(aq.modifiers) > 0 {
+		_spec.Modifiers = aq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -399,6 +516,27 @@ func (aq *ActivityQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Act
 			return nil, err
 		}
 	}
+	if query := aq.withHexes; query != nil {
+		if err := aq.loadHexes(ctx, query, nodes,
+			func(n *Activity) { n.Edges.Hexes = []*ActivityHex{} },
+			func(n *Activity, e *ActivityHex) { n.Edges.Hexes = append(n.Edges.Hexes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withSegmentEfforts; query != nil {
+		if err := aq.loadSegmentEfforts(ctx, query, nodes,
+			func(n *Activity) { n.Edges.SegmentEfforts = []*SegmentEffort{} },
+			func(n *Activity, e *SegmentEffort) { n.Edges.SegmentEfforts = append(n.Edges.SegmentEfforts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withJobs; query != nil {
+		if err := aq.loadJobs(ctx, query, nodes,
+			func(n *Activity) { n.Edges.Jobs = []*ActivityJob{} },
+			func(n *Activity, e *ActivityJob) { n.Edges.Jobs = append(n.Edges.Jobs, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -431,9 +569,102 @@ func (aq *ActivityQuery) loadUser(ctx context.Context, query *UserQuery, nodes [
 	}
 	return nil
 }
+func (aq *ActivityQuery) loadHexes(ctx context.Context, query *ActivityHexQuery, nodes []*Activity, init func(*Activity), assign func(*Activity, *ActivityHex)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Activity)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(activityhex.FieldActivityID)
+	}
+	query.Where(predicate.ActivityHex(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(activity.HexesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ActivityID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "activity_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *ActivityQuery) loadSegmentEfforts(ctx context.Context, query *SegmentEffortQuery, nodes []*Activity, init func(*Activity), assign func(*Activity, *SegmentEffort)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Activity)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(segmenteffort.FieldActivityID)
+	}
+	query.Where(predicate.SegmentEffort(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(activity.SegmentEffortsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ActivityID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "activity_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *ActivityQuery) loadJobs(ctx context.Context, query *ActivityJobQuery, nodes []*Activity, init func(*Activity), assign func(*Activity, *ActivityJob)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Activity)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(activityjob.FieldActivityID)
+	}
+	query.Where(predicate.ActivityJob(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(activity.JobsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ActivityID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "activity_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (aq *ActivityQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := aq.querySpec()
+	if len(aq.modifiers) > 0 {
+		_spec.Modifiers = aq.modifiers
+	}
 	_spec.Node.Columns = aq.ctx.Fields
 	if len(aq.ctx.Fields) > 0 {
 		_spec.Unique = aq.ctx.Unique != nil && *aq.ctx.Unique
@@ -499,6 +730,9 @@ func (aq *ActivityQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if aq.ctx.Unique != nil && *aq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range aq.modifiers {
+		m(selector)
+	}
 	for _, p := range aq.predicates {
 		p(selector)
 	}
@@ -516,6 +750,12 @@ func (aq *ActivityQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (aq *ActivityQuery) Modify(modifiers ...func(s *sql.Selector)) *ActivitySelect {
+	aq.modifiers = append(aq.modifiers, modifiers...)
+	return aq.Select()
+}
+
 // ActivityGroupBy is the group-by builder for Activity entities.
 type ActivityGroupBy struct {
 	selector
@@ -605,3 +845,9 @@ func (as *ActivitySelect) sqlScan(ctx context.Context, root *ActivityQuery, v an
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (as *ActivitySelect) Modify(modifiers ...func(s *sql.Selector)) *ActivitySelect {
+	as.modifiers = append(as.modifiers, modifiers...)
+	return as
+}
@@ -7,7 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"stride-wars-app/ent/activity"
+	"stride-wars-app/ent/activityhex"
+	"stride-wars-app/ent/activityjob"
+	"stride-wars-app/ent/model"
 	"stride-wars-app/ent/predicate"
+	"stride-wars-app/ent/segmenteffort"
 	"stride-wars-app/ent/user"
 	"time"
 
@@ -21,8 +25,9 @@ import (
 // ActivityUpdate is the builder for updating Activity entities.
 type ActivityUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ActivityMutation
+	hooks     []Hook
+	mutation  *ActivityMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ActivityUpdate builder.
@@ -113,11 +118,149 @@ func (au *ActivityUpdate) SetNillableCreatedAt(t *time.Time) *ActivityUpdate {
 	return au
 }
 
+// SetTrack sets the "track" field.
+func (au *ActivityUpdate) SetTrack(mp []model.TrackPoint) *ActivityUpdate {
+	au.mutation.SetTrack(mp)
+	return au
+}
+
+// AppendTrack appends mp to the "track" field.
+func (au *ActivityUpdate) AppendTrack(mp []model.TrackPoint) *ActivityUpdate {
+	au.mutation.AppendTrack(mp)
+	return au
+}
+
+// ClearTrack clears the value of the "track" field.
+func (au *ActivityUpdate) ClearTrack() *ActivityUpdate {
+	au.mutation.ClearTrack()
+	return au
+}
+
+// SetStartedAt sets the "started_at" field.
+func (au *ActivityUpdate) SetStartedAt(t time.Time) *ActivityUpdate {
+	au.mutation.SetStartedAt(t)
+	return au
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (au *ActivityUpdate) SetNillableStartedAt(t *time.Time) *ActivityUpdate {
+	if t != nil {
+		au.SetStartedAt(*t)
+	}
+	return au
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (au *ActivityUpdate) ClearStartedAt() *ActivityUpdate {
+	au.mutation.ClearStartedAt()
+	return au
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (au *ActivityUpdate) SetEndedAt(t time.Time) *ActivityUpdate {
+	au.mutation.SetEndedAt(t)
+	return au
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (au *ActivityUpdate) SetNillableEndedAt(t *time.Time) *ActivityUpdate {
+	if t != nil {
+		au.SetEndedAt(*t)
+	}
+	return au
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (au *ActivityUpdate) ClearEndedAt() *ActivityUpdate {
+	au.mutation.ClearEndedAt()
+	return au
+}
+
+// SetActivityType sets the "activity_type" field.
+func (au *ActivityUpdate) SetActivityType(at activity.ActivityType) *ActivityUpdate {
+	au.mutation.SetActivityType(at)
+	return au
+}
+
+// SetNillableActivityType sets the "activity_type" field if the given value is not nil.
+func (au *ActivityUpdate) SetNillableActivityType(at *activity.ActivityType) *ActivityUpdate {
+	if at != nil {
+		au.SetActivityType(*at)
+	}
+	return au
+}
+
+// SetNewHexes sets the "new_hexes" field.
+func (au *ActivityUpdate) SetNewHexes(i int) *ActivityUpdate {
+	au.mutation.ResetNewHexes()
+	au.mutation.SetNewHexes(i)
+	return au
+}
+
+// SetNillableNewHexes sets the "new_hexes" field if the given value is not nil.
+func (au *ActivityUpdate) SetNillableNewHexes(i *int) *ActivityUpdate {
+	if i != nil {
+		au.SetNewHexes(*i)
+	}
+	return au
+}
+
+// AddNewHexes adds i to the "new_hexes" field.
+func (au *ActivityUpdate) AddNewHexes(i int) *ActivityUpdate {
+	au.mutation.AddNewHexes(i)
+	return au
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (au *ActivityUpdate) SetUser(u *User) *ActivityUpdate {
 	return au.SetUserID(u.ID)
 }
 
+// AddHexIDs adds the "hexes" edge to the ActivityHex entity by IDs.
+func (au *ActivityUpdate) AddHexIDs(ids ...uuid.UUID) *ActivityUpdate {
+	au.mutation.AddHexIDs(ids...)
+	return au
+}
+
+// AddHexes adds the "hexes" edges to the ActivityHex entity.
+func (au *ActivityUpdate) AddHexes(a ...*ActivityHex) *ActivityUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.AddHexIDs(ids...)
+}
+
+// AddSegmentEffortIDs adds the "segment_efforts" edge to the SegmentEffort entity by IDs.
+func (au *ActivityUpdate) AddSegmentEffortIDs(ids ...uuid.UUID) *ActivityUpdate {
+	au.mutation.AddSegmentEffortIDs(ids...)
+	return au
+}
+
+// AddSegmentEfforts adds the "segment_efforts" edges to the SegmentEffort entity.
+func (au *ActivityUpdate) AddSegmentEfforts(s ...*SegmentEffort) *ActivityUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return au.AddSegmentEffortIDs(ids...)
+}
+
+// AddJobIDs adds the "jobs" edge to the ActivityJob entity by IDs.
+func (au *ActivityUpdate) AddJobIDs(ids ...uuid.UUID) *ActivityUpdate {
+	au.mutation.AddJobIDs(ids...)
+	return au
+}
+
+// AddJobs adds the "jobs" edges to the ActivityJob entity.
+func (au *ActivityUpdate) AddJobs(a ...*ActivityJob) *ActivityUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.AddJobIDs(ids...)
+}
+
 // Mutation returns the ActivityMutation object of the builder.
 func (au *ActivityUpdate) Mutation() *ActivityMutation {
 	return au.mutation
@@ -129,6 +272,69 @@ func (au *ActivityUpdate) ClearUser() *ActivityUpdate {
 	return au
 }
 
+// ClearHexes clears all "hexes" edges to the ActivityHex entity.
+func (au *ActivityUpdate) ClearHexes() *ActivityUpdate {
+	au.mutation.ClearHexes()
+	return au
+}
+
+// RemoveHexIDs removes the "hexes" edge to ActivityHex entities by IDs.
+func (au *ActivityUpdate) RemoveHexIDs(ids ...uuid.UUID) *ActivityUpdate {
+	au.mutation.RemoveHexIDs(ids...)
+	return au
+}
+
+// RemoveHexes removes "hexes" edges to ActivityHex entities.
+func (au *ActivityUpdate) RemoveHexes(a ...*ActivityHex) *ActivityUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.RemoveHexIDs(ids...)
+}
+
+// ClearSegmentEfforts clears all "segment_efforts" edges to the SegmentEffort entity.
+func (au *ActivityUpdate) ClearSegmentEfforts() *ActivityUpdate {
+	au.mutation.ClearSegmentEfforts()
+	return au
+}
+
+// RemoveSegmentEffortIDs removes the "segment_efforts" edge to SegmentEffort entities by IDs.
+func (au *ActivityUpdate) RemoveSegmentEffortIDs(ids ...uuid.UUID) *ActivityUpdate {
+	au.mutation.RemoveSegmentEffortIDs(ids...)
+	return au
+}
+
+// RemoveSegmentEfforts removes "segment_efforts" edges to SegmentEffort entities.
+func (au *ActivityUpdate) RemoveSegmentEfforts(s ...*SegmentEffort) *ActivityUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return au.RemoveSegmentEffortIDs(ids...)
+}
+
+// ClearJobs clears all "jobs" edges to the ActivityJob entity.
+func (au *ActivityUpdate) ClearJobs() *ActivityUpdate {
+	au.mutation.ClearJobs()
+	return au
+}
+
+// RemoveJobIDs removes the "jobs" edge to ActivityJob entities by IDs.
+func (au *ActivityUpdate) RemoveJobIDs(ids ...uuid.UUID) *ActivityUpdate {
+	au.mutation.RemoveJobIDs(ids...)
+	return au
+}
+
+// RemoveJobs removes "jobs" edges to ActivityJob entities.
+func (au *ActivityUpdate) RemoveJobs(a ...*ActivityJob) *ActivityUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.RemoveJobIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (au *ActivityUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
@@ -158,12 +364,23 @@ func (au *ActivityUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (au *ActivityUpdate) check() error {
+	if v, ok := au.mutation.ActivityType(); ok {
+		if err := activity.ActivityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "activity_type", err: fmt.Errorf(`ent: validator failed for field "Activity.activity_type": %w`, err)}
+		}
+	}
 	if au.mutation.UserCleared() && len(au.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Activity.user"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (au *ActivityUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ActivityUpdate {
+	au.modifiers = append(au.modifiers, modifiers...)
+	return au
+}
+
 func (au *ActivityUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := au.check(); err != nil {
 		return n, err
@@ -199,6 +416,38 @@ func (au *ActivityUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.CreatedAt(); ok {
 		_spec.SetField(activity.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := au.mutation.Track(); ok {
+		_spec.SetField(activity.FieldTrack, field.TypeJSON, value)
+	}
+	if value, ok := au.mutation.AppendedTrack(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, activity.FieldTrack, value)
+		})
+	}
+	if au.mutation.TrackCleared() {
+		_spec.ClearField(activity.FieldTrack, field.TypeJSON)
+	}
+	if value, ok := au.mutation.StartedAt(); ok {
+		_spec.SetField(activity.FieldStartedAt, field.TypeTime, value)
+	}
+	if au.mutation.StartedAtCleared() {
+		_spec.ClearField(activity.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := au.mutation.EndedAt(); ok {
+		_spec.SetField(activity.FieldEndedAt, field.TypeTime, value)
+	}
+	if au.mutation.EndedAtCleared() {
+		_spec.ClearField(activity.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := au.mutation.ActivityType(); ok {
+		_spec.SetField(activity.FieldActivityType, field.TypeEnum, value)
+	}
+	if value, ok := au.mutation.NewHexes(); ok {
+		_spec.SetField(activity.FieldNewHexes, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedNewHexes(); ok {
+		_spec.AddField(activity.FieldNewHexes, field.TypeInt, value)
+	}
 	if au.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -228,6 +477,142 @@ func (au *ActivityUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if au.mutation.HexesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   activity.HexesTable,
+			Columns: []string{activity.HexesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activityhex.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedHexesIDs(); len(nodes) > 0 && !au.mutation.HexesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   activity.HexesTable,
+			Columns: []string{activity.HexesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activityhex.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.HexesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   activity.HexesTable,
+			Columns: []string{activity.HexesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activityhex.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.SegmentEffortsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   activity.SegmentEffortsTable,
+			Columns: []string{activity.SegmentEffortsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(segmenteffort.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedSegmentEffortsIDs(); len(nodes) > 0 && !au.mutation.SegmentEffortsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   activity.SegmentEffortsTable,
+			Columns: []string{activity.SegmentEffortsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(segmenteffort.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.SegmentEffortsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   activity.SegmentEffortsTable,
+			Columns: []string{activity.SegmentEffortsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(segmenteffort.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   activity.JobsTable,
+			Columns: []string{activity.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activityjob.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedJobsIDs(); len(nodes) > 0 && !au.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   activity.JobsTable,
+			Columns: []string{activity.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activityjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   activity.JobsTable,
+			Columns: []string{activity.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activityjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(au.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{activity.Label}
@@ -243,9 +628,10 @@ func (au *ActivityUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // ActivityUpdateOne is the builder for updating a single Activity entity.
 type ActivityUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ActivityMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *ActivityMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUserID sets the "user_id" field.
@@ -330,11 +716,149 @@ func (auo *ActivityUpdateOne) SetNillableCreatedAt(t *time.Time) *ActivityUpdate
 	return auo
 }
 
+// SetTrack sets the "track" field.
+func (auo *ActivityUpdateOne) SetTrack(mp []model.TrackPoint) *ActivityUpdateOne {
+	auo.mutation.SetTrack(mp)
+	return auo
+}
+
+// AppendTrack appends mp to the "track" field.
+func (auo *ActivityUpdateOne) AppendTrack(mp []model.TrackPoint) *ActivityUpdateOne {
+	auo.mutation.AppendTrack(mp)
+	return auo
+}
+
+// ClearTrack clears the value of the "track" field.
+func (auo *ActivityUpdateOne) ClearTrack() *ActivityUpdateOne {
+	auo.mutation.ClearTrack()
+	return auo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (auo *ActivityUpdateOne) SetStartedAt(t time.Time) *ActivityUpdateOne {
+	auo.mutation.SetStartedAt(t)
+	return auo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (auo *ActivityUpdateOne) SetNillableStartedAt(t *time.Time) *ActivityUpdateOne {
+	if t != nil {
+		auo.SetStartedAt(*t)
+	}
+	return auo
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (auo *ActivityUpdateOne) ClearStartedAt() *ActivityUpdateOne {
+	auo.mutation.ClearStartedAt()
+	return auo
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (auo *ActivityUpdateOne) SetEndedAt(t time.Time) *ActivityUpdateOne {
+	auo.mutation.SetEndedAt(t)
+	return auo
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (auo *ActivityUpdateOne) SetNillableEndedAt(t *time.Time) *ActivityUpdateOne {
+	if t != nil {
+		auo.SetEndedAt(*t)
+	}
+	return auo
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (auo *ActivityUpdateOne) ClearEndedAt() *ActivityUpdateOne {
+	auo.mutation.ClearEndedAt()
+	return auo
+}
+
+// SetActivityType sets the "activity_type" field.
+func (auo *ActivityUpdateOne) SetActivityType(at activity.ActivityType) *ActivityUpdateOne {
+	auo.mutation.SetActivityType(at)
+	return auo
+}
+
+// SetNillableActivityType sets the "activity_type" field if the given value is not nil.
+func (auo *ActivityUpdateOne) SetNillableActivityType(at *activity.ActivityType) *ActivityUpdateOne {
+	if at != nil {
+		auo.SetActivityType(*at)
+	}
+	return auo
+}
+
+// SetNewHexes sets the "new_hexes" field.
+func (auo *ActivityUpdateOne) SetNewHexes(i int) *ActivityUpdateOne {
+	auo.mutation.ResetNewHexes()
+	auo.mutation.SetNewHexes(i)
+	return auo
+}
+
+// SetNillableNewHexes sets the "new_hexes" field if the given value is not nil.
+func (auo *ActivityUpdateOne) SetNillableNewHexes(i *int) *ActivityUpdateOne {
+	if i != nil {
+		auo.SetNewHexes(*i)
+	}
+	return auo
+}
+
+// AddNewHexes adds i to the "new_hexes" field.
+func (auo *ActivityUpdateOne) AddNewHexes(i int) *ActivityUpdateOne {
+	auo.mutation.AddNewHexes(i)
+	return auo
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (auo *ActivityUpdateOne) SetUser(u *User) *ActivityUpdateOne {
 	return auo.SetUserID(u.ID)
 }
 
+// AddHexIDs adds the "hexes" edge to the ActivityHex entity by IDs.
+func (auo *ActivityUpdateOne) AddHexIDs(ids ...uuid.UUID) *ActivityUpdateOne {
+	auo.mutation.AddHexIDs(ids...)
+	return auo
+}
+
+// AddHexes adds the "hexes" edges to the ActivityHex entity.
+func (auo *ActivityUpdateOne) AddHexes(a ...*ActivityHex) *ActivityUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.AddHexIDs(ids...)
+}
+
+// AddSegmentEffortIDs adds the "segment_efforts" edge to the SegmentEffort entity by IDs.
+func (auo *ActivityUpdateOne) AddSegmentEffortIDs(ids ...uuid.UUID) *ActivityUpdateOne {
+	auo.mutation.AddSegmentEffortIDs(ids...)
+	return auo
+}
+
+// AddSegmentEfforts adds the "segment_efforts" edges to the SegmentEffort entity.
+func (auo *ActivityUpdateOne) AddSegmentEfforts(s ...*SegmentEffort) *ActivityUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return auo.AddSegmentEffortIDs(ids...)
+}
+
+// AddJobIDs adds the "jobs" edge to the ActivityJob entity by IDs.
+func (auo *ActivityUpdateOne) AddJobIDs(ids ...uuid.UUID) *ActivityUpdateOne {
+	auo.mutation.AddJobIDs(ids...)
+	return auo
+}
+
+// AddJobs adds the "jobs" edges to the ActivityJob entity.
+func (auo *ActivityUpdateOne) AddJobs(a ...*ActivityJob) *ActivityUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.AddJobIDs(ids...)
+}
+
 // Mutation returns the ActivityMutation object of the builder.
 func (auo *ActivityUpdateOne) Mutation() *ActivityMutation {
 	return auo.mutation
@@ -346,6 +870,69 @@ func (auo *ActivityUpdateOne) ClearUser() *ActivityUpdateOne {
 	return auo
 }
 
+// ClearHexes clears all "hexes" edges to the ActivityHex entity.
+func (auo *ActivityUpdateOne) ClearHexes() *ActivityUpdateOne {
+	auo.mutation.ClearHexes()
+	return auo
+}
+
+// RemoveHexIDs removes the "hexes" edge to ActivityHex entities by IDs.
+func (auo *ActivityUpdateOne) RemoveHexIDs(ids ...uuid.UUID) *ActivityUpdateOne {
+	auo.mutation.RemoveHexIDs(ids...)
+	return auo
+}
+
+// RemoveHexes removes "hexes" edges to ActivityHex entities.
+func (auo *ActivityUpdateOne) RemoveHexes(a ...*ActivityHex) *ActivityUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.RemoveHexIDs(ids...)
+}
+
+// ClearSegmentEfforts clears all "segment_efforts" edges to the SegmentEffort entity.
+func (auo *ActivityUpdateOne) ClearSegmentEfforts() *ActivityUpdateOne {
+	auo.mutation.ClearSegmentEfforts()
+	return auo
+}
+
+// RemoveSegmentEffortIDs removes the "segment_efforts" edge to SegmentEffort entities by IDs.
+func (auo *ActivityUpdateOne) RemoveSegmentEffortIDs(ids ...uuid.UUID) *ActivityUpdateOne {
+	auo.mutation.RemoveSegmentEffortIDs(ids...)
+	return auo
+}
+
+// RemoveSegmentEfforts removes "segment_efforts" edges to SegmentEffort entities.
+func (auo *ActivityUpdateOne) RemoveSegmentEfforts(s ...*SegmentEffort) *ActivityUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return auo.RemoveSegmentEffortIDs(ids...)
+}
+
+// ClearJobs clears all "jobs" edges to the ActivityJob entity.
+func (auo *ActivityUpdateOne) ClearJobs() *ActivityUpdateOne {
+	auo.mutation.ClearJobs()
+	return auo
+}
+
+// RemoveJobIDs removes the "jobs" edge to ActivityJob entities by IDs.
+func (auo *ActivityUpdateOne) RemoveJobIDs(ids ...uuid.UUID) *ActivityUpdateOne {
+	auo.mutation.RemoveJobIDs(ids...)
+	return auo
+}
+
+// RemoveJobs removes "jobs" edges to ActivityJob entities.
+func (auo *ActivityUpdateOne) RemoveJobs(a ...*ActivityJob) *ActivityUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.RemoveJobIDs(ids...)
+}
+
 // Where appends a list predicates to the ActivityUpdate builder.
 func (auo *ActivityUpdateOne) Where(ps ...predicate.Activity) *ActivityUpdateOne {
 	auo.mutation.Where(ps...)
@@ -388,12 +975,23 @@ func (auo *ActivityUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (auo *ActivityUpdateOne) check() error {
+	if v, ok := auo.mutation.ActivityType(); ok {
+		if err := activity.ActivityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "activity_type", err: fmt.Errorf(`ent: validator failed for field "Activity.activity_type": %w`, err)}
+		}
+	}
 	if auo.mutation.UserCleared() && len(auo.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Activity.user"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (auo *ActivityUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ActivityUpdateOne {
+	auo.modifiers = append(auo.modifiers, modifiers...)
+	return auo
+}
+
 func (auo *ActivityUpdateOne) sqlSave(ctx context.Context) (_node *Activity, err error) {
 	if err := auo.check(); err != nil {
 		return _node, err
@@ -446,6 +1044,38 @@ func (auo *ActivityUpdateOne) sqlSave(ctx context.Context) (_node *Activity, err
 	if value, ok := auo.mutation.CreatedAt(); ok {
 		_spec.SetField(activity.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := auo.mutation.Track(); ok {
+		_spec.SetField(activity.FieldTrack, field.TypeJSON, value)
+	}
+	if value, ok := auo.mutation.AppendedTrack(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, activity.FieldTrack, value)
+		})
+	}
+	if auo.mutation.TrackCleared() {
+		_spec.ClearField(activity.FieldTrack, field.TypeJSON)
+	}
+	if value, ok := auo.mutation.StartedAt(); ok {
+		_spec.SetField(activity.FieldStartedAt, field.TypeTime, value)
+	}
+	if auo.mutation.StartedAtCleared() {
+		_spec.ClearField(activity.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := auo.mutation.EndedAt(); ok {
+		_spec.SetField(activity.FieldEndedAt, field.TypeTime, value)
+	}
+	if auo.mutation.EndedAtCleared() {
+		_spec.ClearField(activity.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := auo.mutation.ActivityType(); ok {
+		_spec.SetField(activity.FieldActivityType, field.TypeEnum, value)
+	}
+	if value, ok := auo.mutation.NewHexes(); ok {
+		_spec.SetField(activity.FieldNewHexes, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedNewHexes(); ok {
+		_spec.AddField(activity.FieldNewHexes, field.TypeInt, value)
+	}
 	if auo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -475,6 +1105,142 @@ func (auo *ActivityUpdateOne) sqlSave(ctx context.Context) (_node *Activity, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if auo.mutation.HexesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   activity.HexesTable,
+			Columns: []string{activity.HexesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activityhex.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedHexesIDs(); len(nodes) > 0 && !auo.mutation.HexesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   activity.HexesTable,
+			Columns: []string{activity.HexesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activityhex.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.HexesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   activity.HexesTable,
+			Columns: []string{activity.HexesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activityhex.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.SegmentEffortsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   activity.SegmentEffortsTable,
+			Columns: []string{activity.SegmentEffortsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(segmenteffort.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedSegmentEffortsIDs(); len(nodes) > 0 && !auo.mutation.SegmentEffortsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   activity.SegmentEffortsTable,
+			Columns: []string{activity.SegmentEffortsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(segmenteffort.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.SegmentEffortsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   activity.SegmentEffortsTable,
+			Columns: []string{activity.SegmentEffortsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(segmenteffort.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   activity.JobsTable,
+			Columns: []string{activity.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activityjob.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedJobsIDs(); len(nodes) > 0 && !auo.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   activity.JobsTable,
+			Columns: []string{activity.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activityjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   activity.JobsTable,
+			Columns: []string{activity.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activityjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(auo.modifiers...)
 	_node = &Activity{config: auo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
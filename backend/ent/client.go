@@ -12,11 +12,38 @@ import (
 	"stride-wars-app/ent/migrate"
 
 	"stride-wars-app/ent/activity"
+	"stride-wars-app/ent/activityhex"
+	"stride-wars-app/ent/activityjob"
+	"stride-wars-app/ent/activitysession"
+	"stride-wars-app/ent/decaysweep"
 	"stride-wars-app/ent/friendship"
+	"stride-wars-app/ent/goal"
 	"stride-wars-app/ent/hex"
+	"stride-wars-app/ent/hexcapture"
 	"stride-wars-app/ent/hexinfluence"
 	"stride-wars-app/ent/hexleaderboard"
+	"stride-wars-app/ent/hexrollup"
+	"stride-wars-app/ent/idempotencykey"
+	"stride-wars-app/ent/influencehistory"
+	"stride-wars-app/ent/notification"
+	"stride-wars-app/ent/notificationpreference"
+	"stride-wars-app/ent/personalrecord"
+	"stride-wars-app/ent/privacyzone"
+	"stride-wars-app/ent/pushdevice"
+	"stride-wars-app/ent/pushmessage"
+	"stride-wars-app/ent/region"
+	"stride-wars-app/ent/regionhex"
+	"stride-wars-app/ent/regionstanding"
+	"stride-wars-app/ent/season"
+	"stride-wars-app/ent/seasonhexstanding"
+	"stride-wars-app/ent/seasonstanding"
+	"stride-wars-app/ent/segment"
+	"stride-wars-app/ent/segmenteffort"
+	"stride-wars-app/ent/setting"
+	"stride-wars-app/ent/streak"
 	"stride-wars-app/ent/user"
+	"stride-wars-app/ent/webhookdelivery"
+	"stride-wars-app/ent/webhooksubscription"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -32,16 +59,70 @@ type Client struct {
 	Schema *migrate.Schema
 	// Activity is the client for interacting with the Activity builders.
 	Activity *ActivityClient
+	// ActivityHex is the client for interacting with the ActivityHex builders.
+	ActivityHex *ActivityHexClient
+	// ActivityJob is the client for interacting with the ActivityJob builders.
+	ActivityJob *ActivityJobClient
+	// ActivitySession is the client for interacting with the ActivitySession builders.
+	ActivitySession *ActivitySessionClient
+	// DecaySweep is the client for interacting with the DecaySweep builders.
+	DecaySweep *DecaySweepClient
 	// Friendship is the client for interacting with the Friendship builders.
 	Friendship *FriendshipClient
+	// Goal is the client for interacting with the Goal builders.
+	Goal *GoalClient
 	// Hex is the client for interacting with the Hex builders.
 	Hex *HexClient
+	// HexCapture is the client for interacting with the HexCapture builders.
+	HexCapture *HexCaptureClient
 	// HexInfluence is the client for interacting with the HexInfluence builders.
 	HexInfluence *HexInfluenceClient
 	// HexLeaderboard is the client for interacting with the HexLeaderboard builders.
 	HexLeaderboard *HexLeaderboardClient
+	// HexRollup is the client for interacting with the HexRollup builders.
+	HexRollup *HexRollupClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// InfluenceHistory is the client for interacting with the InfluenceHistory builders.
+	InfluenceHistory *InfluenceHistoryClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
+	// PersonalRecord is the client for interacting with the PersonalRecord builders.
+	PersonalRecord *PersonalRecordClient
+	// PrivacyZone is the client for interacting with the PrivacyZone builders.
+	PrivacyZone *PrivacyZoneClient
+	// PushDevice is the client for interacting with the PushDevice builders.
+	PushDevice *PushDeviceClient
+	// PushMessage is the client for interacting with the PushMessage builders.
+	PushMessage *PushMessageClient
+	// Region is the client for interacting with the Region builders.
+	Region *RegionClient
+	// RegionHex is the client for interacting with the RegionHex builders.
+	RegionHex *RegionHexClient
+	// RegionStanding is the client for interacting with the RegionStanding builders.
+	RegionStanding *RegionStandingClient
+	// Season is the client for interacting with the Season builders.
+	Season *SeasonClient
+	// SeasonHexStanding is the client for interacting with the SeasonHexStanding builders.
+	SeasonHexStanding *SeasonHexStandingClient
+	// SeasonStanding is the client for interacting with the SeasonStanding builders.
+	SeasonStanding *SeasonStandingClient
+	// Segment is the client for interacting with the Segment builders.
+	Segment *SegmentClient
+	// SegmentEffort is the client for interacting with the SegmentEffort builders.
+	SegmentEffort *SegmentEffortClient
+	// Setting is the client for interacting with the Setting builders.
+	Setting *SettingClient
+	// Streak is the client for interacting with the Streak builders.
+	Streak *StreakClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookSubscription is the client for interacting with the WebhookSubscription builders.
+	WebhookSubscription *WebhookSubscriptionClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -54,11 +135,38 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Activity = NewActivityClient(c.config)
+	c.ActivityHex = NewActivityHexClient(c.config)
+	c.ActivityJob = NewActivityJobClient(c.config)
+	c.ActivitySession = NewActivitySessionClient(c.config)
+	c.DecaySweep = NewDecaySweepClient(c.config)
 	c.Friendship = NewFriendshipClient(c.config)
+	c.Goal = NewGoalClient(c.config)
 	c.Hex = NewHexClient(c.config)
+	c.HexCapture = NewHexCaptureClient(c.config)
 	c.HexInfluence = NewHexInfluenceClient(c.config)
 	c.HexLeaderboard = NewHexLeaderboardClient(c.config)
+	c.HexRollup = NewHexRollupClient(c.config)
+	c.IdempotencyKey = NewIdempotencyKeyClient(c.config)
+	c.InfluenceHistory = NewInfluenceHistoryClient(c.config)
+	c.Notification = NewNotificationClient(c.config)
+	c.NotificationPreference = NewNotificationPreferenceClient(c.config)
+	c.PersonalRecord = NewPersonalRecordClient(c.config)
+	c.PrivacyZone = NewPrivacyZoneClient(c.config)
+	c.PushDevice = NewPushDeviceClient(c.config)
+	c.PushMessage = NewPushMessageClient(c.config)
+	c.Region = NewRegionClient(c.config)
+	c.RegionHex = NewRegionHexClient(c.config)
+	c.RegionStanding = NewRegionStandingClient(c.config)
+	c.Season = NewSeasonClient(c.config)
+	c.SeasonHexStanding = NewSeasonHexStandingClient(c.config)
+	c.SeasonStanding = NewSeasonStandingClient(c.config)
+	c.Segment = NewSegmentClient(c.config)
+	c.SegmentEffort = NewSegmentEffortClient(c.config)
+	c.Setting = NewSettingClient(c.config)
+	c.Streak = NewStreakClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
+	c.WebhookSubscription = NewWebhookSubscriptionClient(c.config)
 }
 
 type (
@@ -149,14 +257,41 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		Activity:       NewActivityClient(cfg),
-		Friendship:     NewFriendshipClient(cfg),
-		Hex:            NewHexClient(cfg),
-		HexInfluence:   NewHexInfluenceClient(cfg),
-		HexLeaderboard: NewHexLeaderboardClient(cfg),
-		User:           NewUserClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		Activity:               NewActivityClient(cfg),
+		ActivityHex:            NewActivityHexClient(cfg),
+		ActivityJob:            NewActivityJobClient(cfg),
+		ActivitySession:        NewActivitySessionClient(cfg),
+		DecaySweep:             NewDecaySweepClient(cfg),
+		Friendship:             NewFriendshipClient(cfg),
+		Goal:                   NewGoalClient(cfg),
+		Hex:                    NewHexClient(cfg),
+		HexCapture:             NewHexCaptureClient(cfg),
+		HexInfluence:           NewHexInfluenceClient(cfg),
+		HexLeaderboard:         NewHexLeaderboardClient(cfg),
+		HexRollup:              NewHexRollupClient(cfg),
+		IdempotencyKey:         NewIdempotencyKeyClient(cfg),
+		InfluenceHistory:       NewInfluenceHistoryClient(cfg),
+		Notification:           NewNotificationClient(cfg),
+		NotificationPreference: NewNotificationPreferenceClient(cfg),
+		PersonalRecord:         NewPersonalRecordClient(cfg),
+		PrivacyZone:            NewPrivacyZoneClient(cfg),
+		PushDevice:             NewPushDeviceClient(cfg),
+		PushMessage:            NewPushMessageClient(cfg),
+		Region:                 NewRegionClient(cfg),
+		RegionHex:              NewRegionHexClient(cfg),
+		RegionStanding:         NewRegionStandingClient(cfg),
+		Season:                 NewSeasonClient(cfg),
+		SeasonHexStanding:      NewSeasonHexStandingClient(cfg),
+		SeasonStanding:         NewSeasonStandingClient(cfg),
+		Segment:                NewSegmentClient(cfg),
+		SegmentEffort:          NewSegmentEffortClient(cfg),
+		Setting:                NewSettingClient(cfg),
+		Streak:                 NewStreakClient(cfg),
+		User:                   NewUserClient(cfg),
+		WebhookDelivery:        NewWebhookDeliveryClient(cfg),
+		WebhookSubscription:    NewWebhookSubscriptionClient(cfg),
 	}, nil
 }
 
@@ -174,14 +309,41 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		Activity:       NewActivityClient(cfg),
-		Friendship:     NewFriendshipClient(cfg),
-		Hex:            NewHexClient(cfg),
-		HexInfluence:   NewHexInfluenceClient(cfg),
-		HexLeaderboard: NewHexLeaderboardClient(cfg),
-		User:           NewUserClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		Activity:               NewActivityClient(cfg),
+		ActivityHex:            NewActivityHexClient(cfg),
+		ActivityJob:            NewActivityJobClient(cfg),
+		ActivitySession:        NewActivitySessionClient(cfg),
+		DecaySweep:             NewDecaySweepClient(cfg),
+		Friendship:             NewFriendshipClient(cfg),
+		Goal:                   NewGoalClient(cfg),
+		Hex:                    NewHexClient(cfg),
+		HexCapture:             NewHexCaptureClient(cfg),
+		HexInfluence:           NewHexInfluenceClient(cfg),
+		HexLeaderboard:         NewHexLeaderboardClient(cfg),
+		HexRollup:              NewHexRollupClient(cfg),
+		IdempotencyKey:         NewIdempotencyKeyClient(cfg),
+		InfluenceHistory:       NewInfluenceHistoryClient(cfg),
+		Notification:           NewNotificationClient(cfg),
+		NotificationPreference: NewNotificationPreferenceClient(cfg),
+		PersonalRecord:         NewPersonalRecordClient(cfg),
+		PrivacyZone:            NewPrivacyZoneClient(cfg),
+		PushDevice:             NewPushDeviceClient(cfg),
+		PushMessage:            NewPushMessageClient(cfg),
+		Region:                 NewRegionClient(cfg),
+		RegionHex:              NewRegionHexClient(cfg),
+		RegionStanding:         NewRegionStandingClient(cfg),
+		Season:                 NewSeasonClient(cfg),
+		SeasonHexStanding:      NewSeasonHexStandingClient(cfg),
+		SeasonStanding:         NewSeasonStandingClient(cfg),
+		Segment:                NewSegmentClient(cfg),
+		SegmentEffort:          NewSegmentEffortClient(cfg),
+		Setting:                NewSettingClient(cfg),
+		Streak:                 NewStreakClient(cfg),
+		User:                   NewUserClient(cfg),
+		WebhookDelivery:        NewWebhookDeliveryClient(cfg),
+		WebhookSubscription:    NewWebhookSubscriptionClient(cfg),
 	}, nil
 }
 
@@ -211,7 +373,13 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Activity, c.Friendship, c.Hex, c.HexInfluence, c.HexLeaderboard, c.User,
+		c.Activity, c.ActivityHex, c.ActivityJob, c.ActivitySession, c.DecaySweep,
+		c.Friendship, c.Goal, c.Hex, c.HexCapture, c.HexInfluence, c.HexLeaderboard,
+		c.HexRollup, c.IdempotencyKey, c.InfluenceHistory, c.Notification,
+		c.NotificationPreference, c.PersonalRecord, c.PrivacyZone, c.PushDevice,
+		c.PushMessage, c.Region, c.RegionHex, c.RegionStanding, c.Season,
+		c.SeasonHexStanding, c.SeasonStanding, c.Segment, c.SegmentEffort, c.Setting,
+		c.Streak, c.User, c.WebhookDelivery, c.WebhookSubscription,
 	} {
 		n.Use(hooks...)
 	}
@@ -221,7 +389,13 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Activity, c.Friendship, c.Hex, c.HexInfluence, c.HexLeaderboard, c.User,
+		c.Activity, c.ActivityHex, c.ActivityJob, c.ActivitySession, c.DecaySweep,
+		c.Friendship, c.Goal, c.Hex, c.HexCapture, c.HexInfluence, c.HexLeaderboard,
+		c.HexRollup, c.IdempotencyKey, c.InfluenceHistory, c.Notification,
+		c.NotificationPreference, c.PersonalRecord, c.PrivacyZone, c.PushDevice,
+		c.PushMessage, c.Region, c.RegionHex, c.RegionStanding, c.Season,
+		c.SeasonHexStanding, c.SeasonStanding, c.Segment, c.SegmentEffort, c.Setting,
+		c.Streak, c.User, c.WebhookDelivery, c.WebhookSubscription,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -232,16 +406,70 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *ActivityMutation:
 		return c.Activity.mutate(ctx, m)
+	case *ActivityHexMutation:
+		return c.ActivityHex.mutate(ctx, m)
+	case *ActivityJobMutation:
+		return c.ActivityJob.mutate(ctx, m)
+	case *ActivitySessionMutation:
+		return c.ActivitySession.mutate(ctx, m)
+	case *DecaySweepMutation:
+		return c.DecaySweep.mutate(ctx, m)
 	case *FriendshipMutation:
 		return c.Friendship.mutate(ctx, m)
+	case *GoalMutation:
+		return c.Goal.mutate(ctx, m)
 	case *HexMutation:
 		return c.Hex.mutate(ctx, m)
+	case *HexCaptureMutation:
+		return c.HexCapture.mutate(ctx, m)
 	case *HexInfluenceMutation:
 		return c.HexInfluence.mutate(ctx, m)
 	case *HexLeaderboardMutation:
 		return c.HexLeaderboard.mutate(ctx, m)
+	case *HexRollupMutation:
+		return c.HexRollup.mutate(ctx, m)
+	case *IdempotencyKeyMutation:
+		return c.IdempotencyKey.mutate(ctx, m)
+	case *InfluenceHistoryMutation:
+		return c.InfluenceHistory.mutate(ctx, m)
+	case *NotificationMutation:
+		return c.Notification.mutate(ctx, m)
+	case *NotificationPreferenceMutation:
+		return c.NotificationPreference.mutate(ctx, m)
+	case *PersonalRecordMutation:
+		return c.PersonalRecord.mutate(ctx, m)
+	case *PrivacyZoneMutation:
+		return c.PrivacyZone.mutate(ctx, m)
+	case *PushDeviceMutation:
+		return c.PushDevice.mutate(ctx, m)
+	case *PushMessageMutation:
+		return c.PushMessage.mutate(ctx, m)
+	case *RegionMutation:
+		return c.Region.mutate(ctx, m)
+	case *RegionHexMutation:
+		return c.RegionHex.mutate(ctx, m)
+	case *RegionStandingMutation:
+		return c.RegionStanding.mutate(ctx, m)
+	case *SeasonMutation:
+		return c.Season.mutate(ctx, m)
+	case *SeasonHexStandingMutation:
+		return c.SeasonHexStanding.mutate(ctx, m)
+	case *SeasonStandingMutation:
+		return c.SeasonStanding.mutate(ctx, m)
+	case *SegmentMutation:
+		return c.Segment.mutate(ctx, m)
+	case *SegmentEffortMutation:
+		return c.SegmentEffort.mutate(ctx, m)
+	case *SettingMutation:
+		return c.Setting.mutate(ctx, m)
+	case *StreakMutation:
+		return c.Streak.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
+	case *WebhookSubscriptionMutation:
+		return c.WebhookSubscription.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -371,6 +599,54 @@ func (c *ActivityClient) QueryUser(a *Activity) *UserQuery {
 	return query
 }
 
+// QueryHexes queries the hexes edge of a Activity.
+func (c *ActivityClient) QueryHexes(a *Activity) *ActivityHexQuery {
+	query := (&ActivityHexClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(activity.Table, activity.FieldID, id),
+			sqlgraph.To(activityhex.Table, activityhex.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, activity.HexesTable, activity.HexesColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySegmentEfforts queries the segment_efforts edge of a Activity.
+func (c *ActivityClient) QuerySegmentEfforts(a *Activity) *SegmentEffortQuery {
+	query := (&SegmentEffortClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(activity.Table, activity.FieldID, id),
+			sqlgraph.To(segmenteffort.Table, segmenteffort.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, activity.SegmentEffortsTable, activity.SegmentEffortsColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryJobs queries the jobs edge of a Activity.
+func (c *ActivityClient) QueryJobs(a *Activity) *ActivityJobQuery {
+	query := (&ActivityJobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(activity.Table, activity.FieldID, id),
+			sqlgraph.To(activityjob.Table, activityjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, activity.JobsTable, activity.JobsColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ActivityClient) Hooks() []Hook {
 	return c.hooks.Activity
@@ -396,107 +672,107 @@ func (c *ActivityClient) mutate(ctx context.Context, m *ActivityMutation) (Value
 	}
 }
 
-// FriendshipClient is a client for the Friendship schema.
-type FriendshipClient struct {
+// ActivityHexClient is a client for the ActivityHex schema.
+type ActivityHexClient struct {
 	config
 }
 
-// NewFriendshipClient returns a client for the Friendship from the given config.
-func NewFriendshipClient(c config) *FriendshipClient {
-	return &FriendshipClient{config: c}
+// NewActivityHexClient returns a client for the ActivityHex from the given config.
+func NewActivityHexClient(c config) *ActivityHexClient {
+	return &ActivityHexClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `friendship.Hooks(f(g(h())))`.
-func (c *FriendshipClient) Use(hooks ...Hook) {
-	c.hooks.Friendship = append(c.hooks.Friendship, hooks...)
+// A call to `Use(f, g, h)` equals to `activityhex.Hooks(f(g(h())))`.
+func (c *ActivityHexClient) Use(hooks ...Hook) {
+	c.hooks.ActivityHex = append(c.hooks.ActivityHex, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `friendship.Intercept(f(g(h())))`.
-func (c *FriendshipClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Friendship = append(c.inters.Friendship, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `activityhex.Intercept(f(g(h())))`.
+func (c *ActivityHexClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ActivityHex = append(c.inters.ActivityHex, interceptors...)
 }
 
-// Create returns a builder for creating a Friendship entity.
-func (c *FriendshipClient) Create() *FriendshipCreate {
-	mutation := newFriendshipMutation(c.config, OpCreate)
-	return &FriendshipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ActivityHex entity.
+func (c *ActivityHexClient) Create() *ActivityHexCreate {
+	mutation := newActivityHexMutation(c.config, OpCreate)
+	return &ActivityHexCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Friendship entities.
-func (c *FriendshipClient) CreateBulk(builders ...*FriendshipCreate) *FriendshipCreateBulk {
-	return &FriendshipCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ActivityHex entities.
+func (c *ActivityHexClient) CreateBulk(builders ...*ActivityHexCreate) *ActivityHexCreateBulk {
+	return &ActivityHexCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FriendshipClient) MapCreateBulk(slice any, setFunc func(*FriendshipCreate, int)) *FriendshipCreateBulk {
+func (c *ActivityHexClient) MapCreateBulk(slice any, setFunc func(*ActivityHexCreate, int)) *ActivityHexCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FriendshipCreateBulk{err: fmt.Errorf("calling to FriendshipClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ActivityHexCreateBulk{err: fmt.Errorf("calling to ActivityHexClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FriendshipCreate, rv.Len())
+	builders := make([]*ActivityHexCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FriendshipCreateBulk{config: c.config, builders: builders}
+	return &ActivityHexCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Friendship.
-func (c *FriendshipClient) Update() *FriendshipUpdate {
-	mutation := newFriendshipMutation(c.config, OpUpdate)
-	return &FriendshipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ActivityHex.
+func (c *ActivityHexClient) Update() *ActivityHexUpdate {
+	mutation := newActivityHexMutation(c.config, OpUpdate)
+	return &ActivityHexUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FriendshipClient) UpdateOne(f *Friendship) *FriendshipUpdateOne {
-	mutation := newFriendshipMutation(c.config, OpUpdateOne, withFriendship(f))
-	return &FriendshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ActivityHexClient) UpdateOne(ah *ActivityHex) *ActivityHexUpdateOne {
+	mutation := newActivityHexMutation(c.config, OpUpdateOne, withActivityHex(ah))
+	return &ActivityHexUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FriendshipClient) UpdateOneID(id int) *FriendshipUpdateOne {
-	mutation := newFriendshipMutation(c.config, OpUpdateOne, withFriendshipID(id))
-	return &FriendshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ActivityHexClient) UpdateOneID(id uuid.UUID) *ActivityHexUpdateOne {
+	mutation := newActivityHexMutation(c.config, OpUpdateOne, withActivityHexID(id))
+	return &ActivityHexUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Friendship.
-func (c *FriendshipClient) Delete() *FriendshipDelete {
-	mutation := newFriendshipMutation(c.config, OpDelete)
-	return &FriendshipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ActivityHex.
+func (c *ActivityHexClient) Delete() *ActivityHexDelete {
+	mutation := newActivityHexMutation(c.config, OpDelete)
+	return &ActivityHexDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *FriendshipClient) DeleteOne(f *Friendship) *FriendshipDeleteOne {
-	return c.DeleteOneID(f.ID)
+func (c *ActivityHexClient) DeleteOne(ah *ActivityHex) *ActivityHexDeleteOne {
+	return c.DeleteOneID(ah.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *FriendshipClient) DeleteOneID(id int) *FriendshipDeleteOne {
-	builder := c.Delete().Where(friendship.ID(id))
+func (c *ActivityHexClient) DeleteOneID(id uuid.UUID) *ActivityHexDeleteOne {
+	builder := c.Delete().Where(activityhex.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FriendshipDeleteOne{builder}
+	return &ActivityHexDeleteOne{builder}
 }
 
-// Query returns a query builder for Friendship.
-func (c *FriendshipClient) Query() *FriendshipQuery {
-	return &FriendshipQuery{
+// Query returns a query builder for ActivityHex.
+func (c *ActivityHexClient) Query() *ActivityHexQuery {
+	return &ActivityHexQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeFriendship},
+		ctx:    &QueryContext{Type: TypeActivityHex},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Friendship entity by its id.
-func (c *FriendshipClient) Get(ctx context.Context, id int) (*Friendship, error) {
-	return c.Query().Where(friendship.ID(id)).Only(ctx)
+// Get returns a ActivityHex entity by its id.
+func (c *ActivityHexClient) Get(ctx context.Context, id uuid.UUID) (*ActivityHex, error) {
+	return c.Query().Where(activityhex.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FriendshipClient) GetX(ctx context.Context, id int) *Friendship {
+func (c *ActivityHexClient) GetX(ctx context.Context, id uuid.UUID) *ActivityHex {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -504,164 +780,148 @@ func (c *FriendshipClient) GetX(ctx context.Context, id int) *Friendship {
 	return obj
 }
 
-// QueryUsers queries the users edge of a Friendship.
-func (c *FriendshipClient) QueryUsers(f *Friendship) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := f.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(friendship.Table, friendship.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, friendship.UsersTable, friendship.UsersColumn),
-		)
-		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryFriends queries the friends edge of a Friendship.
-func (c *FriendshipClient) QueryFriends(f *Friendship) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryActivity queries the activity edge of a ActivityHex.
+func (c *ActivityHexClient) QueryActivity(ah *ActivityHex) *ActivityQuery {
+	query := (&ActivityClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := f.ID
+		id := ah.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(friendship.Table, friendship.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, friendship.FriendsTable, friendship.FriendsColumn),
+			sqlgraph.From(activityhex.Table, activityhex.FieldID, id),
+			sqlgraph.To(activity.Table, activity.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, activityhex.ActivityTable, activityhex.ActivityColumn),
 		)
-		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ah.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *FriendshipClient) Hooks() []Hook {
-	return c.hooks.Friendship
+func (c *ActivityHexClient) Hooks() []Hook {
+	return c.hooks.ActivityHex
 }
 
 // Interceptors returns the client interceptors.
-func (c *FriendshipClient) Interceptors() []Interceptor {
-	return c.inters.Friendship
+func (c *ActivityHexClient) Interceptors() []Interceptor {
+	return c.inters.ActivityHex
 }
 
-func (c *FriendshipClient) mutate(ctx context.Context, m *FriendshipMutation) (Value, error) {
+func (c *ActivityHexClient) mutate(ctx context.Context, m *ActivityHexMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&FriendshipCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ActivityHexCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&FriendshipUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ActivityHexUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&FriendshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ActivityHexUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&FriendshipDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ActivityHexDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Friendship mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ActivityHex mutation op: %q", m.Op())
 	}
 }
 
-// HexClient is a client for the Hex schema.
-type HexClient struct {
+// ActivityJobClient is a client for the ActivityJob schema.
+type ActivityJobClient struct {
 	config
 }
 
-// NewHexClient returns a client for the Hex from the given config.
-func NewHexClient(c config) *HexClient {
-	return &HexClient{config: c}
+// NewActivityJobClient returns a client for the ActivityJob from the given config.
+func NewActivityJobClient(c config) *ActivityJobClient {
+	return &ActivityJobClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `hex.Hooks(f(g(h())))`.
-func (c *HexClient) Use(hooks ...Hook) {
-	c.hooks.Hex = append(c.hooks.Hex, hooks...)
+// A call to `Use(f, g, h)` equals to `activityjob.Hooks(f(g(h())))`.
+func (c *ActivityJobClient) Use(hooks ...Hook) {
+	c.hooks.ActivityJob = append(c.hooks.ActivityJob, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `hex.Intercept(f(g(h())))`.
-func (c *HexClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Hex = append(c.inters.Hex, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `activityjob.Intercept(f(g(h())))`.
+func (c *ActivityJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ActivityJob = append(c.inters.ActivityJob, interceptors...)
 }
 
-// Create returns a builder for creating a Hex entity.
-func (c *HexClient) Create() *HexCreate {
-	mutation := newHexMutation(c.config, OpCreate)
-	return &HexCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ActivityJob entity.
+func (c *ActivityJobClient) Create() *ActivityJobCreate {
+	mutation := newActivityJobMutation(c.config, OpCreate)
+	return &ActivityJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Hex entities.
-func (c *HexClient) CreateBulk(builders ...*HexCreate) *HexCreateBulk {
-	return &HexCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ActivityJob entities.
+func (c *ActivityJobClient) CreateBulk(builders ...*ActivityJobCreate) *ActivityJobCreateBulk {
+	return &ActivityJobCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *HexClient) MapCreateBulk(slice any, setFunc func(*HexCreate, int)) *HexCreateBulk {
+func (c *ActivityJobClient) MapCreateBulk(slice any, setFunc func(*ActivityJobCreate, int)) *ActivityJobCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &HexCreateBulk{err: fmt.Errorf("calling to HexClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ActivityJobCreateBulk{err: fmt.Errorf("calling to ActivityJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*HexCreate, rv.Len())
+	builders := make([]*ActivityJobCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &HexCreateBulk{config: c.config, builders: builders}
+	return &ActivityJobCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Hex.
-func (c *HexClient) Update() *HexUpdate {
-	mutation := newHexMutation(c.config, OpUpdate)
-	return &HexUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ActivityJob.
+func (c *ActivityJobClient) Update() *ActivityJobUpdate {
+	mutation := newActivityJobMutation(c.config, OpUpdate)
+	return &ActivityJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *HexClient) UpdateOne(h *Hex) *HexUpdateOne {
-	mutation := newHexMutation(c.config, OpUpdateOne, withHex(h))
-	return &HexUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ActivityJobClient) UpdateOne(aj *ActivityJob) *ActivityJobUpdateOne {
+	mutation := newActivityJobMutation(c.config, OpUpdateOne, withActivityJob(aj))
+	return &ActivityJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *HexClient) UpdateOneID(id string) *HexUpdateOne {
-	mutation := newHexMutation(c.config, OpUpdateOne, withHexID(id))
-	return &HexUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ActivityJobClient) UpdateOneID(id uuid.UUID) *ActivityJobUpdateOne {
+	mutation := newActivityJobMutation(c.config, OpUpdateOne, withActivityJobID(id))
+	return &ActivityJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Hex.
-func (c *HexClient) Delete() *HexDelete {
-	mutation := newHexMutation(c.config, OpDelete)
-	return &HexDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ActivityJob.
+func (c *ActivityJobClient) Delete() *ActivityJobDelete {
+	mutation := newActivityJobMutation(c.config, OpDelete)
+	return &ActivityJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *HexClient) DeleteOne(h *Hex) *HexDeleteOne {
-	return c.DeleteOneID(h.ID)
+func (c *ActivityJobClient) DeleteOne(aj *ActivityJob) *ActivityJobDeleteOne {
+	return c.DeleteOneID(aj.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *HexClient) DeleteOneID(id string) *HexDeleteOne {
-	builder := c.Delete().Where(hex.ID(id))
+func (c *ActivityJobClient) DeleteOneID(id uuid.UUID) *ActivityJobDeleteOne {
+	builder := c.Delete().Where(activityjob.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &HexDeleteOne{builder}
+	return &ActivityJobDeleteOne{builder}
 }
 
-// Query returns a query builder for Hex.
-func (c *HexClient) Query() *HexQuery {
-	return &HexQuery{
+// Query returns a query builder for ActivityJob.
+func (c *ActivityJobClient) Query() *ActivityJobQuery {
+	return &ActivityJobQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeHex},
+		ctx:    &QueryContext{Type: TypeActivityJob},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Hex entity by its id.
-func (c *HexClient) Get(ctx context.Context, id string) (*Hex, error) {
-	return c.Query().Where(hex.ID(id)).Only(ctx)
+// Get returns a ActivityJob entity by its id.
+func (c *ActivityJobClient) Get(ctx context.Context, id uuid.UUID) (*ActivityJob, error) {
+	return c.Query().Where(activityjob.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *HexClient) GetX(ctx context.Context, id string) *Hex {
+func (c *ActivityJobClient) GetX(ctx context.Context, id uuid.UUID) *ActivityJob {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -669,164 +929,148 @@ func (c *HexClient) GetX(ctx context.Context, id string) *Hex {
 	return obj
 }
 
-// QueryHexinfluences queries the hexinfluences edge of a Hex.
-func (c *HexClient) QueryHexinfluences(h *Hex) *HexInfluenceQuery {
-	query := (&HexInfluenceClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := h.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(hex.Table, hex.FieldID, id),
-			sqlgraph.To(hexinfluence.Table, hexinfluence.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, hex.HexinfluencesTable, hex.HexinfluencesColumn),
-		)
-		fromV = sqlgraph.Neighbors(h.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryHexleaderboards queries the hexleaderboards edge of a Hex.
-func (c *HexClient) QueryHexleaderboards(h *Hex) *HexLeaderboardQuery {
-	query := (&HexLeaderboardClient{config: c.config}).Query()
+// QueryActivity queries the activity edge of a ActivityJob.
+func (c *ActivityJobClient) QueryActivity(aj *ActivityJob) *ActivityQuery {
+	query := (&ActivityClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := h.ID
+		id := aj.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(hex.Table, hex.FieldID, id),
-			sqlgraph.To(hexleaderboard.Table, hexleaderboard.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, hex.HexleaderboardsTable, hex.HexleaderboardsColumn),
+			sqlgraph.From(activityjob.Table, activityjob.FieldID, id),
+			sqlgraph.To(activity.Table, activity.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, activityjob.ActivityTable, activityjob.ActivityColumn),
 		)
-		fromV = sqlgraph.Neighbors(h.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(aj.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *HexClient) Hooks() []Hook {
-	return c.hooks.Hex
+func (c *ActivityJobClient) Hooks() []Hook {
+	return c.hooks.ActivityJob
 }
 
 // Interceptors returns the client interceptors.
-func (c *HexClient) Interceptors() []Interceptor {
-	return c.inters.Hex
+func (c *ActivityJobClient) Interceptors() []Interceptor {
+	return c.inters.ActivityJob
 }
 
-func (c *HexClient) mutate(ctx context.Context, m *HexMutation) (Value, error) {
+func (c *ActivityJobClient) mutate(ctx context.Context, m *ActivityJobMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&HexCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ActivityJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&HexUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ActivityJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&HexUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ActivityJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&HexDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ActivityJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Hex mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ActivityJob mutation op: %q", m.Op())
 	}
 }
 
-// HexInfluenceClient is a client for the HexInfluence schema.
-type HexInfluenceClient struct {
+// ActivitySessionClient is a client for the ActivitySession schema.
+type ActivitySessionClient struct {
 	config
 }
 
-// NewHexInfluenceClient returns a client for the HexInfluence from the given config.
-func NewHexInfluenceClient(c config) *HexInfluenceClient {
-	return &HexInfluenceClient{config: c}
+// NewActivitySessionClient returns a client for the ActivitySession from the given config.
+func NewActivitySessionClient(c config) *ActivitySessionClient {
+	return &ActivitySessionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `hexinfluence.Hooks(f(g(h())))`.
-func (c *HexInfluenceClient) Use(hooks ...Hook) {
-	c.hooks.HexInfluence = append(c.hooks.HexInfluence, hooks...)
+// A call to `Use(f, g, h)` equals to `activitysession.Hooks(f(g(h())))`.
+func (c *ActivitySessionClient) Use(hooks ...Hook) {
+	c.hooks.ActivitySession = append(c.hooks.ActivitySession, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `hexinfluence.Intercept(f(g(h())))`.
-func (c *HexInfluenceClient) Intercept(interceptors ...Interceptor) {
-	c.inters.HexInfluence = append(c.inters.HexInfluence, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `activitysession.Intercept(f(g(h())))`.
+func (c *ActivitySessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ActivitySession = append(c.inters.ActivitySession, interceptors...)
 }
 
-// Create returns a builder for creating a HexInfluence entity.
-func (c *HexInfluenceClient) Create() *HexInfluenceCreate {
-	mutation := newHexInfluenceMutation(c.config, OpCreate)
-	return &HexInfluenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ActivitySession entity.
+func (c *ActivitySessionClient) Create() *ActivitySessionCreate {
+	mutation := newActivitySessionMutation(c.config, OpCreate)
+	return &ActivitySessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of HexInfluence entities.
-func (c *HexInfluenceClient) CreateBulk(builders ...*HexInfluenceCreate) *HexInfluenceCreateBulk {
-	return &HexInfluenceCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ActivitySession entities.
+func (c *ActivitySessionClient) CreateBulk(builders ...*ActivitySessionCreate) *ActivitySessionCreateBulk {
+	return &ActivitySessionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *HexInfluenceClient) MapCreateBulk(slice any, setFunc func(*HexInfluenceCreate, int)) *HexInfluenceCreateBulk {
+func (c *ActivitySessionClient) MapCreateBulk(slice any, setFunc func(*ActivitySessionCreate, int)) *ActivitySessionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &HexInfluenceCreateBulk{err: fmt.Errorf("calling to HexInfluenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ActivitySessionCreateBulk{err: fmt.Errorf("calling to ActivitySessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*HexInfluenceCreate, rv.Len())
+	builders := make([]*ActivitySessionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &HexInfluenceCreateBulk{config: c.config, builders: builders}
+	return &ActivitySessionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for HexInfluence.
-func (c *HexInfluenceClient) Update() *HexInfluenceUpdate {
-	mutation := newHexInfluenceMutation(c.config, OpUpdate)
-	return &HexInfluenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ActivitySession.
+func (c *ActivitySessionClient) Update() *ActivitySessionUpdate {
+	mutation := newActivitySessionMutation(c.config, OpUpdate)
+	return &ActivitySessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *HexInfluenceClient) UpdateOne(hi *HexInfluence) *HexInfluenceUpdateOne {
-	mutation := newHexInfluenceMutation(c.config, OpUpdateOne, withHexInfluence(hi))
-	return &HexInfluenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ActivitySessionClient) UpdateOne(as *ActivitySession) *ActivitySessionUpdateOne {
+	mutation := newActivitySessionMutation(c.config, OpUpdateOne, withActivitySession(as))
+	return &ActivitySessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *HexInfluenceClient) UpdateOneID(id uuid.UUID) *HexInfluenceUpdateOne {
-	mutation := newHexInfluenceMutation(c.config, OpUpdateOne, withHexInfluenceID(id))
-	return &HexInfluenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ActivitySessionClient) UpdateOneID(id uuid.UUID) *ActivitySessionUpdateOne {
+	mutation := newActivitySessionMutation(c.config, OpUpdateOne, withActivitySessionID(id))
+	return &ActivitySessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for HexInfluence.
-func (c *HexInfluenceClient) Delete() *HexInfluenceDelete {
-	mutation := newHexInfluenceMutation(c.config, OpDelete)
-	return &HexInfluenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ActivitySession.
+func (c *ActivitySessionClient) Delete() *ActivitySessionDelete {
+	mutation := newActivitySessionMutation(c.config, OpDelete)
+	return &ActivitySessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *HexInfluenceClient) DeleteOne(hi *HexInfluence) *HexInfluenceDeleteOne {
-	return c.DeleteOneID(hi.ID)
+func (c *ActivitySessionClient) DeleteOne(as *ActivitySession) *ActivitySessionDeleteOne {
+	return c.DeleteOneID(as.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *HexInfluenceClient) DeleteOneID(id uuid.UUID) *HexInfluenceDeleteOne {
-	builder := c.Delete().Where(hexinfluence.ID(id))
+func (c *ActivitySessionClient) DeleteOneID(id uuid.UUID) *ActivitySessionDeleteOne {
+	builder := c.Delete().Where(activitysession.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &HexInfluenceDeleteOne{builder}
+	return &ActivitySessionDeleteOne{builder}
 }
 
-// Query returns a query builder for HexInfluence.
-func (c *HexInfluenceClient) Query() *HexInfluenceQuery {
-	return &HexInfluenceQuery{
+// Query returns a query builder for ActivitySession.
+func (c *ActivitySessionClient) Query() *ActivitySessionQuery {
+	return &ActivitySessionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeHexInfluence},
+		ctx:    &QueryContext{Type: TypeActivitySession},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a HexInfluence entity by its id.
-func (c *HexInfluenceClient) Get(ctx context.Context, id uuid.UUID) (*HexInfluence, error) {
-	return c.Query().Where(hexinfluence.ID(id)).Only(ctx)
+// Get returns a ActivitySession entity by its id.
+func (c *ActivitySessionClient) Get(ctx context.Context, id uuid.UUID) (*ActivitySession, error) {
+	return c.Query().Where(activitysession.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *HexInfluenceClient) GetX(ctx context.Context, id uuid.UUID) *HexInfluence {
+func (c *ActivitySessionClient) GetX(ctx context.Context, id uuid.UUID) *ActivitySession {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -834,164 +1078,3681 @@ func (c *HexInfluenceClient) GetX(ctx context.Context, id uuid.UUID) *HexInfluen
 	return obj
 }
 
-// QueryHex queries the hex edge of a HexInfluence.
-func (c *HexInfluenceClient) QueryHex(hi *HexInfluence) *HexQuery {
-	query := (&HexClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+// Hooks returns the client hooks.
+func (c *ActivitySessionClient) Hooks() []Hook {
+	return c.hooks.ActivitySession
+}
+
+// Interceptors returns the client interceptors.
+func (c *ActivitySessionClient) Interceptors() []Interceptor {
+	return c.inters.ActivitySession
+}
+
+func (c *ActivitySessionClient) mutate(ctx context.Context, m *ActivitySessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ActivitySessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ActivitySessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ActivitySessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ActivitySessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ActivitySession mutation op: %q", m.Op())
+	}
+}
+
+// DecaySweepClient is a client for the DecaySweep schema.
+type DecaySweepClient struct {
+	config
+}
+
+// NewDecaySweepClient returns a client for the DecaySweep from the given config.
+func NewDecaySweepClient(c config) *DecaySweepClient {
+	return &DecaySweepClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `decaysweep.Hooks(f(g(h())))`.
+func (c *DecaySweepClient) Use(hooks ...Hook) {
+	c.hooks.DecaySweep = append(c.hooks.DecaySweep, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `decaysweep.Intercept(f(g(h())))`.
+func (c *DecaySweepClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DecaySweep = append(c.inters.DecaySweep, interceptors...)
+}
+
+// Create returns a builder for creating a DecaySweep entity.
+func (c *DecaySweepClient) Create() *DecaySweepCreate {
+	mutation := newDecaySweepMutation(c.config, OpCreate)
+	return &DecaySweepCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DecaySweep entities.
+func (c *DecaySweepClient) CreateBulk(builders ...*DecaySweepCreate) *DecaySweepCreateBulk {
+	return &DecaySweepCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DecaySweepClient) MapCreateBulk(slice any, setFunc func(*DecaySweepCreate, int)) *DecaySweepCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DecaySweepCreateBulk{err: fmt.Errorf("calling to DecaySweepClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DecaySweepCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DecaySweepCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DecaySweep.
+func (c *DecaySweepClient) Update() *DecaySweepUpdate {
+	mutation := newDecaySweepMutation(c.config, OpUpdate)
+	return &DecaySweepUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DecaySweepClient) UpdateOne(ds *DecaySweep) *DecaySweepUpdateOne {
+	mutation := newDecaySweepMutation(c.config, OpUpdateOne, withDecaySweep(ds))
+	return &DecaySweepUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DecaySweepClient) UpdateOneID(id uuid.UUID) *DecaySweepUpdateOne {
+	mutation := newDecaySweepMutation(c.config, OpUpdateOne, withDecaySweepID(id))
+	return &DecaySweepUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DecaySweep.
+func (c *DecaySweepClient) Delete() *DecaySweepDelete {
+	mutation := newDecaySweepMutation(c.config, OpDelete)
+	return &DecaySweepDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DecaySweepClient) DeleteOne(ds *DecaySweep) *DecaySweepDeleteOne {
+	return c.DeleteOneID(ds.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DecaySweepClient) DeleteOneID(id uuid.UUID) *DecaySweepDeleteOne {
+	builder := c.Delete().Where(decaysweep.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DecaySweepDeleteOne{builder}
+}
+
+// Query returns a query builder for DecaySweep.
+func (c *DecaySweepClient) Query() *DecaySweepQuery {
+	return &DecaySweepQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDecaySweep},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DecaySweep entity by its id.
+func (c *DecaySweepClient) Get(ctx context.Context, id uuid.UUID) (*DecaySweep, error) {
+	return c.Query().Where(decaysweep.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DecaySweepClient) GetX(ctx context.Context, id uuid.UUID) *DecaySweep {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DecaySweepClient) Hooks() []Hook {
+	return c.hooks.DecaySweep
+}
+
+// Interceptors returns the client interceptors.
+func (c *DecaySweepClient) Interceptors() []Interceptor {
+	return c.inters.DecaySweep
+}
+
+func (c *DecaySweepClient) mutate(ctx context.Context, m *DecaySweepMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DecaySweepCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DecaySweepUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DecaySweepUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DecaySweepDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DecaySweep mutation op: %q", m.Op())
+	}
+}
+
+// FriendshipClient is a client for the Friendship schema.
+type FriendshipClient struct {
+	config
+}
+
+// NewFriendshipClient returns a client for the Friendship from the given config.
+func NewFriendshipClient(c config) *FriendshipClient {
+	return &FriendshipClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `friendship.Hooks(f(g(h())))`.
+func (c *FriendshipClient) Use(hooks ...Hook) {
+	c.hooks.Friendship = append(c.hooks.Friendship, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `friendship.Intercept(f(g(h())))`.
+func (c *FriendshipClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Friendship = append(c.inters.Friendship, interceptors...)
+}
+
+// Create returns a builder for creating a Friendship entity.
+func (c *FriendshipClient) Create() *FriendshipCreate {
+	mutation := newFriendshipMutation(c.config, OpCreate)
+	return &FriendshipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Friendship entities.
+func (c *FriendshipClient) CreateBulk(builders ...*FriendshipCreate) *FriendshipCreateBulk {
+	return &FriendshipCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FriendshipClient) MapCreateBulk(slice any, setFunc func(*FriendshipCreate, int)) *FriendshipCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FriendshipCreateBulk{err: fmt.Errorf("calling to FriendshipClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FriendshipCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FriendshipCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Friendship.
+func (c *FriendshipClient) Update() *FriendshipUpdate {
+	mutation := newFriendshipMutation(c.config, OpUpdate)
+	return &FriendshipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FriendshipClient) UpdateOne(f *Friendship) *FriendshipUpdateOne {
+	mutation := newFriendshipMutation(c.config, OpUpdateOne, withFriendship(f))
+	return &FriendshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FriendshipClient) UpdateOneID(id int) *FriendshipUpdateOne {
+	mutation := newFriendshipMutation(c.config, OpUpdateOne, withFriendshipID(id))
+	return &FriendshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Friendship.
+func (c *FriendshipClient) Delete() *FriendshipDelete {
+	mutation := newFriendshipMutation(c.config, OpDelete)
+	return &FriendshipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FriendshipClient) DeleteOne(f *Friendship) *FriendshipDeleteOne {
+	return c.DeleteOneID(f.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FriendshipClient) DeleteOneID(id int) *FriendshipDeleteOne {
+	builder := c.Delete().Where(friendship.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FriendshipDeleteOne{builder}
+}
+
+// Query returns a query builder for Friendship.
+func (c *FriendshipClient) Query() *FriendshipQuery {
+	return &FriendshipQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFriendship},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Friendship entity by its id.
+func (c *FriendshipClient) Get(ctx context.Context, id int) (*Friendship, error) {
+	return c.Query().Where(friendship.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FriendshipClient) GetX(ctx context.Context, id int) *Friendship {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUsers queries the users edge of a Friendship.
+func (c *FriendshipClient) QueryUsers(f *Friendship) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(friendship.Table, friendship.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, friendship.UsersTable, friendship.UsersColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFriends queries the friends edge of a Friendship.
+func (c *FriendshipClient) QueryFriends(f *Friendship) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(friendship.Table, friendship.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, friendship.FriendsTable, friendship.FriendsColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FriendshipClient) Hooks() []Hook {
+	return c.hooks.Friendship
+}
+
+// Interceptors returns the client interceptors.
+func (c *FriendshipClient) Interceptors() []Interceptor {
+	return c.inters.Friendship
+}
+
+func (c *FriendshipClient) mutate(ctx context.Context, m *FriendshipMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FriendshipCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FriendshipUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FriendshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FriendshipDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Friendship mutation op: %q", m.Op())
+	}
+}
+
+// GoalClient is a client for the Goal schema.
+type GoalClient struct {
+	config
+}
+
+// NewGoalClient returns a client for the Goal from the given config.
+func NewGoalClient(c config) *GoalClient {
+	return &GoalClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `goal.Hooks(f(g(h())))`.
+func (c *GoalClient) Use(hooks ...Hook) {
+	c.hooks.Goal = append(c.hooks.Goal, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `goal.Intercept(f(g(h())))`.
+func (c *GoalClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Goal = append(c.inters.Goal, interceptors...)
+}
+
+// Create returns a builder for creating a Goal entity.
+func (c *GoalClient) Create() *GoalCreate {
+	mutation := newGoalMutation(c.config, OpCreate)
+	return &GoalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Goal entities.
+func (c *GoalClient) CreateBulk(builders ...*GoalCreate) *GoalCreateBulk {
+	return &GoalCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GoalClient) MapCreateBulk(slice any, setFunc func(*GoalCreate, int)) *GoalCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GoalCreateBulk{err: fmt.Errorf("calling to GoalClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GoalCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GoalCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Goal.
+func (c *GoalClient) Update() *GoalUpdate {
+	mutation := newGoalMutation(c.config, OpUpdate)
+	return &GoalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GoalClient) UpdateOne(_go *Goal) *GoalUpdateOne {
+	mutation := newGoalMutation(c.config, OpUpdateOne, withGoal(_go))
+	return &GoalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GoalClient) UpdateOneID(id uuid.UUID) *GoalUpdateOne {
+	mutation := newGoalMutation(c.config, OpUpdateOne, withGoalID(id))
+	return &GoalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Goal.
+func (c *GoalClient) Delete() *GoalDelete {
+	mutation := newGoalMutation(c.config, OpDelete)
+	return &GoalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GoalClient) DeleteOne(_go *Goal) *GoalDeleteOne {
+	return c.DeleteOneID(_go.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GoalClient) DeleteOneID(id uuid.UUID) *GoalDeleteOne {
+	builder := c.Delete().Where(goal.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GoalDeleteOne{builder}
+}
+
+// Query returns a query builder for Goal.
+func (c *GoalClient) Query() *GoalQuery {
+	return &GoalQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGoal},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Goal entity by its id.
+func (c *GoalClient) Get(ctx context.Context, id uuid.UUID) (*Goal, error) {
+	return c.Query().Where(goal.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GoalClient) GetX(ctx context.Context, id uuid.UUID) *Goal {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *GoalClient) Hooks() []Hook {
+	return c.hooks.Goal
+}
+
+// Interceptors returns the client interceptors.
+func (c *GoalClient) Interceptors() []Interceptor {
+	return c.inters.Goal
+}
+
+func (c *GoalClient) mutate(ctx context.Context, m *GoalMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GoalCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GoalUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GoalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GoalDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Goal mutation op: %q", m.Op())
+	}
+}
+
+// HexClient is a client for the Hex schema.
+type HexClient struct {
+	config
+}
+
+// NewHexClient returns a client for the Hex from the given config.
+func NewHexClient(c config) *HexClient {
+	return &HexClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `hex.Hooks(f(g(h())))`.
+func (c *HexClient) Use(hooks ...Hook) {
+	c.hooks.Hex = append(c.hooks.Hex, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `hex.Intercept(f(g(h())))`.
+func (c *HexClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Hex = append(c.inters.Hex, interceptors...)
+}
+
+// Create returns a builder for creating a Hex entity.
+func (c *HexClient) Create() *HexCreate {
+	mutation := newHexMutation(c.config, OpCreate)
+	return &HexCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Hex entities.
+func (c *HexClient) CreateBulk(builders ...*HexCreate) *HexCreateBulk {
+	return &HexCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HexClient) MapCreateBulk(slice any, setFunc func(*HexCreate, int)) *HexCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HexCreateBulk{err: fmt.Errorf("calling to HexClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HexCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HexCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Hex.
+func (c *HexClient) Update() *HexUpdate {
+	mutation := newHexMutation(c.config, OpUpdate)
+	return &HexUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HexClient) UpdateOne(h *Hex) *HexUpdateOne {
+	mutation := newHexMutation(c.config, OpUpdateOne, withHex(h))
+	return &HexUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HexClient) UpdateOneID(id string) *HexUpdateOne {
+	mutation := newHexMutation(c.config, OpUpdateOne, withHexID(id))
+	return &HexUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Hex.
+func (c *HexClient) Delete() *HexDelete {
+	mutation := newHexMutation(c.config, OpDelete)
+	return &HexDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HexClient) DeleteOne(h *Hex) *HexDeleteOne {
+	return c.DeleteOneID(h.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HexClient) DeleteOneID(id string) *HexDeleteOne {
+	builder := c.Delete().Where(hex.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HexDeleteOne{builder}
+}
+
+// Query returns a query builder for Hex.
+func (c *HexClient) Query() *HexQuery {
+	return &HexQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHex},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Hex entity by its id.
+func (c *HexClient) Get(ctx context.Context, id string) (*Hex, error) {
+	return c.Query().Where(hex.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HexClient) GetX(ctx context.Context, id string) *Hex {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryHexinfluences queries the hexinfluences edge of a Hex.
+func (c *HexClient) QueryHexinfluences(h *Hex) *HexInfluenceQuery {
+	query := (&HexInfluenceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := h.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hex.Table, hex.FieldID, id),
+			sqlgraph.To(hexinfluence.Table, hexinfluence.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, hex.HexinfluencesTable, hex.HexinfluencesColumn),
+		)
+		fromV = sqlgraph.Neighbors(h.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHexleaderboards queries the hexleaderboards edge of a Hex.
+func (c *HexClient) QueryHexleaderboards(h *Hex) *HexLeaderboardQuery {
+	query := (&HexLeaderboardClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := h.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hex.Table, hex.FieldID, id),
+			sqlgraph.To(hexleaderboard.Table, hexleaderboard.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, hex.HexleaderboardsTable, hex.HexleaderboardsColumn),
+		)
+		fromV = sqlgraph.Neighbors(h.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *HexClient) Hooks() []Hook {
+	return c.hooks.Hex
+}
+
+// Interceptors returns the client interceptors.
+func (c *HexClient) Interceptors() []Interceptor {
+	return c.inters.Hex
+}
+
+func (c *HexClient) mutate(ctx context.Context, m *HexMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HexCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HexUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HexUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HexDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Hex mutation op: %q", m.Op())
+	}
+}
+
+// HexCaptureClient is a client for the HexCapture schema.
+type HexCaptureClient struct {
+	config
+}
+
+// NewHexCaptureClient returns a client for the HexCapture from the given config.
+func NewHexCaptureClient(c config) *HexCaptureClient {
+	return &HexCaptureClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `hexcapture.Hooks(f(g(h())))`.
+func (c *HexCaptureClient) Use(hooks ...Hook) {
+	c.hooks.HexCapture = append(c.hooks.HexCapture, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `hexcapture.Intercept(f(g(h())))`.
+func (c *HexCaptureClient) Intercept(interceptors ...Interceptor) {
+	c.inters.HexCapture = append(c.inters.HexCapture, interceptors...)
+}
+
+// Create returns a builder for creating a HexCapture entity.
+func (c *HexCaptureClient) Create() *HexCaptureCreate {
+	mutation := newHexCaptureMutation(c.config, OpCreate)
+	return &HexCaptureCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of HexCapture entities.
+func (c *HexCaptureClient) CreateBulk(builders ...*HexCaptureCreate) *HexCaptureCreateBulk {
+	return &HexCaptureCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HexCaptureClient) MapCreateBulk(slice any, setFunc func(*HexCaptureCreate, int)) *HexCaptureCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HexCaptureCreateBulk{err: fmt.Errorf("calling to HexCaptureClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HexCaptureCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HexCaptureCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for HexCapture.
+func (c *HexCaptureClient) Update() *HexCaptureUpdate {
+	mutation := newHexCaptureMutation(c.config, OpUpdate)
+	return &HexCaptureUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HexCaptureClient) UpdateOne(hc *HexCapture) *HexCaptureUpdateOne {
+	mutation := newHexCaptureMutation(c.config, OpUpdateOne, withHexCapture(hc))
+	return &HexCaptureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HexCaptureClient) UpdateOneID(id uuid.UUID) *HexCaptureUpdateOne {
+	mutation := newHexCaptureMutation(c.config, OpUpdateOne, withHexCaptureID(id))
+	return &HexCaptureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for HexCapture.
+func (c *HexCaptureClient) Delete() *HexCaptureDelete {
+	mutation := newHexCaptureMutation(c.config, OpDelete)
+	return &HexCaptureDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HexCaptureClient) DeleteOne(hc *HexCapture) *HexCaptureDeleteOne {
+	return c.DeleteOneID(hc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HexCaptureClient) DeleteOneID(id uuid.UUID) *HexCaptureDeleteOne {
+	builder := c.Delete().Where(hexcapture.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HexCaptureDeleteOne{builder}
+}
+
+// Query returns a query builder for HexCapture.
+func (c *HexCaptureClient) Query() *HexCaptureQuery {
+	return &HexCaptureQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHexCapture},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a HexCapture entity by its id.
+func (c *HexCaptureClient) Get(ctx context.Context, id uuid.UUID) (*HexCapture, error) {
+	return c.Query().Where(hexcapture.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HexCaptureClient) GetX(ctx context.Context, id uuid.UUID) *HexCapture {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *HexCaptureClient) Hooks() []Hook {
+	return c.hooks.HexCapture
+}
+
+// Interceptors returns the client interceptors.
+func (c *HexCaptureClient) Interceptors() []Interceptor {
+	return c.inters.HexCapture
+}
+
+func (c *HexCaptureClient) mutate(ctx context.Context, m *HexCaptureMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HexCaptureCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HexCaptureUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HexCaptureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HexCaptureDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown HexCapture mutation op: %q", m.Op())
+	}
+}
+
+// HexInfluenceClient is a client for the HexInfluence schema.
+type HexInfluenceClient struct {
+	config
+}
+
+// NewHexInfluenceClient returns a client for the HexInfluence from the given config.
+func NewHexInfluenceClient(c config) *HexInfluenceClient {
+	return &HexInfluenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `hexinfluence.Hooks(f(g(h())))`.
+func (c *HexInfluenceClient) Use(hooks ...Hook) {
+	c.hooks.HexInfluence = append(c.hooks.HexInfluence, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `hexinfluence.Intercept(f(g(h())))`.
+func (c *HexInfluenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.HexInfluence = append(c.inters.HexInfluence, interceptors...)
+}
+
+// Create returns a builder for creating a HexInfluence entity.
+func (c *HexInfluenceClient) Create() *HexInfluenceCreate {
+	mutation := newHexInfluenceMutation(c.config, OpCreate)
+	return &HexInfluenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of HexInfluence entities.
+func (c *HexInfluenceClient) CreateBulk(builders ...*HexInfluenceCreate) *HexInfluenceCreateBulk {
+	return &HexInfluenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HexInfluenceClient) MapCreateBulk(slice any, setFunc func(*HexInfluenceCreate, int)) *HexInfluenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HexInfluenceCreateBulk{err: fmt.Errorf("calling to HexInfluenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HexInfluenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HexInfluenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for HexInfluence.
+func (c *HexInfluenceClient) Update() *HexInfluenceUpdate {
+	mutation := newHexInfluenceMutation(c.config, OpUpdate)
+	return &HexInfluenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HexInfluenceClient) UpdateOne(hi *HexInfluence) *HexInfluenceUpdateOne {
+	mutation := newHexInfluenceMutation(c.config, OpUpdateOne, withHexInfluence(hi))
+	return &HexInfluenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HexInfluenceClient) UpdateOneID(id uuid.UUID) *HexInfluenceUpdateOne {
+	mutation := newHexInfluenceMutation(c.config, OpUpdateOne, withHexInfluenceID(id))
+	return &HexInfluenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for HexInfluence.
+func (c *HexInfluenceClient) Delete() *HexInfluenceDelete {
+	mutation := newHexInfluenceMutation(c.config, OpDelete)
+	return &HexInfluenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HexInfluenceClient) DeleteOne(hi *HexInfluence) *HexInfluenceDeleteOne {
+	return c.DeleteOneID(hi.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HexInfluenceClient) DeleteOneID(id uuid.UUID) *HexInfluenceDeleteOne {
+	builder := c.Delete().Where(hexinfluence.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HexInfluenceDeleteOne{builder}
+}
+
+// Query returns a query builder for HexInfluence.
+func (c *HexInfluenceClient) Query() *HexInfluenceQuery {
+	return &HexInfluenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHexInfluence},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a HexInfluence entity by its id.
+func (c *HexInfluenceClient) Get(ctx context.Context, id uuid.UUID) (*HexInfluence, error) {
+	return c.Query().Where(hexinfluence.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HexInfluenceClient) GetX(ctx context.Context, id uuid.UUID) *HexInfluence {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryHex queries the hex edge of a HexInfluence.
+func (c *HexInfluenceClient) QueryHex(hi *HexInfluence) *HexQuery {
+	query := (&HexClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := hi.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hexinfluence.Table, hexinfluence.FieldID, id),
+			sqlgraph.To(hex.Table, hex.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hexinfluence.HexTable, hexinfluence.HexColumn),
+		)
+		fromV = sqlgraph.Neighbors(hi.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUsers queries the users edge of a HexInfluence.
+func (c *HexInfluenceClient) QueryUsers(hi *HexInfluence) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := hi.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(hexinfluence.Table, hexinfluence.FieldID, id),
-			sqlgraph.To(hex.Table, hex.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, hexinfluence.HexTable, hexinfluence.HexColumn),
+			sqlgraph.From(hexinfluence.Table, hexinfluence.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hexinfluence.UsersTable, hexinfluence.UsersColumn),
+		)
+		fromV = sqlgraph.Neighbors(hi.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *HexInfluenceClient) Hooks() []Hook {
+	return c.hooks.HexInfluence
+}
+
+// Interceptors returns the client interceptors.
+func (c *HexInfluenceClient) Interceptors() []Interceptor {
+	return c.inters.HexInfluence
+}
+
+func (c *HexInfluenceClient) mutate(ctx context.Context, m *HexInfluenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HexInfluenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HexInfluenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HexInfluenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HexInfluenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown HexInfluence mutation op: %q", m.Op())
+	}
+}
+
+// HexLeaderboardClient is a client for the HexLeaderboard schema.
+type HexLeaderboardClient struct {
+	config
+}
+
+// NewHexLeaderboardClient returns a client for the HexLeaderboard from the given config.
+func NewHexLeaderboardClient(c config) *HexLeaderboardClient {
+	return &HexLeaderboardClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `hexleaderboard.Hooks(f(g(h())))`.
+func (c *HexLeaderboardClient) Use(hooks ...Hook) {
+	c.hooks.HexLeaderboard = append(c.hooks.HexLeaderboard, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `hexleaderboard.Intercept(f(g(h())))`.
+func (c *HexLeaderboardClient) Intercept(interceptors ...Interceptor) {
+	c.inters.HexLeaderboard = append(c.inters.HexLeaderboard, interceptors...)
+}
+
+// Create returns a builder for creating a HexLeaderboard entity.
+func (c *HexLeaderboardClient) Create() *HexLeaderboardCreate {
+	mutation := newHexLeaderboardMutation(c.config, OpCreate)
+	return &HexLeaderboardCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of HexLeaderboard entities.
+func (c *HexLeaderboardClient) CreateBulk(builders ...*HexLeaderboardCreate) *HexLeaderboardCreateBulk {
+	return &HexLeaderboardCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HexLeaderboardClient) MapCreateBulk(slice any, setFunc func(*HexLeaderboardCreate, int)) *HexLeaderboardCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HexLeaderboardCreateBulk{err: fmt.Errorf("calling to HexLeaderboardClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HexLeaderboardCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HexLeaderboardCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for HexLeaderboard.
+func (c *HexLeaderboardClient) Update() *HexLeaderboardUpdate {
+	mutation := newHexLeaderboardMutation(c.config, OpUpdate)
+	return &HexLeaderboardUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HexLeaderboardClient) UpdateOne(hl *HexLeaderboard) *HexLeaderboardUpdateOne {
+	mutation := newHexLeaderboardMutation(c.config, OpUpdateOne, withHexLeaderboard(hl))
+	return &HexLeaderboardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HexLeaderboardClient) UpdateOneID(id uuid.UUID) *HexLeaderboardUpdateOne {
+	mutation := newHexLeaderboardMutation(c.config, OpUpdateOne, withHexLeaderboardID(id))
+	return &HexLeaderboardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for HexLeaderboard.
+func (c *HexLeaderboardClient) Delete() *HexLeaderboardDelete {
+	mutation := newHexLeaderboardMutation(c.config, OpDelete)
+	return &HexLeaderboardDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HexLeaderboardClient) DeleteOne(hl *HexLeaderboard) *HexLeaderboardDeleteOne {
+	return c.DeleteOneID(hl.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HexLeaderboardClient) DeleteOneID(id uuid.UUID) *HexLeaderboardDeleteOne {
+	builder := c.Delete().Where(hexleaderboard.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HexLeaderboardDeleteOne{builder}
+}
+
+// Query returns a query builder for HexLeaderboard.
+func (c *HexLeaderboardClient) Query() *HexLeaderboardQuery {
+	return &HexLeaderboardQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHexLeaderboard},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a HexLeaderboard entity by its id.
+func (c *HexLeaderboardClient) Get(ctx context.Context, id uuid.UUID) (*HexLeaderboard, error) {
+	return c.Query().Where(hexleaderboard.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HexLeaderboardClient) GetX(ctx context.Context, id uuid.UUID) *HexLeaderboard {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryHex queries the hex edge of a HexLeaderboard.
+func (c *HexLeaderboardClient) QueryHex(hl *HexLeaderboard) *HexQuery {
+	query := (&HexClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := hl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hexleaderboard.Table, hexleaderboard.FieldID, id),
+			sqlgraph.To(hex.Table, hex.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hexleaderboard.HexTable, hexleaderboard.HexColumn),
+		)
+		fromV = sqlgraph.Neighbors(hl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *HexLeaderboardClient) Hooks() []Hook {
+	return c.hooks.HexLeaderboard
+}
+
+// Interceptors returns the client interceptors.
+func (c *HexLeaderboardClient) Interceptors() []Interceptor {
+	return c.inters.HexLeaderboard
+}
+
+func (c *HexLeaderboardClient) mutate(ctx context.Context, m *HexLeaderboardMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HexLeaderboardCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HexLeaderboardUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HexLeaderboardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HexLeaderboardDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown HexLeaderboard mutation op: %q", m.Op())
+	}
+}
+
+// HexRollupClient is a client for the HexRollup schema.
+type HexRollupClient struct {
+	config
+}
+
+// NewHexRollupClient returns a client for the HexRollup from the given config.
+func NewHexRollupClient(c config) *HexRollupClient {
+	return &HexRollupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `hexrollup.Hooks(f(g(h())))`.
+func (c *HexRollupClient) Use(hooks ...Hook) {
+	c.hooks.HexRollup = append(c.hooks.HexRollup, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `hexrollup.Intercept(f(g(h())))`.
+func (c *HexRollupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.HexRollup = append(c.inters.HexRollup, interceptors...)
+}
+
+// Create returns a builder for creating a HexRollup entity.
+func (c *HexRollupClient) Create() *HexRollupCreate {
+	mutation := newHexRollupMutation(c.config, OpCreate)
+	return &HexRollupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of HexRollup entities.
+func (c *HexRollupClient) CreateBulk(builders ...*HexRollupCreate) *HexRollupCreateBulk {
+	return &HexRollupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HexRollupClient) MapCreateBulk(slice any, setFunc func(*HexRollupCreate, int)) *HexRollupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HexRollupCreateBulk{err: fmt.Errorf("calling to HexRollupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HexRollupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HexRollupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for HexRollup.
+func (c *HexRollupClient) Update() *HexRollupUpdate {
+	mutation := newHexRollupMutation(c.config, OpUpdate)
+	return &HexRollupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HexRollupClient) UpdateOne(hr *HexRollup) *HexRollupUpdateOne {
+	mutation := newHexRollupMutation(c.config, OpUpdateOne, withHexRollup(hr))
+	return &HexRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HexRollupClient) UpdateOneID(id uuid.UUID) *HexRollupUpdateOne {
+	mutation := newHexRollupMutation(c.config, OpUpdateOne, withHexRollupID(id))
+	return &HexRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for HexRollup.
+func (c *HexRollupClient) Delete() *HexRollupDelete {
+	mutation := newHexRollupMutation(c.config, OpDelete)
+	return &HexRollupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HexRollupClient) DeleteOne(hr *HexRollup) *HexRollupDeleteOne {
+	return c.DeleteOneID(hr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HexRollupClient) DeleteOneID(id uuid.UUID) *HexRollupDeleteOne {
+	builder := c.Delete().Where(hexrollup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HexRollupDeleteOne{builder}
+}
+
+// Query returns a query builder for HexRollup.
+func (c *HexRollupClient) Query() *HexRollupQuery {
+	return &HexRollupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHexRollup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a HexRollup entity by its id.
+func (c *HexRollupClient) Get(ctx context.Context, id uuid.UUID) (*HexRollup, error) {
+	return c.Query().Where(hexrollup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HexRollupClient) GetX(ctx context.Context, id uuid.UUID) *HexRollup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *HexRollupClient) Hooks() []Hook {
+	return c.hooks.HexRollup
+}
+
+// Interceptors returns the client interceptors.
+func (c *HexRollupClient) Interceptors() []Interceptor {
+	return c.inters.HexRollup
+}
+
+func (c *HexRollupClient) mutate(ctx context.Context, m *HexRollupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HexRollupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HexRollupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HexRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HexRollupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown HexRollup mutation op: %q", m.Op())
+	}
+}
+
+// IdempotencyKeyClient is a client for the IdempotencyKey schema.
+type IdempotencyKeyClient struct {
+	config
+}
+
+// NewIdempotencyKeyClient returns a client for the IdempotencyKey from the given config.
+func NewIdempotencyKeyClient(c config) *IdempotencyKeyClient {
+	return &IdempotencyKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `idempotencykey.Hooks(f(g(h())))`.
+func (c *IdempotencyKeyClient) Use(hooks ...Hook) {
+	c.hooks.IdempotencyKey = append(c.hooks.IdempotencyKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `idempotencykey.Intercept(f(g(h())))`.
+func (c *IdempotencyKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IdempotencyKey = append(c.inters.IdempotencyKey, interceptors...)
+}
+
+// Create returns a builder for creating a IdempotencyKey entity.
+func (c *IdempotencyKeyClient) Create() *IdempotencyKeyCreate {
+	mutation := newIdempotencyKeyMutation(c.config, OpCreate)
+	return &IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IdempotencyKey entities.
+func (c *IdempotencyKeyClient) CreateBulk(builders ...*IdempotencyKeyCreate) *IdempotencyKeyCreateBulk {
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IdempotencyKeyClient) MapCreateBulk(slice any, setFunc func(*IdempotencyKeyCreate, int)) *IdempotencyKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IdempotencyKeyCreateBulk{err: fmt.Errorf("calling to IdempotencyKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IdempotencyKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Update() *IdempotencyKeyUpdate {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdate)
+	return &IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdempotencyKeyClient) UpdateOne(ik *IdempotencyKey) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKey(ik))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IdempotencyKeyClient) UpdateOneID(id uuid.UUID) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKeyID(id))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Delete() *IdempotencyKeyDelete {
+	mutation := newIdempotencyKeyMutation(c.config, OpDelete)
+	return &IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IdempotencyKeyClient) DeleteOne(ik *IdempotencyKey) *IdempotencyKeyDeleteOne {
+	return c.DeleteOneID(ik.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IdempotencyKeyClient) DeleteOneID(id uuid.UUID) *IdempotencyKeyDeleteOne {
+	builder := c.Delete().Where(idempotencykey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IdempotencyKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Query() *IdempotencyKeyQuery {
+	return &IdempotencyKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIdempotencyKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IdempotencyKey entity by its id.
+func (c *IdempotencyKeyClient) Get(ctx context.Context, id uuid.UUID) (*IdempotencyKey, error) {
+	return c.Query().Where(idempotencykey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IdempotencyKeyClient) GetX(ctx context.Context, id uuid.UUID) *IdempotencyKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *IdempotencyKeyClient) Hooks() []Hook {
+	return c.hooks.IdempotencyKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *IdempotencyKeyClient) Interceptors() []Interceptor {
+	return c.inters.IdempotencyKey
+}
+
+func (c *IdempotencyKeyClient) mutate(ctx context.Context, m *IdempotencyKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IdempotencyKey mutation op: %q", m.Op())
+	}
+}
+
+// InfluenceHistoryClient is a client for the InfluenceHistory schema.
+type InfluenceHistoryClient struct {
+	config
+}
+
+// NewInfluenceHistoryClient returns a client for the InfluenceHistory from the given config.
+func NewInfluenceHistoryClient(c config) *InfluenceHistoryClient {
+	return &InfluenceHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `influencehistory.Hooks(f(g(h())))`.
+func (c *InfluenceHistoryClient) Use(hooks ...Hook) {
+	c.hooks.InfluenceHistory = append(c.hooks.InfluenceHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `influencehistory.Intercept(f(g(h())))`.
+func (c *InfluenceHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InfluenceHistory = append(c.inters.InfluenceHistory, interceptors...)
+}
+
+// Create returns a builder for creating a InfluenceHistory entity.
+func (c *InfluenceHistoryClient) Create() *InfluenceHistoryCreate {
+	mutation := newInfluenceHistoryMutation(c.config, OpCreate)
+	return &InfluenceHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InfluenceHistory entities.
+func (c *InfluenceHistoryClient) CreateBulk(builders ...*InfluenceHistoryCreate) *InfluenceHistoryCreateBulk {
+	return &InfluenceHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InfluenceHistoryClient) MapCreateBulk(slice any, setFunc func(*InfluenceHistoryCreate, int)) *InfluenceHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InfluenceHistoryCreateBulk{err: fmt.Errorf("calling to InfluenceHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InfluenceHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InfluenceHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InfluenceHistory.
+func (c *InfluenceHistoryClient) Update() *InfluenceHistoryUpdate {
+	mutation := newInfluenceHistoryMutation(c.config, OpUpdate)
+	return &InfluenceHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InfluenceHistoryClient) UpdateOne(ih *InfluenceHistory) *InfluenceHistoryUpdateOne {
+	mutation := newInfluenceHistoryMutation(c.config, OpUpdateOne, withInfluenceHistory(ih))
+	return &InfluenceHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InfluenceHistoryClient) UpdateOneID(id uuid.UUID) *InfluenceHistoryUpdateOne {
+	mutation := newInfluenceHistoryMutation(c.config, OpUpdateOne, withInfluenceHistoryID(id))
+	return &InfluenceHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InfluenceHistory.
+func (c *InfluenceHistoryClient) Delete() *InfluenceHistoryDelete {
+	mutation := newInfluenceHistoryMutation(c.config, OpDelete)
+	return &InfluenceHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InfluenceHistoryClient) DeleteOne(ih *InfluenceHistory) *InfluenceHistoryDeleteOne {
+	return c.DeleteOneID(ih.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InfluenceHistoryClient) DeleteOneID(id uuid.UUID) *InfluenceHistoryDeleteOne {
+	builder := c.Delete().Where(influencehistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InfluenceHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for InfluenceHistory.
+func (c *InfluenceHistoryClient) Query() *InfluenceHistoryQuery {
+	return &InfluenceHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInfluenceHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a InfluenceHistory entity by its id.
+func (c *InfluenceHistoryClient) Get(ctx context.Context, id uuid.UUID) (*InfluenceHistory, error) {
+	return c.Query().Where(influencehistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InfluenceHistoryClient) GetX(ctx context.Context, id uuid.UUID) *InfluenceHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InfluenceHistoryClient) Hooks() []Hook {
+	return c.hooks.InfluenceHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *InfluenceHistoryClient) Interceptors() []Interceptor {
+	return c.inters.InfluenceHistory
+}
+
+func (c *InfluenceHistoryClient) mutate(ctx context.Context, m *InfluenceHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InfluenceHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InfluenceHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InfluenceHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InfluenceHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown InfluenceHistory mutation op: %q", m.Op())
+	}
+}
+
+// NotificationClient is a client for the Notification schema.
+type NotificationClient struct {
+	config
+}
+
+// NewNotificationClient returns a client for the Notification from the given config.
+func NewNotificationClient(c config) *NotificationClient {
+	return &NotificationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
+func (c *NotificationClient) Use(hooks ...Hook) {
+	c.hooks.Notification = append(c.hooks.Notification, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notification.Intercept(f(g(h())))`.
+func (c *NotificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Notification = append(c.inters.Notification, interceptors...)
+}
+
+// Create returns a builder for creating a Notification entity.
+func (c *NotificationClient) Create() *NotificationCreate {
+	mutation := newNotificationMutation(c.config, OpCreate)
+	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Notification entities.
+func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationClient) MapCreateBulk(slice any, setFunc func(*NotificationCreate, int)) *NotificationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationCreateBulk{err: fmt.Errorf("calling to NotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Notification.
+func (c *NotificationClient) Update() *NotificationUpdate {
+	mutation := newNotificationMutation(c.config, OpUpdate)
+	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationClient) UpdateOne(n *Notification) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(n))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationClient) UpdateOneID(id uuid.UUID) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Notification.
+func (c *NotificationClient) Delete() *NotificationDelete {
+	mutation := newNotificationMutation(c.config, OpDelete)
+	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationClient) DeleteOne(n *Notification) *NotificationDeleteOne {
+	return c.DeleteOneID(n.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationClient) DeleteOneID(id uuid.UUID) *NotificationDeleteOne {
+	builder := c.Delete().Where(notification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationDeleteOne{builder}
+}
+
+// Query returns a query builder for Notification.
+func (c *NotificationClient) Query() *NotificationQuery {
+	return &NotificationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotification},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Notification entity by its id.
+func (c *NotificationClient) Get(ctx context.Context, id uuid.UUID) (*Notification, error) {
+	return c.Query().Where(notification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationClient) GetX(ctx context.Context, id uuid.UUID) *Notification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationClient) Hooks() []Hook {
+	return c.hooks.Notification
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationClient) Interceptors() []Interceptor {
+	return c.inters.Notification
+}
+
+func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Notification mutation op: %q", m.Op())
+	}
+}
+
+// NotificationPreferenceClient is a client for the NotificationPreference schema.
+type NotificationPreferenceClient struct {
+	config
+}
+
+// NewNotificationPreferenceClient returns a client for the NotificationPreference from the given config.
+func NewNotificationPreferenceClient(c config) *NotificationPreferenceClient {
+	return &NotificationPreferenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notificationpreference.Hooks(f(g(h())))`.
+func (c *NotificationPreferenceClient) Use(hooks ...Hook) {
+	c.hooks.NotificationPreference = append(c.hooks.NotificationPreference, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notificationpreference.Intercept(f(g(h())))`.
+func (c *NotificationPreferenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationPreference = append(c.inters.NotificationPreference, interceptors...)
+}
+
+// Create returns a builder for creating a NotificationPreference entity.
+func (c *NotificationPreferenceClient) Create() *NotificationPreferenceCreate {
+	mutation := newNotificationPreferenceMutation(c.config, OpCreate)
+	return &NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NotificationPreference entities.
+func (c *NotificationPreferenceClient) CreateBulk(builders ...*NotificationPreferenceCreate) *NotificationPreferenceCreateBulk {
+	return &NotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationPreferenceClient) MapCreateBulk(slice any, setFunc func(*NotificationPreferenceCreate, int)) *NotificationPreferenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationPreferenceCreateBulk{err: fmt.Errorf("calling to NotificationPreferenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationPreferenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Update() *NotificationPreferenceUpdate {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdate)
+	return &NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationPreferenceClient) UpdateOne(np *NotificationPreference) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreference(np))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationPreferenceClient) UpdateOneID(id uuid.UUID) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreferenceID(id))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Delete() *NotificationPreferenceDelete {
+	mutation := newNotificationPreferenceMutation(c.config, OpDelete)
+	return &NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationPreferenceClient) DeleteOne(np *NotificationPreference) *NotificationPreferenceDeleteOne {
+	return c.DeleteOneID(np.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationPreferenceClient) DeleteOneID(id uuid.UUID) *NotificationPreferenceDeleteOne {
+	builder := c.Delete().Where(notificationpreference.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationPreferenceDeleteOne{builder}
+}
+
+// Query returns a query builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Query() *NotificationPreferenceQuery {
+	return &NotificationPreferenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotificationPreference},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NotificationPreference entity by its id.
+func (c *NotificationPreferenceClient) Get(ctx context.Context, id uuid.UUID) (*NotificationPreference, error) {
+	return c.Query().Where(notificationpreference.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationPreferenceClient) GetX(ctx context.Context, id uuid.UUID) *NotificationPreference {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationPreferenceClient) Hooks() []Hook {
+	return c.hooks.NotificationPreference
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationPreferenceClient) Interceptors() []Interceptor {
+	return c.inters.NotificationPreference
+}
+
+func (c *NotificationPreferenceClient) mutate(ctx context.Context, m *NotificationPreferenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NotificationPreference mutation op: %q", m.Op())
+	}
+}
+
+// PersonalRecordClient is a client for the PersonalRecord schema.
+type PersonalRecordClient struct {
+	config
+}
+
+// NewPersonalRecordClient returns a client for the PersonalRecord from the given config.
+func NewPersonalRecordClient(c config) *PersonalRecordClient {
+	return &PersonalRecordClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `personalrecord.Hooks(f(g(h())))`.
+func (c *PersonalRecordClient) Use(hooks ...Hook) {
+	c.hooks.PersonalRecord = append(c.hooks.PersonalRecord, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `personalrecord.Intercept(f(g(h())))`.
+func (c *PersonalRecordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PersonalRecord = append(c.inters.PersonalRecord, interceptors...)
+}
+
+// Create returns a builder for creating a PersonalRecord entity.
+func (c *PersonalRecordClient) Create() *PersonalRecordCreate {
+	mutation := newPersonalRecordMutation(c.config, OpCreate)
+	return &PersonalRecordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PersonalRecord entities.
+func (c *PersonalRecordClient) CreateBulk(builders ...*PersonalRecordCreate) *PersonalRecordCreateBulk {
+	return &PersonalRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PersonalRecordClient) MapCreateBulk(slice any, setFunc func(*PersonalRecordCreate, int)) *PersonalRecordCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PersonalRecordCreateBulk{err: fmt.Errorf("calling to PersonalRecordClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PersonalRecordCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PersonalRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PersonalRecord.
+func (c *PersonalRecordClient) Update() *PersonalRecordUpdate {
+	mutation := newPersonalRecordMutation(c.config, OpUpdate)
+	return &PersonalRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PersonalRecordClient) UpdateOne(pr *PersonalRecord) *PersonalRecordUpdateOne {
+	mutation := newPersonalRecordMutation(c.config, OpUpdateOne, withPersonalRecord(pr))
+	return &PersonalRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PersonalRecordClient) UpdateOneID(id uuid.UUID) *PersonalRecordUpdateOne {
+	mutation := newPersonalRecordMutation(c.config, OpUpdateOne, withPersonalRecordID(id))
+	return &PersonalRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PersonalRecord.
+func (c *PersonalRecordClient) Delete() *PersonalRecordDelete {
+	mutation := newPersonalRecordMutation(c.config, OpDelete)
+	return &PersonalRecordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PersonalRecordClient) DeleteOne(pr *PersonalRecord) *PersonalRecordDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PersonalRecordClient) DeleteOneID(id uuid.UUID) *PersonalRecordDeleteOne {
+	builder := c.Delete().Where(personalrecord.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PersonalRecordDeleteOne{builder}
+}
+
+// Query returns a query builder for PersonalRecord.
+func (c *PersonalRecordClient) Query() *PersonalRecordQuery {
+	return &PersonalRecordQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePersonalRecord},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PersonalRecord entity by its id.
+func (c *PersonalRecordClient) Get(ctx context.Context, id uuid.UUID) (*PersonalRecord, error) {
+	return c.Query().Where(personalrecord.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PersonalRecordClient) GetX(ctx context.Context, id uuid.UUID) *PersonalRecord {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PersonalRecordClient) Hooks() []Hook {
+	return c.hooks.PersonalRecord
+}
+
+// Interceptors returns the client interceptors.
+func (c *PersonalRecordClient) Interceptors() []Interceptor {
+	return c.inters.PersonalRecord
+}
+
+func (c *PersonalRecordClient) mutate(ctx context.Context, m *PersonalRecordMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PersonalRecordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PersonalRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PersonalRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PersonalRecordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PersonalRecord mutation op: %q", m.Op())
+	}
+}
+
+// PrivacyZoneClient is a client for the PrivacyZone schema.
+type PrivacyZoneClient struct {
+	config
+}
+
+// NewPrivacyZoneClient returns a client for the PrivacyZone from the given config.
+func NewPrivacyZoneClient(c config) *PrivacyZoneClient {
+	return &PrivacyZoneClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `privacyzone.Hooks(f(g(h())))`.
+func (c *PrivacyZoneClient) Use(hooks ...Hook) {
+	c.hooks.PrivacyZone = append(c.hooks.PrivacyZone, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `privacyzone.Intercept(f(g(h())))`.
+func (c *PrivacyZoneClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PrivacyZone = append(c.inters.PrivacyZone, interceptors...)
+}
+
+// Create returns a builder for creating a PrivacyZone entity.
+func (c *PrivacyZoneClient) Create() *PrivacyZoneCreate {
+	mutation := newPrivacyZoneMutation(c.config, OpCreate)
+	return &PrivacyZoneCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PrivacyZone entities.
+func (c *PrivacyZoneClient) CreateBulk(builders ...*PrivacyZoneCreate) *PrivacyZoneCreateBulk {
+	return &PrivacyZoneCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PrivacyZoneClient) MapCreateBulk(slice any, setFunc func(*PrivacyZoneCreate, int)) *PrivacyZoneCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PrivacyZoneCreateBulk{err: fmt.Errorf("calling to PrivacyZoneClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PrivacyZoneCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PrivacyZoneCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PrivacyZone.
+func (c *PrivacyZoneClient) Update() *PrivacyZoneUpdate {
+	mutation := newPrivacyZoneMutation(c.config, OpUpdate)
+	return &PrivacyZoneUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PrivacyZoneClient) UpdateOne(pz *PrivacyZone) *PrivacyZoneUpdateOne {
+	mutation := newPrivacyZoneMutation(c.config, OpUpdateOne, withPrivacyZone(pz))
+	return &PrivacyZoneUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PrivacyZoneClient) UpdateOneID(id uuid.UUID) *PrivacyZoneUpdateOne {
+	mutation := newPrivacyZoneMutation(c.config, OpUpdateOne, withPrivacyZoneID(id))
+	return &PrivacyZoneUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PrivacyZone.
+func (c *PrivacyZoneClient) Delete() *PrivacyZoneDelete {
+	mutation := newPrivacyZoneMutation(c.config, OpDelete)
+	return &PrivacyZoneDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PrivacyZoneClient) DeleteOne(pz *PrivacyZone) *PrivacyZoneDeleteOne {
+	return c.DeleteOneID(pz.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PrivacyZoneClient) DeleteOneID(id uuid.UUID) *PrivacyZoneDeleteOne {
+	builder := c.Delete().Where(privacyzone.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PrivacyZoneDeleteOne{builder}
+}
+
+// Query returns a query builder for PrivacyZone.
+func (c *PrivacyZoneClient) Query() *PrivacyZoneQuery {
+	return &PrivacyZoneQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePrivacyZone},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PrivacyZone entity by its id.
+func (c *PrivacyZoneClient) Get(ctx context.Context, id uuid.UUID) (*PrivacyZone, error) {
+	return c.Query().Where(privacyzone.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PrivacyZoneClient) GetX(ctx context.Context, id uuid.UUID) *PrivacyZone {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PrivacyZoneClient) Hooks() []Hook {
+	return c.hooks.PrivacyZone
+}
+
+// Interceptors returns the client interceptors.
+func (c *PrivacyZoneClient) Interceptors() []Interceptor {
+	return c.inters.PrivacyZone
+}
+
+func (c *PrivacyZoneClient) mutate(ctx context.Context, m *PrivacyZoneMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PrivacyZoneCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PrivacyZoneUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PrivacyZoneUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PrivacyZoneDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PrivacyZone mutation op: %q", m.Op())
+	}
+}
+
+// PushDeviceClient is a client for the PushDevice schema.
+type PushDeviceClient struct {
+	config
+}
+
+// NewPushDeviceClient returns a client for the PushDevice from the given config.
+func NewPushDeviceClient(c config) *PushDeviceClient {
+	return &PushDeviceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pushdevice.Hooks(f(g(h())))`.
+func (c *PushDeviceClient) Use(hooks ...Hook) {
+	c.hooks.PushDevice = append(c.hooks.PushDevice, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pushdevice.Intercept(f(g(h())))`.
+func (c *PushDeviceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PushDevice = append(c.inters.PushDevice, interceptors...)
+}
+
+// Create returns a builder for creating a PushDevice entity.
+func (c *PushDeviceClient) Create() *PushDeviceCreate {
+	mutation := newPushDeviceMutation(c.config, OpCreate)
+	return &PushDeviceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PushDevice entities.
+func (c *PushDeviceClient) CreateBulk(builders ...*PushDeviceCreate) *PushDeviceCreateBulk {
+	return &PushDeviceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PushDeviceClient) MapCreateBulk(slice any, setFunc func(*PushDeviceCreate, int)) *PushDeviceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PushDeviceCreateBulk{err: fmt.Errorf("calling to PushDeviceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PushDeviceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PushDeviceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PushDevice.
+func (c *PushDeviceClient) Update() *PushDeviceUpdate {
+	mutation := newPushDeviceMutation(c.config, OpUpdate)
+	return &PushDeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PushDeviceClient) UpdateOne(pd *PushDevice) *PushDeviceUpdateOne {
+	mutation := newPushDeviceMutation(c.config, OpUpdateOne, withPushDevice(pd))
+	return &PushDeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PushDeviceClient) UpdateOneID(id uuid.UUID) *PushDeviceUpdateOne {
+	mutation := newPushDeviceMutation(c.config, OpUpdateOne, withPushDeviceID(id))
+	return &PushDeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PushDevice.
+func (c *PushDeviceClient) Delete() *PushDeviceDelete {
+	mutation := newPushDeviceMutation(c.config, OpDelete)
+	return &PushDeviceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PushDeviceClient) DeleteOne(pd *PushDevice) *PushDeviceDeleteOne {
+	return c.DeleteOneID(pd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PushDeviceClient) DeleteOneID(id uuid.UUID) *PushDeviceDeleteOne {
+	builder := c.Delete().Where(pushdevice.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PushDeviceDeleteOne{builder}
+}
+
+// Query returns a query builder for PushDevice.
+func (c *PushDeviceClient) Query() *PushDeviceQuery {
+	return &PushDeviceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePushDevice},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PushDevice entity by its id.
+func (c *PushDeviceClient) Get(ctx context.Context, id uuid.UUID) (*PushDevice, error) {
+	return c.Query().Where(pushdevice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PushDeviceClient) GetX(ctx context.Context, id uuid.UUID) *PushDevice {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PushDeviceClient) Hooks() []Hook {
+	return c.hooks.PushDevice
+}
+
+// Interceptors returns the client interceptors.
+func (c *PushDeviceClient) Interceptors() []Interceptor {
+	return c.inters.PushDevice
+}
+
+func (c *PushDeviceClient) mutate(ctx context.Context, m *PushDeviceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PushDeviceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PushDeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PushDeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PushDeviceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PushDevice mutation op: %q", m.Op())
+	}
+}
+
+// PushMessageClient is a client for the PushMessage schema.
+type PushMessageClient struct {
+	config
+}
+
+// NewPushMessageClient returns a client for the PushMessage from the given config.
+func NewPushMessageClient(c config) *PushMessageClient {
+	return &PushMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pushmessage.Hooks(f(g(h())))`.
+func (c *PushMessageClient) Use(hooks ...Hook) {
+	c.hooks.PushMessage = append(c.hooks.PushMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pushmessage.Intercept(f(g(h())))`.
+func (c *PushMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PushMessage = append(c.inters.PushMessage, interceptors...)
+}
+
+// Create returns a builder for creating a PushMessage entity.
+func (c *PushMessageClient) Create() *PushMessageCreate {
+	mutation := newPushMessageMutation(c.config, OpCreate)
+	return &PushMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PushMessage entities.
+func (c *PushMessageClient) CreateBulk(builders ...*PushMessageCreate) *PushMessageCreateBulk {
+	return &PushMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PushMessageClient) MapCreateBulk(slice any, setFunc func(*PushMessageCreate, int)) *PushMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PushMessageCreateBulk{err: fmt.Errorf("calling to PushMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PushMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PushMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PushMessage.
+func (c *PushMessageClient) Update() *PushMessageUpdate {
+	mutation := newPushMessageMutation(c.config, OpUpdate)
+	return &PushMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PushMessageClient) UpdateOne(pm *PushMessage) *PushMessageUpdateOne {
+	mutation := newPushMessageMutation(c.config, OpUpdateOne, withPushMessage(pm))
+	return &PushMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PushMessageClient) UpdateOneID(id uuid.UUID) *PushMessageUpdateOne {
+	mutation := newPushMessageMutation(c.config, OpUpdateOne, withPushMessageID(id))
+	return &PushMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PushMessage.
+func (c *PushMessageClient) Delete() *PushMessageDelete {
+	mutation := newPushMessageMutation(c.config, OpDelete)
+	return &PushMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PushMessageClient) DeleteOne(pm *PushMessage) *PushMessageDeleteOne {
+	return c.DeleteOneID(pm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PushMessageClient) DeleteOneID(id uuid.UUID) *PushMessageDeleteOne {
+	builder := c.Delete().Where(pushmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PushMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for PushMessage.
+func (c *PushMessageClient) Query() *PushMessageQuery {
+	return &PushMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePushMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PushMessage entity by its id.
+func (c *PushMessageClient) Get(ctx context.Context, id uuid.UUID) (*PushMessage, error) {
+	return c.Query().Where(pushmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PushMessageClient) GetX(ctx context.Context, id uuid.UUID) *PushMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PushMessageClient) Hooks() []Hook {
+	return c.hooks.PushMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *PushMessageClient) Interceptors() []Interceptor {
+	return c.inters.PushMessage
+}
+
+func (c *PushMessageClient) mutate(ctx context.Context, m *PushMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PushMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PushMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PushMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PushMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PushMessage mutation op: %q", m.Op())
+	}
+}
+
+// RegionClient is a client for the Region schema.
+type RegionClient struct {
+	config
+}
+
+// NewRegionClient returns a client for the Region from the given config.
+func NewRegionClient(c config) *RegionClient {
+	return &RegionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `region.Hooks(f(g(h())))`.
+func (c *RegionClient) Use(hooks ...Hook) {
+	c.hooks.Region = append(c.hooks.Region, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `region.Intercept(f(g(h())))`.
+func (c *RegionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Region = append(c.inters.Region, interceptors...)
+}
+
+// Create returns a builder for creating a Region entity.
+func (c *RegionClient) Create() *RegionCreate {
+	mutation := newRegionMutation(c.config, OpCreate)
+	return &RegionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Region entities.
+func (c *RegionClient) CreateBulk(builders ...*RegionCreate) *RegionCreateBulk {
+	return &RegionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RegionClient) MapCreateBulk(slice any, setFunc func(*RegionCreate, int)) *RegionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RegionCreateBulk{err: fmt.Errorf("calling to RegionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RegionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RegionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Region.
+func (c *RegionClient) Update() *RegionUpdate {
+	mutation := newRegionMutation(c.config, OpUpdate)
+	return &RegionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RegionClient) UpdateOne(r *Region) *RegionUpdateOne {
+	mutation := newRegionMutation(c.config, OpUpdateOne, withRegion(r))
+	return &RegionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RegionClient) UpdateOneID(id uuid.UUID) *RegionUpdateOne {
+	mutation := newRegionMutation(c.config, OpUpdateOne, withRegionID(id))
+	return &RegionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Region.
+func (c *RegionClient) Delete() *RegionDelete {
+	mutation := newRegionMutation(c.config, OpDelete)
+	return &RegionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RegionClient) DeleteOne(r *Region) *RegionDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RegionClient) DeleteOneID(id uuid.UUID) *RegionDeleteOne {
+	builder := c.Delete().Where(region.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RegionDeleteOne{builder}
+}
+
+// Query returns a query builder for Region.
+func (c *RegionClient) Query() *RegionQuery {
+	return &RegionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRegion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Region entity by its id.
+func (c *RegionClient) Get(ctx context.Context, id uuid.UUID) (*Region, error) {
+	return c.Query().Where(region.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RegionClient) GetX(ctx context.Context, id uuid.UUID) *Region {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryHexes queries the hexes edge of a Region.
+func (c *RegionClient) QueryHexes(r *Region) *RegionHexQuery {
+	query := (&RegionHexClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(region.Table, region.FieldID, id),
+			sqlgraph.To(regionhex.Table, regionhex.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, region.HexesTable, region.HexesColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStandings queries the standings edge of a Region.
+func (c *RegionClient) QueryStandings(r *Region) *RegionStandingQuery {
+	query := (&RegionStandingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(region.Table, region.FieldID, id),
+			sqlgraph.To(regionstanding.Table, regionstanding.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, region.StandingsTable, region.StandingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RegionClient) Hooks() []Hook {
+	return c.hooks.Region
+}
+
+// Interceptors returns the client interceptors.
+func (c *RegionClient) Interceptors() []Interceptor {
+	return c.inters.Region
+}
+
+func (c *RegionClient) mutate(ctx context.Context, m *RegionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RegionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RegionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RegionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RegionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Region mutation op: %q", m.Op())
+	}
+}
+
+// RegionHexClient is a client for the RegionHex schema.
+type RegionHexClient struct {
+	config
+}
+
+// NewRegionHexClient returns a client for the RegionHex from the given config.
+func NewRegionHexClient(c config) *RegionHexClient {
+	return &RegionHexClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `regionhex.Hooks(f(g(h())))`.
+func (c *RegionHexClient) Use(hooks ...Hook) {
+	c.hooks.RegionHex = append(c.hooks.RegionHex, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `regionhex.Intercept(f(g(h())))`.
+func (c *RegionHexClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RegionHex = append(c.inters.RegionHex, interceptors...)
+}
+
+// Create returns a builder for creating a RegionHex entity.
+func (c *RegionHexClient) Create() *RegionHexCreate {
+	mutation := newRegionHexMutation(c.config, OpCreate)
+	return &RegionHexCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RegionHex entities.
+func (c *RegionHexClient) CreateBulk(builders ...*RegionHexCreate) *RegionHexCreateBulk {
+	return &RegionHexCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RegionHexClient) MapCreateBulk(slice any, setFunc func(*RegionHexCreate, int)) *RegionHexCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RegionHexCreateBulk{err: fmt.Errorf("calling to RegionHexClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RegionHexCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RegionHexCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RegionHex.
+func (c *RegionHexClient) Update() *RegionHexUpdate {
+	mutation := newRegionHexMutation(c.config, OpUpdate)
+	return &RegionHexUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RegionHexClient) UpdateOne(rh *RegionHex) *RegionHexUpdateOne {
+	mutation := newRegionHexMutation(c.config, OpUpdateOne, withRegionHex(rh))
+	return &RegionHexUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RegionHexClient) UpdateOneID(id uuid.UUID) *RegionHexUpdateOne {
+	mutation := newRegionHexMutation(c.config, OpUpdateOne, withRegionHexID(id))
+	return &RegionHexUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RegionHex.
+func (c *RegionHexClient) Delete() *RegionHexDelete {
+	mutation := newRegionHexMutation(c.config, OpDelete)
+	return &RegionHexDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RegionHexClient) DeleteOne(rh *RegionHex) *RegionHexDeleteOne {
+	return c.DeleteOneID(rh.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RegionHexClient) DeleteOneID(id uuid.UUID) *RegionHexDeleteOne {
+	builder := c.Delete().Where(regionhex.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RegionHexDeleteOne{builder}
+}
+
+// Query returns a query builder for RegionHex.
+func (c *RegionHexClient) Query() *RegionHexQuery {
+	return &RegionHexQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRegionHex},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RegionHex entity by its id.
+func (c *RegionHexClient) Get(ctx context.Context, id uuid.UUID) (*RegionHex, error) {
+	return c.Query().Where(regionhex.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RegionHexClient) GetX(ctx context.Context, id uuid.UUID) *RegionHex {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRegion queries the region edge of a RegionHex.
+func (c *RegionHexClient) QueryRegion(rh *RegionHex) *RegionQuery {
+	query := (&RegionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rh.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(regionhex.Table, regionhex.FieldID, id),
+			sqlgraph.To(region.Table, region.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, regionhex.RegionTable, regionhex.RegionColumn),
+		)
+		fromV = sqlgraph.Neighbors(rh.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RegionHexClient) Hooks() []Hook {
+	return c.hooks.RegionHex
+}
+
+// Interceptors returns the client interceptors.
+func (c *RegionHexClient) Interceptors() []Interceptor {
+	return c.inters.RegionHex
+}
+
+func (c *RegionHexClient) mutate(ctx context.Context, m *RegionHexMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RegionHexCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RegionHexUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RegionHexUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RegionHexDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RegionHex mutation op: %q", m.Op())
+	}
+}
+
+// RegionStandingClient is a client for the RegionStanding schema.
+type RegionStandingClient struct {
+	config
+}
+
+// NewRegionStandingClient returns a client for the RegionStanding from the given config.
+func NewRegionStandingClient(c config) *RegionStandingClient {
+	return &RegionStandingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `regionstanding.Hooks(f(g(h())))`.
+func (c *RegionStandingClient) Use(hooks ...Hook) {
+	c.hooks.RegionStanding = append(c.hooks.RegionStanding, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `regionstanding.Intercept(f(g(h())))`.
+func (c *RegionStandingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RegionStanding = append(c.inters.RegionStanding, interceptors...)
+}
+
+// Create returns a builder for creating a RegionStanding entity.
+func (c *RegionStandingClient) Create() *RegionStandingCreate {
+	mutation := newRegionStandingMutation(c.config, OpCreate)
+	return &RegionStandingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RegionStanding entities.
+func (c *RegionStandingClient) CreateBulk(builders ...*RegionStandingCreate) *RegionStandingCreateBulk {
+	return &RegionStandingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RegionStandingClient) MapCreateBulk(slice any, setFunc func(*RegionStandingCreate, int)) *RegionStandingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RegionStandingCreateBulk{err: fmt.Errorf("calling to RegionStandingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RegionStandingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RegionStandingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RegionStanding.
+func (c *RegionStandingClient) Update() *RegionStandingUpdate {
+	mutation := newRegionStandingMutation(c.config, OpUpdate)
+	return &RegionStandingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RegionStandingClient) UpdateOne(rs *RegionStanding) *RegionStandingUpdateOne {
+	mutation := newRegionStandingMutation(c.config, OpUpdateOne, withRegionStanding(rs))
+	return &RegionStandingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RegionStandingClient) UpdateOneID(id uuid.UUID) *RegionStandingUpdateOne {
+	mutation := newRegionStandingMutation(c.config, OpUpdateOne, withRegionStandingID(id))
+	return &RegionStandingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RegionStanding.
+func (c *RegionStandingClient) Delete() *RegionStandingDelete {
+	mutation := newRegionStandingMutation(c.config, OpDelete)
+	return &RegionStandingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RegionStandingClient) DeleteOne(rs *RegionStanding) *RegionStandingDeleteOne {
+	return c.DeleteOneID(rs.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RegionStandingClient) DeleteOneID(id uuid.UUID) *RegionStandingDeleteOne {
+	builder := c.Delete().Where(regionstanding.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RegionStandingDeleteOne{builder}
+}
+
+// Query returns a query builder for RegionStanding.
+func (c *RegionStandingClient) Query() *RegionStandingQuery {
+	return &RegionStandingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRegionStanding},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RegionStanding entity by its id.
+func (c *RegionStandingClient) Get(ctx context.Context, id uuid.UUID) (*RegionStanding, error) {
+	return c.Query().Where(regionstanding.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RegionStandingClient) GetX(ctx context.Context, id uuid.UUID) *RegionStanding {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRegion queries the region edge of a RegionStanding.
+func (c *RegionStandingClient) QueryRegion(rs *RegionStanding) *RegionQuery {
+	query := (&RegionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(regionstanding.Table, regionstanding.FieldID, id),
+			sqlgraph.To(region.Table, region.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, regionstanding.RegionTable, regionstanding.RegionColumn),
+		)
+		fromV = sqlgraph.Neighbors(rs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RegionStandingClient) Hooks() []Hook {
+	return c.hooks.RegionStanding
+}
+
+// Interceptors returns the client interceptors.
+func (c *RegionStandingClient) Interceptors() []Interceptor {
+	return c.inters.RegionStanding
+}
+
+func (c *RegionStandingClient) mutate(ctx context.Context, m *RegionStandingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RegionStandingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RegionStandingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RegionStandingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RegionStandingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RegionStanding mutation op: %q", m.Op())
+	}
+}
+
+// SeasonClient is a client for the Season schema.
+type SeasonClient struct {
+	config
+}
+
+// NewSeasonClient returns a client for the Season from the given config.
+func NewSeasonClient(c config) *SeasonClient {
+	return &SeasonClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `season.Hooks(f(g(h())))`.
+func (c *SeasonClient) Use(hooks ...Hook) {
+	c.hooks.Season = append(c.hooks.Season, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `season.Intercept(f(g(h())))`.
+func (c *SeasonClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Season = append(c.inters.Season, interceptors...)
+}
+
+// Create returns a builder for creating a Season entity.
+func (c *SeasonClient) Create() *SeasonCreate {
+	mutation := newSeasonMutation(c.config, OpCreate)
+	return &SeasonCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Season entities.
+func (c *SeasonClient) CreateBulk(builders ...*SeasonCreate) *SeasonCreateBulk {
+	return &SeasonCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SeasonClient) MapCreateBulk(slice any, setFunc func(*SeasonCreate, int)) *SeasonCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SeasonCreateBulk{err: fmt.Errorf("calling to SeasonClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SeasonCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SeasonCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Season.
+func (c *SeasonClient) Update() *SeasonUpdate {
+	mutation := newSeasonMutation(c.config, OpUpdate)
+	return &SeasonUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SeasonClient) UpdateOne(s *Season) *SeasonUpdateOne {
+	mutation := newSeasonMutation(c.config, OpUpdateOne, withSeason(s))
+	return &SeasonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SeasonClient) UpdateOneID(id uuid.UUID) *SeasonUpdateOne {
+	mutation := newSeasonMutation(c.config, OpUpdateOne, withSeasonID(id))
+	return &SeasonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Season.
+func (c *SeasonClient) Delete() *SeasonDelete {
+	mutation := newSeasonMutation(c.config, OpDelete)
+	return &SeasonDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SeasonClient) DeleteOne(s *Season) *SeasonDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SeasonClient) DeleteOneID(id uuid.UUID) *SeasonDeleteOne {
+	builder := c.Delete().Where(season.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SeasonDeleteOne{builder}
+}
+
+// Query returns a query builder for Season.
+func (c *SeasonClient) Query() *SeasonQuery {
+	return &SeasonQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSeason},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Season entity by its id.
+func (c *SeasonClient) Get(ctx context.Context, id uuid.UUID) (*Season, error) {
+	return c.Query().Where(season.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SeasonClient) GetX(ctx context.Context, id uuid.UUID) *Season {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SeasonClient) Hooks() []Hook {
+	return c.hooks.Season
+}
+
+// Interceptors returns the client interceptors.
+func (c *SeasonClient) Interceptors() []Interceptor {
+	return c.inters.Season
+}
+
+func (c *SeasonClient) mutate(ctx context.Context, m *SeasonMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SeasonCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SeasonUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SeasonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SeasonDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Season mutation op: %q", m.Op())
+	}
+}
+
+// SeasonHexStandingClient is a client for the SeasonHexStanding schema.
+type SeasonHexStandingClient struct {
+	config
+}
+
+// NewSeasonHexStandingClient returns a client for the SeasonHexStanding from the given config.
+func NewSeasonHexStandingClient(c config) *SeasonHexStandingClient {
+	return &SeasonHexStandingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `seasonhexstanding.Hooks(f(g(h())))`.
+func (c *SeasonHexStandingClient) Use(hooks ...Hook) {
+	c.hooks.SeasonHexStanding = append(c.hooks.SeasonHexStanding, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `seasonhexstanding.Intercept(f(g(h())))`.
+func (c *SeasonHexStandingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SeasonHexStanding = append(c.inters.SeasonHexStanding, interceptors...)
+}
+
+// Create returns a builder for creating a SeasonHexStanding entity.
+func (c *SeasonHexStandingClient) Create() *SeasonHexStandingCreate {
+	mutation := newSeasonHexStandingMutation(c.config, OpCreate)
+	return &SeasonHexStandingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SeasonHexStanding entities.
+func (c *SeasonHexStandingClient) CreateBulk(builders ...*SeasonHexStandingCreate) *SeasonHexStandingCreateBulk {
+	return &SeasonHexStandingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SeasonHexStandingClient) MapCreateBulk(slice any, setFunc func(*SeasonHexStandingCreate, int)) *SeasonHexStandingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SeasonHexStandingCreateBulk{err: fmt.Errorf("calling to SeasonHexStandingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SeasonHexStandingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SeasonHexStandingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SeasonHexStanding.
+func (c *SeasonHexStandingClient) Update() *SeasonHexStandingUpdate {
+	mutation := newSeasonHexStandingMutation(c.config, OpUpdate)
+	return &SeasonHexStandingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SeasonHexStandingClient) UpdateOne(shs *SeasonHexStanding) *SeasonHexStandingUpdateOne {
+	mutation := newSeasonHexStandingMutation(c.config, OpUpdateOne, withSeasonHexStanding(shs))
+	return &SeasonHexStandingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SeasonHexStandingClient) UpdateOneID(id uuid.UUID) *SeasonHexStandingUpdateOne {
+	mutation := newSeasonHexStandingMutation(c.config, OpUpdateOne, withSeasonHexStandingID(id))
+	return &SeasonHexStandingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SeasonHexStanding.
+func (c *SeasonHexStandingClient) Delete() *SeasonHexStandingDelete {
+	mutation := newSeasonHexStandingMutation(c.config, OpDelete)
+	return &SeasonHexStandingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SeasonHexStandingClient) DeleteOne(shs *SeasonHexStanding) *SeasonHexStandingDeleteOne {
+	return c.DeleteOneID(shs.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SeasonHexStandingClient) DeleteOneID(id uuid.UUID) *SeasonHexStandingDeleteOne {
+	builder := c.Delete().Where(seasonhexstanding.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SeasonHexStandingDeleteOne{builder}
+}
+
+// Query returns a query builder for SeasonHexStanding.
+func (c *SeasonHexStandingClient) Query() *SeasonHexStandingQuery {
+	return &SeasonHexStandingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSeasonHexStanding},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SeasonHexStanding entity by its id.
+func (c *SeasonHexStandingClient) Get(ctx context.Context, id uuid.UUID) (*SeasonHexStanding, error) {
+	return c.Query().Where(seasonhexstanding.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SeasonHexStandingClient) GetX(ctx context.Context, id uuid.UUID) *SeasonHexStanding {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SeasonHexStandingClient) Hooks() []Hook {
+	return c.hooks.SeasonHexStanding
+}
+
+// Interceptors returns the client interceptors.
+func (c *SeasonHexStandingClient) Interceptors() []Interceptor {
+	return c.inters.SeasonHexStanding
+}
+
+func (c *SeasonHexStandingClient) mutate(ctx context.Context, m *SeasonHexStandingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SeasonHexStandingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SeasonHexStandingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SeasonHexStandingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SeasonHexStandingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SeasonHexStanding mutation op: %q", m.Op())
+	}
+}
+
+// SeasonStandingClient is a client for the SeasonStanding schema.
+type SeasonStandingClient struct {
+	config
+}
+
+// NewSeasonStandingClient returns a client for the SeasonStanding from the given config.
+func NewSeasonStandingClient(c config) *SeasonStandingClient {
+	return &SeasonStandingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `seasonstanding.Hooks(f(g(h())))`.
+func (c *SeasonStandingClient) Use(hooks ...Hook) {
+	c.hooks.SeasonStanding = append(c.hooks.SeasonStanding, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `seasonstanding.Intercept(f(g(h())))`.
+func (c *SeasonStandingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SeasonStanding = append(c.inters.SeasonStanding, interceptors...)
+}
+
+// Create returns a builder for creating a SeasonStanding entity.
+func (c *SeasonStandingClient) Create() *SeasonStandingCreate {
+	mutation := newSeasonStandingMutation(c.config, OpCreate)
+	return &SeasonStandingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SeasonStanding entities.
+func (c *SeasonStandingClient) CreateBulk(builders ...*SeasonStandingCreate) *SeasonStandingCreateBulk {
+	return &SeasonStandingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SeasonStandingClient) MapCreateBulk(slice any, setFunc func(*SeasonStandingCreate, int)) *SeasonStandingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SeasonStandingCreateBulk{err: fmt.Errorf("calling to SeasonStandingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SeasonStandingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SeasonStandingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SeasonStanding.
+func (c *SeasonStandingClient) Update() *SeasonStandingUpdate {
+	mutation := newSeasonStandingMutation(c.config, OpUpdate)
+	return &SeasonStandingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SeasonStandingClient) UpdateOne(ss *SeasonStanding) *SeasonStandingUpdateOne {
+	mutation := newSeasonStandingMutation(c.config, OpUpdateOne, withSeasonStanding(ss))
+	return &SeasonStandingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SeasonStandingClient) UpdateOneID(id uuid.UUID) *SeasonStandingUpdateOne {
+	mutation := newSeasonStandingMutation(c.config, OpUpdateOne, withSeasonStandingID(id))
+	return &SeasonStandingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SeasonStanding.
+func (c *SeasonStandingClient) Delete() *SeasonStandingDelete {
+	mutation := newSeasonStandingMutation(c.config, OpDelete)
+	return &SeasonStandingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SeasonStandingClient) DeleteOne(ss *SeasonStanding) *SeasonStandingDeleteOne {
+	return c.DeleteOneID(ss.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SeasonStandingClient) DeleteOneID(id uuid.UUID) *SeasonStandingDeleteOne {
+	builder := c.Delete().Where(seasonstanding.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SeasonStandingDeleteOne{builder}
+}
+
+// Query returns a query builder for SeasonStanding.
+func (c *SeasonStandingClient) Query() *SeasonStandingQuery {
+	return &SeasonStandingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSeasonStanding},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SeasonStanding entity by its id.
+func (c *SeasonStandingClient) Get(ctx context.Context, id uuid.UUID) (*SeasonStanding, error) {
+	return c.Query().Where(seasonstanding.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SeasonStandingClient) GetX(ctx context.Context, id uuid.UUID) *SeasonStanding {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SeasonStandingClient) Hooks() []Hook {
+	return c.hooks.SeasonStanding
+}
+
+// Interceptors returns the client interceptors.
+func (c *SeasonStandingClient) Interceptors() []Interceptor {
+	return c.inters.SeasonStanding
+}
+
+func (c *SeasonStandingClient) mutate(ctx context.Context, m *SeasonStandingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SeasonStandingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SeasonStandingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SeasonStandingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SeasonStandingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SeasonStanding mutation op: %q", m.Op())
+	}
+}
+
+// SegmentClient is a client for the Segment schema.
+type SegmentClient struct {
+	config
+}
+
+// NewSegmentClient returns a client for the Segment from the given config.
+func NewSegmentClient(c config) *SegmentClient {
+	return &SegmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `segment.Hooks(f(g(h())))`.
+func (c *SegmentClient) Use(hooks ...Hook) {
+	c.hooks.Segment = append(c.hooks.Segment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `segment.Intercept(f(g(h())))`.
+func (c *SegmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Segment = append(c.inters.Segment, interceptors...)
+}
+
+// Create returns a builder for creating a Segment entity.
+func (c *SegmentClient) Create() *SegmentCreate {
+	mutation := newSegmentMutation(c.config, OpCreate)
+	return &SegmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Segment entities.
+func (c *SegmentClient) CreateBulk(builders ...*SegmentCreate) *SegmentCreateBulk {
+	return &SegmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SegmentClient) MapCreateBulk(slice any, setFunc func(*SegmentCreate, int)) *SegmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SegmentCreateBulk{err: fmt.Errorf("calling to SegmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SegmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SegmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Segment.
+func (c *SegmentClient) Update() *SegmentUpdate {
+	mutation := newSegmentMutation(c.config, OpUpdate)
+	return &SegmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SegmentClient) UpdateOne(s *Segment) *SegmentUpdateOne {
+	mutation := newSegmentMutation(c.config, OpUpdateOne, withSegment(s))
+	return &SegmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SegmentClient) UpdateOneID(id uuid.UUID) *SegmentUpdateOne {
+	mutation := newSegmentMutation(c.config, OpUpdateOne, withSegmentID(id))
+	return &SegmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Segment.
+func (c *SegmentClient) Delete() *SegmentDelete {
+	mutation := newSegmentMutation(c.config, OpDelete)
+	return &SegmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SegmentClient) DeleteOne(s *Segment) *SegmentDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SegmentClient) DeleteOneID(id uuid.UUID) *SegmentDeleteOne {
+	builder := c.Delete().Where(segment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SegmentDeleteOne{builder}
+}
+
+// Query returns a query builder for Segment.
+func (c *SegmentClient) Query() *SegmentQuery {
+	return &SegmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSegment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Segment entity by its id.
+func (c *SegmentClient) Get(ctx context.Context, id uuid.UUID) (*Segment, error) {
+	return c.Query().Where(segment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SegmentClient) GetX(ctx context.Context, id uuid.UUID) *Segment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEfforts queries the efforts edge of a Segment.
+func (c *SegmentClient) QueryEfforts(s *Segment) *SegmentEffortQuery {
+	query := (&SegmentEffortClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(segment.Table, segment.FieldID, id),
+			sqlgraph.To(segmenteffort.Table, segmenteffort.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, segment.EffortsTable, segment.EffortsColumn),
 		)
-		fromV = sqlgraph.Neighbors(hi.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SegmentClient) Hooks() []Hook {
+	return c.hooks.Segment
+}
+
+// Interceptors returns the client interceptors.
+func (c *SegmentClient) Interceptors() []Interceptor {
+	return c.inters.Segment
+}
+
+func (c *SegmentClient) mutate(ctx context.Context, m *SegmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SegmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SegmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SegmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SegmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Segment mutation op: %q", m.Op())
+	}
+}
+
+// SegmentEffortClient is a client for the SegmentEffort schema.
+type SegmentEffortClient struct {
+	config
+}
+
+// NewSegmentEffortClient returns a client for the SegmentEffort from the given config.
+func NewSegmentEffortClient(c config) *SegmentEffortClient {
+	return &SegmentEffortClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `segmenteffort.Hooks(f(g(h())))`.
+func (c *SegmentEffortClient) Use(hooks ...Hook) {
+	c.hooks.SegmentEffort = append(c.hooks.SegmentEffort, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `segmenteffort.Intercept(f(g(h())))`.
+func (c *SegmentEffortClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SegmentEffort = append(c.inters.SegmentEffort, interceptors...)
+}
+
+// Create returns a builder for creating a SegmentEffort entity.
+func (c *SegmentEffortClient) Create() *SegmentEffortCreate {
+	mutation := newSegmentEffortMutation(c.config, OpCreate)
+	return &SegmentEffortCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SegmentEffort entities.
+func (c *SegmentEffortClient) CreateBulk(builders ...*SegmentEffortCreate) *SegmentEffortCreateBulk {
+	return &SegmentEffortCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SegmentEffortClient) MapCreateBulk(slice any, setFunc func(*SegmentEffortCreate, int)) *SegmentEffortCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SegmentEffortCreateBulk{err: fmt.Errorf("calling to SegmentEffortClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SegmentEffortCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SegmentEffortCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SegmentEffort.
+func (c *SegmentEffortClient) Update() *SegmentEffortUpdate {
+	mutation := newSegmentEffortMutation(c.config, OpUpdate)
+	return &SegmentEffortUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SegmentEffortClient) UpdateOne(se *SegmentEffort) *SegmentEffortUpdateOne {
+	mutation := newSegmentEffortMutation(c.config, OpUpdateOne, withSegmentEffort(se))
+	return &SegmentEffortUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SegmentEffortClient) UpdateOneID(id uuid.UUID) *SegmentEffortUpdateOne {
+	mutation := newSegmentEffortMutation(c.config, OpUpdateOne, withSegmentEffortID(id))
+	return &SegmentEffortUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SegmentEffort.
+func (c *SegmentEffortClient) Delete() *SegmentEffortDelete {
+	mutation := newSegmentEffortMutation(c.config, OpDelete)
+	return &SegmentEffortDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SegmentEffortClient) DeleteOne(se *SegmentEffort) *SegmentEffortDeleteOne {
+	return c.DeleteOneID(se.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SegmentEffortClient) DeleteOneID(id uuid.UUID) *SegmentEffortDeleteOne {
+	builder := c.Delete().Where(segmenteffort.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SegmentEffortDeleteOne{builder}
+}
+
+// Query returns a query builder for SegmentEffort.
+func (c *SegmentEffortClient) Query() *SegmentEffortQuery {
+	return &SegmentEffortQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSegmentEffort},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SegmentEffort entity by its id.
+func (c *SegmentEffortClient) Get(ctx context.Context, id uuid.UUID) (*SegmentEffort, error) {
+	return c.Query().Where(segmenteffort.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SegmentEffortClient) GetX(ctx context.Context, id uuid.UUID) *SegmentEffort {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySegment queries the segment edge of a SegmentEffort.
+func (c *SegmentEffortClient) QuerySegment(se *SegmentEffort) *SegmentQuery {
+	query := (&SegmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := se.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(segmenteffort.Table, segmenteffort.FieldID, id),
+			sqlgraph.To(segment.Table, segment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, segmenteffort.SegmentTable, segmenteffort.SegmentColumn),
+		)
+		fromV = sqlgraph.Neighbors(se.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryActivity queries the activity edge of a SegmentEffort.
+func (c *SegmentEffortClient) QueryActivity(se *SegmentEffort) *ActivityQuery {
+	query := (&ActivityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := se.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(segmenteffort.Table, segmenteffort.FieldID, id),
+			sqlgraph.To(activity.Table, activity.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, segmenteffort.ActivityTable, segmenteffort.ActivityColumn),
+		)
+		fromV = sqlgraph.Neighbors(se.driver.Dialect(), step)
 		return fromV, nil
 	}
-	return query
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SegmentEffortClient) Hooks() []Hook {
+	return c.hooks.SegmentEffort
+}
+
+// Interceptors returns the client interceptors.
+func (c *SegmentEffortClient) Interceptors() []Interceptor {
+	return c.inters.SegmentEffort
+}
+
+func (c *SegmentEffortClient) mutate(ctx context.Context, m *SegmentEffortMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SegmentEffortCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SegmentEffortUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SegmentEffortUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SegmentEffortDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SegmentEffort mutation op: %q", m.Op())
+	}
+}
+
+// SettingClient is a client for the Setting schema.
+type SettingClient struct {
+	config
+}
+
+// NewSettingClient returns a client for the Setting from the given config.
+func NewSettingClient(c config) *SettingClient {
+	return &SettingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `setting.Hooks(f(g(h())))`.
+func (c *SettingClient) Use(hooks ...Hook) {
+	c.hooks.Setting = append(c.hooks.Setting, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `setting.Intercept(f(g(h())))`.
+func (c *SettingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Setting = append(c.inters.Setting, interceptors...)
+}
+
+// Create returns a builder for creating a Setting entity.
+func (c *SettingClient) Create() *SettingCreate {
+	mutation := newSettingMutation(c.config, OpCreate)
+	return &SettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Setting entities.
+func (c *SettingClient) CreateBulk(builders ...*SettingCreate) *SettingCreateBulk {
+	return &SettingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SettingClient) MapCreateBulk(slice any, setFunc func(*SettingCreate, int)) *SettingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SettingCreateBulk{err: fmt.Errorf("calling to SettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SettingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SettingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Setting.
+func (c *SettingClient) Update() *SettingUpdate {
+	mutation := newSettingMutation(c.config, OpUpdate)
+	return &SettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SettingClient) UpdateOne(s *Setting) *SettingUpdateOne {
+	mutation := newSettingMutation(c.config, OpUpdateOne, withSetting(s))
+	return &SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SettingClient) UpdateOneID(id string) *SettingUpdateOne {
+	mutation := newSettingMutation(c.config, OpUpdateOne, withSettingID(id))
+	return &SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Setting.
+func (c *SettingClient) Delete() *SettingDelete {
+	mutation := newSettingMutation(c.config, OpDelete)
+	return &SettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SettingClient) DeleteOne(s *Setting) *SettingDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SettingClient) DeleteOneID(id string) *SettingDeleteOne {
+	builder := c.Delete().Where(setting.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SettingDeleteOne{builder}
+}
+
+// Query returns a query builder for Setting.
+func (c *SettingClient) Query() *SettingQuery {
+	return &SettingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSetting},
+		inters: c.Interceptors(),
+	}
 }
 
-// QueryUsers queries the users edge of a HexInfluence.
-func (c *HexInfluenceClient) QueryUsers(hi *HexInfluence) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := hi.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(hexinfluence.Table, hexinfluence.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, hexinfluence.UsersTable, hexinfluence.UsersColumn),
-		)
-		fromV = sqlgraph.Neighbors(hi.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a Setting entity by its id.
+func (c *SettingClient) Get(ctx context.Context, id string) (*Setting, error) {
+	return c.Query().Where(setting.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SettingClient) GetX(ctx context.Context, id string) *Setting {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *HexInfluenceClient) Hooks() []Hook {
-	return c.hooks.HexInfluence
+func (c *SettingClient) Hooks() []Hook {
+	return c.hooks.Setting
 }
 
 // Interceptors returns the client interceptors.
-func (c *HexInfluenceClient) Interceptors() []Interceptor {
-	return c.inters.HexInfluence
+func (c *SettingClient) Interceptors() []Interceptor {
+	return c.inters.Setting
 }
 
-func (c *HexInfluenceClient) mutate(ctx context.Context, m *HexInfluenceMutation) (Value, error) {
+func (c *SettingClient) mutate(ctx context.Context, m *SettingMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&HexInfluenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&HexInfluenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&HexInfluenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&HexInfluenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown HexInfluence mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Setting mutation op: %q", m.Op())
 	}
 }
 
-// HexLeaderboardClient is a client for the HexLeaderboard schema.
-type HexLeaderboardClient struct {
+// StreakClient is a client for the Streak schema.
+type StreakClient struct {
 	config
 }
 
-// NewHexLeaderboardClient returns a client for the HexLeaderboard from the given config.
-func NewHexLeaderboardClient(c config) *HexLeaderboardClient {
-	return &HexLeaderboardClient{config: c}
+// NewStreakClient returns a client for the Streak from the given config.
+func NewStreakClient(c config) *StreakClient {
+	return &StreakClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `hexleaderboard.Hooks(f(g(h())))`.
-func (c *HexLeaderboardClient) Use(hooks ...Hook) {
-	c.hooks.HexLeaderboard = append(c.hooks.HexLeaderboard, hooks...)
+// A call to `Use(f, g, h)` equals to `streak.Hooks(f(g(h())))`.
+func (c *StreakClient) Use(hooks ...Hook) {
+	c.hooks.Streak = append(c.hooks.Streak, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `hexleaderboard.Intercept(f(g(h())))`.
-func (c *HexLeaderboardClient) Intercept(interceptors ...Interceptor) {
-	c.inters.HexLeaderboard = append(c.inters.HexLeaderboard, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `streak.Intercept(f(g(h())))`.
+func (c *StreakClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Streak = append(c.inters.Streak, interceptors...)
 }
 
-// Create returns a builder for creating a HexLeaderboard entity.
-func (c *HexLeaderboardClient) Create() *HexLeaderboardCreate {
-	mutation := newHexLeaderboardMutation(c.config, OpCreate)
-	return &HexLeaderboardCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Streak entity.
+func (c *StreakClient) Create() *StreakCreate {
+	mutation := newStreakMutation(c.config, OpCreate)
+	return &StreakCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of HexLeaderboard entities.
-func (c *HexLeaderboardClient) CreateBulk(builders ...*HexLeaderboardCreate) *HexLeaderboardCreateBulk {
-	return &HexLeaderboardCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Streak entities.
+func (c *StreakClient) CreateBulk(builders ...*StreakCreate) *StreakCreateBulk {
+	return &StreakCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *HexLeaderboardClient) MapCreateBulk(slice any, setFunc func(*HexLeaderboardCreate, int)) *HexLeaderboardCreateBulk {
+func (c *StreakClient) MapCreateBulk(slice any, setFunc func(*StreakCreate, int)) *StreakCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &HexLeaderboardCreateBulk{err: fmt.Errorf("calling to HexLeaderboardClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &StreakCreateBulk{err: fmt.Errorf("calling to StreakClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*HexLeaderboardCreate, rv.Len())
+	builders := make([]*StreakCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &HexLeaderboardCreateBulk{config: c.config, builders: builders}
+	return &StreakCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for HexLeaderboard.
-func (c *HexLeaderboardClient) Update() *HexLeaderboardUpdate {
-	mutation := newHexLeaderboardMutation(c.config, OpUpdate)
-	return &HexLeaderboardUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Streak.
+func (c *StreakClient) Update() *StreakUpdate {
+	mutation := newStreakMutation(c.config, OpUpdate)
+	return &StreakUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *HexLeaderboardClient) UpdateOne(hl *HexLeaderboard) *HexLeaderboardUpdateOne {
-	mutation := newHexLeaderboardMutation(c.config, OpUpdateOne, withHexLeaderboard(hl))
-	return &HexLeaderboardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *StreakClient) UpdateOne(s *Streak) *StreakUpdateOne {
+	mutation := newStreakMutation(c.config, OpUpdateOne, withStreak(s))
+	return &StreakUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *HexLeaderboardClient) UpdateOneID(id uuid.UUID) *HexLeaderboardUpdateOne {
-	mutation := newHexLeaderboardMutation(c.config, OpUpdateOne, withHexLeaderboardID(id))
-	return &HexLeaderboardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *StreakClient) UpdateOneID(id uuid.UUID) *StreakUpdateOne {
+	mutation := newStreakMutation(c.config, OpUpdateOne, withStreakID(id))
+	return &StreakUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for HexLeaderboard.
-func (c *HexLeaderboardClient) Delete() *HexLeaderboardDelete {
-	mutation := newHexLeaderboardMutation(c.config, OpDelete)
-	return &HexLeaderboardDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Streak.
+func (c *StreakClient) Delete() *StreakDelete {
+	mutation := newStreakMutation(c.config, OpDelete)
+	return &StreakDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *HexLeaderboardClient) DeleteOne(hl *HexLeaderboard) *HexLeaderboardDeleteOne {
-	return c.DeleteOneID(hl.ID)
+func (c *StreakClient) DeleteOne(s *Streak) *StreakDeleteOne {
+	return c.DeleteOneID(s.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *HexLeaderboardClient) DeleteOneID(id uuid.UUID) *HexLeaderboardDeleteOne {
-	builder := c.Delete().Where(hexleaderboard.ID(id))
+func (c *StreakClient) DeleteOneID(id uuid.UUID) *StreakDeleteOne {
+	builder := c.Delete().Where(streak.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &HexLeaderboardDeleteOne{builder}
+	return &StreakDeleteOne{builder}
 }
 
-// Query returns a query builder for HexLeaderboard.
-func (c *HexLeaderboardClient) Query() *HexLeaderboardQuery {
-	return &HexLeaderboardQuery{
+// Query returns a query builder for Streak.
+func (c *StreakClient) Query() *StreakQuery {
+	return &StreakQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeHexLeaderboard},
+		ctx:    &QueryContext{Type: TypeStreak},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a HexLeaderboard entity by its id.
-func (c *HexLeaderboardClient) Get(ctx context.Context, id uuid.UUID) (*HexLeaderboard, error) {
-	return c.Query().Where(hexleaderboard.ID(id)).Only(ctx)
+// Get returns a Streak entity by its id.
+func (c *StreakClient) Get(ctx context.Context, id uuid.UUID) (*Streak, error) {
+	return c.Query().Where(streak.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *HexLeaderboardClient) GetX(ctx context.Context, id uuid.UUID) *HexLeaderboard {
+func (c *StreakClient) GetX(ctx context.Context, id uuid.UUID) *Streak {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -999,44 +4760,28 @@ func (c *HexLeaderboardClient) GetX(ctx context.Context, id uuid.UUID) *HexLeade
 	return obj
 }
 
-// QueryHex queries the hex edge of a HexLeaderboard.
-func (c *HexLeaderboardClient) QueryHex(hl *HexLeaderboard) *HexQuery {
-	query := (&HexClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := hl.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(hexleaderboard.Table, hexleaderboard.FieldID, id),
-			sqlgraph.To(hex.Table, hex.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, hexleaderboard.HexTable, hexleaderboard.HexColumn),
-		)
-		fromV = sqlgraph.Neighbors(hl.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *HexLeaderboardClient) Hooks() []Hook {
-	return c.hooks.HexLeaderboard
+func (c *StreakClient) Hooks() []Hook {
+	return c.hooks.Streak
 }
 
 // Interceptors returns the client interceptors.
-func (c *HexLeaderboardClient) Interceptors() []Interceptor {
-	return c.inters.HexLeaderboard
+func (c *StreakClient) Interceptors() []Interceptor {
+	return c.inters.Streak
 }
 
-func (c *HexLeaderboardClient) mutate(ctx context.Context, m *HexLeaderboardMutation) (Value, error) {
+func (c *StreakClient) mutate(ctx context.Context, m *StreakMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&HexLeaderboardCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StreakCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&HexLeaderboardUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StreakUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&HexLeaderboardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StreakUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&HexLeaderboardDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&StreakDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown HexLeaderboard mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Streak mutation op: %q", m.Op())
 	}
 }
 
@@ -1221,12 +4966,288 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 	}
 }
 
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(wd *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(wd))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id uuid.UUID) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(wd *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(wd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookDeliveryClient) DeleteOneID(id uuid.UUID) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id uuid.UUID) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id uuid.UUID) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebhookDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
+}
+
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookDelivery mutation op: %q", m.Op())
+	}
+}
+
+// WebhookSubscriptionClient is a client for the WebhookSubscription schema.
+type WebhookSubscriptionClient struct {
+	config
+}
+
+// NewWebhookSubscriptionClient returns a client for the WebhookSubscription from the given config.
+func NewWebhookSubscriptionClient(c config) *WebhookSubscriptionClient {
+	return &WebhookSubscriptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhooksubscription.Hooks(f(g(h())))`.
+func (c *WebhookSubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.WebhookSubscription = append(c.hooks.WebhookSubscription, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhooksubscription.Intercept(f(g(h())))`.
+func (c *WebhookSubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookSubscription = append(c.inters.WebhookSubscription, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookSubscription entity.
+func (c *WebhookSubscriptionClient) Create() *WebhookSubscriptionCreate {
+	mutation := newWebhookSubscriptionMutation(c.config, OpCreate)
+	return &WebhookSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookSubscription entities.
+func (c *WebhookSubscriptionClient) CreateBulk(builders ...*WebhookSubscriptionCreate) *WebhookSubscriptionCreateBulk {
+	return &WebhookSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookSubscriptionClient) MapCreateBulk(slice any, setFunc func(*WebhookSubscriptionCreate, int)) *WebhookSubscriptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookSubscriptionCreateBulk{err: fmt.Errorf("calling to WebhookSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookSubscriptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookSubscription.
+func (c *WebhookSubscriptionClient) Update() *WebhookSubscriptionUpdate {
+	mutation := newWebhookSubscriptionMutation(c.config, OpUpdate)
+	return &WebhookSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookSubscriptionClient) UpdateOne(ws *WebhookSubscription) *WebhookSubscriptionUpdateOne {
+	mutation := newWebhookSubscriptionMutation(c.config, OpUpdateOne, withWebhookSubscription(ws))
+	return &WebhookSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookSubscriptionClient) UpdateOneID(id uuid.UUID) *WebhookSubscriptionUpdateOne {
+	mutation := newWebhookSubscriptionMutation(c.config, OpUpdateOne, withWebhookSubscriptionID(id))
+	return &WebhookSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookSubscription.
+func (c *WebhookSubscriptionClient) Delete() *WebhookSubscriptionDelete {
+	mutation := newWebhookSubscriptionMutation(c.config, OpDelete)
+	return &WebhookSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookSubscriptionClient) DeleteOne(ws *WebhookSubscription) *WebhookSubscriptionDeleteOne {
+	return c.DeleteOneID(ws.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookSubscriptionClient) DeleteOneID(id uuid.UUID) *WebhookSubscriptionDeleteOne {
+	builder := c.Delete().Where(webhooksubscription.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookSubscriptionDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookSubscription.
+func (c *WebhookSubscriptionClient) Query() *WebhookSubscriptionQuery {
+	return &WebhookSubscriptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookSubscription},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookSubscription entity by its id.
+func (c *WebhookSubscriptionClient) Get(ctx context.Context, id uuid.UUID) (*WebhookSubscription, error) {
+	return c.Query().Where(webhooksubscription.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookSubscriptionClient) GetX(ctx context.Context, id uuid.UUID) *WebhookSubscription {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookSubscriptionClient) Hooks() []Hook {
+	return c.hooks.WebhookSubscription
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookSubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.WebhookSubscription
+}
+
+func (c *WebhookSubscriptionClient) mutate(ctx context.Context, m *WebhookSubscriptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookSubscription mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Activity, Friendship, Hex, HexInfluence, HexLeaderboard, User []ent.Hook
+		Activity, ActivityHex, ActivityJob, ActivitySession, DecaySweep, Friendship,
+		Goal, Hex, HexCapture, HexInfluence, HexLeaderboard, HexRollup, IdempotencyKey,
+		InfluenceHistory, Notification, NotificationPreference, PersonalRecord,
+		PrivacyZone, PushDevice, PushMessage, Region, RegionHex, RegionStanding,
+		Season, SeasonHexStanding, SeasonStanding, Segment, SegmentEffort, Setting,
+		Streak, User, WebhookDelivery, WebhookSubscription []ent.Hook
 	}
 	inters struct {
-		Activity, Friendship, Hex, HexInfluence, HexLeaderboard, User []ent.Interceptor
+		Activity, ActivityHex, ActivityJob, ActivitySession, DecaySweep, Friendship,
+		Goal, Hex, HexCapture, HexInfluence, HexLeaderboard, HexRollup, IdempotencyKey,
+		InfluenceHistory, Notification, NotificationPreference, PersonalRecord,
+		PrivacyZone, PushDevice, PushMessage, Region, RegionHex, RegionStanding,
+		Season, SeasonHexStanding, SeasonStanding, Segment, SegmentEffort, Setting,
+		Streak, User, WebhookDelivery, WebhookSubscription []ent.Interceptor
 	}
 )
@@ -8,11 +8,38 @@ import (
 	"fmt"
 	"reflect"
 	"stride-wars-app/ent/activity"
+	"stride-wars-app/ent/activityhex"
+	"stride-wars-app/ent/activityjob"
+	"stride-wars-app/ent/activitysession"
+	"stride-wars-app/ent/decaysweep"
 	"stride-wars-app/ent/friendship"
+	"stride-wars-app/ent/goal"
 	"stride-wars-app/ent/hex"
+	"stride-wars-app/ent/hexcapture"
 	"stride-wars-app/ent/hexinfluence"
 	"stride-wars-app/ent/hexleaderboard"
+	"stride-wars-app/ent/hexrollup"
+	"stride-wars-app/ent/idempotencykey"
+	"stride-wars-app/ent/influencehistory"
+	"stride-wars-app/ent/notification"
+	"stride-wars-app/ent/notificationpreference"
+	"stride-wars-app/ent/personalrecord"
+	"stride-wars-app/ent/privacyzone"
+	"stride-wars-app/ent/pushdevice"
+	"stride-wars-app/ent/pushmessage"
+	"stride-wars-app/ent/region"
+	"stride-wars-app/ent/regionhex"
+	"stride-wars-app/ent/regionstanding"
+	"stride-wars-app/ent/season"
+	"stride-wars-app/ent/seasonhexstanding"
+	"stride-wars-app/ent/seasonstanding"
+	"stride-wars-app/ent/segment"
+	"stride-wars-app/ent/segmenteffort"
+	"stride-wars-app/ent/setting"
+	"stride-wars-app/ent/streak"
 	"stride-wars-app/ent/user"
+	"stride-wars-app/ent/webhookdelivery"
+	"stride-wars-app/ent/webhooksubscription"
 	"sync"
 
 	"entgo.io/ent"
@@ -78,12 +105,39 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			activity.Table:       activity.ValidColumn,
-			friendship.Table:     friendship.ValidColumn,
-			hex.Table:            hex.ValidColumn,
-			hexinfluence.Table:   hexinfluence.ValidColumn,
-			hexleaderboard.Table: hexleaderboard.ValidColumn,
-			user.Table:           user.ValidColumn,
+			activity.Table:               activity.ValidColumn,
+			activityhex.Table:            activityhex.ValidColumn,
+			activityjob.Table:            activityjob.ValidColumn,
+			activitysession.Table:        activitysession.ValidColumn,
+			decaysweep.Table:             decaysweep.ValidColumn,
+			friendship.Table:             friendship.ValidColumn,
+			goal.Table:                   goal.ValidColumn,
+			hex.Table:                    hex.ValidColumn,
+			hexcapture.Table:             hexcapture.ValidColumn,
+			hexinfluence.Table:           hexinfluence.ValidColumn,
+			hexleaderboard.Table:         hexleaderboard.ValidColumn,
+			hexrollup.Table:              hexrollup.ValidColumn,
+			idempotencykey.Table:         idempotencykey.ValidColumn,
+			influencehistory.Table:       influencehistory.ValidColumn,
+			notification.Table:           notification.ValidColumn,
+			notificationpreference.Table: notificationpreference.ValidColumn,
+			personalrecord.Table:         personalrecord.ValidColumn,
+			privacyzone.Table:            privacyzone.ValidColumn,
+			pushdevice.Table:             pushdevice.ValidColumn,
+			pushmessage.Table:            pushmessage.ValidColumn,
+			region.Table:                 region.ValidColumn,
+			regionhex.Table:              regionhex.ValidColumn,
+			regionstanding.Table:         regionstanding.ValidColumn,
+			season.Table:                 season.ValidColumn,
+			seasonhexstanding.Table:      seasonhexstanding.ValidColumn,
+			seasonstanding.Table:         seasonstanding.ValidColumn,
+			segment.Table:                segment.ValidColumn,
+			segmenteffort.Table:          segmenteffort.ValidColumn,
+			setting.Table:                setting.ValidColumn,
+			streak.Table:                 streak.ValidColumn,
+			user.Table:                   user.ValidColumn,
+			webhookdelivery.Table:        webhookdelivery.ValidColumn,
+			webhooksubscription.Table:    webhooksubscription.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
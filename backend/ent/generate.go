@@ -1,3 +1,3 @@
 package ent
 
-//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate ./model
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --feature sql/modifier,sql/upsert ./model
@@ -4,11 +4,14 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"stride-wars-app/ent/hex"
 	"stride-wars-app/ent/hexinfluence"
 	"stride-wars-app/ent/hexleaderboard"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -19,6 +22,7 @@ type HexCreate struct {
 	config
 	mutation *HexMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetID sets the "id" field.
@@ -122,6 +126,7 @@ func (hc *HexCreate) createSpec() (*Hex, *sqlgraph.CreateSpec) {
 		_node = &Hex{config: hc.config}
 		_spec = sqlgraph.NewCreateSpec(hex.Table, sqlgraph.NewFieldSpec(hex.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = hc.conflict
 	if id, ok := hc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -161,11 +166,141 @@ func (hc *HexCreate) createSpec() (*Hex, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Hex.Create().
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (hc *HexCreate) OnConflict(opts ...sql.ConflictOption) *HexUpsertOne {
+	hc.conflict = opts
+	return &HexUpsertOne{
+		create: hc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Hex.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (hc *HexCreate) OnConflictColumns(columns ...string) *HexUpsertOne {
+	hc.conflict = append(hc.conflict, sql.ConflictColumns(columns...))
+	return &HexUpsertOne{
+		create: hc,
+	}
+}
+
+type (
+	// HexUpsertOne is the builder for "upsert"-ing
+	//  one Hex node.
+	HexUpsertOne struct {
+		create *HexCreate
+	}
+
+	// HexUpsert is the "OnConflict" setter.
+	HexUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Hex.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(hex.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *HexUpsertOne) UpdateNewValues() *HexUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(hex.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Hex.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *HexUpsertOne) Ignore() *HexUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HexUpsertOne) DoNothing() *HexUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HexCreate.OnConflict
+// documentation for more info.
+func (u *HexUpsertOne) Update(set func(*HexUpsert)) *HexUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HexUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *HexUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HexCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HexUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *HexUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: HexUpsertOne.ID is not supported by MySQL driver. Use HexUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *HexUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // HexCreateBulk is the builder for creating many Hex entities in bulk.
 type HexCreateBulk struct {
 	config
 	err      error
 	builders []*HexCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Hex entities in the database.
@@ -194,6 +329,7 @@ func (hcb *HexCreateBulk) Save(ctx context.Context) ([]*Hex, error) {
 					_, err = mutators[i+1].Mutate(root, hcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = hcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, hcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -243,3 +379,112 @@ func (hcb *HexCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Hex.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (hcb *HexCreateBulk) OnConflict(opts ...sql.ConflictOption) *HexUpsertBulk {
+	hcb.conflict = opts
+	return &HexUpsertBulk{
+		create: hcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Hex.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (hcb *HexCreateBulk) OnConflictColumns(columns ...string) *HexUpsertBulk {
+	hcb.conflict = append(hcb.conflict, sql.ConflictColumns(columns...))
+	return &HexUpsertBulk{
+		create: hcb,
+	}
+}
+
+// HexUpsertBulk is the builder for "upsert"-ing
+// a bulk of Hex nodes.
+type HexUpsertBulk struct {
+	create *HexCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Hex.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(hex.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *HexUpsertBulk) UpdateNewValues() *HexUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(hex.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Hex.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *HexUpsertBulk) Ignore() *HexUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HexUpsertBulk) DoNothing() *HexUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HexCreateBulk.OnConflict
+// documentation for more info.
+func (u *HexUpsertBulk) Update(set func(*HexUpsert)) *HexUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HexUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *HexUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the HexCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HexCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HexUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -27,6 +27,7 @@ type HexQuery struct {
 	predicates          []predicate.Hex
 	withHexinfluences   *HexInfluenceQuery
 	withHexleaderboards *HexLeaderboardQuery
+	modifiers           []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -302,8 +303,9 @@ func (hq *HexQuery) Clone() *HexQuery {
 		withHexinfluences:   hq.withHexinfluences.Clone(),
 		withHexleaderboards: hq.withHexleaderboards.Clone(),
 		// clone intermediate query.
-		sql:  hq.sql.Clone(),
-		path: hq.path,
+		sql:       hq.sql.Clone(),
+		path:      hq.path,
+		modifiers: append([]func(*sql.Selector){}, hq.modifiers...),
 	}
 }
 
@@ -399,6 +401,9 @@ func (hq *HexQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Hex, err
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(hq.modifiers) > 0 {
+		_spec.Modifiers = hq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -488,6 +493,9 @@ func (hq *HexQuery) loadHexleaderboards(ctx context.Context, query *HexLeaderboa
 
 func (hq *HexQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := hq.querySpec()
+	if len(hq.modifiers) > 0 {
+		_spec.Modifiers = hq.modifiers
+	}
 	_spec.Node.Columns = hq.ctx.Fields
 	if len(hq.ctx.Fields) > 0 {
 		_spec.Unique = hq.ctx.Unique != nil && *hq.ctx.Unique
@@ -550,6 +558,9 @@ func (hq *HexQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if hq.ctx.Unique != nil && *hq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range hq.modifiers {
+		m(selector)
+	}
 	for _, p := range hq.predicates {
 		p(selector)
 	}
@@ -567,6 +578,12 @@ func (hq *HexQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (hq *HexQuery) Modify(modifiers ...func(s *sql.Selector)) *HexSelect {
+	hq.modifiers = append(hq.modifiers, modifiers...)
+	return hq.Select()
+}
+
 // HexGroupBy is the group-by builder for Hex entities.
 type HexGroupBy struct {
 	selector
@@ -656,3 +673,9 @@ func (hs *HexSelect) sqlScan(ctx context.Context, root *HexQuery, v any) error {
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (hs *HexSelect) Modify(modifiers ...func(s *sql.Selector)) *HexSelect {
+	hs.modifiers = append(hs.modifiers, modifiers...)
+	return hs
+}
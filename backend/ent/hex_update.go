@@ -20,8 +20,9 @@ import (
 // HexUpdate is the builder for updating Hex entities.
 type HexUpdate struct {
 	config
-	hooks    []Hook
-	mutation *HexMutation
+	hooks     []Hook
+	mutation  *HexMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the HexUpdate builder.
@@ -134,6 +135,12 @@ func (hu *HexUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (hu *HexUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *HexUpdate {
+	hu.modifiers = append(hu.modifiers, modifiers...)
+	return hu
+}
+
 func (hu *HexUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(hex.Table, hex.Columns, sqlgraph.NewFieldSpec(hex.FieldID, field.TypeString))
 	if ps := hu.mutation.predicates; len(ps) > 0 {
@@ -233,6 +240,7 @@ func (hu *HexUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(hu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, hu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{hex.Label}
@@ -248,9 +256,10 @@ func (hu *HexUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // HexUpdateOne is the builder for updating a single Hex entity.
 type HexUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *HexMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *HexMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // AddHexinfluenceIDs adds the "hexinfluences" edge to the HexInfluence entity by IDs.
@@ -370,6 +379,12 @@ func (huo *HexUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (huo *HexUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *HexUpdateOne {
+	huo.modifiers = append(huo.modifiers, modifiers...)
+	return huo
+}
+
 func (huo *HexUpdateOne) sqlSave(ctx context.Context) (_node *Hex, err error) {
 	_spec := sqlgraph.NewUpdateSpec(hex.Table, hex.Columns, sqlgraph.NewFieldSpec(hex.FieldID, field.TypeString))
 	id, ok := huo.mutation.ID()
@@ -486,6 +501,7 @@ func (huo *HexUpdateOne) sqlSave(ctx context.Context) (_node *Hex, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(huo.modifiers...)
 	_node = &Hex{config: huo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -28,6 +28,10 @@ type HexInfluence struct {
 	Score float64 `json:"score,omitempty"`
 	// LastUpdated holds the value of the "last_updated" field.
 	LastUpdated time.Time `json:"last_updated,omitempty"`
+	// CarriedScore holds the value of the "carried_score" field.
+	CarriedScore float64 `json:"carried_score,omitempty"`
+	// CarriedAt holds the value of the "carried_at" field.
+	CarriedAt *time.Time `json:"carried_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the HexInfluenceQuery when eager-loading is set.
 	Edges        HexInfluenceEdges `json:"edges"`
@@ -72,11 +76,11 @@ func (*HexInfluence) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case hexinfluence.FieldScore:
+		case hexinfluence.FieldScore, hexinfluence.FieldCarriedScore:
 			values[i] = new(sql.NullFloat64)
 		case hexinfluence.FieldH3Index:
 			values[i] = new(sql.NullString)
-		case hexinfluence.FieldLastUpdated:
+		case hexinfluence.FieldLastUpdated, hexinfluence.FieldCarriedAt:
 			values[i] = new(sql.NullTime)
 		case hexinfluence.FieldID, hexinfluence.FieldUserID:
 			values[i] = new(uuid.UUID)
@@ -125,6 +129,19 @@ func (hi *HexInfluence) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				hi.LastUpdated = value.Time
 			}
+		case hexinfluence.FieldCarriedScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field carried_score", values[i])
+			} else if value.Valid {
+				hi.CarriedScore = value.Float64
+			}
+		case hexinfluence.FieldCarriedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field carried_at", values[i])
+			} else if value.Valid {
+				hi.CarriedAt = new(time.Time)
+				*hi.CarriedAt = value.Time
+			}
 		default:
 			hi.selectValues.Set(columns[i], values[i])
 		}
@@ -182,6 +199,14 @@ func (hi *HexInfluence) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("last_updated=")
 	builder.WriteString(hi.LastUpdated.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("carried_score=")
+	builder.WriteString(fmt.Sprintf("%v", hi.CarriedScore))
+	builder.WriteString(", ")
+	if v := hi.CarriedAt; v != nil {
+		builder.WriteString("carried_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -21,6 +21,10 @@ const (
 	FieldScore = "score"
 	// FieldLastUpdated holds the string denoting the last_updated field in the database.
 	FieldLastUpdated = "last_updated"
+	// FieldCarriedScore holds the string denoting the carried_score field in the database.
+	FieldCarriedScore = "carried_score"
+	// FieldCarriedAt holds the string denoting the carried_at field in the database.
+	FieldCarriedAt = "carried_at"
 	// EdgeHex holds the string denoting the hex edge name in mutations.
 	EdgeHex = "hex"
 	// EdgeUsers holds the string denoting the users edge name in mutations.
@@ -50,6 +54,8 @@ var Columns = []string{
 	FieldUserID,
 	FieldScore,
 	FieldLastUpdated,
+	FieldCarriedScore,
+	FieldCarriedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -63,6 +69,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultCarriedScore holds the default value on creation for the "carried_score" field.
+	DefaultCarriedScore float64
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -95,6 +103,16 @@ func ByLastUpdated(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastUpdated, opts...).ToFunc()
 }
 
+// ByCarriedScore orders the results by the carried_score field.
+func ByCarriedScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCarriedScore, opts...).ToFunc()
+}
+
+// ByCarriedAt orders the results by the carried_at field.
+func ByCarriedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCarriedAt, opts...).ToFunc()
+}
+
 // ByHexField orders the results by hex field.
 func ByHexField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -76,6 +76,16 @@ func LastUpdated(v time.Time) predicate.HexInfluence {
 	return predicate.HexInfluence(sql.FieldEQ(FieldLastUpdated, v))
 }
 
+// CarriedScore applies equality check predicate on the "carried_score" field. It's identical to CarriedScoreEQ.
+func CarriedScore(v float64) predicate.HexInfluence {
+	return predicate.HexInfluence(sql.FieldEQ(FieldCarriedScore, v))
+}
+
+// CarriedAt applies equality check predicate on the "carried_at" field. It's identical to CarriedAtEQ.
+func CarriedAt(v time.Time) predicate.HexInfluence {
+	return predicate.HexInfluence(sql.FieldEQ(FieldCarriedAt, v))
+}
+
 // H3IndexEQ applies the EQ predicate on the "h3_index" field.
 func H3IndexEQ(v string) predicate.HexInfluence {
 	return predicate.HexInfluence(sql.FieldEQ(FieldH3Index, v))
@@ -241,6 +251,96 @@ func LastUpdatedLTE(v time.Time) predicate.HexInfluence {
 	return predicate.HexInfluence(sql.FieldLTE(FieldLastUpdated, v))
 }
 
+// CarriedScoreEQ applies the EQ predicate on the "carried_score" field.
+func CarriedScoreEQ(v float64) predicate.HexInfluence {
+	return predicate.HexInfluence(sql.FieldEQ(FieldCarriedScore, v))
+}
+
+// CarriedScoreNEQ applies the NEQ predicate on the "carried_score" field.
+func CarriedScoreNEQ(v float64) predicate.HexInfluence {
+	return predicate.HexInfluence(sql.FieldNEQ(FieldCarriedScore, v))
+}
+
+// CarriedScoreIn applies the In predicate on the "carried_score" field.
+func CarriedScoreIn(vs ...float64) predicate.HexInfluence {
+	return predicate.HexInfluence(sql.FieldIn(FieldCarriedScore, vs...))
+}
+
+// CarriedScoreNotIn applies the NotIn predicate on the "carried_score" field.
+func CarriedScoreNotIn(vs ...float64) predicate.HexInfluence {
+	return predicate.HexInfluence(sql.FieldNotIn(FieldCarriedScore, vs...))
+}
+
+// CarriedScoreGT applies the GT predicate on the "carried_score" field.
+func CarriedScoreGT(v float64) predicate.HexInfluence {
+	return predicate.HexInfluence(sql.FieldGT(FieldCarriedScore, v))
+}
+
+// CarriedScoreGTE applies the GTE predicate on the "carried_score" field.
+func CarriedScoreGTE(v float64) predicate.HexInfluence {
+	return predicate.HexInfluence(sql.FieldGTE(FieldCarriedScore, v))
+}
+
+// CarriedScoreLT applies the LT predicate on the "carried_score" field.
+func CarriedScoreLT(v float64) predicate.HexInfluence {
+	return predicate.HexInfluence(sql.FieldLT(FieldCarriedScore, v))
+}
+
+// CarriedScoreLTE applies the LTE predicate on the "carried_score" field.
+func CarriedScoreLTE(v float64) predicate.HexInfluence {
+	return predicate.HexInfluence(sql.FieldLTE(FieldCarriedScore, v))
+}
+
+// CarriedAtEQ applies the EQ predicate on the "carried_at" field.
+func CarriedAtEQ(v time.Time) predicate.HexInfluence {
+	return predicate.HexInfluence(sql.FieldEQ(FieldCarriedAt, v))
+}
+
+// CarriedAtNEQ applies the NEQ predicate on the "carried_at" field.
+func CarriedAtNEQ(v time.Time) predicate.HexInfluence {
+	return predicate.HexInfluence(sql.FieldNEQ(FieldCarriedAt, v))
+}
+
+// CarriedAtIn applies the In predicate on the "carried_at" field.
+func CarriedAtIn(vs ...time.Time) predicate.HexInfluence {
+	return predicate.HexInfluence(sql.FieldIn(FieldCarriedAt, vs...))
+}
+
+// CarriedAtNotIn applies the NotIn predicate on the "carried_at" field.
+func CarriedAtNotIn(vs ...time.Time) predicate.HexInfluence {
+	return predicate.HexInfluence(sql.FieldNotIn(FieldCarriedAt, vs...))
+}
+
+// CarriedAtGT applies the GT predicate on the "carried_at" field.
+func CarriedAtGT(v time.Time) predicate.HexInfluence {
+	return predicate.HexInfluence(sql.FieldGT(FieldCarriedAt, v))
+}
+
+// CarriedAtGTE applies the GTE predicate on the "carried_at" field.
+func CarriedAtGTE(v time.Time) predicate.HexInfluence {
+	return predicate.HexInfluence(sql.FieldGTE(FieldCarriedAt, v))
+}
+
+// CarriedAtLT applies the LT predicate on the "carried_at" field.
+func CarriedAtLT(v time.Time) predicate.HexInfluence {
+	return predicate.HexInfluence(sql.FieldLT(FieldCarriedAt, v))
+}
+
+// CarriedAtLTE applies the LTE predicate on the "carried_at" field.
+func CarriedAtLTE(v time.Time) predicate.HexInfluence {
+	return predicate.HexInfluence(sql.FieldLTE(FieldCarriedAt, v))
+}
+
+// CarriedAtIsNil applies the IsNil predicate on the "carried_at" field.
+func CarriedAtIsNil() predicate.HexInfluence {
+	return predicate.HexInfluence(sql.FieldIsNull(FieldCarriedAt))
+}
+
+// CarriedAtNotNil applies the NotNil predicate on the "carried_at" field.
+func CarriedAtNotNil() predicate.HexInfluence {
+	return predicate.HexInfluence(sql.FieldNotNull(FieldCarriedAt))
+}
+
 // HasHex applies the HasEdge predicate on the "hex" edge.
 func HasHex() predicate.HexInfluence {
 	return predicate.HexInfluence(func(s *sql.Selector) {
@@ -11,6 +11,8 @@ import (
 	"stride-wars-app/ent/user"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -21,6 +23,7 @@ type HexInfluenceCreate struct {
 	config
 	mutation *HexInfluenceMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetH3Index sets the "h3_index" field.
@@ -47,6 +50,34 @@ func (hic *HexInfluenceCreate) SetLastUpdated(t time.Time) *HexInfluenceCreate {
 	return hic
 }
 
+// SetCarriedScore sets the "carried_score" field.
+func (hic *HexInfluenceCreate) SetCarriedScore(f float64) *HexInfluenceCreate {
+	hic.mutation.SetCarriedScore(f)
+	return hic
+}
+
+// SetNillableCarriedScore sets the "carried_score" field if the given value is not nil.
+func (hic *HexInfluenceCreate) SetNillableCarriedScore(f *float64) *HexInfluenceCreate {
+	if f != nil {
+		hic.SetCarriedScore(*f)
+	}
+	return hic
+}
+
+// SetCarriedAt sets the "carried_at" field.
+func (hic *HexInfluenceCreate) SetCarriedAt(t time.Time) *HexInfluenceCreate {
+	hic.mutation.SetCarriedAt(t)
+	return hic
+}
+
+// SetNillableCarriedAt sets the "carried_at" field if the given value is not nil.
+func (hic *HexInfluenceCreate) SetNillableCarriedAt(t *time.Time) *HexInfluenceCreate {
+	if t != nil {
+		hic.SetCarriedAt(*t)
+	}
+	return hic
+}
+
 // SetID sets the "id" field.
 func (hic *HexInfluenceCreate) SetID(u uuid.UUID) *HexInfluenceCreate {
 	hic.mutation.SetID(u)
@@ -118,6 +149,10 @@ func (hic *HexInfluenceCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (hic *HexInfluenceCreate) defaults() {
+	if _, ok := hic.mutation.CarriedScore(); !ok {
+		v := hexinfluence.DefaultCarriedScore
+		hic.mutation.SetCarriedScore(v)
+	}
 	if _, ok := hic.mutation.ID(); !ok {
 		v := hexinfluence.DefaultID()
 		hic.mutation.SetID(v)
@@ -138,6 +173,9 @@ func (hic *HexInfluenceCreate) check() error {
 	if _, ok := hic.mutation.LastUpdated(); !ok {
 		return &ValidationError{Name: "last_updated", err: errors.New(`ent: missing required field "HexInfluence.last_updated"`)}
 	}
+	if _, ok := hic.mutation.CarriedScore(); !ok {
+		return &ValidationError{Name: "carried_score", err: errors.New(`ent: missing required field "HexInfluence.carried_score"`)}
+	}
 	if len(hic.mutation.HexIDs()) == 0 {
 		return &ValidationError{Name: "hex", err: errors.New(`ent: missing required edge "HexInfluence.hex"`)}
 	}
@@ -175,6 +213,7 @@ func (hic *HexInfluenceCreate) createSpec() (*HexInfluence, *sqlgraph.CreateSpec
 		_node = &HexInfluence{config: hic.config}
 		_spec = sqlgraph.NewCreateSpec(hexinfluence.Table, sqlgraph.NewFieldSpec(hexinfluence.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = hic.conflict
 	if id, ok := hic.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -187,6 +226,14 @@ func (hic *HexInfluenceCreate) createSpec() (*HexInfluence, *sqlgraph.CreateSpec
 		_spec.SetField(hexinfluence.FieldLastUpdated, field.TypeTime, value)
 		_node.LastUpdated = value
 	}
+	if value, ok := hic.mutation.CarriedScore(); ok {
+		_spec.SetField(hexinfluence.FieldCarriedScore, field.TypeFloat64, value)
+		_node.CarriedScore = value
+	}
+	if value, ok := hic.mutation.CarriedAt(); ok {
+		_spec.SetField(hexinfluence.FieldCarriedAt, field.TypeTime, value)
+		_node.CarriedAt = &value
+	}
 	if nodes := hic.mutation.HexIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -224,11 +271,342 @@ func (hic *HexInfluenceCreate) createSpec() (*HexInfluence, *sqlgraph.CreateSpec
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.HexInfluence.Create().
+//		SetH3Index(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HexInfluenceUpsert) {
+//			SetH3Index(v+v).
+//		}).
+//		Exec(ctx)
+func (hic *HexInfluenceCreate) OnConflict(opts ...sql.ConflictOption) *HexInfluenceUpsertOne {
+	hic.conflict = opts
+	return &HexInfluenceUpsertOne{
+		create: hic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.HexInfluence.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (hic *HexInfluenceCreate) OnConflictColumns(columns ...string) *HexInfluenceUpsertOne {
+	hic.conflict = append(hic.conflict, sql.ConflictColumns(columns...))
+	return &HexInfluenceUpsertOne{
+		create: hic,
+	}
+}
+
+type (
+	// HexInfluenceUpsertOne is the builder for "upsert"-ing
+	//  one HexInfluence node.
+	HexInfluenceUpsertOne struct {
+		create *HexInfluenceCreate
+	}
+
+	// HexInfluenceUpsert is the "OnConflict" setter.
+	HexInfluenceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetH3Index sets the "h3_index" field.
+func (u *HexInfluenceUpsert) SetH3Index(v string) *HexInfluenceUpsert {
+	u.Set(hexinfluence.FieldH3Index, v)
+	return u
+}
+
+// UpdateH3Index sets the "h3_index" field to the value that was provided on create.
+func (u *HexInfluenceUpsert) UpdateH3Index() *HexInfluenceUpsert {
+	u.SetExcluded(hexinfluence.FieldH3Index)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *HexInfluenceUpsert) SetUserID(v uuid.UUID) *HexInfluenceUpsert {
+	u.Set(hexinfluence.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *HexInfluenceUpsert) UpdateUserID() *HexInfluenceUpsert {
+	u.SetExcluded(hexinfluence.FieldUserID)
+	return u
+}
+
+// SetScore sets the "score" field.
+func (u *HexInfluenceUpsert) SetScore(v float64) *HexInfluenceUpsert {
+	u.Set(hexinfluence.FieldScore, v)
+	return u
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *HexInfluenceUpsert) UpdateScore() *HexInfluenceUpsert {
+	u.SetExcluded(hexinfluence.FieldScore)
+	return u
+}
+
+// AddScore adds v to the "score" field.
+func (u *HexInfluenceUpsert) AddScore(v float64) *HexInfluenceUpsert {
+	u.Add(hexinfluence.FieldScore, v)
+	return u
+}
+
+// SetLastUpdated sets the "last_updated" field.
+func (u *HexInfluenceUpsert) SetLastUpdated(v time.Time) *HexInfluenceUpsert {
+	u.Set(hexinfluence.FieldLastUpdated, v)
+	return u
+}
+
+// UpdateLastUpdated sets the "last_updated" field to the value that was provided on create.
+func (u *HexInfluenceUpsert) UpdateLastUpdated() *HexInfluenceUpsert {
+	u.SetExcluded(hexinfluence.FieldLastUpdated)
+	return u
+}
+
+// SetCarriedScore sets the "carried_score" field.
+func (u *HexInfluenceUpsert) SetCarriedScore(v float64) *HexInfluenceUpsert {
+	u.Set(hexinfluence.FieldCarriedScore, v)
+	return u
+}
+
+// UpdateCarriedScore sets the "carried_score" field to the value that was provided on create.
+func (u *HexInfluenceUpsert) UpdateCarriedScore() *HexInfluenceUpsert {
+	u.SetExcluded(hexinfluence.FieldCarriedScore)
+	return u
+}
+
+// AddCarriedScore adds v to the "carried_score" field.
+func (u *HexInfluenceUpsert) AddCarriedScore(v float64) *HexInfluenceUpsert {
+	u.Add(hexinfluence.FieldCarriedScore, v)
+	return u
+}
+
+// SetCarriedAt sets the "carried_at" field.
+func (u *HexInfluenceUpsert) SetCarriedAt(v time.Time) *HexInfluenceUpsert {
+	u.Set(hexinfluence.FieldCarriedAt, v)
+	return u
+}
+
+// UpdateCarriedAt sets the "carried_at" field to the value that was provided on create.
+func (u *HexInfluenceUpsert) UpdateCarriedAt() *HexInfluenceUpsert {
+	u.SetExcluded(hexinfluence.FieldCarriedAt)
+	return u
+}
+
+// ClearCarriedAt clears the value of the "carried_at" field.
+func (u *HexInfluenceUpsert) ClearCarriedAt() *HexInfluenceUpsert {
+	u.SetNull(hexinfluence.FieldCarriedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.HexInfluence.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(hexinfluence.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *HexInfluenceUpsertOne) UpdateNewValues() *HexInfluenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(hexinfluence.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.HexInfluence.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *HexInfluenceUpsertOne) Ignore() *HexInfluenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HexInfluenceUpsertOne) DoNothing() *HexInfluenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HexInfluenceCreate.OnConflict
+// documentation for more info.
+func (u *HexInfluenceUpsertOne) Update(set func(*HexInfluenceUpsert)) *HexInfluenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HexInfluenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetH3Index sets the "h3_index" field.
+func (u *HexInfluenceUpsertOne) SetH3Index(v string) *HexInfluenceUpsertOne {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.SetH3Index(v)
+	})
+}
+
+// UpdateH3Index sets the "h3_index" field to the value that was provided on create.
+func (u *HexInfluenceUpsertOne) UpdateH3Index() *HexInfluenceUpsertOne {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.UpdateH3Index()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *HexInfluenceUpsertOne) SetUserID(v uuid.UUID) *HexInfluenceUpsertOne {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *HexInfluenceUpsertOne) UpdateUserID() *HexInfluenceUpsertOne {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetScore sets the "score" field.
+func (u *HexInfluenceUpsertOne) SetScore(v float64) *HexInfluenceUpsertOne {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.SetScore(v)
+	})
+}
+
+// AddScore adds v to the "score" field.
+func (u *HexInfluenceUpsertOne) AddScore(v float64) *HexInfluenceUpsertOne {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.AddScore(v)
+	})
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *HexInfluenceUpsertOne) UpdateScore() *HexInfluenceUpsertOne {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.UpdateScore()
+	})
+}
+
+// SetLastUpdated sets the "last_updated" field.
+func (u *HexInfluenceUpsertOne) SetLastUpdated(v time.Time) *HexInfluenceUpsertOne {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.SetLastUpdated(v)
+	})
+}
+
+// UpdateLastUpdated sets the "last_updated" field to the value that was provided on create.
+func (u *HexInfluenceUpsertOne) UpdateLastUpdated() *HexInfluenceUpsertOne {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.UpdateLastUpdated()
+	})
+}
+
+// SetCarriedScore sets the "carried_score" field.
+func (u *HexInfluenceUpsertOne) SetCarriedScore(v float64) *HexInfluenceUpsertOne {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.SetCarriedScore(v)
+	})
+}
+
+// AddCarriedScore adds v to the "carried_score" field.
+func (u *HexInfluenceUpsertOne) AddCarriedScore(v float64) *HexInfluenceUpsertOne {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.AddCarriedScore(v)
+	})
+}
+
+// UpdateCarriedScore sets the "carried_score" field to the value that was provided on create.
+func (u *HexInfluenceUpsertOne) UpdateCarriedScore() *HexInfluenceUpsertOne {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.UpdateCarriedScore()
+	})
+}
+
+// SetCarriedAt sets the "carried_at" field.
+func (u *HexInfluenceUpsertOne) SetCarriedAt(v time.Time) *HexInfluenceUpsertOne {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.SetCarriedAt(v)
+	})
+}
+
+// UpdateCarriedAt sets the "carried_at" field to the value that was provided on create.
+func (u *HexInfluenceUpsertOne) UpdateCarriedAt() *HexInfluenceUpsertOne {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.UpdateCarriedAt()
+	})
+}
+
+// ClearCarriedAt clears the value of the "carried_at" field.
+func (u *HexInfluenceUpsertOne) ClearCarriedAt() *HexInfluenceUpsertOne {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.ClearCarriedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *HexInfluenceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HexInfluenceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HexInfluenceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *HexInfluenceUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: HexInfluenceUpsertOne.ID is not supported by MySQL driver. Use HexInfluenceUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *HexInfluenceUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // HexInfluenceCreateBulk is the builder for creating many HexInfluence entities in bulk.
 type HexInfluenceCreateBulk struct {
 	config
 	err      error
 	builders []*HexInfluenceCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the HexInfluence entities in the database.
@@ -258,6 +636,7 @@ func (hicb *HexInfluenceCreateBulk) Save(ctx context.Context) ([]*HexInfluence,
 					_, err = mutators[i+1].Mutate(root, hicb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = hicb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, hicb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -307,3 +686,222 @@ func (hicb *HexInfluenceCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.HexInfluence.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HexInfluenceUpsert) {
+//			SetH3Index(v+v).
+//		}).
+//		Exec(ctx)
+func (hicb *HexInfluenceCreateBulk) OnConflict(opts ...sql.ConflictOption) *HexInfluenceUpsertBulk {
+	hicb.conflict = opts
+	return &HexInfluenceUpsertBulk{
+		create: hicb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.HexInfluence.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (hicb *HexInfluenceCreateBulk) OnConflictColumns(columns ...string) *HexInfluenceUpsertBulk {
+	hicb.conflict = append(hicb.conflict, sql.ConflictColumns(columns...))
+	return &HexInfluenceUpsertBulk{
+		create: hicb,
+	}
+}
+
+// HexInfluenceUpsertBulk is the builder for "upsert"-ing
+// a bulk of HexInfluence nodes.
+type HexInfluenceUpsertBulk struct {
+	create *HexInfluenceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.HexInfluence.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(hexinfluence.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *HexInfluenceUpsertBulk) UpdateNewValues() *HexInfluenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(hexinfluence.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.HexInfluence.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *HexInfluenceUpsertBulk) Ignore() *HexInfluenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HexInfluenceUpsertBulk) DoNothing() *HexInfluenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HexInfluenceCreateBulk.OnConflict
+// documentation for more info.
+func (u *HexInfluenceUpsertBulk) Update(set func(*HexInfluenceUpsert)) *HexInfluenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HexInfluenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetH3Index sets the "h3_index" field.
+func (u *HexInfluenceUpsertBulk) SetH3Index(v string) *HexInfluenceUpsertBulk {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.SetH3Index(v)
+	})
+}
+
+// UpdateH3Index sets the "h3_index" field to the value that was provided on create.
+func (u *HexInfluenceUpsertBulk) UpdateH3Index() *HexInfluenceUpsertBulk {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.UpdateH3Index()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *HexInfluenceUpsertBulk) SetUserID(v uuid.UUID) *HexInfluenceUpsertBulk {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *HexInfluenceUpsertBulk) UpdateUserID() *HexInfluenceUpsertBulk {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetScore sets the "score" field.
+func (u *HexInfluenceUpsertBulk) SetScore(v float64) *HexInfluenceUpsertBulk {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.SetScore(v)
+	})
+}
+
+// AddScore adds v to the "score" field.
+func (u *HexInfluenceUpsertBulk) AddScore(v float64) *HexInfluenceUpsertBulk {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.AddScore(v)
+	})
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *HexInfluenceUpsertBulk) UpdateScore() *HexInfluenceUpsertBulk {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.UpdateScore()
+	})
+}
+
+// SetLastUpdated sets the "last_updated" field.
+func (u *HexInfluenceUpsertBulk) SetLastUpdated(v time.Time) *HexInfluenceUpsertBulk {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.SetLastUpdated(v)
+	})
+}
+
+// UpdateLastUpdated sets the "last_updated" field to the value that was provided on create.
+func (u *HexInfluenceUpsertBulk) UpdateLastUpdated() *HexInfluenceUpsertBulk {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.UpdateLastUpdated()
+	})
+}
+
+// SetCarriedScore sets the "carried_score" field.
+func (u *HexInfluenceUpsertBulk) SetCarriedScore(v float64) *HexInfluenceUpsertBulk {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.SetCarriedScore(v)
+	})
+}
+
+// AddCarriedScore adds v to the "carried_score" field.
+func (u *HexInfluenceUpsertBulk) AddCarriedScore(v float64) *HexInfluenceUpsertBulk {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.AddCarriedScore(v)
+	})
+}
+
+// UpdateCarriedScore sets the "carried_score" field to the value that was provided on create.
+func (u *HexInfluenceUpsertBulk) UpdateCarriedScore() *HexInfluenceUpsertBulk {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.UpdateCarriedScore()
+	})
+}
+
+// SetCarriedAt sets the "carried_at" field.
+func (u *HexInfluenceUpsertBulk) SetCarriedAt(v time.Time) *HexInfluenceUpsertBulk {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.SetCarriedAt(v)
+	})
+}
+
+// UpdateCarriedAt sets the "carried_at" field to the value that was provided on create.
+func (u *HexInfluenceUpsertBulk) UpdateCarriedAt() *HexInfluenceUpsertBulk {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.UpdateCarriedAt()
+	})
+}
+
+// ClearCarriedAt clears the value of the "carried_at" field.
+func (u *HexInfluenceUpsertBulk) ClearCarriedAt() *HexInfluenceUpsertBulk {
+	return u.Update(func(s *HexInfluenceUpsert) {
+		s.ClearCarriedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *HexInfluenceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the HexInfluenceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HexInfluenceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HexInfluenceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -27,6 +27,7 @@ type HexInfluenceQuery struct {
 	predicates []predicate.HexInfluence
 	withHex    *HexQuery
 	withUsers  *UserQuery
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -302,8 +303,9 @@ func (hiq *HexInfluenceQuery) Clone() *HexInfluenceQuery {
 		withHex:    hiq.withHex.Clone(),
 		withUsers:  hiq.withUsers.Clone(),
 		// clone intermediate query.
-		sql:  hiq.sql.Clone(),
-		path: hiq.path,
+		sql:       hiq.sql.Clone(),
+		path:      hiq.path,
+		modifiers: append([]func(*sql.Selector){}, hiq.modifiers...),
 	}
 }
 
@@ -421,6 +423,9 @@ func (hiq *HexInfluenceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(hiq.modifiers) > 0 {
+		_spec.Modifiers = hiq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -506,6 +511,9 @@ func (hiq *HexInfluenceQuery) loadUsers(ctx context.Context, query *UserQuery, n
 
 func (hiq *HexInfluenceQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := hiq.querySpec()
+	if len(hiq.modifiers) > 0 {
+		_spec.Modifiers = hiq.modifiers
+	}
 	_spec.Node.Columns = hiq.ctx.Fields
 	if len(hiq.ctx.Fields) > 0 {
 		_spec.Unique = hiq.ctx.Unique != nil && *hiq.ctx.Unique
@@ -574,6 +582,9 @@ func (hiq *HexInfluenceQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if hiq.ctx.Unique != nil && *hiq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range hiq.modifiers {
+		m(selector)
+	}
 	for _, p := range hiq.predicates {
 		p(selector)
 	}
@@ -591,6 +602,12 @@ func (hiq *HexInfluenceQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (hiq *HexInfluenceQuery) Modify(modifiers ...func(s *sql.Selector)) *HexInfluenceSelect {
+	hiq.modifiers = append(hiq.modifiers, modifiers...)
+	return hiq.Select()
+}
+
 // HexInfluenceGroupBy is the group-by builder for HexInfluence entities.
 type HexInfluenceGroupBy struct {
 	selector
@@ -680,3 +697,9 @@ func (his *HexInfluenceSelect) sqlScan(ctx context.Context, root *HexInfluenceQu
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (his *HexInfluenceSelect) Modify(modifiers ...func(s *sql.Selector)) *HexInfluenceSelect {
+	his.modifiers = append(his.modifiers, modifiers...)
+	return his
+}
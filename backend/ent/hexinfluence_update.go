@@ -21,8 +21,9 @@ import (
 // HexInfluenceUpdate is the builder for updating HexInfluence entities.
 type HexInfluenceUpdate struct {
 	config
-	hooks    []Hook
-	mutation *HexInfluenceMutation
+	hooks     []Hook
+	mutation  *HexInfluenceMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the HexInfluenceUpdate builder.
@@ -94,6 +95,47 @@ func (hiu *HexInfluenceUpdate) SetNillableLastUpdated(t *time.Time) *HexInfluenc
 	return hiu
 }
 
+// SetCarriedScore sets the "carried_score" field.
+func (hiu *HexInfluenceUpdate) SetCarriedScore(f float64) *HexInfluenceUpdate {
+	hiu.mutation.ResetCarriedScore()
+	hiu.mutation.SetCarriedScore(f)
+	return hiu
+}
+
+// SetNillableCarriedScore sets the "carried_score" field if the given value is not nil.
+func (hiu *HexInfluenceUpdate) SetNillableCarriedScore(f *float64) *HexInfluenceUpdate {
+	if f != nil {
+		hiu.SetCarriedScore(*f)
+	}
+	return hiu
+}
+
+// AddCarriedScore adds f to the "carried_score" field.
+func (hiu *HexInfluenceUpdate) AddCarriedScore(f float64) *HexInfluenceUpdate {
+	hiu.mutation.AddCarriedScore(f)
+	return hiu
+}
+
+// SetCarriedAt sets the "carried_at" field.
+func (hiu *HexInfluenceUpdate) SetCarriedAt(t time.Time) *HexInfluenceUpdate {
+	hiu.mutation.SetCarriedAt(t)
+	return hiu
+}
+
+// SetNillableCarriedAt sets the "carried_at" field if the given value is not nil.
+func (hiu *HexInfluenceUpdate) SetNillableCarriedAt(t *time.Time) *HexInfluenceUpdate {
+	if t != nil {
+		hiu.SetCarriedAt(*t)
+	}
+	return hiu
+}
+
+// ClearCarriedAt clears the value of the "carried_at" field.
+func (hiu *HexInfluenceUpdate) ClearCarriedAt() *HexInfluenceUpdate {
+	hiu.mutation.ClearCarriedAt()
+	return hiu
+}
+
 // SetHexID sets the "hex" edge to the Hex entity by ID.
 func (hiu *HexInfluenceUpdate) SetHexID(id string) *HexInfluenceUpdate {
 	hiu.mutation.SetHexID(id)
@@ -171,6 +213,12 @@ func (hiu *HexInfluenceUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (hiu *HexInfluenceUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *HexInfluenceUpdate {
+	hiu.modifiers = append(hiu.modifiers, modifiers...)
+	return hiu
+}
+
 func (hiu *HexInfluenceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := hiu.check(); err != nil {
 		return n, err
@@ -192,6 +240,18 @@ func (hiu *HexInfluenceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := hiu.mutation.LastUpdated(); ok {
 		_spec.SetField(hexinfluence.FieldLastUpdated, field.TypeTime, value)
 	}
+	if value, ok := hiu.mutation.CarriedScore(); ok {
+		_spec.SetField(hexinfluence.FieldCarriedScore, field.TypeFloat64, value)
+	}
+	if value, ok := hiu.mutation.AddedCarriedScore(); ok {
+		_spec.AddField(hexinfluence.FieldCarriedScore, field.TypeFloat64, value)
+	}
+	if value, ok := hiu.mutation.CarriedAt(); ok {
+		_spec.SetField(hexinfluence.FieldCarriedAt, field.TypeTime, value)
+	}
+	if hiu.mutation.CarriedAtCleared() {
+		_spec.ClearField(hexinfluence.FieldCarriedAt, field.TypeTime)
+	}
 	if hiu.mutation.HexCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -250,6 +310,7 @@ func (hiu *HexInfluenceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(hiu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, hiu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{hexinfluence.Label}
@@ -265,9 +326,10 @@ func (hiu *HexInfluenceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // HexInfluenceUpdateOne is the builder for updating a single HexInfluence entity.
 type HexInfluenceUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *HexInfluenceMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *HexInfluenceMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetH3Index sets the "h3_index" field.
@@ -333,6 +395,47 @@ func (hiuo *HexInfluenceUpdateOne) SetNillableLastUpdated(t *time.Time) *HexInfl
 	return hiuo
 }
 
+// SetCarriedScore sets the "carried_score" field.
+func (hiuo *HexInfluenceUpdateOne) SetCarriedScore(f float64) *HexInfluenceUpdateOne {
+	hiuo.mutation.ResetCarriedScore()
+	hiuo.mutation.SetCarriedScore(f)
+	return hiuo
+}
+
+// SetNillableCarriedScore sets the "carried_score" field if the given value is not nil.
+func (hiuo *HexInfluenceUpdateOne) SetNillableCarriedScore(f *float64) *HexInfluenceUpdateOne {
+	if f != nil {
+		hiuo.SetCarriedScore(*f)
+	}
+	return hiuo
+}
+
+// AddCarriedScore adds f to the "carried_score" field.
+func (hiuo *HexInfluenceUpdateOne) AddCarriedScore(f float64) *HexInfluenceUpdateOne {
+	hiuo.mutation.AddCarriedScore(f)
+	return hiuo
+}
+
+// SetCarriedAt sets the "carried_at" field.
+func (hiuo *HexInfluenceUpdateOne) SetCarriedAt(t time.Time) *HexInfluenceUpdateOne {
+	hiuo.mutation.SetCarriedAt(t)
+	return hiuo
+}
+
+// SetNillableCarriedAt sets the "carried_at" field if the given value is not nil.
+func (hiuo *HexInfluenceUpdateOne) SetNillableCarriedAt(t *time.Time) *HexInfluenceUpdateOne {
+	if t != nil {
+		hiuo.SetCarriedAt(*t)
+	}
+	return hiuo
+}
+
+// ClearCarriedAt clears the value of the "carried_at" field.
+func (hiuo *HexInfluenceUpdateOne) ClearCarriedAt() *HexInfluenceUpdateOne {
+	hiuo.mutation.ClearCarriedAt()
+	return hiuo
+}
+
 // SetHexID sets the "hex" edge to the Hex entity by ID.
 func (hiuo *HexInfluenceUpdateOne) SetHexID(id string) *HexInfluenceUpdateOne {
 	hiuo.mutation.SetHexID(id)
@@ -423,6 +526,12 @@ func (hiuo *HexInfluenceUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (hiuo *HexInfluenceUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *HexInfluenceUpdateOne {
+	hiuo.modifiers = append(hiuo.modifiers, modifiers...)
+	return hiuo
+}
+
 func (hiuo *HexInfluenceUpdateOne) sqlSave(ctx context.Context) (_node *HexInfluence, err error) {
 	if err := hiuo.check(); err != nil {
 		return _node, err
@@ -461,6 +570,18 @@ func (hiuo *HexInfluenceUpdateOne) sqlSave(ctx context.Context) (_node *HexInflu
 	if value, ok := hiuo.mutation.LastUpdated(); ok {
 		_spec.SetField(hexinfluence.FieldLastUpdated, field.TypeTime, value)
 	}
+	if value, ok := hiuo.mutation.CarriedScore(); ok {
+		_spec.SetField(hexinfluence.FieldCarriedScore, field.TypeFloat64, value)
+	}
+	if value, ok := hiuo.mutation.AddedCarriedScore(); ok {
+		_spec.AddField(hexinfluence.FieldCarriedScore, field.TypeFloat64, value)
+	}
+	if value, ok := hiuo.mutation.CarriedAt(); ok {
+		_spec.SetField(hexinfluence.FieldCarriedAt, field.TypeTime, value)
+	}
+	if hiuo.mutation.CarriedAtCleared() {
+		_spec.ClearField(hexinfluence.FieldCarriedAt, field.TypeTime)
+	}
 	if hiuo.mutation.HexCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -519,6 +640,7 @@ func (hiuo *HexInfluenceUpdateOne) sqlSave(ctx context.Context) (_node *HexInflu
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(hiuo.modifiers...)
 	_node = &HexInfluence{config: hiuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -10,6 +10,8 @@ import (
 	"stride-wars-app/ent/hexleaderboard"
 	"stride-wars-app/ent/model"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -20,6 +22,7 @@ type HexLeaderboardCreate struct {
 	config
 	mutation *HexLeaderboardMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetH3Index sets the "h3_index" field.
@@ -146,6 +149,7 @@ func (hlc *HexLeaderboardCreate) createSpec() (*HexLeaderboard, *sqlgraph.Create
 		_node = &HexLeaderboard{config: hlc.config}
 		_spec = sqlgraph.NewCreateSpec(hexleaderboard.Table, sqlgraph.NewFieldSpec(hexleaderboard.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = hlc.conflict
 	if id, ok := hlc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -174,11 +178,199 @@ func (hlc *HexLeaderboardCreate) createSpec() (*HexLeaderboard, *sqlgraph.Create
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.HexLeaderboard.Create().
+//		SetH3Index(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HexLeaderboardUpsert) {
+//			SetH3Index(v+v).
+//		}).
+//		Exec(ctx)
+func (hlc *HexLeaderboardCreate) OnConflict(opts ...sql.ConflictOption) *HexLeaderboardUpsertOne {
+	hlc.conflict = opts
+	return &HexLeaderboardUpsertOne{
+		create: hlc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.HexLeaderboard.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (hlc *HexLeaderboardCreate) OnConflictColumns(columns ...string) *HexLeaderboardUpsertOne {
+	hlc.conflict = append(hlc.conflict, sql.ConflictColumns(columns...))
+	return &HexLeaderboardUpsertOne{
+		create: hlc,
+	}
+}
+
+type (
+	// HexLeaderboardUpsertOne is the builder for "upsert"-ing
+	//  one HexLeaderboard node.
+	HexLeaderboardUpsertOne struct {
+		create *HexLeaderboardCreate
+	}
+
+	// HexLeaderboardUpsert is the "OnConflict" setter.
+	HexLeaderboardUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetH3Index sets the "h3_index" field.
+func (u *HexLeaderboardUpsert) SetH3Index(v string) *HexLeaderboardUpsert {
+	u.Set(hexleaderboard.FieldH3Index, v)
+	return u
+}
+
+// UpdateH3Index sets the "h3_index" field to the value that was provided on create.
+func (u *HexLeaderboardUpsert) UpdateH3Index() *HexLeaderboardUpsert {
+	u.SetExcluded(hexleaderboard.FieldH3Index)
+	return u
+}
+
+// SetTopUsers sets the "top_users" field.
+func (u *HexLeaderboardUpsert) SetTopUsers(v []model.TopUser) *HexLeaderboardUpsert {
+	u.Set(hexleaderboard.FieldTopUsers, v)
+	return u
+}
+
+// UpdateTopUsers sets the "top_users" field to the value that was provided on create.
+func (u *HexLeaderboardUpsert) UpdateTopUsers() *HexLeaderboardUpsert {
+	u.SetExcluded(hexleaderboard.FieldTopUsers)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.HexLeaderboard.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(hexleaderboard.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *HexLeaderboardUpsertOne) UpdateNewValues() *HexLeaderboardUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(hexleaderboard.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.HexLeaderboard.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *HexLeaderboardUpsertOne) Ignore() *HexLeaderboardUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HexLeaderboardUpsertOne) DoNothing() *HexLeaderboardUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HexLeaderboardCreate.OnConflict
+// documentation for more info.
+func (u *HexLeaderboardUpsertOne) Update(set func(*HexLeaderboardUpsert)) *HexLeaderboardUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HexLeaderboardUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetH3Index sets the "h3_index" field.
+func (u *HexLeaderboardUpsertOne) SetH3Index(v string) *HexLeaderboardUpsertOne {
+	return u.Update(func(s *HexLeaderboardUpsert) {
+		s.SetH3Index(v)
+	})
+}
+
+// UpdateH3Index sets the "h3_index" field to the value that was provided on create.
+func (u *HexLeaderboardUpsertOne) UpdateH3Index() *HexLeaderboardUpsertOne {
+	return u.Update(func(s *HexLeaderboardUpsert) {
+		s.UpdateH3Index()
+	})
+}
+
+// SetTopUsers sets the "top_users" field.
+func (u *HexLeaderboardUpsertOne) SetTopUsers(v []model.TopUser) *HexLeaderboardUpsertOne {
+	return u.Update(func(s *HexLeaderboardUpsert) {
+		s.SetTopUsers(v)
+	})
+}
+
+// UpdateTopUsers sets the "top_users" field to the value that was provided on create.
+func (u *HexLeaderboardUpsertOne) UpdateTopUsers() *HexLeaderboardUpsertOne {
+	return u.Update(func(s *HexLeaderboardUpsert) {
+		s.UpdateTopUsers()
+	})
+}
+
+// Exec executes the query.
+func (u *HexLeaderboardUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HexLeaderboardCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HexLeaderboardUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *HexLeaderboardUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: HexLeaderboardUpsertOne.ID is not supported by MySQL driver. Use HexLeaderboardUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *HexLeaderboardUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // HexLeaderboardCreateBulk is the builder for creating many HexLeaderboard entities in bulk.
 type HexLeaderboardCreateBulk struct {
 	config
 	err      error
 	builders []*HexLeaderboardCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the HexLeaderboard entities in the database.
@@ -208,6 +400,7 @@ func (hlcb *HexLeaderboardCreateBulk) Save(ctx context.Context) ([]*HexLeaderboa
 					_, err = mutators[i+1].Mutate(root, hlcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = hlcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, hlcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -257,3 +450,145 @@ func (hlcb *HexLeaderboardCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.HexLeaderboard.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HexLeaderboardUpsert) {
+//			SetH3Index(v+v).
+//		}).
+//		Exec(ctx)
+func (hlcb *HexLeaderboardCreateBulk) OnConflict(opts ...sql.ConflictOption) *HexLeaderboardUpsertBulk {
+	hlcb.conflict = opts
+	return &HexLeaderboardUpsertBulk{
+		create: hlcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.HexLeaderboard.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (hlcb *HexLeaderboardCreateBulk) OnConflictColumns(columns ...string) *HexLeaderboardUpsertBulk {
+	hlcb.conflict = append(hlcb.conflict, sql.ConflictColumns(columns...))
+	return &HexLeaderboardUpsertBulk{
+		create: hlcb,
+	}
+}
+
+// HexLeaderboardUpsertBulk is the builder for "upsert"-ing
+// a bulk of HexLeaderboard nodes.
+type HexLeaderboardUpsertBulk struct {
+	create *HexLeaderboardCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.HexLeaderboard.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(hexleaderboard.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *HexLeaderboardUpsertBulk) UpdateNewValues() *HexLeaderboardUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(hexleaderboard.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.HexLeaderboard.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *HexLeaderboardUpsertBulk) Ignore() *HexLeaderboardUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HexLeaderboardUpsertBulk) DoNothing() *HexLeaderboardUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HexLeaderboardCreateBulk.OnConflict
+// documentation for more info.
+func (u *HexLeaderboardUpsertBulk) Update(set func(*HexLeaderboardUpsert)) *HexLeaderboardUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HexLeaderboardUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetH3Index sets the "h3_index" field.
+func (u *HexLeaderboardUpsertBulk) SetH3Index(v string) *HexLeaderboardUpsertBulk {
+	return u.Update(func(s *HexLeaderboardUpsert) {
+		s.SetH3Index(v)
+	})
+}
+
+// UpdateH3Index sets the "h3_index" field to the value that was provided on create.
+func (u *HexLeaderboardUpsertBulk) UpdateH3Index() *HexLeaderboardUpsertBulk {
+	return u.Update(func(s *HexLeaderboardUpsert) {
+		s.UpdateH3Index()
+	})
+}
+
+// SetTopUsers sets the "top_users" field.
+func (u *HexLeaderboardUpsertBulk) SetTopUsers(v []model.TopUser) *HexLeaderboardUpsertBulk {
+	return u.Update(func(s *HexLeaderboardUpsert) {
+		s.SetTopUsers(v)
+	})
+}
+
+// UpdateTopUsers sets the "top_users" field to the value that was provided on create.
+func (u *HexLeaderboardUpsertBulk) UpdateTopUsers() *HexLeaderboardUpsertBulk {
+	return u.Update(func(s *HexLeaderboardUpsert) {
+		s.UpdateTopUsers()
+	})
+}
+
+// Exec executes the query.
+func (u *HexLeaderboardUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the HexLeaderboardCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HexLeaderboardCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HexLeaderboardUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
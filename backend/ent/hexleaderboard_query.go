@@ -25,6 +25,7 @@ type HexLeaderboardQuery struct {
 	inters     []Interceptor
 	predicates []predicate.HexLeaderboard
 	withHex    *HexQuery
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -277,8 +278,9 @@ func (hlq *HexLeaderboardQuery) Clone() *HexLeaderboardQuery {
 		predicates: append([]predicate.HexLeaderboard{}, hlq.predicates...),
 		withHex:    hlq.withHex.Clone(),
 		// clone intermediate query.
-		sql:  hlq.sql.Clone(),
-		path: hlq.path,
+		sql:       hlq.sql.Clone(),
+		path:      hlq.path,
+		modifiers: append([]func(*sql.Selector){}, hlq.modifiers...),
 	}
 }
 
@@ -384,6 +386,9 @@ func (hlq *HexLeaderboardQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(hlq.modifiers) > 0 {
+		_spec.Modifiers = hlq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -434,6 +439,9 @@ func (hlq *HexLeaderboardQuery) loadHex(ctx context.Context, query *HexQuery, no
 
 func (hlq *HexLeaderboardQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := hlq.querySpec()
+	if len(hlq.modifiers) > 0 {
+		_spec.Modifiers = hlq.modifiers
+	}
 	_spec.Node.Columns = hlq.ctx.Fields
 	if len(hlq.ctx.Fields) > 0 {
 		_spec.Unique = hlq.ctx.Unique != nil && *hlq.ctx.Unique
@@ -499,6 +507,9 @@ func (hlq *HexLeaderboardQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if hlq.ctx.Unique != nil && *hlq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range hlq.modifiers {
+		m(selector)
+	}
 	for _, p := range hlq.predicates {
 		p(selector)
 	}
@@ -516,6 +527,12 @@ func (hlq *HexLeaderboardQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (hlq *HexLeaderboardQuery) Modify(modifiers ...func(s *sql.Selector)) *HexLeaderboardSelect {
+	hlq.modifiers = append(hlq.modifiers, modifiers...)
+	return hlq.Select()
+}
+
 // HexLeaderboardGroupBy is the group-by builder for HexLeaderboard entities.
 type HexLeaderboardGroupBy struct {
 	selector
@@ -605,3 +622,9 @@ func (hls *HexLeaderboardSelect) sqlScan(ctx context.Context, root *HexLeaderboa
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (hls *HexLeaderboardSelect) Modify(modifiers ...func(s *sql.Selector)) *HexLeaderboardSelect {
+	hls.modifiers = append(hls.modifiers, modifiers...)
+	return hls
+}
@@ -20,8 +20,9 @@ import (
 // HexLeaderboardUpdate is the builder for updating HexLeaderboard entities.
 type HexLeaderboardUpdate struct {
 	config
-	hooks    []Hook
-	mutation *HexLeaderboardMutation
+	hooks     []Hook
+	mutation  *HexLeaderboardMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the HexLeaderboardUpdate builder.
@@ -113,6 +114,12 @@ func (hlu *HexLeaderboardUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (hlu *HexLeaderboardUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *HexLeaderboardUpdate {
+	hlu.modifiers = append(hlu.modifiers, modifiers...)
+	return hlu
+}
+
 func (hlu *HexLeaderboardUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := hlu.check(); err != nil {
 		return n, err
@@ -162,6 +169,7 @@ func (hlu *HexLeaderboardUpdate) sqlSave(ctx context.Context) (n int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(hlu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, hlu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{hexleaderboard.Label}
@@ -177,9 +185,10 @@ func (hlu *HexLeaderboardUpdate) sqlSave(ctx context.Context) (n int, err error)
 // HexLeaderboardUpdateOne is the builder for updating a single HexLeaderboard entity.
 type HexLeaderboardUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *HexLeaderboardMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *HexLeaderboardMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetH3Index sets the "h3_index" field.
@@ -278,6 +287,12 @@ func (hluo *HexLeaderboardUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (hluo *HexLeaderboardUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *HexLeaderboardUpdateOne {
+	hluo.modifiers = append(hluo.modifiers, modifiers...)
+	return hluo
+}
+
 func (hluo *HexLeaderboardUpdateOne) sqlSave(ctx context.Context) (_node *HexLeaderboard, err error) {
 	if err := hluo.check(); err != nil {
 		return _node, err
@@ -344,6 +359,7 @@ func (hluo *HexLeaderboardUpdateOne) sqlSave(ctx context.Context) (_node *HexLea
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(hluo.modifiers...)
 	_node = &HexLeaderboard{config: hluo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -15,6 +15,11 @@ var (
 		{Name: "distance_meters", Type: field.TypeFloat64},
 		{Name: "h3_indexes", Type: field.TypeJSON},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "track", Type: field.TypeJSON, Nullable: true},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "activity_type", Type: field.TypeEnum, Enums: []string{"run", "walk", "ride"}, Default: "run"},
+		{Name: "new_hexes", Type: field.TypeInt, Default: 0},
 		{Name: "user_id", Type: field.TypeUUID},
 	}
 	// ActivitiesTable holds the schema information for the "activities" table.
@@ -25,11 +30,156 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "activities_users_user",
-				Columns:    []*schema.Column{ActivitiesColumns[5]},
+				Columns:    []*schema.Column{ActivitiesColumns[10]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "activity_user_id_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{ActivitiesColumns[10], ActivitiesColumns[6]},
+			},
+		},
+	}
+	// ActivityHexesColumns holds the columns for the "activity_hexes" table.
+	ActivityHexesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "h3_index", Type: field.TypeString},
+		{Name: "visited_at", Type: field.TypeTime},
+		{Name: "activity_id", Type: field.TypeUUID},
+	}
+	// ActivityHexesTable holds the schema information for the "activity_hexes" table.
+	ActivityHexesTable = &schema.Table{
+		Name:       "activity_hexes",
+		Columns:    ActivityHexesColumns,
+		PrimaryKey: []*schema.Column{ActivityHexesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "activity_hexes_activities_activity",
+				Columns:    []*schema.Column{ActivityHexesColumns[4]},
+				RefColumns: []*schema.Column{ActivitiesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "activityhex_activity_id_h3_index",
+				Unique:  true,
+				Columns: []*schema.Column{ActivityHexesColumns[4], ActivityHexesColumns[2]},
+			},
+			{
+				Name:    "activityhex_user_id_visited_at",
+				Unique:  false,
+				Columns: []*schema.Column{ActivityHexesColumns[1], ActivityHexesColumns[3]},
+			},
+			{
+				Name:    "activityhex_user_id_h3_index_visited_at",
+				Unique:  false,
+				Columns: []*schema.Column{ActivityHexesColumns[1], ActivityHexesColumns[2], ActivityHexesColumns[3]},
+			},
+		},
+	}
+	// ActivityJobsColumns holds the columns for the "activity_jobs" table.
+	ActivityJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "done", "dead"}, Default: "pending"},
+		{Name: "already_scored", Type: field.TypeInt, Default: 0},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "run_at", Type: field.TypeTime},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "activity_id", Type: field.TypeUUID},
+	}
+	// ActivityJobsTable holds the schema information for the "activity_jobs" table.
+	ActivityJobsTable = &schema.Table{
+		Name:       "activity_jobs",
+		Columns:    ActivityJobsColumns,
+		PrimaryKey: []*schema.Column{ActivityJobsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "activity_jobs_activities_activity",
+				Columns:    []*schema.Column{ActivityJobsColumns[10]},
+				RefColumns: []*schema.Column{ActivitiesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "activityjob_status_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{ActivityJobsColumns[2], ActivityJobsColumns[5]},
+			},
+			{
+				Name:    "activityjob_user_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ActivityJobsColumns[1], ActivityJobsColumns[2]},
+			},
+		},
+	}
+	// ActivitySessionsColumns holds the columns for the "activity_sessions" table.
+	ActivitySessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "paused", "finished", "expired"}, Default: "active"},
+		{Name: "activity_type", Type: field.TypeEnum, Enums: []string{"run", "walk", "ride"}, Default: "run"},
+		{Name: "h3_indexes", Type: field.TypeJSON},
+		{Name: "track", Type: field.TypeJSON, Nullable: true},
+		{Name: "distance_meters", Type: field.TypeFloat64, Default: 0},
+		{Name: "active_seconds", Type: field.TypeFloat64, Default: 0},
+		{Name: "scored_cells", Type: field.TypeInt, Default: 0},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "resumed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_seen_at", Type: field.TypeTime},
+		{Name: "activity_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// ActivitySessionsTable holds the schema information for the "activity_sessions" table.
+	ActivitySessionsTable = &schema.Table{
+		Name:       "activity_sessions",
+		Columns:    ActivitySessionsColumns,
+		PrimaryKey: []*schema.Column{ActivitySessionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "activitysession_user_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ActivitySessionsColumns[1], ActivitySessionsColumns[2]},
+			},
+			{
+				Name:    "activitysession_status_last_seen_at",
+				Unique:  false,
+				Columns: []*schema.Column{ActivitySessionsColumns[2], ActivitySessionsColumns[11]},
+			},
+		},
+	}
+	// DecaySweepsColumns holds the columns for the "decay_sweeps" table.
+	DecaySweepsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "cursor", Type: field.TypeUUID, Nullable: true},
+		{Name: "processed", Type: field.TypeInt, Default: 0},
+		{Name: "decayed", Type: field.TypeInt, Default: 0},
+		{Name: "pruned", Type: field.TypeInt, Default: 0},
+		{Name: "owner_changes", Type: field.TypeInt, Default: 0},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// DecaySweepsTable holds the schema information for the "decay_sweeps" table.
+	DecaySweepsTable = &schema.Table{
+		Name:       "decay_sweeps",
+		Columns:    DecaySweepsColumns,
+		PrimaryKey: []*schema.Column{DecaySweepsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "decaysweep_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{DecaySweepsColumns[1]},
+			},
+		},
 	}
 	// FriendshipsColumns holds the columns for the "friendships" table.
 	FriendshipsColumns = []*schema.Column{
@@ -58,6 +208,30 @@ var (
 			},
 		},
 	}
+	// GoalsColumns holds the columns for the "goals" table.
+	GoalsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "metric", Type: field.TypeEnum, Enums: []string{"distance", "new_hexes", "activities"}},
+		{Name: "period", Type: field.TypeEnum, Enums: []string{"day", "week"}},
+		{Name: "target", Type: field.TypeFloat64},
+		{Name: "progress", Type: field.TypeFloat64, Default: 0},
+		{Name: "period_start", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// GoalsTable holds the schema information for the "goals" table.
+	GoalsTable = &schema.Table{
+		Name:       "goals",
+		Columns:    GoalsColumns,
+		PrimaryKey: []*schema.Column{GoalsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "goal_user_id_metric_period",
+				Unique:  true,
+				Columns: []*schema.Column{GoalsColumns[1], GoalsColumns[2], GoalsColumns[3]},
+			},
+		},
+	}
 	// HexesColumns holds the columns for the "hexes" table.
 	HexesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -68,11 +242,47 @@ var (
 		Columns:    HexesColumns,
 		PrimaryKey: []*schema.Column{HexesColumns[0]},
 	}
+	// HexCapturesColumns holds the columns for the "hex_captures" table.
+	HexCapturesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "h3_index", Type: field.TypeString},
+		{Name: "previous_owner_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "new_owner_id", Type: field.TypeUUID},
+		{Name: "previous_score", Type: field.TypeFloat64, Default: 0},
+		{Name: "new_score", Type: field.TypeFloat64},
+		{Name: "activity_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "captured_at", Type: field.TypeTime},
+	}
+	// HexCapturesTable holds the schema information for the "hex_captures" table.
+	HexCapturesTable = &schema.Table{
+		Name:       "hex_captures",
+		Columns:    HexCapturesColumns,
+		PrimaryKey: []*schema.Column{HexCapturesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "hexcapture_h3_index_captured_at",
+				Unique:  false,
+				Columns: []*schema.Column{HexCapturesColumns[1], HexCapturesColumns[7]},
+			},
+			{
+				Name:    "hexcapture_new_owner_id_captured_at",
+				Unique:  false,
+				Columns: []*schema.Column{HexCapturesColumns[3], HexCapturesColumns[7]},
+			},
+			{
+				Name:    "hexcapture_previous_owner_id_captured_at",
+				Unique:  false,
+				Columns: []*schema.Column{HexCapturesColumns[2], HexCapturesColumns[7]},
+			},
+		},
+	}
 	// HexInfluencesColumns holds the columns for the "hex_influences" table.
 	HexInfluencesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "score", Type: field.TypeFloat64},
 		{Name: "last_updated", Type: field.TypeTime},
+		{Name: "carried_score", Type: field.TypeFloat64, Default: 0},
+		{Name: "carried_at", Type: field.TypeTime, Nullable: true},
 		{Name: "h3_index", Type: field.TypeString},
 		{Name: "user_id", Type: field.TypeUUID},
 	}
@@ -84,17 +294,29 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "hex_influences_hexes_hex",
-				Columns:    []*schema.Column{HexInfluencesColumns[3]},
+				Columns:    []*schema.Column{HexInfluencesColumns[5]},
 				RefColumns: []*schema.Column{HexesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "hex_influences_users_users",
-				Columns:    []*schema.Column{HexInfluencesColumns[4]},
+				Columns:    []*schema.Column{HexInfluencesColumns[6]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "hexinfluence_user_id_h3_index",
+				Unique:  true,
+				Columns: []*schema.Column{HexInfluencesColumns[6], HexInfluencesColumns[5]},
+			},
+			{
+				Name:    "hexinfluence_h3_index",
+				Unique:  false,
+				Columns: []*schema.Column{HexInfluencesColumns[5]},
+			},
+		},
 	}
 	// HexLeaderboardsColumns holds the columns for the "hex_leaderboards" table.
 	HexLeaderboardsColumns = []*schema.Column{
@@ -115,12 +337,534 @@ var (
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "hexleaderboard_h3_index",
+				Unique:  true,
+				Columns: []*schema.Column{HexLeaderboardsColumns[2]},
+			},
+		},
+	}
+	// HexRollupsColumns holds the columns for the "hex_rollups" table.
+	HexRollupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "h3_index", Type: field.TypeString, Unique: true},
+		{Name: "resolution", Type: field.TypeInt},
+		{Name: "owners", Type: field.TypeJSON},
+		{Name: "owned_hexes", Type: field.TypeInt},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// HexRollupsTable holds the schema information for the "hex_rollups" table.
+	HexRollupsTable = &schema.Table{
+		Name:       "hex_rollups",
+		Columns:    HexRollupsColumns,
+		PrimaryKey: []*schema.Column{HexRollupsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "hexrollup_resolution",
+				Unique:  false,
+				Columns: []*schema.Column{HexRollupsColumns[2]},
+			},
+		},
+	}
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "key", Type: field.TypeString, Size: 255},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "request_hash", Type: field.TypeString},
+		{Name: "response", Type: field.TypeBytes, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_user_id_key",
+				Unique:  true,
+				Columns: []*schema.Column{IdempotencyKeysColumns[2], IdempotencyKeysColumns[1]},
+			},
+			{
+				Name:    "idempotencykey_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyKeysColumns[6]},
+			},
+		},
+	}
+	// InfluenceHistoriesColumns holds the columns for the "influence_histories" table.
+	InfluenceHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "h3_index", Type: field.TypeString},
+		{Name: "activity_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "score_before", Type: field.TypeFloat64},
+		{Name: "score_after", Type: field.TypeFloat64},
+		{Name: "recorded_at", Type: field.TypeTime},
+	}
+	// InfluenceHistoriesTable holds the schema information for the "influence_histories" table.
+	InfluenceHistoriesTable = &schema.Table{
+		Name:       "influence_histories",
+		Columns:    InfluenceHistoriesColumns,
+		PrimaryKey: []*schema.Column{InfluenceHistoriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "influencehistory_user_id_h3_index_recorded_at",
+				Unique:  false,
+				Columns: []*schema.Column{InfluenceHistoriesColumns[1], InfluenceHistoriesColumns[2], InfluenceHistoriesColumns[6]},
+			},
+			{
+				Name:    "influencehistory_user_id_recorded_at",
+				Unique:  false,
+				Columns: []*schema.Column{InfluenceHistoriesColumns[1], InfluenceHistoriesColumns[6]},
+			},
+		},
+	}
+	// NotificationsColumns holds the columns for the "notifications" table.
+	NotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"hex_captured", "leaderboard_lost", "personal_record"}},
+		{Name: "message", Type: field.TypeString},
+		{Name: "data", Type: field.TypeJSON, Nullable: true},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// NotificationsTable holds the schema information for the "notifications" table.
+	NotificationsTable = &schema.Table{
+		Name:       "notifications",
+		Columns:    NotificationsColumns,
+		PrimaryKey: []*schema.Column{NotificationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notification_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[1], NotificationsColumns[6]},
+			},
+			{
+				Name:    "notification_user_id_read_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[1], NotificationsColumns[5]},
+			},
+		},
+	}
+	// NotificationPreferencesColumns holds the columns for the "notification_preferences" table.
+	NotificationPreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"hex_captured", "leaderboard_lost", "personal_record"}},
+		{Name: "enabled", Type: field.TypeBool},
+	}
+	// NotificationPreferencesTable holds the schema information for the "notification_preferences" table.
+	NotificationPreferencesTable = &schema.Table{
+		Name:       "notification_preferences",
+		Columns:    NotificationPreferencesColumns,
+		PrimaryKey: []*schema.Column{NotificationPreferencesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationpreference_user_id_type",
+				Unique:  true,
+				Columns: []*schema.Column{NotificationPreferencesColumns[1], NotificationPreferencesColumns[2]},
+			},
+		},
+	}
+	// PersonalRecordsColumns holds the columns for the "personal_records" table.
+	PersonalRecordsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "activity_type", Type: field.TypeEnum, Enums: []string{"run", "walk", "ride"}},
+		{Name: "record", Type: field.TypeEnum, Enums: []string{"fastest_1k", "fastest_5k", "fastest_10k", "fastest_half_marathon", "longest_distance", "longest_duration", "most_new_hexes"}},
+		{Name: "value", Type: field.TypeFloat64},
+		{Name: "activity_id", Type: field.TypeUUID},
+		{Name: "achieved_at", Type: field.TypeTime},
+	}
+	// PersonalRecordsTable holds the schema information for the "personal_records" table.
+	PersonalRecordsTable = &schema.Table{
+		Name:       "personal_records",
+		Columns:    PersonalRecordsColumns,
+		PrimaryKey: []*schema.Column{PersonalRecordsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "personalrecord_user_id_activity_type_record",
+				Unique:  true,
+				Columns: []*schema.Column{PersonalRecordsColumns[1], PersonalRecordsColumns[2], PersonalRecordsColumns[3]},
+			},
+			{
+				Name:    "personalrecord_activity_id",
+				Unique:  false,
+				Columns: []*schema.Column{PersonalRecordsColumns[5]},
+			},
+		},
+	}
+	// PrivacyZonesColumns holds the columns for the "privacy_zones" table.
+	PrivacyZonesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Default: ""},
+		{Name: "lat", Type: field.TypeFloat64, Default: 0},
+		{Name: "lng", Type: field.TypeFloat64, Default: 0},
+		{Name: "radius_meters", Type: field.TypeFloat64, Default: 0},
+		{Name: "h3_indexes", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// PrivacyZonesTable holds the schema information for the "privacy_zones" table.
+	PrivacyZonesTable = &schema.Table{
+		Name:       "privacy_zones",
+		Columns:    PrivacyZonesColumns,
+		PrimaryKey: []*schema.Column{PrivacyZonesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "privacyzone_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{PrivacyZonesColumns[1]},
+			},
+		},
+	}
+	// PushDevicesColumns holds the columns for the "push_devices" table.
+	PushDevicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "device_id", Type: field.TypeString},
+		{Name: "token", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// PushDevicesTable holds the schema information for the "push_devices" table.
+	PushDevicesTable = &schema.Table{
+		Name:       "push_devices",
+		Columns:    PushDevicesColumns,
+		PrimaryKey: []*schema.Column{PushDevicesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pushdevice_user_id_device_id",
+				Unique:  true,
+				Columns: []*schema.Column{PushDevicesColumns[1], PushDevicesColumns[2]},
+			},
+			{
+				Name:    "pushdevice_token",
+				Unique:  false,
+				Columns: []*schema.Column{PushDevicesColumns[3]},
+			},
+		},
+	}
+	// PushMessagesColumns holds the columns for the "push_messages" table.
+	PushMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "notification_id", Type: field.TypeUUID},
+		{Name: "title", Type: field.TypeString},
+		{Name: "body", Type: field.TypeString},
+		{Name: "data", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "sending", "sent", "skipped", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// PushMessagesTable holds the schema information for the "push_messages" table.
+	PushMessagesTable = &schema.Table{
+		Name:       "push_messages",
+		Columns:    PushMessagesColumns,
+		PrimaryKey: []*schema.Column{PushMessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pushmessage_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PushMessagesColumns[6], PushMessagesColumns[10]},
+			},
+		},
+	}
+	// RegionsColumns holds the columns for the "regions" table.
+	RegionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString, Default: ""},
+		{Name: "hex_count", Type: field.TypeInt},
+		{Name: "standings_refreshed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// RegionsTable holds the schema information for the "regions" table.
+	RegionsTable = &schema.Table{
+		Name:       "regions",
+		Columns:    RegionsColumns,
+		PrimaryKey: []*schema.Column{RegionsColumns[0]},
+	}
+	// RegionHexesColumns holds the columns for the "region_hexes" table.
+	RegionHexesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "h3_index", Type: field.TypeString},
+		{Name: "region_id", Type: field.TypeUUID},
+	}
+	// RegionHexesTable holds the schema information for the "region_hexes" table.
+	RegionHexesTable = &schema.Table{
+		Name:       "region_hexes",
+		Columns:    RegionHexesColumns,
+		PrimaryKey: []*schema.Column{RegionHexesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "region_hexes_regions_region",
+				Columns:    []*schema.Column{RegionHexesColumns[2]},
+				RefColumns: []*schema.Column{RegionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "regionhex_region_id_h3_index",
+				Unique:  true,
+				Columns: []*schema.Column{RegionHexesColumns[2], RegionHexesColumns[1]},
+			},
+			{
+				Name:    "regionhex_h3_index",
+				Unique:  false,
+				Columns: []*schema.Column{RegionHexesColumns[1]},
+			},
+		},
+	}
+	// RegionStandingsColumns holds the columns for the "region_standings" table.
+	RegionStandingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "rank", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "username", Type: field.TypeString},
+		{Name: "hexes_owned", Type: field.TypeInt},
+		{Name: "total_influence", Type: field.TypeFloat64},
+		{Name: "region_id", Type: field.TypeUUID},
+	}
+	// RegionStandingsTable holds the schema information for the "region_standings" table.
+	RegionStandingsTable = &schema.Table{
+		Name:       "region_standings",
+		Columns:    RegionStandingsColumns,
+		PrimaryKey: []*schema.Column{RegionStandingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "region_standings_regions_region",
+				Columns:    []*schema.Column{RegionStandingsColumns[6]},
+				RefColumns: []*schema.Column{RegionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "regionstanding_region_id_rank",
+				Unique:  false,
+				Columns: []*schema.Column{RegionStandingsColumns[6], RegionStandingsColumns[1]},
+			},
+			{
+				Name:    "regionstanding_region_id_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{RegionStandingsColumns[6], RegionStandingsColumns[2]},
+			},
+		},
+	}
+	// SeasonsColumns holds the columns for the "seasons" table.
+	SeasonsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "number", Type: field.TypeInt},
+		{Name: "name", Type: field.TypeString},
+		{Name: "starts_at", Type: field.TypeTime},
+		{Name: "ends_at", Type: field.TypeTime},
+		{Name: "carry_over", Type: field.TypeFloat64, Default: 0},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "archived"}, Default: "active"},
+		{Name: "archived_at", Type: field.TypeTime, Nullable: true},
+	}
+	// SeasonsTable holds the schema information for the "seasons" table.
+	SeasonsTable = &schema.Table{
+		Name:       "seasons",
+		Columns:    SeasonsColumns,
+		PrimaryKey: []*schema.Column{SeasonsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "season_number",
+				Unique:  true,
+				Columns: []*schema.Column{SeasonsColumns[1]},
+			},
+			{
+				Name:    "season_status",
+				Unique:  false,
+				Columns: []*schema.Column{SeasonsColumns[6]},
+			},
+		},
+	}
+	// SeasonHexStandingsColumns holds the columns for the "season_hex_standings" table.
+	SeasonHexStandingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "season_id", Type: field.TypeUUID},
+		{Name: "h3_index", Type: field.TypeString},
+		{Name: "rank", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "username", Type: field.TypeString},
+		{Name: "score", Type: field.TypeFloat64},
+	}
+	// SeasonHexStandingsTable holds the schema information for the "season_hex_standings" table.
+	SeasonHexStandingsTable = &schema.Table{
+		Name:       "season_hex_standings",
+		Columns:    SeasonHexStandingsColumns,
+		PrimaryKey: []*schema.Column{SeasonHexStandingsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "seasonhexstanding_season_id_h3_index_rank",
+				Unique:  true,
+				Columns: []*schema.Column{SeasonHexStandingsColumns[1], SeasonHexStandingsColumns[2], SeasonHexStandingsColumns[3]},
+			},
+			{
+				Name:    "seasonhexstanding_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SeasonHexStandingsColumns[4]},
+			},
+		},
+	}
+	// SeasonStandingsColumns holds the columns for the "season_standings" table.
+	SeasonStandingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "season_id", Type: field.TypeUUID},
+		{Name: "rank", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "username", Type: field.TypeString},
+		{Name: "hexes_owned", Type: field.TypeInt},
+		{Name: "total_influence", Type: field.TypeFloat64},
+	}
+	// SeasonStandingsTable holds the schema information for the "season_standings" table.
+	SeasonStandingsTable = &schema.Table{
+		Name:       "season_standings",
+		Columns:    SeasonStandingsColumns,
+		PrimaryKey: []*schema.Column{SeasonStandingsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "seasonstanding_season_id_rank",
+				Unique:  false,
+				Columns: []*schema.Column{SeasonStandingsColumns[1], SeasonStandingsColumns[2]},
+			},
+			{
+				Name:    "seasonstanding_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SeasonStandingsColumns[3]},
+			},
+		},
+	}
+	// SegmentsColumns holds the columns for the "segments" table.
+	SegmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "creator_id", Type: field.TypeUUID},
+		{Name: "h3_indexes", Type: field.TypeJSON},
+		{Name: "start_h3_index", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// SegmentsTable holds the schema information for the "segments" table.
+	SegmentsTable = &schema.Table{
+		Name:       "segments",
+		Columns:    SegmentsColumns,
+		PrimaryKey: []*schema.Column{SegmentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "segment_start_h3_index",
+				Unique:  false,
+				Columns: []*schema.Column{SegmentsColumns[4]},
+			},
+			{
+				Name:    "segment_creator_id",
+				Unique:  false,
+				Columns: []*schema.Column{SegmentsColumns[2]},
+			},
+		},
+	}
+	// SegmentEffortsColumns holds the columns for the "segment_efforts" table.
+	SegmentEffortsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "elapsed_seconds", Type: field.TypeFloat64},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "estimated", Type: field.TypeBool, Default: false},
+		{Name: "segment_id", Type: field.TypeUUID},
+		{Name: "activity_id", Type: field.TypeUUID},
+	}
+	// SegmentEffortsTable holds the schema information for the "segment_efforts" table.
+	SegmentEffortsTable = &schema.Table{
+		Name:       "segment_efforts",
+		Columns:    SegmentEffortsColumns,
+		PrimaryKey: []*schema.Column{SegmentEffortsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "segment_efforts_segments_segment",
+				Columns:    []*schema.Column{SegmentEffortsColumns[5]},
+				RefColumns: []*schema.Column{SegmentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "segment_efforts_activities_activity",
+				Columns:    []*schema.Column{SegmentEffortsColumns[6]},
+				RefColumns: []*schema.Column{ActivitiesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "segmenteffort_segment_id_elapsed_seconds",
+				Unique:  false,
+				Columns: []*schema.Column{SegmentEffortsColumns[5], SegmentEffortsColumns[2]},
+			},
+			{
+				Name:    "segmenteffort_segment_id_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SegmentEffortsColumns[5], SegmentEffortsColumns[1]},
+			},
+			{
+				Name:    "segmenteffort_activity_id",
+				Unique:  false,
+				Columns: []*schema.Column{SegmentEffortsColumns[6]},
+			},
+		},
+	}
+	// SettingsColumns holds the columns for the "settings" table.
+	SettingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "value", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SettingsTable holds the schema information for the "settings" table.
+	SettingsTable = &schema.Table{
+		Name:       "settings",
+		Columns:    SettingsColumns,
+		PrimaryKey: []*schema.Column{SettingsColumns[0]},
+	}
+	// StreaksColumns holds the columns for the "streaks" table.
+	StreaksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "period", Type: field.TypeEnum, Enums: []string{"day", "week"}},
+		{Name: "current", Type: field.TypeInt, Default: 0},
+		{Name: "longest", Type: field.TypeInt, Default: 0},
+		{Name: "last_period", Type: field.TypeTime},
+	}
+	// StreaksTable holds the schema information for the "streaks" table.
+	StreaksTable = &schema.Table{
+		Name:       "streaks",
+		Columns:    StreaksColumns,
+		PrimaryKey: []*schema.Column{StreaksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "streak_user_id_period",
+				Unique:  true,
+				Columns: []*schema.Column{StreaksColumns[1], StreaksColumns[2]},
+			},
+		},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "external_user", Type: field.TypeUUID},
 		{Name: "username", Type: field.TypeString},
+		{Name: "time_zone", Type: field.TypeString, Default: "UTC"},
+		{Name: "hide_zone_leaderboards", Type: field.TypeBool, Default: false},
+		{Name: "quiet_hours_start", Type: field.TypeInt, Nullable: true},
+		{Name: "quiet_hours_end", Type: field.TypeInt, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -128,22 +872,112 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "subscription_id", Type: field.TypeUUID},
+		{Name: "event_id", Type: field.TypeUUID},
+		{Name: "event_type", Type: field.TypeEnum, Enums: []string{"activity_created", "hex_captured", "leaderboard_changed"}},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "delivering", "delivered", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[5], WebhookDeliveriesColumns[7]},
+			},
+			{
+				Name:    "webhookdelivery_subscription_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[1], WebhookDeliveriesColumns[12]},
+			},
+		},
+	}
+	// WebhookSubscriptionsColumns holds the columns for the "webhook_subscriptions" table.
+	WebhookSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "url", Type: field.TypeString},
+		{Name: "event_types", Type: field.TypeJSON},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// WebhookSubscriptionsTable holds the schema information for the "webhook_subscriptions" table.
+	WebhookSubscriptionsTable = &schema.Table{
+		Name:       "webhook_subscriptions",
+		Columns:    WebhookSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{WebhookSubscriptionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhooksubscription_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookSubscriptionsColumns[1]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		ActivitiesTable,
+		ActivityHexesTable,
+		ActivityJobsTable,
+		ActivitySessionsTable,
+		DecaySweepsTable,
 		FriendshipsTable,
+		GoalsTable,
 		HexesTable,
+		HexCapturesTable,
 		HexInfluencesTable,
 		HexLeaderboardsTable,
+		HexRollupsTable,
+		IdempotencyKeysTable,
+		InfluenceHistoriesTable,
+		NotificationsTable,
+		NotificationPreferencesTable,
+		PersonalRecordsTable,
+		PrivacyZonesTable,
+		PushDevicesTable,
+		PushMessagesTable,
+		RegionsTable,
+		RegionHexesTable,
+		RegionStandingsTable,
+		SeasonsTable,
+		SeasonHexStandingsTable,
+		SeasonStandingsTable,
+		SegmentsTable,
+		SegmentEffortsTable,
+		SettingsTable,
+		StreaksTable,
 		UsersTable,
+		WebhookDeliveriesTable,
+		WebhookSubscriptionsTable,
 	}
 )
 
 func init() {
 	ActivitiesTable.ForeignKeys[0].RefTable = UsersTable
+	ActivityHexesTable.ForeignKeys[0].RefTable = ActivitiesTable
+	ActivityJobsTable.ForeignKeys[0].RefTable = ActivitiesTable
 	FriendshipsTable.ForeignKeys[0].RefTable = UsersTable
 	FriendshipsTable.ForeignKeys[1].RefTable = UsersTable
 	HexInfluencesTable.ForeignKeys[0].RefTable = HexesTable
 	HexInfluencesTable.ForeignKeys[1].RefTable = UsersTable
 	HexLeaderboardsTable.ForeignKeys[0].RefTable = HexesTable
+	RegionHexesTable.ForeignKeys[0].RefTable = RegionsTable
+	RegionStandingsTable.ForeignKeys[0].RefTable = RegionsTable
+	SegmentEffortsTable.ForeignKeys[0].RefTable = SegmentsTable
+	SegmentEffortsTable.ForeignKeys[1].RefTable = ActivitiesTable
 }
@@ -6,16 +6,35 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
+const (
+	ActivityTypeRun  = "run"
+	ActivityTypeWalk = "walk"
+	ActivityTypeRide = "ride"
+)
+
+// TrackPoint is a single GPS fix of a recorded activity.
+type TrackPoint struct {
+	Lat       float64   `json:"lat"`
+	Lng       float64   `json:"lng"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
 type Activity struct {
-	ID        uuid.UUID
-	UserID    uuid.UUID
-	Duration  float64
-	Distance  float64
-	H3Indexes []string
-	CreatedAt time.Time
+	ID           uuid.UUID
+	UserID       uuid.UUID
+	Duration     float64
+	Distance     float64
+	H3Indexes    []string
+	Track        []TrackPoint
+	ActivityType string
+	NewHexes     int
+	StartedAt    time.Time
+	EndedAt      time.Time
+	CreatedAt    time.Time
 	ent.Schema
 }
 
@@ -26,7 +45,20 @@ func (Activity) Fields() []ent.Field {
 		field.Float("duration_seconds"),
 		field.Float("distance_meters"),
 		field.JSON("h3_indexes", []string{}),
-		field.Time("created_at").Default(time.Now()),
+		field.Time("created_at").Default(time.Now),
+		field.JSON("track", []TrackPoint{}).Optional(),
+		// StartedAt and EndedAt are reported by the client, nil for activities uploaded before they existed.
+		field.Time("started_at").Optional().Nillable(),
+		field.Time("ended_at").Optional().Nillable(),
+		field.Enum("activity_type").Values(ActivityTypeRun, ActivityTypeWalk, ActivityTypeRide).Default(ActivityTypeRun),
+		// NewHexes counts the cells the user visited for the first time with this activity.
+		field.Int("new_hexes").Default(0),
+	}
+}
+
+func (Activity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "started_at"),
 	}
 }
 
@@ -37,5 +69,8 @@ func (Activity) Edges() []ent.Edge {
 			Field("user_id").
 			Unique().
 			Required(),
+		edge.From("hexes", ActivityHex.Type).Ref("activity"),
+		edge.From("segment_efforts", SegmentEffort.Type).Ref("activity"),
+		edge.From("jobs", ActivityJob.Type).Ref("activity"),
 	}
 }
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ActivityHex is one distinct cell visited by an activity. It lets hex statistics be
+// aggregated in the database instead of expanding every activity's h3_indexes.
+type ActivityHex struct {
+	ID         uuid.UUID
+	ActivityID uuid.UUID
+	UserID     uuid.UUID
+	H3Index    string
+	VisitedAt  time.Time
+	ent.Schema
+}
+
+func (ActivityHex) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("activity_id", uuid.UUID{}),
+		field.UUID("user_id", uuid.UUID{}),
+		field.String("h3_index"),
+		// VisitedAt is when the activity started.
+		field.Time("visited_at"),
+	}
+}
+
+func (ActivityHex) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("activity", Activity.Type).
+			Field("activity_id").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (ActivityHex) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("activity_id", "h3_index").Unique(),
+		index.Fields("user_id", "visited_at"),
+		index.Fields("user_id", "h3_index", "visited_at"),
+	}
+}
@@ -0,0 +1,75 @@
+package model
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+const (
+	ActivityJobPending    = "pending"
+	ActivityJobProcessing = "processing"
+	ActivityJobDone       = "done"
+	ActivityJobDead       = "dead"
+)
+
+// ActivityJob scores a stored activity's hexes in the background. Jobs live in the database so
+// that they survive restarts, and one that keeps failing ends up dead instead of being retried
+// forever.
+type ActivityJob struct {
+	ID            uuid.UUID
+	ActivityID    uuid.UUID
+	UserID        uuid.UUID
+	Status        string
+	AlreadyScored int
+	Attempts      int
+	RunAt         time.Time
+	LockedUntil   *time.Time
+	LastError     *string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	ent.Schema
+}
+
+func (ActivityJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("activity_id", uuid.UUID{}).Unique(),
+		field.UUID("user_id", uuid.UUID{}),
+		field.Enum("status").
+			Values(ActivityJobPending, ActivityJobProcessing, ActivityJobDone, ActivityJobDead).
+			Default(ActivityJobPending),
+		// AlreadyScored counts the leading h3_indexes of the activity whose influence was applied live.
+		field.Int("already_scored").Default(0),
+		field.Int("attempts").Default(0),
+		// RunAt is the earliest time the job may be claimed, pushed back after every failure.
+		field.Time("run_at"),
+		// LockedUntil is when a claimed job is given up on and may be claimed by another worker.
+		field.Time("locked_until").Optional().Nillable(),
+		field.String("last_error").Optional().Nillable(),
+		field.Time("created_at"),
+		field.Time("updated_at"),
+	}
+}
+
+func (ActivityJob) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("activity", Activity.Type).
+			Field("activity_id").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (ActivityJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "run_at"),
+		index.Fields("user_id", "status"),
+	}
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+const (
+	ActivitySessionActive   = "active"
+	ActivitySessionPaused   = "paused"
+	ActivitySessionFinished = "finished"
+	ActivitySessionExpired  = "expired"
+)
+
+// ActivitySession is an activity that is still being recorded. Points are appended while the
+// user moves and the session becomes a regular Activity once it is finished.
+type ActivitySession struct {
+	ID             uuid.UUID
+	UserID         uuid.UUID
+	Status         string
+	ActivityType   string
+	H3Indexes      []string
+	Track          []TrackPoint
+	DistanceMeters float64
+	ActiveSeconds  float64
+	ScoredCells    int
+	StartedAt      time.Time
+	ResumedAt      *time.Time
+	LastSeenAt     time.Time
+	ActivityID     *uuid.UUID
+	ent.Schema
+}
+
+func (ActivitySession) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("user_id", uuid.UUID{}),
+		field.Enum("status").
+			Values(ActivitySessionActive, ActivitySessionPaused, ActivitySessionFinished, ActivitySessionExpired).
+			Default(ActivitySessionActive),
+		field.Enum("activity_type").Values(ActivityTypeRun, ActivityTypeWalk, ActivityTypeRide).Default(ActivityTypeRun),
+		field.JSON("h3_indexes", []string{}).Default([]string{}),
+		field.JSON("track", []TrackPoint{}).Optional(),
+		field.Float("distance_meters").Default(0),
+		// ActiveSeconds holds the time recorded before the current active stretch began.
+		field.Float("active_seconds").Default(0),
+		// ScoredCells counts the leading h3_indexes whose influence was applied while the session was open.
+		field.Int("scored_cells").Default(0),
+		field.Time("started_at"),
+		// ResumedAt is the start of the current active stretch, nil while paused.
+		field.Time("resumed_at").Optional().Nillable(),
+		field.Time("last_seen_at"),
+		field.UUID("activity_id", uuid.UUID{}).Optional().Nillable(),
+	}
+}
+
+func (ActivitySession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "status"),
+		index.Fields("status", "last_seen_at"),
+	}
+}
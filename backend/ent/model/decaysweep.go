@@ -0,0 +1,49 @@
+package model
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// DecaySweep is one pass of the background job that decays idle hex influences, prunes the ones
+// that faded away and rebuilds the affected leaderboards. Its progress is checkpointed after
+// every chunk, so a sweep interrupted by a restart resumes where it left off.
+type DecaySweep struct {
+	ID           uuid.UUID
+	StartedAt    time.Time
+	Cursor       *uuid.UUID
+	Processed    int
+	Decayed      int
+	Pruned       int
+	OwnerChanges int
+	FinishedAt   *time.Time
+	UpdatedAt    time.Time
+	ent.Schema
+}
+
+func (DecaySweep) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		// StartedAt is also the time every influence in the sweep is decayed up to.
+		field.Time("started_at"),
+		// Cursor is the ID of the last hex influence processed; influences are swept in ID order.
+		field.UUID("cursor", uuid.UUID{}).Optional().Nillable(),
+		field.Int("processed").Default(0),
+		field.Int("decayed").Default(0),
+		field.Int("pruned").Default(0),
+		// OwnerChanges counts the hexes whose leader changed when their leaderboard was rebuilt.
+		field.Int("owner_changes").Default(0),
+		field.Time("finished_at").Optional().Nillable(),
+		field.Time("updated_at"),
+	}
+}
+
+func (DecaySweep) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("started_at"),
+	}
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+const (
+	GoalMetricDistance   = "distance"
+	GoalMetricNewHexes   = "new_hexes"
+	GoalMetricActivities = "activities"
+)
+
+// Goal is a user-defined target for one metric per day or week.
+type Goal struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	Metric      string
+	Period      string
+	Target      float64
+	Progress    float64
+	PeriodStart time.Time
+	ent.Schema
+}
+
+func (Goal) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("user_id", uuid.UUID{}),
+		field.Enum("metric").Values(GoalMetricDistance, GoalMetricNewHexes, GoalMetricActivities),
+		field.Enum("period").Values(PeriodDay, PeriodWeek),
+		field.Float("target").Positive(),
+		// Progress is accumulated over the period starting on PeriodStart.
+		field.Float("progress").Default(0),
+		// PeriodStart is the local date the tracked period started on, stored as midnight UTC.
+		field.Time("period_start"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (Goal) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "metric", "period").Unique(),
+	}
+}
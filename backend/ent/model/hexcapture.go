@@ -0,0 +1,49 @@
+package model
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// HexCapture records a hex changing owner when a user took the lead of its leaderboard. Like the
+// influence history it is append-only and keeps no edges, so it outlives the activities and
+// leaderboard entries involved.
+type HexCapture struct {
+	ID              uuid.UUID
+	H3Index         string
+	PreviousOwnerID *uuid.UUID
+	NewOwnerID      uuid.UUID
+	PreviousScore   float64
+	NewScore        float64
+	ActivityID      *uuid.UUID
+	CapturedAt      time.Time
+	ent.Schema
+}
+
+func (HexCapture) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.String("h3_index"),
+		// PreviousOwnerID is empty when the hex was claimed for the first time.
+		field.UUID("previous_owner_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("new_owner_id", uuid.UUID{}),
+		// Scores are effective scores at the time of the capture.
+		field.Float("previous_score").Default(0),
+		field.Float("new_score"),
+		// ActivityID is the activity whose upload caused the capture. It is empty for live sessions.
+		field.UUID("activity_id", uuid.UUID{}).Optional().Nillable(),
+		field.Time("captured_at"),
+	}
+}
+
+func (HexCapture) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("h3_index", "captured_at"),
+		index.Fields("new_owner_id", "captured_at"),
+		index.Fields("previous_owner_id", "captured_at"),
+	}
+}
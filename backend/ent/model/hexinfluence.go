@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -15,6 +16,10 @@ type HexInfluence struct {
 	UserID      uuid.UUID
 	Score       float64
 	LastUpdated time.Time
+	// CarriedScore and CarriedAt are the score and last update the influence was carried into the
+	// active season with, nil when it was not carried over.
+	CarriedScore float64
+	CarriedAt    *time.Time
 	ent.Schema
 }
 
@@ -25,6 +30,10 @@ func (HexInfluence) Fields() []ent.Field {
 		field.UUID("user_id", uuid.UUID{}),
 		field.Float("score"),
 		field.Time("last_updated"),
+		// Replays of the active season start from the carried over score instead of replaying
+		// the activities of previous seasons.
+		field.Float("carried_score").Default(0),
+		field.Time("carried_at").Optional().Nillable(),
 	}
 }
 
@@ -34,3 +43,12 @@ func (HexInfluence) Edges() []ent.Edge {
 		edge.To("users", User.Type).Unique().Field("user_id").Required(),
 	}
 }
+
+func (HexInfluence) Indexes() []ent.Index {
+	return []ent.Index{
+		// A user has a single influence per hex, which lets visits be upserted in bulk.
+		index.Fields("user_id", "h3_index").Unique(),
+		// Hex rankings read every influence in one hex.
+		index.Fields("h3_index"),
+	}
+}
@@ -1,9 +1,12 @@
 package model
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -11,6 +14,9 @@ type TopUser struct {
 	UserID   uuid.UUID `json:"user_id"`
 	UserName string    `json:"user_name"`
 	Score    float64   `json:"score"`
+	// LastUpdated is when Score was last changed, from which its decay is computed on read.
+	// It is zero for entries written before it was stored, whose score is taken as is.
+	LastUpdated time.Time `json:"last_updated"`
 }
 
 type HexLeaderboard struct {
@@ -34,3 +40,10 @@ func (HexLeaderboard) Edges() []ent.Edge {
 		edge.To("hex", Hex.Type).Field("h3_index").Unique().Required(),
 	}
 }
+
+func (HexLeaderboard) Indexes() []ent.Index {
+	return []ent.Index{
+		// The edge column drops the field's uniqueness, so enforce one leaderboard per hex here.
+		index.Fields("h3_index").Unique(),
+	}
+}
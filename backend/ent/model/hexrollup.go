@@ -0,0 +1,49 @@
+package model
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// RollupOwner is a user leading some of the cells under a rollup's coarse cell.
+type RollupOwner struct {
+	UserID   uuid.UUID `json:"user_id"`
+	UserName string    `json:"user_name"`
+	// Hexes is the number of cells at the default resolution the user leads.
+	Hexes int `json:"hexes"`
+}
+
+// HexRollup aggregates the ownership of the cells at the default resolution under a coarser
+// cell, so zoomed-out maps need one row per area instead of thousands of leaderboards.
+type HexRollup struct {
+	ID         uuid.UUID
+	H3Index    string
+	Resolution int
+	// Owners are sorted by the number of cells they lead, the dominant user first.
+	Owners     []RollupOwner
+	OwnedHexes int
+	UpdatedAt  time.Time
+	ent.Schema
+}
+
+func (HexRollup) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.String("h3_index").Unique(),
+		field.Int("resolution"),
+		field.JSON("owners", []RollupOwner{}).Default([]RollupOwner{}),
+		// OwnedHexes is the number of cells under the rollup that have a leader.
+		field.Int("owned_hexes"),
+		field.Time("updated_at"),
+	}
+}
+
+func (HexRollup) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resolution"),
+	}
+}
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type IdempotencyKey struct {
+	ID          uuid.UUID
+	Key         string
+	UserID      uuid.UUID
+	RequestHash string
+	Response    []byte
+	ExpiresAt   time.Time
+	ent.Schema
+}
+
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.String("key").MaxLen(255),
+		field.UUID("user_id", uuid.UUID{}),
+		field.String("request_hash"),
+		// Response is empty while the original request is still being processed.
+		field.Bytes("response").Optional(),
+		field.Time("created_at").Default(time.Now),
+		field.Time("expires_at"),
+	}
+}
+
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "key").Unique(),
+		index.Fields("expires_at"),
+	}
+}
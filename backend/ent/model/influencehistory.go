@@ -0,0 +1,44 @@
+package model
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// InfluenceHistory records one change of a user's score in a hex. The history is append-only and
+// not tied to the activities that caused the changes, so it outlives their deletion.
+type InfluenceHistory struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	H3Index     string
+	ActivityID  *uuid.UUID
+	ScoreBefore float64
+	ScoreAfter  float64
+	RecordedAt  time.Time
+	ent.Schema
+}
+
+func (InfluenceHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("user_id", uuid.UUID{}),
+		field.String("h3_index"),
+		// ActivityID is the activity whose upload or deletion changed the score. It is empty for
+		// live sessions that have no activity yet and for decay sweeps.
+		field.UUID("activity_id", uuid.UUID{}).Optional().Nillable(),
+		field.Float("score_before"),
+		field.Float("score_after"),
+		field.Time("recorded_at"),
+	}
+}
+
+func (InfluenceHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "h3_index", "recorded_at"),
+		index.Fields("user_id", "recorded_at"),
+	}
+}
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+const (
+	// NotificationHexCaptured tells a user that someone took the lead of a hex they led.
+	NotificationHexCaptured = "hex_captured"
+	// NotificationLeaderboardLost tells a user they were pushed out of a hex's leaderboard.
+	NotificationLeaderboardLost = "leaderboard_lost"
+	// NotificationPersonalRecord tells a user they beat one of their personal records.
+	NotificationPersonalRecord = "personal_record"
+)
+
+// NotificationTypes lists every notification type, in the order preferences are shown.
+var NotificationTypes = []string{NotificationHexCaptured, NotificationLeaderboardLost, NotificationPersonalRecord}
+
+// Notification is an entry of a user's in-app inbox.
+type Notification struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	// NotificationType is stored in the type field; a Type field would hide ent.Schema's Type method.
+	NotificationType string
+	Message          string
+	// Data holds the IDs a client needs to open what the notification is about, e.g. h3_index.
+	Data      map[string]string
+	ReadAt    *time.Time
+	CreatedAt time.Time
+	ent.Schema
+}
+
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("user_id", uuid.UUID{}),
+		field.Enum("type").Values(NotificationTypes...),
+		field.String("message"),
+		field.JSON("data", map[string]string{}).Optional(),
+		field.Time("read_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		index.Fields("user_id", "read_at"),
+	}
+}
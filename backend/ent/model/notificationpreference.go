@@ -0,0 +1,33 @@
+package model
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// NotificationPreference turns one type of notification on or off for a user. Types without a
+// preference are on.
+type NotificationPreference struct {
+	ID               uuid.UUID
+	UserID           uuid.UUID
+	NotificationType string
+	Enabled          bool
+	ent.Schema
+}
+
+func (NotificationPreference) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("user_id", uuid.UUID{}),
+		field.Enum("type").Values(NotificationTypes...),
+		field.Bool("enabled"),
+	}
+}
+
+func (NotificationPreference) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "type").Unique(),
+	}
+}
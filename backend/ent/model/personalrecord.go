@@ -0,0 +1,55 @@
+package model
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+const (
+	RecordFastest1K           = "fastest_1k"
+	RecordFastest5K           = "fastest_5k"
+	RecordFastest10K          = "fastest_10k"
+	RecordFastestHalfMarathon = "fastest_half_marathon"
+	RecordLongestDistance     = "longest_distance"
+	RecordLongestDuration     = "longest_duration"
+	RecordMostNewHexes        = "most_new_hexes"
+)
+
+// PersonalRecord is the user's best result of one kind for an activity type.
+type PersonalRecord struct {
+	ID           uuid.UUID
+	UserID       uuid.UUID
+	ActivityType string
+	Record       string
+	Value        float64
+	ActivityID   uuid.UUID
+	AchievedAt   time.Time
+	ent.Schema
+}
+
+func (PersonalRecord) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("user_id", uuid.UUID{}),
+		field.Enum("activity_type").Values(ActivityTypeRun, ActivityTypeWalk, ActivityTypeRide),
+		field.Enum("record").Values(
+			RecordFastest1K, RecordFastest5K, RecordFastest10K, RecordFastestHalfMarathon,
+			RecordLongestDistance, RecordLongestDuration, RecordMostNewHexes,
+		),
+		// Value is in seconds for fastest efforts and durations, meters for distance and a count for hexes.
+		field.Float("value"),
+		field.UUID("activity_id", uuid.UUID{}),
+		field.Time("achieved_at"),
+	}
+}
+
+func (PersonalRecord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "activity_type", "record").Unique(),
+		index.Fields("activity_id"),
+	}
+}
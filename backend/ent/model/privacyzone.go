@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PrivacyZone is an area whose GPS points and cells are hidden from the user's public output.
+// It is either a circle around a point or a set of H3 cells.
+type PrivacyZone struct {
+	ID           uuid.UUID
+	UserID       uuid.UUID
+	Name         string
+	Lat          float64
+	Lng          float64
+	RadiusMeters float64
+	H3Indexes    []string
+	ent.Schema
+}
+
+func (PrivacyZone) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("user_id", uuid.UUID{}),
+		field.String("name").Default(""),
+		field.Float("lat").Default(0),
+		field.Float("lng").Default(0),
+		// RadiusMeters is zero for zones made of cells.
+		field.Float("radius_meters").Default(0),
+		field.JSON("h3_indexes", []string{}).Default([]string{}),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (PrivacyZone) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PushDevice is a device a user receives push notifications on, with its Expo push token.
+type PushDevice struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	DeviceID  string
+	Token     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	ent.Schema
+}
+
+func (PushDevice) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("user_id", uuid.UUID{}),
+		// DeviceID is chosen by the app and stays the same when the device's token changes.
+		field.String("device_id").NotEmpty(),
+		field.String("token").NotEmpty(),
+		field.Time("created_at"),
+		field.Time("updated_at"),
+	}
+}
+
+func (PushDevice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "device_id").Unique(),
+		index.Fields("token"),
+	}
+}
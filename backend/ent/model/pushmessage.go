@@ -0,0 +1,66 @@
+package model
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+const (
+	PushMessagePending = "pending"
+	PushMessageSending = "sending"
+	PushMessageSent    = "sent"
+	// PushMessageSkipped is a message that arrived during the user's quiet hours or after they
+	// removed their devices. The notification is still in the inbox.
+	PushMessageSkipped = "skipped"
+	PushMessageFailed  = "failed"
+)
+
+// PushMessage is a push notification waiting to be sent to all of a user's devices. Messages are
+// queued in the transaction that creates their notification and sent in batches afterwards, so a
+// rolled back notification is never pushed and a slow provider never holds up ingestion.
+type PushMessage struct {
+	ID             uuid.UUID
+	UserID         uuid.UUID
+	NotificationID uuid.UUID
+	Title          string
+	Body           string
+	Data           map[string]string
+	Status         string
+	Attempts       int
+	LockedUntil    *time.Time
+	LastError      *string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	ent.Schema
+}
+
+func (PushMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("user_id", uuid.UUID{}),
+		field.UUID("notification_id", uuid.UUID{}),
+		field.String("title"),
+		field.String("body"),
+		field.JSON("data", map[string]string{}).Optional(),
+		field.Enum("status").
+			Values(PushMessagePending, PushMessageSending, PushMessageSent, PushMessageSkipped, PushMessageFailed).
+			Default(PushMessagePending),
+		field.Int("attempts").Default(0),
+		// LockedUntil is when a message being sent is given up on and may be claimed again, or
+		// when a pending message that failed is retried.
+		field.Time("locked_until").Optional().Nillable(),
+		field.String("last_error").Optional().Nillable(),
+		field.Time("created_at"),
+		field.Time("updated_at"),
+	}
+}
+
+func (PushMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "created_at"),
+	}
+}
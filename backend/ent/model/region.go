@@ -0,0 +1,44 @@
+package model
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Region is a named area, such as a district or a city, loaded from a GeoJSON boundary. Its
+// boundary is stored as the cells at the default resolution it covers.
+type Region struct {
+	ID       uuid.UUID
+	Slug     string
+	Name     string
+	Kind     string
+	HexCount int
+	ent.Schema
+}
+
+func (Region) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		// Slug identifies the region in URLs and when its boundary is loaded again.
+		field.String("slug").NotEmpty().Unique(),
+		field.String("name").NotEmpty(),
+		// Kind is a free-form label such as "district" or "city".
+		field.String("kind").Default(""),
+		field.Int("hex_count"),
+		// StandingsRefreshedAt is when the region's standings were last recomputed.
+		field.Time("standings_refreshed_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now),
+	}
+}
+
+func (Region) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("hexes", RegionHex.Type).Ref("region"),
+		edge.From("standings", RegionStanding.Type).Ref("region"),
+	}
+}
@@ -0,0 +1,44 @@
+package model
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// RegionHex is a cell at the default resolution inside a region. A cell can be in several
+// regions, such as a district and the city around it.
+type RegionHex struct {
+	ID       uuid.UUID
+	RegionID uuid.UUID
+	H3Index  string
+	ent.Schema
+}
+
+func (RegionHex) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("region_id", uuid.UUID{}),
+		field.String("h3_index"),
+	}
+}
+
+func (RegionHex) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("region", Region.Type).
+			Field("region_id").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (RegionHex) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("region_id", "h3_index").Unique(),
+		index.Fields("h3_index"),
+	}
+}
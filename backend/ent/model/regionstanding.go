@@ -0,0 +1,52 @@
+package model
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// RegionStanding is a user's place in a region's leaderboard as of the region's last refresh.
+// Users are ranked by the hexes they lead inside the region, then by their total influence there.
+type RegionStanding struct {
+	ID             uuid.UUID
+	RegionID       uuid.UUID
+	Rank           int
+	UserID         uuid.UUID
+	Username       string
+	HexesOwned     int
+	TotalInfluence float64
+	ent.Schema
+}
+
+func (RegionStanding) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("region_id", uuid.UUID{}),
+		field.Int("rank").Positive(),
+		field.UUID("user_id", uuid.UUID{}),
+		field.String("username"),
+		field.Int("hexes_owned"),
+		field.Float("total_influence"),
+	}
+}
+
+func (RegionStanding) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("region", Region.Type).
+			Field("region_id").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (RegionStanding) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("region_id", "rank"),
+		index.Fields("region_id", "user_id"),
+	}
+}
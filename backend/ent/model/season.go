@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+const (
+	SeasonActive   = "active"
+	SeasonArchived = "archived"
+)
+
+// Season is a period of the game whose final standings are archived when it ends. The live hex
+// influences and leaderboards always belong to the active season; at rollover they are reset or
+// carried over into the next one by the season's carry over fraction.
+type Season struct {
+	ID         uuid.UUID
+	Number     int
+	Name       string
+	StartsAt   time.Time
+	EndsAt     time.Time
+	CarryOver  float64
+	Status     string
+	ArchivedAt *time.Time
+	ent.Schema
+}
+
+func (Season) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.Int("number").Positive(),
+		field.String("name"),
+		field.Time("starts_at"),
+		field.Time("ends_at"),
+		// CarryOver is the fraction of the final scores kept into the next season, 0 resets them.
+		field.Float("carry_over").Default(0),
+		field.Enum("status").Values(SeasonActive, SeasonArchived).Default(SeasonActive),
+		field.Time("archived_at").Optional().Nillable(),
+	}
+}
+
+func (Season) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("number").Unique(),
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SeasonHexStanding is one place of a hex's final leaderboard in an archived season.
+type SeasonHexStanding struct {
+	ID       uuid.UUID
+	SeasonID uuid.UUID
+	H3Index  string
+	Rank     int
+	UserID   uuid.UUID
+	Username string
+	Score    float64
+	ent.Schema
+}
+
+func (SeasonHexStanding) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("season_id", uuid.UUID{}),
+		field.String("h3_index"),
+		field.Int("rank").Positive(),
+		field.UUID("user_id", uuid.UUID{}),
+		field.String("username"),
+		// Score is the effective score at the end of the season.
+		field.Float("score"),
+	}
+}
+
+func (SeasonHexStanding) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("season_id", "h3_index", "rank").Unique(),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SeasonStanding is a user's final place in the global standings of an archived season. Users are
+// ranked by the hexes they led, then by their total influence, both at the end of the season.
+type SeasonStanding struct {
+	ID             uuid.UUID
+	SeasonID       uuid.UUID
+	Rank           int
+	UserID         uuid.UUID
+	Username       string
+	HexesOwned     int
+	TotalInfluence float64
+	ent.Schema
+}
+
+func (SeasonStanding) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("season_id", uuid.UUID{}),
+		field.Int("rank").Positive(),
+		field.UUID("user_id", uuid.UUID{}),
+		// Username is the name the user had when the season ended.
+		field.String("username"),
+		field.Int("hexes_owned"),
+		field.Float("total_influence"),
+	}
+}
+
+func (SeasonStanding) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("season_id", "rank"),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Segment is a named, ordered path of neighbouring cells that activities are timed over.
+type Segment struct {
+	ID           uuid.UUID
+	Name         string
+	CreatorID    uuid.UUID
+	H3Indexes    []string
+	StartH3Index string
+	ent.Schema
+}
+
+func (Segment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.String("name").NotEmpty(),
+		field.UUID("creator_id", uuid.UUID{}),
+		field.JSON("h3_indexes", []string{}),
+		// StartH3Index is the first cell of the path. Candidate segments for an activity are
+		// looked up by it, so matching does not scan every segment.
+		field.String("start_h3_index"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (Segment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("efforts", SegmentEffort.Type).Ref("segment"),
+	}
+}
+
+func (Segment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("start_h3_index"),
+		index.Fields("creator_id"),
+	}
+}
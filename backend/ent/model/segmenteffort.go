@@ -0,0 +1,62 @@
+package model
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SegmentEffort is one traversal of a segment by an activity.
+type SegmentEffort struct {
+	ID             uuid.UUID
+	SegmentID      uuid.UUID
+	UserID         uuid.UUID
+	ActivityID     uuid.UUID
+	ElapsedSeconds float64
+	StartedAt      time.Time
+	Estimated      bool
+	ent.Schema
+}
+
+func (SegmentEffort) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("segment_id", uuid.UUID{}),
+		field.UUID("user_id", uuid.UUID{}),
+		field.UUID("activity_id", uuid.UUID{}),
+		field.Float("elapsed_seconds"),
+		// StartedAt is when the activity entered the first cell of the segment.
+		field.Time("started_at"),
+		// Estimated efforts were timed from cells spread evenly over an activity without a timed
+		// track. They are shown to the athlete but kept off the segment leaderboard.
+		field.Bool("estimated").Default(false),
+	}
+}
+
+func (SegmentEffort) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("segment", Segment.Type).
+			Field("segment_id").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("activity", Activity.Type).
+			Field("activity_id").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (SegmentEffort) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("segment_id", "elapsed_seconds"),
+		index.Fields("segment_id", "user_id"),
+		index.Fields("activity_id"),
+	}
+}
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Setting is a value kept across restarts under a key, such as the configuration that stored
+// data was derived with, so a restart with a different configuration can tell it changed.
+type Setting struct {
+	ID        string
+	Value     string
+	UpdatedAt time.Time
+	ent.Schema
+}
+
+func (Setting) Fields() []ent.Field {
+	return []ent.Field{
+		// ID is the setting's key.
+		field.String("id").Immutable(),
+		field.String("value"),
+		field.Time("updated_at"),
+	}
+}
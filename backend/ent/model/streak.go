@@ -0,0 +1,45 @@
+package model
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Calendar periods streaks and goals are tracked over.
+const (
+	PeriodDay  = "day"
+	PeriodWeek = "week"
+)
+
+// Streak counts consecutive days or weeks in which the user recorded a qualifying activity.
+type Streak struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Period     string
+	Current    int
+	Longest    int
+	LastPeriod time.Time
+	ent.Schema
+}
+
+func (Streak) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("user_id", uuid.UUID{}),
+		field.Enum("period").Values(PeriodDay, PeriodWeek),
+		field.Int("current").Default(0),
+		field.Int("longest").Default(0),
+		// LastPeriod is the local date the last period with a qualifying activity started on, stored as midnight UTC.
+		field.Time("last_period"),
+	}
+}
+
+func (Streak) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "period").Unique(),
+	}
+}
@@ -12,6 +12,13 @@ type User struct {
 	ID           uuid.UUID
 	ExternalUser uuid.UUID
 	Username     string
+	TimeZone     string
+	// HideZoneLeaderboards keeps the user off the leaderboards of hexes inside their privacy zones.
+	HideZoneLeaderboards bool
+	// QuietHoursStart and QuietHoursEnd are minutes after local midnight between which no push
+	// notifications are sent. Both are nil when the user has no quiet hours.
+	QuietHoursStart *int
+	QuietHoursEnd   *int
 	ent.Schema
 }
 
@@ -20,6 +27,11 @@ func (User) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
 		field.UUID("external_user", uuid.UUID{}),
 		field.String("username"),
+		// TimeZone is an IANA zone name used to split the user's activities into days.
+		field.String("time_zone").Default("UTC"),
+		field.Bool("hide_zone_leaderboards").Default(false),
+		field.Int("quiet_hours_start").Optional().Nillable(),
+		field.Int("quiet_hours_end").Optional().Nillable(),
 	}
 }
 
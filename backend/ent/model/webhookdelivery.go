@@ -0,0 +1,68 @@
+package model
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+const (
+	WebhookDeliveryPending    = "pending"
+	WebhookDeliveryDelivering = "delivering"
+	WebhookDeliveryDelivered  = "delivered"
+	WebhookDeliveryFailed     = "failed"
+)
+
+// WebhookDelivery is one event sent, or to be sent, to one subscription. Deliveries are queued in
+// the transaction that produced the event and kept afterwards as the subscription's delivery log.
+type WebhookDelivery struct {
+	ID             uuid.UUID
+	SubscriptionID uuid.UUID
+	EventID        uuid.UUID
+	EventType      string
+	Payload        string
+	Status         string
+	Attempts       int
+	NextAttemptAt  time.Time
+	LockedUntil    *time.Time
+	ResponseStatus *int
+	LastError      *string
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	ent.Schema
+}
+
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("subscription_id", uuid.UUID{}),
+		// EventID is shared by the deliveries of the same event to different subscriptions.
+		field.UUID("event_id", uuid.UUID{}),
+		field.Enum("event_type").Values(WebhookActivityCreated, WebhookHexCaptured, WebhookLeaderboardChanged),
+		// Payload is the exact body that was signed.
+		field.Text("payload"),
+		field.Enum("status").
+			Values(WebhookDeliveryPending, WebhookDeliveryDelivering, WebhookDeliveryDelivered, WebhookDeliveryFailed).
+			Default(WebhookDeliveryPending),
+		field.Int("attempts").Default(0),
+		field.Time("next_attempt_at"),
+		// LockedUntil is when a delivery being sent is given up on and may be claimed again.
+		field.Time("locked_until").Optional().Nillable(),
+		field.Int("response_status").Optional().Nillable(),
+		field.String("last_error").Optional().Nillable(),
+		field.Time("delivered_at").Optional().Nillable(),
+		field.Time("created_at"),
+		field.Time("updated_at"),
+	}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("subscription_id", "created_at"),
+	}
+}
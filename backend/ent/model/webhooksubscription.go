@@ -0,0 +1,49 @@
+package model
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Game events webhook subscriptions can select.
+const (
+	WebhookActivityCreated    = "activity_created"
+	WebhookHexCaptured        = "hex_captured"
+	WebhookLeaderboardChanged = "leaderboard_changed"
+)
+
+var WebhookEventTypes = []string{WebhookActivityCreated, WebhookHexCaptured, WebhookLeaderboardChanged}
+
+// WebhookSubscription sends the game events of the selected types to a URL, signed with the
+// subscription's secret.
+type WebhookSubscription struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	URL        string
+	EventTypes []string
+	Secret     string
+	CreatedAt  time.Time
+	ent.Schema
+}
+
+func (WebhookSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		// UserID is the user who manages the subscription. It receives the events of all users.
+		field.UUID("user_id", uuid.UUID{}),
+		field.String("url").NotEmpty(),
+		field.JSON("event_types", []string{}),
+		field.String("secret").NotEmpty().Sensitive(),
+		field.Time("created_at"),
+	}
+}
+
+func (WebhookSubscription) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
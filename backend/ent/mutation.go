@@ -7,13 +7,40 @@ import (
 	"errors"
 	"fmt"
 	"stride-wars-app/ent/activity"
+	"stride-wars-app/ent/activityhex"
+	"stride-wars-app/ent/activityjob"
+	"stride-wars-app/ent/activitysession"
+	"stride-wars-app/ent/decaysweep"
 	"stride-wars-app/ent/friendship"
+	"stride-wars-app/ent/goal"
 	"stride-wars-app/ent/hex"
+	"stride-wars-app/ent/hexcapture"
 	"stride-wars-app/ent/hexinfluence"
 	"stride-wars-app/ent/hexleaderboard"
+	"stride-wars-app/ent/hexrollup"
+	"stride-wars-app/ent/idempotencykey"
+	"stride-wars-app/ent/influencehistory"
 	"stride-wars-app/ent/model"
+	"stride-wars-app/ent/notification"
+	"stride-wars-app/ent/notificationpreference"
+	"stride-wars-app/ent/personalrecord"
 	"stride-wars-app/ent/predicate"
+	"stride-wars-app/ent/privacyzone"
+	"stride-wars-app/ent/pushdevice"
+	"stride-wars-app/ent/pushmessage"
+	"stride-wars-app/ent/region"
+	"stride-wars-app/ent/regionhex"
+	"stride-wars-app/ent/regionstanding"
+	"stride-wars-app/ent/season"
+	"stride-wars-app/ent/seasonhexstanding"
+	"stride-wars-app/ent/seasonstanding"
+	"stride-wars-app/ent/segment"
+	"stride-wars-app/ent/segmenteffort"
+	"stride-wars-app/ent/setting"
+	"stride-wars-app/ent/streak"
 	"stride-wars-app/ent/user"
+	"stride-wars-app/ent/webhookdelivery"
+	"stride-wars-app/ent/webhooksubscription"
 	"sync"
 	"time"
 
@@ -31,33 +58,76 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeActivity       = "Activity"
-	TypeFriendship     = "Friendship"
-	TypeHex            = "Hex"
-	TypeHexInfluence   = "HexInfluence"
-	TypeHexLeaderboard = "HexLeaderboard"
-	TypeUser           = "User"
+	TypeActivity               = "Activity"
+	TypeActivityHex            = "ActivityHex"
+	TypeActivityJob            = "ActivityJob"
+	TypeActivitySession        = "ActivitySession"
+	TypeDecaySweep             = "DecaySweep"
+	TypeFriendship             = "Friendship"
+	TypeGoal                   = "Goal"
+	TypeHex                    = "Hex"
+	TypeHexCapture             = "HexCapture"
+	TypeHexInfluence           = "HexInfluence"
+	TypeHexLeaderboard         = "HexLeaderboard"
+	TypeHexRollup              = "HexRollup"
+	TypeIdempotencyKey         = "IdempotencyKey"
+	TypeInfluenceHistory       = "InfluenceHistory"
+	TypeNotification           = "Notification"
+	TypeNotificationPreference = "NotificationPreference"
+	TypePersonalRecord         = "PersonalRecord"
+	TypePrivacyZone            = "PrivacyZone"
+	TypePushDevice             = "PushDevice"
+	TypePushMessage            = "PushMessage"
+	TypeRegion                 = "Region"
+	TypeRegionHex              = "RegionHex"
+	TypeRegionStanding         = "RegionStanding"
+	TypeSeason                 = "Season"
+	TypeSeasonHexStanding      = "SeasonHexStanding"
+	TypeSeasonStanding         = "SeasonStanding"
+	TypeSegment                = "Segment"
+	TypeSegmentEffort          = "SegmentEffort"
+	TypeSetting                = "Setting"
+	TypeStreak                 = "Streak"
+	TypeUser                   = "User"
+	TypeWebhookDelivery        = "WebhookDelivery"
+	TypeWebhookSubscription    = "WebhookSubscription"
 )
 
 // ActivityMutation represents an operation that mutates the Activity nodes in the graph.
 type ActivityMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *uuid.UUID
-	duration_seconds    *float64
-	addduration_seconds *float64
-	distance_meters     *float64
-	adddistance_meters  *float64
-	h3_indexes          *[]string
-	appendh3_indexes    []string
-	created_at          *time.Time
-	clearedFields       map[string]struct{}
-	user                *uuid.UUID
-	cleareduser         bool
-	done                bool
-	oldValue            func(context.Context) (*Activity, error)
-	predicates          []predicate.Activity
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	duration_seconds       *float64
+	addduration_seconds    *float64
+	distance_meters        *float64
+	adddistance_meters     *float64
+	h3_indexes             *[]string
+	appendh3_indexes       []string
+	created_at             *time.Time
+	track                  *[]model.TrackPoint
+	appendtrack            []model.TrackPoint
+	started_at             *time.Time
+	ended_at               *time.Time
+	activity_type          *activity.ActivityType
+	new_hexes              *int
+	addnew_hexes           *int
+	clearedFields          map[string]struct{}
+	user                   *uuid.UUID
+	cleareduser            bool
+	hexes                  map[uuid.UUID]struct{}
+	removedhexes           map[uuid.UUID]struct{}
+	clearedhexes           bool
+	segment_efforts        map[uuid.UUID]struct{}
+	removedsegment_efforts map[uuid.UUID]struct{}
+	clearedsegment_efforts bool
+	jobs                   map[uuid.UUID]struct{}
+	removedjobs            map[uuid.UUID]struct{}
+	clearedjobs            bool
+	done                   bool
+	oldValue               func(context.Context) (*Activity, error)
+	predicates             []predicate.Activity
 }
 
 var _ ent.Mutation = (*ActivityMutation)(nil)
@@ -399,6 +469,261 @@ func (m *ActivityMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
+// SetTrack sets the "track" field.
+func (m *ActivityMutation) SetTrack(mp []model.TrackPoint) {
+	m.track = &mp
+	m.appendtrack = nil
+}
+
+// Track returns the value of the "track" field in the mutation.
+func (m *ActivityMutation) Track() (r []model.TrackPoint, exists bool) {
+	v := m.track
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrack returns the old "track" field's value of the Activity entity.
+// If the Activity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityMutation) OldTrack(ctx context.Context) (v []model.TrackPoint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrack is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrack requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrack: %w", err)
+	}
+	return oldValue.Track, nil
+}
+
+// AppendTrack adds mp to the "track" field.
+func (m *ActivityMutation) AppendTrack(mp []model.TrackPoint) {
+	m.appendtrack = append(m.appendtrack, mp...)
+}
+
+// AppendedTrack returns the list of values that were appended to the "track" field in this mutation.
+func (m *ActivityMutation) AppendedTrack() ([]model.TrackPoint, bool) {
+	if len(m.appendtrack) == 0 {
+		return nil, false
+	}
+	return m.appendtrack, true
+}
+
+// ClearTrack clears the value of the "track" field.
+func (m *ActivityMutation) ClearTrack() {
+	m.track = nil
+	m.appendtrack = nil
+	m.clearedFields[activity.FieldTrack] = struct{}{}
+}
+
+// TrackCleared returns if the "track" field was cleared in this mutation.
+func (m *ActivityMutation) TrackCleared() bool {
+	_, ok := m.clearedFields[activity.FieldTrack]
+	return ok
+}
+
+// ResetTrack resets all changes to the "track" field.
+func (m *ActivityMutation) ResetTrack() {
+	m.track = nil
+	m.appendtrack = nil
+	delete(m.clearedFields, activity.FieldTrack)
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *ActivityMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *ActivityMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the Activity entity.
+// If the Activity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (m *ActivityMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[activity.FieldStartedAt] = struct{}{}
+}
+
+// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
+func (m *ActivityMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[activity.FieldStartedAt]
+	return ok
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *ActivityMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, activity.FieldStartedAt)
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (m *ActivityMutation) SetEndedAt(t time.Time) {
+	m.ended_at = &t
+}
+
+// EndedAt returns the value of the "ended_at" field in the mutation.
+func (m *ActivityMutation) EndedAt() (r time.Time, exists bool) {
+	v := m.ended_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndedAt returns the old "ended_at" field's value of the Activity entity.
+// If the Activity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityMutation) OldEndedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndedAt: %w", err)
+	}
+	return oldValue.EndedAt, nil
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (m *ActivityMutation) ClearEndedAt() {
+	m.ended_at = nil
+	m.clearedFields[activity.FieldEndedAt] = struct{}{}
+}
+
+// EndedAtCleared returns if the "ended_at" field was cleared in this mutation.
+func (m *ActivityMutation) EndedAtCleared() bool {
+	_, ok := m.clearedFields[activity.FieldEndedAt]
+	return ok
+}
+
+// ResetEndedAt resets all changes to the "ended_at" field.
+func (m *ActivityMutation) ResetEndedAt() {
+	m.ended_at = nil
+	delete(m.clearedFields, activity.FieldEndedAt)
+}
+
+// SetActivityType sets the "activity_type" field.
+func (m *ActivityMutation) SetActivityType(at activity.ActivityType) {
+	m.activity_type = &at
+}
+
+// ActivityType returns the value of the "activity_type" field in the mutation.
+func (m *ActivityMutation) ActivityType() (r activity.ActivityType, exists bool) {
+	v := m.activity_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActivityType returns the old "activity_type" field's value of the Activity entity.
+// If the Activity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityMutation) OldActivityType(ctx context.Context) (v activity.ActivityType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActivityType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActivityType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActivityType: %w", err)
+	}
+	return oldValue.ActivityType, nil
+}
+
+// ResetActivityType resets all changes to the "activity_type" field.
+func (m *ActivityMutation) ResetActivityType() {
+	m.activity_type = nil
+}
+
+// SetNewHexes sets the "new_hexes" field.
+func (m *ActivityMutation) SetNewHexes(i int) {
+	m.new_hexes = &i
+	m.addnew_hexes = nil
+}
+
+// NewHexes returns the value of the "new_hexes" field in the mutation.
+func (m *ActivityMutation) NewHexes() (r int, exists bool) {
+	v := m.new_hexes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNewHexes returns the old "new_hexes" field's value of the Activity entity.
+// If the Activity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityMutation) OldNewHexes(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNewHexes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNewHexes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNewHexes: %w", err)
+	}
+	return oldValue.NewHexes, nil
+}
+
+// AddNewHexes adds i to the "new_hexes" field.
+func (m *ActivityMutation) AddNewHexes(i int) {
+	if m.addnew_hexes != nil {
+		*m.addnew_hexes += i
+	} else {
+		m.addnew_hexes = &i
+	}
+}
+
+// AddedNewHexes returns the value that was added to the "new_hexes" field in this mutation.
+func (m *ActivityMutation) AddedNewHexes() (r int, exists bool) {
+	v := m.addnew_hexes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNewHexes resets all changes to the "new_hexes" field.
+func (m *ActivityMutation) ResetNewHexes() {
+	m.new_hexes = nil
+	m.addnew_hexes = nil
+}
+
 // ClearUser clears the "user" edge to the User entity.
 func (m *ActivityMutation) ClearUser() {
 	m.cleareduser = true
@@ -426,6 +751,168 @@ func (m *ActivityMutation) ResetUser() {
 	m.cleareduser = false
 }
 
+// AddHexIDs adds the "hexes" edge to the ActivityHex entity by ids.
+func (m *ActivityMutation) AddHexIDs(ids ...uuid.UUID) {
+	if m.hexes == nil {
+		m.hexes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.hexes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHexes clears the "hexes" edge to the ActivityHex entity.
+func (m *ActivityMutation) ClearHexes() {
+	m.clearedhexes = true
+}
+
+// HexesCleared reports if the "hexes" edge to the ActivityHex entity was cleared.
+func (m *ActivityMutation) HexesCleared() bool {
+	return m.clearedhexes
+}
+
+// RemoveHexIDs removes the "hexes" edge to the ActivityHex entity by IDs.
+func (m *ActivityMutation) RemoveHexIDs(ids ...uuid.UUID) {
+	if m.removedhexes == nil {
+		m.removedhexes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.hexes, ids[i])
+		m.removedhexes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHexes returns the removed IDs of the "hexes" edge to the ActivityHex entity.
+func (m *ActivityMutation) RemovedHexesIDs() (ids []uuid.UUID) {
+	for id := range m.removedhexes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HexesIDs returns the "hexes" edge IDs in the mutation.
+func (m *ActivityMutation) HexesIDs() (ids []uuid.UUID) {
+	for id := range m.hexes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHexes resets all changes to the "hexes" edge.
+func (m *ActivityMutation) ResetHexes() {
+	m.hexes = nil
+	m.clearedhexes = false
+	m.removedhexes = nil
+}
+
+// AddSegmentEffortIDs adds the "segment_efforts" edge to the SegmentEffort entity by ids.
+func (m *ActivityMutation) AddSegmentEffortIDs(ids ...uuid.UUID) {
+	if m.segment_efforts == nil {
+		m.segment_efforts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.segment_efforts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSegmentEfforts clears the "segment_efforts" edge to the SegmentEffort entity.
+func (m *ActivityMutation) ClearSegmentEfforts() {
+	m.clearedsegment_efforts = true
+}
+
+// SegmentEffortsCleared reports if the "segment_efforts" edge to the SegmentEffort entity was cleared.
+func (m *ActivityMutation) SegmentEffortsCleared() bool {
+	return m.clearedsegment_efforts
+}
+
+// RemoveSegmentEffortIDs removes the "segment_efforts" edge to the SegmentEffort entity by IDs.
+func (m *ActivityMutation) RemoveSegmentEffortIDs(ids ...uuid.UUID) {
+	if m.removedsegment_efforts == nil {
+		m.removedsegment_efforts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.segment_efforts, ids[i])
+		m.removedsegment_efforts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSegmentEfforts returns the removed IDs of the "segment_efforts" edge to the SegmentEffort entity.
+func (m *ActivityMutation) RemovedSegmentEffortsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsegment_efforts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SegmentEffortsIDs returns the "segment_efforts" edge IDs in the mutation.
+func (m *ActivityMutation) SegmentEffortsIDs() (ids []uuid.UUID) {
+	for id := range m.segment_efforts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSegmentEfforts resets all changes to the "segment_efforts" edge.
+func (m *ActivityMutation) ResetSegmentEfforts() {
+	m.segment_efforts = nil
+	m.clearedsegment_efforts = false
+	m.removedsegment_efforts = nil
+}
+
+// AddJobIDs adds the "jobs" edge to the ActivityJob entity by ids.
+func (m *ActivityMutation) AddJobIDs(ids ...uuid.UUID) {
+	if m.jobs == nil {
+		m.jobs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.jobs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearJobs clears the "jobs" edge to the ActivityJob entity.
+func (m *ActivityMutation) ClearJobs() {
+	m.clearedjobs = true
+}
+
+// JobsCleared reports if the "jobs" edge to the ActivityJob entity was cleared.
+func (m *ActivityMutation) JobsCleared() bool {
+	return m.clearedjobs
+}
+
+// RemoveJobIDs removes the "jobs" edge to the ActivityJob entity by IDs.
+func (m *ActivityMutation) RemoveJobIDs(ids ...uuid.UUID) {
+	if m.removedjobs == nil {
+		m.removedjobs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.jobs, ids[i])
+		m.removedjobs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedJobs returns the removed IDs of the "jobs" edge to the ActivityJob entity.
+func (m *ActivityMutation) RemovedJobsIDs() (ids []uuid.UUID) {
+	for id := range m.removedjobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// JobsIDs returns the "jobs" edge IDs in the mutation.
+func (m *ActivityMutation) JobsIDs() (ids []uuid.UUID) {
+	for id := range m.jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetJobs resets all changes to the "jobs" edge.
+func (m *ActivityMutation) ResetJobs() {
+	m.jobs = nil
+	m.clearedjobs = false
+	m.removedjobs = nil
+}
+
 // Where appends a list predicates to the ActivityMutation builder.
 func (m *ActivityMutation) Where(ps ...predicate.Activity) {
 	m.predicates = append(m.predicates, ps...)
@@ -460,7 +947,7 @@ func (m *ActivityMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ActivityMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+	fields := make([]string, 0, 10)
 	if m.user != nil {
 		fields = append(fields, activity.FieldUserID)
 	}
@@ -476,6 +963,21 @@ func (m *ActivityMutation) Fields() []string {
 	if m.created_at != nil {
 		fields = append(fields, activity.FieldCreatedAt)
 	}
+	if m.track != nil {
+		fields = append(fields, activity.FieldTrack)
+	}
+	if m.started_at != nil {
+		fields = append(fields, activity.FieldStartedAt)
+	}
+	if m.ended_at != nil {
+		fields = append(fields, activity.FieldEndedAt)
+	}
+	if m.activity_type != nil {
+		fields = append(fields, activity.FieldActivityType)
+	}
+	if m.new_hexes != nil {
+		fields = append(fields, activity.FieldNewHexes)
+	}
 	return fields
 }
 
@@ -494,6 +996,16 @@ func (m *ActivityMutation) Field(name string) (ent.Value, bool) {
 		return m.H3Indexes()
 	case activity.FieldCreatedAt:
 		return m.CreatedAt()
+	case activity.FieldTrack:
+		return m.Track()
+	case activity.FieldStartedAt:
+		return m.StartedAt()
+	case activity.FieldEndedAt:
+		return m.EndedAt()
+	case activity.FieldActivityType:
+		return m.ActivityType()
+	case activity.FieldNewHexes:
+		return m.NewHexes()
 	}
 	return nil, false
 }
@@ -513,6 +1025,16 @@ func (m *ActivityMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldH3Indexes(ctx)
 	case activity.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case activity.FieldTrack:
+		return m.OldTrack(ctx)
+	case activity.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case activity.FieldEndedAt:
+		return m.OldEndedAt(ctx)
+	case activity.FieldActivityType:
+		return m.OldActivityType(ctx)
+	case activity.FieldNewHexes:
+		return m.OldNewHexes(ctx)
 	}
 	return nil, fmt.Errorf("unknown Activity field %s", name)
 }
@@ -557,6 +1079,41 @@ func (m *ActivityMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCreatedAt(v)
 		return nil
+	case activity.FieldTrack:
+		v, ok := value.([]model.TrackPoint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrack(v)
+		return nil
+	case activity.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case activity.FieldEndedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndedAt(v)
+		return nil
+	case activity.FieldActivityType:
+		v, ok := value.(activity.ActivityType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActivityType(v)
+		return nil
+	case activity.FieldNewHexes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNewHexes(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Activity field %s", name)
 }
@@ -571,6 +1128,9 @@ func (m *ActivityMutation) AddedFields() []string {
 	if m.adddistance_meters != nil {
 		fields = append(fields, activity.FieldDistanceMeters)
 	}
+	if m.addnew_hexes != nil {
+		fields = append(fields, activity.FieldNewHexes)
+	}
 	return fields
 }
 
@@ -583,6 +1143,8 @@ func (m *ActivityMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedDurationSeconds()
 	case activity.FieldDistanceMeters:
 		return m.AddedDistanceMeters()
+	case activity.FieldNewHexes:
+		return m.AddedNewHexes()
 	}
 	return nil, false
 }
@@ -606,6 +1168,13 @@ func (m *ActivityMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddDistanceMeters(v)
 		return nil
+	case activity.FieldNewHexes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNewHexes(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Activity numeric field %s", name)
 }
@@ -613,11 +1182,21 @@ func (m *ActivityMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *ActivityMutation) ClearedFields() []string {
-	return nil
-}
-
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
+	var fields []string
+	if m.FieldCleared(activity.FieldTrack) {
+		fields = append(fields, activity.FieldTrack)
+	}
+	if m.FieldCleared(activity.FieldStartedAt) {
+		fields = append(fields, activity.FieldStartedAt)
+	}
+	if m.FieldCleared(activity.FieldEndedAt) {
+		fields = append(fields, activity.FieldEndedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
 func (m *ActivityMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
@@ -626,6 +1205,17 @@ func (m *ActivityMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *ActivityMutation) ClearField(name string) error {
+	switch name {
+	case activity.FieldTrack:
+		m.ClearTrack()
+		return nil
+	case activity.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case activity.FieldEndedAt:
+		m.ClearEndedAt()
+		return nil
+	}
 	return fmt.Errorf("unknown Activity nullable field %s", name)
 }
 
@@ -648,16 +1238,40 @@ func (m *ActivityMutation) ResetField(name string) error {
 	case activity.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case activity.FieldTrack:
+		m.ResetTrack()
+		return nil
+	case activity.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case activity.FieldEndedAt:
+		m.ResetEndedAt()
+		return nil
+	case activity.FieldActivityType:
+		m.ResetActivityType()
+		return nil
+	case activity.FieldNewHexes:
+		m.ResetNewHexes()
+		return nil
 	}
 	return fmt.Errorf("unknown Activity field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *ActivityMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 4)
 	if m.user != nil {
 		edges = append(edges, activity.EdgeUser)
 	}
+	if m.hexes != nil {
+		edges = append(edges, activity.EdgeHexes)
+	}
+	if m.segment_efforts != nil {
+		edges = append(edges, activity.EdgeSegmentEfforts)
+	}
+	if m.jobs != nil {
+		edges = append(edges, activity.EdgeJobs)
+	}
 	return edges
 }
 
@@ -669,28 +1283,84 @@ func (m *ActivityMutation) AddedIDs(name string) []ent.Value {
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
+	case activity.EdgeHexes:
+		ids := make([]ent.Value, 0, len(m.hexes))
+		for id := range m.hexes {
+			ids = append(ids, id)
+		}
+		return ids
+	case activity.EdgeSegmentEfforts:
+		ids := make([]ent.Value, 0, len(m.segment_efforts))
+		for id := range m.segment_efforts {
+			ids = append(ids, id)
+		}
+		return ids
+	case activity.EdgeJobs:
+		ids := make([]ent.Value, 0, len(m.jobs))
+		for id := range m.jobs {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *ActivityMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 4)
+	if m.removedhexes != nil {
+		edges = append(edges, activity.EdgeHexes)
+	}
+	if m.removedsegment_efforts != nil {
+		edges = append(edges, activity.EdgeSegmentEfforts)
+	}
+	if m.removedjobs != nil {
+		edges = append(edges, activity.EdgeJobs)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
 func (m *ActivityMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case activity.EdgeHexes:
+		ids := make([]ent.Value, 0, len(m.removedhexes))
+		for id := range m.removedhexes {
+			ids = append(ids, id)
+		}
+		return ids
+	case activity.EdgeSegmentEfforts:
+		ids := make([]ent.Value, 0, len(m.removedsegment_efforts))
+		for id := range m.removedsegment_efforts {
+			ids = append(ids, id)
+		}
+		return ids
+	case activity.EdgeJobs:
+		ids := make([]ent.Value, 0, len(m.removedjobs))
+		for id := range m.removedjobs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *ActivityMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 4)
 	if m.cleareduser {
 		edges = append(edges, activity.EdgeUser)
 	}
+	if m.clearedhexes {
+		edges = append(edges, activity.EdgeHexes)
+	}
+	if m.clearedsegment_efforts {
+		edges = append(edges, activity.EdgeSegmentEfforts)
+	}
+	if m.clearedjobs {
+		edges = append(edges, activity.EdgeJobs)
+	}
 	return edges
 }
 
@@ -700,6 +1370,12 @@ func (m *ActivityMutation) EdgeCleared(name string) bool {
 	switch name {
 	case activity.EdgeUser:
 		return m.cleareduser
+	case activity.EdgeHexes:
+		return m.clearedhexes
+	case activity.EdgeSegmentEfforts:
+		return m.clearedsegment_efforts
+	case activity.EdgeJobs:
+		return m.clearedjobs
 	}
 	return false
 }
@@ -722,38 +1398,47 @@ func (m *ActivityMutation) ResetEdge(name string) error {
 	case activity.EdgeUser:
 		m.ResetUser()
 		return nil
+	case activity.EdgeHexes:
+		m.ResetHexes()
+		return nil
+	case activity.EdgeSegmentEfforts:
+		m.ResetSegmentEfforts()
+		return nil
+	case activity.EdgeJobs:
+		m.ResetJobs()
+		return nil
 	}
 	return fmt.Errorf("unknown Activity edge %s", name)
 }
 
-// FriendshipMutation represents an operation that mutates the Friendship nodes in the graph.
-type FriendshipMutation struct {
+// ActivityHexMutation represents an operation that mutates the ActivityHex nodes in the graph.
+type ActivityHexMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	created_at     *time.Time
-	clearedFields  map[string]struct{}
-	users          *uuid.UUID
-	clearedusers   bool
-	friends        *uuid.UUID
-	clearedfriends bool
-	done           bool
-	oldValue       func(context.Context) (*Friendship, error)
-	predicates     []predicate.Friendship
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	user_id         *uuid.UUID
+	h3_index        *string
+	visited_at      *time.Time
+	clearedFields   map[string]struct{}
+	activity        *uuid.UUID
+	clearedactivity bool
+	done            bool
+	oldValue        func(context.Context) (*ActivityHex, error)
+	predicates      []predicate.ActivityHex
 }
 
-var _ ent.Mutation = (*FriendshipMutation)(nil)
+var _ ent.Mutation = (*ActivityHexMutation)(nil)
 
-// friendshipOption allows management of the mutation configuration using functional options.
-type friendshipOption func(*FriendshipMutation)
+// activityhexOption allows management of the mutation configuration using functional options.
+type activityhexOption func(*ActivityHexMutation)
 
-// newFriendshipMutation creates new mutation for the Friendship entity.
-func newFriendshipMutation(c config, op Op, opts ...friendshipOption) *FriendshipMutation {
-	m := &FriendshipMutation{
+// newActivityHexMutation creates new mutation for the ActivityHex entity.
+func newActivityHexMutation(c config, op Op, opts ...activityhexOption) *ActivityHexMutation {
+	m := &ActivityHexMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFriendship,
+		typ:           TypeActivityHex,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -762,20 +1447,20 @@ func newFriendshipMutation(c config, op Op, opts ...friendshipOption) *Friendshi
 	return m
 }
 
-// withFriendshipID sets the ID field of the mutation.
-func withFriendshipID(id int) friendshipOption {
-	return func(m *FriendshipMutation) {
+// withActivityHexID sets the ID field of the mutation.
+func withActivityHexID(id uuid.UUID) activityhexOption {
+	return func(m *ActivityHexMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Friendship
+			value *ActivityHex
 		)
-		m.oldValue = func(ctx context.Context) (*Friendship, error) {
+		m.oldValue = func(ctx context.Context) (*ActivityHex, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Friendship.Get(ctx, id)
+					value, err = m.Client().ActivityHex.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -784,10 +1469,10 @@ func withFriendshipID(id int) friendshipOption {
 	}
 }
 
-// withFriendship sets the old Friendship of the mutation.
-func withFriendship(node *Friendship) friendshipOption {
-	return func(m *FriendshipMutation) {
-		m.oldValue = func(context.Context) (*Friendship, error) {
+// withActivityHex sets the old ActivityHex of the mutation.
+func withActivityHex(node *ActivityHex) activityhexOption {
+	return func(m *ActivityHexMutation) {
+		m.oldValue = func(context.Context) (*ActivityHex, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -796,7 +1481,7 @@ func withFriendship(node *Friendship) friendshipOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FriendshipMutation) Client() *Client {
+func (m ActivityHexMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -804,7 +1489,7 @@ func (m FriendshipMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FriendshipMutation) Tx() (*Tx, error) {
+func (m ActivityHexMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -814,14 +1499,14 @@ func (m FriendshipMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Friendship entities.
-func (m *FriendshipMutation) SetID(id int) {
+// operation is only accepted on creation of ActivityHex entities.
+func (m *ActivityHexMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FriendshipMutation) ID() (id int, exists bool) {
+func (m *ActivityHexMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -832,218 +1517,201 @@ func (m *FriendshipMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FriendshipMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ActivityHexMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Friendship.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ActivityHex.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *FriendshipMutation) SetUserID(u uuid.UUID) {
-	m.users = &u
+// SetActivityID sets the "activity_id" field.
+func (m *ActivityHexMutation) SetActivityID(u uuid.UUID) {
+	m.activity = &u
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *FriendshipMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.users
+// ActivityID returns the value of the "activity_id" field in the mutation.
+func (m *ActivityHexMutation) ActivityID() (r uuid.UUID, exists bool) {
+	v := m.activity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Friendship entity.
-// If the Friendship object wasn't provided to the builder, the object is fetched from the database.
+// OldActivityID returns the old "activity_id" field's value of the ActivityHex entity.
+// If the ActivityHex object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FriendshipMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ActivityHexMutation) OldActivityID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldActivityID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldActivityID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldActivityID: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.ActivityID, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *FriendshipMutation) ResetUserID() {
-	m.users = nil
+// ResetActivityID resets all changes to the "activity_id" field.
+func (m *ActivityHexMutation) ResetActivityID() {
+	m.activity = nil
 }
 
-// SetFriendID sets the "friend_id" field.
-func (m *FriendshipMutation) SetFriendID(u uuid.UUID) {
-	m.friends = &u
+// SetUserID sets the "user_id" field.
+func (m *ActivityHexMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
 }
 
-// FriendID returns the value of the "friend_id" field in the mutation.
-func (m *FriendshipMutation) FriendID() (r uuid.UUID, exists bool) {
-	v := m.friends
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ActivityHexMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFriendID returns the old "friend_id" field's value of the Friendship entity.
-// If the Friendship object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the ActivityHex entity.
+// If the ActivityHex object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FriendshipMutation) OldFriendID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ActivityHexMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFriendID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFriendID requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFriendID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.FriendID, nil
+	return oldValue.UserID, nil
 }
 
-// ResetFriendID resets all changes to the "friend_id" field.
-func (m *FriendshipMutation) ResetFriendID() {
-	m.friends = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ActivityHexMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *FriendshipMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetH3Index sets the "h3_index" field.
+func (m *ActivityHexMutation) SetH3Index(s string) {
+	m.h3_index = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FriendshipMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// H3Index returns the value of the "h3_index" field in the mutation.
+func (m *ActivityHexMutation) H3Index() (r string, exists bool) {
+	v := m.h3_index
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Friendship entity.
-// If the Friendship object wasn't provided to the builder, the object is fetched from the database.
+// OldH3Index returns the old "h3_index" field's value of the ActivityHex entity.
+// If the ActivityHex object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FriendshipMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ActivityHexMutation) OldH3Index(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldH3Index is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldH3Index requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldH3Index: %w", err)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FriendshipMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUsersID sets the "users" edge to the User entity by id.
-func (m *FriendshipMutation) SetUsersID(id uuid.UUID) {
-	m.users = &id
+	return oldValue.H3Index, nil
 }
 
-// ClearUsers clears the "users" edge to the User entity.
-func (m *FriendshipMutation) ClearUsers() {
-	m.clearedusers = true
-	m.clearedFields[friendship.FieldUserID] = struct{}{}
+// ResetH3Index resets all changes to the "h3_index" field.
+func (m *ActivityHexMutation) ResetH3Index() {
+	m.h3_index = nil
 }
 
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *FriendshipMutation) UsersCleared() bool {
-	return m.clearedusers
+// SetVisitedAt sets the "visited_at" field.
+func (m *ActivityHexMutation) SetVisitedAt(t time.Time) {
+	m.visited_at = &t
 }
 
-// UsersID returns the "users" edge ID in the mutation.
-func (m *FriendshipMutation) UsersID() (id uuid.UUID, exists bool) {
-	if m.users != nil {
-		return *m.users, true
+// VisitedAt returns the value of the "visited_at" field in the mutation.
+func (m *ActivityHexMutation) VisitedAt() (r time.Time, exists bool) {
+	v := m.visited_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UsersID instead. It exists only for internal usage by the builders.
-func (m *FriendshipMutation) UsersIDs() (ids []uuid.UUID) {
-	if id := m.users; id != nil {
-		ids = append(ids, *id)
+// OldVisitedAt returns the old "visited_at" field's value of the ActivityHex entity.
+// If the ActivityHex object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityHexMutation) OldVisitedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVisitedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetUsers resets all changes to the "users" edge.
-func (m *FriendshipMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
-}
-
-// SetFriendsID sets the "friends" edge to the User entity by id.
-func (m *FriendshipMutation) SetFriendsID(id uuid.UUID) {
-	m.friends = &id
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVisitedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVisitedAt: %w", err)
+	}
+	return oldValue.VisitedAt, nil
 }
 
-// ClearFriends clears the "friends" edge to the User entity.
-func (m *FriendshipMutation) ClearFriends() {
-	m.clearedfriends = true
-	m.clearedFields[friendship.FieldFriendID] = struct{}{}
+// ResetVisitedAt resets all changes to the "visited_at" field.
+func (m *ActivityHexMutation) ResetVisitedAt() {
+	m.visited_at = nil
 }
 
-// FriendsCleared reports if the "friends" edge to the User entity was cleared.
-func (m *FriendshipMutation) FriendsCleared() bool {
-	return m.clearedfriends
+// ClearActivity clears the "activity" edge to the Activity entity.
+func (m *ActivityHexMutation) ClearActivity() {
+	m.clearedactivity = true
+	m.clearedFields[activityhex.FieldActivityID] = struct{}{}
 }
 
-// FriendsID returns the "friends" edge ID in the mutation.
-func (m *FriendshipMutation) FriendsID() (id uuid.UUID, exists bool) {
-	if m.friends != nil {
-		return *m.friends, true
-	}
-	return
+// ActivityCleared reports if the "activity" edge to the Activity entity was cleared.
+func (m *ActivityHexMutation) ActivityCleared() bool {
+	return m.clearedactivity
 }
 
-// FriendsIDs returns the "friends" edge IDs in the mutation.
+// ActivityIDs returns the "activity" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FriendsID instead. It exists only for internal usage by the builders.
-func (m *FriendshipMutation) FriendsIDs() (ids []uuid.UUID) {
-	if id := m.friends; id != nil {
+// ActivityID instead. It exists only for internal usage by the builders.
+func (m *ActivityHexMutation) ActivityIDs() (ids []uuid.UUID) {
+	if id := m.activity; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetFriends resets all changes to the "friends" edge.
-func (m *FriendshipMutation) ResetFriends() {
-	m.friends = nil
-	m.clearedfriends = false
+// ResetActivity resets all changes to the "activity" edge.
+func (m *ActivityHexMutation) ResetActivity() {
+	m.activity = nil
+	m.clearedactivity = false
 }
 
-// Where appends a list predicates to the FriendshipMutation builder.
-func (m *FriendshipMutation) Where(ps ...predicate.Friendship) {
+// Where appends a list predicates to the ActivityHexMutation builder.
+func (m *ActivityHexMutation) Where(ps ...predicate.ActivityHex) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FriendshipMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ActivityHexMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FriendshipMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Friendship, len(ps))
+func (m *ActivityHexMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ActivityHex, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1051,33 +1719,36 @@ func (m *FriendshipMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FriendshipMutation) Op() Op {
+func (m *ActivityHexMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FriendshipMutation) SetOp(op Op) {
+func (m *ActivityHexMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Friendship).
-func (m *FriendshipMutation) Type() string {
+// Type returns the node type of this mutation (ActivityHex).
+func (m *ActivityHexMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FriendshipMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.users != nil {
-		fields = append(fields, friendship.FieldUserID)
+func (m *ActivityHexMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.activity != nil {
+		fields = append(fields, activityhex.FieldActivityID)
 	}
-	if m.friends != nil {
-		fields = append(fields, friendship.FieldFriendID)
+	if m.user_id != nil {
+		fields = append(fields, activityhex.FieldUserID)
 	}
-	if m.created_at != nil {
-		fields = append(fields, friendship.FieldCreatedAt)
+	if m.h3_index != nil {
+		fields = append(fields, activityhex.FieldH3Index)
+	}
+	if m.visited_at != nil {
+		fields = append(fields, activityhex.FieldVisitedAt)
 	}
 	return fields
 }
@@ -1085,14 +1756,16 @@ func (m *FriendshipMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FriendshipMutation) Field(name string) (ent.Value, bool) {
+func (m *ActivityHexMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case friendship.FieldUserID:
+	case activityhex.FieldActivityID:
+		return m.ActivityID()
+	case activityhex.FieldUserID:
 		return m.UserID()
-	case friendship.FieldFriendID:
-		return m.FriendID()
-	case friendship.FieldCreatedAt:
-		return m.CreatedAt()
+	case activityhex.FieldH3Index:
+		return m.H3Index()
+	case activityhex.FieldVisitedAt:
+		return m.VisitedAt()
 	}
 	return nil, false
 }
@@ -1100,128 +1773,133 @@ func (m *FriendshipMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FriendshipMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ActivityHexMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case friendship.FieldUserID:
+	case activityhex.FieldActivityID:
+		return m.OldActivityID(ctx)
+	case activityhex.FieldUserID:
 		return m.OldUserID(ctx)
-	case friendship.FieldFriendID:
-		return m.OldFriendID(ctx)
-	case friendship.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+	case activityhex.FieldH3Index:
+		return m.OldH3Index(ctx)
+	case activityhex.FieldVisitedAt:
+		return m.OldVisitedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Friendship field %s", name)
+	return nil, fmt.Errorf("unknown ActivityHex field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FriendshipMutation) SetField(name string, value ent.Value) error {
+func (m *ActivityHexMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case friendship.FieldUserID:
+	case activityhex.FieldActivityID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetActivityID(v)
 		return nil
-	case friendship.FieldFriendID:
+	case activityhex.FieldUserID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFriendID(v)
+		m.SetUserID(v)
 		return nil
-	case friendship.FieldCreatedAt:
+	case activityhex.FieldH3Index:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetH3Index(v)
+		return nil
+	case activityhex.FieldVisitedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetVisitedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Friendship field %s", name)
+	return fmt.Errorf("unknown ActivityHex field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FriendshipMutation) AddedFields() []string {
+func (m *ActivityHexMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FriendshipMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ActivityHexMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FriendshipMutation) AddField(name string, value ent.Value) error {
+func (m *ActivityHexMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Friendship numeric field %s", name)
+	return fmt.Errorf("unknown ActivityHex numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FriendshipMutation) ClearedFields() []string {
+func (m *ActivityHexMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FriendshipMutation) FieldCleared(name string) bool {
+func (m *ActivityHexMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FriendshipMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Friendship nullable field %s", name)
+func (m *ActivityHexMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ActivityHex nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FriendshipMutation) ResetField(name string) error {
+func (m *ActivityHexMutation) ResetField(name string) error {
 	switch name {
-	case friendship.FieldUserID:
+	case activityhex.FieldActivityID:
+		m.ResetActivityID()
+		return nil
+	case activityhex.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case friendship.FieldFriendID:
-		m.ResetFriendID()
+	case activityhex.FieldH3Index:
+		m.ResetH3Index()
 		return nil
-	case friendship.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case activityhex.FieldVisitedAt:
+		m.ResetVisitedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Friendship field %s", name)
+	return fmt.Errorf("unknown ActivityHex field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FriendshipMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.users != nil {
-		edges = append(edges, friendship.EdgeUsers)
-	}
-	if m.friends != nil {
-		edges = append(edges, friendship.EdgeFriends)
+func (m *ActivityHexMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.activity != nil {
+		edges = append(edges, activityhex.EdgeActivity)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FriendshipMutation) AddedIDs(name string) []ent.Value {
+func (m *ActivityHexMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case friendship.EdgeUsers:
-		if id := m.users; id != nil {
-			return []ent.Value{*id}
-		}
-	case friendship.EdgeFriends:
-		if id := m.friends; id != nil {
+	case activityhex.EdgeActivity:
+		if id := m.activity; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -1229,98 +1907,94 @@ func (m *FriendshipMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FriendshipMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *ActivityHexMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FriendshipMutation) RemovedIDs(name string) []ent.Value {
+func (m *ActivityHexMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FriendshipMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedusers {
-		edges = append(edges, friendship.EdgeUsers)
-	}
-	if m.clearedfriends {
-		edges = append(edges, friendship.EdgeFriends)
+func (m *ActivityHexMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedactivity {
+		edges = append(edges, activityhex.EdgeActivity)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FriendshipMutation) EdgeCleared(name string) bool {
+func (m *ActivityHexMutation) EdgeCleared(name string) bool {
 	switch name {
-	case friendship.EdgeUsers:
-		return m.clearedusers
-	case friendship.EdgeFriends:
-		return m.clearedfriends
+	case activityhex.EdgeActivity:
+		return m.clearedactivity
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FriendshipMutation) ClearEdge(name string) error {
+func (m *ActivityHexMutation) ClearEdge(name string) error {
 	switch name {
-	case friendship.EdgeUsers:
-		m.ClearUsers()
-		return nil
-	case friendship.EdgeFriends:
-		m.ClearFriends()
+	case activityhex.EdgeActivity:
+		m.ClearActivity()
 		return nil
 	}
-	return fmt.Errorf("unknown Friendship unique edge %s", name)
+	return fmt.Errorf("unknown ActivityHex unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FriendshipMutation) ResetEdge(name string) error {
+func (m *ActivityHexMutation) ResetEdge(name string) error {
 	switch name {
-	case friendship.EdgeUsers:
-		m.ResetUsers()
-		return nil
-	case friendship.EdgeFriends:
-		m.ResetFriends()
+	case activityhex.EdgeActivity:
+		m.ResetActivity()
 		return nil
 	}
-	return fmt.Errorf("unknown Friendship edge %s", name)
+	return fmt.Errorf("unknown ActivityHex edge %s", name)
 }
 
-// HexMutation represents an operation that mutates the Hex nodes in the graph.
-type HexMutation struct {
+// ActivityJobMutation represents an operation that mutates the ActivityJob nodes in the graph.
+type ActivityJobMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *string
-	clearedFields          map[string]struct{}
-	hexinfluences          map[uuid.UUID]struct{}
-	removedhexinfluences   map[uuid.UUID]struct{}
-	clearedhexinfluences   bool
-	hexleaderboards        map[uuid.UUID]struct{}
-	removedhexleaderboards map[uuid.UUID]struct{}
-	clearedhexleaderboards bool
-	done                   bool
-	oldValue               func(context.Context) (*Hex, error)
-	predicates             []predicate.Hex
-}
-
-var _ ent.Mutation = (*HexMutation)(nil)
-
-// hexOption allows management of the mutation configuration using functional options.
-type hexOption func(*HexMutation)
-
-// newHexMutation creates new mutation for the Hex entity.
-func newHexMutation(c config, op Op, opts ...hexOption) *HexMutation {
-	m := &HexMutation{
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	user_id           *uuid.UUID
+	status            *activityjob.Status
+	already_scored    *int
+	addalready_scored *int
+	attempts          *int
+	addattempts       *int
+	run_at            *time.Time
+	locked_until      *time.Time
+	last_error        *string
+	created_at        *time.Time
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	activity          *uuid.UUID
+	clearedactivity   bool
+	done              bool
+	oldValue          func(context.Context) (*ActivityJob, error)
+	predicates        []predicate.ActivityJob
+}
+
+var _ ent.Mutation = (*ActivityJobMutation)(nil)
+
+// activityjobOption allows management of the mutation configuration using functional options.
+type activityjobOption func(*ActivityJobMutation)
+
+// newActivityJobMutation creates new mutation for the ActivityJob entity.
+func newActivityJobMutation(c config, op Op, opts ...activityjobOption) *ActivityJobMutation {
+	m := &ActivityJobMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeHex,
+		typ:           TypeActivityJob,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1329,20 +2003,20 @@ func newHexMutation(c config, op Op, opts ...hexOption) *HexMutation {
 	return m
 }
 
-// withHexID sets the ID field of the mutation.
-func withHexID(id string) hexOption {
-	return func(m *HexMutation) {
+// withActivityJobID sets the ID field of the mutation.
+func withActivityJobID(id uuid.UUID) activityjobOption {
+	return func(m *ActivityJobMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Hex
+			value *ActivityJob
 		)
-		m.oldValue = func(ctx context.Context) (*Hex, error) {
+		m.oldValue = func(ctx context.Context) (*ActivityJob, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Hex.Get(ctx, id)
+					value, err = m.Client().ActivityJob.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1351,10 +2025,10 @@ func withHexID(id string) hexOption {
 	}
 }
 
-// withHex sets the old Hex of the mutation.
-func withHex(node *Hex) hexOption {
-	return func(m *HexMutation) {
-		m.oldValue = func(context.Context) (*Hex, error) {
+// withActivityJob sets the old ActivityJob of the mutation.
+func withActivityJob(node *ActivityJob) activityjobOption {
+	return func(m *ActivityJobMutation) {
+		m.oldValue = func(context.Context) (*ActivityJob, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1363,7 +2037,7 @@ func withHex(node *Hex) hexOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m HexMutation) Client() *Client {
+func (m ActivityJobMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1371,7 +2045,7 @@ func (m HexMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m HexMutation) Tx() (*Tx, error) {
+func (m ActivityJobMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1381,14 +2055,14 @@ func (m HexMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Hex entities.
-func (m *HexMutation) SetID(id string) {
+// operation is only accepted on creation of ActivityJob entities.
+func (m *ActivityJobMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *HexMutation) ID() (id string, exists bool) {
+func (m *ActivityJobMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1399,839 +2073,688 @@ func (m *HexMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *HexMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *ActivityJobMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Hex.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ActivityJob.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// AddHexinfluenceIDs adds the "hexinfluences" edge to the HexInfluence entity by ids.
-func (m *HexMutation) AddHexinfluenceIDs(ids ...uuid.UUID) {
-	if m.hexinfluences == nil {
-		m.hexinfluences = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.hexinfluences[ids[i]] = struct{}{}
-	}
-}
-
-// ClearHexinfluences clears the "hexinfluences" edge to the HexInfluence entity.
-func (m *HexMutation) ClearHexinfluences() {
-	m.clearedhexinfluences = true
+// SetActivityID sets the "activity_id" field.
+func (m *ActivityJobMutation) SetActivityID(u uuid.UUID) {
+	m.activity = &u
 }
 
-// HexinfluencesCleared reports if the "hexinfluences" edge to the HexInfluence entity was cleared.
-func (m *HexMutation) HexinfluencesCleared() bool {
-	return m.clearedhexinfluences
+// ActivityID returns the value of the "activity_id" field in the mutation.
+func (m *ActivityJobMutation) ActivityID() (r uuid.UUID, exists bool) {
+	v := m.activity
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveHexinfluenceIDs removes the "hexinfluences" edge to the HexInfluence entity by IDs.
-func (m *HexMutation) RemoveHexinfluenceIDs(ids ...uuid.UUID) {
-	if m.removedhexinfluences == nil {
-		m.removedhexinfluences = make(map[uuid.UUID]struct{})
+// OldActivityID returns the old "activity_id" field's value of the ActivityJob entity.
+// If the ActivityJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityJobMutation) OldActivityID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActivityID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.hexinfluences, ids[i])
-		m.removedhexinfluences[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActivityID requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActivityID: %w", err)
+	}
+	return oldValue.ActivityID, nil
 }
 
-// RemovedHexinfluences returns the removed IDs of the "hexinfluences" edge to the HexInfluence entity.
-func (m *HexMutation) RemovedHexinfluencesIDs() (ids []uuid.UUID) {
-	for id := range m.removedhexinfluences {
-		ids = append(ids, id)
-	}
-	return
+// ResetActivityID resets all changes to the "activity_id" field.
+func (m *ActivityJobMutation) ResetActivityID() {
+	m.activity = nil
 }
 
-// HexinfluencesIDs returns the "hexinfluences" edge IDs in the mutation.
-func (m *HexMutation) HexinfluencesIDs() (ids []uuid.UUID) {
-	for id := range m.hexinfluences {
-		ids = append(ids, id)
-	}
-	return
+// SetUserID sets the "user_id" field.
+func (m *ActivityJobMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
 }
 
-// ResetHexinfluences resets all changes to the "hexinfluences" edge.
-func (m *HexMutation) ResetHexinfluences() {
-	m.hexinfluences = nil
-	m.clearedhexinfluences = false
-	m.removedhexinfluences = nil
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ActivityJobMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddHexleaderboardIDs adds the "hexleaderboards" edge to the HexLeaderboard entity by ids.
-func (m *HexMutation) AddHexleaderboardIDs(ids ...uuid.UUID) {
-	if m.hexleaderboards == nil {
-		m.hexleaderboards = make(map[uuid.UUID]struct{})
+// OldUserID returns the old "user_id" field's value of the ActivityJob entity.
+// If the ActivityJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityJobMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.hexleaderboards[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
+	return oldValue.UserID, nil
 }
 
-// ClearHexleaderboards clears the "hexleaderboards" edge to the HexLeaderboard entity.
-func (m *HexMutation) ClearHexleaderboards() {
-	m.clearedhexleaderboards = true
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ActivityJobMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// HexleaderboardsCleared reports if the "hexleaderboards" edge to the HexLeaderboard entity was cleared.
-func (m *HexMutation) HexleaderboardsCleared() bool {
-	return m.clearedhexleaderboards
+// SetStatus sets the "status" field.
+func (m *ActivityJobMutation) SetStatus(a activityjob.Status) {
+	m.status = &a
 }
 
-// RemoveHexleaderboardIDs removes the "hexleaderboards" edge to the HexLeaderboard entity by IDs.
-func (m *HexMutation) RemoveHexleaderboardIDs(ids ...uuid.UUID) {
-	if m.removedhexleaderboards == nil {
-		m.removedhexleaderboards = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.hexleaderboards, ids[i])
-		m.removedhexleaderboards[ids[i]] = struct{}{}
+// Status returns the value of the "status" field in the mutation.
+func (m *ActivityJobMutation) Status() (r activityjob.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedHexleaderboards returns the removed IDs of the "hexleaderboards" edge to the HexLeaderboard entity.
-func (m *HexMutation) RemovedHexleaderboardsIDs() (ids []uuid.UUID) {
-	for id := range m.removedhexleaderboards {
-		ids = append(ids, id)
+// OldStatus returns the old "status" field's value of the ActivityJob entity.
+// If the ActivityJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityJobMutation) OldStatus(ctx context.Context) (v activityjob.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// HexleaderboardsIDs returns the "hexleaderboards" edge IDs in the mutation.
-func (m *HexMutation) HexleaderboardsIDs() (ids []uuid.UUID) {
-	for id := range m.hexleaderboards {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// ResetHexleaderboards resets all changes to the "hexleaderboards" edge.
-func (m *HexMutation) ResetHexleaderboards() {
-	m.hexleaderboards = nil
-	m.clearedhexleaderboards = false
-	m.removedhexleaderboards = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *ActivityJobMutation) ResetStatus() {
+	m.status = nil
 }
 
-// Where appends a list predicates to the HexMutation builder.
-func (m *HexMutation) Where(ps ...predicate.Hex) {
-	m.predicates = append(m.predicates, ps...)
+// SetAlreadyScored sets the "already_scored" field.
+func (m *ActivityJobMutation) SetAlreadyScored(i int) {
+	m.already_scored = &i
+	m.addalready_scored = nil
 }
 
-// WhereP appends storage-level predicates to the HexMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *HexMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Hex, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AlreadyScored returns the value of the "already_scored" field in the mutation.
+func (m *ActivityJobMutation) AlreadyScored() (r int, exists bool) {
+	v := m.already_scored
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *HexMutation) Op() Op {
-	return m.op
+// OldAlreadyScored returns the old "already_scored" field's value of the ActivityJob entity.
+// If the ActivityJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityJobMutation) OldAlreadyScored(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlreadyScored is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlreadyScored requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlreadyScored: %w", err)
+	}
+	return oldValue.AlreadyScored, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *HexMutation) SetOp(op Op) {
-	m.op = op
+// AddAlreadyScored adds i to the "already_scored" field.
+func (m *ActivityJobMutation) AddAlreadyScored(i int) {
+	if m.addalready_scored != nil {
+		*m.addalready_scored += i
+	} else {
+		m.addalready_scored = &i
+	}
 }
 
-// Type returns the node type of this mutation (Hex).
-func (m *HexMutation) Type() string {
-	return m.typ
+// AddedAlreadyScored returns the value that was added to the "already_scored" field in this mutation.
+func (m *ActivityJobMutation) AddedAlreadyScored() (r int, exists bool) {
+	v := m.addalready_scored
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *HexMutation) Fields() []string {
-	fields := make([]string, 0, 0)
-	return fields
+// ResetAlreadyScored resets all changes to the "already_scored" field.
+func (m *ActivityJobMutation) ResetAlreadyScored() {
+	m.already_scored = nil
+	m.addalready_scored = nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *HexMutation) Field(name string) (ent.Value, bool) {
-	return nil, false
+// SetAttempts sets the "attempts" field.
+func (m *ActivityJobMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *HexMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, fmt.Errorf("unknown Hex field %s", name)
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *ActivityJobMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *HexMutation) SetField(name string, value ent.Value) error {
-	switch name {
+// OldAttempts returns the old "attempts" field's value of the ActivityJob entity.
+// If the ActivityJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityJobMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Hex field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *HexMutation) AddedFields() []string {
-	return nil
+// AddAttempts adds i to the "attempts" field.
+func (m *ActivityJobMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *HexMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *ActivityJobMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *HexMutation) AddField(name string, value ent.Value) error {
-	return fmt.Errorf("unknown Hex numeric field %s", name)
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *ActivityJobMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *HexMutation) ClearedFields() []string {
-	return nil
+// SetRunAt sets the "run_at" field.
+func (m *ActivityJobMutation) SetRunAt(t time.Time) {
+	m.run_at = &t
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *HexMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// RunAt returns the value of the "run_at" field in the mutation.
+func (m *ActivityJobMutation) RunAt() (r time.Time, exists bool) {
+	v := m.run_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *HexMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Hex nullable field %s", name)
+// OldRunAt returns the old "run_at" field's value of the ActivityJob entity.
+// If the ActivityJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityJobMutation) OldRunAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunAt: %w", err)
+	}
+	return oldValue.RunAt, nil
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *HexMutation) ResetField(name string) error {
-	return fmt.Errorf("unknown Hex field %s", name)
+// ResetRunAt resets all changes to the "run_at" field.
+func (m *ActivityJobMutation) ResetRunAt() {
+	m.run_at = nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *HexMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.hexinfluences != nil {
-		edges = append(edges, hex.EdgeHexinfluences)
-	}
-	if m.hexleaderboards != nil {
-		edges = append(edges, hex.EdgeHexleaderboards)
-	}
-	return edges
+// SetLockedUntil sets the "locked_until" field.
+func (m *ActivityJobMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *HexMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case hex.EdgeHexinfluences:
-		ids := make([]ent.Value, 0, len(m.hexinfluences))
-		for id := range m.hexinfluences {
-			ids = append(ids, id)
-		}
-		return ids
-	case hex.EdgeHexleaderboards:
-		ids := make([]ent.Value, 0, len(m.hexleaderboards))
-		for id := range m.hexleaderboards {
-			ids = append(ids, id)
-		}
-		return ids
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *ActivityJobMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *HexMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedhexinfluences != nil {
-		edges = append(edges, hex.EdgeHexinfluences)
+// OldLockedUntil returns the old "locked_until" field's value of the ActivityJob entity.
+// If the ActivityJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityJobMutation) OldLockedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
 	}
-	if m.removedhexleaderboards != nil {
-		edges = append(edges, hex.EdgeHexleaderboards)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
+	}
+	return oldValue.LockedUntil, nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *HexMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case hex.EdgeHexinfluences:
-		ids := make([]ent.Value, 0, len(m.removedhexinfluences))
-		for id := range m.removedhexinfluences {
-			ids = append(ids, id)
-		}
-		return ids
-	case hex.EdgeHexleaderboards:
-		ids := make([]ent.Value, 0, len(m.removedhexleaderboards))
-		for id := range m.removedhexleaderboards {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (m *ActivityJobMutation) ClearLockedUntil() {
+	m.locked_until = nil
+	m.clearedFields[activityjob.FieldLockedUntil] = struct{}{}
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *HexMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedhexinfluences {
-		edges = append(edges, hex.EdgeHexinfluences)
-	}
-	if m.clearedhexleaderboards {
-		edges = append(edges, hex.EdgeHexleaderboards)
-	}
-	return edges
+// LockedUntilCleared returns if the "locked_until" field was cleared in this mutation.
+func (m *ActivityJobMutation) LockedUntilCleared() bool {
+	_, ok := m.clearedFields[activityjob.FieldLockedUntil]
+	return ok
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *HexMutation) EdgeCleared(name string) bool {
-	switch name {
-	case hex.EdgeHexinfluences:
-		return m.clearedhexinfluences
-	case hex.EdgeHexleaderboards:
-		return m.clearedhexleaderboards
-	}
-	return false
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *ActivityJobMutation) ResetLockedUntil() {
+	m.locked_until = nil
+	delete(m.clearedFields, activityjob.FieldLockedUntil)
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *HexMutation) ClearEdge(name string) error {
-	switch name {
+// SetLastError sets the "last_error" field.
+func (m *ActivityJobMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *ActivityJobMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Hex unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *HexMutation) ResetEdge(name string) error {
-	switch name {
-	case hex.EdgeHexinfluences:
-		m.ResetHexinfluences()
-		return nil
-	case hex.EdgeHexleaderboards:
-		m.ResetHexleaderboards()
-		return nil
+// OldLastError returns the old "last_error" field's value of the ActivityJob entity.
+// If the ActivityJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityJobMutation) OldLastError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Hex edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
 }
 
-// HexInfluenceMutation represents an operation that mutates the HexInfluence nodes in the graph.
-type HexInfluenceMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	score         *float64
-	addscore      *float64
-	last_updated  *time.Time
-	clearedFields map[string]struct{}
-	hex           *string
-	clearedhex    bool
-	users         *uuid.UUID
-	clearedusers  bool
-	done          bool
-	oldValue      func(context.Context) (*HexInfluence, error)
-	predicates    []predicate.HexInfluence
+// ClearLastError clears the value of the "last_error" field.
+func (m *ActivityJobMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[activityjob.FieldLastError] = struct{}{}
 }
 
-var _ ent.Mutation = (*HexInfluenceMutation)(nil)
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *ActivityJobMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[activityjob.FieldLastError]
+	return ok
+}
 
-// hexinfluenceOption allows management of the mutation configuration using functional options.
-type hexinfluenceOption func(*HexInfluenceMutation)
+// ResetLastError resets all changes to the "last_error" field.
+func (m *ActivityJobMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, activityjob.FieldLastError)
+}
 
-// newHexInfluenceMutation creates new mutation for the HexInfluence entity.
-func newHexInfluenceMutation(c config, op Op, opts ...hexinfluenceOption) *HexInfluenceMutation {
-	m := &HexInfluenceMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeHexInfluence,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// SetCreatedAt sets the "created_at" field.
+func (m *ActivityJobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ActivityJobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withHexInfluenceID sets the ID field of the mutation.
-func withHexInfluenceID(id uuid.UUID) hexinfluenceOption {
-	return func(m *HexInfluenceMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *HexInfluence
-		)
-		m.oldValue = func(ctx context.Context) (*HexInfluence, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().HexInfluence.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
-}
-
-// withHexInfluence sets the old HexInfluence of the mutation.
-func withHexInfluence(node *HexInfluence) hexinfluenceOption {
-	return func(m *HexInfluenceMutation) {
-		m.oldValue = func(context.Context) (*HexInfluence, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m HexInfluenceMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m HexInfluenceMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of HexInfluence entities.
-func (m *HexInfluenceMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *HexInfluenceMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *HexInfluenceMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().HexInfluence.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetH3Index sets the "h3_index" field.
-func (m *HexInfluenceMutation) SetH3Index(s string) {
-	m.hex = &s
-}
-
-// H3Index returns the value of the "h3_index" field in the mutation.
-func (m *HexInfluenceMutation) H3Index() (r string, exists bool) {
-	v := m.hex
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldH3Index returns the old "h3_index" field's value of the HexInfluence entity.
-// If the HexInfluence object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ActivityJob entity.
+// If the ActivityJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HexInfluenceMutation) OldH3Index(ctx context.Context) (v string, err error) {
+func (m *ActivityJobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldH3Index is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldH3Index requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldH3Index: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.H3Index, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetH3Index resets all changes to the "h3_index" field.
-func (m *HexInfluenceMutation) ResetH3Index() {
-	m.hex = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ActivityJobMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetUserID sets the "user_id" field.
-func (m *HexInfluenceMutation) SetUserID(u uuid.UUID) {
-	m.users = &u
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ActivityJobMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *HexInfluenceMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.users
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ActivityJobMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the HexInfluence entity.
-// If the HexInfluence object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ActivityJob entity.
+// If the ActivityJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HexInfluenceMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ActivityJobMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *HexInfluenceMutation) ResetUserID() {
-	m.users = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ActivityJobMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetScore sets the "score" field.
-func (m *HexInfluenceMutation) SetScore(f float64) {
-	m.score = &f
-	m.addscore = nil
+// ClearActivity clears the "activity" edge to the Activity entity.
+func (m *ActivityJobMutation) ClearActivity() {
+	m.clearedactivity = true
+	m.clearedFields[activityjob.FieldActivityID] = struct{}{}
 }
 
-// Score returns the value of the "score" field in the mutation.
-func (m *HexInfluenceMutation) Score() (r float64, exists bool) {
-	v := m.score
-	if v == nil {
-		return
-	}
-	return *v, true
+// ActivityCleared reports if the "activity" edge to the Activity entity was cleared.
+func (m *ActivityJobMutation) ActivityCleared() bool {
+	return m.clearedactivity
 }
 
-// OldScore returns the old "score" field's value of the HexInfluence entity.
-// If the HexInfluence object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HexInfluenceMutation) OldScore(ctx context.Context) (v float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScore is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScore requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+// ActivityIDs returns the "activity" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ActivityID instead. It exists only for internal usage by the builders.
+func (m *ActivityJobMutation) ActivityIDs() (ids []uuid.UUID) {
+	if id := m.activity; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.Score, nil
+	return
 }
 
-// AddScore adds f to the "score" field.
-func (m *HexInfluenceMutation) AddScore(f float64) {
-	if m.addscore != nil {
-		*m.addscore += f
-	} else {
-		m.addscore = &f
-	}
+// ResetActivity resets all changes to the "activity" edge.
+func (m *ActivityJobMutation) ResetActivity() {
+	m.activity = nil
+	m.clearedactivity = false
 }
 
-// AddedScore returns the value that was added to the "score" field in this mutation.
-func (m *HexInfluenceMutation) AddedScore() (r float64, exists bool) {
-	v := m.addscore
-	if v == nil {
-		return
+// Where appends a list predicates to the ActivityJobMutation builder.
+func (m *ActivityJobMutation) Where(ps ...predicate.ActivityJob) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ActivityJobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ActivityJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ActivityJob, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// ResetScore resets all changes to the "score" field.
-func (m *HexInfluenceMutation) ResetScore() {
-	m.score = nil
-	m.addscore = nil
+// Op returns the operation name.
+func (m *ActivityJobMutation) Op() Op {
+	return m.op
 }
 
-// SetLastUpdated sets the "last_updated" field.
-func (m *HexInfluenceMutation) SetLastUpdated(t time.Time) {
-	m.last_updated = &t
+// SetOp allows setting the mutation operation.
+func (m *ActivityJobMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// LastUpdated returns the value of the "last_updated" field in the mutation.
-func (m *HexInfluenceMutation) LastUpdated() (r time.Time, exists bool) {
-	v := m.last_updated
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (ActivityJob).
+func (m *ActivityJobMutation) Type() string {
+	return m.typ
 }
 
-// OldLastUpdated returns the old "last_updated" field's value of the HexInfluence entity.
-// If the HexInfluence object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HexInfluenceMutation) OldLastUpdated(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastUpdated is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ActivityJobMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.activity != nil {
+		fields = append(fields, activityjob.FieldActivityID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastUpdated requires an ID field in the mutation")
+	if m.user_id != nil {
+		fields = append(fields, activityjob.FieldUserID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastUpdated: %w", err)
+	if m.status != nil {
+		fields = append(fields, activityjob.FieldStatus)
 	}
-	return oldValue.LastUpdated, nil
-}
-
-// ResetLastUpdated resets all changes to the "last_updated" field.
-func (m *HexInfluenceMutation) ResetLastUpdated() {
-	m.last_updated = nil
-}
-
-// SetHexID sets the "hex" edge to the Hex entity by id.
-func (m *HexInfluenceMutation) SetHexID(id string) {
-	m.hex = &id
-}
-
-// ClearHex clears the "hex" edge to the Hex entity.
-func (m *HexInfluenceMutation) ClearHex() {
-	m.clearedhex = true
-	m.clearedFields[hexinfluence.FieldH3Index] = struct{}{}
+	if m.already_scored != nil {
+		fields = append(fields, activityjob.FieldAlreadyScored)
+	}
+	if m.attempts != nil {
+		fields = append(fields, activityjob.FieldAttempts)
+	}
+	if m.run_at != nil {
+		fields = append(fields, activityjob.FieldRunAt)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, activityjob.FieldLockedUntil)
+	}
+	if m.last_error != nil {
+		fields = append(fields, activityjob.FieldLastError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, activityjob.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, activityjob.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// HexCleared reports if the "hex" edge to the Hex entity was cleared.
-func (m *HexInfluenceMutation) HexCleared() bool {
-	return m.clearedhex
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ActivityJobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case activityjob.FieldActivityID:
+		return m.ActivityID()
+	case activityjob.FieldUserID:
+		return m.UserID()
+	case activityjob.FieldStatus:
+		return m.Status()
+	case activityjob.FieldAlreadyScored:
+		return m.AlreadyScored()
+	case activityjob.FieldAttempts:
+		return m.Attempts()
+	case activityjob.FieldRunAt:
+		return m.RunAt()
+	case activityjob.FieldLockedUntil:
+		return m.LockedUntil()
+	case activityjob.FieldLastError:
+		return m.LastError()
+	case activityjob.FieldCreatedAt:
+		return m.CreatedAt()
+	case activityjob.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// HexID returns the "hex" edge ID in the mutation.
-func (m *HexInfluenceMutation) HexID() (id string, exists bool) {
-	if m.hex != nil {
-		return *m.hex, true
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ActivityJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case activityjob.FieldActivityID:
+		return m.OldActivityID(ctx)
+	case activityjob.FieldUserID:
+		return m.OldUserID(ctx)
+	case activityjob.FieldStatus:
+		return m.OldStatus(ctx)
+	case activityjob.FieldAlreadyScored:
+		return m.OldAlreadyScored(ctx)
+	case activityjob.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case activityjob.FieldRunAt:
+		return m.OldRunAt(ctx)
+	case activityjob.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
+	case activityjob.FieldLastError:
+		return m.OldLastError(ctx)
+	case activityjob.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case activityjob.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return
-}
-
-// HexIDs returns the "hex" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// HexID instead. It exists only for internal usage by the builders.
-func (m *HexInfluenceMutation) HexIDs() (ids []string) {
-	if id := m.hex; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetHex resets all changes to the "hex" edge.
-func (m *HexInfluenceMutation) ResetHex() {
-	m.hex = nil
-	m.clearedhex = false
-}
-
-// SetUsersID sets the "users" edge to the User entity by id.
-func (m *HexInfluenceMutation) SetUsersID(id uuid.UUID) {
-	m.users = &id
-}
-
-// ClearUsers clears the "users" edge to the User entity.
-func (m *HexInfluenceMutation) ClearUsers() {
-	m.clearedusers = true
-	m.clearedFields[hexinfluence.FieldUserID] = struct{}{}
-}
-
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *HexInfluenceMutation) UsersCleared() bool {
-	return m.clearedusers
-}
-
-// UsersID returns the "users" edge ID in the mutation.
-func (m *HexInfluenceMutation) UsersID() (id uuid.UUID, exists bool) {
-	if m.users != nil {
-		return *m.users, true
-	}
-	return
-}
-
-// UsersIDs returns the "users" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UsersID instead. It exists only for internal usage by the builders.
-func (m *HexInfluenceMutation) UsersIDs() (ids []uuid.UUID) {
-	if id := m.users; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetUsers resets all changes to the "users" edge.
-func (m *HexInfluenceMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
-}
-
-// Where appends a list predicates to the HexInfluenceMutation builder.
-func (m *HexInfluenceMutation) Where(ps ...predicate.HexInfluence) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the HexInfluenceMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *HexInfluenceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.HexInfluence, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *HexInfluenceMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *HexInfluenceMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (HexInfluence).
-func (m *HexInfluenceMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *HexInfluenceMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.hex != nil {
-		fields = append(fields, hexinfluence.FieldH3Index)
-	}
-	if m.users != nil {
-		fields = append(fields, hexinfluence.FieldUserID)
-	}
-	if m.score != nil {
-		fields = append(fields, hexinfluence.FieldScore)
-	}
-	if m.last_updated != nil {
-		fields = append(fields, hexinfluence.FieldLastUpdated)
-	}
-	return fields
-}
-
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *HexInfluenceMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case hexinfluence.FieldH3Index:
-		return m.H3Index()
-	case hexinfluence.FieldUserID:
-		return m.UserID()
-	case hexinfluence.FieldScore:
-		return m.Score()
-	case hexinfluence.FieldLastUpdated:
-		return m.LastUpdated()
-	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *HexInfluenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case hexinfluence.FieldH3Index:
-		return m.OldH3Index(ctx)
-	case hexinfluence.FieldUserID:
-		return m.OldUserID(ctx)
-	case hexinfluence.FieldScore:
-		return m.OldScore(ctx)
-	case hexinfluence.FieldLastUpdated:
-		return m.OldLastUpdated(ctx)
-	}
-	return nil, fmt.Errorf("unknown HexInfluence field %s", name)
+	return nil, fmt.Errorf("unknown ActivityJob field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HexInfluenceMutation) SetField(name string, value ent.Value) error {
+func (m *ActivityJobMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case hexinfluence.FieldH3Index:
-		v, ok := value.(string)
+	case activityjob.FieldActivityID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetH3Index(v)
+		m.SetActivityID(v)
 		return nil
-	case hexinfluence.FieldUserID:
+	case activityjob.FieldUserID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserID(v)
 		return nil
-	case hexinfluence.FieldScore:
-		v, ok := value.(float64)
+	case activityjob.FieldStatus:
+		v, ok := value.(activityjob.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScore(v)
+		m.SetStatus(v)
 		return nil
-	case hexinfluence.FieldLastUpdated:
+	case activityjob.FieldAlreadyScored:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlreadyScored(v)
+		return nil
+	case activityjob.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case activityjob.FieldRunAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastUpdated(v)
+		m.SetRunAt(v)
+		return nil
+	case activityjob.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
+	case activityjob.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case activityjob.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case activityjob.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown HexInfluence field %s", name)
+	return fmt.Errorf("unknown ActivityJob field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *HexInfluenceMutation) AddedFields() []string {
+func (m *ActivityJobMutation) AddedFields() []string {
 	var fields []string
-	if m.addscore != nil {
-		fields = append(fields, hexinfluence.FieldScore)
+	if m.addalready_scored != nil {
+		fields = append(fields, activityjob.FieldAlreadyScored)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, activityjob.FieldAttempts)
 	}
 	return fields
 }
@@ -2239,10 +2762,12 @@ func (m *HexInfluenceMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *HexInfluenceMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ActivityJobMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case hexinfluence.FieldScore:
-		return m.AddedScore()
+	case activityjob.FieldAlreadyScored:
+		return m.AddedAlreadyScored()
+	case activityjob.FieldAttempts:
+		return m.AddedAttempts()
 	}
 	return nil, false
 }
@@ -2250,80 +2775,113 @@ func (m *HexInfluenceMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HexInfluenceMutation) AddField(name string, value ent.Value) error {
+func (m *ActivityJobMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case hexinfluence.FieldScore:
-		v, ok := value.(float64)
+	case activityjob.FieldAlreadyScored:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddScore(v)
+		m.AddAlreadyScored(v)
+		return nil
+	case activityjob.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
 		return nil
 	}
-	return fmt.Errorf("unknown HexInfluence numeric field %s", name)
+	return fmt.Errorf("unknown ActivityJob numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *HexInfluenceMutation) ClearedFields() []string {
-	return nil
+func (m *ActivityJobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(activityjob.FieldLockedUntil) {
+		fields = append(fields, activityjob.FieldLockedUntil)
+	}
+	if m.FieldCleared(activityjob.FieldLastError) {
+		fields = append(fields, activityjob.FieldLastError)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *HexInfluenceMutation) FieldCleared(name string) bool {
+func (m *ActivityJobMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *HexInfluenceMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown HexInfluence nullable field %s", name)
+func (m *ActivityJobMutation) ClearField(name string) error {
+	switch name {
+	case activityjob.FieldLockedUntil:
+		m.ClearLockedUntil()
+		return nil
+	case activityjob.FieldLastError:
+		m.ClearLastError()
+		return nil
+	}
+	return fmt.Errorf("unknown ActivityJob nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *HexInfluenceMutation) ResetField(name string) error {
+func (m *ActivityJobMutation) ResetField(name string) error {
 	switch name {
-	case hexinfluence.FieldH3Index:
-		m.ResetH3Index()
+	case activityjob.FieldActivityID:
+		m.ResetActivityID()
 		return nil
-	case hexinfluence.FieldUserID:
+	case activityjob.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case hexinfluence.FieldScore:
-		m.ResetScore()
+	case activityjob.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case hexinfluence.FieldLastUpdated:
-		m.ResetLastUpdated()
+	case activityjob.FieldAlreadyScored:
+		m.ResetAlreadyScored()
+		return nil
+	case activityjob.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case activityjob.FieldRunAt:
+		m.ResetRunAt()
+		return nil
+	case activityjob.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
+	case activityjob.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case activityjob.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case activityjob.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown HexInfluence field %s", name)
+	return fmt.Errorf("unknown ActivityJob field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *HexInfluenceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.hex != nil {
-		edges = append(edges, hexinfluence.EdgeHex)
-	}
-	if m.users != nil {
-		edges = append(edges, hexinfluence.EdgeUsers)
+func (m *ActivityJobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.activity != nil {
+		edges = append(edges, activityjob.EdgeActivity)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *HexInfluenceMutation) AddedIDs(name string) []ent.Value {
+func (m *ActivityJobMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case hexinfluence.EdgeHex:
-		if id := m.hex; id != nil {
-			return []ent.Value{*id}
-		}
-	case hexinfluence.EdgeUsers:
-		if id := m.users; id != nil {
+	case activityjob.EdgeActivity:
+		if id := m.activity; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -2331,96 +2889,98 @@ func (m *HexInfluenceMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *HexInfluenceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *ActivityJobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *HexInfluenceMutation) RemovedIDs(name string) []ent.Value {
+func (m *ActivityJobMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *HexInfluenceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedhex {
-		edges = append(edges, hexinfluence.EdgeHex)
-	}
-	if m.clearedusers {
-		edges = append(edges, hexinfluence.EdgeUsers)
+func (m *ActivityJobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedactivity {
+		edges = append(edges, activityjob.EdgeActivity)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *HexInfluenceMutation) EdgeCleared(name string) bool {
+func (m *ActivityJobMutation) EdgeCleared(name string) bool {
 	switch name {
-	case hexinfluence.EdgeHex:
-		return m.clearedhex
-	case hexinfluence.EdgeUsers:
-		return m.clearedusers
+	case activityjob.EdgeActivity:
+		return m.clearedactivity
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *HexInfluenceMutation) ClearEdge(name string) error {
+func (m *ActivityJobMutation) ClearEdge(name string) error {
 	switch name {
-	case hexinfluence.EdgeHex:
-		m.ClearHex()
-		return nil
-	case hexinfluence.EdgeUsers:
-		m.ClearUsers()
+	case activityjob.EdgeActivity:
+		m.ClearActivity()
 		return nil
 	}
-	return fmt.Errorf("unknown HexInfluence unique edge %s", name)
+	return fmt.Errorf("unknown ActivityJob unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *HexInfluenceMutation) ResetEdge(name string) error {
+func (m *ActivityJobMutation) ResetEdge(name string) error {
 	switch name {
-	case hexinfluence.EdgeHex:
-		m.ResetHex()
-		return nil
-	case hexinfluence.EdgeUsers:
-		m.ResetUsers()
+	case activityjob.EdgeActivity:
+		m.ResetActivity()
 		return nil
 	}
-	return fmt.Errorf("unknown HexInfluence edge %s", name)
+	return fmt.Errorf("unknown ActivityJob edge %s", name)
 }
 
-// HexLeaderboardMutation represents an operation that mutates the HexLeaderboard nodes in the graph.
-type HexLeaderboardMutation struct {
+// ActivitySessionMutation represents an operation that mutates the ActivitySession nodes in the graph.
+type ActivitySessionMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *uuid.UUID
-	top_users       *[]model.TopUser
-	appendtop_users []model.TopUser
-	clearedFields   map[string]struct{}
-	hex             *string
-	clearedhex      bool
-	done            bool
-	oldValue        func(context.Context) (*HexLeaderboard, error)
-	predicates      []predicate.HexLeaderboard
-}
-
-var _ ent.Mutation = (*HexLeaderboardMutation)(nil)
-
-// hexleaderboardOption allows management of the mutation configuration using functional options.
-type hexleaderboardOption func(*HexLeaderboardMutation)
-
-// newHexLeaderboardMutation creates new mutation for the HexLeaderboard entity.
-func newHexLeaderboardMutation(c config, op Op, opts ...hexleaderboardOption) *HexLeaderboardMutation {
-	m := &HexLeaderboardMutation{
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	user_id            *uuid.UUID
+	status             *activitysession.Status
+	activity_type      *activitysession.ActivityType
+	h3_indexes         *[]string
+	appendh3_indexes   []string
+	track              *[]model.TrackPoint
+	appendtrack        []model.TrackPoint
+	distance_meters    *float64
+	adddistance_meters *float64
+	active_seconds     *float64
+	addactive_seconds  *float64
+	scored_cells       *int
+	addscored_cells    *int
+	started_at         *time.Time
+	resumed_at         *time.Time
+	last_seen_at       *time.Time
+	activity_id        *uuid.UUID
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*ActivitySession, error)
+	predicates         []predicate.ActivitySession
+}
+
+var _ ent.Mutation = (*ActivitySessionMutation)(nil)
+
+// activitysessionOption allows management of the mutation configuration using functional options.
+type activitysessionOption func(*ActivitySessionMutation)
+
+// newActivitySessionMutation creates new mutation for the ActivitySession entity.
+func newActivitySessionMutation(c config, op Op, opts ...activitysessionOption) *ActivitySessionMutation {
+	m := &ActivitySessionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeHexLeaderboard,
+		typ:           TypeActivitySession,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2429,20 +2989,20 @@ func newHexLeaderboardMutation(c config, op Op, opts ...hexleaderboardOption) *H
 	return m
 }
 
-// withHexLeaderboardID sets the ID field of the mutation.
-func withHexLeaderboardID(id uuid.UUID) hexleaderboardOption {
-	return func(m *HexLeaderboardMutation) {
+// withActivitySessionID sets the ID field of the mutation.
+func withActivitySessionID(id uuid.UUID) activitysessionOption {
+	return func(m *ActivitySessionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *HexLeaderboard
+			value *ActivitySession
 		)
-		m.oldValue = func(ctx context.Context) (*HexLeaderboard, error) {
+		m.oldValue = func(ctx context.Context) (*ActivitySession, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().HexLeaderboard.Get(ctx, id)
+					value, err = m.Client().ActivitySession.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2451,10 +3011,10 @@ func withHexLeaderboardID(id uuid.UUID) hexleaderboardOption {
 	}
 }
 
-// withHexLeaderboard sets the old HexLeaderboard of the mutation.
-func withHexLeaderboard(node *HexLeaderboard) hexleaderboardOption {
-	return func(m *HexLeaderboardMutation) {
-		m.oldValue = func(context.Context) (*HexLeaderboard, error) {
+// withActivitySession sets the old ActivitySession of the mutation.
+func withActivitySession(node *ActivitySession) activitysessionOption {
+	return func(m *ActivitySessionMutation) {
+		m.oldValue = func(context.Context) (*ActivitySession, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2463,7 +3023,7 @@ func withHexLeaderboard(node *HexLeaderboard) hexleaderboardOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m HexLeaderboardMutation) Client() *Client {
+func (m ActivitySessionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2471,185 +3031,19957 @@ func (m HexLeaderboardMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m HexLeaderboardMutation) Tx() (*Tx, error) {
+func (m ActivitySessionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ActivitySession entities.
+func (m *ActivitySessionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ActivitySessionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ActivitySessionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ActivitySession.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ActivitySessionMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ActivitySessionMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ActivitySession entity.
+// If the ActivitySession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivitySessionMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ActivitySessionMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ActivitySessionMutation) SetStatus(a activitysession.Status) {
+	m.status = &a
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ActivitySessionMutation) Status() (r activitysession.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ActivitySession entity.
+// If the ActivitySession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivitySessionMutation) OldStatus(ctx context.Context) (v activitysession.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ActivitySessionMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetActivityType sets the "activity_type" field.
+func (m *ActivitySessionMutation) SetActivityType(at activitysession.ActivityType) {
+	m.activity_type = &at
+}
+
+// ActivityType returns the value of the "activity_type" field in the mutation.
+func (m *ActivitySessionMutation) ActivityType() (r activitysession.ActivityType, exists bool) {
+	v := m.activity_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActivityType returns the old "activity_type" field's value of the ActivitySession entity.
+// If the ActivitySession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivitySessionMutation) OldActivityType(ctx context.Context) (v activitysession.ActivityType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActivityType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActivityType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActivityType: %w", err)
+	}
+	return oldValue.ActivityType, nil
+}
+
+// ResetActivityType resets all changes to the "activity_type" field.
+func (m *ActivitySessionMutation) ResetActivityType() {
+	m.activity_type = nil
+}
+
+// SetH3Indexes sets the "h3_indexes" field.
+func (m *ActivitySessionMutation) SetH3Indexes(s []string) {
+	m.h3_indexes = &s
+	m.appendh3_indexes = nil
+}
+
+// H3Indexes returns the value of the "h3_indexes" field in the mutation.
+func (m *ActivitySessionMutation) H3Indexes() (r []string, exists bool) {
+	v := m.h3_indexes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldH3Indexes returns the old "h3_indexes" field's value of the ActivitySession entity.
+// If the ActivitySession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivitySessionMutation) OldH3Indexes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldH3Indexes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldH3Indexes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldH3Indexes: %w", err)
+	}
+	return oldValue.H3Indexes, nil
+}
+
+// AppendH3Indexes adds s to the "h3_indexes" field.
+func (m *ActivitySessionMutation) AppendH3Indexes(s []string) {
+	m.appendh3_indexes = append(m.appendh3_indexes, s...)
+}
+
+// AppendedH3Indexes returns the list of values that were appended to the "h3_indexes" field in this mutation.
+func (m *ActivitySessionMutation) AppendedH3Indexes() ([]string, bool) {
+	if len(m.appendh3_indexes) == 0 {
+		return nil, false
+	}
+	return m.appendh3_indexes, true
+}
+
+// ResetH3Indexes resets all changes to the "h3_indexes" field.
+func (m *ActivitySessionMutation) ResetH3Indexes() {
+	m.h3_indexes = nil
+	m.appendh3_indexes = nil
+}
+
+// SetTrack sets the "track" field.
+func (m *ActivitySessionMutation) SetTrack(mp []model.TrackPoint) {
+	m.track = &mp
+	m.appendtrack = nil
+}
+
+// Track returns the value of the "track" field in the mutation.
+func (m *ActivitySessionMutation) Track() (r []model.TrackPoint, exists bool) {
+	v := m.track
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrack returns the old "track" field's value of the ActivitySession entity.
+// If the ActivitySession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivitySessionMutation) OldTrack(ctx context.Context) (v []model.TrackPoint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrack is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrack requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrack: %w", err)
+	}
+	return oldValue.Track, nil
+}
+
+// AppendTrack adds mp to the "track" field.
+func (m *ActivitySessionMutation) AppendTrack(mp []model.TrackPoint) {
+	m.appendtrack = append(m.appendtrack, mp...)
+}
+
+// AppendedTrack returns the list of values that were appended to the "track" field in this mutation.
+func (m *ActivitySessionMutation) AppendedTrack() ([]model.TrackPoint, bool) {
+	if len(m.appendtrack) == 0 {
+		return nil, false
+	}
+	return m.appendtrack, true
+}
+
+// ClearTrack clears the value of the "track" field.
+func (m *ActivitySessionMutation) ClearTrack() {
+	m.track = nil
+	m.appendtrack = nil
+	m.clearedFields[activitysession.FieldTrack] = struct{}{}
+}
+
+// TrackCleared returns if the "track" field was cleared in this mutation.
+func (m *ActivitySessionMutation) TrackCleared() bool {
+	_, ok := m.clearedFields[activitysession.FieldTrack]
+	return ok
+}
+
+// ResetTrack resets all changes to the "track" field.
+func (m *ActivitySessionMutation) ResetTrack() {
+	m.track = nil
+	m.appendtrack = nil
+	delete(m.clearedFields, activitysession.FieldTrack)
+}
+
+// SetDistanceMeters sets the "distance_meters" field.
+func (m *ActivitySessionMutation) SetDistanceMeters(f float64) {
+	m.distance_meters = &f
+	m.adddistance_meters = nil
+}
+
+// DistanceMeters returns the value of the "distance_meters" field in the mutation.
+func (m *ActivitySessionMutation) DistanceMeters() (r float64, exists bool) {
+	v := m.distance_meters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDistanceMeters returns the old "distance_meters" field's value of the ActivitySession entity.
+// If the ActivitySession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivitySessionMutation) OldDistanceMeters(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDistanceMeters is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDistanceMeters requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDistanceMeters: %w", err)
+	}
+	return oldValue.DistanceMeters, nil
+}
+
+// AddDistanceMeters adds f to the "distance_meters" field.
+func (m *ActivitySessionMutation) AddDistanceMeters(f float64) {
+	if m.adddistance_meters != nil {
+		*m.adddistance_meters += f
+	} else {
+		m.adddistance_meters = &f
+	}
+}
+
+// AddedDistanceMeters returns the value that was added to the "distance_meters" field in this mutation.
+func (m *ActivitySessionMutation) AddedDistanceMeters() (r float64, exists bool) {
+	v := m.adddistance_meters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDistanceMeters resets all changes to the "distance_meters" field.
+func (m *ActivitySessionMutation) ResetDistanceMeters() {
+	m.distance_meters = nil
+	m.adddistance_meters = nil
+}
+
+// SetActiveSeconds sets the "active_seconds" field.
+func (m *ActivitySessionMutation) SetActiveSeconds(f float64) {
+	m.active_seconds = &f
+	m.addactive_seconds = nil
+}
+
+// ActiveSeconds returns the value of the "active_seconds" field in the mutation.
+func (m *ActivitySessionMutation) ActiveSeconds() (r float64, exists bool) {
+	v := m.active_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActiveSeconds returns the old "active_seconds" field's value of the ActivitySession entity.
+// If the ActivitySession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivitySessionMutation) OldActiveSeconds(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActiveSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActiveSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActiveSeconds: %w", err)
+	}
+	return oldValue.ActiveSeconds, nil
+}
+
+// AddActiveSeconds adds f to the "active_seconds" field.
+func (m *ActivitySessionMutation) AddActiveSeconds(f float64) {
+	if m.addactive_seconds != nil {
+		*m.addactive_seconds += f
+	} else {
+		m.addactive_seconds = &f
+	}
+}
+
+// AddedActiveSeconds returns the value that was added to the "active_seconds" field in this mutation.
+func (m *ActivitySessionMutation) AddedActiveSeconds() (r float64, exists bool) {
+	v := m.addactive_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetActiveSeconds resets all changes to the "active_seconds" field.
+func (m *ActivitySessionMutation) ResetActiveSeconds() {
+	m.active_seconds = nil
+	m.addactive_seconds = nil
+}
+
+// SetScoredCells sets the "scored_cells" field.
+func (m *ActivitySessionMutation) SetScoredCells(i int) {
+	m.scored_cells = &i
+	m.addscored_cells = nil
+}
+
+// ScoredCells returns the value of the "scored_cells" field in the mutation.
+func (m *ActivitySessionMutation) ScoredCells() (r int, exists bool) {
+	v := m.scored_cells
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScoredCells returns the old "scored_cells" field's value of the ActivitySession entity.
+// If the ActivitySession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivitySessionMutation) OldScoredCells(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScoredCells is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScoredCells requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScoredCells: %w", err)
+	}
+	return oldValue.ScoredCells, nil
+}
+
+// AddScoredCells adds i to the "scored_cells" field.
+func (m *ActivitySessionMutation) AddScoredCells(i int) {
+	if m.addscored_cells != nil {
+		*m.addscored_cells += i
+	} else {
+		m.addscored_cells = &i
+	}
+}
+
+// AddedScoredCells returns the value that was added to the "scored_cells" field in this mutation.
+func (m *ActivitySessionMutation) AddedScoredCells() (r int, exists bool) {
+	v := m.addscored_cells
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScoredCells resets all changes to the "scored_cells" field.
+func (m *ActivitySessionMutation) ResetScoredCells() {
+	m.scored_cells = nil
+	m.addscored_cells = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *ActivitySessionMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *ActivitySessionMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the ActivitySession entity.
+// If the ActivitySession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivitySessionMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *ActivitySessionMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetResumedAt sets the "resumed_at" field.
+func (m *ActivitySessionMutation) SetResumedAt(t time.Time) {
+	m.resumed_at = &t
+}
+
+// ResumedAt returns the value of the "resumed_at" field in the mutation.
+func (m *ActivitySessionMutation) ResumedAt() (r time.Time, exists bool) {
+	v := m.resumed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResumedAt returns the old "resumed_at" field's value of the ActivitySession entity.
+// If the ActivitySession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivitySessionMutation) OldResumedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResumedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResumedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResumedAt: %w", err)
+	}
+	return oldValue.ResumedAt, nil
+}
+
+// ClearResumedAt clears the value of the "resumed_at" field.
+func (m *ActivitySessionMutation) ClearResumedAt() {
+	m.resumed_at = nil
+	m.clearedFields[activitysession.FieldResumedAt] = struct{}{}
+}
+
+// ResumedAtCleared returns if the "resumed_at" field was cleared in this mutation.
+func (m *ActivitySessionMutation) ResumedAtCleared() bool {
+	_, ok := m.clearedFields[activitysession.FieldResumedAt]
+	return ok
+}
+
+// ResetResumedAt resets all changes to the "resumed_at" field.
+func (m *ActivitySessionMutation) ResetResumedAt() {
+	m.resumed_at = nil
+	delete(m.clearedFields, activitysession.FieldResumedAt)
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *ActivitySessionMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
+}
+
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *ActivitySessionMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenAt returns the old "last_seen_at" field's value of the ActivitySession entity.
+// If the ActivitySession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivitySessionMutation) OldLastSeenAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *ActivitySessionMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+}
+
+// SetActivityID sets the "activity_id" field.
+func (m *ActivitySessionMutation) SetActivityID(u uuid.UUID) {
+	m.activity_id = &u
+}
+
+// ActivityID returns the value of the "activity_id" field in the mutation.
+func (m *ActivitySessionMutation) ActivityID() (r uuid.UUID, exists bool) {
+	v := m.activity_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActivityID returns the old "activity_id" field's value of the ActivitySession entity.
+// If the ActivitySession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivitySessionMutation) OldActivityID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActivityID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActivityID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActivityID: %w", err)
+	}
+	return oldValue.ActivityID, nil
+}
+
+// ClearActivityID clears the value of the "activity_id" field.
+func (m *ActivitySessionMutation) ClearActivityID() {
+	m.activity_id = nil
+	m.clearedFields[activitysession.FieldActivityID] = struct{}{}
+}
+
+// ActivityIDCleared returns if the "activity_id" field was cleared in this mutation.
+func (m *ActivitySessionMutation) ActivityIDCleared() bool {
+	_, ok := m.clearedFields[activitysession.FieldActivityID]
+	return ok
+}
+
+// ResetActivityID resets all changes to the "activity_id" field.
+func (m *ActivitySessionMutation) ResetActivityID() {
+	m.activity_id = nil
+	delete(m.clearedFields, activitysession.FieldActivityID)
+}
+
+// Where appends a list predicates to the ActivitySessionMutation builder.
+func (m *ActivitySessionMutation) Where(ps ...predicate.ActivitySession) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ActivitySessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ActivitySessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ActivitySession, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ActivitySessionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ActivitySessionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ActivitySession).
+func (m *ActivitySessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ActivitySessionMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.user_id != nil {
+		fields = append(fields, activitysession.FieldUserID)
+	}
+	if m.status != nil {
+		fields = append(fields, activitysession.FieldStatus)
+	}
+	if m.activity_type != nil {
+		fields = append(fields, activitysession.FieldActivityType)
+	}
+	if m.h3_indexes != nil {
+		fields = append(fields, activitysession.FieldH3Indexes)
+	}
+	if m.track != nil {
+		fields = append(fields, activitysession.FieldTrack)
+	}
+	if m.distance_meters != nil {
+		fields = append(fields, activitysession.FieldDistanceMeters)
+	}
+	if m.active_seconds != nil {
+		fields = append(fields, activitysession.FieldActiveSeconds)
+	}
+	if m.scored_cells != nil {
+		fields = append(fields, activitysession.FieldScoredCells)
+	}
+	if m.started_at != nil {
+		fields = append(fields, activitysession.FieldStartedAt)
+	}
+	if m.resumed_at != nil {
+		fields = append(fields, activitysession.FieldResumedAt)
+	}
+	if m.last_seen_at != nil {
+		fields = append(fields, activitysession.FieldLastSeenAt)
+	}
+	if m.activity_id != nil {
+		fields = append(fields, activitysession.FieldActivityID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ActivitySessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case activitysession.FieldUserID:
+		return m.UserID()
+	case activitysession.FieldStatus:
+		return m.Status()
+	case activitysession.FieldActivityType:
+		return m.ActivityType()
+	case activitysession.FieldH3Indexes:
+		return m.H3Indexes()
+	case activitysession.FieldTrack:
+		return m.Track()
+	case activitysession.FieldDistanceMeters:
+		return m.DistanceMeters()
+	case activitysession.FieldActiveSeconds:
+		return m.ActiveSeconds()
+	case activitysession.FieldScoredCells:
+		return m.ScoredCells()
+	case activitysession.FieldStartedAt:
+		return m.StartedAt()
+	case activitysession.FieldResumedAt:
+		return m.ResumedAt()
+	case activitysession.FieldLastSeenAt:
+		return m.LastSeenAt()
+	case activitysession.FieldActivityID:
+		return m.ActivityID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ActivitySessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case activitysession.FieldUserID:
+		return m.OldUserID(ctx)
+	case activitysession.FieldStatus:
+		return m.OldStatus(ctx)
+	case activitysession.FieldActivityType:
+		return m.OldActivityType(ctx)
+	case activitysession.FieldH3Indexes:
+		return m.OldH3Indexes(ctx)
+	case activitysession.FieldTrack:
+		return m.OldTrack(ctx)
+	case activitysession.FieldDistanceMeters:
+		return m.OldDistanceMeters(ctx)
+	case activitysession.FieldActiveSeconds:
+		return m.OldActiveSeconds(ctx)
+	case activitysession.FieldScoredCells:
+		return m.OldScoredCells(ctx)
+	case activitysession.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case activitysession.FieldResumedAt:
+		return m.OldResumedAt(ctx)
+	case activitysession.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
+	case activitysession.FieldActivityID:
+		return m.OldActivityID(ctx)
+	}
+	return nil, fmt.Errorf("unknown ActivitySession field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ActivitySessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case activitysession.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case activitysession.FieldStatus:
+		v, ok := value.(activitysession.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case activitysession.FieldActivityType:
+		v, ok := value.(activitysession.ActivityType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActivityType(v)
+		return nil
+	case activitysession.FieldH3Indexes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetH3Indexes(v)
+		return nil
+	case activitysession.FieldTrack:
+		v, ok := value.([]model.TrackPoint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrack(v)
+		return nil
+	case activitysession.FieldDistanceMeters:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDistanceMeters(v)
+		return nil
+	case activitysession.FieldActiveSeconds:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActiveSeconds(v)
+		return nil
+	case activitysession.FieldScoredCells:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScoredCells(v)
+		return nil
+	case activitysession.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case activitysession.FieldResumedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResumedAt(v)
+		return nil
+	case activitysession.FieldLastSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenAt(v)
+		return nil
+	case activitysession.FieldActivityID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActivityID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ActivitySession field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ActivitySessionMutation) AddedFields() []string {
+	var fields []string
+	if m.adddistance_meters != nil {
+		fields = append(fields, activitysession.FieldDistanceMeters)
+	}
+	if m.addactive_seconds != nil {
+		fields = append(fields, activitysession.FieldActiveSeconds)
+	}
+	if m.addscored_cells != nil {
+		fields = append(fields, activitysession.FieldScoredCells)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ActivitySessionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case activitysession.FieldDistanceMeters:
+		return m.AddedDistanceMeters()
+	case activitysession.FieldActiveSeconds:
+		return m.AddedActiveSeconds()
+	case activitysession.FieldScoredCells:
+		return m.AddedScoredCells()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ActivitySessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case activitysession.FieldDistanceMeters:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDistanceMeters(v)
+		return nil
+	case activitysession.FieldActiveSeconds:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddActiveSeconds(v)
+		return nil
+	case activitysession.FieldScoredCells:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScoredCells(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ActivitySession numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ActivitySessionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(activitysession.FieldTrack) {
+		fields = append(fields, activitysession.FieldTrack)
+	}
+	if m.FieldCleared(activitysession.FieldResumedAt) {
+		fields = append(fields, activitysession.FieldResumedAt)
+	}
+	if m.FieldCleared(activitysession.FieldActivityID) {
+		fields = append(fields, activitysession.FieldActivityID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ActivitySessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ActivitySessionMutation) ClearField(name string) error {
+	switch name {
+	case activitysession.FieldTrack:
+		m.ClearTrack()
+		return nil
+	case activitysession.FieldResumedAt:
+		m.ClearResumedAt()
+		return nil
+	case activitysession.FieldActivityID:
+		m.ClearActivityID()
+		return nil
+	}
+	return fmt.Errorf("unknown ActivitySession nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ActivitySessionMutation) ResetField(name string) error {
+	switch name {
+	case activitysession.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case activitysession.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case activitysession.FieldActivityType:
+		m.ResetActivityType()
+		return nil
+	case activitysession.FieldH3Indexes:
+		m.ResetH3Indexes()
+		return nil
+	case activitysession.FieldTrack:
+		m.ResetTrack()
+		return nil
+	case activitysession.FieldDistanceMeters:
+		m.ResetDistanceMeters()
+		return nil
+	case activitysession.FieldActiveSeconds:
+		m.ResetActiveSeconds()
+		return nil
+	case activitysession.FieldScoredCells:
+		m.ResetScoredCells()
+		return nil
+	case activitysession.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case activitysession.FieldResumedAt:
+		m.ResetResumedAt()
+		return nil
+	case activitysession.FieldLastSeenAt:
+		m.ResetLastSeenAt()
+		return nil
+	case activitysession.FieldActivityID:
+		m.ResetActivityID()
+		return nil
+	}
+	return fmt.Errorf("unknown ActivitySession field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ActivitySessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ActivitySessionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ActivitySessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ActivitySessionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ActivitySessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ActivitySessionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ActivitySessionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ActivitySession unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ActivitySessionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ActivitySession edge %s", name)
+}
+
+// DecaySweepMutation represents an operation that mutates the DecaySweep nodes in the graph.
+type DecaySweepMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	started_at       *time.Time
+	cursor           *uuid.UUID
+	processed        *int
+	addprocessed     *int
+	decayed          *int
+	adddecayed       *int
+	pruned           *int
+	addpruned        *int
+	owner_changes    *int
+	addowner_changes *int
+	finished_at      *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*DecaySweep, error)
+	predicates       []predicate.DecaySweep
+}
+
+var _ ent.Mutation = (*DecaySweepMutation)(nil)
+
+// decaysweepOption allows management of the mutation configuration using functional options.
+type decaysweepOption func(*DecaySweepMutation)
+
+// newDecaySweepMutation creates new mutation for the DecaySweep entity.
+func newDecaySweepMutation(c config, op Op, opts ...decaysweepOption) *DecaySweepMutation {
+	m := &DecaySweepMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDecaySweep,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDecaySweepID sets the ID field of the mutation.
+func withDecaySweepID(id uuid.UUID) decaysweepOption {
+	return func(m *DecaySweepMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DecaySweep
+		)
+		m.oldValue = func(ctx context.Context) (*DecaySweep, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DecaySweep.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDecaySweep sets the old DecaySweep of the mutation.
+func withDecaySweep(node *DecaySweep) decaysweepOption {
+	return func(m *DecaySweepMutation) {
+		m.oldValue = func(context.Context) (*DecaySweep, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DecaySweepMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DecaySweepMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DecaySweep entities.
+func (m *DecaySweepMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DecaySweepMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DecaySweepMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DecaySweep.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *DecaySweepMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *DecaySweepMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the DecaySweep entity.
+// If the DecaySweep object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DecaySweepMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *DecaySweepMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetCursor sets the "cursor" field.
+func (m *DecaySweepMutation) SetCursor(u uuid.UUID) {
+	m.cursor = &u
+}
+
+// Cursor returns the value of the "cursor" field in the mutation.
+func (m *DecaySweepMutation) Cursor() (r uuid.UUID, exists bool) {
+	v := m.cursor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCursor returns the old "cursor" field's value of the DecaySweep entity.
+// If the DecaySweep object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DecaySweepMutation) OldCursor(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCursor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCursor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCursor: %w", err)
+	}
+	return oldValue.Cursor, nil
+}
+
+// ClearCursor clears the value of the "cursor" field.
+func (m *DecaySweepMutation) ClearCursor() {
+	m.cursor = nil
+	m.clearedFields[decaysweep.FieldCursor] = struct{}{}
+}
+
+// CursorCleared returns if the "cursor" field was cleared in this mutation.
+func (m *DecaySweepMutation) CursorCleared() bool {
+	_, ok := m.clearedFields[decaysweep.FieldCursor]
+	return ok
+}
+
+// ResetCursor resets all changes to the "cursor" field.
+func (m *DecaySweepMutation) ResetCursor() {
+	m.cursor = nil
+	delete(m.clearedFields, decaysweep.FieldCursor)
+}
+
+// SetProcessed sets the "processed" field.
+func (m *DecaySweepMutation) SetProcessed(i int) {
+	m.processed = &i
+	m.addprocessed = nil
+}
+
+// Processed returns the value of the "processed" field in the mutation.
+func (m *DecaySweepMutation) Processed() (r int, exists bool) {
+	v := m.processed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessed returns the old "processed" field's value of the DecaySweep entity.
+// If the DecaySweep object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DecaySweepMutation) OldProcessed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessed: %w", err)
+	}
+	return oldValue.Processed, nil
+}
+
+// AddProcessed adds i to the "processed" field.
+func (m *DecaySweepMutation) AddProcessed(i int) {
+	if m.addprocessed != nil {
+		*m.addprocessed += i
+	} else {
+		m.addprocessed = &i
+	}
+}
+
+// AddedProcessed returns the value that was added to the "processed" field in this mutation.
+func (m *DecaySweepMutation) AddedProcessed() (r int, exists bool) {
+	v := m.addprocessed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetProcessed resets all changes to the "processed" field.
+func (m *DecaySweepMutation) ResetProcessed() {
+	m.processed = nil
+	m.addprocessed = nil
+}
+
+// SetDecayed sets the "decayed" field.
+func (m *DecaySweepMutation) SetDecayed(i int) {
+	m.decayed = &i
+	m.adddecayed = nil
+}
+
+// Decayed returns the value of the "decayed" field in the mutation.
+func (m *DecaySweepMutation) Decayed() (r int, exists bool) {
+	v := m.decayed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDecayed returns the old "decayed" field's value of the DecaySweep entity.
+// If the DecaySweep object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DecaySweepMutation) OldDecayed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDecayed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDecayed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDecayed: %w", err)
+	}
+	return oldValue.Decayed, nil
+}
+
+// AddDecayed adds i to the "decayed" field.
+func (m *DecaySweepMutation) AddDecayed(i int) {
+	if m.adddecayed != nil {
+		*m.adddecayed += i
+	} else {
+		m.adddecayed = &i
+	}
+}
+
+// AddedDecayed returns the value that was added to the "decayed" field in this mutation.
+func (m *DecaySweepMutation) AddedDecayed() (r int, exists bool) {
+	v := m.adddecayed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDecayed resets all changes to the "decayed" field.
+func (m *DecaySweepMutation) ResetDecayed() {
+	m.decayed = nil
+	m.adddecayed = nil
+}
+
+// SetPruned sets the "pruned" field.
+func (m *DecaySweepMutation) SetPruned(i int) {
+	m.pruned = &i
+	m.addpruned = nil
+}
+
+// Pruned returns the value of the "pruned" field in the mutation.
+func (m *DecaySweepMutation) Pruned() (r int, exists bool) {
+	v := m.pruned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPruned returns the old "pruned" field's value of the DecaySweep entity.
+// If the DecaySweep object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DecaySweepMutation) OldPruned(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPruned is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPruned requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPruned: %w", err)
+	}
+	return oldValue.Pruned, nil
+}
+
+// AddPruned adds i to the "pruned" field.
+func (m *DecaySweepMutation) AddPruned(i int) {
+	if m.addpruned != nil {
+		*m.addpruned += i
+	} else {
+		m.addpruned = &i
+	}
+}
+
+// AddedPruned returns the value that was added to the "pruned" field in this mutation.
+func (m *DecaySweepMutation) AddedPruned() (r int, exists bool) {
+	v := m.addpruned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPruned resets all changes to the "pruned" field.
+func (m *DecaySweepMutation) ResetPruned() {
+	m.pruned = nil
+	m.addpruned = nil
+}
+
+// SetOwnerChanges sets the "owner_changes" field.
+func (m *DecaySweepMutation) SetOwnerChanges(i int) {
+	m.owner_changes = &i
+	m.addowner_changes = nil
+}
+
+// OwnerChanges returns the value of the "owner_changes" field in the mutation.
+func (m *DecaySweepMutation) OwnerChanges() (r int, exists bool) {
+	v := m.owner_changes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerChanges returns the old "owner_changes" field's value of the DecaySweep entity.
+// If the DecaySweep object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DecaySweepMutation) OldOwnerChanges(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerChanges is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerChanges requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerChanges: %w", err)
+	}
+	return oldValue.OwnerChanges, nil
+}
+
+// AddOwnerChanges adds i to the "owner_changes" field.
+func (m *DecaySweepMutation) AddOwnerChanges(i int) {
+	if m.addowner_changes != nil {
+		*m.addowner_changes += i
+	} else {
+		m.addowner_changes = &i
+	}
+}
+
+// AddedOwnerChanges returns the value that was added to the "owner_changes" field in this mutation.
+func (m *DecaySweepMutation) AddedOwnerChanges() (r int, exists bool) {
+	v := m.addowner_changes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOwnerChanges resets all changes to the "owner_changes" field.
+func (m *DecaySweepMutation) ResetOwnerChanges() {
+	m.owner_changes = nil
+	m.addowner_changes = nil
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *DecaySweepMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *DecaySweepMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the DecaySweep entity.
+// If the DecaySweep object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DecaySweepMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *DecaySweepMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[decaysweep.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *DecaySweepMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[decaysweep.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *DecaySweepMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, decaysweep.FieldFinishedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DecaySweepMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DecaySweepMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the DecaySweep entity.
+// If the DecaySweep object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DecaySweepMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DecaySweepMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the DecaySweepMutation builder.
+func (m *DecaySweepMutation) Where(ps ...predicate.DecaySweep) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DecaySweepMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DecaySweepMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DecaySweep, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DecaySweepMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DecaySweepMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DecaySweep).
+func (m *DecaySweepMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DecaySweepMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.started_at != nil {
+		fields = append(fields, decaysweep.FieldStartedAt)
+	}
+	if m.cursor != nil {
+		fields = append(fields, decaysweep.FieldCursor)
+	}
+	if m.processed != nil {
+		fields = append(fields, decaysweep.FieldProcessed)
+	}
+	if m.decayed != nil {
+		fields = append(fields, decaysweep.FieldDecayed)
+	}
+	if m.pruned != nil {
+		fields = append(fields, decaysweep.FieldPruned)
+	}
+	if m.owner_changes != nil {
+		fields = append(fields, decaysweep.FieldOwnerChanges)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, decaysweep.FieldFinishedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, decaysweep.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DecaySweepMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case decaysweep.FieldStartedAt:
+		return m.StartedAt()
+	case decaysweep.FieldCursor:
+		return m.Cursor()
+	case decaysweep.FieldProcessed:
+		return m.Processed()
+	case decaysweep.FieldDecayed:
+		return m.Decayed()
+	case decaysweep.FieldPruned:
+		return m.Pruned()
+	case decaysweep.FieldOwnerChanges:
+		return m.OwnerChanges()
+	case decaysweep.FieldFinishedAt:
+		return m.FinishedAt()
+	case decaysweep.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DecaySweepMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case decaysweep.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case decaysweep.FieldCursor:
+		return m.OldCursor(ctx)
+	case decaysweep.FieldProcessed:
+		return m.OldProcessed(ctx)
+	case decaysweep.FieldDecayed:
+		return m.OldDecayed(ctx)
+	case decaysweep.FieldPruned:
+		return m.OldPruned(ctx)
+	case decaysweep.FieldOwnerChanges:
+		return m.OldOwnerChanges(ctx)
+	case decaysweep.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case decaysweep.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DecaySweep field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DecaySweepMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case decaysweep.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case decaysweep.FieldCursor:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCursor(v)
+		return nil
+	case decaysweep.FieldProcessed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessed(v)
+		return nil
+	case decaysweep.FieldDecayed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDecayed(v)
+		return nil
+	case decaysweep.FieldPruned:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPruned(v)
+		return nil
+	case decaysweep.FieldOwnerChanges:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerChanges(v)
+		return nil
+	case decaysweep.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	case decaysweep.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DecaySweep field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DecaySweepMutation) AddedFields() []string {
+	var fields []string
+	if m.addprocessed != nil {
+		fields = append(fields, decaysweep.FieldProcessed)
+	}
+	if m.adddecayed != nil {
+		fields = append(fields, decaysweep.FieldDecayed)
+	}
+	if m.addpruned != nil {
+		fields = append(fields, decaysweep.FieldPruned)
+	}
+	if m.addowner_changes != nil {
+		fields = append(fields, decaysweep.FieldOwnerChanges)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DecaySweepMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case decaysweep.FieldProcessed:
+		return m.AddedProcessed()
+	case decaysweep.FieldDecayed:
+		return m.AddedDecayed()
+	case decaysweep.FieldPruned:
+		return m.AddedPruned()
+	case decaysweep.FieldOwnerChanges:
+		return m.AddedOwnerChanges()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DecaySweepMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case decaysweep.FieldProcessed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProcessed(v)
+		return nil
+	case decaysweep.FieldDecayed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDecayed(v)
+		return nil
+	case decaysweep.FieldPruned:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPruned(v)
+		return nil
+	case decaysweep.FieldOwnerChanges:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOwnerChanges(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DecaySweep numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DecaySweepMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(decaysweep.FieldCursor) {
+		fields = append(fields, decaysweep.FieldCursor)
+	}
+	if m.FieldCleared(decaysweep.FieldFinishedAt) {
+		fields = append(fields, decaysweep.FieldFinishedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DecaySweepMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DecaySweepMutation) ClearField(name string) error {
+	switch name {
+	case decaysweep.FieldCursor:
+		m.ClearCursor()
+		return nil
+	case decaysweep.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DecaySweep nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DecaySweepMutation) ResetField(name string) error {
+	switch name {
+	case decaysweep.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case decaysweep.FieldCursor:
+		m.ResetCursor()
+		return nil
+	case decaysweep.FieldProcessed:
+		m.ResetProcessed()
+		return nil
+	case decaysweep.FieldDecayed:
+		m.ResetDecayed()
+		return nil
+	case decaysweep.FieldPruned:
+		m.ResetPruned()
+		return nil
+	case decaysweep.FieldOwnerChanges:
+		m.ResetOwnerChanges()
+		return nil
+	case decaysweep.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	case decaysweep.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DecaySweep field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DecaySweepMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DecaySweepMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DecaySweepMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DecaySweepMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DecaySweepMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DecaySweepMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DecaySweepMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DecaySweep unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DecaySweepMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DecaySweep edge %s", name)
+}
+
+// FriendshipMutation represents an operation that mutates the Friendship nodes in the graph.
+type FriendshipMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	users          *uuid.UUID
+	clearedusers   bool
+	friends        *uuid.UUID
+	clearedfriends bool
+	done           bool
+	oldValue       func(context.Context) (*Friendship, error)
+	predicates     []predicate.Friendship
+}
+
+var _ ent.Mutation = (*FriendshipMutation)(nil)
+
+// friendshipOption allows management of the mutation configuration using functional options.
+type friendshipOption func(*FriendshipMutation)
+
+// newFriendshipMutation creates new mutation for the Friendship entity.
+func newFriendshipMutation(c config, op Op, opts ...friendshipOption) *FriendshipMutation {
+	m := &FriendshipMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFriendship,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFriendshipID sets the ID field of the mutation.
+func withFriendshipID(id int) friendshipOption {
+	return func(m *FriendshipMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Friendship
+		)
+		m.oldValue = func(ctx context.Context) (*Friendship, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Friendship.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFriendship sets the old Friendship of the mutation.
+func withFriendship(node *Friendship) friendshipOption {
+	return func(m *FriendshipMutation) {
+		m.oldValue = func(context.Context) (*Friendship, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FriendshipMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FriendshipMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Friendship entities.
+func (m *FriendshipMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FriendshipMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FriendshipMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Friendship.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *FriendshipMutation) SetUserID(u uuid.UUID) {
+	m.users = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *FriendshipMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.users
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Friendship entity.
+// If the Friendship object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FriendshipMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *FriendshipMutation) ResetUserID() {
+	m.users = nil
+}
+
+// SetFriendID sets the "friend_id" field.
+func (m *FriendshipMutation) SetFriendID(u uuid.UUID) {
+	m.friends = &u
+}
+
+// FriendID returns the value of the "friend_id" field in the mutation.
+func (m *FriendshipMutation) FriendID() (r uuid.UUID, exists bool) {
+	v := m.friends
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFriendID returns the old "friend_id" field's value of the Friendship entity.
+// If the Friendship object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FriendshipMutation) OldFriendID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFriendID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFriendID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFriendID: %w", err)
+	}
+	return oldValue.FriendID, nil
+}
+
+// ResetFriendID resets all changes to the "friend_id" field.
+func (m *FriendshipMutation) ResetFriendID() {
+	m.friends = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FriendshipMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FriendshipMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Friendship entity.
+// If the Friendship object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FriendshipMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FriendshipMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUsersID sets the "users" edge to the User entity by id.
+func (m *FriendshipMutation) SetUsersID(id uuid.UUID) {
+	m.users = &id
+}
+
+// ClearUsers clears the "users" edge to the User entity.
+func (m *FriendshipMutation) ClearUsers() {
+	m.clearedusers = true
+	m.clearedFields[friendship.FieldUserID] = struct{}{}
+}
+
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *FriendshipMutation) UsersCleared() bool {
+	return m.clearedusers
+}
+
+// UsersID returns the "users" edge ID in the mutation.
+func (m *FriendshipMutation) UsersID() (id uuid.UUID, exists bool) {
+	if m.users != nil {
+		return *m.users, true
+	}
+	return
+}
+
+// UsersIDs returns the "users" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UsersID instead. It exists only for internal usage by the builders.
+func (m *FriendshipMutation) UsersIDs() (ids []uuid.UUID) {
+	if id := m.users; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUsers resets all changes to the "users" edge.
+func (m *FriendshipMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+}
+
+// SetFriendsID sets the "friends" edge to the User entity by id.
+func (m *FriendshipMutation) SetFriendsID(id uuid.UUID) {
+	m.friends = &id
+}
+
+// ClearFriends clears the "friends" edge to the User entity.
+func (m *FriendshipMutation) ClearFriends() {
+	m.clearedfriends = true
+	m.clearedFields[friendship.FieldFriendID] = struct{}{}
+}
+
+// FriendsCleared reports if the "friends" edge to the User entity was cleared.
+func (m *FriendshipMutation) FriendsCleared() bool {
+	return m.clearedfriends
+}
+
+// FriendsID returns the "friends" edge ID in the mutation.
+func (m *FriendshipMutation) FriendsID() (id uuid.UUID, exists bool) {
+	if m.friends != nil {
+		return *m.friends, true
+	}
+	return
+}
+
+// FriendsIDs returns the "friends" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FriendsID instead. It exists only for internal usage by the builders.
+func (m *FriendshipMutation) FriendsIDs() (ids []uuid.UUID) {
+	if id := m.friends; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFriends resets all changes to the "friends" edge.
+func (m *FriendshipMutation) ResetFriends() {
+	m.friends = nil
+	m.clearedfriends = false
+}
+
+// Where appends a list predicates to the FriendshipMutation builder.
+func (m *FriendshipMutation) Where(ps ...predicate.Friendship) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FriendshipMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FriendshipMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Friendship, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FriendshipMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FriendshipMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Friendship).
+func (m *FriendshipMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FriendshipMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.users != nil {
+		fields = append(fields, friendship.FieldUserID)
+	}
+	if m.friends != nil {
+		fields = append(fields, friendship.FieldFriendID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, friendship.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FriendshipMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case friendship.FieldUserID:
+		return m.UserID()
+	case friendship.FieldFriendID:
+		return m.FriendID()
+	case friendship.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FriendshipMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case friendship.FieldUserID:
+		return m.OldUserID(ctx)
+	case friendship.FieldFriendID:
+		return m.OldFriendID(ctx)
+	case friendship.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Friendship field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FriendshipMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case friendship.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case friendship.FieldFriendID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFriendID(v)
+		return nil
+	case friendship.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Friendship field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FriendshipMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FriendshipMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FriendshipMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Friendship numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FriendshipMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FriendshipMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FriendshipMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Friendship nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FriendshipMutation) ResetField(name string) error {
+	switch name {
+	case friendship.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case friendship.FieldFriendID:
+		m.ResetFriendID()
+		return nil
+	case friendship.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Friendship field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FriendshipMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.users != nil {
+		edges = append(edges, friendship.EdgeUsers)
+	}
+	if m.friends != nil {
+		edges = append(edges, friendship.EdgeFriends)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FriendshipMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case friendship.EdgeUsers:
+		if id := m.users; id != nil {
+			return []ent.Value{*id}
+		}
+	case friendship.EdgeFriends:
+		if id := m.friends; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FriendshipMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FriendshipMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FriendshipMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedusers {
+		edges = append(edges, friendship.EdgeUsers)
+	}
+	if m.clearedfriends {
+		edges = append(edges, friendship.EdgeFriends)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FriendshipMutation) EdgeCleared(name string) bool {
+	switch name {
+	case friendship.EdgeUsers:
+		return m.clearedusers
+	case friendship.EdgeFriends:
+		return m.clearedfriends
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FriendshipMutation) ClearEdge(name string) error {
+	switch name {
+	case friendship.EdgeUsers:
+		m.ClearUsers()
+		return nil
+	case friendship.EdgeFriends:
+		m.ClearFriends()
+		return nil
+	}
+	return fmt.Errorf("unknown Friendship unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FriendshipMutation) ResetEdge(name string) error {
+	switch name {
+	case friendship.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	case friendship.EdgeFriends:
+		m.ResetFriends()
+		return nil
+	}
+	return fmt.Errorf("unknown Friendship edge %s", name)
+}
+
+// GoalMutation represents an operation that mutates the Goal nodes in the graph.
+type GoalMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	user_id       *uuid.UUID
+	metric        *goal.Metric
+	period        *goal.Period
+	target        *float64
+	addtarget     *float64
+	progress      *float64
+	addprogress   *float64
+	period_start  *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Goal, error)
+	predicates    []predicate.Goal
+}
+
+var _ ent.Mutation = (*GoalMutation)(nil)
+
+// goalOption allows management of the mutation configuration using functional options.
+type goalOption func(*GoalMutation)
+
+// newGoalMutation creates new mutation for the Goal entity.
+func newGoalMutation(c config, op Op, opts ...goalOption) *GoalMutation {
+	m := &GoalMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGoal,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGoalID sets the ID field of the mutation.
+func withGoalID(id uuid.UUID) goalOption {
+	return func(m *GoalMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Goal
+		)
+		m.oldValue = func(ctx context.Context) (*Goal, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Goal.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGoal sets the old Goal of the mutation.
+func withGoal(node *Goal) goalOption {
+	return func(m *GoalMutation) {
+		m.oldValue = func(context.Context) (*Goal, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GoalMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GoalMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Goal entities.
+func (m *GoalMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GoalMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GoalMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Goal.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *GoalMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *GoalMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *GoalMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetMetric sets the "metric" field.
+func (m *GoalMutation) SetMetric(_go goal.Metric) {
+	m.metric = &_go
+}
+
+// Metric returns the value of the "metric" field in the mutation.
+func (m *GoalMutation) Metric() (r goal.Metric, exists bool) {
+	v := m.metric
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetric returns the old "metric" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldMetric(ctx context.Context) (v goal.Metric, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetric is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetric requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetric: %w", err)
+	}
+	return oldValue.Metric, nil
+}
+
+// ResetMetric resets all changes to the "metric" field.
+func (m *GoalMutation) ResetMetric() {
+	m.metric = nil
+}
+
+// SetPeriod sets the "period" field.
+func (m *GoalMutation) SetPeriod(_go goal.Period) {
+	m.period = &_go
+}
+
+// Period returns the value of the "period" field in the mutation.
+func (m *GoalMutation) Period() (r goal.Period, exists bool) {
+	v := m.period
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeriod returns the old "period" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldPeriod(ctx context.Context) (v goal.Period, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeriod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeriod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeriod: %w", err)
+	}
+	return oldValue.Period, nil
+}
+
+// ResetPeriod resets all changes to the "period" field.
+func (m *GoalMutation) ResetPeriod() {
+	m.period = nil
+}
+
+// SetTarget sets the "target" field.
+func (m *GoalMutation) SetTarget(f float64) {
+	m.target = &f
+	m.addtarget = nil
+}
+
+// Target returns the value of the "target" field in the mutation.
+func (m *GoalMutation) Target() (r float64, exists bool) {
+	v := m.target
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTarget returns the old "target" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldTarget(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTarget is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTarget requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTarget: %w", err)
+	}
+	return oldValue.Target, nil
+}
+
+// AddTarget adds f to the "target" field.
+func (m *GoalMutation) AddTarget(f float64) {
+	if m.addtarget != nil {
+		*m.addtarget += f
+	} else {
+		m.addtarget = &f
+	}
+}
+
+// AddedTarget returns the value that was added to the "target" field in this mutation.
+func (m *GoalMutation) AddedTarget() (r float64, exists bool) {
+	v := m.addtarget
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTarget resets all changes to the "target" field.
+func (m *GoalMutation) ResetTarget() {
+	m.target = nil
+	m.addtarget = nil
+}
+
+// SetProgress sets the "progress" field.
+func (m *GoalMutation) SetProgress(f float64) {
+	m.progress = &f
+	m.addprogress = nil
+}
+
+// Progress returns the value of the "progress" field in the mutation.
+func (m *GoalMutation) Progress() (r float64, exists bool) {
+	v := m.progress
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProgress returns the old "progress" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldProgress(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProgress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProgress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProgress: %w", err)
+	}
+	return oldValue.Progress, nil
+}
+
+// AddProgress adds f to the "progress" field.
+func (m *GoalMutation) AddProgress(f float64) {
+	if m.addprogress != nil {
+		*m.addprogress += f
+	} else {
+		m.addprogress = &f
+	}
+}
+
+// AddedProgress returns the value that was added to the "progress" field in this mutation.
+func (m *GoalMutation) AddedProgress() (r float64, exists bool) {
+	v := m.addprogress
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetProgress resets all changes to the "progress" field.
+func (m *GoalMutation) ResetProgress() {
+	m.progress = nil
+	m.addprogress = nil
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (m *GoalMutation) SetPeriodStart(t time.Time) {
+	m.period_start = &t
+}
+
+// PeriodStart returns the value of the "period_start" field in the mutation.
+func (m *GoalMutation) PeriodStart() (r time.Time, exists bool) {
+	v := m.period_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeriodStart returns the old "period_start" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldPeriodStart(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeriodStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeriodStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeriodStart: %w", err)
+	}
+	return oldValue.PeriodStart, nil
+}
+
+// ResetPeriodStart resets all changes to the "period_start" field.
+func (m *GoalMutation) ResetPeriodStart() {
+	m.period_start = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GoalMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GoalMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GoalMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the GoalMutation builder.
+func (m *GoalMutation) Where(ps ...predicate.Goal) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GoalMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GoalMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Goal, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GoalMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GoalMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Goal).
+func (m *GoalMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GoalMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.user_id != nil {
+		fields = append(fields, goal.FieldUserID)
+	}
+	if m.metric != nil {
+		fields = append(fields, goal.FieldMetric)
+	}
+	if m.period != nil {
+		fields = append(fields, goal.FieldPeriod)
+	}
+	if m.target != nil {
+		fields = append(fields, goal.FieldTarget)
+	}
+	if m.progress != nil {
+		fields = append(fields, goal.FieldProgress)
+	}
+	if m.period_start != nil {
+		fields = append(fields, goal.FieldPeriodStart)
+	}
+	if m.created_at != nil {
+		fields = append(fields, goal.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GoalMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case goal.FieldUserID:
+		return m.UserID()
+	case goal.FieldMetric:
+		return m.Metric()
+	case goal.FieldPeriod:
+		return m.Period()
+	case goal.FieldTarget:
+		return m.Target()
+	case goal.FieldProgress:
+		return m.Progress()
+	case goal.FieldPeriodStart:
+		return m.PeriodStart()
+	case goal.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GoalMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case goal.FieldUserID:
+		return m.OldUserID(ctx)
+	case goal.FieldMetric:
+		return m.OldMetric(ctx)
+	case goal.FieldPeriod:
+		return m.OldPeriod(ctx)
+	case goal.FieldTarget:
+		return m.OldTarget(ctx)
+	case goal.FieldProgress:
+		return m.OldProgress(ctx)
+	case goal.FieldPeriodStart:
+		return m.OldPeriodStart(ctx)
+	case goal.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Goal field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GoalMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case goal.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case goal.FieldMetric:
+		v, ok := value.(goal.Metric)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetric(v)
+		return nil
+	case goal.FieldPeriod:
+		v, ok := value.(goal.Period)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeriod(v)
+		return nil
+	case goal.FieldTarget:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTarget(v)
+		return nil
+	case goal.FieldProgress:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProgress(v)
+		return nil
+	case goal.FieldPeriodStart:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeriodStart(v)
+		return nil
+	case goal.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Goal field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GoalMutation) AddedFields() []string {
+	var fields []string
+	if m.addtarget != nil {
+		fields = append(fields, goal.FieldTarget)
+	}
+	if m.addprogress != nil {
+		fields = append(fields, goal.FieldProgress)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GoalMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case goal.FieldTarget:
+		return m.AddedTarget()
+	case goal.FieldProgress:
+		return m.AddedProgress()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GoalMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case goal.FieldTarget:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTarget(v)
+		return nil
+	case goal.FieldProgress:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProgress(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Goal numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GoalMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GoalMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GoalMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Goal nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GoalMutation) ResetField(name string) error {
+	switch name {
+	case goal.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case goal.FieldMetric:
+		m.ResetMetric()
+		return nil
+	case goal.FieldPeriod:
+		m.ResetPeriod()
+		return nil
+	case goal.FieldTarget:
+		m.ResetTarget()
+		return nil
+	case goal.FieldProgress:
+		m.ResetProgress()
+		return nil
+	case goal.FieldPeriodStart:
+		m.ResetPeriodStart()
+		return nil
+	case goal.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Goal field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GoalMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GoalMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GoalMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GoalMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GoalMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GoalMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GoalMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Goal unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GoalMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Goal edge %s", name)
+}
+
+// HexMutation represents an operation that mutates the Hex nodes in the graph.
+type HexMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *string
+	clearedFields          map[string]struct{}
+	hexinfluences          map[uuid.UUID]struct{}
+	removedhexinfluences   map[uuid.UUID]struct{}
+	clearedhexinfluences   bool
+	hexleaderboards        map[uuid.UUID]struct{}
+	removedhexleaderboards map[uuid.UUID]struct{}
+	clearedhexleaderboards bool
+	done                   bool
+	oldValue               func(context.Context) (*Hex, error)
+	predicates             []predicate.Hex
+}
+
+var _ ent.Mutation = (*HexMutation)(nil)
+
+// hexOption allows management of the mutation configuration using functional options.
+type hexOption func(*HexMutation)
+
+// newHexMutation creates new mutation for the Hex entity.
+func newHexMutation(c config, op Op, opts ...hexOption) *HexMutation {
+	m := &HexMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHex,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHexID sets the ID field of the mutation.
+func withHexID(id string) hexOption {
+	return func(m *HexMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Hex
+		)
+		m.oldValue = func(ctx context.Context) (*Hex, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Hex.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHex sets the old Hex of the mutation.
+func withHex(node *Hex) hexOption {
+	return func(m *HexMutation) {
+		m.oldValue = func(context.Context) (*Hex, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HexMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HexMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Hex entities.
+func (m *HexMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HexMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HexMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Hex.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// AddHexinfluenceIDs adds the "hexinfluences" edge to the HexInfluence entity by ids.
+func (m *HexMutation) AddHexinfluenceIDs(ids ...uuid.UUID) {
+	if m.hexinfluences == nil {
+		m.hexinfluences = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.hexinfluences[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHexinfluences clears the "hexinfluences" edge to the HexInfluence entity.
+func (m *HexMutation) ClearHexinfluences() {
+	m.clearedhexinfluences = true
+}
+
+// HexinfluencesCleared reports if the "hexinfluences" edge to the HexInfluence entity was cleared.
+func (m *HexMutation) HexinfluencesCleared() bool {
+	return m.clearedhexinfluences
+}
+
+// RemoveHexinfluenceIDs removes the "hexinfluences" edge to the HexInfluence entity by IDs.
+func (m *HexMutation) RemoveHexinfluenceIDs(ids ...uuid.UUID) {
+	if m.removedhexinfluences == nil {
+		m.removedhexinfluences = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.hexinfluences, ids[i])
+		m.removedhexinfluences[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHexinfluences returns the removed IDs of the "hexinfluences" edge to the HexInfluence entity.
+func (m *HexMutation) RemovedHexinfluencesIDs() (ids []uuid.UUID) {
+	for id := range m.removedhexinfluences {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HexinfluencesIDs returns the "hexinfluences" edge IDs in the mutation.
+func (m *HexMutation) HexinfluencesIDs() (ids []uuid.UUID) {
+	for id := range m.hexinfluences {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHexinfluences resets all changes to the "hexinfluences" edge.
+func (m *HexMutation) ResetHexinfluences() {
+	m.hexinfluences = nil
+	m.clearedhexinfluences = false
+	m.removedhexinfluences = nil
+}
+
+// AddHexleaderboardIDs adds the "hexleaderboards" edge to the HexLeaderboard entity by ids.
+func (m *HexMutation) AddHexleaderboardIDs(ids ...uuid.UUID) {
+	if m.hexleaderboards == nil {
+		m.hexleaderboards = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.hexleaderboards[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHexleaderboards clears the "hexleaderboards" edge to the HexLeaderboard entity.
+func (m *HexMutation) ClearHexleaderboards() {
+	m.clearedhexleaderboards = true
+}
+
+// HexleaderboardsCleared reports if the "hexleaderboards" edge to the HexLeaderboard entity was cleared.
+func (m *HexMutation) HexleaderboardsCleared() bool {
+	return m.clearedhexleaderboards
+}
+
+// RemoveHexleaderboardIDs removes the "hexleaderboards" edge to the HexLeaderboard entity by IDs.
+func (m *HexMutation) RemoveHexleaderboardIDs(ids ...uuid.UUID) {
+	if m.removedhexleaderboards == nil {
+		m.removedhexleaderboards = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.hexleaderboards, ids[i])
+		m.removedhexleaderboards[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHexleaderboards returns the removed IDs of the "hexleaderboards" edge to the HexLeaderboard entity.
+func (m *HexMutation) RemovedHexleaderboardsIDs() (ids []uuid.UUID) {
+	for id := range m.removedhexleaderboards {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HexleaderboardsIDs returns the "hexleaderboards" edge IDs in the mutation.
+func (m *HexMutation) HexleaderboardsIDs() (ids []uuid.UUID) {
+	for id := range m.hexleaderboards {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHexleaderboards resets all changes to the "hexleaderboards" edge.
+func (m *HexMutation) ResetHexleaderboards() {
+	m.hexleaderboards = nil
+	m.clearedhexleaderboards = false
+	m.removedhexleaderboards = nil
+}
+
+// Where appends a list predicates to the HexMutation builder.
+func (m *HexMutation) Where(ps ...predicate.Hex) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HexMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HexMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Hex, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HexMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HexMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Hex).
+func (m *HexMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HexMutation) Fields() []string {
+	fields := make([]string, 0, 0)
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HexMutation) Field(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HexMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	return nil, fmt.Errorf("unknown Hex field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HexMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Hex field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HexMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HexMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HexMutation) AddField(name string, value ent.Value) error {
+	return fmt.Errorf("unknown Hex numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HexMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HexMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HexMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Hex nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HexMutation) ResetField(name string) error {
+	return fmt.Errorf("unknown Hex field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HexMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.hexinfluences != nil {
+		edges = append(edges, hex.EdgeHexinfluences)
+	}
+	if m.hexleaderboards != nil {
+		edges = append(edges, hex.EdgeHexleaderboards)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HexMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case hex.EdgeHexinfluences:
+		ids := make([]ent.Value, 0, len(m.hexinfluences))
+		for id := range m.hexinfluences {
+			ids = append(ids, id)
+		}
+		return ids
+	case hex.EdgeHexleaderboards:
+		ids := make([]ent.Value, 0, len(m.hexleaderboards))
+		for id := range m.hexleaderboards {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HexMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedhexinfluences != nil {
+		edges = append(edges, hex.EdgeHexinfluences)
+	}
+	if m.removedhexleaderboards != nil {
+		edges = append(edges, hex.EdgeHexleaderboards)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HexMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case hex.EdgeHexinfluences:
+		ids := make([]ent.Value, 0, len(m.removedhexinfluences))
+		for id := range m.removedhexinfluences {
+			ids = append(ids, id)
+		}
+		return ids
+	case hex.EdgeHexleaderboards:
+		ids := make([]ent.Value, 0, len(m.removedhexleaderboards))
+		for id := range m.removedhexleaderboards {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HexMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedhexinfluences {
+		edges = append(edges, hex.EdgeHexinfluences)
+	}
+	if m.clearedhexleaderboards {
+		edges = append(edges, hex.EdgeHexleaderboards)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HexMutation) EdgeCleared(name string) bool {
+	switch name {
+	case hex.EdgeHexinfluences:
+		return m.clearedhexinfluences
+	case hex.EdgeHexleaderboards:
+		return m.clearedhexleaderboards
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HexMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Hex unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HexMutation) ResetEdge(name string) error {
+	switch name {
+	case hex.EdgeHexinfluences:
+		m.ResetHexinfluences()
+		return nil
+	case hex.EdgeHexleaderboards:
+		m.ResetHexleaderboards()
+		return nil
+	}
+	return fmt.Errorf("unknown Hex edge %s", name)
+}
+
+// HexCaptureMutation represents an operation that mutates the HexCapture nodes in the graph.
+type HexCaptureMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	h3_index          *string
+	previous_owner_id *uuid.UUID
+	new_owner_id      *uuid.UUID
+	previous_score    *float64
+	addprevious_score *float64
+	new_score         *float64
+	addnew_score      *float64
+	activity_id       *uuid.UUID
+	captured_at       *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*HexCapture, error)
+	predicates        []predicate.HexCapture
+}
+
+var _ ent.Mutation = (*HexCaptureMutation)(nil)
+
+// hexcaptureOption allows management of the mutation configuration using functional options.
+type hexcaptureOption func(*HexCaptureMutation)
+
+// newHexCaptureMutation creates new mutation for the HexCapture entity.
+func newHexCaptureMutation(c config, op Op, opts ...hexcaptureOption) *HexCaptureMutation {
+	m := &HexCaptureMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHexCapture,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHexCaptureID sets the ID field of the mutation.
+func withHexCaptureID(id uuid.UUID) hexcaptureOption {
+	return func(m *HexCaptureMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *HexCapture
+		)
+		m.oldValue = func(ctx context.Context) (*HexCapture, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().HexCapture.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHexCapture sets the old HexCapture of the mutation.
+func withHexCapture(node *HexCapture) hexcaptureOption {
+	return func(m *HexCaptureMutation) {
+		m.oldValue = func(context.Context) (*HexCapture, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HexCaptureMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HexCaptureMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of HexCapture entities.
+func (m *HexCaptureMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HexCaptureMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HexCaptureMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().HexCapture.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetH3Index sets the "h3_index" field.
+func (m *HexCaptureMutation) SetH3Index(s string) {
+	m.h3_index = &s
+}
+
+// H3Index returns the value of the "h3_index" field in the mutation.
+func (m *HexCaptureMutation) H3Index() (r string, exists bool) {
+	v := m.h3_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldH3Index returns the old "h3_index" field's value of the HexCapture entity.
+// If the HexCapture object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HexCaptureMutation) OldH3Index(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldH3Index is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldH3Index requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldH3Index: %w", err)
+	}
+	return oldValue.H3Index, nil
+}
+
+// ResetH3Index resets all changes to the "h3_index" field.
+func (m *HexCaptureMutation) ResetH3Index() {
+	m.h3_index = nil
+}
+
+// SetPreviousOwnerID sets the "previous_owner_id" field.
+func (m *HexCaptureMutation) SetPreviousOwnerID(u uuid.UUID) {
+	m.previous_owner_id = &u
+}
+
+// PreviousOwnerID returns the value of the "previous_owner_id" field in the mutation.
+func (m *HexCaptureMutation) PreviousOwnerID() (r uuid.UUID, exists bool) {
+	v := m.previous_owner_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousOwnerID returns the old "previous_owner_id" field's value of the HexCapture entity.
+// If the HexCapture object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HexCaptureMutation) OldPreviousOwnerID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousOwnerID: %w", err)
+	}
+	return oldValue.PreviousOwnerID, nil
+}
+
+// ClearPreviousOwnerID clears the value of the "previous_owner_id" field.
+func (m *HexCaptureMutation) ClearPreviousOwnerID() {
+	m.previous_owner_id = nil
+	m.clearedFields[hexcapture.FieldPreviousOwnerID] = struct{}{}
+}
+
+// PreviousOwnerIDCleared returns if the "previous_owner_id" field was cleared in this mutation.
+func (m *HexCaptureMutation) PreviousOwnerIDCleared() bool {
+	_, ok := m.clearedFields[hexcapture.FieldPreviousOwnerID]
+	return ok
+}
+
+// ResetPreviousOwnerID resets all changes to the "previous_owner_id" field.
+func (m *HexCaptureMutation) ResetPreviousOwnerID() {
+	m.previous_owner_id = nil
+	delete(m.clearedFields, hexcapture.FieldPreviousOwnerID)
+}
+
+// SetNewOwnerID sets the "new_owner_id" field.
+func (m *HexCaptureMutation) SetNewOwnerID(u uuid.UUID) {
+	m.new_owner_id = &u
+}
+
+// NewOwnerID returns the value of the "new_owner_id" field in the mutation.
+func (m *HexCaptureMutation) NewOwnerID() (r uuid.UUID, exists bool) {
+	v := m.new_owner_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNewOwnerID returns the old "new_owner_id" field's value of the HexCapture entity.
+// If the HexCapture object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HexCaptureMutation) OldNewOwnerID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNewOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNewOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNewOwnerID: %w", err)
+	}
+	return oldValue.NewOwnerID, nil
+}
+
+// ResetNewOwnerID resets all changes to the "new_owner_id" field.
+func (m *HexCaptureMutation) ResetNewOwnerID() {
+	m.new_owner_id = nil
+}
+
+// SetPreviousScore sets the "previous_score" field.
+func (m *HexCaptureMutation) SetPreviousScore(f float64) {
+	m.previous_score = &f
+	m.addprevious_score = nil
+}
+
+// PreviousScore returns the value of the "previous_score" field in the mutation.
+func (m *HexCaptureMutation) PreviousScore() (r float64, exists bool) {
+	v := m.previous_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousScore returns the old "previous_score" field's value of the HexCapture entity.
+// If the HexCapture object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HexCaptureMutation) OldPreviousScore(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousScore: %w", err)
+	}
+	return oldValue.PreviousScore, nil
+}
+
+// AddPreviousScore adds f to the "previous_score" field.
+func (m *HexCaptureMutation) AddPreviousScore(f float64) {
+	if m.addprevious_score != nil {
+		*m.addprevious_score += f
+	} else {
+		m.addprevious_score = &f
+	}
+}
+
+// AddedPreviousScore returns the value that was added to the "previous_score" field in this mutation.
+func (m *HexCaptureMutation) AddedPreviousScore() (r float64, exists bool) {
+	v := m.addprevious_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPreviousScore resets all changes to the "previous_score" field.
+func (m *HexCaptureMutation) ResetPreviousScore() {
+	m.previous_score = nil
+	m.addprevious_score = nil
+}
+
+// SetNewScore sets the "new_score" field.
+func (m *HexCaptureMutation) SetNewScore(f float64) {
+	m.new_score = &f
+	m.addnew_score = nil
+}
+
+// NewScore returns the value of the "new_score" field in the mutation.
+func (m *HexCaptureMutation) NewScore() (r float64, exists bool) {
+	v := m.new_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNewScore returns the old "new_score" field's value of the HexCapture entity.
+// If the HexCapture object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HexCaptureMutation) OldNewScore(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNewScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNewScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNewScore: %w", err)
+	}
+	return oldValue.NewScore, nil
+}
+
+// AddNewScore adds f to the "new_score" field.
+func (m *HexCaptureMutation) AddNewScore(f float64) {
+	if m.addnew_score != nil {
+		*m.addnew_score += f
+	} else {
+		m.addnew_score = &f
+	}
+}
+
+// AddedNewScore returns the value that was added to the "new_score" field in this mutation.
+func (m *HexCaptureMutation) AddedNewScore() (r float64, exists bool) {
+	v := m.addnew_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNewScore resets all changes to the "new_score" field.
+func (m *HexCaptureMutation) ResetNewScore() {
+	m.new_score = nil
+	m.addnew_score = nil
+}
+
+// SetActivityID sets the "activity_id" field.
+func (m *HexCaptureMutation) SetActivityID(u uuid.UUID) {
+	m.activity_id = &u
+}
+
+// ActivityID returns the value of the "activity_id" field in the mutation.
+func (m *HexCaptureMutation) ActivityID() (r uuid.UUID, exists bool) {
+	v := m.activity_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActivityID returns the old "activity_id" field's value of the HexCapture entity.
+// If the HexCapture object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HexCaptureMutation) OldActivityID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActivityID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActivityID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActivityID: %w", err)
+	}
+	return oldValue.ActivityID, nil
+}
+
+// ClearActivityID clears the value of the "activity_id" field.
+func (m *HexCaptureMutation) ClearActivityID() {
+	m.activity_id = nil
+	m.clearedFields[hexcapture.FieldActivityID] = struct{}{}
+}
+
+// ActivityIDCleared returns if the "activity_id" field was cleared in this mutation.
+func (m *HexCaptureMutation) ActivityIDCleared() bool {
+	_, ok := m.clearedFields[hexcapture.FieldActivityID]
+	return ok
+}
+
+// ResetActivityID resets all changes to the "activity_id" field.
+func (m *HexCaptureMutation) ResetActivityID() {
+	m.activity_id = nil
+	delete(m.clearedFields, hexcapture.FieldActivityID)
+}
+
+// SetCapturedAt sets the "captured_at" field.
+func (m *HexCaptureMutation) SetCapturedAt(t time.Time) {
+	m.captured_at = &t
+}
+
+// CapturedAt returns the value of the "captured_at" field in the mutation.
+func (m *HexCaptureMutation) CapturedAt() (r time.Time, exists bool) {
+	v := m.captured_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCapturedAt returns the old "captured_at" field's value of the HexCapture entity.
+// If the HexCapture object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HexCaptureMutation) OldCapturedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCapturedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCapturedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCapturedAt: %w", err)
+	}
+	return oldValue.CapturedAt, nil
+}
+
+// ResetCapturedAt resets all changes to the "captured_at" field.
+func (m *HexCaptureMutation) ResetCapturedAt() {
+	m.captured_at = nil
+}
+
+// Where appends a list predicates to the HexCaptureMutation builder.
+func (m *HexCaptureMutation) Where(ps ...predicate.HexCapture) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HexCaptureMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HexCaptureMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.HexCapture, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HexCaptureMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HexCaptureMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (HexCapture).
+func (m *HexCaptureMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HexCaptureMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.h3_index != nil {
+		fields = append(fields, hexcapture.FieldH3Index)
+	}
+	if m.previous_owner_id != nil {
+		fields = append(fields, hexcapture.FieldPreviousOwnerID)
+	}
+	if m.new_owner_id != nil {
+		fields = append(fields, hexcapture.FieldNewOwnerID)
+	}
+	if m.previous_score != nil {
+		fields = append(fields, hexcapture.FieldPreviousScore)
+	}
+	if m.new_score != nil {
+		fields = append(fields, hexcapture.FieldNewScore)
+	}
+	if m.activity_id != nil {
+		fields = append(fields, hexcapture.FieldActivityID)
+	}
+	if m.captured_at != nil {
+		fields = append(fields, hexcapture.FieldCapturedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HexCaptureMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case hexcapture.FieldH3Index:
+		return m.H3Index()
+	case hexcapture.FieldPreviousOwnerID:
+		return m.PreviousOwnerID()
+	case hexcapture.FieldNewOwnerID:
+		return m.NewOwnerID()
+	case hexcapture.FieldPreviousScore:
+		return m.PreviousScore()
+	case hexcapture.FieldNewScore:
+		return m.NewScore()
+	case hexcapture.FieldActivityID:
+		return m.ActivityID()
+	case hexcapture.FieldCapturedAt:
+		return m.CapturedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HexCaptureMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case hexcapture.FieldH3Index:
+		return m.OldH3Index(ctx)
+	case hexcapture.FieldPreviousOwnerID:
+		return m.OldPreviousOwnerID(ctx)
+	case hexcapture.FieldNewOwnerID:
+		return m.OldNewOwnerID(ctx)
+	case hexcapture.FieldPreviousScore:
+		return m.OldPreviousScore(ctx)
+	case hexcapture.FieldNewScore:
+		return m.OldNewScore(ctx)
+	case hexcapture.FieldActivityID:
+		return m.OldActivityID(ctx)
+	case hexcapture.FieldCapturedAt:
+		return m.OldCapturedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown HexCapture field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HexCaptureMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case hexcapture.FieldH3Index:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetH3Index(v)
+		return nil
+	case hexcapture.FieldPreviousOwnerID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousOwnerID(v)
+		return nil
+	case hexcapture.FieldNewOwnerID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNewOwnerID(v)
+		return nil
+	case hexcapture.FieldPreviousScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousScore(v)
+		return nil
+	case hexcapture.FieldNewScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNewScore(v)
+		return nil
+	case hexcapture.FieldActivityID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActivityID(v)
+		return nil
+	case hexcapture.FieldCapturedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCapturedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HexCapture field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HexCaptureMutation) AddedFields() []string {
+	var fields []string
+	if m.addprevious_score != nil {
+		fields = append(fields, hexcapture.FieldPreviousScore)
+	}
+	if m.addnew_score != nil {
+		fields = append(fields, hexcapture.FieldNewScore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HexCaptureMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case hexcapture.FieldPreviousScore:
+		return m.AddedPreviousScore()
+	case hexcapture.FieldNewScore:
+		return m.AddedNewScore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HexCaptureMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case hexcapture.FieldPreviousScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPreviousScore(v)
+		return nil
+	case hexcapture.FieldNewScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNewScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HexCapture numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HexCaptureMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(hexcapture.FieldPreviousOwnerID) {
+		fields = append(fields, hexcapture.FieldPreviousOwnerID)
+	}
+	if m.FieldCleared(hexcapture.FieldActivityID) {
+		fields = append(fields, hexcapture.FieldActivityID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HexCaptureMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HexCaptureMutation) ClearField(name string) error {
+	switch name {
+	case hexcapture.FieldPreviousOwnerID:
+		m.ClearPreviousOwnerID()
+		return nil
+	case hexcapture.FieldActivityID:
+		m.ClearActivityID()
+		return nil
+	}
+	return fmt.Errorf("unknown HexCapture nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HexCaptureMutation) ResetField(name string) error {
+	switch name {
+	case hexcapture.FieldH3Index:
+		m.ResetH3Index()
+		return nil
+	case hexcapture.FieldPreviousOwnerID:
+		m.ResetPreviousOwnerID()
+		return nil
+	case hexcapture.FieldNewOwnerID:
+		m.ResetNewOwnerID()
+		return nil
+	case hexcapture.FieldPreviousScore:
+		m.ResetPreviousScore()
+		return nil
+	case hexcapture.FieldNewScore:
+		m.ResetNewScore()
+		return nil
+	case hexcapture.FieldActivityID:
+		m.ResetActivityID()
+		return nil
+	case hexcapture.FieldCapturedAt:
+		m.ResetCapturedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown HexCapture field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HexCaptureMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HexCaptureMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HexCaptureMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HexCaptureMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HexCaptureMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HexCaptureMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HexCaptureMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown HexCapture unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HexCaptureMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown HexCapture edge %s", name)
+}
+
+// HexInfluenceMutation represents an operation that mutates the HexInfluence nodes in the graph.
+type HexInfluenceMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	score            *float64
+	addscore         *float64
+	last_updated     *time.Time
+	carried_score    *float64
+	addcarried_score *float64
+	carried_at       *time.Time
+	clearedFields    map[string]struct{}
+	hex              *string
+	clearedhex       bool
+	users            *uuid.UUID
+	clearedusers     bool
+	done             bool
+	oldValue         func(context.Context) (*HexInfluence, error)
+	predicates       []predicate.HexInfluence
+}
+
+var _ ent.Mutation = (*HexInfluenceMutation)(nil)
+
+// hexinfluenceOption allows management of the mutation configuration using functional options.
+type hexinfluenceOption func(*HexInfluenceMutation)
+
+// newHexInfluenceMutation creates new mutation for the HexInfluence entity.
+func newHexInfluenceMutation(c config, op Op, opts ...hexinfluenceOption) *HexInfluenceMutation {
+	m := &HexInfluenceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHexInfluence,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHexInfluenceID sets the ID field of the mutation.
+func withHexInfluenceID(id uuid.UUID) hexinfluenceOption {
+	return func(m *HexInfluenceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *HexInfluence
+		)
+		m.oldValue = func(ctx context.Context) (*HexInfluence, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().HexInfluence.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHexInfluence sets the old HexInfluence of the mutation.
+func withHexInfluence(node *HexInfluence) hexinfluenceOption {
+	return func(m *HexInfluenceMutation) {
+		m.oldValue = func(context.Context) (*HexInfluence, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HexInfluenceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HexInfluenceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of HexInfluence entities.
+func (m *HexInfluenceMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HexInfluenceMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HexInfluenceMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().HexInfluence.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetH3Index sets the "h3_index" field.
+func (m *HexInfluenceMutation) SetH3Index(s string) {
+	m.hex = &s
+}
+
+// H3Index returns the value of the "h3_index" field in the mutation.
+func (m *HexInfluenceMutation) H3Index() (r string, exists bool) {
+	v := m.hex
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldH3Index returns the old "h3_index" field's value of the HexInfluence entity.
+// If the HexInfluence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HexInfluenceMutation) OldH3Index(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldH3Index is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldH3Index requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldH3Index: %w", err)
+	}
+	return oldValue.H3Index, nil
+}
+
+// ResetH3Index resets all changes to the "h3_index" field.
+func (m *HexInfluenceMutation) ResetH3Index() {
+	m.hex = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *HexInfluenceMutation) SetUserID(u uuid.UUID) {
+	m.users = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *HexInfluenceMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.users
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the HexInfluence entity.
+// If the HexInfluence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HexInfluenceMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *HexInfluenceMutation) ResetUserID() {
+	m.users = nil
+}
+
+// SetScore sets the "score" field.
+func (m *HexInfluenceMutation) SetScore(f float64) {
+	m.score = &f
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *HexInfluenceMutation) Score() (r float64, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the HexInfluence entity.
+// If the HexInfluence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HexInfluenceMutation) OldScore(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds f to the "score" field.
+func (m *HexInfluenceMutation) AddScore(f float64) {
+	if m.addscore != nil {
+		*m.addscore += f
+	} else {
+		m.addscore = &f
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *HexInfluenceMutation) AddedScore() (r float64, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *HexInfluenceMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+}
+
+// SetLastUpdated sets the "last_updated" field.
+func (m *HexInfluenceMutation) SetLastUpdated(t time.Time) {
+	m.last_updated = &t
+}
+
+// LastUpdated returns the value of the "last_updated" field in the mutation.
+func (m *HexInfluenceMutation) LastUpdated() (r time.Time, exists bool) {
+	v := m.last_updated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUpdated returns the old "last_updated" field's value of the HexInfluence entity.
+// If the HexInfluence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HexInfluenceMutation) OldLastUpdated(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUpdated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUpdated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUpdated: %w", err)
+	}
+	return oldValue.LastUpdated, nil
+}
+
+// ResetLastUpdated resets all changes to the "last_updated" field.
+func (m *HexInfluenceMutation) ResetLastUpdated() {
+	m.last_updated = nil
+}
+
+// SetCarriedScore sets the "carried_score" field.
+func (m *HexInfluenceMutation) SetCarriedScore(f float64) {
+	m.carried_score = &f
+	m.addcarried_score = nil
+}
+
+// CarriedScore returns the value of the "carried_score" field in the mutation.
+func (m *HexInfluenceMutation) CarriedScore() (r float64, exists bool) {
+	v := m.carried_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarriedScore returns the old "carried_score" field's value of the HexInfluence entity.
+// If the HexInfluence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HexInfluenceMutation) OldCarriedScore(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarriedScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarriedScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarriedScore: %w", err)
+	}
+	return oldValue.CarriedScore, nil
+}
+
+// AddCarriedScore adds f to the "carried_score" field.
+func (m *HexInfluenceMutation) AddCarriedScore(f float64) {
+	if m.addcarried_score != nil {
+		*m.addcarried_score += f
+	} else {
+		m.addcarried_score = &f
+	}
+}
+
+// AddedCarriedScore returns the value that was added to the "carried_score" field in this mutation.
+func (m *HexInfluenceMutation) AddedCarriedScore() (r float64, exists bool) {
+	v := m.addcarried_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCarriedScore resets all changes to the "carried_score" field.
+func (m *HexInfluenceMutation) ResetCarriedScore() {
+	m.carried_score = nil
+	m.addcarried_score = nil
+}
+
+// SetCarriedAt sets the "carried_at" field.
+func (m *HexInfluenceMutation) SetCarriedAt(t time.Time) {
+	m.carried_at = &t
+}
+
+// CarriedAt returns the value of the "carried_at" field in the mutation.
+func (m *HexInfluenceMutation) CarriedAt() (r time.Time, exists bool) {
+	v := m.carried_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarriedAt returns the old "carried_at" field's value of the HexInfluence entity.
+// If the HexInfluence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HexInfluenceMutation) OldCarriedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarriedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarriedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarriedAt: %w", err)
+	}
+	return oldValue.CarriedAt, nil
+}
+
+// ClearCarriedAt clears the value of the "carried_at" field.
+func (m *HexInfluenceMutation) ClearCarriedAt() {
+	m.carried_at = nil
+	m.clearedFields[hexinfluence.FieldCarriedAt] = struct{}{}
+}
+
+// CarriedAtCleared returns if the "carried_at" field was cleared in this mutation.
+func (m *HexInfluenceMutation) CarriedAtCleared() bool {
+	_, ok := m.clearedFields[hexinfluence.FieldCarriedAt]
+	return ok
+}
+
+// ResetCarriedAt resets all changes to the "carried_at" field.
+func (m *HexInfluenceMutation) ResetCarriedAt() {
+	m.carried_at = nil
+	delete(m.clearedFields, hexinfluence.FieldCarriedAt)
+}
+
+// SetHexID sets the "hex" edge to the Hex entity by id.
+func (m *HexInfluenceMutation) SetHexID(id string) {
+	m.hex = &id
+}
+
+// ClearHex clears the "hex" edge to the Hex entity.
+func (m *HexInfluenceMutation) ClearHex() {
+	m.clearedhex = true
+	m.clearedFields[hexinfluence.FieldH3Index] = struct{}{}
+}
+
+// HexCleared reports if the "hex" edge to the Hex entity was cleared.
+func (m *HexInfluenceMutation) HexCleared() bool {
+	return m.clearedhex
+}
+
+// HexID returns the "hex" edge ID in the mutation.
+func (m *HexInfluenceMutation) HexID() (id string, exists bool) {
+	if m.hex != nil {
+		return *m.hex, true
+	}
+	return
+}
+
+// HexIDs returns the "hex" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// HexID instead. It exists only for internal usage by the builders.
+func (m *HexInfluenceMutation) HexIDs() (ids []string) {
+	if id := m.hex; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetHex resets all changes to the "hex" edge.
+func (m *HexInfluenceMutation) ResetHex() {
+	m.hex = nil
+	m.clearedhex = false
+}
+
+// SetUsersID sets the "users" edge to the User entity by id.
+func (m *HexInfluenceMutation) SetUsersID(id uuid.UUID) {
+	m.users = &id
+}
+
+// ClearUsers clears the "users" edge to the User entity.
+func (m *HexInfluenceMutation) ClearUsers() {
+	m.clearedusers = true
+	m.clearedFields[hexinfluence.FieldUserID] = struct{}{}
+}
+
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *HexInfluenceMutation) UsersCleared() bool {
+	return m.clearedusers
+}
+
+// UsersID returns the "users" edge ID in the mutation.
+func (m *HexInfluenceMutation) UsersID() (id uuid.UUID, exists bool) {
+	if m.users != nil {
+		return *m.users, true
+	}
+	return
+}
+
+// UsersIDs returns the "users" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UsersID instead. It exists only for internal usage by the builders.
+func (m *HexInfluenceMutation) UsersIDs() (ids []uuid.UUID) {
+	if id := m.users; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUsers resets all changes to the "users" edge.
+func (m *HexInfluenceMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+}
+
+// Where appends a list predicates to the HexInfluenceMutation builder.
+func (m *HexInfluenceMutation) Where(ps ...predicate.HexInfluence) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HexInfluenceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HexInfluenceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.HexInfluence, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HexInfluenceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HexInfluenceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (HexInfluence).
+func (m *HexInfluenceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HexInfluenceMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.hex != nil {
+		fields = append(fields, hexinfluence.FieldH3Index)
+	}
+	if m.users != nil {
+		fields = append(fields, hexinfluence.FieldUserID)
+	}
+	if m.score != nil {
+		fields = append(fields, hexinfluence.FieldScore)
+	}
+	if m.last_updated != nil {
+		fields = append(fields, hexinfluence.FieldLastUpdated)
+	}
+	if m.carried_score != nil {
+		fields = append(fields, hexinfluence.FieldCarriedScore)
+	}
+	if m.carried_at != nil {
+		fields = append(fields, hexinfluence.FieldCarriedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HexInfluenceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case hexinfluence.FieldH3Index:
+		return m.H3Index()
+	case hexinfluence.FieldUserID:
+		return m.UserID()
+	case hexinfluence.FieldScore:
+		return m.Score()
+	case hexinfluence.FieldLastUpdated:
+		return m.LastUpdated()
+	case hexinfluence.FieldCarriedScore:
+		return m.CarriedScore()
+	case hexinfluence.FieldCarriedAt:
+		return m.CarriedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HexInfluenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case hexinfluence.FieldH3Index:
+		return m.OldH3Index(ctx)
+	case hexinfluence.FieldUserID:
+		return m.OldUserID(ctx)
+	case hexinfluence.FieldScore:
+		return m.OldScore(ctx)
+	case hexinfluence.FieldLastUpdated:
+		return m.OldLastUpdated(ctx)
+	case hexinfluence.FieldCarriedScore:
+		return m.OldCarriedScore(ctx)
+	case hexinfluence.FieldCarriedAt:
+		return m.OldCarriedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown HexInfluence field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HexInfluenceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case hexinfluence.FieldH3Index:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetH3Index(v)
+		return nil
+	case hexinfluence.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case hexinfluence.FieldScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	case hexinfluence.FieldLastUpdated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUpdated(v)
+		return nil
+	case hexinfluence.FieldCarriedScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarriedScore(v)
+		return nil
+	case hexinfluence.FieldCarriedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarriedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HexInfluence field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HexInfluenceMutation) AddedFields() []string {
+	var fields []string
+	if m.addscore != nil {
+		fields = append(fields, hexinfluence.FieldScore)
+	}
+	if m.addcarried_score != nil {
+		fields = append(fields, hexinfluence.FieldCarriedScore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HexInfluenceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case hexinfluence.FieldScore:
+		return m.AddedScore()
+	case hexinfluence.FieldCarriedScore:
+		return m.AddedCarriedScore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HexInfluenceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case hexinfluence.FieldScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	case hexinfluence.FieldCarriedScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCarriedScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HexInfluence numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HexInfluenceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(hexinfluence.FieldCarriedAt) {
+		fields = append(fields, hexinfluence.FieldCarriedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HexInfluenceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HexInfluenceMutation) ClearField(name string) error {
+	switch name {
+	case hexinfluence.FieldCarriedAt:
+		m.ClearCarriedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown HexInfluence nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HexInfluenceMutation) ResetField(name string) error {
+	switch name {
+	case hexinfluence.FieldH3Index:
+		m.ResetH3Index()
+		return nil
+	case hexinfluence.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case hexinfluence.FieldScore:
+		m.ResetScore()
+		return nil
+	case hexinfluence.FieldLastUpdated:
+		m.ResetLastUpdated()
+		return nil
+	case hexinfluence.FieldCarriedScore:
+		m.ResetCarriedScore()
+		return nil
+	case hexinfluence.FieldCarriedAt:
+		m.ResetCarriedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown HexInfluence field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HexInfluenceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.hex != nil {
+		edges = append(edges, hexinfluence.EdgeHex)
+	}
+	if m.users != nil {
+		edges = append(edges, hexinfluence.EdgeUsers)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HexInfluenceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case hexinfluence.EdgeHex:
+		if id := m.hex; id != nil {
+			return []ent.Value{*id}
+		}
+	case hexinfluence.EdgeUsers:
+		if id := m.users; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HexInfluenceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HexInfluenceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HexInfluenceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedhex {
+		edges = append(edges, hexinfluence.EdgeHex)
+	}
+	if m.clearedusers {
+		edges = append(edges, hexinfluence.EdgeUsers)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HexInfluenceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case hexinfluence.EdgeHex:
+		return m.clearedhex
+	case hexinfluence.EdgeUsers:
+		return m.clearedusers
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HexInfluenceMutation) ClearEdge(name string) error {
+	switch name {
+	case hexinfluence.EdgeHex:
+		m.ClearHex()
+		return nil
+	case hexinfluence.EdgeUsers:
+		m.ClearUsers()
+		return nil
+	}
+	return fmt.Errorf("unknown HexInfluence unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HexInfluenceMutation) ResetEdge(name string) error {
+	switch name {
+	case hexinfluence.EdgeHex:
+		m.ResetHex()
+		return nil
+	case hexinfluence.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	}
+	return fmt.Errorf("unknown HexInfluence edge %s", name)
+}
+
+// HexLeaderboardMutation represents an operation that mutates the HexLeaderboard nodes in the graph.
+type HexLeaderboardMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	top_users       *[]model.TopUser
+	appendtop_users []model.TopUser
+	clearedFields   map[string]struct{}
+	hex             *string
+	clearedhex      bool
+	done            bool
+	oldValue        func(context.Context) (*HexLeaderboard, error)
+	predicates      []predicate.HexLeaderboard
+}
+
+var _ ent.Mutation = (*HexLeaderboardMutation)(nil)
+
+// hexleaderboardOption allows management of the mutation configuration using functional options.
+type hexleaderboardOption func(*HexLeaderboardMutation)
+
+// newHexLeaderboardMutation creates new mutation for the HexLeaderboard entity.
+func newHexLeaderboardMutation(c config, op Op, opts ...hexleaderboardOption) *HexLeaderboardMutation {
+	m := &HexLeaderboardMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHexLeaderboard,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHexLeaderboardID sets the ID field of the mutation.
+func withHexLeaderboardID(id uuid.UUID) hexleaderboardOption {
+	return func(m *HexLeaderboardMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *HexLeaderboard
+		)
+		m.oldValue = func(ctx context.Context) (*HexLeaderboard, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().HexLeaderboard.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHexLeaderboard sets the old HexLeaderboard of the mutation.
+func withHexLeaderboard(node *HexLeaderboard) hexleaderboardOption {
+	return func(m *HexLeaderboardMutation) {
+		m.oldValue = func(context.Context) (*HexLeaderboard, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HexLeaderboardMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HexLeaderboardMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of HexLeaderboard entities.
+func (m *HexLeaderboardMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HexLeaderboardMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HexLeaderboardMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().HexLeaderboard.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetH3Index sets the "h3_index" field.
+func (m *HexLeaderboardMutation) SetH3Index(s string) {
+	m.hex = &s
+}
+
+// H3Index returns the value of the "h3_index" field in the mutation.
+func (m *HexLeaderboardMutation) H3Index() (r string, exists bool) {
+	v := m.hex
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldH3Index returns the old "h3_index" field's value of the HexLeaderboard entity.
+// If the HexLeaderboard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HexLeaderboardMutation) OldH3Index(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldH3Index is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldH3Index requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldH3Index: %w", err)
+	}
+	return oldValue.H3Index, nil
+}
+
+// ResetH3Index resets all changes to the "h3_index" field.
+func (m *HexLeaderboardMutation) ResetH3Index() {
+	m.hex = nil
+}
+
+// SetTopUsers sets the "top_users" field.
+func (m *HexLeaderboardMutation) SetTopUsers(mu []model.TopUser) {
+	m.top_users = &mu
+	m.appendtop_users = nil
+}
+
+// TopUsers returns the value of the "top_users" field in the mutation.
+func (m *HexLeaderboardMutation) TopUsers() (r []model.TopUser, exists bool) {
+	v := m.top_users
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTopUsers returns the old "top_users" field's value of the HexLeaderboard entity.
+// If the HexLeaderboard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HexLeaderboardMutation) OldTopUsers(ctx context.Context) (v []model.TopUser, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTopUsers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTopUsers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTopUsers: %w", err)
+	}
+	return oldValue.TopUsers, nil
+}
+
+// AppendTopUsers adds mu to the "top_users" field.
+func (m *HexLeaderboardMutation) AppendTopUsers(mu []model.TopUser) {
+	m.appendtop_users = append(m.appendtop_users, mu...)
+}
+
+// AppendedTopUsers returns the list of values that were appended to the "top_users" field in this mutation.
+func (m *HexLeaderboardMutation) AppendedTopUsers() ([]model.TopUser, bool) {
+	if len(m.appendtop_users) == 0 {
+		return nil, false
+	}
+	return m.appendtop_users, true
+}
+
+// ResetTopUsers resets all changes to the "top_users" field.
+func (m *HexLeaderboardMutation) ResetTopUsers() {
+	m.top_users = nil
+	m.appendtop_users = nil
+}
+
+// SetHexID sets the "hex" edge to the Hex entity by id.
+func (m *HexLeaderboardMutation) SetHexID(id string) {
+	m.hex = &id
+}
+
+// ClearHex clears the "hex" edge to the Hex entity.
+func (m *HexLeaderboardMutation) ClearHex() {
+	m.clearedhex = true
+	m.clearedFields[hexleaderboard.FieldH3Index] = struct{}{}
+}
+
+// HexCleared reports if the "hex" edge to the Hex entity was cleared.
+func (m *HexLeaderboardMutation) HexCleared() bool {
+	return m.clearedhex
+}
+
+// HexID returns the "hex" edge ID in the mutation.
+func (m *HexLeaderboardMutation) HexID() (id string, exists bool) {
+	if m.hex != nil {
+		return *m.hex, true
+	}
+	return
+}
+
+// HexIDs returns the "hex" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// HexID instead. It exists only for internal usage by the builders.
+func (m *HexLeaderboardMutation) HexIDs() (ids []string) {
+	if id := m.hex; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetHex resets all changes to the "hex" edge.
+func (m *HexLeaderboardMutation) ResetHex() {
+	m.hex = nil
+	m.clearedhex = false
+}
+
+// Where appends a list predicates to the HexLeaderboardMutation builder.
+func (m *HexLeaderboardMutation) Where(ps ...predicate.HexLeaderboard) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HexLeaderboardMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HexLeaderboardMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.HexLeaderboard, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HexLeaderboardMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HexLeaderboardMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (HexLeaderboard).
+func (m *HexLeaderboardMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HexLeaderboardMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.hex != nil {
+		fields = append(fields, hexleaderboard.FieldH3Index)
+	}
+	if m.top_users != nil {
+		fields = append(fields, hexleaderboard.FieldTopUsers)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HexLeaderboardMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case hexleaderboard.FieldH3Index:
+		return m.H3Index()
+	case hexleaderboard.FieldTopUsers:
+		return m.TopUsers()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HexLeaderboardMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case hexleaderboard.FieldH3Index:
+		return m.OldH3Index(ctx)
+	case hexleaderboard.FieldTopUsers:
+		return m.OldTopUsers(ctx)
+	}
+	return nil, fmt.Errorf("unknown HexLeaderboard field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HexLeaderboardMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case hexleaderboard.FieldH3Index:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetH3Index(v)
+		return nil
+	case hexleaderboard.FieldTopUsers:
+		v, ok := value.([]model.TopUser)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTopUsers(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HexLeaderboard field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HexLeaderboardMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HexLeaderboardMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HexLeaderboardMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown HexLeaderboard numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HexLeaderboardMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HexLeaderboardMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HexLeaderboardMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown HexLeaderboard nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HexLeaderboardMutation) ResetField(name string) error {
+	switch name {
+	case hexleaderboard.FieldH3Index:
+		m.ResetH3Index()
+		return nil
+	case hexleaderboard.FieldTopUsers:
+		m.ResetTopUsers()
+		return nil
+	}
+	return fmt.Errorf("unknown HexLeaderboard field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HexLeaderboardMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.hex != nil {
+		edges = append(edges, hexleaderboard.EdgeHex)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HexLeaderboardMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case hexleaderboard.EdgeHex:
+		if id := m.hex; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HexLeaderboardMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HexLeaderboardMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HexLeaderboardMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedhex {
+		edges = append(edges, hexleaderboard.EdgeHex)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HexLeaderboardMutation) EdgeCleared(name string) bool {
+	switch name {
+	case hexleaderboard.EdgeHex:
+		return m.clearedhex
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HexLeaderboardMutation) ClearEdge(name string) error {
+	switch name {
+	case hexleaderboard.EdgeHex:
+		m.ClearHex()
+		return nil
+	}
+	return fmt.Errorf("unknown HexLeaderboard unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HexLeaderboardMutation) ResetEdge(name string) error {
+	switch name {
+	case hexleaderboard.EdgeHex:
+		m.ResetHex()
+		return nil
+	}
+	return fmt.Errorf("unknown HexLeaderboard edge %s", name)
+}
+
+// HexRollupMutation represents an operation that mutates the HexRollup nodes in the graph.
+type HexRollupMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	h3_index       *string
+	resolution     *int
+	addresolution  *int
+	owners         *[]model.RollupOwner
+	appendowners   []model.RollupOwner
+	owned_hexes    *int
+	addowned_hexes *int
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*HexRollup, error)
+	predicates     []predicate.HexRollup
+}
+
+var _ ent.Mutation = (*HexRollupMutation)(nil)
+
+// hexrollupOption allows management of the mutation configuration using functional options.
+type hexrollupOption func(*HexRollupMutation)
+
+// newHexRollupMutation creates new mutation for the HexRollup entity.
+func newHexRollupMutation(c config, op Op, opts ...hexrollupOption) *HexRollupMutation {
+	m := &HexRollupMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHexRollup,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHexRollupID sets the ID field of the mutation.
+func withHexRollupID(id uuid.UUID) hexrollupOption {
+	return func(m *HexRollupMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *HexRollup
+		)
+		m.oldValue = func(ctx context.Context) (*HexRollup, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().HexRollup.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHexRollup sets the old HexRollup of the mutation.
+func withHexRollup(node *HexRollup) hexrollupOption {
+	return func(m *HexRollupMutation) {
+		m.oldValue = func(context.Context) (*HexRollup, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HexRollupMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HexRollupMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of HexRollup entities.
+func (m *HexRollupMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HexRollupMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HexRollupMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().HexRollup.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetH3Index sets the "h3_index" field.
+func (m *HexRollupMutation) SetH3Index(s string) {
+	m.h3_index = &s
+}
+
+// H3Index returns the value of the "h3_index" field in the mutation.
+func (m *HexRollupMutation) H3Index() (r string, exists bool) {
+	v := m.h3_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldH3Index returns the old "h3_index" field's value of the HexRollup entity.
+// If the HexRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HexRollupMutation) OldH3Index(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldH3Index is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldH3Index requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldH3Index: %w", err)
+	}
+	return oldValue.H3Index, nil
+}
+
+// ResetH3Index resets all changes to the "h3_index" field.
+func (m *HexRollupMutation) ResetH3Index() {
+	m.h3_index = nil
+}
+
+// SetResolution sets the "resolution" field.
+func (m *HexRollupMutation) SetResolution(i int) {
+	m.resolution = &i
+	m.addresolution = nil
+}
+
+// Resolution returns the value of the "resolution" field in the mutation.
+func (m *HexRollupMutation) Resolution() (r int, exists bool) {
+	v := m.resolution
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolution returns the old "resolution" field's value of the HexRollup entity.
+// If the HexRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HexRollupMutation) OldResolution(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolution is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolution requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolution: %w", err)
+	}
+	return oldValue.Resolution, nil
+}
+
+// AddResolution adds i to the "resolution" field.
+func (m *HexRollupMutation) AddResolution(i int) {
+	if m.addresolution != nil {
+		*m.addresolution += i
+	} else {
+		m.addresolution = &i
+	}
+}
+
+// AddedResolution returns the value that was added to the "resolution" field in this mutation.
+func (m *HexRollupMutation) AddedResolution() (r int, exists bool) {
+	v := m.addresolution
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetResolution resets all changes to the "resolution" field.
+func (m *HexRollupMutation) ResetResolution() {
+	m.resolution = nil
+	m.addresolution = nil
+}
+
+// SetOwners sets the "owners" field.
+func (m *HexRollupMutation) SetOwners(mo []model.RollupOwner) {
+	m.owners = &mo
+	m.appendowners = nil
+}
+
+// Owners returns the value of the "owners" field in the mutation.
+func (m *HexRollupMutation) Owners() (r []model.RollupOwner, exists bool) {
+	v := m.owners
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwners returns the old "owners" field's value of the HexRollup entity.
+// If the HexRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HexRollupMutation) OldOwners(ctx context.Context) (v []model.RollupOwner, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwners is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwners requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwners: %w", err)
+	}
+	return oldValue.Owners, nil
+}
+
+// AppendOwners adds mo to the "owners" field.
+func (m *HexRollupMutation) AppendOwners(mo []model.RollupOwner) {
+	m.appendowners = append(m.appendowners, mo...)
+}
+
+// AppendedOwners returns the list of values that were appended to the "owners" field in this mutation.
+func (m *HexRollupMutation) AppendedOwners() ([]model.RollupOwner, bool) {
+	if len(m.appendowners) == 0 {
+		return nil, false
+	}
+	return m.appendowners, true
+}
+
+// ResetOwners resets all changes to the "owners" field.
+func (m *HexRollupMutation) ResetOwners() {
+	m.owners = nil
+	m.appendowners = nil
+}
+
+// SetOwnedHexes sets the "owned_hexes" field.
+func (m *HexRollupMutation) SetOwnedHexes(i int) {
+	m.owned_hexes = &i
+	m.addowned_hexes = nil
+}
+
+// OwnedHexes returns the value of the "owned_hexes" field in the mutation.
+func (m *HexRollupMutation) OwnedHexes() (r int, exists bool) {
+	v := m.owned_hexes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnedHexes returns the old "owned_hexes" field's value of the HexRollup entity.
+// If the HexRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HexRollupMutation) OldOwnedHexes(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnedHexes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnedHexes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnedHexes: %w", err)
+	}
+	return oldValue.OwnedHexes, nil
+}
+
+// AddOwnedHexes adds i to the "owned_hexes" field.
+func (m *HexRollupMutation) AddOwnedHexes(i int) {
+	if m.addowned_hexes != nil {
+		*m.addowned_hexes += i
+	} else {
+		m.addowned_hexes = &i
+	}
+}
+
+// AddedOwnedHexes returns the value that was added to the "owned_hexes" field in this mutation.
+func (m *HexRollupMutation) AddedOwnedHexes() (r int, exists bool) {
+	v := m.addowned_hexes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOwnedHexes resets all changes to the "owned_hexes" field.
+func (m *HexRollupMutation) ResetOwnedHexes() {
+	m.owned_hexes = nil
+	m.addowned_hexes = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *HexRollupMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *HexRollupMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the HexRollup entity.
+// If the HexRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HexRollupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *HexRollupMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the HexRollupMutation builder.
+func (m *HexRollupMutation) Where(ps ...predicate.HexRollup) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HexRollupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HexRollupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.HexRollup, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HexRollupMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HexRollupMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (HexRollup).
+func (m *HexRollupMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HexRollupMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.h3_index != nil {
+		fields = append(fields, hexrollup.FieldH3Index)
+	}
+	if m.resolution != nil {
+		fields = append(fields, hexrollup.FieldResolution)
+	}
+	if m.owners != nil {
+		fields = append(fields, hexrollup.FieldOwners)
+	}
+	if m.owned_hexes != nil {
+		fields = append(fields, hexrollup.FieldOwnedHexes)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, hexrollup.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HexRollupMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case hexrollup.FieldH3Index:
+		return m.H3Index()
+	case hexrollup.FieldResolution:
+		return m.Resolution()
+	case hexrollup.FieldOwners:
+		return m.Owners()
+	case hexrollup.FieldOwnedHexes:
+		return m.OwnedHexes()
+	case hexrollup.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HexRollupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case hexrollup.FieldH3Index:
+		return m.OldH3Index(ctx)
+	case hexrollup.FieldResolution:
+		return m.OldResolution(ctx)
+	case hexrollup.FieldOwners:
+		return m.OldOwners(ctx)
+	case hexrollup.FieldOwnedHexes:
+		return m.OldOwnedHexes(ctx)
+	case hexrollup.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown HexRollup field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HexRollupMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case hexrollup.FieldH3Index:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetH3Index(v)
+		return nil
+	case hexrollup.FieldResolution:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolution(v)
+		return nil
+	case hexrollup.FieldOwners:
+		v, ok := value.([]model.RollupOwner)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwners(v)
+		return nil
+	case hexrollup.FieldOwnedHexes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnedHexes(v)
+		return nil
+	case hexrollup.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HexRollup field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HexRollupMutation) AddedFields() []string {
+	var fields []string
+	if m.addresolution != nil {
+		fields = append(fields, hexrollup.FieldResolution)
+	}
+	if m.addowned_hexes != nil {
+		fields = append(fields, hexrollup.FieldOwnedHexes)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HexRollupMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case hexrollup.FieldResolution:
+		return m.AddedResolution()
+	case hexrollup.FieldOwnedHexes:
+		return m.AddedOwnedHexes()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HexRollupMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case hexrollup.FieldResolution:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResolution(v)
+		return nil
+	case hexrollup.FieldOwnedHexes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOwnedHexes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HexRollup numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HexRollupMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HexRollupMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HexRollupMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown HexRollup nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HexRollupMutation) ResetField(name string) error {
+	switch name {
+	case hexrollup.FieldH3Index:
+		m.ResetH3Index()
+		return nil
+	case hexrollup.FieldResolution:
+		m.ResetResolution()
+		return nil
+	case hexrollup.FieldOwners:
+		m.ResetOwners()
+		return nil
+	case hexrollup.FieldOwnedHexes:
+		m.ResetOwnedHexes()
+		return nil
+	case hexrollup.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown HexRollup field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HexRollupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HexRollupMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HexRollupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HexRollupMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HexRollupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HexRollupMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HexRollupMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown HexRollup unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HexRollupMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown HexRollup edge %s", name)
+}
+
+// IdempotencyKeyMutation represents an operation that mutates the IdempotencyKey nodes in the graph.
+type IdempotencyKeyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	key           *string
+	user_id       *uuid.UUID
+	request_hash  *string
+	response      *[]byte
+	created_at    *time.Time
+	expires_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*IdempotencyKey, error)
+	predicates    []predicate.IdempotencyKey
+}
+
+var _ ent.Mutation = (*IdempotencyKeyMutation)(nil)
+
+// idempotencykeyOption allows management of the mutation configuration using functional options.
+type idempotencykeyOption func(*IdempotencyKeyMutation)
+
+// newIdempotencyKeyMutation creates new mutation for the IdempotencyKey entity.
+func newIdempotencyKeyMutation(c config, op Op, opts ...idempotencykeyOption) *IdempotencyKeyMutation {
+	m := &IdempotencyKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIdempotencyKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIdempotencyKeyID sets the ID field of the mutation.
+func withIdempotencyKeyID(id uuid.UUID) idempotencykeyOption {
+	return func(m *IdempotencyKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IdempotencyKey
+		)
+		m.oldValue = func(ctx context.Context) (*IdempotencyKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IdempotencyKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIdempotencyKey sets the old IdempotencyKey of the mutation.
+func withIdempotencyKey(node *IdempotencyKey) idempotencykeyOption {
+	return func(m *IdempotencyKeyMutation) {
+		m.oldValue = func(context.Context) (*IdempotencyKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IdempotencyKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IdempotencyKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of IdempotencyKey entities.
+func (m *IdempotencyKeyMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IdempotencyKeyMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IdempotencyKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IdempotencyKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *IdempotencyKeyMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *IdempotencyKeyMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *IdempotencyKeyMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *IdempotencyKeyMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *IdempotencyKeyMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *IdempotencyKeyMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetRequestHash sets the "request_hash" field.
+func (m *IdempotencyKeyMutation) SetRequestHash(s string) {
+	m.request_hash = &s
+}
+
+// RequestHash returns the value of the "request_hash" field in the mutation.
+func (m *IdempotencyKeyMutation) RequestHash() (r string, exists bool) {
+	v := m.request_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestHash returns the old "request_hash" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldRequestHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestHash: %w", err)
+	}
+	return oldValue.RequestHash, nil
+}
+
+// ResetRequestHash resets all changes to the "request_hash" field.
+func (m *IdempotencyKeyMutation) ResetRequestHash() {
+	m.request_hash = nil
+}
+
+// SetResponse sets the "response" field.
+func (m *IdempotencyKeyMutation) SetResponse(b []byte) {
+	m.response = &b
+}
+
+// Response returns the value of the "response" field in the mutation.
+func (m *IdempotencyKeyMutation) Response() (r []byte, exists bool) {
+	v := m.response
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponse returns the old "response" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldResponse(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponse is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponse requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponse: %w", err)
+	}
+	return oldValue.Response, nil
+}
+
+// ClearResponse clears the value of the "response" field.
+func (m *IdempotencyKeyMutation) ClearResponse() {
+	m.response = nil
+	m.clearedFields[idempotencykey.FieldResponse] = struct{}{}
+}
+
+// ResponseCleared returns if the "response" field was cleared in this mutation.
+func (m *IdempotencyKeyMutation) ResponseCleared() bool {
+	_, ok := m.clearedFields[idempotencykey.FieldResponse]
+	return ok
+}
+
+// ResetResponse resets all changes to the "response" field.
+func (m *IdempotencyKeyMutation) ResetResponse() {
+	m.response = nil
+	delete(m.clearedFields, idempotencykey.FieldResponse)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IdempotencyKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IdempotencyKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IdempotencyKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *IdempotencyKeyMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *IdempotencyKeyMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *IdempotencyKeyMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// Where appends a list predicates to the IdempotencyKeyMutation builder.
+func (m *IdempotencyKeyMutation) Where(ps ...predicate.IdempotencyKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IdempotencyKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IdempotencyKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IdempotencyKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IdempotencyKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IdempotencyKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IdempotencyKey).
+func (m *IdempotencyKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IdempotencyKeyMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.key != nil {
+		fields = append(fields, idempotencykey.FieldKey)
+	}
+	if m.user_id != nil {
+		fields = append(fields, idempotencykey.FieldUserID)
+	}
+	if m.request_hash != nil {
+		fields = append(fields, idempotencykey.FieldRequestHash)
+	}
+	if m.response != nil {
+		fields = append(fields, idempotencykey.FieldResponse)
+	}
+	if m.created_at != nil {
+		fields = append(fields, idempotencykey.FieldCreatedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, idempotencykey.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IdempotencyKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case idempotencykey.FieldKey:
+		return m.Key()
+	case idempotencykey.FieldUserID:
+		return m.UserID()
+	case idempotencykey.FieldRequestHash:
+		return m.RequestHash()
+	case idempotencykey.FieldResponse:
+		return m.Response()
+	case idempotencykey.FieldCreatedAt:
+		return m.CreatedAt()
+	case idempotencykey.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IdempotencyKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case idempotencykey.FieldKey:
+		return m.OldKey(ctx)
+	case idempotencykey.FieldUserID:
+		return m.OldUserID(ctx)
+	case idempotencykey.FieldRequestHash:
+		return m.OldRequestHash(ctx)
+	case idempotencykey.FieldResponse:
+		return m.OldResponse(ctx)
+	case idempotencykey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case idempotencykey.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotencyKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case idempotencykey.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case idempotencykey.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case idempotencykey.FieldRequestHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestHash(v)
+		return nil
+	case idempotencykey.FieldResponse:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponse(v)
+		return nil
+	case idempotencykey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case idempotencykey.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IdempotencyKeyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IdempotencyKeyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotencyKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown IdempotencyKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IdempotencyKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(idempotencykey.FieldResponse) {
+		fields = append(fields, idempotencykey.FieldResponse)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IdempotencyKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IdempotencyKeyMutation) ClearField(name string) error {
+	switch name {
+	case idempotencykey.FieldResponse:
+		m.ClearResponse()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IdempotencyKeyMutation) ResetField(name string) error {
+	switch name {
+	case idempotencykey.FieldKey:
+		m.ResetKey()
+		return nil
+	case idempotencykey.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case idempotencykey.FieldRequestHash:
+		m.ResetRequestHash()
+		return nil
+	case idempotencykey.FieldResponse:
+		m.ResetResponse()
+		return nil
+	case idempotencykey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case idempotencykey.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IdempotencyKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IdempotencyKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IdempotencyKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IdempotencyKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IdempotencyKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IdempotencyKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IdempotencyKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown IdempotencyKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IdempotencyKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown IdempotencyKey edge %s", name)
+}
+
+// InfluenceHistoryMutation represents an operation that mutates the InfluenceHistory nodes in the graph.
+type InfluenceHistoryMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	user_id         *uuid.UUID
+	h3_index        *string
+	activity_id     *uuid.UUID
+	score_before    *float64
+	addscore_before *float64
+	score_after     *float64
+	addscore_after  *float64
+	recorded_at     *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*InfluenceHistory, error)
+	predicates      []predicate.InfluenceHistory
+}
+
+var _ ent.Mutation = (*InfluenceHistoryMutation)(nil)
+
+// influencehistoryOption allows management of the mutation configuration using functional options.
+type influencehistoryOption func(*InfluenceHistoryMutation)
+
+// newInfluenceHistoryMutation creates new mutation for the InfluenceHistory entity.
+func newInfluenceHistoryMutation(c config, op Op, opts ...influencehistoryOption) *InfluenceHistoryMutation {
+	m := &InfluenceHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeInfluenceHistory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withInfluenceHistoryID sets the ID field of the mutation.
+func withInfluenceHistoryID(id uuid.UUID) influencehistoryOption {
+	return func(m *InfluenceHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *InfluenceHistory
+		)
+		m.oldValue = func(ctx context.Context) (*InfluenceHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().InfluenceHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withInfluenceHistory sets the old InfluenceHistory of the mutation.
+func withInfluenceHistory(node *InfluenceHistory) influencehistoryOption {
+	return func(m *InfluenceHistoryMutation) {
+		m.oldValue = func(context.Context) (*InfluenceHistory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m InfluenceHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m InfluenceHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of InfluenceHistory entities.
+func (m *InfluenceHistoryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *InfluenceHistoryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *InfluenceHistoryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().InfluenceHistory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *InfluenceHistoryMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *InfluenceHistoryMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the InfluenceHistory entity.
+// If the InfluenceHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InfluenceHistoryMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *InfluenceHistoryMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetH3Index sets the "h3_index" field.
+func (m *InfluenceHistoryMutation) SetH3Index(s string) {
+	m.h3_index = &s
+}
+
+// H3Index returns the value of the "h3_index" field in the mutation.
+func (m *InfluenceHistoryMutation) H3Index() (r string, exists bool) {
+	v := m.h3_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldH3Index returns the old "h3_index" field's value of the InfluenceHistory entity.
+// If the InfluenceHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InfluenceHistoryMutation) OldH3Index(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldH3Index is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldH3Index requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldH3Index: %w", err)
+	}
+	return oldValue.H3Index, nil
+}
+
+// ResetH3Index resets all changes to the "h3_index" field.
+func (m *InfluenceHistoryMutation) ResetH3Index() {
+	m.h3_index = nil
+}
+
+// SetActivityID sets the "activity_id" field.
+func (m *InfluenceHistoryMutation) SetActivityID(u uuid.UUID) {
+	m.activity_id = &u
+}
+
+// ActivityID returns the value of the "activity_id" field in the mutation.
+func (m *InfluenceHistoryMutation) ActivityID() (r uuid.UUID, exists bool) {
+	v := m.activity_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActivityID returns the old "activity_id" field's value of the InfluenceHistory entity.
+// If the InfluenceHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InfluenceHistoryMutation) OldActivityID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActivityID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActivityID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActivityID: %w", err)
+	}
+	return oldValue.ActivityID, nil
+}
+
+// ClearActivityID clears the value of the "activity_id" field.
+func (m *InfluenceHistoryMutation) ClearActivityID() {
+	m.activity_id = nil
+	m.clearedFields[influencehistory.FieldActivityID] = struct{}{}
+}
+
+// ActivityIDCleared returns if the "activity_id" field was cleared in this mutation.
+func (m *InfluenceHistoryMutation) ActivityIDCleared() bool {
+	_, ok := m.clearedFields[influencehistory.FieldActivityID]
+	return ok
+}
+
+// ResetActivityID resets all changes to the "activity_id" field.
+func (m *InfluenceHistoryMutation) ResetActivityID() {
+	m.activity_id = nil
+	delete(m.clearedFields, influencehistory.FieldActivityID)
+}
+
+// SetScoreBefore sets the "score_before" field.
+func (m *InfluenceHistoryMutation) SetScoreBefore(f float64) {
+	m.score_before = &f
+	m.addscore_before = nil
+}
+
+// ScoreBefore returns the value of the "score_before" field in the mutation.
+func (m *InfluenceHistoryMutation) ScoreBefore() (r float64, exists bool) {
+	v := m.score_before
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScoreBefore returns the old "score_before" field's value of the InfluenceHistory entity.
+// If the InfluenceHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InfluenceHistoryMutation) OldScoreBefore(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScoreBefore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScoreBefore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScoreBefore: %w", err)
+	}
+	return oldValue.ScoreBefore, nil
+}
+
+// AddScoreBefore adds f to the "score_before" field.
+func (m *InfluenceHistoryMutation) AddScoreBefore(f float64) {
+	if m.addscore_before != nil {
+		*m.addscore_before += f
+	} else {
+		m.addscore_before = &f
+	}
+}
+
+// AddedScoreBefore returns the value that was added to the "score_before" field in this mutation.
+func (m *InfluenceHistoryMutation) AddedScoreBefore() (r float64, exists bool) {
+	v := m.addscore_before
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScoreBefore resets all changes to the "score_before" field.
+func (m *InfluenceHistoryMutation) ResetScoreBefore() {
+	m.score_before = nil
+	m.addscore_before = nil
+}
+
+// SetScoreAfter sets the "score_after" field.
+func (m *InfluenceHistoryMutation) SetScoreAfter(f float64) {
+	m.score_after = &f
+	m.addscore_after = nil
+}
+
+// ScoreAfter returns the value of the "score_after" field in the mutation.
+func (m *InfluenceHistoryMutation) ScoreAfter() (r float64, exists bool) {
+	v := m.score_after
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScoreAfter returns the old "score_after" field's value of the InfluenceHistory entity.
+// If the InfluenceHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InfluenceHistoryMutation) OldScoreAfter(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScoreAfter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScoreAfter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScoreAfter: %w", err)
+	}
+	return oldValue.ScoreAfter, nil
+}
+
+// AddScoreAfter adds f to the "score_after" field.
+func (m *InfluenceHistoryMutation) AddScoreAfter(f float64) {
+	if m.addscore_after != nil {
+		*m.addscore_after += f
+	} else {
+		m.addscore_after = &f
+	}
+}
+
+// AddedScoreAfter returns the value that was added to the "score_after" field in this mutation.
+func (m *InfluenceHistoryMutation) AddedScoreAfter() (r float64, exists bool) {
+	v := m.addscore_after
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScoreAfter resets all changes to the "score_after" field.
+func (m *InfluenceHistoryMutation) ResetScoreAfter() {
+	m.score_after = nil
+	m.addscore_after = nil
+}
+
+// SetRecordedAt sets the "recorded_at" field.
+func (m *InfluenceHistoryMutation) SetRecordedAt(t time.Time) {
+	m.recorded_at = &t
+}
+
+// RecordedAt returns the value of the "recorded_at" field in the mutation.
+func (m *InfluenceHistoryMutation) RecordedAt() (r time.Time, exists bool) {
+	v := m.recorded_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecordedAt returns the old "recorded_at" field's value of the InfluenceHistory entity.
+// If the InfluenceHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InfluenceHistoryMutation) OldRecordedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecordedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecordedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecordedAt: %w", err)
+	}
+	return oldValue.RecordedAt, nil
+}
+
+// ResetRecordedAt resets all changes to the "recorded_at" field.
+func (m *InfluenceHistoryMutation) ResetRecordedAt() {
+	m.recorded_at = nil
+}
+
+// Where appends a list predicates to the InfluenceHistoryMutation builder.
+func (m *InfluenceHistoryMutation) Where(ps ...predicate.InfluenceHistory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the InfluenceHistoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *InfluenceHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.InfluenceHistory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *InfluenceHistoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *InfluenceHistoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (InfluenceHistory).
+func (m *InfluenceHistoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *InfluenceHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.user_id != nil {
+		fields = append(fields, influencehistory.FieldUserID)
+	}
+	if m.h3_index != nil {
+		fields = append(fields, influencehistory.FieldH3Index)
+	}
+	if m.activity_id != nil {
+		fields = append(fields, influencehistory.FieldActivityID)
+	}
+	if m.score_before != nil {
+		fields = append(fields, influencehistory.FieldScoreBefore)
+	}
+	if m.score_after != nil {
+		fields = append(fields, influencehistory.FieldScoreAfter)
+	}
+	if m.recorded_at != nil {
+		fields = append(fields, influencehistory.FieldRecordedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *InfluenceHistoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case influencehistory.FieldUserID:
+		return m.UserID()
+	case influencehistory.FieldH3Index:
+		return m.H3Index()
+	case influencehistory.FieldActivityID:
+		return m.ActivityID()
+	case influencehistory.FieldScoreBefore:
+		return m.ScoreBefore()
+	case influencehistory.FieldScoreAfter:
+		return m.ScoreAfter()
+	case influencehistory.FieldRecordedAt:
+		return m.RecordedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *InfluenceHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case influencehistory.FieldUserID:
+		return m.OldUserID(ctx)
+	case influencehistory.FieldH3Index:
+		return m.OldH3Index(ctx)
+	case influencehistory.FieldActivityID:
+		return m.OldActivityID(ctx)
+	case influencehistory.FieldScoreBefore:
+		return m.OldScoreBefore(ctx)
+	case influencehistory.FieldScoreAfter:
+		return m.OldScoreAfter(ctx)
+	case influencehistory.FieldRecordedAt:
+		return m.OldRecordedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown InfluenceHistory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InfluenceHistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case influencehistory.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case influencehistory.FieldH3Index:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetH3Index(v)
+		return nil
+	case influencehistory.FieldActivityID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActivityID(v)
+		return nil
+	case influencehistory.FieldScoreBefore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScoreBefore(v)
+		return nil
+	case influencehistory.FieldScoreAfter:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScoreAfter(v)
+		return nil
+	case influencehistory.FieldRecordedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecordedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown InfluenceHistory field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *InfluenceHistoryMutation) AddedFields() []string {
+	var fields []string
+	if m.addscore_before != nil {
+		fields = append(fields, influencehistory.FieldScoreBefore)
+	}
+	if m.addscore_after != nil {
+		fields = append(fields, influencehistory.FieldScoreAfter)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *InfluenceHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case influencehistory.FieldScoreBefore:
+		return m.AddedScoreBefore()
+	case influencehistory.FieldScoreAfter:
+		return m.AddedScoreAfter()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InfluenceHistoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case influencehistory.FieldScoreBefore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScoreBefore(v)
+		return nil
+	case influencehistory.FieldScoreAfter:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScoreAfter(v)
+		return nil
+	}
+	return fmt.Errorf("unknown InfluenceHistory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *InfluenceHistoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(influencehistory.FieldActivityID) {
+		fields = append(fields, influencehistory.FieldActivityID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *InfluenceHistoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *InfluenceHistoryMutation) ClearField(name string) error {
+	switch name {
+	case influencehistory.FieldActivityID:
+		m.ClearActivityID()
+		return nil
+	}
+	return fmt.Errorf("unknown InfluenceHistory nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *InfluenceHistoryMutation) ResetField(name string) error {
+	switch name {
+	case influencehistory.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case influencehistory.FieldH3Index:
+		m.ResetH3Index()
+		return nil
+	case influencehistory.FieldActivityID:
+		m.ResetActivityID()
+		return nil
+	case influencehistory.FieldScoreBefore:
+		m.ResetScoreBefore()
+		return nil
+	case influencehistory.FieldScoreAfter:
+		m.ResetScoreAfter()
+		return nil
+	case influencehistory.FieldRecordedAt:
+		m.ResetRecordedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown InfluenceHistory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *InfluenceHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *InfluenceHistoryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *InfluenceHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *InfluenceHistoryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *InfluenceHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *InfluenceHistoryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *InfluenceHistoryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown InfluenceHistory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *InfluenceHistoryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown InfluenceHistory edge %s", name)
+}
+
+// NotificationMutation represents an operation that mutates the Notification nodes in the graph.
+type NotificationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	user_id       *uuid.UUID
+	_type         *notification.Type
+	message       *string
+	data          *map[string]string
+	read_at       *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Notification, error)
+	predicates    []predicate.Notification
+}
+
+var _ ent.Mutation = (*NotificationMutation)(nil)
+
+// notificationOption allows management of the mutation configuration using functional options.
+type notificationOption func(*NotificationMutation)
+
+// newNotificationMutation creates new mutation for the Notification entity.
+func newNotificationMutation(c config, op Op, opts ...notificationOption) *NotificationMutation {
+	m := &NotificationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotification,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotificationID sets the ID field of the mutation.
+func withNotificationID(id uuid.UUID) notificationOption {
+	return func(m *NotificationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Notification
+		)
+		m.oldValue = func(ctx context.Context) (*Notification, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Notification.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotification sets the old Notification of the mutation.
+func withNotification(node *Notification) notificationOption {
+	return func(m *NotificationMutation) {
+		m.oldValue = func(context.Context) (*Notification, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Notification entities.
+func (m *NotificationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Notification.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *NotificationMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *NotificationMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *NotificationMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetType sets the "type" field.
+func (m *NotificationMutation) SetType(n notification.Type) {
+	m._type = &n
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *NotificationMutation) GetType() (r notification.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldType(ctx context.Context) (v notification.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *NotificationMutation) ResetType() {
+	m._type = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *NotificationMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *NotificationMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *NotificationMutation) ResetMessage() {
+	m.message = nil
+}
+
+// SetData sets the "data" field.
+func (m *NotificationMutation) SetData(value map[string]string) {
+	m.data = &value
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *NotificationMutation) Data() (r map[string]string, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldData(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// ClearData clears the value of the "data" field.
+func (m *NotificationMutation) ClearData() {
+	m.data = nil
+	m.clearedFields[notification.FieldData] = struct{}{}
+}
+
+// DataCleared returns if the "data" field was cleared in this mutation.
+func (m *NotificationMutation) DataCleared() bool {
+	_, ok := m.clearedFields[notification.FieldData]
+	return ok
+}
+
+// ResetData resets all changes to the "data" field.
+func (m *NotificationMutation) ResetData() {
+	m.data = nil
+	delete(m.clearedFields, notification.FieldData)
+}
+
+// SetReadAt sets the "read_at" field.
+func (m *NotificationMutation) SetReadAt(t time.Time) {
+	m.read_at = &t
+}
+
+// ReadAt returns the value of the "read_at" field in the mutation.
+func (m *NotificationMutation) ReadAt() (r time.Time, exists bool) {
+	v := m.read_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadAt returns the old "read_at" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldReadAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadAt: %w", err)
+	}
+	return oldValue.ReadAt, nil
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (m *NotificationMutation) ClearReadAt() {
+	m.read_at = nil
+	m.clearedFields[notification.FieldReadAt] = struct{}{}
+}
+
+// ReadAtCleared returns if the "read_at" field was cleared in this mutation.
+func (m *NotificationMutation) ReadAtCleared() bool {
+	_, ok := m.clearedFields[notification.FieldReadAt]
+	return ok
+}
+
+// ResetReadAt resets all changes to the "read_at" field.
+func (m *NotificationMutation) ResetReadAt() {
+	m.read_at = nil
+	delete(m.clearedFields, notification.FieldReadAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NotificationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NotificationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NotificationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the NotificationMutation builder.
+func (m *NotificationMutation) Where(ps ...predicate.Notification) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NotificationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NotificationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Notification, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NotificationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NotificationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Notification).
+func (m *NotificationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NotificationMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.user_id != nil {
+		fields = append(fields, notification.FieldUserID)
+	}
+	if m._type != nil {
+		fields = append(fields, notification.FieldType)
+	}
+	if m.message != nil {
+		fields = append(fields, notification.FieldMessage)
+	}
+	if m.data != nil {
+		fields = append(fields, notification.FieldData)
+	}
+	if m.read_at != nil {
+		fields = append(fields, notification.FieldReadAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, notification.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotificationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notification.FieldUserID:
+		return m.UserID()
+	case notification.FieldType:
+		return m.GetType()
+	case notification.FieldMessage:
+		return m.Message()
+	case notification.FieldData:
+		return m.Data()
+	case notification.FieldReadAt:
+		return m.ReadAt()
+	case notification.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notification.FieldUserID:
+		return m.OldUserID(ctx)
+	case notification.FieldType:
+		return m.OldType(ctx)
+	case notification.FieldMessage:
+		return m.OldMessage(ctx)
+	case notification.FieldData:
+		return m.OldData(ctx)
+	case notification.FieldReadAt:
+		return m.OldReadAt(ctx)
+	case notification.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Notification field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notification.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case notification.FieldType:
+		v, ok := value.(notification.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case notification.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case notification.FieldData:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	case notification.FieldReadAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadAt(v)
+		return nil
+	case notification.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Notification field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotificationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotificationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Notification numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(notification.FieldData) {
+		fields = append(fields, notification.FieldData)
+	}
+	if m.FieldCleared(notification.FieldReadAt) {
+		fields = append(fields, notification.FieldReadAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotificationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotificationMutation) ClearField(name string) error {
+	switch name {
+	case notification.FieldData:
+		m.ClearData()
+		return nil
+	case notification.FieldReadAt:
+		m.ClearReadAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotificationMutation) ResetField(name string) error {
+	switch name {
+	case notification.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case notification.FieldType:
+		m.ResetType()
+		return nil
+	case notification.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case notification.FieldData:
+		m.ResetData()
+		return nil
+	case notification.FieldReadAt:
+		m.ResetReadAt()
+		return nil
+	case notification.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotificationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotificationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotificationMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotificationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Notification unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotificationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Notification edge %s", name)
+}
+
+// NotificationPreferenceMutation represents an operation that mutates the NotificationPreference nodes in the graph.
+type NotificationPreferenceMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	user_id       *uuid.UUID
+	_type         *notificationpreference.Type
+	enabled       *bool
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*NotificationPreference, error)
+	predicates    []predicate.NotificationPreference
+}
+
+var _ ent.Mutation = (*NotificationPreferenceMutation)(nil)
+
+// notificationpreferenceOption allows management of the mutation configuration using functional options.
+type notificationpreferenceOption func(*NotificationPreferenceMutation)
+
+// newNotificationPreferenceMutation creates new mutation for the NotificationPreference entity.
+func newNotificationPreferenceMutation(c config, op Op, opts ...notificationpreferenceOption) *NotificationPreferenceMutation {
+	m := &NotificationPreferenceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotificationPreference,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotificationPreferenceID sets the ID field of the mutation.
+func withNotificationPreferenceID(id uuid.UUID) notificationpreferenceOption {
+	return func(m *NotificationPreferenceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NotificationPreference
+		)
+		m.oldValue = func(ctx context.Context) (*NotificationPreference, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NotificationPreference.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotificationPreference sets the old NotificationPreference of the mutation.
+func withNotificationPreference(node *NotificationPreference) notificationpreferenceOption {
+	return func(m *NotificationPreferenceMutation) {
+		m.oldValue = func(context.Context) (*NotificationPreference, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationPreferenceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationPreferenceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of NotificationPreference entities.
+func (m *NotificationPreferenceMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationPreferenceMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationPreferenceMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NotificationPreference.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *NotificationPreferenceMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *NotificationPreferenceMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationPreferenceMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *NotificationPreferenceMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetType sets the "type" field.
+func (m *NotificationPreferenceMutation) SetType(n notificationpreference.Type) {
+	m._type = &n
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *NotificationPreferenceMutation) GetType() (r notificationpreference.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationPreferenceMutation) OldType(ctx context.Context) (v notificationpreference.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *NotificationPreferenceMutation) ResetType() {
+	m._type = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *NotificationPreferenceMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *NotificationPreferenceMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationPreferenceMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *NotificationPreferenceMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// Where appends a list predicates to the NotificationPreferenceMutation builder.
+func (m *NotificationPreferenceMutation) Where(ps ...predicate.NotificationPreference) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NotificationPreferenceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NotificationPreferenceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NotificationPreference, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NotificationPreferenceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NotificationPreferenceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NotificationPreference).
+func (m *NotificationPreferenceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NotificationPreferenceMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.user_id != nil {
+		fields = append(fields, notificationpreference.FieldUserID)
+	}
+	if m._type != nil {
+		fields = append(fields, notificationpreference.FieldType)
+	}
+	if m.enabled != nil {
+		fields = append(fields, notificationpreference.FieldEnabled)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotificationPreferenceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notificationpreference.FieldUserID:
+		return m.UserID()
+	case notificationpreference.FieldType:
+		return m.GetType()
+	case notificationpreference.FieldEnabled:
+		return m.Enabled()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotificationPreferenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notificationpreference.FieldUserID:
+		return m.OldUserID(ctx)
+	case notificationpreference.FieldType:
+		return m.OldType(ctx)
+	case notificationpreference.FieldEnabled:
+		return m.OldEnabled(ctx)
+	}
+	return nil, fmt.Errorf("unknown NotificationPreference field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationPreferenceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notificationpreference.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case notificationpreference.FieldType:
+		v, ok := value.(notificationpreference.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case notificationpreference.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationPreference field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotificationPreferenceMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotificationPreferenceMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationPreferenceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown NotificationPreference numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationPreferenceMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotificationPreferenceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotificationPreferenceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown NotificationPreference nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotificationPreferenceMutation) ResetField(name string) error {
+	switch name {
+	case notificationpreference.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case notificationpreference.FieldType:
+		m.ResetType()
+		return nil
+	case notificationpreference.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationPreference field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotificationPreferenceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotificationPreferenceMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationPreferenceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationPreferenceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationPreferenceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotificationPreferenceMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotificationPreferenceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown NotificationPreference unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotificationPreferenceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown NotificationPreference edge %s", name)
+}
+
+// PersonalRecordMutation represents an operation that mutates the PersonalRecord nodes in the graph.
+type PersonalRecordMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	user_id       *uuid.UUID
+	activity_type *personalrecord.ActivityType
+	record        *personalrecord.Record
+	value         *float64
+	addvalue      *float64
+	activity_id   *uuid.UUID
+	achieved_at   *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*PersonalRecord, error)
+	predicates    []predicate.PersonalRecord
+}
+
+var _ ent.Mutation = (*PersonalRecordMutation)(nil)
+
+// personalrecordOption allows management of the mutation configuration using functional options.
+type personalrecordOption func(*PersonalRecordMutation)
+
+// newPersonalRecordMutation creates new mutation for the PersonalRecord entity.
+func newPersonalRecordMutation(c config, op Op, opts ...personalrecordOption) *PersonalRecordMutation {
+	m := &PersonalRecordMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePersonalRecord,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPersonalRecordID sets the ID field of the mutation.
+func withPersonalRecordID(id uuid.UUID) personalrecordOption {
+	return func(m *PersonalRecordMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PersonalRecord
+		)
+		m.oldValue = func(ctx context.Context) (*PersonalRecord, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PersonalRecord.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPersonalRecord sets the old PersonalRecord of the mutation.
+func withPersonalRecord(node *PersonalRecord) personalrecordOption {
+	return func(m *PersonalRecordMutation) {
+		m.oldValue = func(context.Context) (*PersonalRecord, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PersonalRecordMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PersonalRecordMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PersonalRecord entities.
+func (m *PersonalRecordMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PersonalRecordMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PersonalRecordMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PersonalRecord.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PersonalRecordMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PersonalRecordMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the PersonalRecord entity.
+// If the PersonalRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonalRecordMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PersonalRecordMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetActivityType sets the "activity_type" field.
+func (m *PersonalRecordMutation) SetActivityType(pt personalrecord.ActivityType) {
+	m.activity_type = &pt
+}
+
+// ActivityType returns the value of the "activity_type" field in the mutation.
+func (m *PersonalRecordMutation) ActivityType() (r personalrecord.ActivityType, exists bool) {
+	v := m.activity_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActivityType returns the old "activity_type" field's value of the PersonalRecord entity.
+// If the PersonalRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonalRecordMutation) OldActivityType(ctx context.Context) (v personalrecord.ActivityType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActivityType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActivityType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActivityType: %w", err)
+	}
+	return oldValue.ActivityType, nil
+}
+
+// ResetActivityType resets all changes to the "activity_type" field.
+func (m *PersonalRecordMutation) ResetActivityType() {
+	m.activity_type = nil
+}
+
+// SetRecord sets the "record" field.
+func (m *PersonalRecordMutation) SetRecord(pe personalrecord.Record) {
+	m.record = &pe
+}
+
+// Record returns the value of the "record" field in the mutation.
+func (m *PersonalRecordMutation) Record() (r personalrecord.Record, exists bool) {
+	v := m.record
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecord returns the old "record" field's value of the PersonalRecord entity.
+// If the PersonalRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonalRecordMutation) OldRecord(ctx context.Context) (v personalrecord.Record, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecord is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecord requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecord: %w", err)
+	}
+	return oldValue.Record, nil
+}
+
+// ResetRecord resets all changes to the "record" field.
+func (m *PersonalRecordMutation) ResetRecord() {
+	m.record = nil
+}
+
+// SetValue sets the "value" field.
+func (m *PersonalRecordMutation) SetValue(f float64) {
+	m.value = &f
+	m.addvalue = nil
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *PersonalRecordMutation) Value() (r float64, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the PersonalRecord entity.
+// If the PersonalRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonalRecordMutation) OldValue(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// AddValue adds f to the "value" field.
+func (m *PersonalRecordMutation) AddValue(f float64) {
+	if m.addvalue != nil {
+		*m.addvalue += f
+	} else {
+		m.addvalue = &f
+	}
+}
+
+// AddedValue returns the value that was added to the "value" field in this mutation.
+func (m *PersonalRecordMutation) AddedValue() (r float64, exists bool) {
+	v := m.addvalue
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *PersonalRecordMutation) ResetValue() {
+	m.value = nil
+	m.addvalue = nil
+}
+
+// SetActivityID sets the "activity_id" field.
+func (m *PersonalRecordMutation) SetActivityID(u uuid.UUID) {
+	m.activity_id = &u
+}
+
+// ActivityID returns the value of the "activity_id" field in the mutation.
+func (m *PersonalRecordMutation) ActivityID() (r uuid.UUID, exists bool) {
+	v := m.activity_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActivityID returns the old "activity_id" field's value of the PersonalRecord entity.
+// If the PersonalRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonalRecordMutation) OldActivityID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActivityID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActivityID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActivityID: %w", err)
+	}
+	return oldValue.ActivityID, nil
+}
+
+// ResetActivityID resets all changes to the "activity_id" field.
+func (m *PersonalRecordMutation) ResetActivityID() {
+	m.activity_id = nil
+}
+
+// SetAchievedAt sets the "achieved_at" field.
+func (m *PersonalRecordMutation) SetAchievedAt(t time.Time) {
+	m.achieved_at = &t
+}
+
+// AchievedAt returns the value of the "achieved_at" field in the mutation.
+func (m *PersonalRecordMutation) AchievedAt() (r time.Time, exists bool) {
+	v := m.achieved_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAchievedAt returns the old "achieved_at" field's value of the PersonalRecord entity.
+// If the PersonalRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonalRecordMutation) OldAchievedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAchievedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAchievedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAchievedAt: %w", err)
+	}
+	return oldValue.AchievedAt, nil
+}
+
+// ResetAchievedAt resets all changes to the "achieved_at" field.
+func (m *PersonalRecordMutation) ResetAchievedAt() {
+	m.achieved_at = nil
+}
+
+// Where appends a list predicates to the PersonalRecordMutation builder.
+func (m *PersonalRecordMutation) Where(ps ...predicate.PersonalRecord) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PersonalRecordMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PersonalRecordMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PersonalRecord, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PersonalRecordMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PersonalRecordMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PersonalRecord).
+func (m *PersonalRecordMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PersonalRecordMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.user_id != nil {
+		fields = append(fields, personalrecord.FieldUserID)
+	}
+	if m.activity_type != nil {
+		fields = append(fields, personalrecord.FieldActivityType)
+	}
+	if m.record != nil {
+		fields = append(fields, personalrecord.FieldRecord)
+	}
+	if m.value != nil {
+		fields = append(fields, personalrecord.FieldValue)
+	}
+	if m.activity_id != nil {
+		fields = append(fields, personalrecord.FieldActivityID)
+	}
+	if m.achieved_at != nil {
+		fields = append(fields, personalrecord.FieldAchievedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PersonalRecordMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case personalrecord.FieldUserID:
+		return m.UserID()
+	case personalrecord.FieldActivityType:
+		return m.ActivityType()
+	case personalrecord.FieldRecord:
+		return m.Record()
+	case personalrecord.FieldValue:
+		return m.Value()
+	case personalrecord.FieldActivityID:
+		return m.ActivityID()
+	case personalrecord.FieldAchievedAt:
+		return m.AchievedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PersonalRecordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case personalrecord.FieldUserID:
+		return m.OldUserID(ctx)
+	case personalrecord.FieldActivityType:
+		return m.OldActivityType(ctx)
+	case personalrecord.FieldRecord:
+		return m.OldRecord(ctx)
+	case personalrecord.FieldValue:
+		return m.OldValue(ctx)
+	case personalrecord.FieldActivityID:
+		return m.OldActivityID(ctx)
+	case personalrecord.FieldAchievedAt:
+		return m.OldAchievedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PersonalRecord field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PersonalRecordMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case personalrecord.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case personalrecord.FieldActivityType:
+		v, ok := value.(personalrecord.ActivityType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActivityType(v)
+		return nil
+	case personalrecord.FieldRecord:
+		v, ok := value.(personalrecord.Record)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecord(v)
+		return nil
+	case personalrecord.FieldValue:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case personalrecord.FieldActivityID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActivityID(v)
+		return nil
+	case personalrecord.FieldAchievedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAchievedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PersonalRecord field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PersonalRecordMutation) AddedFields() []string {
+	var fields []string
+	if m.addvalue != nil {
+		fields = append(fields, personalrecord.FieldValue)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PersonalRecordMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case personalrecord.FieldValue:
+		return m.AddedValue()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PersonalRecordMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case personalrecord.FieldValue:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PersonalRecord numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PersonalRecordMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PersonalRecordMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PersonalRecordMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PersonalRecord nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PersonalRecordMutation) ResetField(name string) error {
+	switch name {
+	case personalrecord.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case personalrecord.FieldActivityType:
+		m.ResetActivityType()
+		return nil
+	case personalrecord.FieldRecord:
+		m.ResetRecord()
+		return nil
+	case personalrecord.FieldValue:
+		m.ResetValue()
+		return nil
+	case personalrecord.FieldActivityID:
+		m.ResetActivityID()
+		return nil
+	case personalrecord.FieldAchievedAt:
+		m.ResetAchievedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PersonalRecord field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PersonalRecordMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PersonalRecordMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PersonalRecordMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PersonalRecordMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PersonalRecordMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PersonalRecordMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PersonalRecordMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PersonalRecord unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PersonalRecordMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PersonalRecord edge %s", name)
+}
+
+// PrivacyZoneMutation represents an operation that mutates the PrivacyZone nodes in the graph.
+type PrivacyZoneMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	user_id          *uuid.UUID
+	name             *string
+	lat              *float64
+	addlat           *float64
+	lng              *float64
+	addlng           *float64
+	radius_meters    *float64
+	addradius_meters *float64
+	h3_indexes       *[]string
+	appendh3_indexes []string
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*PrivacyZone, error)
+	predicates       []predicate.PrivacyZone
+}
+
+var _ ent.Mutation = (*PrivacyZoneMutation)(nil)
+
+// privacyzoneOption allows management of the mutation configuration using functional options.
+type privacyzoneOption func(*PrivacyZoneMutation)
+
+// newPrivacyZoneMutation creates new mutation for the PrivacyZone entity.
+func newPrivacyZoneMutation(c config, op Op, opts ...privacyzoneOption) *PrivacyZoneMutation {
+	m := &PrivacyZoneMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePrivacyZone,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPrivacyZoneID sets the ID field of the mutation.
+func withPrivacyZoneID(id uuid.UUID) privacyzoneOption {
+	return func(m *PrivacyZoneMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PrivacyZone
+		)
+		m.oldValue = func(ctx context.Context) (*PrivacyZone, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PrivacyZone.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPrivacyZone sets the old PrivacyZone of the mutation.
+func withPrivacyZone(node *PrivacyZone) privacyzoneOption {
+	return func(m *PrivacyZoneMutation) {
+		m.oldValue = func(context.Context) (*PrivacyZone, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PrivacyZoneMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PrivacyZoneMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PrivacyZone entities.
+func (m *PrivacyZoneMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PrivacyZoneMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PrivacyZoneMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PrivacyZone.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PrivacyZoneMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PrivacyZoneMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the PrivacyZone entity.
+// If the PrivacyZone object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyZoneMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PrivacyZoneMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetName sets the "name" field.
+func (m *PrivacyZoneMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PrivacyZoneMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the PrivacyZone entity.
+// If the PrivacyZone object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyZoneMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PrivacyZoneMutation) ResetName() {
+	m.name = nil
+}
+
+// SetLat sets the "lat" field.
+func (m *PrivacyZoneMutation) SetLat(f float64) {
+	m.lat = &f
+	m.addlat = nil
+}
+
+// Lat returns the value of the "lat" field in the mutation.
+func (m *PrivacyZoneMutation) Lat() (r float64, exists bool) {
+	v := m.lat
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLat returns the old "lat" field's value of the PrivacyZone entity.
+// If the PrivacyZone object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyZoneMutation) OldLat(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLat: %w", err)
+	}
+	return oldValue.Lat, nil
+}
+
+// AddLat adds f to the "lat" field.
+func (m *PrivacyZoneMutation) AddLat(f float64) {
+	if m.addlat != nil {
+		*m.addlat += f
+	} else {
+		m.addlat = &f
+	}
+}
+
+// AddedLat returns the value that was added to the "lat" field in this mutation.
+func (m *PrivacyZoneMutation) AddedLat() (r float64, exists bool) {
+	v := m.addlat
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLat resets all changes to the "lat" field.
+func (m *PrivacyZoneMutation) ResetLat() {
+	m.lat = nil
+	m.addlat = nil
+}
+
+// SetLng sets the "lng" field.
+func (m *PrivacyZoneMutation) SetLng(f float64) {
+	m.lng = &f
+	m.addlng = nil
+}
+
+// Lng returns the value of the "lng" field in the mutation.
+func (m *PrivacyZoneMutation) Lng() (r float64, exists bool) {
+	v := m.lng
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLng returns the old "lng" field's value of the PrivacyZone entity.
+// If the PrivacyZone object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyZoneMutation) OldLng(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLng is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLng requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLng: %w", err)
+	}
+	return oldValue.Lng, nil
+}
+
+// AddLng adds f to the "lng" field.
+func (m *PrivacyZoneMutation) AddLng(f float64) {
+	if m.addlng != nil {
+		*m.addlng += f
+	} else {
+		m.addlng = &f
+	}
+}
+
+// AddedLng returns the value that was added to the "lng" field in this mutation.
+func (m *PrivacyZoneMutation) AddedLng() (r float64, exists bool) {
+	v := m.addlng
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLng resets all changes to the "lng" field.
+func (m *PrivacyZoneMutation) ResetLng() {
+	m.lng = nil
+	m.addlng = nil
+}
+
+// SetRadiusMeters sets the "radius_meters" field.
+func (m *PrivacyZoneMutation) SetRadiusMeters(f float64) {
+	m.radius_meters = &f
+	m.addradius_meters = nil
+}
+
+// RadiusMeters returns the value of the "radius_meters" field in the mutation.
+func (m *PrivacyZoneMutation) RadiusMeters() (r float64, exists bool) {
+	v := m.radius_meters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRadiusMeters returns the old "radius_meters" field's value of the PrivacyZone entity.
+// If the PrivacyZone object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyZoneMutation) OldRadiusMeters(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRadiusMeters is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRadiusMeters requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRadiusMeters: %w", err)
+	}
+	return oldValue.RadiusMeters, nil
+}
+
+// AddRadiusMeters adds f to the "radius_meters" field.
+func (m *PrivacyZoneMutation) AddRadiusMeters(f float64) {
+	if m.addradius_meters != nil {
+		*m.addradius_meters += f
+	} else {
+		m.addradius_meters = &f
+	}
+}
+
+// AddedRadiusMeters returns the value that was added to the "radius_meters" field in this mutation.
+func (m *PrivacyZoneMutation) AddedRadiusMeters() (r float64, exists bool) {
+	v := m.addradius_meters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRadiusMeters resets all changes to the "radius_meters" field.
+func (m *PrivacyZoneMutation) ResetRadiusMeters() {
+	m.radius_meters = nil
+	m.addradius_meters = nil
+}
+
+// SetH3Indexes sets the "h3_indexes" field.
+func (m *PrivacyZoneMutation) SetH3Indexes(s []string) {
+	m.h3_indexes = &s
+	m.appendh3_indexes = nil
+}
+
+// H3Indexes returns the value of the "h3_indexes" field in the mutation.
+func (m *PrivacyZoneMutation) H3Indexes() (r []string, exists bool) {
+	v := m.h3_indexes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldH3Indexes returns the old "h3_indexes" field's value of the PrivacyZone entity.
+// If the PrivacyZone object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyZoneMutation) OldH3Indexes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldH3Indexes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldH3Indexes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldH3Indexes: %w", err)
+	}
+	return oldValue.H3Indexes, nil
+}
+
+// AppendH3Indexes adds s to the "h3_indexes" field.
+func (m *PrivacyZoneMutation) AppendH3Indexes(s []string) {
+	m.appendh3_indexes = append(m.appendh3_indexes, s...)
+}
+
+// AppendedH3Indexes returns the list of values that were appended to the "h3_indexes" field in this mutation.
+func (m *PrivacyZoneMutation) AppendedH3Indexes() ([]string, bool) {
+	if len(m.appendh3_indexes) == 0 {
+		return nil, false
+	}
+	return m.appendh3_indexes, true
+}
+
+// ResetH3Indexes resets all changes to the "h3_indexes" field.
+func (m *PrivacyZoneMutation) ResetH3Indexes() {
+	m.h3_indexes = nil
+	m.appendh3_indexes = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PrivacyZoneMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PrivacyZoneMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PrivacyZone entity.
+// If the PrivacyZone object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyZoneMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PrivacyZoneMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the PrivacyZoneMutation builder.
+func (m *PrivacyZoneMutation) Where(ps ...predicate.PrivacyZone) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PrivacyZoneMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PrivacyZoneMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PrivacyZone, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PrivacyZoneMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PrivacyZoneMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PrivacyZone).
+func (m *PrivacyZoneMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PrivacyZoneMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.user_id != nil {
+		fields = append(fields, privacyzone.FieldUserID)
+	}
+	if m.name != nil {
+		fields = append(fields, privacyzone.FieldName)
+	}
+	if m.lat != nil {
+		fields = append(fields, privacyzone.FieldLat)
+	}
+	if m.lng != nil {
+		fields = append(fields, privacyzone.FieldLng)
+	}
+	if m.radius_meters != nil {
+		fields = append(fields, privacyzone.FieldRadiusMeters)
+	}
+	if m.h3_indexes != nil {
+		fields = append(fields, privacyzone.FieldH3Indexes)
+	}
+	if m.created_at != nil {
+		fields = append(fields, privacyzone.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PrivacyZoneMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case privacyzone.FieldUserID:
+		return m.UserID()
+	case privacyzone.FieldName:
+		return m.Name()
+	case privacyzone.FieldLat:
+		return m.Lat()
+	case privacyzone.FieldLng:
+		return m.Lng()
+	case privacyzone.FieldRadiusMeters:
+		return m.RadiusMeters()
+	case privacyzone.FieldH3Indexes:
+		return m.H3Indexes()
+	case privacyzone.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PrivacyZoneMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case privacyzone.FieldUserID:
+		return m.OldUserID(ctx)
+	case privacyzone.FieldName:
+		return m.OldName(ctx)
+	case privacyzone.FieldLat:
+		return m.OldLat(ctx)
+	case privacyzone.FieldLng:
+		return m.OldLng(ctx)
+	case privacyzone.FieldRadiusMeters:
+		return m.OldRadiusMeters(ctx)
+	case privacyzone.FieldH3Indexes:
+		return m.OldH3Indexes(ctx)
+	case privacyzone.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PrivacyZone field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PrivacyZoneMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case privacyzone.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case privacyzone.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case privacyzone.FieldLat:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLat(v)
+		return nil
+	case privacyzone.FieldLng:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLng(v)
+		return nil
+	case privacyzone.FieldRadiusMeters:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRadiusMeters(v)
+		return nil
+	case privacyzone.FieldH3Indexes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetH3Indexes(v)
+		return nil
+	case privacyzone.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PrivacyZone field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PrivacyZoneMutation) AddedFields() []string {
+	var fields []string
+	if m.addlat != nil {
+		fields = append(fields, privacyzone.FieldLat)
+	}
+	if m.addlng != nil {
+		fields = append(fields, privacyzone.FieldLng)
+	}
+	if m.addradius_meters != nil {
+		fields = append(fields, privacyzone.FieldRadiusMeters)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PrivacyZoneMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case privacyzone.FieldLat:
+		return m.AddedLat()
+	case privacyzone.FieldLng:
+		return m.AddedLng()
+	case privacyzone.FieldRadiusMeters:
+		return m.AddedRadiusMeters()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PrivacyZoneMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case privacyzone.FieldLat:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLat(v)
+		return nil
+	case privacyzone.FieldLng:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLng(v)
+		return nil
+	case privacyzone.FieldRadiusMeters:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRadiusMeters(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PrivacyZone numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PrivacyZoneMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PrivacyZoneMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PrivacyZoneMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PrivacyZone nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PrivacyZoneMutation) ResetField(name string) error {
+	switch name {
+	case privacyzone.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case privacyzone.FieldName:
+		m.ResetName()
+		return nil
+	case privacyzone.FieldLat:
+		m.ResetLat()
+		return nil
+	case privacyzone.FieldLng:
+		m.ResetLng()
+		return nil
+	case privacyzone.FieldRadiusMeters:
+		m.ResetRadiusMeters()
+		return nil
+	case privacyzone.FieldH3Indexes:
+		m.ResetH3Indexes()
+		return nil
+	case privacyzone.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PrivacyZone field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PrivacyZoneMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PrivacyZoneMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PrivacyZoneMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PrivacyZoneMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PrivacyZoneMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PrivacyZoneMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PrivacyZoneMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PrivacyZone unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PrivacyZoneMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PrivacyZone edge %s", name)
+}
+
+// PushDeviceMutation represents an operation that mutates the PushDevice nodes in the graph.
+type PushDeviceMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	user_id       *uuid.UUID
+	device_id     *string
+	token         *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*PushDevice, error)
+	predicates    []predicate.PushDevice
+}
+
+var _ ent.Mutation = (*PushDeviceMutation)(nil)
+
+// pushdeviceOption allows management of the mutation configuration using functional options.
+type pushdeviceOption func(*PushDeviceMutation)
+
+// newPushDeviceMutation creates new mutation for the PushDevice entity.
+func newPushDeviceMutation(c config, op Op, opts ...pushdeviceOption) *PushDeviceMutation {
+	m := &PushDeviceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePushDevice,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPushDeviceID sets the ID field of the mutation.
+func withPushDeviceID(id uuid.UUID) pushdeviceOption {
+	return func(m *PushDeviceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PushDevice
+		)
+		m.oldValue = func(ctx context.Context) (*PushDevice, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PushDevice.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPushDevice sets the old PushDevice of the mutation.
+func withPushDevice(node *PushDevice) pushdeviceOption {
+	return func(m *PushDeviceMutation) {
+		m.oldValue = func(context.Context) (*PushDevice, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PushDeviceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PushDeviceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PushDevice entities.
+func (m *PushDeviceMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PushDeviceMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PushDeviceMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PushDevice.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PushDeviceMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PushDeviceMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the PushDevice entity.
+// If the PushDevice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PushDeviceMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PushDeviceMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetDeviceID sets the "device_id" field.
+func (m *PushDeviceMutation) SetDeviceID(s string) {
+	m.device_id = &s
+}
+
+// DeviceID returns the value of the "device_id" field in the mutation.
+func (m *PushDeviceMutation) DeviceID() (r string, exists bool) {
+	v := m.device_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceID returns the old "device_id" field's value of the PushDevice entity.
+// If the PushDevice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PushDeviceMutation) OldDeviceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
+	}
+	return oldValue.DeviceID, nil
+}
+
+// ResetDeviceID resets all changes to the "device_id" field.
+func (m *PushDeviceMutation) ResetDeviceID() {
+	m.device_id = nil
+}
+
+// SetToken sets the "token" field.
+func (m *PushDeviceMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *PushDeviceMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the PushDevice entity.
+// If the PushDevice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PushDeviceMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *PushDeviceMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PushDeviceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PushDeviceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PushDevice entity.
+// If the PushDevice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PushDeviceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PushDeviceMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PushDeviceMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PushDeviceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PushDevice entity.
+// If the PushDevice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PushDeviceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PushDeviceMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the PushDeviceMutation builder.
+func (m *PushDeviceMutation) Where(ps ...predicate.PushDevice) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PushDeviceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PushDeviceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PushDevice, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PushDeviceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PushDeviceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PushDevice).
+func (m *PushDeviceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PushDeviceMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.user_id != nil {
+		fields = append(fields, pushdevice.FieldUserID)
+	}
+	if m.device_id != nil {
+		fields = append(fields, pushdevice.FieldDeviceID)
+	}
+	if m.token != nil {
+		fields = append(fields, pushdevice.FieldToken)
+	}
+	if m.created_at != nil {
+		fields = append(fields, pushdevice.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, pushdevice.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PushDeviceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pushdevice.FieldUserID:
+		return m.UserID()
+	case pushdevice.FieldDeviceID:
+		return m.DeviceID()
+	case pushdevice.FieldToken:
+		return m.Token()
+	case pushdevice.FieldCreatedAt:
+		return m.CreatedAt()
+	case pushdevice.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PushDeviceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pushdevice.FieldUserID:
+		return m.OldUserID(ctx)
+	case pushdevice.FieldDeviceID:
+		return m.OldDeviceID(ctx)
+	case pushdevice.FieldToken:
+		return m.OldToken(ctx)
+	case pushdevice.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case pushdevice.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PushDevice field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PushDeviceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pushdevice.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case pushdevice.FieldDeviceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceID(v)
+		return nil
+	case pushdevice.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case pushdevice.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case pushdevice.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PushDevice field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PushDeviceMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PushDeviceMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PushDeviceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PushDevice numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PushDeviceMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PushDeviceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PushDeviceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PushDevice nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PushDeviceMutation) ResetField(name string) error {
+	switch name {
+	case pushdevice.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case pushdevice.FieldDeviceID:
+		m.ResetDeviceID()
+		return nil
+	case pushdevice.FieldToken:
+		m.ResetToken()
+		return nil
+	case pushdevice.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case pushdevice.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PushDevice field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PushDeviceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PushDeviceMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PushDeviceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PushDeviceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PushDeviceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PushDeviceMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PushDeviceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PushDevice unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PushDeviceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PushDevice edge %s", name)
+}
+
+// PushMessageMutation represents an operation that mutates the PushMessage nodes in the graph.
+type PushMessageMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	user_id         *uuid.UUID
+	notification_id *uuid.UUID
+	title           *string
+	body            *string
+	data            *map[string]string
+	status          *pushmessage.Status
+	attempts        *int
+	addattempts     *int
+	locked_until    *time.Time
+	last_error      *string
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*PushMessage, error)
+	predicates      []predicate.PushMessage
+}
+
+var _ ent.Mutation = (*PushMessageMutation)(nil)
+
+// pushmessageOption allows management of the mutation configuration using functional options.
+type pushmessageOption func(*PushMessageMutation)
+
+// newPushMessageMutation creates new mutation for the PushMessage entity.
+func newPushMessageMutation(c config, op Op, opts ...pushmessageOption) *PushMessageMutation {
+	m := &PushMessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePushMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPushMessageID sets the ID field of the mutation.
+func withPushMessageID(id uuid.UUID) pushmessageOption {
+	return func(m *PushMessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PushMessage
+		)
+		m.oldValue = func(ctx context.Context) (*PushMessage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PushMessage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPushMessage sets the old PushMessage of the mutation.
+func withPushMessage(node *PushMessage) pushmessageOption {
+	return func(m *PushMessageMutation) {
+		m.oldValue = func(context.Context) (*PushMessage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PushMessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PushMessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PushMessage entities.
+func (m *PushMessageMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PushMessageMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PushMessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PushMessage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PushMessageMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PushMessageMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the PushMessage entity.
+// If the PushMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PushMessageMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PushMessageMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetNotificationID sets the "notification_id" field.
+func (m *PushMessageMutation) SetNotificationID(u uuid.UUID) {
+	m.notification_id = &u
+}
+
+// NotificationID returns the value of the "notification_id" field in the mutation.
+func (m *PushMessageMutation) NotificationID() (r uuid.UUID, exists bool) {
+	v := m.notification_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotificationID returns the old "notification_id" field's value of the PushMessage entity.
+// If the PushMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PushMessageMutation) OldNotificationID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotificationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotificationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotificationID: %w", err)
+	}
+	return oldValue.NotificationID, nil
+}
+
+// ResetNotificationID resets all changes to the "notification_id" field.
+func (m *PushMessageMutation) ResetNotificationID() {
+	m.notification_id = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *PushMessageMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *PushMessageMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the PushMessage entity.
+// If the PushMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PushMessageMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *PushMessageMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetBody sets the "body" field.
+func (m *PushMessageMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *PushMessageMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the PushMessage entity.
+// If the PushMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PushMessageMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *PushMessageMutation) ResetBody() {
+	m.body = nil
+}
+
+// SetData sets the "data" field.
+func (m *PushMessageMutation) SetData(value map[string]string) {
+	m.data = &value
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *PushMessageMutation) Data() (r map[string]string, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the PushMessage entity.
+// If the PushMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PushMessageMutation) OldData(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// ClearData clears the value of the "data" field.
+func (m *PushMessageMutation) ClearData() {
+	m.data = nil
+	m.clearedFields[pushmessage.FieldData] = struct{}{}
+}
+
+// DataCleared returns if the "data" field was cleared in this mutation.
+func (m *PushMessageMutation) DataCleared() bool {
+	_, ok := m.clearedFields[pushmessage.FieldData]
+	return ok
+}
+
+// ResetData resets all changes to the "data" field.
+func (m *PushMessageMutation) ResetData() {
+	m.data = nil
+	delete(m.clearedFields, pushmessage.FieldData)
+}
+
+// SetStatus sets the "status" field.
+func (m *PushMessageMutation) SetStatus(pu pushmessage.Status) {
+	m.status = &pu
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *PushMessageMutation) Status() (r pushmessage.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the PushMessage entity.
+// If the PushMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PushMessageMutation) OldStatus(ctx context.Context) (v pushmessage.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *PushMessageMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *PushMessageMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *PushMessageMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the PushMessage entity.
+// If the PushMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PushMessageMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *PushMessageMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *PushMessageMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *PushMessageMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (m *PushMessageMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
+}
+
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *PushMessageMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedUntil returns the old "locked_until" field's value of the PushMessage entity.
+// If the PushMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PushMessageMutation) OldLockedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
+	}
+	return oldValue.LockedUntil, nil
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (m *PushMessageMutation) ClearLockedUntil() {
+	m.locked_until = nil
+	m.clearedFields[pushmessage.FieldLockedUntil] = struct{}{}
+}
+
+// LockedUntilCleared returns if the "locked_until" field was cleared in this mutation.
+func (m *PushMessageMutation) LockedUntilCleared() bool {
+	_, ok := m.clearedFields[pushmessage.FieldLockedUntil]
+	return ok
+}
+
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *PushMessageMutation) ResetLockedUntil() {
+	m.locked_until = nil
+	delete(m.clearedFields, pushmessage.FieldLockedUntil)
+}
+
+// SetLastError sets the "last_error" field.
+func (m *PushMessageMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *PushMessageMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the PushMessage entity.
+// If the PushMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PushMessageMutation) OldLastError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *PushMessageMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[pushmessage.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *PushMessageMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[pushmessage.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *PushMessageMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, pushmessage.FieldLastError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PushMessageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PushMessageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PushMessage entity.
+// If the PushMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PushMessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PushMessageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PushMessageMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PushMessageMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PushMessage entity.
+// If the PushMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PushMessageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PushMessageMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the PushMessageMutation builder.
+func (m *PushMessageMutation) Where(ps ...predicate.PushMessage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PushMessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PushMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PushMessage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PushMessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PushMessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PushMessage).
+func (m *PushMessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PushMessageMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.user_id != nil {
+		fields = append(fields, pushmessage.FieldUserID)
+	}
+	if m.notification_id != nil {
+		fields = append(fields, pushmessage.FieldNotificationID)
+	}
+	if m.title != nil {
+		fields = append(fields, pushmessage.FieldTitle)
+	}
+	if m.body != nil {
+		fields = append(fields, pushmessage.FieldBody)
+	}
+	if m.data != nil {
+		fields = append(fields, pushmessage.FieldData)
+	}
+	if m.status != nil {
+		fields = append(fields, pushmessage.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, pushmessage.FieldAttempts)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, pushmessage.FieldLockedUntil)
+	}
+	if m.last_error != nil {
+		fields = append(fields, pushmessage.FieldLastError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, pushmessage.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, pushmessage.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PushMessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pushmessage.FieldUserID:
+		return m.UserID()
+	case pushmessage.FieldNotificationID:
+		return m.NotificationID()
+	case pushmessage.FieldTitle:
+		return m.Title()
+	case pushmessage.FieldBody:
+		return m.Body()
+	case pushmessage.FieldData:
+		return m.Data()
+	case pushmessage.FieldStatus:
+		return m.Status()
+	case pushmessage.FieldAttempts:
+		return m.Attempts()
+	case pushmessage.FieldLockedUntil:
+		return m.LockedUntil()
+	case pushmessage.FieldLastError:
+		return m.LastError()
+	case pushmessage.FieldCreatedAt:
+		return m.CreatedAt()
+	case pushmessage.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PushMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pushmessage.FieldUserID:
+		return m.OldUserID(ctx)
+	case pushmessage.FieldNotificationID:
+		return m.OldNotificationID(ctx)
+	case pushmessage.FieldTitle:
+		return m.OldTitle(ctx)
+	case pushmessage.FieldBody:
+		return m.OldBody(ctx)
+	case pushmessage.FieldData:
+		return m.OldData(ctx)
+	case pushmessage.FieldStatus:
+		return m.OldStatus(ctx)
+	case pushmessage.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case pushmessage.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
+	case pushmessage.FieldLastError:
+		return m.OldLastError(ctx)
+	case pushmessage.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case pushmessage.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PushMessage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PushMessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pushmessage.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case pushmessage.FieldNotificationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotificationID(v)
+		return nil
+	case pushmessage.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case pushmessage.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case pushmessage.FieldData:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	case pushmessage.FieldStatus:
+		v, ok := value.(pushmessage.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case pushmessage.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case pushmessage.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
+	case pushmessage.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case pushmessage.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case pushmessage.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PushMessage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PushMessageMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, pushmessage.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PushMessageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case pushmessage.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PushMessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case pushmessage.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PushMessage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PushMessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(pushmessage.FieldData) {
+		fields = append(fields, pushmessage.FieldData)
+	}
+	if m.FieldCleared(pushmessage.FieldLockedUntil) {
+		fields = append(fields, pushmessage.FieldLockedUntil)
+	}
+	if m.FieldCleared(pushmessage.FieldLastError) {
+		fields = append(fields, pushmessage.FieldLastError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PushMessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PushMessageMutation) ClearField(name string) error {
+	switch name {
+	case pushmessage.FieldData:
+		m.ClearData()
+		return nil
+	case pushmessage.FieldLockedUntil:
+		m.ClearLockedUntil()
+		return nil
+	case pushmessage.FieldLastError:
+		m.ClearLastError()
+		return nil
+	}
+	return fmt.Errorf("unknown PushMessage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PushMessageMutation) ResetField(name string) error {
+	switch name {
+	case pushmessage.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case pushmessage.FieldNotificationID:
+		m.ResetNotificationID()
+		return nil
+	case pushmessage.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case pushmessage.FieldBody:
+		m.ResetBody()
+		return nil
+	case pushmessage.FieldData:
+		m.ResetData()
+		return nil
+	case pushmessage.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case pushmessage.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case pushmessage.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
+	case pushmessage.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case pushmessage.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case pushmessage.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PushMessage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PushMessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PushMessageMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PushMessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PushMessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PushMessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PushMessageMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PushMessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PushMessage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PushMessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PushMessage edge %s", name)
+}
+
+// RegionMutation represents an operation that mutates the Region nodes in the graph.
+type RegionMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	slug                   *string
+	name                   *string
+	kind                   *string
+	hex_count              *int
+	addhex_count           *int
+	standings_refreshed_at *time.Time
+	created_at             *time.Time
+	updated_at             *time.Time
+	clearedFields          map[string]struct{}
+	hexes                  map[uuid.UUID]struct{}
+	removedhexes           map[uuid.UUID]struct{}
+	clearedhexes           bool
+	standings              map[uuid.UUID]struct{}
+	removedstandings       map[uuid.UUID]struct{}
+	clearedstandings       bool
+	done                   bool
+	oldValue               func(context.Context) (*Region, error)
+	predicates             []predicate.Region
+}
+
+var _ ent.Mutation = (*RegionMutation)(nil)
+
+// regionOption allows management of the mutation configuration using functional options.
+type regionOption func(*RegionMutation)
+
+// newRegionMutation creates new mutation for the Region entity.
+func newRegionMutation(c config, op Op, opts ...regionOption) *RegionMutation {
+	m := &RegionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRegion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRegionID sets the ID field of the mutation.
+func withRegionID(id uuid.UUID) regionOption {
+	return func(m *RegionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Region
+		)
+		m.oldValue = func(ctx context.Context) (*Region, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Region.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRegion sets the old Region of the mutation.
+func withRegion(node *Region) regionOption {
+	return func(m *RegionMutation) {
+		m.oldValue = func(context.Context) (*Region, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RegionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RegionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Region entities.
+func (m *RegionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RegionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RegionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Region.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSlug sets the "slug" field.
+func (m *RegionMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *RegionMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the Region entity.
+// If the Region object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegionMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *RegionMutation) ResetSlug() {
+	m.slug = nil
+}
+
+// SetName sets the "name" field.
+func (m *RegionMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RegionMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Region entity.
+// If the Region object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegionMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RegionMutation) ResetName() {
+	m.name = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *RegionMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *RegionMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Region entity.
+// If the Region object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegionMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *RegionMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetHexCount sets the "hex_count" field.
+func (m *RegionMutation) SetHexCount(i int) {
+	m.hex_count = &i
+	m.addhex_count = nil
+}
+
+// HexCount returns the value of the "hex_count" field in the mutation.
+func (m *RegionMutation) HexCount() (r int, exists bool) {
+	v := m.hex_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHexCount returns the old "hex_count" field's value of the Region entity.
+// If the Region object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegionMutation) OldHexCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHexCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHexCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHexCount: %w", err)
+	}
+	return oldValue.HexCount, nil
+}
+
+// AddHexCount adds i to the "hex_count" field.
+func (m *RegionMutation) AddHexCount(i int) {
+	if m.addhex_count != nil {
+		*m.addhex_count += i
+	} else {
+		m.addhex_count = &i
+	}
+}
+
+// AddedHexCount returns the value that was added to the "hex_count" field in this mutation.
+func (m *RegionMutation) AddedHexCount() (r int, exists bool) {
+	v := m.addhex_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHexCount resets all changes to the "hex_count" field.
+func (m *RegionMutation) ResetHexCount() {
+	m.hex_count = nil
+	m.addhex_count = nil
+}
+
+// SetStandingsRefreshedAt sets the "standings_refreshed_at" field.
+func (m *RegionMutation) SetStandingsRefreshedAt(t time.Time) {
+	m.standings_refreshed_at = &t
+}
+
+// StandingsRefreshedAt returns the value of the "standings_refreshed_at" field in the mutation.
+func (m *RegionMutation) StandingsRefreshedAt() (r time.Time, exists bool) {
+	v := m.standings_refreshed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStandingsRefreshedAt returns the old "standings_refreshed_at" field's value of the Region entity.
+// If the Region object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegionMutation) OldStandingsRefreshedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStandingsRefreshedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStandingsRefreshedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStandingsRefreshedAt: %w", err)
+	}
+	return oldValue.StandingsRefreshedAt, nil
+}
+
+// ClearStandingsRefreshedAt clears the value of the "standings_refreshed_at" field.
+func (m *RegionMutation) ClearStandingsRefreshedAt() {
+	m.standings_refreshed_at = nil
+	m.clearedFields[region.FieldStandingsRefreshedAt] = struct{}{}
+}
+
+// StandingsRefreshedAtCleared returns if the "standings_refreshed_at" field was cleared in this mutation.
+func (m *RegionMutation) StandingsRefreshedAtCleared() bool {
+	_, ok := m.clearedFields[region.FieldStandingsRefreshedAt]
+	return ok
+}
+
+// ResetStandingsRefreshedAt resets all changes to the "standings_refreshed_at" field.
+func (m *RegionMutation) ResetStandingsRefreshedAt() {
+	m.standings_refreshed_at = nil
+	delete(m.clearedFields, region.FieldStandingsRefreshedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RegionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RegionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Region entity.
+// If the Region object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RegionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RegionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RegionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Region entity.
+// If the Region object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RegionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddHexIDs adds the "hexes" edge to the RegionHex entity by ids.
+func (m *RegionMutation) AddHexIDs(ids ...uuid.UUID) {
+	if m.hexes == nil {
+		m.hexes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.hexes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHexes clears the "hexes" edge to the RegionHex entity.
+func (m *RegionMutation) ClearHexes() {
+	m.clearedhexes = true
+}
+
+// HexesCleared reports if the "hexes" edge to the RegionHex entity was cleared.
+func (m *RegionMutation) HexesCleared() bool {
+	return m.clearedhexes
+}
+
+// RemoveHexIDs removes the "hexes" edge to the RegionHex entity by IDs.
+func (m *RegionMutation) RemoveHexIDs(ids ...uuid.UUID) {
+	if m.removedhexes == nil {
+		m.removedhexes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.hexes, ids[i])
+		m.removedhexes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHexes returns the removed IDs of the "hexes" edge to the RegionHex entity.
+func (m *RegionMutation) RemovedHexesIDs() (ids []uuid.UUID) {
+	for id := range m.removedhexes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HexesIDs returns the "hexes" edge IDs in the mutation.
+func (m *RegionMutation) HexesIDs() (ids []uuid.UUID) {
+	for id := range m.hexes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHexes resets all changes to the "hexes" edge.
+func (m *RegionMutation) ResetHexes() {
+	m.hexes = nil
+	m.clearedhexes = false
+	m.removedhexes = nil
+}
+
+// AddStandingIDs adds the "standings" edge to the RegionStanding entity by ids.
+func (m *RegionMutation) AddStandingIDs(ids ...uuid.UUID) {
+	if m.standings == nil {
+		m.standings = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.standings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearStandings clears the "standings" edge to the RegionStanding entity.
+func (m *RegionMutation) ClearStandings() {
+	m.clearedstandings = true
+}
+
+// StandingsCleared reports if the "standings" edge to the RegionStanding entity was cleared.
+func (m *RegionMutation) StandingsCleared() bool {
+	return m.clearedstandings
+}
+
+// RemoveStandingIDs removes the "standings" edge to the RegionStanding entity by IDs.
+func (m *RegionMutation) RemoveStandingIDs(ids ...uuid.UUID) {
+	if m.removedstandings == nil {
+		m.removedstandings = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.standings, ids[i])
+		m.removedstandings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedStandings returns the removed IDs of the "standings" edge to the RegionStanding entity.
+func (m *RegionMutation) RemovedStandingsIDs() (ids []uuid.UUID) {
+	for id := range m.removedstandings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// StandingsIDs returns the "standings" edge IDs in the mutation.
+func (m *RegionMutation) StandingsIDs() (ids []uuid.UUID) {
+	for id := range m.standings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetStandings resets all changes to the "standings" edge.
+func (m *RegionMutation) ResetStandings() {
+	m.standings = nil
+	m.clearedstandings = false
+	m.removedstandings = nil
+}
+
+// Where appends a list predicates to the RegionMutation builder.
+func (m *RegionMutation) Where(ps ...predicate.Region) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RegionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RegionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Region, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RegionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RegionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Region).
+func (m *RegionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RegionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.slug != nil {
+		fields = append(fields, region.FieldSlug)
+	}
+	if m.name != nil {
+		fields = append(fields, region.FieldName)
+	}
+	if m.kind != nil {
+		fields = append(fields, region.FieldKind)
+	}
+	if m.hex_count != nil {
+		fields = append(fields, region.FieldHexCount)
+	}
+	if m.standings_refreshed_at != nil {
+		fields = append(fields, region.FieldStandingsRefreshedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, region.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, region.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RegionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case region.FieldSlug:
+		return m.Slug()
+	case region.FieldName:
+		return m.Name()
+	case region.FieldKind:
+		return m.Kind()
+	case region.FieldHexCount:
+		return m.HexCount()
+	case region.FieldStandingsRefreshedAt:
+		return m.StandingsRefreshedAt()
+	case region.FieldCreatedAt:
+		return m.CreatedAt()
+	case region.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RegionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case region.FieldSlug:
+		return m.OldSlug(ctx)
+	case region.FieldName:
+		return m.OldName(ctx)
+	case region.FieldKind:
+		return m.OldKind(ctx)
+	case region.FieldHexCount:
+		return m.OldHexCount(ctx)
+	case region.FieldStandingsRefreshedAt:
+		return m.OldStandingsRefreshedAt(ctx)
+	case region.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case region.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Region field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RegionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case region.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
+	case region.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case region.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case region.FieldHexCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHexCount(v)
+		return nil
+	case region.FieldStandingsRefreshedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStandingsRefreshedAt(v)
+		return nil
+	case region.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case region.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Region field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RegionMutation) AddedFields() []string {
+	var fields []string
+	if m.addhex_count != nil {
+		fields = append(fields, region.FieldHexCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RegionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case region.FieldHexCount:
+		return m.AddedHexCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RegionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case region.FieldHexCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHexCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Region numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RegionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(region.FieldStandingsRefreshedAt) {
+		fields = append(fields, region.FieldStandingsRefreshedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RegionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RegionMutation) ClearField(name string) error {
+	switch name {
+	case region.FieldStandingsRefreshedAt:
+		m.ClearStandingsRefreshedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Region nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RegionMutation) ResetField(name string) error {
+	switch name {
+	case region.FieldSlug:
+		m.ResetSlug()
+		return nil
+	case region.FieldName:
+		m.ResetName()
+		return nil
+	case region.FieldKind:
+		m.ResetKind()
+		return nil
+	case region.FieldHexCount:
+		m.ResetHexCount()
+		return nil
+	case region.FieldStandingsRefreshedAt:
+		m.ResetStandingsRefreshedAt()
+		return nil
+	case region.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case region.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Region field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RegionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.hexes != nil {
+		edges = append(edges, region.EdgeHexes)
+	}
+	if m.standings != nil {
+		edges = append(edges, region.EdgeStandings)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RegionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case region.EdgeHexes:
+		ids := make([]ent.Value, 0, len(m.hexes))
+		for id := range m.hexes {
+			ids = append(ids, id)
+		}
+		return ids
+	case region.EdgeStandings:
+		ids := make([]ent.Value, 0, len(m.standings))
+		for id := range m.standings {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RegionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedhexes != nil {
+		edges = append(edges, region.EdgeHexes)
+	}
+	if m.removedstandings != nil {
+		edges = append(edges, region.EdgeStandings)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RegionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case region.EdgeHexes:
+		ids := make([]ent.Value, 0, len(m.removedhexes))
+		for id := range m.removedhexes {
+			ids = append(ids, id)
+		}
+		return ids
+	case region.EdgeStandings:
+		ids := make([]ent.Value, 0, len(m.removedstandings))
+		for id := range m.removedstandings {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RegionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedhexes {
+		edges = append(edges, region.EdgeHexes)
+	}
+	if m.clearedstandings {
+		edges = append(edges, region.EdgeStandings)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RegionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case region.EdgeHexes:
+		return m.clearedhexes
+	case region.EdgeStandings:
+		return m.clearedstandings
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RegionMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Region unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RegionMutation) ResetEdge(name string) error {
+	switch name {
+	case region.EdgeHexes:
+		m.ResetHexes()
+		return nil
+	case region.EdgeStandings:
+		m.ResetStandings()
+		return nil
+	}
+	return fmt.Errorf("unknown Region edge %s", name)
+}
+
+// RegionHexMutation represents an operation that mutates the RegionHex nodes in the graph.
+type RegionHexMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	h3_index      *string
+	clearedFields map[string]struct{}
+	region        *uuid.UUID
+	clearedregion bool
+	done          bool
+	oldValue      func(context.Context) (*RegionHex, error)
+	predicates    []predicate.RegionHex
+}
+
+var _ ent.Mutation = (*RegionHexMutation)(nil)
+
+// regionhexOption allows management of the mutation configuration using functional options.
+type regionhexOption func(*RegionHexMutation)
+
+// newRegionHexMutation creates new mutation for the RegionHex entity.
+func newRegionHexMutation(c config, op Op, opts ...regionhexOption) *RegionHexMutation {
+	m := &RegionHexMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRegionHex,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRegionHexID sets the ID field of the mutation.
+func withRegionHexID(id uuid.UUID) regionhexOption {
+	return func(m *RegionHexMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RegionHex
+		)
+		m.oldValue = func(ctx context.Context) (*RegionHex, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RegionHex.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRegionHex sets the old RegionHex of the mutation.
+func withRegionHex(node *RegionHex) regionhexOption {
+	return func(m *RegionHexMutation) {
+		m.oldValue = func(context.Context) (*RegionHex, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RegionHexMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RegionHexMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RegionHex entities.
+func (m *RegionHexMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RegionHexMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RegionHexMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RegionHex.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRegionID sets the "region_id" field.
+func (m *RegionHexMutation) SetRegionID(u uuid.UUID) {
+	m.region = &u
+}
+
+// RegionID returns the value of the "region_id" field in the mutation.
+func (m *RegionHexMutation) RegionID() (r uuid.UUID, exists bool) {
+	v := m.region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegionID returns the old "region_id" field's value of the RegionHex entity.
+// If the RegionHex object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegionHexMutation) OldRegionID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegionID: %w", err)
+	}
+	return oldValue.RegionID, nil
+}
+
+// ResetRegionID resets all changes to the "region_id" field.
+func (m *RegionHexMutation) ResetRegionID() {
+	m.region = nil
+}
+
+// SetH3Index sets the "h3_index" field.
+func (m *RegionHexMutation) SetH3Index(s string) {
+	m.h3_index = &s
+}
+
+// H3Index returns the value of the "h3_index" field in the mutation.
+func (m *RegionHexMutation) H3Index() (r string, exists bool) {
+	v := m.h3_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldH3Index returns the old "h3_index" field's value of the RegionHex entity.
+// If the RegionHex object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegionHexMutation) OldH3Index(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldH3Index is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldH3Index requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldH3Index: %w", err)
+	}
+	return oldValue.H3Index, nil
+}
+
+// ResetH3Index resets all changes to the "h3_index" field.
+func (m *RegionHexMutation) ResetH3Index() {
+	m.h3_index = nil
+}
+
+// ClearRegion clears the "region" edge to the Region entity.
+func (m *RegionHexMutation) ClearRegion() {
+	m.clearedregion = true
+	m.clearedFields[regionhex.FieldRegionID] = struct{}{}
+}
+
+// RegionCleared reports if the "region" edge to the Region entity was cleared.
+func (m *RegionHexMutation) RegionCleared() bool {
+	return m.clearedregion
+}
+
+// RegionIDs returns the "region" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RegionID instead. It exists only for internal usage by the builders.
+func (m *RegionHexMutation) RegionIDs() (ids []uuid.UUID) {
+	if id := m.region; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRegion resets all changes to the "region" edge.
+func (m *RegionHexMutation) ResetRegion() {
+	m.region = nil
+	m.clearedregion = false
+}
+
+// Where appends a list predicates to the RegionHexMutation builder.
+func (m *RegionHexMutation) Where(ps ...predicate.RegionHex) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RegionHexMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RegionHexMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RegionHex, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RegionHexMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RegionHexMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RegionHex).
+func (m *RegionHexMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RegionHexMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.region != nil {
+		fields = append(fields, regionhex.FieldRegionID)
+	}
+	if m.h3_index != nil {
+		fields = append(fields, regionhex.FieldH3Index)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RegionHexMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case regionhex.FieldRegionID:
+		return m.RegionID()
+	case regionhex.FieldH3Index:
+		return m.H3Index()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RegionHexMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case regionhex.FieldRegionID:
+		return m.OldRegionID(ctx)
+	case regionhex.FieldH3Index:
+		return m.OldH3Index(ctx)
+	}
+	return nil, fmt.Errorf("unknown RegionHex field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RegionHexMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case regionhex.FieldRegionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegionID(v)
+		return nil
+	case regionhex.FieldH3Index:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetH3Index(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RegionHex field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RegionHexMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RegionHexMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RegionHexMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RegionHex numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RegionHexMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RegionHexMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RegionHexMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RegionHex nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RegionHexMutation) ResetField(name string) error {
+	switch name {
+	case regionhex.FieldRegionID:
+		m.ResetRegionID()
+		return nil
+	case regionhex.FieldH3Index:
+		m.ResetH3Index()
+		return nil
+	}
+	return fmt.Errorf("unknown RegionHex field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RegionHexMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.region != nil {
+		edges = append(edges, regionhex.EdgeRegion)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RegionHexMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case regionhex.EdgeRegion:
+		if id := m.region; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RegionHexMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RegionHexMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RegionHexMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedregion {
+		edges = append(edges, regionhex.EdgeRegion)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RegionHexMutation) EdgeCleared(name string) bool {
+	switch name {
+	case regionhex.EdgeRegion:
+		return m.clearedregion
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RegionHexMutation) ClearEdge(name string) error {
+	switch name {
+	case regionhex.EdgeRegion:
+		m.ClearRegion()
+		return nil
+	}
+	return fmt.Errorf("unknown RegionHex unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RegionHexMutation) ResetEdge(name string) error {
+	switch name {
+	case regionhex.EdgeRegion:
+		m.ResetRegion()
+		return nil
+	}
+	return fmt.Errorf("unknown RegionHex edge %s", name)
+}
+
+// RegionStandingMutation represents an operation that mutates the RegionStanding nodes in the graph.
+type RegionStandingMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	rank               *int
+	addrank            *int
+	user_id            *uuid.UUID
+	username           *string
+	hexes_owned        *int
+	addhexes_owned     *int
+	total_influence    *float64
+	addtotal_influence *float64
+	clearedFields      map[string]struct{}
+	region             *uuid.UUID
+	clearedregion      bool
+	done               bool
+	oldValue           func(context.Context) (*RegionStanding, error)
+	predicates         []predicate.RegionStanding
+}
+
+var _ ent.Mutation = (*RegionStandingMutation)(nil)
+
+// regionstandingOption allows management of the mutation configuration using functional options.
+type regionstandingOption func(*RegionStandingMutation)
+
+// newRegionStandingMutation creates new mutation for the RegionStanding entity.
+func newRegionStandingMutation(c config, op Op, opts ...regionstandingOption) *RegionStandingMutation {
+	m := &RegionStandingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRegionStanding,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRegionStandingID sets the ID field of the mutation.
+func withRegionStandingID(id uuid.UUID) regionstandingOption {
+	return func(m *RegionStandingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RegionStanding
+		)
+		m.oldValue = func(ctx context.Context) (*RegionStanding, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RegionStanding.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRegionStanding sets the old RegionStanding of the mutation.
+func withRegionStanding(node *RegionStanding) regionstandingOption {
+	return func(m *RegionStandingMutation) {
+		m.oldValue = func(context.Context) (*RegionStanding, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RegionStandingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RegionStandingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RegionStanding entities.
+func (m *RegionStandingMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RegionStandingMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RegionStandingMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RegionStanding.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRegionID sets the "region_id" field.
+func (m *RegionStandingMutation) SetRegionID(u uuid.UUID) {
+	m.region = &u
+}
+
+// RegionID returns the value of the "region_id" field in the mutation.
+func (m *RegionStandingMutation) RegionID() (r uuid.UUID, exists bool) {
+	v := m.region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegionID returns the old "region_id" field's value of the RegionStanding entity.
+// If the RegionStanding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegionStandingMutation) OldRegionID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegionID: %w", err)
+	}
+	return oldValue.RegionID, nil
+}
+
+// ResetRegionID resets all changes to the "region_id" field.
+func (m *RegionStandingMutation) ResetRegionID() {
+	m.region = nil
+}
+
+// SetRank sets the "rank" field.
+func (m *RegionStandingMutation) SetRank(i int) {
+	m.rank = &i
+	m.addrank = nil
+}
+
+// Rank returns the value of the "rank" field in the mutation.
+func (m *RegionStandingMutation) Rank() (r int, exists bool) {
+	v := m.rank
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRank returns the old "rank" field's value of the RegionStanding entity.
+// If the RegionStanding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegionStandingMutation) OldRank(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRank is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRank requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRank: %w", err)
+	}
+	return oldValue.Rank, nil
+}
+
+// AddRank adds i to the "rank" field.
+func (m *RegionStandingMutation) AddRank(i int) {
+	if m.addrank != nil {
+		*m.addrank += i
+	} else {
+		m.addrank = &i
+	}
+}
+
+// AddedRank returns the value that was added to the "rank" field in this mutation.
+func (m *RegionStandingMutation) AddedRank() (r int, exists bool) {
+	v := m.addrank
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRank resets all changes to the "rank" field.
+func (m *RegionStandingMutation) ResetRank() {
+	m.rank = nil
+	m.addrank = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RegionStandingMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RegionStandingMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RegionStanding entity.
+// If the RegionStanding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegionStandingMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RegionStandingMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *RegionStandingMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *RegionStandingMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the RegionStanding entity.
+// If the RegionStanding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegionStandingMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *RegionStandingMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetHexesOwned sets the "hexes_owned" field.
+func (m *RegionStandingMutation) SetHexesOwned(i int) {
+	m.hexes_owned = &i
+	m.addhexes_owned = nil
+}
+
+// HexesOwned returns the value of the "hexes_owned" field in the mutation.
+func (m *RegionStandingMutation) HexesOwned() (r int, exists bool) {
+	v := m.hexes_owned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHexesOwned returns the old "hexes_owned" field's value of the RegionStanding entity.
+// If the RegionStanding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegionStandingMutation) OldHexesOwned(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHexesOwned is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHexesOwned requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHexesOwned: %w", err)
+	}
+	return oldValue.HexesOwned, nil
+}
+
+// AddHexesOwned adds i to the "hexes_owned" field.
+func (m *RegionStandingMutation) AddHexesOwned(i int) {
+	if m.addhexes_owned != nil {
+		*m.addhexes_owned += i
+	} else {
+		m.addhexes_owned = &i
+	}
+}
+
+// AddedHexesOwned returns the value that was added to the "hexes_owned" field in this mutation.
+func (m *RegionStandingMutation) AddedHexesOwned() (r int, exists bool) {
+	v := m.addhexes_owned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHexesOwned resets all changes to the "hexes_owned" field.
+func (m *RegionStandingMutation) ResetHexesOwned() {
+	m.hexes_owned = nil
+	m.addhexes_owned = nil
+}
+
+// SetTotalInfluence sets the "total_influence" field.
+func (m *RegionStandingMutation) SetTotalInfluence(f float64) {
+	m.total_influence = &f
+	m.addtotal_influence = nil
+}
+
+// TotalInfluence returns the value of the "total_influence" field in the mutation.
+func (m *RegionStandingMutation) TotalInfluence() (r float64, exists bool) {
+	v := m.total_influence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalInfluence returns the old "total_influence" field's value of the RegionStanding entity.
+// If the RegionStanding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegionStandingMutation) OldTotalInfluence(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalInfluence is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalInfluence requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalInfluence: %w", err)
+	}
+	return oldValue.TotalInfluence, nil
+}
+
+// AddTotalInfluence adds f to the "total_influence" field.
+func (m *RegionStandingMutation) AddTotalInfluence(f float64) {
+	if m.addtotal_influence != nil {
+		*m.addtotal_influence += f
+	} else {
+		m.addtotal_influence = &f
+	}
+}
+
+// AddedTotalInfluence returns the value that was added to the "total_influence" field in this mutation.
+func (m *RegionStandingMutation) AddedTotalInfluence() (r float64, exists bool) {
+	v := m.addtotal_influence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalInfluence resets all changes to the "total_influence" field.
+func (m *RegionStandingMutation) ResetTotalInfluence() {
+	m.total_influence = nil
+	m.addtotal_influence = nil
+}
+
+// ClearRegion clears the "region" edge to the Region entity.
+func (m *RegionStandingMutation) ClearRegion() {
+	m.clearedregion = true
+	m.clearedFields[regionstanding.FieldRegionID] = struct{}{}
+}
+
+// RegionCleared reports if the "region" edge to the Region entity was cleared.
+func (m *RegionStandingMutation) RegionCleared() bool {
+	return m.clearedregion
+}
+
+// RegionIDs returns the "region" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RegionID instead. It exists only for internal usage by the builders.
+func (m *RegionStandingMutation) RegionIDs() (ids []uuid.UUID) {
+	if id := m.region; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRegion resets all changes to the "region" edge.
+func (m *RegionStandingMutation) ResetRegion() {
+	m.region = nil
+	m.clearedregion = false
+}
+
+// Where appends a list predicates to the RegionStandingMutation builder.
+func (m *RegionStandingMutation) Where(ps ...predicate.RegionStanding) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RegionStandingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RegionStandingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RegionStanding, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RegionStandingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RegionStandingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RegionStanding).
+func (m *RegionStandingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RegionStandingMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.region != nil {
+		fields = append(fields, regionstanding.FieldRegionID)
+	}
+	if m.rank != nil {
+		fields = append(fields, regionstanding.FieldRank)
+	}
+	if m.user_id != nil {
+		fields = append(fields, regionstanding.FieldUserID)
+	}
+	if m.username != nil {
+		fields = append(fields, regionstanding.FieldUsername)
+	}
+	if m.hexes_owned != nil {
+		fields = append(fields, regionstanding.FieldHexesOwned)
+	}
+	if m.total_influence != nil {
+		fields = append(fields, regionstanding.FieldTotalInfluence)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RegionStandingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case regionstanding.FieldRegionID:
+		return m.RegionID()
+	case regionstanding.FieldRank:
+		return m.Rank()
+	case regionstanding.FieldUserID:
+		return m.UserID()
+	case regionstanding.FieldUsername:
+		return m.Username()
+	case regionstanding.FieldHexesOwned:
+		return m.HexesOwned()
+	case regionstanding.FieldTotalInfluence:
+		return m.TotalInfluence()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RegionStandingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case regionstanding.FieldRegionID:
+		return m.OldRegionID(ctx)
+	case regionstanding.FieldRank:
+		return m.OldRank(ctx)
+	case regionstanding.FieldUserID:
+		return m.OldUserID(ctx)
+	case regionstanding.FieldUsername:
+		return m.OldUsername(ctx)
+	case regionstanding.FieldHexesOwned:
+		return m.OldHexesOwned(ctx)
+	case regionstanding.FieldTotalInfluence:
+		return m.OldTotalInfluence(ctx)
+	}
+	return nil, fmt.Errorf("unknown RegionStanding field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RegionStandingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case regionstanding.FieldRegionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegionID(v)
+		return nil
+	case regionstanding.FieldRank:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRank(v)
+		return nil
+	case regionstanding.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case regionstanding.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case regionstanding.FieldHexesOwned:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHexesOwned(v)
+		return nil
+	case regionstanding.FieldTotalInfluence:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalInfluence(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RegionStanding field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RegionStandingMutation) AddedFields() []string {
+	var fields []string
+	if m.addrank != nil {
+		fields = append(fields, regionstanding.FieldRank)
+	}
+	if m.addhexes_owned != nil {
+		fields = append(fields, regionstanding.FieldHexesOwned)
+	}
+	if m.addtotal_influence != nil {
+		fields = append(fields, regionstanding.FieldTotalInfluence)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RegionStandingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case regionstanding.FieldRank:
+		return m.AddedRank()
+	case regionstanding.FieldHexesOwned:
+		return m.AddedHexesOwned()
+	case regionstanding.FieldTotalInfluence:
+		return m.AddedTotalInfluence()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RegionStandingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case regionstanding.FieldRank:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRank(v)
+		return nil
+	case regionstanding.FieldHexesOwned:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHexesOwned(v)
+		return nil
+	case regionstanding.FieldTotalInfluence:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalInfluence(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RegionStanding numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RegionStandingMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RegionStandingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RegionStandingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RegionStanding nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RegionStandingMutation) ResetField(name string) error {
+	switch name {
+	case regionstanding.FieldRegionID:
+		m.ResetRegionID()
+		return nil
+	case regionstanding.FieldRank:
+		m.ResetRank()
+		return nil
+	case regionstanding.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case regionstanding.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case regionstanding.FieldHexesOwned:
+		m.ResetHexesOwned()
+		return nil
+	case regionstanding.FieldTotalInfluence:
+		m.ResetTotalInfluence()
+		return nil
+	}
+	return fmt.Errorf("unknown RegionStanding field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RegionStandingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.region != nil {
+		edges = append(edges, regionstanding.EdgeRegion)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RegionStandingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case regionstanding.EdgeRegion:
+		if id := m.region; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RegionStandingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RegionStandingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RegionStandingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedregion {
+		edges = append(edges, regionstanding.EdgeRegion)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RegionStandingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case regionstanding.EdgeRegion:
+		return m.clearedregion
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RegionStandingMutation) ClearEdge(name string) error {
+	switch name {
+	case regionstanding.EdgeRegion:
+		m.ClearRegion()
+		return nil
+	}
+	return fmt.Errorf("unknown RegionStanding unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RegionStandingMutation) ResetEdge(name string) error {
+	switch name {
+	case regionstanding.EdgeRegion:
+		m.ResetRegion()
+		return nil
+	}
+	return fmt.Errorf("unknown RegionStanding edge %s", name)
+}
+
+// SeasonMutation represents an operation that mutates the Season nodes in the graph.
+type SeasonMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	number        *int
+	addnumber     *int
+	name          *string
+	starts_at     *time.Time
+	ends_at       *time.Time
+	carry_over    *float64
+	addcarry_over *float64
+	status        *season.Status
+	archived_at   *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Season, error)
+	predicates    []predicate.Season
+}
+
+var _ ent.Mutation = (*SeasonMutation)(nil)
+
+// seasonOption allows management of the mutation configuration using functional options.
+type seasonOption func(*SeasonMutation)
+
+// newSeasonMutation creates new mutation for the Season entity.
+func newSeasonMutation(c config, op Op, opts ...seasonOption) *SeasonMutation {
+	m := &SeasonMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSeason,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSeasonID sets the ID field of the mutation.
+func withSeasonID(id uuid.UUID) seasonOption {
+	return func(m *SeasonMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Season
+		)
+		m.oldValue = func(ctx context.Context) (*Season, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Season.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSeason sets the old Season of the mutation.
+func withSeason(node *Season) seasonOption {
+	return func(m *SeasonMutation) {
+		m.oldValue = func(context.Context) (*Season, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SeasonMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SeasonMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Season entities.
+func (m *SeasonMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SeasonMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SeasonMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Season.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetNumber sets the "number" field.
+func (m *SeasonMutation) SetNumber(i int) {
+	m.number = &i
+	m.addnumber = nil
+}
+
+// Number returns the value of the "number" field in the mutation.
+func (m *SeasonMutation) Number() (r int, exists bool) {
+	v := m.number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNumber returns the old "number" field's value of the Season entity.
+// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonMutation) OldNumber(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumber: %w", err)
+	}
+	return oldValue.Number, nil
+}
+
+// AddNumber adds i to the "number" field.
+func (m *SeasonMutation) AddNumber(i int) {
+	if m.addnumber != nil {
+		*m.addnumber += i
+	} else {
+		m.addnumber = &i
+	}
+}
+
+// AddedNumber returns the value that was added to the "number" field in this mutation.
+func (m *SeasonMutation) AddedNumber() (r int, exists bool) {
+	v := m.addnumber
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNumber resets all changes to the "number" field.
+func (m *SeasonMutation) ResetNumber() {
+	m.number = nil
+	m.addnumber = nil
+}
+
+// SetName sets the "name" field.
+func (m *SeasonMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SeasonMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Season entity.
+// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SeasonMutation) ResetName() {
+	m.name = nil
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (m *SeasonMutation) SetStartsAt(t time.Time) {
+	m.starts_at = &t
+}
+
+// StartsAt returns the value of the "starts_at" field in the mutation.
+func (m *SeasonMutation) StartsAt() (r time.Time, exists bool) {
+	v := m.starts_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartsAt returns the old "starts_at" field's value of the Season entity.
+// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonMutation) OldStartsAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartsAt: %w", err)
+	}
+	return oldValue.StartsAt, nil
+}
+
+// ResetStartsAt resets all changes to the "starts_at" field.
+func (m *SeasonMutation) ResetStartsAt() {
+	m.starts_at = nil
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (m *SeasonMutation) SetEndsAt(t time.Time) {
+	m.ends_at = &t
+}
+
+// EndsAt returns the value of the "ends_at" field in the mutation.
+func (m *SeasonMutation) EndsAt() (r time.Time, exists bool) {
+	v := m.ends_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndsAt returns the old "ends_at" field's value of the Season entity.
+// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonMutation) OldEndsAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndsAt: %w", err)
+	}
+	return oldValue.EndsAt, nil
+}
+
+// ResetEndsAt resets all changes to the "ends_at" field.
+func (m *SeasonMutation) ResetEndsAt() {
+	m.ends_at = nil
+}
+
+// SetCarryOver sets the "carry_over" field.
+func (m *SeasonMutation) SetCarryOver(f float64) {
+	m.carry_over = &f
+	m.addcarry_over = nil
+}
+
+// CarryOver returns the value of the "carry_over" field in the mutation.
+func (m *SeasonMutation) CarryOver() (r float64, exists bool) {
+	v := m.carry_over
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarryOver returns the old "carry_over" field's value of the Season entity.
+// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonMutation) OldCarryOver(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarryOver is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarryOver requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarryOver: %w", err)
+	}
+	return oldValue.CarryOver, nil
+}
+
+// AddCarryOver adds f to the "carry_over" field.
+func (m *SeasonMutation) AddCarryOver(f float64) {
+	if m.addcarry_over != nil {
+		*m.addcarry_over += f
+	} else {
+		m.addcarry_over = &f
+	}
+}
+
+// AddedCarryOver returns the value that was added to the "carry_over" field in this mutation.
+func (m *SeasonMutation) AddedCarryOver() (r float64, exists bool) {
+	v := m.addcarry_over
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCarryOver resets all changes to the "carry_over" field.
+func (m *SeasonMutation) ResetCarryOver() {
+	m.carry_over = nil
+	m.addcarry_over = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *SeasonMutation) SetStatus(s season.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SeasonMutation) Status() (r season.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Season entity.
+// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonMutation) OldStatus(ctx context.Context) (v season.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SeasonMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (m *SeasonMutation) SetArchivedAt(t time.Time) {
+	m.archived_at = &t
+}
+
+// ArchivedAt returns the value of the "archived_at" field in the mutation.
+func (m *SeasonMutation) ArchivedAt() (r time.Time, exists bool) {
+	v := m.archived_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchivedAt returns the old "archived_at" field's value of the Season entity.
+// If the Season object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonMutation) OldArchivedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchivedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchivedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchivedAt: %w", err)
+	}
+	return oldValue.ArchivedAt, nil
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (m *SeasonMutation) ClearArchivedAt() {
+	m.archived_at = nil
+	m.clearedFields[season.FieldArchivedAt] = struct{}{}
+}
+
+// ArchivedAtCleared returns if the "archived_at" field was cleared in this mutation.
+func (m *SeasonMutation) ArchivedAtCleared() bool {
+	_, ok := m.clearedFields[season.FieldArchivedAt]
+	return ok
+}
+
+// ResetArchivedAt resets all changes to the "archived_at" field.
+func (m *SeasonMutation) ResetArchivedAt() {
+	m.archived_at = nil
+	delete(m.clearedFields, season.FieldArchivedAt)
+}
+
+// Where appends a list predicates to the SeasonMutation builder.
+func (m *SeasonMutation) Where(ps ...predicate.Season) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SeasonMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SeasonMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Season, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SeasonMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SeasonMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Season).
+func (m *SeasonMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SeasonMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.number != nil {
+		fields = append(fields, season.FieldNumber)
+	}
+	if m.name != nil {
+		fields = append(fields, season.FieldName)
+	}
+	if m.starts_at != nil {
+		fields = append(fields, season.FieldStartsAt)
+	}
+	if m.ends_at != nil {
+		fields = append(fields, season.FieldEndsAt)
+	}
+	if m.carry_over != nil {
+		fields = append(fields, season.FieldCarryOver)
+	}
+	if m.status != nil {
+		fields = append(fields, season.FieldStatus)
+	}
+	if m.archived_at != nil {
+		fields = append(fields, season.FieldArchivedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SeasonMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case season.FieldNumber:
+		return m.Number()
+	case season.FieldName:
+		return m.Name()
+	case season.FieldStartsAt:
+		return m.StartsAt()
+	case season.FieldEndsAt:
+		return m.EndsAt()
+	case season.FieldCarryOver:
+		return m.CarryOver()
+	case season.FieldStatus:
+		return m.Status()
+	case season.FieldArchivedAt:
+		return m.ArchivedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SeasonMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case season.FieldNumber:
+		return m.OldNumber(ctx)
+	case season.FieldName:
+		return m.OldName(ctx)
+	case season.FieldStartsAt:
+		return m.OldStartsAt(ctx)
+	case season.FieldEndsAt:
+		return m.OldEndsAt(ctx)
+	case season.FieldCarryOver:
+		return m.OldCarryOver(ctx)
+	case season.FieldStatus:
+		return m.OldStatus(ctx)
+	case season.FieldArchivedAt:
+		return m.OldArchivedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Season field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SeasonMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case season.FieldNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumber(v)
+		return nil
+	case season.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case season.FieldStartsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartsAt(v)
+		return nil
+	case season.FieldEndsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndsAt(v)
+		return nil
+	case season.FieldCarryOver:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarryOver(v)
+		return nil
+	case season.FieldStatus:
+		v, ok := value.(season.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case season.FieldArchivedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchivedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Season field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SeasonMutation) AddedFields() []string {
+	var fields []string
+	if m.addnumber != nil {
+		fields = append(fields, season.FieldNumber)
+	}
+	if m.addcarry_over != nil {
+		fields = append(fields, season.FieldCarryOver)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SeasonMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case season.FieldNumber:
+		return m.AddedNumber()
+	case season.FieldCarryOver:
+		return m.AddedCarryOver()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SeasonMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case season.FieldNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNumber(v)
+		return nil
+	case season.FieldCarryOver:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCarryOver(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Season numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SeasonMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(season.FieldArchivedAt) {
+		fields = append(fields, season.FieldArchivedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SeasonMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SeasonMutation) ClearField(name string) error {
+	switch name {
+	case season.FieldArchivedAt:
+		m.ClearArchivedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Season nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SeasonMutation) ResetField(name string) error {
+	switch name {
+	case season.FieldNumber:
+		m.ResetNumber()
+		return nil
+	case season.FieldName:
+		m.ResetName()
+		return nil
+	case season.FieldStartsAt:
+		m.ResetStartsAt()
+		return nil
+	case season.FieldEndsAt:
+		m.ResetEndsAt()
+		return nil
+	case season.FieldCarryOver:
+		m.ResetCarryOver()
+		return nil
+	case season.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case season.FieldArchivedAt:
+		m.ResetArchivedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Season field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SeasonMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SeasonMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SeasonMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SeasonMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SeasonMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SeasonMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SeasonMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Season unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SeasonMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Season edge %s", name)
+}
+
+// SeasonHexStandingMutation represents an operation that mutates the SeasonHexStanding nodes in the graph.
+type SeasonHexStandingMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	season_id     *uuid.UUID
+	h3_index      *string
+	rank          *int
+	addrank       *int
+	user_id       *uuid.UUID
+	username      *string
+	score         *float64
+	addscore      *float64
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SeasonHexStanding, error)
+	predicates    []predicate.SeasonHexStanding
+}
+
+var _ ent.Mutation = (*SeasonHexStandingMutation)(nil)
+
+// seasonhexstandingOption allows management of the mutation configuration using functional options.
+type seasonhexstandingOption func(*SeasonHexStandingMutation)
+
+// newSeasonHexStandingMutation creates new mutation for the SeasonHexStanding entity.
+func newSeasonHexStandingMutation(c config, op Op, opts ...seasonhexstandingOption) *SeasonHexStandingMutation {
+	m := &SeasonHexStandingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSeasonHexStanding,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSeasonHexStandingID sets the ID field of the mutation.
+func withSeasonHexStandingID(id uuid.UUID) seasonhexstandingOption {
+	return func(m *SeasonHexStandingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SeasonHexStanding
+		)
+		m.oldValue = func(ctx context.Context) (*SeasonHexStanding, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SeasonHexStanding.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSeasonHexStanding sets the old SeasonHexStanding of the mutation.
+func withSeasonHexStanding(node *SeasonHexStanding) seasonhexstandingOption {
+	return func(m *SeasonHexStandingMutation) {
+		m.oldValue = func(context.Context) (*SeasonHexStanding, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SeasonHexStandingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SeasonHexStandingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SeasonHexStanding entities.
+func (m *SeasonHexStandingMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SeasonHexStandingMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SeasonHexStandingMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SeasonHexStanding.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSeasonID sets the "season_id" field.
+func (m *SeasonHexStandingMutation) SetSeasonID(u uuid.UUID) {
+	m.season_id = &u
+}
+
+// SeasonID returns the value of the "season_id" field in the mutation.
+func (m *SeasonHexStandingMutation) SeasonID() (r uuid.UUID, exists bool) {
+	v := m.season_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeasonID returns the old "season_id" field's value of the SeasonHexStanding entity.
+// If the SeasonHexStanding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonHexStandingMutation) OldSeasonID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeasonID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeasonID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeasonID: %w", err)
+	}
+	return oldValue.SeasonID, nil
+}
+
+// ResetSeasonID resets all changes to the "season_id" field.
+func (m *SeasonHexStandingMutation) ResetSeasonID() {
+	m.season_id = nil
+}
+
+// SetH3Index sets the "h3_index" field.
+func (m *SeasonHexStandingMutation) SetH3Index(s string) {
+	m.h3_index = &s
+}
+
+// H3Index returns the value of the "h3_index" field in the mutation.
+func (m *SeasonHexStandingMutation) H3Index() (r string, exists bool) {
+	v := m.h3_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldH3Index returns the old "h3_index" field's value of the SeasonHexStanding entity.
+// If the SeasonHexStanding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonHexStandingMutation) OldH3Index(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldH3Index is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldH3Index requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldH3Index: %w", err)
+	}
+	return oldValue.H3Index, nil
+}
+
+// ResetH3Index resets all changes to the "h3_index" field.
+func (m *SeasonHexStandingMutation) ResetH3Index() {
+	m.h3_index = nil
+}
+
+// SetRank sets the "rank" field.
+func (m *SeasonHexStandingMutation) SetRank(i int) {
+	m.rank = &i
+	m.addrank = nil
+}
+
+// Rank returns the value of the "rank" field in the mutation.
+func (m *SeasonHexStandingMutation) Rank() (r int, exists bool) {
+	v := m.rank
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRank returns the old "rank" field's value of the SeasonHexStanding entity.
+// If the SeasonHexStanding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonHexStandingMutation) OldRank(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRank is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRank requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRank: %w", err)
+	}
+	return oldValue.Rank, nil
+}
+
+// AddRank adds i to the "rank" field.
+func (m *SeasonHexStandingMutation) AddRank(i int) {
+	if m.addrank != nil {
+		*m.addrank += i
+	} else {
+		m.addrank = &i
+	}
+}
+
+// AddedRank returns the value that was added to the "rank" field in this mutation.
+func (m *SeasonHexStandingMutation) AddedRank() (r int, exists bool) {
+	v := m.addrank
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRank resets all changes to the "rank" field.
+func (m *SeasonHexStandingMutation) ResetRank() {
+	m.rank = nil
+	m.addrank = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SeasonHexStandingMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SeasonHexStandingMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the SeasonHexStanding entity.
+// If the SeasonHexStanding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonHexStandingMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SeasonHexStandingMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *SeasonHexStandingMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *SeasonHexStandingMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the SeasonHexStanding entity.
+// If the SeasonHexStanding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonHexStandingMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *SeasonHexStandingMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetScore sets the "score" field.
+func (m *SeasonHexStandingMutation) SetScore(f float64) {
+	m.score = &f
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *SeasonHexStandingMutation) Score() (r float64, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the SeasonHexStanding entity.
+// If the SeasonHexStanding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonHexStandingMutation) OldScore(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds f to the "score" field.
+func (m *SeasonHexStandingMutation) AddScore(f float64) {
+	if m.addscore != nil {
+		*m.addscore += f
+	} else {
+		m.addscore = &f
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *SeasonHexStandingMutation) AddedScore() (r float64, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *SeasonHexStandingMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+}
+
+// Where appends a list predicates to the SeasonHexStandingMutation builder.
+func (m *SeasonHexStandingMutation) Where(ps ...predicate.SeasonHexStanding) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SeasonHexStandingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SeasonHexStandingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SeasonHexStanding, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SeasonHexStandingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SeasonHexStandingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SeasonHexStanding).
+func (m *SeasonHexStandingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SeasonHexStandingMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.season_id != nil {
+		fields = append(fields, seasonhexstanding.FieldSeasonID)
+	}
+	if m.h3_index != nil {
+		fields = append(fields, seasonhexstanding.FieldH3Index)
+	}
+	if m.rank != nil {
+		fields = append(fields, seasonhexstanding.FieldRank)
+	}
+	if m.user_id != nil {
+		fields = append(fields, seasonhexstanding.FieldUserID)
+	}
+	if m.username != nil {
+		fields = append(fields, seasonhexstanding.FieldUsername)
+	}
+	if m.score != nil {
+		fields = append(fields, seasonhexstanding.FieldScore)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SeasonHexStandingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case seasonhexstanding.FieldSeasonID:
+		return m.SeasonID()
+	case seasonhexstanding.FieldH3Index:
+		return m.H3Index()
+	case seasonhexstanding.FieldRank:
+		return m.Rank()
+	case seasonhexstanding.FieldUserID:
+		return m.UserID()
+	case seasonhexstanding.FieldUsername:
+		return m.Username()
+	case seasonhexstanding.FieldScore:
+		return m.Score()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SeasonHexStandingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case seasonhexstanding.FieldSeasonID:
+		return m.OldSeasonID(ctx)
+	case seasonhexstanding.FieldH3Index:
+		return m.OldH3Index(ctx)
+	case seasonhexstanding.FieldRank:
+		return m.OldRank(ctx)
+	case seasonhexstanding.FieldUserID:
+		return m.OldUserID(ctx)
+	case seasonhexstanding.FieldUsername:
+		return m.OldUsername(ctx)
+	case seasonhexstanding.FieldScore:
+		return m.OldScore(ctx)
+	}
+	return nil, fmt.Errorf("unknown SeasonHexStanding field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SeasonHexStandingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case seasonhexstanding.FieldSeasonID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeasonID(v)
+		return nil
+	case seasonhexstanding.FieldH3Index:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetH3Index(v)
+		return nil
+	case seasonhexstanding.FieldRank:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRank(v)
+		return nil
+	case seasonhexstanding.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case seasonhexstanding.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case seasonhexstanding.FieldScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SeasonHexStanding field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SeasonHexStandingMutation) AddedFields() []string {
+	var fields []string
+	if m.addrank != nil {
+		fields = append(fields, seasonhexstanding.FieldRank)
+	}
+	if m.addscore != nil {
+		fields = append(fields, seasonhexstanding.FieldScore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SeasonHexStandingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case seasonhexstanding.FieldRank:
+		return m.AddedRank()
+	case seasonhexstanding.FieldScore:
+		return m.AddedScore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SeasonHexStandingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case seasonhexstanding.FieldRank:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRank(v)
+		return nil
+	case seasonhexstanding.FieldScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SeasonHexStanding numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SeasonHexStandingMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SeasonHexStandingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SeasonHexStandingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SeasonHexStanding nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SeasonHexStandingMutation) ResetField(name string) error {
+	switch name {
+	case seasonhexstanding.FieldSeasonID:
+		m.ResetSeasonID()
+		return nil
+	case seasonhexstanding.FieldH3Index:
+		m.ResetH3Index()
+		return nil
+	case seasonhexstanding.FieldRank:
+		m.ResetRank()
+		return nil
+	case seasonhexstanding.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case seasonhexstanding.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case seasonhexstanding.FieldScore:
+		m.ResetScore()
+		return nil
+	}
+	return fmt.Errorf("unknown SeasonHexStanding field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SeasonHexStandingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SeasonHexStandingMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SeasonHexStandingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SeasonHexStandingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SeasonHexStandingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SeasonHexStandingMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SeasonHexStandingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SeasonHexStanding unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SeasonHexStandingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SeasonHexStanding edge %s", name)
+}
+
+// SeasonStandingMutation represents an operation that mutates the SeasonStanding nodes in the graph.
+type SeasonStandingMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	season_id          *uuid.UUID
+	rank               *int
+	addrank            *int
+	user_id            *uuid.UUID
+	username           *string
+	hexes_owned        *int
+	addhexes_owned     *int
+	total_influence    *float64
+	addtotal_influence *float64
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*SeasonStanding, error)
+	predicates         []predicate.SeasonStanding
+}
+
+var _ ent.Mutation = (*SeasonStandingMutation)(nil)
+
+// seasonstandingOption allows management of the mutation configuration using functional options.
+type seasonstandingOption func(*SeasonStandingMutation)
+
+// newSeasonStandingMutation creates new mutation for the SeasonStanding entity.
+func newSeasonStandingMutation(c config, op Op, opts ...seasonstandingOption) *SeasonStandingMutation {
+	m := &SeasonStandingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSeasonStanding,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSeasonStandingID sets the ID field of the mutation.
+func withSeasonStandingID(id uuid.UUID) seasonstandingOption {
+	return func(m *SeasonStandingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SeasonStanding
+		)
+		m.oldValue = func(ctx context.Context) (*SeasonStanding, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SeasonStanding.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSeasonStanding sets the old SeasonStanding of the mutation.
+func withSeasonStanding(node *SeasonStanding) seasonstandingOption {
+	return func(m *SeasonStandingMutation) {
+		m.oldValue = func(context.Context) (*SeasonStanding, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SeasonStandingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SeasonStandingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SeasonStanding entities.
+func (m *SeasonStandingMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SeasonStandingMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SeasonStandingMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SeasonStanding.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSeasonID sets the "season_id" field.
+func (m *SeasonStandingMutation) SetSeasonID(u uuid.UUID) {
+	m.season_id = &u
+}
+
+// SeasonID returns the value of the "season_id" field in the mutation.
+func (m *SeasonStandingMutation) SeasonID() (r uuid.UUID, exists bool) {
+	v := m.season_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeasonID returns the old "season_id" field's value of the SeasonStanding entity.
+// If the SeasonStanding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonStandingMutation) OldSeasonID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeasonID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeasonID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeasonID: %w", err)
+	}
+	return oldValue.SeasonID, nil
+}
+
+// ResetSeasonID resets all changes to the "season_id" field.
+func (m *SeasonStandingMutation) ResetSeasonID() {
+	m.season_id = nil
+}
+
+// SetRank sets the "rank" field.
+func (m *SeasonStandingMutation) SetRank(i int) {
+	m.rank = &i
+	m.addrank = nil
+}
+
+// Rank returns the value of the "rank" field in the mutation.
+func (m *SeasonStandingMutation) Rank() (r int, exists bool) {
+	v := m.rank
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRank returns the old "rank" field's value of the SeasonStanding entity.
+// If the SeasonStanding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonStandingMutation) OldRank(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRank is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRank requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRank: %w", err)
+	}
+	return oldValue.Rank, nil
+}
+
+// AddRank adds i to the "rank" field.
+func (m *SeasonStandingMutation) AddRank(i int) {
+	if m.addrank != nil {
+		*m.addrank += i
+	} else {
+		m.addrank = &i
+	}
+}
+
+// AddedRank returns the value that was added to the "rank" field in this mutation.
+func (m *SeasonStandingMutation) AddedRank() (r int, exists bool) {
+	v := m.addrank
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRank resets all changes to the "rank" field.
+func (m *SeasonStandingMutation) ResetRank() {
+	m.rank = nil
+	m.addrank = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SeasonStandingMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SeasonStandingMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the SeasonStanding entity.
+// If the SeasonStanding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonStandingMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SeasonStandingMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *SeasonStandingMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *SeasonStandingMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the SeasonStanding entity.
+// If the SeasonStanding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonStandingMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *SeasonStandingMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetHexesOwned sets the "hexes_owned" field.
+func (m *SeasonStandingMutation) SetHexesOwned(i int) {
+	m.hexes_owned = &i
+	m.addhexes_owned = nil
+}
+
+// HexesOwned returns the value of the "hexes_owned" field in the mutation.
+func (m *SeasonStandingMutation) HexesOwned() (r int, exists bool) {
+	v := m.hexes_owned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHexesOwned returns the old "hexes_owned" field's value of the SeasonStanding entity.
+// If the SeasonStanding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonStandingMutation) OldHexesOwned(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHexesOwned is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHexesOwned requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHexesOwned: %w", err)
+	}
+	return oldValue.HexesOwned, nil
+}
+
+// AddHexesOwned adds i to the "hexes_owned" field.
+func (m *SeasonStandingMutation) AddHexesOwned(i int) {
+	if m.addhexes_owned != nil {
+		*m.addhexes_owned += i
+	} else {
+		m.addhexes_owned = &i
+	}
+}
+
+// AddedHexesOwned returns the value that was added to the "hexes_owned" field in this mutation.
+func (m *SeasonStandingMutation) AddedHexesOwned() (r int, exists bool) {
+	v := m.addhexes_owned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHexesOwned resets all changes to the "hexes_owned" field.
+func (m *SeasonStandingMutation) ResetHexesOwned() {
+	m.hexes_owned = nil
+	m.addhexes_owned = nil
+}
+
+// SetTotalInfluence sets the "total_influence" field.
+func (m *SeasonStandingMutation) SetTotalInfluence(f float64) {
+	m.total_influence = &f
+	m.addtotal_influence = nil
+}
+
+// TotalInfluence returns the value of the "total_influence" field in the mutation.
+func (m *SeasonStandingMutation) TotalInfluence() (r float64, exists bool) {
+	v := m.total_influence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalInfluence returns the old "total_influence" field's value of the SeasonStanding entity.
+// If the SeasonStanding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeasonStandingMutation) OldTotalInfluence(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalInfluence is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalInfluence requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalInfluence: %w", err)
+	}
+	return oldValue.TotalInfluence, nil
+}
+
+// AddTotalInfluence adds f to the "total_influence" field.
+func (m *SeasonStandingMutation) AddTotalInfluence(f float64) {
+	if m.addtotal_influence != nil {
+		*m.addtotal_influence += f
+	} else {
+		m.addtotal_influence = &f
+	}
+}
+
+// AddedTotalInfluence returns the value that was added to the "total_influence" field in this mutation.
+func (m *SeasonStandingMutation) AddedTotalInfluence() (r float64, exists bool) {
+	v := m.addtotal_influence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalInfluence resets all changes to the "total_influence" field.
+func (m *SeasonStandingMutation) ResetTotalInfluence() {
+	m.total_influence = nil
+	m.addtotal_influence = nil
+}
+
+// Where appends a list predicates to the SeasonStandingMutation builder.
+func (m *SeasonStandingMutation) Where(ps ...predicate.SeasonStanding) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SeasonStandingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SeasonStandingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SeasonStanding, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SeasonStandingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SeasonStandingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SeasonStanding).
+func (m *SeasonStandingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SeasonStandingMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.season_id != nil {
+		fields = append(fields, seasonstanding.FieldSeasonID)
+	}
+	if m.rank != nil {
+		fields = append(fields, seasonstanding.FieldRank)
+	}
+	if m.user_id != nil {
+		fields = append(fields, seasonstanding.FieldUserID)
+	}
+	if m.username != nil {
+		fields = append(fields, seasonstanding.FieldUsername)
+	}
+	if m.hexes_owned != nil {
+		fields = append(fields, seasonstanding.FieldHexesOwned)
+	}
+	if m.total_influence != nil {
+		fields = append(fields, seasonstanding.FieldTotalInfluence)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SeasonStandingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case seasonstanding.FieldSeasonID:
+		return m.SeasonID()
+	case seasonstanding.FieldRank:
+		return m.Rank()
+	case seasonstanding.FieldUserID:
+		return m.UserID()
+	case seasonstanding.FieldUsername:
+		return m.Username()
+	case seasonstanding.FieldHexesOwned:
+		return m.HexesOwned()
+	case seasonstanding.FieldTotalInfluence:
+		return m.TotalInfluence()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SeasonStandingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case seasonstanding.FieldSeasonID:
+		return m.OldSeasonID(ctx)
+	case seasonstanding.FieldRank:
+		return m.OldRank(ctx)
+	case seasonstanding.FieldUserID:
+		return m.OldUserID(ctx)
+	case seasonstanding.FieldUsername:
+		return m.OldUsername(ctx)
+	case seasonstanding.FieldHexesOwned:
+		return m.OldHexesOwned(ctx)
+	case seasonstanding.FieldTotalInfluence:
+		return m.OldTotalInfluence(ctx)
+	}
+	return nil, fmt.Errorf("unknown SeasonStanding field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SeasonStandingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case seasonstanding.FieldSeasonID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeasonID(v)
+		return nil
+	case seasonstanding.FieldRank:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRank(v)
+		return nil
+	case seasonstanding.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case seasonstanding.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case seasonstanding.FieldHexesOwned:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHexesOwned(v)
+		return nil
+	case seasonstanding.FieldTotalInfluence:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalInfluence(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SeasonStanding field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SeasonStandingMutation) AddedFields() []string {
+	var fields []string
+	if m.addrank != nil {
+		fields = append(fields, seasonstanding.FieldRank)
+	}
+	if m.addhexes_owned != nil {
+		fields = append(fields, seasonstanding.FieldHexesOwned)
+	}
+	if m.addtotal_influence != nil {
+		fields = append(fields, seasonstanding.FieldTotalInfluence)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SeasonStandingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case seasonstanding.FieldRank:
+		return m.AddedRank()
+	case seasonstanding.FieldHexesOwned:
+		return m.AddedHexesOwned()
+	case seasonstanding.FieldTotalInfluence:
+		return m.AddedTotalInfluence()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SeasonStandingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case seasonstanding.FieldRank:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRank(v)
+		return nil
+	case seasonstanding.FieldHexesOwned:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHexesOwned(v)
+		return nil
+	case seasonstanding.FieldTotalInfluence:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalInfluence(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SeasonStanding numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SeasonStandingMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SeasonStandingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SeasonStandingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SeasonStanding nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SeasonStandingMutation) ResetField(name string) error {
+	switch name {
+	case seasonstanding.FieldSeasonID:
+		m.ResetSeasonID()
+		return nil
+	case seasonstanding.FieldRank:
+		m.ResetRank()
+		return nil
+	case seasonstanding.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case seasonstanding.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case seasonstanding.FieldHexesOwned:
+		m.ResetHexesOwned()
+		return nil
+	case seasonstanding.FieldTotalInfluence:
+		m.ResetTotalInfluence()
+		return nil
+	}
+	return fmt.Errorf("unknown SeasonStanding field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SeasonStandingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SeasonStandingMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SeasonStandingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SeasonStandingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SeasonStandingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SeasonStandingMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SeasonStandingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SeasonStanding unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SeasonStandingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SeasonStanding edge %s", name)
+}
+
+// SegmentMutation represents an operation that mutates the Segment nodes in the graph.
+type SegmentMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	name             *string
+	creator_id       *uuid.UUID
+	h3_indexes       *[]string
+	appendh3_indexes []string
+	start_h3_index   *string
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	efforts          map[uuid.UUID]struct{}
+	removedefforts   map[uuid.UUID]struct{}
+	clearedefforts   bool
+	done             bool
+	oldValue         func(context.Context) (*Segment, error)
+	predicates       []predicate.Segment
+}
+
+var _ ent.Mutation = (*SegmentMutation)(nil)
+
+// segmentOption allows management of the mutation configuration using functional options.
+type segmentOption func(*SegmentMutation)
+
+// newSegmentMutation creates new mutation for the Segment entity.
+func newSegmentMutation(c config, op Op, opts ...segmentOption) *SegmentMutation {
+	m := &SegmentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSegment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSegmentID sets the ID field of the mutation.
+func withSegmentID(id uuid.UUID) segmentOption {
+	return func(m *SegmentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Segment
+		)
+		m.oldValue = func(ctx context.Context) (*Segment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Segment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSegment sets the old Segment of the mutation.
+func withSegment(node *Segment) segmentOption {
+	return func(m *SegmentMutation) {
+		m.oldValue = func(context.Context) (*Segment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SegmentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SegmentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Segment entities.
+func (m *SegmentMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SegmentMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SegmentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Segment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *SegmentMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SegmentMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Segment entity.
+// If the Segment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SegmentMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SegmentMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (m *SegmentMutation) SetCreatorID(u uuid.UUID) {
+	m.creator_id = &u
+}
+
+// CreatorID returns the value of the "creator_id" field in the mutation.
+func (m *SegmentMutation) CreatorID() (r uuid.UUID, exists bool) {
+	v := m.creator_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatorID returns the old "creator_id" field's value of the Segment entity.
+// If the Segment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SegmentMutation) OldCreatorID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatorID: %w", err)
+	}
+	return oldValue.CreatorID, nil
+}
+
+// ResetCreatorID resets all changes to the "creator_id" field.
+func (m *SegmentMutation) ResetCreatorID() {
+	m.creator_id = nil
+}
+
+// SetH3Indexes sets the "h3_indexes" field.
+func (m *SegmentMutation) SetH3Indexes(s []string) {
+	m.h3_indexes = &s
+	m.appendh3_indexes = nil
+}
+
+// H3Indexes returns the value of the "h3_indexes" field in the mutation.
+func (m *SegmentMutation) H3Indexes() (r []string, exists bool) {
+	v := m.h3_indexes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldH3Indexes returns the old "h3_indexes" field's value of the Segment entity.
+// If the Segment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SegmentMutation) OldH3Indexes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldH3Indexes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldH3Indexes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldH3Indexes: %w", err)
+	}
+	return oldValue.H3Indexes, nil
+}
+
+// AppendH3Indexes adds s to the "h3_indexes" field.
+func (m *SegmentMutation) AppendH3Indexes(s []string) {
+	m.appendh3_indexes = append(m.appendh3_indexes, s...)
+}
+
+// AppendedH3Indexes returns the list of values that were appended to the "h3_indexes" field in this mutation.
+func (m *SegmentMutation) AppendedH3Indexes() ([]string, bool) {
+	if len(m.appendh3_indexes) == 0 {
+		return nil, false
+	}
+	return m.appendh3_indexes, true
+}
+
+// ResetH3Indexes resets all changes to the "h3_indexes" field.
+func (m *SegmentMutation) ResetH3Indexes() {
+	m.h3_indexes = nil
+	m.appendh3_indexes = nil
+}
+
+// SetStartH3Index sets the "start_h3_index" field.
+func (m *SegmentMutation) SetStartH3Index(s string) {
+	m.start_h3_index = &s
+}
+
+// StartH3Index returns the value of the "start_h3_index" field in the mutation.
+func (m *SegmentMutation) StartH3Index() (r string, exists bool) {
+	v := m.start_h3_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartH3Index returns the old "start_h3_index" field's value of the Segment entity.
+// If the Segment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SegmentMutation) OldStartH3Index(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartH3Index is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartH3Index requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartH3Index: %w", err)
+	}
+	return oldValue.StartH3Index, nil
+}
+
+// ResetStartH3Index resets all changes to the "start_h3_index" field.
+func (m *SegmentMutation) ResetStartH3Index() {
+	m.start_h3_index = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SegmentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SegmentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Segment entity.
+// If the Segment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SegmentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SegmentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddEffortIDs adds the "efforts" edge to the SegmentEffort entity by ids.
+func (m *SegmentMutation) AddEffortIDs(ids ...uuid.UUID) {
+	if m.efforts == nil {
+		m.efforts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.efforts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEfforts clears the "efforts" edge to the SegmentEffort entity.
+func (m *SegmentMutation) ClearEfforts() {
+	m.clearedefforts = true
+}
+
+// EffortsCleared reports if the "efforts" edge to the SegmentEffort entity was cleared.
+func (m *SegmentMutation) EffortsCleared() bool {
+	return m.clearedefforts
+}
+
+// RemoveEffortIDs removes the "efforts" edge to the SegmentEffort entity by IDs.
+func (m *SegmentMutation) RemoveEffortIDs(ids ...uuid.UUID) {
+	if m.removedefforts == nil {
+		m.removedefforts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.efforts, ids[i])
+		m.removedefforts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEfforts returns the removed IDs of the "efforts" edge to the SegmentEffort entity.
+func (m *SegmentMutation) RemovedEffortsIDs() (ids []uuid.UUID) {
+	for id := range m.removedefforts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EffortsIDs returns the "efforts" edge IDs in the mutation.
+func (m *SegmentMutation) EffortsIDs() (ids []uuid.UUID) {
+	for id := range m.efforts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEfforts resets all changes to the "efforts" edge.
+func (m *SegmentMutation) ResetEfforts() {
+	m.efforts = nil
+	m.clearedefforts = false
+	m.removedefforts = nil
+}
+
+// Where appends a list predicates to the SegmentMutation builder.
+func (m *SegmentMutation) Where(ps ...predicate.Segment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SegmentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SegmentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Segment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SegmentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SegmentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Segment).
+func (m *SegmentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SegmentMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, segment.FieldName)
+	}
+	if m.creator_id != nil {
+		fields = append(fields, segment.FieldCreatorID)
+	}
+	if m.h3_indexes != nil {
+		fields = append(fields, segment.FieldH3Indexes)
+	}
+	if m.start_h3_index != nil {
+		fields = append(fields, segment.FieldStartH3Index)
+	}
+	if m.created_at != nil {
+		fields = append(fields, segment.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SegmentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case segment.FieldName:
+		return m.Name()
+	case segment.FieldCreatorID:
+		return m.CreatorID()
+	case segment.FieldH3Indexes:
+		return m.H3Indexes()
+	case segment.FieldStartH3Index:
+		return m.StartH3Index()
+	case segment.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SegmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case segment.FieldName:
+		return m.OldName(ctx)
+	case segment.FieldCreatorID:
+		return m.OldCreatorID(ctx)
+	case segment.FieldH3Indexes:
+		return m.OldH3Indexes(ctx)
+	case segment.FieldStartH3Index:
+		return m.OldStartH3Index(ctx)
+	case segment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Segment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SegmentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case segment.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case segment.FieldCreatorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatorID(v)
+		return nil
+	case segment.FieldH3Indexes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetH3Indexes(v)
+		return nil
+	case segment.FieldStartH3Index:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartH3Index(v)
+		return nil
+	case segment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Segment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SegmentMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SegmentMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SegmentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Segment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SegmentMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SegmentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SegmentMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Segment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SegmentMutation) ResetField(name string) error {
+	switch name {
+	case segment.FieldName:
+		m.ResetName()
+		return nil
+	case segment.FieldCreatorID:
+		m.ResetCreatorID()
+		return nil
+	case segment.FieldH3Indexes:
+		m.ResetH3Indexes()
+		return nil
+	case segment.FieldStartH3Index:
+		m.ResetStartH3Index()
+		return nil
+	case segment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Segment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SegmentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.efforts != nil {
+		edges = append(edges, segment.EdgeEfforts)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SegmentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case segment.EdgeEfforts:
+		ids := make([]ent.Value, 0, len(m.efforts))
+		for id := range m.efforts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SegmentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedefforts != nil {
+		edges = append(edges, segment.EdgeEfforts)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SegmentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case segment.EdgeEfforts:
+		ids := make([]ent.Value, 0, len(m.removedefforts))
+		for id := range m.removedefforts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SegmentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedefforts {
+		edges = append(edges, segment.EdgeEfforts)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SegmentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case segment.EdgeEfforts:
+		return m.clearedefforts
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SegmentMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Segment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SegmentMutation) ResetEdge(name string) error {
+	switch name {
+	case segment.EdgeEfforts:
+		m.ResetEfforts()
+		return nil
+	}
+	return fmt.Errorf("unknown Segment edge %s", name)
+}
+
+// SegmentEffortMutation represents an operation that mutates the SegmentEffort nodes in the graph.
+type SegmentEffortMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	user_id            *uuid.UUID
+	elapsed_seconds    *float64
+	addelapsed_seconds *float64
+	started_at         *time.Time
+	estimated          *bool
+	clearedFields      map[string]struct{}
+	segment            *uuid.UUID
+	clearedsegment     bool
+	activity           *uuid.UUID
+	clearedactivity    bool
+	done               bool
+	oldValue           func(context.Context) (*SegmentEffort, error)
+	predicates         []predicate.SegmentEffort
+}
+
+var _ ent.Mutation = (*SegmentEffortMutation)(nil)
+
+// segmenteffortOption allows management of the mutation configuration using functional options.
+type segmenteffortOption func(*SegmentEffortMutation)
+
+// newSegmentEffortMutation creates new mutation for the SegmentEffort entity.
+func newSegmentEffortMutation(c config, op Op, opts ...segmenteffortOption) *SegmentEffortMutation {
+	m := &SegmentEffortMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSegmentEffort,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSegmentEffortID sets the ID field of the mutation.
+func withSegmentEffortID(id uuid.UUID) segmenteffortOption {
+	return func(m *SegmentEffortMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SegmentEffort
+		)
+		m.oldValue = func(ctx context.Context) (*SegmentEffort, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SegmentEffort.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSegmentEffort sets the old SegmentEffort of the mutation.
+func withSegmentEffort(node *SegmentEffort) segmenteffortOption {
+	return func(m *SegmentEffortMutation) {
+		m.oldValue = func(context.Context) (*SegmentEffort, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SegmentEffortMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SegmentEffortMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SegmentEffort entities.
+func (m *SegmentEffortMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SegmentEffortMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SegmentEffortMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SegmentEffort.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSegmentID sets the "segment_id" field.
+func (m *SegmentEffortMutation) SetSegmentID(u uuid.UUID) {
+	m.segment = &u
+}
+
+// SegmentID returns the value of the "segment_id" field in the mutation.
+func (m *SegmentEffortMutation) SegmentID() (r uuid.UUID, exists bool) {
+	v := m.segment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSegmentID returns the old "segment_id" field's value of the SegmentEffort entity.
+// If the SegmentEffort object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SegmentEffortMutation) OldSegmentID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSegmentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSegmentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSegmentID: %w", err)
+	}
+	return oldValue.SegmentID, nil
+}
+
+// ResetSegmentID resets all changes to the "segment_id" field.
+func (m *SegmentEffortMutation) ResetSegmentID() {
+	m.segment = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SegmentEffortMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SegmentEffortMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the SegmentEffort entity.
+// If the SegmentEffort object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SegmentEffortMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SegmentEffortMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetActivityID sets the "activity_id" field.
+func (m *SegmentEffortMutation) SetActivityID(u uuid.UUID) {
+	m.activity = &u
+}
+
+// ActivityID returns the value of the "activity_id" field in the mutation.
+func (m *SegmentEffortMutation) ActivityID() (r uuid.UUID, exists bool) {
+	v := m.activity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActivityID returns the old "activity_id" field's value of the SegmentEffort entity.
+// If the SegmentEffort object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SegmentEffortMutation) OldActivityID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActivityID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActivityID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActivityID: %w", err)
+	}
+	return oldValue.ActivityID, nil
+}
+
+// ResetActivityID resets all changes to the "activity_id" field.
+func (m *SegmentEffortMutation) ResetActivityID() {
+	m.activity = nil
+}
+
+// SetElapsedSeconds sets the "elapsed_seconds" field.
+func (m *SegmentEffortMutation) SetElapsedSeconds(f float64) {
+	m.elapsed_seconds = &f
+	m.addelapsed_seconds = nil
+}
+
+// ElapsedSeconds returns the value of the "elapsed_seconds" field in the mutation.
+func (m *SegmentEffortMutation) ElapsedSeconds() (r float64, exists bool) {
+	v := m.elapsed_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldElapsedSeconds returns the old "elapsed_seconds" field's value of the SegmentEffort entity.
+// If the SegmentEffort object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SegmentEffortMutation) OldElapsedSeconds(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldElapsedSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldElapsedSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldElapsedSeconds: %w", err)
+	}
+	return oldValue.ElapsedSeconds, nil
+}
+
+// AddElapsedSeconds adds f to the "elapsed_seconds" field.
+func (m *SegmentEffortMutation) AddElapsedSeconds(f float64) {
+	if m.addelapsed_seconds != nil {
+		*m.addelapsed_seconds += f
+	} else {
+		m.addelapsed_seconds = &f
+	}
+}
+
+// AddedElapsedSeconds returns the value that was added to the "elapsed_seconds" field in this mutation.
+func (m *SegmentEffortMutation) AddedElapsedSeconds() (r float64, exists bool) {
+	v := m.addelapsed_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetElapsedSeconds resets all changes to the "elapsed_seconds" field.
+func (m *SegmentEffortMutation) ResetElapsedSeconds() {
+	m.elapsed_seconds = nil
+	m.addelapsed_seconds = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *SegmentEffortMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *SegmentEffortMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the SegmentEffort entity.
+// If the SegmentEffort object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SegmentEffortMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *SegmentEffortMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetEstimated sets the "estimated" field.
+func (m *SegmentEffortMutation) SetEstimated(b bool) {
+	m.estimated = &b
+}
+
+// Estimated returns the value of the "estimated" field in the mutation.
+func (m *SegmentEffortMutation) Estimated() (r bool, exists bool) {
+	v := m.estimated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEstimated returns the old "estimated" field's value of the SegmentEffort entity.
+// If the SegmentEffort object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SegmentEffortMutation) OldEstimated(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEstimated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEstimated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEstimated: %w", err)
+	}
+	return oldValue.Estimated, nil
+}
+
+// ResetEstimated resets all changes to the "estimated" field.
+func (m *SegmentEffortMutation) ResetEstimated() {
+	m.estimated = nil
+}
+
+// ClearSegment clears the "segment" edge to the Segment entity.
+func (m *SegmentEffortMutation) ClearSegment() {
+	m.clearedsegment = true
+	m.clearedFields[segmenteffort.FieldSegmentID] = struct{}{}
+}
+
+// SegmentCleared reports if the "segment" edge to the Segment entity was cleared.
+func (m *SegmentEffortMutation) SegmentCleared() bool {
+	return m.clearedsegment
+}
+
+// SegmentIDs returns the "segment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SegmentID instead. It exists only for internal usage by the builders.
+func (m *SegmentEffortMutation) SegmentIDs() (ids []uuid.UUID) {
+	if id := m.segment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSegment resets all changes to the "segment" edge.
+func (m *SegmentEffortMutation) ResetSegment() {
+	m.segment = nil
+	m.clearedsegment = false
+}
+
+// ClearActivity clears the "activity" edge to the Activity entity.
+func (m *SegmentEffortMutation) ClearActivity() {
+	m.clearedactivity = true
+	m.clearedFields[segmenteffort.FieldActivityID] = struct{}{}
+}
+
+// ActivityCleared reports if the "activity" edge to the Activity entity was cleared.
+func (m *SegmentEffortMutation) ActivityCleared() bool {
+	return m.clearedactivity
+}
+
+// ActivityIDs returns the "activity" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ActivityID instead. It exists only for internal usage by the builders.
+func (m *SegmentEffortMutation) ActivityIDs() (ids []uuid.UUID) {
+	if id := m.activity; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetActivity resets all changes to the "activity" edge.
+func (m *SegmentEffortMutation) ResetActivity() {
+	m.activity = nil
+	m.clearedactivity = false
+}
+
+// Where appends a list predicates to the SegmentEffortMutation builder.
+func (m *SegmentEffortMutation) Where(ps ...predicate.SegmentEffort) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SegmentEffortMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SegmentEffortMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SegmentEffort, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SegmentEffortMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SegmentEffortMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SegmentEffort).
+func (m *SegmentEffortMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SegmentEffortMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.segment != nil {
+		fields = append(fields, segmenteffort.FieldSegmentID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, segmenteffort.FieldUserID)
+	}
+	if m.activity != nil {
+		fields = append(fields, segmenteffort.FieldActivityID)
+	}
+	if m.elapsed_seconds != nil {
+		fields = append(fields, segmenteffort.FieldElapsedSeconds)
+	}
+	if m.started_at != nil {
+		fields = append(fields, segmenteffort.FieldStartedAt)
+	}
+	if m.estimated != nil {
+		fields = append(fields, segmenteffort.FieldEstimated)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SegmentEffortMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case segmenteffort.FieldSegmentID:
+		return m.SegmentID()
+	case segmenteffort.FieldUserID:
+		return m.UserID()
+	case segmenteffort.FieldActivityID:
+		return m.ActivityID()
+	case segmenteffort.FieldElapsedSeconds:
+		return m.ElapsedSeconds()
+	case segmenteffort.FieldStartedAt:
+		return m.StartedAt()
+	case segmenteffort.FieldEstimated:
+		return m.Estimated()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SegmentEffortMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case segmenteffort.FieldSegmentID:
+		return m.OldSegmentID(ctx)
+	case segmenteffort.FieldUserID:
+		return m.OldUserID(ctx)
+	case segmenteffort.FieldActivityID:
+		return m.OldActivityID(ctx)
+	case segmenteffort.FieldElapsedSeconds:
+		return m.OldElapsedSeconds(ctx)
+	case segmenteffort.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case segmenteffort.FieldEstimated:
+		return m.OldEstimated(ctx)
+	}
+	return nil, fmt.Errorf("unknown SegmentEffort field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SegmentEffortMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case segmenteffort.FieldSegmentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSegmentID(v)
+		return nil
+	case segmenteffort.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case segmenteffort.FieldActivityID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActivityID(v)
+		return nil
+	case segmenteffort.FieldElapsedSeconds:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetElapsedSeconds(v)
+		return nil
+	case segmenteffort.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case segmenteffort.FieldEstimated:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEstimated(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SegmentEffort field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SegmentEffortMutation) AddedFields() []string {
+	var fields []string
+	if m.addelapsed_seconds != nil {
+		fields = append(fields, segmenteffort.FieldElapsedSeconds)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SegmentEffortMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case segmenteffort.FieldElapsedSeconds:
+		return m.AddedElapsedSeconds()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SegmentEffortMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case segmenteffort.FieldElapsedSeconds:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddElapsedSeconds(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SegmentEffort numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SegmentEffortMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SegmentEffortMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SegmentEffortMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SegmentEffort nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SegmentEffortMutation) ResetField(name string) error {
+	switch name {
+	case segmenteffort.FieldSegmentID:
+		m.ResetSegmentID()
+		return nil
+	case segmenteffort.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case segmenteffort.FieldActivityID:
+		m.ResetActivityID()
+		return nil
+	case segmenteffort.FieldElapsedSeconds:
+		m.ResetElapsedSeconds()
+		return nil
+	case segmenteffort.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case segmenteffort.FieldEstimated:
+		m.ResetEstimated()
+		return nil
+	}
+	return fmt.Errorf("unknown SegmentEffort field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SegmentEffortMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.segment != nil {
+		edges = append(edges, segmenteffort.EdgeSegment)
+	}
+	if m.activity != nil {
+		edges = append(edges, segmenteffort.EdgeActivity)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SegmentEffortMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case segmenteffort.EdgeSegment:
+		if id := m.segment; id != nil {
+			return []ent.Value{*id}
+		}
+	case segmenteffort.EdgeActivity:
+		if id := m.activity; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SegmentEffortMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SegmentEffortMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SegmentEffortMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedsegment {
+		edges = append(edges, segmenteffort.EdgeSegment)
+	}
+	if m.clearedactivity {
+		edges = append(edges, segmenteffort.EdgeActivity)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SegmentEffortMutation) EdgeCleared(name string) bool {
+	switch name {
+	case segmenteffort.EdgeSegment:
+		return m.clearedsegment
+	case segmenteffort.EdgeActivity:
+		return m.clearedactivity
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SegmentEffortMutation) ClearEdge(name string) error {
+	switch name {
+	case segmenteffort.EdgeSegment:
+		m.ClearSegment()
+		return nil
+	case segmenteffort.EdgeActivity:
+		m.ClearActivity()
+		return nil
+	}
+	return fmt.Errorf("unknown SegmentEffort unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SegmentEffortMutation) ResetEdge(name string) error {
+	switch name {
+	case segmenteffort.EdgeSegment:
+		m.ResetSegment()
+		return nil
+	case segmenteffort.EdgeActivity:
+		m.ResetActivity()
+		return nil
+	}
+	return fmt.Errorf("unknown SegmentEffort edge %s", name)
+}
+
+// SettingMutation represents an operation that mutates the Setting nodes in the graph.
+type SettingMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	value         *string
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Setting, error)
+	predicates    []predicate.Setting
+}
+
+var _ ent.Mutation = (*SettingMutation)(nil)
+
+// settingOption allows management of the mutation configuration using functional options.
+type settingOption func(*SettingMutation)
+
+// newSettingMutation creates new mutation for the Setting entity.
+func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation {
+	m := &SettingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSetting,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSettingID sets the ID field of the mutation.
+func withSettingID(id string) settingOption {
+	return func(m *SettingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Setting
+		)
+		m.oldValue = func(ctx context.Context) (*Setting, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Setting.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSetting sets the old Setting of the mutation.
+func withSetting(node *Setting) settingOption {
+	return func(m *SettingMutation) {
+		m.oldValue = func(context.Context) (*Setting, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SettingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SettingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Setting entities.
+func (m *SettingMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SettingMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SettingMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Setting.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetValue sets the "value" field.
+func (m *SettingMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *SettingMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *SettingMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SettingMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SettingMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SettingMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the SettingMutation builder.
+func (m *SettingMutation) Where(ps ...predicate.Setting) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SettingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SettingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Setting, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SettingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SettingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Setting).
+func (m *SettingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SettingMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.value != nil {
+		fields = append(fields, setting.FieldValue)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, setting.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SettingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case setting.FieldValue:
+		return m.Value()
+	case setting.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case setting.FieldValue:
+		return m.OldValue(ctx)
+	case setting.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Setting field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case setting.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case setting.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Setting field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SettingMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SettingMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Setting numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SettingMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SettingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SettingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Setting nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SettingMutation) ResetField(name string) error {
+	switch name {
+	case setting.FieldValue:
+		m.ResetValue()
+		return nil
+	case setting.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Setting field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SettingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SettingMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SettingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SettingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SettingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SettingMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SettingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Setting unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SettingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Setting edge %s", name)
+}
+
+// StreakMutation represents an operation that mutates the Streak nodes in the graph.
+type StreakMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	user_id       *uuid.UUID
+	period        *streak.Period
+	current       *int
+	addcurrent    *int
+	longest       *int
+	addlongest    *int
+	last_period   *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Streak, error)
+	predicates    []predicate.Streak
+}
+
+var _ ent.Mutation = (*StreakMutation)(nil)
+
+// streakOption allows management of the mutation configuration using functional options.
+type streakOption func(*StreakMutation)
+
+// newStreakMutation creates new mutation for the Streak entity.
+func newStreakMutation(c config, op Op, opts ...streakOption) *StreakMutation {
+	m := &StreakMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStreak,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStreakID sets the ID field of the mutation.
+func withStreakID(id uuid.UUID) streakOption {
+	return func(m *StreakMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Streak
+		)
+		m.oldValue = func(ctx context.Context) (*Streak, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Streak.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStreak sets the old Streak of the mutation.
+func withStreak(node *Streak) streakOption {
+	return func(m *StreakMutation) {
+		m.oldValue = func(context.Context) (*Streak, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StreakMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StreakMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Streak entities.
+func (m *StreakMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StreakMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StreakMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Streak.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *StreakMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *StreakMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Streak entity.
+// If the Streak object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreakMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *StreakMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetPeriod sets the "period" field.
+func (m *StreakMutation) SetPeriod(s streak.Period) {
+	m.period = &s
+}
+
+// Period returns the value of the "period" field in the mutation.
+func (m *StreakMutation) Period() (r streak.Period, exists bool) {
+	v := m.period
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeriod returns the old "period" field's value of the Streak entity.
+// If the Streak object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreakMutation) OldPeriod(ctx context.Context) (v streak.Period, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeriod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeriod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeriod: %w", err)
+	}
+	return oldValue.Period, nil
+}
+
+// ResetPeriod resets all changes to the "period" field.
+func (m *StreakMutation) ResetPeriod() {
+	m.period = nil
+}
+
+// SetCurrent sets the "current" field.
+func (m *StreakMutation) SetCurrent(i int) {
+	m.current = &i
+	m.addcurrent = nil
+}
+
+// Current returns the value of the "current" field in the mutation.
+func (m *StreakMutation) Current() (r int, exists bool) {
+	v := m.current
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrent returns the old "current" field's value of the Streak entity.
+// If the Streak object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreakMutation) OldCurrent(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrent: %w", err)
+	}
+	return oldValue.Current, nil
+}
+
+// AddCurrent adds i to the "current" field.
+func (m *StreakMutation) AddCurrent(i int) {
+	if m.addcurrent != nil {
+		*m.addcurrent += i
+	} else {
+		m.addcurrent = &i
+	}
+}
+
+// AddedCurrent returns the value that was added to the "current" field in this mutation.
+func (m *StreakMutation) AddedCurrent() (r int, exists bool) {
+	v := m.addcurrent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCurrent resets all changes to the "current" field.
+func (m *StreakMutation) ResetCurrent() {
+	m.current = nil
+	m.addcurrent = nil
+}
+
+// SetLongest sets the "longest" field.
+func (m *StreakMutation) SetLongest(i int) {
+	m.longest = &i
+	m.addlongest = nil
+}
+
+// Longest returns the value of the "longest" field in the mutation.
+func (m *StreakMutation) Longest() (r int, exists bool) {
+	v := m.longest
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLongest returns the old "longest" field's value of the Streak entity.
+// If the Streak object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreakMutation) OldLongest(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLongest is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLongest requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLongest: %w", err)
+	}
+	return oldValue.Longest, nil
+}
+
+// AddLongest adds i to the "longest" field.
+func (m *StreakMutation) AddLongest(i int) {
+	if m.addlongest != nil {
+		*m.addlongest += i
+	} else {
+		m.addlongest = &i
+	}
+}
+
+// AddedLongest returns the value that was added to the "longest" field in this mutation.
+func (m *StreakMutation) AddedLongest() (r int, exists bool) {
+	v := m.addlongest
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLongest resets all changes to the "longest" field.
+func (m *StreakMutation) ResetLongest() {
+	m.longest = nil
+	m.addlongest = nil
+}
+
+// SetLastPeriod sets the "last_period" field.
+func (m *StreakMutation) SetLastPeriod(t time.Time) {
+	m.last_period = &t
+}
+
+// LastPeriod returns the value of the "last_period" field in the mutation.
+func (m *StreakMutation) LastPeriod() (r time.Time, exists bool) {
+	v := m.last_period
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastPeriod returns the old "last_period" field's value of the Streak entity.
+// If the Streak object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreakMutation) OldLastPeriod(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastPeriod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastPeriod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastPeriod: %w", err)
+	}
+	return oldValue.LastPeriod, nil
+}
+
+// ResetLastPeriod resets all changes to the "last_period" field.
+func (m *StreakMutation) ResetLastPeriod() {
+	m.last_period = nil
+}
+
+// Where appends a list predicates to the StreakMutation builder.
+func (m *StreakMutation) Where(ps ...predicate.Streak) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StreakMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StreakMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Streak, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StreakMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StreakMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Streak).
+func (m *StreakMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StreakMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.user_id != nil {
+		fields = append(fields, streak.FieldUserID)
+	}
+	if m.period != nil {
+		fields = append(fields, streak.FieldPeriod)
+	}
+	if m.current != nil {
+		fields = append(fields, streak.FieldCurrent)
+	}
+	if m.longest != nil {
+		fields = append(fields, streak.FieldLongest)
+	}
+	if m.last_period != nil {
+		fields = append(fields, streak.FieldLastPeriod)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StreakMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case streak.FieldUserID:
+		return m.UserID()
+	case streak.FieldPeriod:
+		return m.Period()
+	case streak.FieldCurrent:
+		return m.Current()
+	case streak.FieldLongest:
+		return m.Longest()
+	case streak.FieldLastPeriod:
+		return m.LastPeriod()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StreakMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case streak.FieldUserID:
+		return m.OldUserID(ctx)
+	case streak.FieldPeriod:
+		return m.OldPeriod(ctx)
+	case streak.FieldCurrent:
+		return m.OldCurrent(ctx)
+	case streak.FieldLongest:
+		return m.OldLongest(ctx)
+	case streak.FieldLastPeriod:
+		return m.OldLastPeriod(ctx)
+	}
+	return nil, fmt.Errorf("unknown Streak field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StreakMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case streak.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case streak.FieldPeriod:
+		v, ok := value.(streak.Period)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeriod(v)
+		return nil
+	case streak.FieldCurrent:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrent(v)
+		return nil
+	case streak.FieldLongest:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLongest(v)
+		return nil
+	case streak.FieldLastPeriod:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastPeriod(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Streak field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StreakMutation) AddedFields() []string {
+	var fields []string
+	if m.addcurrent != nil {
+		fields = append(fields, streak.FieldCurrent)
+	}
+	if m.addlongest != nil {
+		fields = append(fields, streak.FieldLongest)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StreakMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case streak.FieldCurrent:
+		return m.AddedCurrent()
+	case streak.FieldLongest:
+		return m.AddedLongest()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StreakMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case streak.FieldCurrent:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCurrent(v)
+		return nil
+	case streak.FieldLongest:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLongest(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Streak numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StreakMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StreakMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StreakMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Streak nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StreakMutation) ResetField(name string) error {
+	switch name {
+	case streak.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case streak.FieldPeriod:
+		m.ResetPeriod()
+		return nil
+	case streak.FieldCurrent:
+		m.ResetCurrent()
+		return nil
+	case streak.FieldLongest:
+		m.ResetLongest()
+		return nil
+	case streak.FieldLastPeriod:
+		m.ResetLastPeriod()
+		return nil
+	}
+	return fmt.Errorf("unknown Streak field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StreakMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StreakMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StreakMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StreakMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StreakMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StreakMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StreakMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Streak unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StreakMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Streak edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	external_user          *uuid.UUID
+	username               *string
+	time_zone              *string
+	hide_zone_leaderboards *bool
+	quiet_hours_start      *int
+	addquiet_hours_start   *int
+	quiet_hours_end        *int
+	addquiet_hours_end     *int
+	clearedFields          map[string]struct{}
+	activities             map[uuid.UUID]struct{}
+	removedactivities      map[uuid.UUID]struct{}
+	clearedactivities      bool
+	friendship             map[int]struct{}
+	removedfriendship      map[int]struct{}
+	clearedfriendship      bool
+	hexinfluence           map[uuid.UUID]struct{}
+	removedhexinfluence    map[uuid.UUID]struct{}
+	clearedhexinfluence    bool
+	done                   bool
+	oldValue               func(context.Context) (*User, error)
+	predicates             []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id uuid.UUID) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetExternalUser sets the "external_user" field.
+func (m *UserMutation) SetExternalUser(u uuid.UUID) {
+	m.external_user = &u
+}
+
+// ExternalUser returns the value of the "external_user" field in the mutation.
+func (m *UserMutation) ExternalUser() (r uuid.UUID, exists bool) {
+	v := m.external_user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalUser returns the old "external_user" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldExternalUser(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExternalUser is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExternalUser requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalUser: %w", err)
+	}
+	return oldValue.ExternalUser, nil
+}
+
+// ResetExternalUser resets all changes to the "external_user" field.
+func (m *UserMutation) ResetExternalUser() {
+	m.external_user = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *UserMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *UserMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *UserMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (m *UserMutation) SetTimeZone(s string) {
+	m.time_zone = &s
+}
+
+// TimeZone returns the value of the "time_zone" field in the mutation.
+func (m *UserMutation) TimeZone() (r string, exists bool) {
+	v := m.time_zone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeZone returns the old "time_zone" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTimeZone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeZone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeZone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeZone: %w", err)
+	}
+	return oldValue.TimeZone, nil
+}
+
+// ResetTimeZone resets all changes to the "time_zone" field.
+func (m *UserMutation) ResetTimeZone() {
+	m.time_zone = nil
+}
+
+// SetHideZoneLeaderboards sets the "hide_zone_leaderboards" field.
+func (m *UserMutation) SetHideZoneLeaderboards(b bool) {
+	m.hide_zone_leaderboards = &b
+}
+
+// HideZoneLeaderboards returns the value of the "hide_zone_leaderboards" field in the mutation.
+func (m *UserMutation) HideZoneLeaderboards() (r bool, exists bool) {
+	v := m.hide_zone_leaderboards
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHideZoneLeaderboards returns the old "hide_zone_leaderboards" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldHideZoneLeaderboards(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHideZoneLeaderboards is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHideZoneLeaderboards requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHideZoneLeaderboards: %w", err)
+	}
+	return oldValue.HideZoneLeaderboards, nil
+}
+
+// ResetHideZoneLeaderboards resets all changes to the "hide_zone_leaderboards" field.
+func (m *UserMutation) ResetHideZoneLeaderboards() {
+	m.hide_zone_leaderboards = nil
+}
+
+// SetQuietHoursStart sets the "quiet_hours_start" field.
+func (m *UserMutation) SetQuietHoursStart(i int) {
+	m.quiet_hours_start = &i
+	m.addquiet_hours_start = nil
+}
+
+// QuietHoursStart returns the value of the "quiet_hours_start" field in the mutation.
+func (m *UserMutation) QuietHoursStart() (r int, exists bool) {
+	v := m.quiet_hours_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuietHoursStart returns the old "quiet_hours_start" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldQuietHoursStart(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuietHoursStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuietHoursStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuietHoursStart: %w", err)
+	}
+	return oldValue.QuietHoursStart, nil
+}
+
+// AddQuietHoursStart adds i to the "quiet_hours_start" field.
+func (m *UserMutation) AddQuietHoursStart(i int) {
+	if m.addquiet_hours_start != nil {
+		*m.addquiet_hours_start += i
+	} else {
+		m.addquiet_hours_start = &i
+	}
+}
+
+// AddedQuietHoursStart returns the value that was added to the "quiet_hours_start" field in this mutation.
+func (m *UserMutation) AddedQuietHoursStart() (r int, exists bool) {
+	v := m.addquiet_hours_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearQuietHoursStart clears the value of the "quiet_hours_start" field.
+func (m *UserMutation) ClearQuietHoursStart() {
+	m.quiet_hours_start = nil
+	m.addquiet_hours_start = nil
+	m.clearedFields[user.FieldQuietHoursStart] = struct{}{}
+}
+
+// QuietHoursStartCleared returns if the "quiet_hours_start" field was cleared in this mutation.
+func (m *UserMutation) QuietHoursStartCleared() bool {
+	_, ok := m.clearedFields[user.FieldQuietHoursStart]
+	return ok
+}
+
+// ResetQuietHoursStart resets all changes to the "quiet_hours_start" field.
+func (m *UserMutation) ResetQuietHoursStart() {
+	m.quiet_hours_start = nil
+	m.addquiet_hours_start = nil
+	delete(m.clearedFields, user.FieldQuietHoursStart)
+}
+
+// SetQuietHoursEnd sets the "quiet_hours_end" field.
+func (m *UserMutation) SetQuietHoursEnd(i int) {
+	m.quiet_hours_end = &i
+	m.addquiet_hours_end = nil
+}
+
+// QuietHoursEnd returns the value of the "quiet_hours_end" field in the mutation.
+func (m *UserMutation) QuietHoursEnd() (r int, exists bool) {
+	v := m.quiet_hours_end
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuietHoursEnd returns the old "quiet_hours_end" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldQuietHoursEnd(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuietHoursEnd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuietHoursEnd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuietHoursEnd: %w", err)
+	}
+	return oldValue.QuietHoursEnd, nil
+}
+
+// AddQuietHoursEnd adds i to the "quiet_hours_end" field.
+func (m *UserMutation) AddQuietHoursEnd(i int) {
+	if m.addquiet_hours_end != nil {
+		*m.addquiet_hours_end += i
+	} else {
+		m.addquiet_hours_end = &i
+	}
+}
+
+// AddedQuietHoursEnd returns the value that was added to the "quiet_hours_end" field in this mutation.
+func (m *UserMutation) AddedQuietHoursEnd() (r int, exists bool) {
+	v := m.addquiet_hours_end
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearQuietHoursEnd clears the value of the "quiet_hours_end" field.
+func (m *UserMutation) ClearQuietHoursEnd() {
+	m.quiet_hours_end = nil
+	m.addquiet_hours_end = nil
+	m.clearedFields[user.FieldQuietHoursEnd] = struct{}{}
+}
+
+// QuietHoursEndCleared returns if the "quiet_hours_end" field was cleared in this mutation.
+func (m *UserMutation) QuietHoursEndCleared() bool {
+	_, ok := m.clearedFields[user.FieldQuietHoursEnd]
+	return ok
+}
+
+// ResetQuietHoursEnd resets all changes to the "quiet_hours_end" field.
+func (m *UserMutation) ResetQuietHoursEnd() {
+	m.quiet_hours_end = nil
+	m.addquiet_hours_end = nil
+	delete(m.clearedFields, user.FieldQuietHoursEnd)
+}
+
+// AddActivityIDs adds the "activities" edge to the Activity entity by ids.
+func (m *UserMutation) AddActivityIDs(ids ...uuid.UUID) {
+	if m.activities == nil {
+		m.activities = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.activities[ids[i]] = struct{}{}
+	}
+}
+
+// ClearActivities clears the "activities" edge to the Activity entity.
+func (m *UserMutation) ClearActivities() {
+	m.clearedactivities = true
+}
+
+// ActivitiesCleared reports if the "activities" edge to the Activity entity was cleared.
+func (m *UserMutation) ActivitiesCleared() bool {
+	return m.clearedactivities
+}
+
+// RemoveActivityIDs removes the "activities" edge to the Activity entity by IDs.
+func (m *UserMutation) RemoveActivityIDs(ids ...uuid.UUID) {
+	if m.removedactivities == nil {
+		m.removedactivities = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.activities, ids[i])
+		m.removedactivities[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedActivities returns the removed IDs of the "activities" edge to the Activity entity.
+func (m *UserMutation) RemovedActivitiesIDs() (ids []uuid.UUID) {
+	for id := range m.removedactivities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ActivitiesIDs returns the "activities" edge IDs in the mutation.
+func (m *UserMutation) ActivitiesIDs() (ids []uuid.UUID) {
+	for id := range m.activities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetActivities resets all changes to the "activities" edge.
+func (m *UserMutation) ResetActivities() {
+	m.activities = nil
+	m.clearedactivities = false
+	m.removedactivities = nil
+}
+
+// AddFriendshipIDs adds the "friendship" edge to the Friendship entity by ids.
+func (m *UserMutation) AddFriendshipIDs(ids ...int) {
+	if m.friendship == nil {
+		m.friendship = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.friendship[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFriendship clears the "friendship" edge to the Friendship entity.
+func (m *UserMutation) ClearFriendship() {
+	m.clearedfriendship = true
+}
+
+// FriendshipCleared reports if the "friendship" edge to the Friendship entity was cleared.
+func (m *UserMutation) FriendshipCleared() bool {
+	return m.clearedfriendship
+}
+
+// RemoveFriendshipIDs removes the "friendship" edge to the Friendship entity by IDs.
+func (m *UserMutation) RemoveFriendshipIDs(ids ...int) {
+	if m.removedfriendship == nil {
+		m.removedfriendship = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.friendship, ids[i])
+		m.removedfriendship[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFriendship returns the removed IDs of the "friendship" edge to the Friendship entity.
+func (m *UserMutation) RemovedFriendshipIDs() (ids []int) {
+	for id := range m.removedfriendship {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FriendshipIDs returns the "friendship" edge IDs in the mutation.
+func (m *UserMutation) FriendshipIDs() (ids []int) {
+	for id := range m.friendship {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFriendship resets all changes to the "friendship" edge.
+func (m *UserMutation) ResetFriendship() {
+	m.friendship = nil
+	m.clearedfriendship = false
+	m.removedfriendship = nil
+}
+
+// AddHexinfluenceIDs adds the "hexinfluence" edge to the HexInfluence entity by ids.
+func (m *UserMutation) AddHexinfluenceIDs(ids ...uuid.UUID) {
+	if m.hexinfluence == nil {
+		m.hexinfluence = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.hexinfluence[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHexinfluence clears the "hexinfluence" edge to the HexInfluence entity.
+func (m *UserMutation) ClearHexinfluence() {
+	m.clearedhexinfluence = true
+}
+
+// HexinfluenceCleared reports if the "hexinfluence" edge to the HexInfluence entity was cleared.
+func (m *UserMutation) HexinfluenceCleared() bool {
+	return m.clearedhexinfluence
+}
+
+// RemoveHexinfluenceIDs removes the "hexinfluence" edge to the HexInfluence entity by IDs.
+func (m *UserMutation) RemoveHexinfluenceIDs(ids ...uuid.UUID) {
+	if m.removedhexinfluence == nil {
+		m.removedhexinfluence = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.hexinfluence, ids[i])
+		m.removedhexinfluence[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHexinfluence returns the removed IDs of the "hexinfluence" edge to the HexInfluence entity.
+func (m *UserMutation) RemovedHexinfluenceIDs() (ids []uuid.UUID) {
+	for id := range m.removedhexinfluence {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HexinfluenceIDs returns the "hexinfluence" edge IDs in the mutation.
+func (m *UserMutation) HexinfluenceIDs() (ids []uuid.UUID) {
+	for id := range m.hexinfluence {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHexinfluence resets all changes to the "hexinfluence" edge.
+func (m *UserMutation) ResetHexinfluence() {
+	m.hexinfluence = nil
+	m.clearedhexinfluence = false
+	m.removedhexinfluence = nil
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.external_user != nil {
+		fields = append(fields, user.FieldExternalUser)
+	}
+	if m.username != nil {
+		fields = append(fields, user.FieldUsername)
+	}
+	if m.time_zone != nil {
+		fields = append(fields, user.FieldTimeZone)
+	}
+	if m.hide_zone_leaderboards != nil {
+		fields = append(fields, user.FieldHideZoneLeaderboards)
+	}
+	if m.quiet_hours_start != nil {
+		fields = append(fields, user.FieldQuietHoursStart)
+	}
+	if m.quiet_hours_end != nil {
+		fields = append(fields, user.FieldQuietHoursEnd)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldExternalUser:
+		return m.ExternalUser()
+	case user.FieldUsername:
+		return m.Username()
+	case user.FieldTimeZone:
+		return m.TimeZone()
+	case user.FieldHideZoneLeaderboards:
+		return m.HideZoneLeaderboards()
+	case user.FieldQuietHoursStart:
+		return m.QuietHoursStart()
+	case user.FieldQuietHoursEnd:
+		return m.QuietHoursEnd()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldExternalUser:
+		return m.OldExternalUser(ctx)
+	case user.FieldUsername:
+		return m.OldUsername(ctx)
+	case user.FieldTimeZone:
+		return m.OldTimeZone(ctx)
+	case user.FieldHideZoneLeaderboards:
+		return m.OldHideZoneLeaderboards(ctx)
+	case user.FieldQuietHoursStart:
+		return m.OldQuietHoursStart(ctx)
+	case user.FieldQuietHoursEnd:
+		return m.OldQuietHoursEnd(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldExternalUser:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalUser(v)
+		return nil
+	case user.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case user.FieldTimeZone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeZone(v)
+		return nil
+	case user.FieldHideZoneLeaderboards:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHideZoneLeaderboards(v)
+		return nil
+	case user.FieldQuietHoursStart:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuietHoursStart(v)
+		return nil
+	case user.FieldQuietHoursEnd:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuietHoursEnd(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserMutation) AddedFields() []string {
+	var fields []string
+	if m.addquiet_hours_start != nil {
+		fields = append(fields, user.FieldQuietHoursStart)
+	}
+	if m.addquiet_hours_end != nil {
+		fields = append(fields, user.FieldQuietHoursEnd)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldQuietHoursStart:
+		return m.AddedQuietHoursStart()
+	case user.FieldQuietHoursEnd:
+		return m.AddedQuietHoursEnd()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldQuietHoursStart:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuietHoursStart(v)
+		return nil
+	case user.FieldQuietHoursEnd:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuietHoursEnd(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldQuietHoursStart) {
+		fields = append(fields, user.FieldQuietHoursStart)
+	}
+	if m.FieldCleared(user.FieldQuietHoursEnd) {
+		fields = append(fields, user.FieldQuietHoursEnd)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldQuietHoursStart:
+		m.ClearQuietHoursStart()
+		return nil
+	case user.FieldQuietHoursEnd:
+		m.ClearQuietHoursEnd()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldExternalUser:
+		m.ResetExternalUser()
+		return nil
+	case user.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case user.FieldTimeZone:
+		m.ResetTimeZone()
+		return nil
+	case user.FieldHideZoneLeaderboards:
+		m.ResetHideZoneLeaderboards()
+		return nil
+	case user.FieldQuietHoursStart:
+		m.ResetQuietHoursStart()
+		return nil
+	case user.FieldQuietHoursEnd:
+		m.ResetQuietHoursEnd()
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.activities != nil {
+		edges = append(edges, user.EdgeActivities)
+	}
+	if m.friendship != nil {
+		edges = append(edges, user.EdgeFriendship)
+	}
+	if m.hexinfluence != nil {
+		edges = append(edges, user.EdgeHexinfluence)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeActivities:
+		ids := make([]ent.Value, 0, len(m.activities))
+		for id := range m.activities {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFriendship:
+		ids := make([]ent.Value, 0, len(m.friendship))
+		for id := range m.friendship {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeHexinfluence:
+		ids := make([]ent.Value, 0, len(m.hexinfluence))
+		for id := range m.hexinfluence {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedactivities != nil {
+		edges = append(edges, user.EdgeActivities)
+	}
+	if m.removedfriendship != nil {
+		edges = append(edges, user.EdgeFriendship)
+	}
+	if m.removedhexinfluence != nil {
+		edges = append(edges, user.EdgeHexinfluence)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeActivities:
+		ids := make([]ent.Value, 0, len(m.removedactivities))
+		for id := range m.removedactivities {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFriendship:
+		ids := make([]ent.Value, 0, len(m.removedfriendship))
+		for id := range m.removedfriendship {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeHexinfluence:
+		ids := make([]ent.Value, 0, len(m.removedhexinfluence))
+		for id := range m.removedhexinfluence {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedactivities {
+		edges = append(edges, user.EdgeActivities)
+	}
+	if m.clearedfriendship {
+		edges = append(edges, user.EdgeFriendship)
+	}
+	if m.clearedhexinfluence {
+		edges = append(edges, user.EdgeHexinfluence)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeActivities:
+		return m.clearedactivities
+	case user.EdgeFriendship:
+		return m.clearedfriendship
+	case user.EdgeHexinfluence:
+		return m.clearedhexinfluence
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown User unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeActivities:
+		m.ResetActivities()
+		return nil
+	case user.EdgeFriendship:
+		m.ResetFriendship()
+		return nil
+	case user.EdgeHexinfluence:
+		m.ResetHexinfluence()
+		return nil
+	}
+	return fmt.Errorf("unknown User edge %s", name)
+}
+
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	subscription_id    *uuid.UUID
+	event_id           *uuid.UUID
+	event_type         *webhookdelivery.EventType
+	payload            *string
+	status             *webhookdelivery.Status
+	attempts           *int
+	addattempts        *int
+	next_attempt_at    *time.Time
+	locked_until       *time.Time
+	response_status    *int
+	addresponse_status *int
+	last_error         *string
+	delivered_at       *time.Time
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*WebhookDelivery, error)
+	predicates         []predicate.WebhookDelivery
+}
+
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
+
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
+
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebhookDelivery,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id uuid.UUID) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WebhookDelivery
+		)
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookDeliveryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WebhookDelivery entities.
+func (m *WebhookDeliveryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookDeliveryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (m *WebhookDeliveryMutation) SetSubscriptionID(u uuid.UUID) {
+	m.subscription_id = &u
+}
+
+// SubscriptionID returns the value of the "subscription_id" field in the mutation.
+func (m *WebhookDeliveryMutation) SubscriptionID() (r uuid.UUID, exists bool) {
+	v := m.subscription_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubscriptionID returns the old "subscription_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldSubscriptionID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubscriptionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubscriptionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubscriptionID: %w", err)
+	}
+	return oldValue.SubscriptionID, nil
+}
+
+// ResetSubscriptionID resets all changes to the "subscription_id" field.
+func (m *WebhookDeliveryMutation) ResetSubscriptionID() {
+	m.subscription_id = nil
+}
+
+// SetEventID sets the "event_id" field.
+func (m *WebhookDeliveryMutation) SetEventID(u uuid.UUID) {
+	m.event_id = &u
+}
+
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *WebhookDeliveryMutation) EventID() (r uuid.UUID, exists bool) {
+	v := m.event_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventID returns the old "event_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldEventID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
+	}
+	return oldValue.EventID, nil
+}
+
+// ResetEventID resets all changes to the "event_id" field.
+func (m *WebhookDeliveryMutation) ResetEventID() {
+	m.event_id = nil
+}
+
+// SetEventType sets the "event_type" field.
+func (m *WebhookDeliveryMutation) SetEventType(wt webhookdelivery.EventType) {
+	m.event_type = &wt
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *WebhookDeliveryMutation) EventType() (r webhookdelivery.EventType, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldEventType(ctx context.Context) (v webhookdelivery.EventType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+	}
+	return oldValue.EventType, nil
+}
+
+// ResetEventType resets all changes to the "event_type" field.
+func (m *WebhookDeliveryMutation) ResetEventType() {
+	m.event_type = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *WebhookDeliveryMutation) SetPayload(s string) {
+	m.payload = &s
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r string, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldPayload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *WebhookDeliveryMutation) SetStatus(w webhookdelivery.Status) {
+	m.status = &w
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *WebhookDeliveryMutation) Status() (r webhookdelivery.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldStatus(ctx context.Context) (v webhookdelivery.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *WebhookDeliveryMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeliveryMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeliveryMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *WebhookDeliveryMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldNextAttemptAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
+	}
+	return oldValue.NextAttemptAt, nil
+}
+
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (m *WebhookDeliveryMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
+}
+
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *WebhookDeliveryMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedUntil returns the old "locked_until" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldLockedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
+	}
+	return oldValue.LockedUntil, nil
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (m *WebhookDeliveryMutation) ClearLockedUntil() {
+	m.locked_until = nil
+	m.clearedFields[webhookdelivery.FieldLockedUntil] = struct{}{}
+}
+
+// LockedUntilCleared returns if the "locked_until" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) LockedUntilCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldLockedUntil]
+	return ok
+}
+
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *WebhookDeliveryMutation) ResetLockedUntil() {
+	m.locked_until = nil
+	delete(m.clearedFields, webhookdelivery.FieldLockedUntil)
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (m *WebhookDeliveryMutation) SetResponseStatus(i int) {
+	m.response_status = &i
+	m.addresponse_status = nil
+}
+
+// ResponseStatus returns the value of the "response_status" field in the mutation.
+func (m *WebhookDeliveryMutation) ResponseStatus() (r int, exists bool) {
+	v := m.response_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponseStatus returns the old "response_status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldResponseStatus(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseStatus: %w", err)
+	}
+	return oldValue.ResponseStatus, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of HexLeaderboard entities.
-func (m *HexLeaderboardMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// AddResponseStatus adds i to the "response_status" field.
+func (m *WebhookDeliveryMutation) AddResponseStatus(i int) {
+	if m.addresponse_status != nil {
+		*m.addresponse_status += i
+	} else {
+		m.addresponse_status = &i
+	}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *HexLeaderboardMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// AddedResponseStatus returns the value that was added to the "response_status" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedResponseStatus() (r int, exists bool) {
+	v := m.addresponse_status
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *HexLeaderboardMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().HexLeaderboard.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ClearResponseStatus clears the value of the "response_status" field.
+func (m *WebhookDeliveryMutation) ClearResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	m.clearedFields[webhookdelivery.FieldResponseStatus] = struct{}{}
 }
 
-// SetH3Index sets the "h3_index" field.
-func (m *HexLeaderboardMutation) SetH3Index(s string) {
-	m.hex = &s
+// ResponseStatusCleared returns if the "response_status" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ResponseStatusCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldResponseStatus]
+	return ok
 }
 
-// H3Index returns the value of the "h3_index" field in the mutation.
-func (m *HexLeaderboardMutation) H3Index() (r string, exists bool) {
-	v := m.hex
+// ResetResponseStatus resets all changes to the "response_status" field.
+func (m *WebhookDeliveryMutation) ResetResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	delete(m.clearedFields, webhookdelivery.FieldResponseStatus)
+}
+
+// SetLastError sets the "last_error" field.
+func (m *WebhookDeliveryMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *WebhookDeliveryMutation) LastError() (r string, exists bool) {
+	v := m.last_error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldH3Index returns the old "h3_index" field's value of the HexLeaderboard entity.
-// If the HexLeaderboard object wasn't provided to the builder, the object is fetched from the database.
+// OldLastError returns the old "last_error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HexLeaderboardMutation) OldH3Index(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldLastError(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldH3Index is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldH3Index requires an ID field in the mutation")
+		return v, errors.New("OldLastError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldH3Index: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
 	}
-	return oldValue.H3Index, nil
+	return oldValue.LastError, nil
 }
 
-// ResetH3Index resets all changes to the "h3_index" field.
-func (m *HexLeaderboardMutation) ResetH3Index() {
-	m.hex = nil
+// ClearLastError clears the value of the "last_error" field.
+func (m *WebhookDeliveryMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[webhookdelivery.FieldLastError] = struct{}{}
 }
 
-// SetTopUsers sets the "top_users" field.
-func (m *HexLeaderboardMutation) SetTopUsers(mu []model.TopUser) {
-	m.top_users = &mu
-	m.appendtop_users = nil
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldLastError]
+	return ok
 }
 
-// TopUsers returns the value of the "top_users" field in the mutation.
-func (m *HexLeaderboardMutation) TopUsers() (r []model.TopUser, exists bool) {
-	v := m.top_users
+// ResetLastError resets all changes to the "last_error" field.
+func (m *WebhookDeliveryMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, webhookdelivery.FieldLastError)
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (m *WebhookDeliveryMutation) SetDeliveredAt(t time.Time) {
+	m.delivered_at = &t
+}
+
+// DeliveredAt returns the value of the "delivered_at" field in the mutation.
+func (m *WebhookDeliveryMutation) DeliveredAt() (r time.Time, exists bool) {
+	v := m.delivered_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTopUsers returns the old "top_users" field's value of the HexLeaderboard entity.
-// If the HexLeaderboard object wasn't provided to the builder, the object is fetched from the database.
+// OldDeliveredAt returns the old "delivered_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HexLeaderboardMutation) OldTopUsers(ctx context.Context) (v []model.TopUser, err error) {
+func (m *WebhookDeliveryMutation) OldDeliveredAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTopUsers is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeliveredAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTopUsers requires an ID field in the mutation")
+		return v, errors.New("OldDeliveredAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTopUsers: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeliveredAt: %w", err)
 	}
-	return oldValue.TopUsers, nil
+	return oldValue.DeliveredAt, nil
 }
 
-// AppendTopUsers adds mu to the "top_users" field.
-func (m *HexLeaderboardMutation) AppendTopUsers(mu []model.TopUser) {
-	m.appendtop_users = append(m.appendtop_users, mu...)
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (m *WebhookDeliveryMutation) ClearDeliveredAt() {
+	m.delivered_at = nil
+	m.clearedFields[webhookdelivery.FieldDeliveredAt] = struct{}{}
 }
 
-// AppendedTopUsers returns the list of values that were appended to the "top_users" field in this mutation.
-func (m *HexLeaderboardMutation) AppendedTopUsers() ([]model.TopUser, bool) {
-	if len(m.appendtop_users) == 0 {
-		return nil, false
-	}
-	return m.appendtop_users, true
+// DeliveredAtCleared returns if the "delivered_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) DeliveredAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldDeliveredAt]
+	return ok
 }
 
-// ResetTopUsers resets all changes to the "top_users" field.
-func (m *HexLeaderboardMutation) ResetTopUsers() {
-	m.top_users = nil
-	m.appendtop_users = nil
+// ResetDeliveredAt resets all changes to the "delivered_at" field.
+func (m *WebhookDeliveryMutation) ResetDeliveredAt() {
+	m.delivered_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldDeliveredAt)
 }
 
-// SetHexID sets the "hex" edge to the Hex entity by id.
-func (m *HexLeaderboardMutation) SetHexID(id string) {
-	m.hex = &id
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookDeliveryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ClearHex clears the "hex" edge to the Hex entity.
-func (m *HexLeaderboardMutation) ClearHex() {
-	m.clearedhex = true
-	m.clearedFields[hexleaderboard.FieldH3Index] = struct{}{}
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// HexCleared reports if the "hex" edge to the Hex entity was cleared.
-func (m *HexLeaderboardMutation) HexCleared() bool {
-	return m.clearedhex
+// OldCreatedAt returns the old "created_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// HexID returns the "hex" edge ID in the mutation.
-func (m *HexLeaderboardMutation) HexID() (id string, exists bool) {
-	if m.hex != nil {
-		return *m.hex, true
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookDeliveryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *WebhookDeliveryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WebhookDeliveryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// HexIDs returns the "hex" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// HexID instead. It exists only for internal usage by the builders.
-func (m *HexLeaderboardMutation) HexIDs() (ids []string) {
-	if id := m.hex; id != nil {
-		ids = append(ids, *id)
+// OldUpdatedAt returns the old "updated_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetHex resets all changes to the "hex" edge.
-func (m *HexLeaderboardMutation) ResetHex() {
-	m.hex = nil
-	m.clearedhex = false
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WebhookDeliveryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Where appends a list predicates to the HexLeaderboardMutation builder.
-func (m *HexLeaderboardMutation) Where(ps ...predicate.HexLeaderboard) {
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the HexLeaderboardMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *HexLeaderboardMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.HexLeaderboard, len(ps))
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2657,30 +22989,63 @@ func (m *HexLeaderboardMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *HexLeaderboardMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *HexLeaderboardMutation) SetOp(op Op) {
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (HexLeaderboard).
-func (m *HexLeaderboardMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *HexLeaderboardMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.hex != nil {
-		fields = append(fields, hexleaderboard.FieldH3Index)
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.subscription_id != nil {
+		fields = append(fields, webhookdelivery.FieldSubscriptionID)
 	}
-	if m.top_users != nil {
-		fields = append(fields, hexleaderboard.FieldTopUsers)
+	if m.event_id != nil {
+		fields = append(fields, webhookdelivery.FieldEventID)
+	}
+	if m.event_type != nil {
+		fields = append(fields, webhookdelivery.FieldEventType)
+	}
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
+	}
+	if m.status != nil {
+		fields = append(fields, webhookdelivery.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, webhookdelivery.FieldLockedUntil)
+	}
+	if m.response_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.last_error != nil {
+		fields = append(fields, webhookdelivery.FieldLastError)
+	}
+	if m.delivered_at != nil {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, webhookdelivery.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, webhookdelivery.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2688,12 +23053,34 @@ func (m *HexLeaderboardMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *HexLeaderboardMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case hexleaderboard.FieldH3Index:
-		return m.H3Index()
-	case hexleaderboard.FieldTopUsers:
-		return m.TopUsers()
+	case webhookdelivery.FieldSubscriptionID:
+		return m.SubscriptionID()
+	case webhookdelivery.FieldEventID:
+		return m.EventID()
+	case webhookdelivery.FieldEventType:
+		return m.EventType()
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldStatus:
+		return m.Status()
+	case webhookdelivery.FieldAttempts:
+		return m.Attempts()
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case webhookdelivery.FieldLockedUntil:
+		return m.LockedUntil()
+	case webhookdelivery.FieldResponseStatus:
+		return m.ResponseStatus()
+	case webhookdelivery.FieldLastError:
+		return m.LastError()
+	case webhookdelivery.FieldDeliveredAt:
+		return m.DeliveredAt()
+	case webhookdelivery.FieldCreatedAt:
+		return m.CreatedAt()
+	case webhookdelivery.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -2701,202 +23088,357 @@ func (m *HexLeaderboardMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *HexLeaderboardMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case hexleaderboard.FieldH3Index:
-		return m.OldH3Index(ctx)
-	case hexleaderboard.FieldTopUsers:
-		return m.OldTopUsers(ctx)
+	case webhookdelivery.FieldSubscriptionID:
+		return m.OldSubscriptionID(ctx)
+	case webhookdelivery.FieldEventID:
+		return m.OldEventID(ctx)
+	case webhookdelivery.FieldEventType:
+		return m.OldEventType(ctx)
+	case webhookdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdelivery.FieldStatus:
+		return m.OldStatus(ctx)
+	case webhookdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case webhookdelivery.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
+	case webhookdelivery.FieldResponseStatus:
+		return m.OldResponseStatus(ctx)
+	case webhookdelivery.FieldLastError:
+		return m.OldLastError(ctx)
+	case webhookdelivery.FieldDeliveredAt:
+		return m.OldDeliveredAt(ctx)
+	case webhookdelivery.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case webhookdelivery.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown HexLeaderboard field %s", name)
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HexLeaderboardMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case hexleaderboard.FieldH3Index:
+	case webhookdelivery.FieldSubscriptionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubscriptionID(v)
+		return nil
+	case webhookdelivery.FieldEventID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventID(v)
+		return nil
+	case webhookdelivery.FieldEventType:
+		v, ok := value.(webhookdelivery.EventType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventType(v)
+		return nil
+	case webhookdelivery.FieldPayload:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetH3Index(v)
+		m.SetPayload(v)
 		return nil
-	case hexleaderboard.FieldTopUsers:
-		v, ok := value.([]model.TopUser)
+	case webhookdelivery.FieldStatus:
+		v, ok := value.(webhookdelivery.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTopUsers(v)
+		m.SetStatus(v)
+		return nil
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case webhookdelivery.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseStatus(v)
+		return nil
+	case webhookdelivery.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveredAt(v)
+		return nil
+	case webhookdelivery.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case webhookdelivery.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown HexLeaderboard field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *HexLeaderboardMutation) AddedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.addresponse_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *HexLeaderboardMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case webhookdelivery.FieldResponseStatus:
+		return m.AddedResponseStatus()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HexLeaderboardMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseStatus(v)
+		return nil
 	}
-	return fmt.Errorf("unknown HexLeaderboard numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *HexLeaderboardMutation) ClearedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(webhookdelivery.FieldLockedUntil) {
+		fields = append(fields, webhookdelivery.FieldLockedUntil)
+	}
+	if m.FieldCleared(webhookdelivery.FieldResponseStatus) {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.FieldCleared(webhookdelivery.FieldLastError) {
+		fields = append(fields, webhookdelivery.FieldLastError)
+	}
+	if m.FieldCleared(webhookdelivery.FieldDeliveredAt) {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *HexLeaderboardMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *HexLeaderboardMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown HexLeaderboard nullable field %s", name)
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
+	switch name {
+	case webhookdelivery.FieldLockedUntil:
+		m.ClearLockedUntil()
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		m.ClearResponseStatus()
+		return nil
+	case webhookdelivery.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		m.ClearDeliveredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *HexLeaderboardMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case hexleaderboard.FieldH3Index:
-		m.ResetH3Index()
+	case webhookdelivery.FieldSubscriptionID:
+		m.ResetSubscriptionID()
 		return nil
-	case hexleaderboard.FieldTopUsers:
-		m.ResetTopUsers()
+	case webhookdelivery.FieldEventID:
+		m.ResetEventID()
+		return nil
+	case webhookdelivery.FieldEventType:
+		m.ResetEventType()
+		return nil
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case webhookdelivery.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case webhookdelivery.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case webhookdelivery.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		m.ResetResponseStatus()
+		return nil
+	case webhookdelivery.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		m.ResetDeliveredAt()
+		return nil
+	case webhookdelivery.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case webhookdelivery.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown HexLeaderboard field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *HexLeaderboardMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.hex != nil {
-		edges = append(edges, hexleaderboard.EdgeHex)
-	}
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *HexLeaderboardMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case hexleaderboard.EdgeHex:
-		if id := m.hex; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *HexLeaderboardMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *HexLeaderboardMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *HexLeaderboardMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedhex {
-		edges = append(edges, hexleaderboard.EdgeHex)
-	}
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *HexLeaderboardMutation) EdgeCleared(name string) bool {
-	switch name {
-	case hexleaderboard.EdgeHex:
-		return m.clearedhex
-	}
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *HexLeaderboardMutation) ClearEdge(name string) error {
-	switch name {
-	case hexleaderboard.EdgeHex:
-		m.ClearHex()
-		return nil
-	}
-	return fmt.Errorf("unknown HexLeaderboard unique edge %s", name)
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *HexLeaderboardMutation) ResetEdge(name string) error {
-	switch name {
-	case hexleaderboard.EdgeHex:
-		m.ResetHex()
-		return nil
-	}
-	return fmt.Errorf("unknown HexLeaderboard edge %s", name)
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// WebhookSubscriptionMutation represents an operation that mutates the WebhookSubscription nodes in the graph.
+type WebhookSubscriptionMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *uuid.UUID
-	external_user       *uuid.UUID
-	username            *string
-	clearedFields       map[string]struct{}
-	activities          map[uuid.UUID]struct{}
-	removedactivities   map[uuid.UUID]struct{}
-	clearedactivities   bool
-	friendship          map[int]struct{}
-	removedfriendship   map[int]struct{}
-	clearedfriendship   bool
-	hexinfluence        map[uuid.UUID]struct{}
-	removedhexinfluence map[uuid.UUID]struct{}
-	clearedhexinfluence bool
-	done                bool
-	oldValue            func(context.Context) (*User, error)
-	predicates          []predicate.User
-}
-
-var _ ent.Mutation = (*UserMutation)(nil)
-
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
-
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	user_id           *uuid.UUID
+	url               *string
+	event_types       *[]string
+	appendevent_types []string
+	secret            *string
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*WebhookSubscription, error)
+	predicates        []predicate.WebhookSubscription
+}
+
+var _ ent.Mutation = (*WebhookSubscriptionMutation)(nil)
+
+// webhooksubscriptionOption allows management of the mutation configuration using functional options.
+type webhooksubscriptionOption func(*WebhookSubscriptionMutation)
+
+// newWebhookSubscriptionMutation creates new mutation for the WebhookSubscription entity.
+func newWebhookSubscriptionMutation(c config, op Op, opts ...webhooksubscriptionOption) *WebhookSubscriptionMutation {
+	m := &WebhookSubscriptionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeWebhookSubscription,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2905,20 +23447,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id uuid.UUID) userOption {
-	return func(m *UserMutation) {
+// withWebhookSubscriptionID sets the ID field of the mutation.
+func withWebhookSubscriptionID(id uuid.UUID) webhooksubscriptionOption {
+	return func(m *WebhookSubscriptionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *WebhookSubscription
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookSubscription, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().WebhookSubscription.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2927,10 +23469,10 @@ func withUserID(id uuid.UUID) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withWebhookSubscription sets the old WebhookSubscription of the mutation.
+func withWebhookSubscription(node *WebhookSubscription) webhooksubscriptionOption {
+	return func(m *WebhookSubscriptionMutation) {
+		m.oldValue = func(context.Context) (*WebhookSubscription, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2939,7 +23481,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m WebhookSubscriptionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2947,7 +23489,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m WebhookSubscriptionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2957,14 +23499,14 @@ func (m UserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of WebhookSubscription entities.
+func (m *WebhookSubscriptionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+func (m *WebhookSubscriptionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2975,7 +23517,7 @@ func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *WebhookSubscriptionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2984,255 +23526,216 @@ func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebhookSubscription.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetExternalUser sets the "external_user" field.
-func (m *UserMutation) SetExternalUser(u uuid.UUID) {
-	m.external_user = &u
+// SetUserID sets the "user_id" field.
+func (m *WebhookSubscriptionMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
 }
 
-// ExternalUser returns the value of the "external_user" field in the mutation.
-func (m *UserMutation) ExternalUser() (r uuid.UUID, exists bool) {
-	v := m.external_user
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *WebhookSubscriptionMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExternalUser returns the old "external_user" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldExternalUser(ctx context.Context) (v uuid.UUID, err error) {
+func (m *WebhookSubscriptionMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExternalUser is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExternalUser requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExternalUser: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.ExternalUser, nil
+	return oldValue.UserID, nil
 }
 
-// ResetExternalUser resets all changes to the "external_user" field.
-func (m *UserMutation) ResetExternalUser() {
-	m.external_user = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *WebhookSubscriptionMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// SetUsername sets the "username" field.
-func (m *UserMutation) SetUsername(s string) {
-	m.username = &s
+// SetURL sets the "url" field.
+func (m *WebhookSubscriptionMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// Username returns the value of the "username" field in the mutation.
-func (m *UserMutation) Username() (r string, exists bool) {
-	v := m.username
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookSubscriptionMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsername returns the old "username" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
+func (m *WebhookSubscriptionMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsername requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
-	}
-	return oldValue.Username, nil
-}
-
-// ResetUsername resets all changes to the "username" field.
-func (m *UserMutation) ResetUsername() {
-	m.username = nil
-}
-
-// AddActivityIDs adds the "activities" edge to the Activity entity by ids.
-func (m *UserMutation) AddActivityIDs(ids ...uuid.UUID) {
-	if m.activities == nil {
-		m.activities = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.activities[ids[i]] = struct{}{}
-	}
-}
-
-// ClearActivities clears the "activities" edge to the Activity entity.
-func (m *UserMutation) ClearActivities() {
-	m.clearedactivities = true
-}
-
-// ActivitiesCleared reports if the "activities" edge to the Activity entity was cleared.
-func (m *UserMutation) ActivitiesCleared() bool {
-	return m.clearedactivities
-}
-
-// RemoveActivityIDs removes the "activities" edge to the Activity entity by IDs.
-func (m *UserMutation) RemoveActivityIDs(ids ...uuid.UUID) {
-	if m.removedactivities == nil {
-		m.removedactivities = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.activities, ids[i])
-		m.removedactivities[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedActivities returns the removed IDs of the "activities" edge to the Activity entity.
-func (m *UserMutation) RemovedActivitiesIDs() (ids []uuid.UUID) {
-	for id := range m.removedactivities {
-		ids = append(ids, id)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return
+	return oldValue.URL, nil
 }
 
-// ActivitiesIDs returns the "activities" edge IDs in the mutation.
-func (m *UserMutation) ActivitiesIDs() (ids []uuid.UUID) {
-	for id := range m.activities {
-		ids = append(ids, id)
-	}
-	return
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookSubscriptionMutation) ResetURL() {
+	m.url = nil
 }
 
-// ResetActivities resets all changes to the "activities" edge.
-func (m *UserMutation) ResetActivities() {
-	m.activities = nil
-	m.clearedactivities = false
-	m.removedactivities = nil
+// SetEventTypes sets the "event_types" field.
+func (m *WebhookSubscriptionMutation) SetEventTypes(s []string) {
+	m.event_types = &s
+	m.appendevent_types = nil
 }
 
-// AddFriendshipIDs adds the "friendship" edge to the Friendship entity by ids.
-func (m *UserMutation) AddFriendshipIDs(ids ...int) {
-	if m.friendship == nil {
-		m.friendship = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.friendship[ids[i]] = struct{}{}
+// EventTypes returns the value of the "event_types" field in the mutation.
+func (m *WebhookSubscriptionMutation) EventTypes() (r []string, exists bool) {
+	v := m.event_types
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearFriendship clears the "friendship" edge to the Friendship entity.
-func (m *UserMutation) ClearFriendship() {
-	m.clearedfriendship = true
-}
-
-// FriendshipCleared reports if the "friendship" edge to the Friendship entity was cleared.
-func (m *UserMutation) FriendshipCleared() bool {
-	return m.clearedfriendship
-}
-
-// RemoveFriendshipIDs removes the "friendship" edge to the Friendship entity by IDs.
-func (m *UserMutation) RemoveFriendshipIDs(ids ...int) {
-	if m.removedfriendship == nil {
-		m.removedfriendship = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.friendship, ids[i])
-		m.removedfriendship[ids[i]] = struct{}{}
+// OldEventTypes returns the old "event_types" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookSubscriptionMutation) OldEventTypes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventTypes is only allowed on UpdateOne operations")
 	}
-}
-
-// RemovedFriendship returns the removed IDs of the "friendship" edge to the Friendship entity.
-func (m *UserMutation) RemovedFriendshipIDs() (ids []int) {
-	for id := range m.removedfriendship {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventTypes requires an ID field in the mutation")
 	}
-	return
-}
-
-// FriendshipIDs returns the "friendship" edge IDs in the mutation.
-func (m *UserMutation) FriendshipIDs() (ids []int) {
-	for id := range m.friendship {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventTypes: %w", err)
 	}
-	return
+	return oldValue.EventTypes, nil
 }
 
-// ResetFriendship resets all changes to the "friendship" edge.
-func (m *UserMutation) ResetFriendship() {
-	m.friendship = nil
-	m.clearedfriendship = false
-	m.removedfriendship = nil
+// AppendEventTypes adds s to the "event_types" field.
+func (m *WebhookSubscriptionMutation) AppendEventTypes(s []string) {
+	m.appendevent_types = append(m.appendevent_types, s...)
 }
 
-// AddHexinfluenceIDs adds the "hexinfluence" edge to the HexInfluence entity by ids.
-func (m *UserMutation) AddHexinfluenceIDs(ids ...uuid.UUID) {
-	if m.hexinfluence == nil {
-		m.hexinfluence = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.hexinfluence[ids[i]] = struct{}{}
+// AppendedEventTypes returns the list of values that were appended to the "event_types" field in this mutation.
+func (m *WebhookSubscriptionMutation) AppendedEventTypes() ([]string, bool) {
+	if len(m.appendevent_types) == 0 {
+		return nil, false
 	}
+	return m.appendevent_types, true
 }
 
-// ClearHexinfluence clears the "hexinfluence" edge to the HexInfluence entity.
-func (m *UserMutation) ClearHexinfluence() {
-	m.clearedhexinfluence = true
+// ResetEventTypes resets all changes to the "event_types" field.
+func (m *WebhookSubscriptionMutation) ResetEventTypes() {
+	m.event_types = nil
+	m.appendevent_types = nil
 }
 
-// HexinfluenceCleared reports if the "hexinfluence" edge to the HexInfluence entity was cleared.
-func (m *UserMutation) HexinfluenceCleared() bool {
-	return m.clearedhexinfluence
+// SetSecret sets the "secret" field.
+func (m *WebhookSubscriptionMutation) SetSecret(s string) {
+	m.secret = &s
 }
 
-// RemoveHexinfluenceIDs removes the "hexinfluence" edge to the HexInfluence entity by IDs.
-func (m *UserMutation) RemoveHexinfluenceIDs(ids ...uuid.UUID) {
-	if m.removedhexinfluence == nil {
-		m.removedhexinfluence = make(map[uuid.UUID]struct{})
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookSubscriptionMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.hexinfluence, ids[i])
-		m.removedhexinfluence[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookSubscriptionMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
 }
 
-// RemovedHexinfluence returns the removed IDs of the "hexinfluence" edge to the HexInfluence entity.
-func (m *UserMutation) RemovedHexinfluenceIDs() (ids []uuid.UUID) {
-	for id := range m.removedhexinfluence {
-		ids = append(ids, id)
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookSubscriptionMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookSubscriptionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookSubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// HexinfluenceIDs returns the "hexinfluence" edge IDs in the mutation.
-func (m *UserMutation) HexinfluenceIDs() (ids []uuid.UUID) {
-	for id := range m.hexinfluence {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookSubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetHexinfluence resets all changes to the "hexinfluence" edge.
-func (m *UserMutation) ResetHexinfluence() {
-	m.hexinfluence = nil
-	m.clearedhexinfluence = false
-	m.removedhexinfluence = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookSubscriptionMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the WebhookSubscriptionMutation builder.
+func (m *WebhookSubscriptionMutation) Where(ps ...predicate.WebhookSubscription) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookSubscriptionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *WebhookSubscriptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookSubscription, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3240,30 +23743,39 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *WebhookSubscriptionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *WebhookSubscriptionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (WebhookSubscription).
+func (m *WebhookSubscriptionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.external_user != nil {
-		fields = append(fields, user.FieldExternalUser)
+func (m *WebhookSubscriptionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.user_id != nil {
+		fields = append(fields, webhooksubscription.FieldUserID)
 	}
-	if m.username != nil {
-		fields = append(fields, user.FieldUsername)
+	if m.url != nil {
+		fields = append(fields, webhooksubscription.FieldURL)
+	}
+	if m.event_types != nil {
+		fields = append(fields, webhooksubscription.FieldEventTypes)
+	}
+	if m.secret != nil {
+		fields = append(fields, webhooksubscription.FieldSecret)
+	}
+	if m.created_at != nil {
+		fields = append(fields, webhooksubscription.FieldCreatedAt)
 	}
 	return fields
 }
@@ -3271,12 +23783,18 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookSubscriptionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldExternalUser:
-		return m.ExternalUser()
-	case user.FieldUsername:
-		return m.Username()
+	case webhooksubscription.FieldUserID:
+		return m.UserID()
+	case webhooksubscription.FieldURL:
+		return m.URL()
+	case webhooksubscription.FieldEventTypes:
+		return m.EventTypes()
+	case webhooksubscription.FieldSecret:
+		return m.Secret()
+	case webhooksubscription.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -3284,226 +23802,174 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookSubscriptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldExternalUser:
-		return m.OldExternalUser(ctx)
-	case user.FieldUsername:
-		return m.OldUsername(ctx)
+	case webhooksubscription.FieldUserID:
+		return m.OldUserID(ctx)
+	case webhooksubscription.FieldURL:
+		return m.OldURL(ctx)
+	case webhooksubscription.FieldEventTypes:
+		return m.OldEventTypes(ctx)
+	case webhooksubscription.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhooksubscription.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown WebhookSubscription field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookSubscriptionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldExternalUser:
+	case webhooksubscription.FieldUserID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExternalUser(v)
+		m.SetUserID(v)
 		return nil
-	case user.FieldUsername:
+	case webhooksubscription.FieldURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUsername(v)
+		m.SetURL(v)
+		return nil
+	case webhooksubscription.FieldEventTypes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventTypes(v)
+		return nil
+	case webhooksubscription.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case webhooksubscription.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WebhookSubscription field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
+func (m *WebhookSubscriptionMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookSubscriptionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookSubscriptionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown WebhookSubscription numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
+func (m *WebhookSubscriptionMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *WebhookSubscriptionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *WebhookSubscriptionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WebhookSubscription nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *WebhookSubscriptionMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldExternalUser:
-		m.ResetExternalUser()
+	case webhooksubscription.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case user.FieldUsername:
-		m.ResetUsername()
+	case webhooksubscription.FieldURL:
+		m.ResetURL()
+		return nil
+	case webhooksubscription.FieldEventTypes:
+		m.ResetEventTypes()
+		return nil
+	case webhooksubscription.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case webhooksubscription.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WebhookSubscription field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.activities != nil {
-		edges = append(edges, user.EdgeActivities)
-	}
-	if m.friendship != nil {
-		edges = append(edges, user.EdgeFriendship)
-	}
-	if m.hexinfluence != nil {
-		edges = append(edges, user.EdgeHexinfluence)
-	}
+func (m *WebhookSubscriptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeActivities:
-		ids := make([]ent.Value, 0, len(m.activities))
-		for id := range m.activities {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeFriendship:
-		ids := make([]ent.Value, 0, len(m.friendship))
-		for id := range m.friendship {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeHexinfluence:
-		ids := make([]ent.Value, 0, len(m.hexinfluence))
-		for id := range m.hexinfluence {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WebhookSubscriptionMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedactivities != nil {
-		edges = append(edges, user.EdgeActivities)
-	}
-	if m.removedfriendship != nil {
-		edges = append(edges, user.EdgeFriendship)
-	}
-	if m.removedhexinfluence != nil {
-		edges = append(edges, user.EdgeHexinfluence)
-	}
+func (m *WebhookSubscriptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeActivities:
-		ids := make([]ent.Value, 0, len(m.removedactivities))
-		for id := range m.removedactivities {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeFriendship:
-		ids := make([]ent.Value, 0, len(m.removedfriendship))
-		for id := range m.removedfriendship {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeHexinfluence:
-		ids := make([]ent.Value, 0, len(m.removedhexinfluence))
-		for id := range m.removedhexinfluence {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WebhookSubscriptionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedactivities {
-		edges = append(edges, user.EdgeActivities)
-	}
-	if m.clearedfriendship {
-		edges = append(edges, user.EdgeFriendship)
-	}
-	if m.clearedhexinfluence {
-		edges = append(edges, user.EdgeHexinfluence)
-	}
+func (m *WebhookSubscriptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
-	switch name {
-	case user.EdgeActivities:
-		return m.clearedactivities
-	case user.EdgeFriendship:
-		return m.clearedfriendship
-	case user.EdgeHexinfluence:
-		return m.clearedhexinfluence
-	}
+func (m *WebhookSubscriptionMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+func (m *WebhookSubscriptionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WebhookSubscription unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
-	switch name {
-	case user.EdgeActivities:
-		m.ResetActivities()
-		return nil
-	case user.EdgeFriendship:
-		m.ResetFriendship()
-		return nil
-	case user.EdgeHexinfluence:
-		m.ResetHexinfluence()
-		return nil
-	}
-	return fmt.Errorf("unknown User edge %s", name)
+func (m *WebhookSubscriptionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WebhookSubscription edge %s", name)
 }
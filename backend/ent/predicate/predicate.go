@@ -9,17 +9,98 @@ import (
 // Activity is the predicate function for activity builders.
 type Activity func(*sql.Selector)
 
+// ActivityHex is the predicate function for activityhex builders.
+type ActivityHex func(*sql.Selector)
+
+// ActivityJob is the predicate function for activityjob builders.
+type ActivityJob func(*sql.Selector)
+
+// ActivitySession is the predicate function for activitysession builders.
+type ActivitySession func(*sql.Selector)
+
+// DecaySweep is the predicate function for decaysweep builders.
+type DecaySweep func(*sql.Selector)
+
 // Friendship is the predicate function for friendship builders.
 type Friendship func(*sql.Selector)
 
+// Goal is the predicate function for goal builders.
+type Goal func(*sql.Selector)
+
 // Hex is the predicate function for hex builders.
 type Hex func(*sql.Selector)
 
+// HexCapture is the predicate function for hexcapture builders.
+type HexCapture func(*sql.Selector)
+
 // HexInfluence is the predicate function for hexinfluence builders.
 type HexInfluence func(*sql.Selector)
 
 // HexLeaderboard is the predicate function for hexleaderboard builders.
 type HexLeaderboard func(*sql.Selector)
 
+// HexRollup is the predicate function for hexrollup builders.
+type HexRollup func(*sql.Selector)
+
+// IdempotencyKey is the predicate function for idempotencykey builders.
+type IdempotencyKey func(*sql.Selector)
+
+// InfluenceHistory is the predicate function for influencehistory builders.
+type InfluenceHistory func(*sql.Selector)
+
+// Notification is the predicate function for notification builders.
+type Notification func(*sql.Selector)
+
+// NotificationPreference is the predicate function for notificationpreference builders.
+type NotificationPreference func(*sql.Selector)
+
+// PersonalRecord is the predicate function for personalrecord builders.
+type PersonalRecord func(*sql.Selector)
+
+// PrivacyZone is the predicate function for privacyzone builders.
+type PrivacyZone func(*sql.Selector)
+
+// PushDevice is the predicate function for pushdevice builders.
+type PushDevice func(*sql.Selector)
+
+// PushMessage is the predicate function for pushmessage builders.
+type PushMessage func(*sql.Selector)
+
+// Region is the predicate function for region builders.
+type Region func(*sql.Selector)
+
+// RegionHex is the predicate function for regionhex builders.
+type RegionHex func(*sql.Selector)
+
+// RegionStanding is the predicate function for regionstanding builders.
+type RegionStanding func(*sql.Selector)
+
+// Season is the predicate function for season builders.
+type Season func(*sql.Selector)
+
+// SeasonHexStanding is the predicate function for seasonhexstanding builders.
+type SeasonHexStanding func(*sql.Selector)
+
+// SeasonStanding is the predicate function for seasonstanding builders.
+type SeasonStanding func(*sql.Selector)
+
+// Segment is the predicate function for segment builders.
+type Segment func(*sql.Selector)
+
+// SegmentEffort is the predicate function for segmenteffort builders.
+type SegmentEffort func(*sql.Selector)
+
+// Setting is the predicate function for setting builders.
+type Setting func(*sql.Selector)
+
+// Streak is the predicate function for streak builders.
+type Streak func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
+// WebhookSubscription is the predicate function for webhooksubscription builders.
+type WebhookSubscription func(*sql.Selector)
@@ -4,10 +4,36 @@ package ent
 
 import (
 	"stride-wars-app/ent/activity"
+	"stride-wars-app/ent/activityhex"
+	"stride-wars-app/ent/activityjob"
+	"stride-wars-app/ent/activitysession"
+	"stride-wars-app/ent/decaysweep"
+	"stride-wars-app/ent/goal"
+	"stride-wars-app/ent/hexcapture"
 	"stride-wars-app/ent/hexinfluence"
 	"stride-wars-app/ent/hexleaderboard"
+	"stride-wars-app/ent/hexrollup"
+	"stride-wars-app/ent/idempotencykey"
+	"stride-wars-app/ent/influencehistory"
 	"stride-wars-app/ent/model"
+	"stride-wars-app/ent/notification"
+	"stride-wars-app/ent/notificationpreference"
+	"stride-wars-app/ent/personalrecord"
+	"stride-wars-app/ent/privacyzone"
+	"stride-wars-app/ent/pushdevice"
+	"stride-wars-app/ent/pushmessage"
+	"stride-wars-app/ent/region"
+	"stride-wars-app/ent/regionhex"
+	"stride-wars-app/ent/regionstanding"
+	"stride-wars-app/ent/season"
+	"stride-wars-app/ent/seasonhexstanding"
+	"stride-wars-app/ent/seasonstanding"
+	"stride-wars-app/ent/segment"
+	"stride-wars-app/ent/segmenteffort"
+	"stride-wars-app/ent/streak"
 	"stride-wars-app/ent/user"
+	"stride-wars-app/ent/webhookdelivery"
+	"stride-wars-app/ent/webhooksubscription"
 	"time"
 
 	"github.com/google/uuid"
@@ -22,13 +48,113 @@ func init() {
 	// activityDescCreatedAt is the schema descriptor for created_at field.
 	activityDescCreatedAt := activityFields[5].Descriptor()
 	// activity.DefaultCreatedAt holds the default value on creation for the created_at field.
-	activity.DefaultCreatedAt = activityDescCreatedAt.Default.(time.Time)
+	activity.DefaultCreatedAt = activityDescCreatedAt.Default.(func() time.Time)
+	// activityDescNewHexes is the schema descriptor for new_hexes field.
+	activityDescNewHexes := activityFields[10].Descriptor()
+	// activity.DefaultNewHexes holds the default value on creation for the new_hexes field.
+	activity.DefaultNewHexes = activityDescNewHexes.Default.(int)
 	// activityDescID is the schema descriptor for id field.
 	activityDescID := activityFields[0].Descriptor()
 	// activity.DefaultID holds the default value on creation for the id field.
 	activity.DefaultID = activityDescID.Default.(func() uuid.UUID)
+	activityhexFields := model.ActivityHex{}.Fields()
+	_ = activityhexFields
+	// activityhexDescID is the schema descriptor for id field.
+	activityhexDescID := activityhexFields[0].Descriptor()
+	// activityhex.DefaultID holds the default value on creation for the id field.
+	activityhex.DefaultID = activityhexDescID.Default.(func() uuid.UUID)
+	activityjobFields := model.ActivityJob{}.Fields()
+	_ = activityjobFields
+	// activityjobDescAlreadyScored is the schema descriptor for already_scored field.
+	activityjobDescAlreadyScored := activityjobFields[4].Descriptor()
+	// activityjob.DefaultAlreadyScored holds the default value on creation for the already_scored field.
+	activityjob.DefaultAlreadyScored = activityjobDescAlreadyScored.Default.(int)
+	// activityjobDescAttempts is the schema descriptor for attempts field.
+	activityjobDescAttempts := activityjobFields[5].Descriptor()
+	// activityjob.DefaultAttempts holds the default value on creation for the attempts field.
+	activityjob.DefaultAttempts = activityjobDescAttempts.Default.(int)
+	// activityjobDescID is the schema descriptor for id field.
+	activityjobDescID := activityjobFields[0].Descriptor()
+	// activityjob.DefaultID holds the default value on creation for the id field.
+	activityjob.DefaultID = activityjobDescID.Default.(func() uuid.UUID)
+	activitysessionFields := model.ActivitySession{}.Fields()
+	_ = activitysessionFields
+	// activitysessionDescH3Indexes is the schema descriptor for h3_indexes field.
+	activitysessionDescH3Indexes := activitysessionFields[4].Descriptor()
+	// activitysession.DefaultH3Indexes holds the default value on creation for the h3_indexes field.
+	activitysession.DefaultH3Indexes = activitysessionDescH3Indexes.Default.([]string)
+	// activitysessionDescDistanceMeters is the schema descriptor for distance_meters field.
+	activitysessionDescDistanceMeters := activitysessionFields[6].Descriptor()
+	// activitysession.DefaultDistanceMeters holds the default value on creation for the distance_meters field.
+	activitysession.DefaultDistanceMeters = activitysessionDescDistanceMeters.Default.(float64)
+	// activitysessionDescActiveSeconds is the schema descriptor for active_seconds field.
+	activitysessionDescActiveSeconds := activitysessionFields[7].Descriptor()
+	// activitysession.DefaultActiveSeconds holds the default value on creation for the active_seconds field.
+	activitysession.DefaultActiveSeconds = activitysessionDescActiveSeconds.Default.(float64)
+	// activitysessionDescScoredCells is the schema descriptor for scored_cells field.
+	activitysessionDescScoredCells := activitysessionFields[8].Descriptor()
+	// activitysession.DefaultScoredCells holds the default value on creation for the scored_cells field.
+	activitysession.DefaultScoredCells = activitysessionDescScoredCells.Default.(int)
+	// activitysessionDescID is the schema descriptor for id field.
+	activitysessionDescID := activitysessionFields[0].Descriptor()
+	// activitysession.DefaultID holds the default value on creation for the id field.
+	activitysession.DefaultID = activitysessionDescID.Default.(func() uuid.UUID)
+	decaysweepFields := model.DecaySweep{}.Fields()
+	_ = decaysweepFields
+	// decaysweepDescProcessed is the schema descriptor for processed field.
+	decaysweepDescProcessed := decaysweepFields[3].Descriptor()
+	// decaysweep.DefaultProcessed holds the default value on creation for the processed field.
+	decaysweep.DefaultProcessed = decaysweepDescProcessed.Default.(int)
+	// decaysweepDescDecayed is the schema descriptor for decayed field.
+	decaysweepDescDecayed := decaysweepFields[4].Descriptor()
+	// decaysweep.DefaultDecayed holds the default value on creation for the decayed field.
+	decaysweep.DefaultDecayed = decaysweepDescDecayed.Default.(int)
+	// decaysweepDescPruned is the schema descriptor for pruned field.
+	decaysweepDescPruned := decaysweepFields[5].Descriptor()
+	// decaysweep.DefaultPruned holds the default value on creation for the pruned field.
+	decaysweep.DefaultPruned = decaysweepDescPruned.Default.(int)
+	// decaysweepDescOwnerChanges is the schema descriptor for owner_changes field.
+	decaysweepDescOwnerChanges := decaysweepFields[6].Descriptor()
+	// decaysweep.DefaultOwnerChanges holds the default value on creation for the owner_changes field.
+	decaysweep.DefaultOwnerChanges = decaysweepDescOwnerChanges.Default.(int)
+	// decaysweepDescID is the schema descriptor for id field.
+	decaysweepDescID := decaysweepFields[0].Descriptor()
+	// decaysweep.DefaultID holds the default value on creation for the id field.
+	decaysweep.DefaultID = decaysweepDescID.Default.(func() uuid.UUID)
+	goalFields := model.Goal{}.Fields()
+	_ = goalFields
+	// goalDescTarget is the schema descriptor for target field.
+	goalDescTarget := goalFields[4].Descriptor()
+	// goal.TargetValidator is a validator for the "target" field. It is called by the builders before save.
+	goal.TargetValidator = goalDescTarget.Validators[0].(func(float64) error)
+	// goalDescProgress is the schema descriptor for progress field.
+	goalDescProgress := goalFields[5].Descriptor()
+	// goal.DefaultProgress holds the default value on creation for the progress field.
+	goal.DefaultProgress = goalDescProgress.Default.(float64)
+	// goalDescCreatedAt is the schema descriptor for created_at field.
+	goalDescCreatedAt := goalFields[7].Descriptor()
+	// goal.DefaultCreatedAt holds the default value on creation for the created_at field.
+	goal.DefaultCreatedAt = goalDescCreatedAt.Default.(func() time.Time)
+	// goalDescID is the schema descriptor for id field.
+	goalDescID := goalFields[0].Descriptor()
+	// goal.DefaultID holds the default value on creation for the id field.
+	goal.DefaultID = goalDescID.Default.(func() uuid.UUID)
+	hexcaptureFields := model.HexCapture{}.Fields()
+	_ = hexcaptureFields
+	// hexcaptureDescPreviousScore is the schema descriptor for previous_score field.
+	hexcaptureDescPreviousScore := hexcaptureFields[4].Descriptor()
+	// hexcapture.DefaultPreviousScore holds the default value on creation for the previous_score field.
+	hexcapture.DefaultPreviousScore = hexcaptureDescPreviousScore.Default.(float64)
+	// hexcaptureDescID is the schema descriptor for id field.
+	hexcaptureDescID := hexcaptureFields[0].Descriptor()
+	// hexcapture.DefaultID holds the default value on creation for the id field.
+	hexcapture.DefaultID = hexcaptureDescID.Default.(func() uuid.UUID)
 	hexinfluenceFields := model.HexInfluence{}.Fields()
 	_ = hexinfluenceFields
+	// hexinfluenceDescCarriedScore is the schema descriptor for carried_score field.
+	hexinfluenceDescCarriedScore := hexinfluenceFields[5].Descriptor()
+	// hexinfluence.DefaultCarriedScore holds the default value on creation for the carried_score field.
+	hexinfluence.DefaultCarriedScore = hexinfluenceDescCarriedScore.Default.(float64)
 	// hexinfluenceDescID is the schema descriptor for id field.
 	hexinfluenceDescID := hexinfluenceFields[0].Descriptor()
 	// hexinfluence.DefaultID holds the default value on creation for the id field.
@@ -43,10 +169,262 @@ func init() {
 	hexleaderboardDescID := hexleaderboardFields[0].Descriptor()
 	// hexleaderboard.DefaultID holds the default value on creation for the id field.
 	hexleaderboard.DefaultID = hexleaderboardDescID.Default.(func() uuid.UUID)
+	hexrollupFields := model.HexRollup{}.Fields()
+	_ = hexrollupFields
+	// hexrollupDescOwners is the schema descriptor for owners field.
+	hexrollupDescOwners := hexrollupFields[3].Descriptor()
+	// hexrollup.DefaultOwners holds the default value on creation for the owners field.
+	hexrollup.DefaultOwners = hexrollupDescOwners.Default.([]model.RollupOwner)
+	// hexrollupDescID is the schema descriptor for id field.
+	hexrollupDescID := hexrollupFields[0].Descriptor()
+	// hexrollup.DefaultID holds the default value on creation for the id field.
+	hexrollup.DefaultID = hexrollupDescID.Default.(func() uuid.UUID)
+	idempotencykeyFields := model.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescKey is the schema descriptor for key field.
+	idempotencykeyDescKey := idempotencykeyFields[1].Descriptor()
+	// idempotencykey.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	idempotencykey.KeyValidator = idempotencykeyDescKey.Validators[0].(func(string) error)
+	// idempotencykeyDescCreatedAt is the schema descriptor for created_at field.
+	idempotencykeyDescCreatedAt := idempotencykeyFields[5].Descriptor()
+	// idempotencykey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotencykey.DefaultCreatedAt = idempotencykeyDescCreatedAt.Default.(func() time.Time)
+	// idempotencykeyDescID is the schema descriptor for id field.
+	idempotencykeyDescID := idempotencykeyFields[0].Descriptor()
+	// idempotencykey.DefaultID holds the default value on creation for the id field.
+	idempotencykey.DefaultID = idempotencykeyDescID.Default.(func() uuid.UUID)
+	influencehistoryFields := model.InfluenceHistory{}.Fields()
+	_ = influencehistoryFields
+	// influencehistoryDescID is the schema descriptor for id field.
+	influencehistoryDescID := influencehistoryFields[0].Descriptor()
+	// influencehistory.DefaultID holds the default value on creation for the id field.
+	influencehistory.DefaultID = influencehistoryDescID.Default.(func() uuid.UUID)
+	notificationFields := model.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescCreatedAt is the schema descriptor for created_at field.
+	notificationDescCreatedAt := notificationFields[6].Descriptor()
+	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
+	// notificationDescID is the schema descriptor for id field.
+	notificationDescID := notificationFields[0].Descriptor()
+	// notification.DefaultID holds the default value on creation for the id field.
+	notification.DefaultID = notificationDescID.Default.(func() uuid.UUID)
+	notificationpreferenceFields := model.NotificationPreference{}.Fields()
+	_ = notificationpreferenceFields
+	// notificationpreferenceDescID is the schema descriptor for id field.
+	notificationpreferenceDescID := notificationpreferenceFields[0].Descriptor()
+	// notificationpreference.DefaultID holds the default value on creation for the id field.
+	notificationpreference.DefaultID = notificationpreferenceDescID.Default.(func() uuid.UUID)
+	personalrecordFields := model.PersonalRecord{}.Fields()
+	_ = personalrecordFields
+	// personalrecordDescID is the schema descriptor for id field.
+	personalrecordDescID := personalrecordFields[0].Descriptor()
+	// personalrecord.DefaultID holds the default value on creation for the id field.
+	personalrecord.DefaultID = personalrecordDescID.Default.(func() uuid.UUID)
+	privacyzoneFields := model.PrivacyZone{}.Fields()
+	_ = privacyzoneFields
+	// privacyzoneDescName is the schema descriptor for name field.
+	privacyzoneDescName := privacyzoneFields[2].Descriptor()
+	// privacyzone.DefaultName holds the default value on creation for the name field.
+	privacyzone.DefaultName = privacyzoneDescName.Default.(string)
+	// privacyzoneDescLat is the schema descriptor for lat field.
+	privacyzoneDescLat := privacyzoneFields[3].Descriptor()
+	// privacyzone.DefaultLat holds the default value on creation for the lat field.
+	privacyzone.DefaultLat = privacyzoneDescLat.Default.(float64)
+	// privacyzoneDescLng is the schema descriptor for lng field.
+	privacyzoneDescLng := privacyzoneFields[4].Descriptor()
+	// privacyzone.DefaultLng holds the default value on creation for the lng field.
+	privacyzone.DefaultLng = privacyzoneDescLng.Default.(float64)
+	// privacyzoneDescRadiusMeters is the schema descriptor for radius_meters field.
+	privacyzoneDescRadiusMeters := privacyzoneFields[5].Descriptor()
+	// privacyzone.DefaultRadiusMeters holds the default value on creation for the radius_meters field.
+	privacyzone.DefaultRadiusMeters = privacyzoneDescRadiusMeters.Default.(float64)
+	// privacyzoneDescH3Indexes is the schema descriptor for h3_indexes field.
+	privacyzoneDescH3Indexes := privacyzoneFields[6].Descriptor()
+	// privacyzone.DefaultH3Indexes holds the default value on creation for the h3_indexes field.
+	privacyzone.DefaultH3Indexes = privacyzoneDescH3Indexes.Default.([]string)
+	// privacyzoneDescCreatedAt is the schema descriptor for created_at field.
+	privacyzoneDescCreatedAt := privacyzoneFields[7].Descriptor()
+	// privacyzone.DefaultCreatedAt holds the default value on creation for the created_at field.
+	privacyzone.DefaultCreatedAt = privacyzoneDescCreatedAt.Default.(func() time.Time)
+	// privacyzoneDescID is the schema descriptor for id field.
+	privacyzoneDescID := privacyzoneFields[0].Descriptor()
+	// privacyzone.DefaultID holds the default value on creation for the id field.
+	privacyzone.DefaultID = privacyzoneDescID.Default.(func() uuid.UUID)
+	pushdeviceFields := model.PushDevice{}.Fields()
+	_ = pushdeviceFields
+	// pushdeviceDescDeviceID is the schema descriptor for device_id field.
+	pushdeviceDescDeviceID := pushdeviceFields[2].Descriptor()
+	// pushdevice.DeviceIDValidator is a validator for the "device_id" field. It is called by the builders before save.
+	pushdevice.DeviceIDValidator = pushdeviceDescDeviceID.Validators[0].(func(string) error)
+	// pushdeviceDescToken is the schema descriptor for token field.
+	pushdeviceDescToken := pushdeviceFields[3].Descriptor()
+	// pushdevice.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	pushdevice.TokenValidator = pushdeviceDescToken.Validators[0].(func(string) error)
+	// pushdeviceDescID is the schema descriptor for id field.
+	pushdeviceDescID := pushdeviceFields[0].Descriptor()
+	// pushdevice.DefaultID holds the default value on creation for the id field.
+	pushdevice.DefaultID = pushdeviceDescID.Default.(func() uuid.UUID)
+	pushmessageFields := model.PushMessage{}.Fields()
+	_ = pushmessageFields
+	// pushmessageDescAttempts is the schema descriptor for attempts field.
+	pushmessageDescAttempts := pushmessageFields[7].Descriptor()
+	// pushmessage.DefaultAttempts holds the default value on creation for the attempts field.
+	pushmessage.DefaultAttempts = pushmessageDescAttempts.Default.(int)
+	// pushmessageDescID is the schema descriptor for id field.
+	pushmessageDescID := pushmessageFields[0].Descriptor()
+	// pushmessage.DefaultID holds the default value on creation for the id field.
+	pushmessage.DefaultID = pushmessageDescID.Default.(func() uuid.UUID)
+	regionFields := model.Region{}.Fields()
+	_ = regionFields
+	// regionDescSlug is the schema descriptor for slug field.
+	regionDescSlug := regionFields[1].Descriptor()
+	// region.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	region.SlugValidator = regionDescSlug.Validators[0].(func(string) error)
+	// regionDescName is the schema descriptor for name field.
+	regionDescName := regionFields[2].Descriptor()
+	// region.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	region.NameValidator = regionDescName.Validators[0].(func(string) error)
+	// regionDescKind is the schema descriptor for kind field.
+	regionDescKind := regionFields[3].Descriptor()
+	// region.DefaultKind holds the default value on creation for the kind field.
+	region.DefaultKind = regionDescKind.Default.(string)
+	// regionDescCreatedAt is the schema descriptor for created_at field.
+	regionDescCreatedAt := regionFields[6].Descriptor()
+	// region.DefaultCreatedAt holds the default value on creation for the created_at field.
+	region.DefaultCreatedAt = regionDescCreatedAt.Default.(func() time.Time)
+	// regionDescUpdatedAt is the schema descriptor for updated_at field.
+	regionDescUpdatedAt := regionFields[7].Descriptor()
+	// region.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	region.DefaultUpdatedAt = regionDescUpdatedAt.Default.(func() time.Time)
+	// regionDescID is the schema descriptor for id field.
+	regionDescID := regionFields[0].Descriptor()
+	// region.DefaultID holds the default value on creation for the id field.
+	region.DefaultID = regionDescID.Default.(func() uuid.UUID)
+	regionhexFields := model.RegionHex{}.Fields()
+	_ = regionhexFields
+	// regionhexDescID is the schema descriptor for id field.
+	regionhexDescID := regionhexFields[0].Descriptor()
+	// regionhex.DefaultID holds the default value on creation for the id field.
+	regionhex.DefaultID = regionhexDescID.Default.(func() uuid.UUID)
+	regionstandingFields := model.RegionStanding{}.Fields()
+	_ = regionstandingFields
+	// regionstandingDescRank is the schema descriptor for rank field.
+	regionstandingDescRank := regionstandingFields[2].Descriptor()
+	// regionstanding.RankValidator is a validator for the "rank" field. It is called by the builders before save.
+	regionstanding.RankValidator = regionstandingDescRank.Validators[0].(func(int) error)
+	// regionstandingDescID is the schema descriptor for id field.
+	regionstandingDescID := regionstandingFields[0].Descriptor()
+	// regionstanding.DefaultID holds the default value on creation for the id field.
+	regionstanding.DefaultID = regionstandingDescID.Default.(func() uuid.UUID)
+	seasonFields := model.Season{}.Fields()
+	_ = seasonFields
+	// seasonDescNumber is the schema descriptor for number field.
+	seasonDescNumber := seasonFields[1].Descriptor()
+	// season.NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	season.NumberValidator = seasonDescNumber.Validators[0].(func(int) error)
+	// seasonDescCarryOver is the schema descriptor for carry_over field.
+	seasonDescCarryOver := seasonFields[5].Descriptor()
+	// season.DefaultCarryOver holds the default value on creation for the carry_over field.
+	season.DefaultCarryOver = seasonDescCarryOver.Default.(float64)
+	// seasonDescID is the schema descriptor for id field.
+	seasonDescID := seasonFields[0].Descriptor()
+	// season.DefaultID holds the default value on creation for the id field.
+	season.DefaultID = seasonDescID.Default.(func() uuid.UUID)
+	seasonhexstandingFields := model.SeasonHexStanding{}.Fields()
+	_ = seasonhexstandingFields
+	// seasonhexstandingDescRank is the schema descriptor for rank field.
+	seasonhexstandingDescRank := seasonhexstandingFields[3].Descriptor()
+	// seasonhexstanding.RankValidator is a validator for the "rank" field. It is called by the builders before save.
+	seasonhexstanding.RankValidator = seasonhexstandingDescRank.Validators[0].(func(int) error)
+	// seasonhexstandingDescID is the schema descriptor for id field.
+	seasonhexstandingDescID := seasonhexstandingFields[0].Descriptor()
+	// seasonhexstanding.DefaultID holds the default value on creation for the id field.
+	seasonhexstanding.DefaultID = seasonhexstandingDescID.Default.(func() uuid.UUID)
+	seasonstandingFields := model.SeasonStanding{}.Fields()
+	_ = seasonstandingFields
+	// seasonstandingDescRank is the schema descriptor for rank field.
+	seasonstandingDescRank := seasonstandingFields[2].Descriptor()
+	// seasonstanding.RankValidator is a validator for the "rank" field. It is called by the builders before save.
+	seasonstanding.RankValidator = seasonstandingDescRank.Validators[0].(func(int) error)
+	// seasonstandingDescID is the schema descriptor for id field.
+	seasonstandingDescID := seasonstandingFields[0].Descriptor()
+	// seasonstanding.DefaultID holds the default value on creation for the id field.
+	seasonstanding.DefaultID = seasonstandingDescID.Default.(func() uuid.UUID)
+	segmentFields := model.Segment{}.Fields()
+	_ = segmentFields
+	// segmentDescName is the schema descriptor for name field.
+	segmentDescName := segmentFields[1].Descriptor()
+	// segment.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	segment.NameValidator = segmentDescName.Validators[0].(func(string) error)
+	// segmentDescCreatedAt is the schema descriptor for created_at field.
+	segmentDescCreatedAt := segmentFields[5].Descriptor()
+	// segment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	segment.DefaultCreatedAt = segmentDescCreatedAt.Default.(func() time.Time)
+	// segmentDescID is the schema descriptor for id field.
+	segmentDescID := segmentFields[0].Descriptor()
+	// segment.DefaultID holds the default value on creation for the id field.
+	segment.DefaultID = segmentDescID.Default.(func() uuid.UUID)
+	segmenteffortFields := model.SegmentEffort{}.Fields()
+	_ = segmenteffortFields
+	// segmenteffortDescEstimated is the schema descriptor for estimated field.
+	segmenteffortDescEstimated := segmenteffortFields[6].Descriptor()
+	// segmenteffort.DefaultEstimated holds the default value on creation for the estimated field.
+	segmenteffort.DefaultEstimated = segmenteffortDescEstimated.Default.(bool)
+	// segmenteffortDescID is the schema descriptor for id field.
+	segmenteffortDescID := segmenteffortFields[0].Descriptor()
+	// segmenteffort.DefaultID holds the default value on creation for the id field.
+	segmenteffort.DefaultID = segmenteffortDescID.Default.(func() uuid.UUID)
+	streakFields := model.Streak{}.Fields()
+	_ = streakFields
+	// streakDescCurrent is the schema descriptor for current field.
+	streakDescCurrent := streakFields[3].Descriptor()
+	// streak.DefaultCurrent holds the default value on creation for the current field.
+	streak.DefaultCurrent = streakDescCurrent.Default.(int)
+	// streakDescLongest is the schema descriptor for longest field.
+	streakDescLongest := streakFields[4].Descriptor()
+	// streak.DefaultLongest holds the default value on creation for the longest field.
+	streak.DefaultLongest = streakDescLongest.Default.(int)
+	// streakDescID is the schema descriptor for id field.
+	streakDescID := streakFields[0].Descriptor()
+	// streak.DefaultID holds the default value on creation for the id field.
+	streak.DefaultID = streakDescID.Default.(func() uuid.UUID)
 	userFields := model.User{}.Fields()
 	_ = userFields
+	// userDescTimeZone is the schema descriptor for time_zone field.
+	userDescTimeZone := userFields[3].Descriptor()
+	// user.DefaultTimeZone holds the default value on creation for the time_zone field.
+	user.DefaultTimeZone = userDescTimeZone.Default.(string)
+	// userDescHideZoneLeaderboards is the schema descriptor for hide_zone_leaderboards field.
+	userDescHideZoneLeaderboards := userFields[4].Descriptor()
+	// user.DefaultHideZoneLeaderboards holds the default value on creation for the hide_zone_leaderboards field.
+	user.DefaultHideZoneLeaderboards = userDescHideZoneLeaderboards.Default.(bool)
 	// userDescID is the schema descriptor for id field.
 	userDescID := userFields[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
 	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	webhookdeliveryFields := model.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[6].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.DefaultID holds the default value on creation for the id field.
+	webhookdelivery.DefaultID = webhookdeliveryDescID.Default.(func() uuid.UUID)
+	webhooksubscriptionFields := model.WebhookSubscription{}.Fields()
+	_ = webhooksubscriptionFields
+	// webhooksubscriptionDescURL is the schema descriptor for url field.
+	webhooksubscriptionDescURL := webhooksubscriptionFields[2].Descriptor()
+	// webhooksubscription.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhooksubscription.URLValidator = webhooksubscriptionDescURL.Validators[0].(func(string) error)
+	// webhooksubscriptionDescSecret is the schema descriptor for secret field.
+	webhooksubscriptionDescSecret := webhooksubscriptionFields[4].Descriptor()
+	// webhooksubscription.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhooksubscription.SecretValidator = webhooksubscriptionDescSecret.Validators[0].(func(string) error)
+	// webhooksubscriptionDescID is the schema descriptor for id field.
+	webhooksubscriptionDescID := webhooksubscriptionFields[0].Descriptor()
+	// webhooksubscription.DefaultID holds the default value on creation for the id field.
+	webhooksubscription.DefaultID = webhooksubscriptionDescID.Default.(func() uuid.UUID)
 }
@@ -14,16 +14,70 @@ type Tx struct {
 	config
 	// Activity is the client for interacting with the Activity builders.
 	Activity *ActivityClient
+	// ActivityHex is the client for interacting with the ActivityHex builders.
+	ActivityHex *ActivityHexClient
+	// ActivityJob is the client for interacting with the ActivityJob builders.
+	ActivityJob *ActivityJobClient
+	// ActivitySession is the client for interacting with the ActivitySession builders.
+	ActivitySession *ActivitySessionClient
+	// DecaySweep is the client for interacting with the DecaySweep builders.
+	DecaySweep *DecaySweepClient
 	// Friendship is the client for interacting with the Friendship builders.
 	Friendship *FriendshipClient
+	// Goal is the client for interacting with the Goal builders.
+	Goal *GoalClient
 	// Hex is the client for interacting with the Hex builders.
 	Hex *HexClient
+	// HexCapture is the client for interacting with the HexCapture builders.
+	HexCapture *HexCaptureClient
 	// HexInfluence is the client for interacting with the HexInfluence builders.
 	HexInfluence *HexInfluenceClient
 	// HexLeaderboard is the client for interacting with the HexLeaderboard builders.
 	HexLeaderboard *HexLeaderboardClient
+	// HexRollup is the client for interacting with the HexRollup builders.
+	HexRollup *HexRollupClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// InfluenceHistory is the client for interacting with the InfluenceHistory builders.
+	InfluenceHistory *InfluenceHistoryClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
+	// PersonalRecord is the client for interacting with the PersonalRecord builders.
+	PersonalRecord *PersonalRecordClient
+	// PrivacyZone is the client for interacting with the PrivacyZone builders.
+	PrivacyZone *PrivacyZoneClient
+	// PushDevice is the client for interacting with the PushDevice builders.
+	PushDevice *PushDeviceClient
+	// PushMessage is the client for interacting with the PushMessage builders.
+	PushMessage *PushMessageClient
+	// Region is the client for interacting with the Region builders.
+	Region *RegionClient
+	// RegionHex is the client for interacting with the RegionHex builders.
+	RegionHex *RegionHexClient
+	// RegionStanding is the client for interacting with the RegionStanding builders.
+	RegionStanding *RegionStandingClient
+	// Season is the client for interacting with the Season builders.
+	Season *SeasonClient
+	// SeasonHexStanding is the client for interacting with the SeasonHexStanding builders.
+	SeasonHexStanding *SeasonHexStandingClient
+	// SeasonStanding is the client for interacting with the SeasonStanding builders.
+	SeasonStanding *SeasonStandingClient
+	// Segment is the client for interacting with the Segment builders.
+	Segment *SegmentClient
+	// SegmentEffort is the client for interacting with the SegmentEffort builders.
+	SegmentEffort *SegmentEffortClient
+	// Setting is the client for interacting with the Setting builders.
+	Setting *SettingClient
+	// Streak is the client for interacting with the Streak builders.
+	Streak *StreakClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookSubscription is the client for interacting with the WebhookSubscription builders.
+	WebhookSubscription *WebhookSubscriptionClient
 
 	// lazily loaded.
 	client     *Client
@@ -156,11 +210,38 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Activity = NewActivityClient(tx.config)
+	tx.ActivityHex = NewActivityHexClient(tx.config)
+	tx.ActivityJob = NewActivityJobClient(tx.config)
+	tx.ActivitySession = NewActivitySessionClient(tx.config)
+	tx.DecaySweep = NewDecaySweepClient(tx.config)
 	tx.Friendship = NewFriendshipClient(tx.config)
+	tx.Goal = NewGoalClient(tx.config)
 	tx.Hex = NewHexClient(tx.config)
+	tx.HexCapture = NewHexCaptureClient(tx.config)
 	tx.HexInfluence = NewHexInfluenceClient(tx.config)
 	tx.HexLeaderboard = NewHexLeaderboardClient(tx.config)
+	tx.HexRollup = NewHexRollupClient(tx.config)
+	tx.IdempotencyKey = NewIdempotencyKeyClient(tx.config)
+	tx.InfluenceHistory = NewInfluenceHistoryClient(tx.config)
+	tx.Notification = NewNotificationClient(tx.config)
+	tx.NotificationPreference = NewNotificationPreferenceClient(tx.config)
+	tx.PersonalRecord = NewPersonalRecordClient(tx.config)
+	tx.PrivacyZone = NewPrivacyZoneClient(tx.config)
+	tx.PushDevice = NewPushDeviceClient(tx.config)
+	tx.PushMessage = NewPushMessageClient(tx.config)
+	tx.Region = NewRegionClient(tx.config)
+	tx.RegionHex = NewRegionHexClient(tx.config)
+	tx.RegionStanding = NewRegionStandingClient(tx.config)
+	tx.Season = NewSeasonClient(tx.config)
+	tx.SeasonHexStanding = NewSeasonHexStandingClient(tx.config)
+	tx.SeasonStanding = NewSeasonStandingClient(tx.config)
+	tx.Segment = NewSegmentClient(tx.config)
+	tx.SegmentEffort = NewSegmentEffortClient(tx.config)
+	tx.Setting = NewSettingClient(tx.config)
+	tx.Streak = NewStreakClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
+	tx.WebhookSubscription = NewWebhookSubscriptionClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -21,6 +21,14 @@ type User struct {
 	ExternalUser uuid.UUID `json:"external_user,omitempty"`
 	// Username holds the value of the "username" field.
 	Username string `json:"username,omitempty"`
+	// TimeZone holds the value of the "time_zone" field.
+	TimeZone string `json:"time_zone,omitempty"`
+	// HideZoneLeaderboards holds the value of the "hide_zone_leaderboards" field.
+	HideZoneLeaderboards bool `json:"hide_zone_leaderboards,omitempty"`
+	// QuietHoursStart holds the value of the "quiet_hours_start" field.
+	QuietHoursStart *int `json:"quiet_hours_start,omitempty"`
+	// QuietHoursEnd holds the value of the "quiet_hours_end" field.
+	QuietHoursEnd *int `json:"quiet_hours_end,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -72,7 +80,11 @@ func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldUsername:
+		case user.FieldHideZoneLeaderboards:
+			values[i] = new(sql.NullBool)
+		case user.FieldQuietHoursStart, user.FieldQuietHoursEnd:
+			values[i] = new(sql.NullInt64)
+		case user.FieldUsername, user.FieldTimeZone:
 			values[i] = new(sql.NullString)
 		case user.FieldID, user.FieldExternalUser:
 			values[i] = new(uuid.UUID)
@@ -109,6 +121,32 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Username = value.String
 			}
+		case user.FieldTimeZone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field time_zone", values[i])
+			} else if value.Valid {
+				u.TimeZone = value.String
+			}
+		case user.FieldHideZoneLeaderboards:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field hide_zone_leaderboards", values[i])
+			} else if value.Valid {
+				u.HideZoneLeaderboards = value.Bool
+			}
+		case user.FieldQuietHoursStart:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quiet_hours_start", values[i])
+			} else if value.Valid {
+				u.QuietHoursStart = new(int)
+				*u.QuietHoursStart = int(value.Int64)
+			}
+		case user.FieldQuietHoursEnd:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quiet_hours_end", values[i])
+			} else if value.Valid {
+				u.QuietHoursEnd = new(int)
+				*u.QuietHoursEnd = int(value.Int64)
+			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
 		}
@@ -165,6 +203,22 @@ func (u *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("username=")
 	builder.WriteString(u.Username)
+	builder.WriteString(", ")
+	builder.WriteString("time_zone=")
+	builder.WriteString(u.TimeZone)
+	builder.WriteString(", ")
+	builder.WriteString("hide_zone_leaderboards=")
+	builder.WriteString(fmt.Sprintf("%v", u.HideZoneLeaderboards))
+	builder.WriteString(", ")
+	if v := u.QuietHoursStart; v != nil {
+		builder.WriteString("quiet_hours_start=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := u.QuietHoursEnd; v != nil {
+		builder.WriteString("quiet_hours_end=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
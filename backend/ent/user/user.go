@@ -17,6 +17,14 @@ const (
 	FieldExternalUser = "external_user"
 	// FieldUsername holds the string denoting the username field in the database.
 	FieldUsername = "username"
+	// FieldTimeZone holds the string denoting the time_zone field in the database.
+	FieldTimeZone = "time_zone"
+	// FieldHideZoneLeaderboards holds the string denoting the hide_zone_leaderboards field in the database.
+	FieldHideZoneLeaderboards = "hide_zone_leaderboards"
+	// FieldQuietHoursStart holds the string denoting the quiet_hours_start field in the database.
+	FieldQuietHoursStart = "quiet_hours_start"
+	// FieldQuietHoursEnd holds the string denoting the quiet_hours_end field in the database.
+	FieldQuietHoursEnd = "quiet_hours_end"
 	// EdgeActivities holds the string denoting the activities edge name in mutations.
 	EdgeActivities = "activities"
 	// EdgeFriendship holds the string denoting the friendship edge name in mutations.
@@ -53,6 +61,10 @@ var Columns = []string{
 	FieldID,
 	FieldExternalUser,
 	FieldUsername,
+	FieldTimeZone,
+	FieldHideZoneLeaderboards,
+	FieldQuietHoursStart,
+	FieldQuietHoursEnd,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -66,6 +78,10 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultTimeZone holds the default value on creation for the "time_zone" field.
+	DefaultTimeZone string
+	// DefaultHideZoneLeaderboards holds the default value on creation for the "hide_zone_leaderboards" field.
+	DefaultHideZoneLeaderboards bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -88,6 +104,26 @@ func ByUsername(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUsername, opts...).ToFunc()
 }
 
+// ByTimeZone orders the results by the time_zone field.
+func ByTimeZone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeZone, opts...).ToFunc()
+}
+
+// ByHideZoneLeaderboards orders the results by the hide_zone_leaderboards field.
+func ByHideZoneLeaderboards(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHideZoneLeaderboards, opts...).ToFunc()
+}
+
+// ByQuietHoursStart orders the results by the quiet_hours_start field.
+func ByQuietHoursStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuietHoursStart, opts...).ToFunc()
+}
+
+// ByQuietHoursEnd orders the results by the quiet_hours_end field.
+func ByQuietHoursEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuietHoursEnd, opts...).ToFunc()
+}
+
 // ByActivitiesCount orders the results by activities count.
 func ByActivitiesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
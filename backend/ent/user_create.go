@@ -11,6 +11,8 @@ import (
 	"stride-wars-app/ent/hexinfluence"
 	"stride-wars-app/ent/user"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -21,6 +23,7 @@ type UserCreate struct {
 	config
 	mutation *UserMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetExternalUser sets the "external_user" field.
@@ -35,6 +38,62 @@ func (uc *UserCreate) SetUsername(s string) *UserCreate {
 	return uc
 }
 
+// SetTimeZone sets the "time_zone" field.
+func (uc *UserCreate) SetTimeZone(s string) *UserCreate {
+	uc.mutation.SetTimeZone(s)
+	return uc
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTimeZone(s *string) *UserCreate {
+	if s != nil {
+		uc.SetTimeZone(*s)
+	}
+	return uc
+}
+
+// SetHideZoneLeaderboards sets the "hide_zone_leaderboards" field.
+func (uc *UserCreate) SetHideZoneLeaderboards(b bool) *UserCreate {
+	uc.mutation.SetHideZoneLeaderboards(b)
+	return uc
+}
+
+// SetNillableHideZoneLeaderboards sets the "hide_zone_leaderboards" field if the given value is not nil.
+func (uc *UserCreate) SetNillableHideZoneLeaderboards(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetHideZoneLeaderboards(*b)
+	}
+	return uc
+}
+
+// SetQuietHoursStart sets the "quiet_hours_start" field.
+func (uc *UserCreate) SetQuietHoursStart(i int) *UserCreate {
+	uc.mutation.SetQuietHoursStart(i)
+	return uc
+}
+
+// SetNillableQuietHoursStart sets the "quiet_hours_start" field if the given value is not nil.
+func (uc *UserCreate) SetNillableQuietHoursStart(i *int) *UserCreate {
+	if i != nil {
+		uc.SetQuietHoursStart(*i)
+	}
+	return uc
+}
+
+// SetQuietHoursEnd sets the "quiet_hours_end" field.
+func (uc *UserCreate) SetQuietHoursEnd(i int) *UserCreate {
+	uc.mutation.SetQuietHoursEnd(i)
+	return uc
+}
+
+// SetNillableQuietHoursEnd sets the "quiet_hours_end" field if the given value is not nil.
+func (uc *UserCreate) SetNillableQuietHoursEnd(i *int) *UserCreate {
+	if i != nil {
+		uc.SetQuietHoursEnd(*i)
+	}
+	return uc
+}
+
 // SetID sets the "id" field.
 func (uc *UserCreate) SetID(u uuid.UUID) *UserCreate {
 	uc.mutation.SetID(u)
@@ -129,6 +188,14 @@ func (uc *UserCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (uc *UserCreate) defaults() {
+	if _, ok := uc.mutation.TimeZone(); !ok {
+		v := user.DefaultTimeZone
+		uc.mutation.SetTimeZone(v)
+	}
+	if _, ok := uc.mutation.HideZoneLeaderboards(); !ok {
+		v := user.DefaultHideZoneLeaderboards
+		uc.mutation.SetHideZoneLeaderboards(v)
+	}
 	if _, ok := uc.mutation.ID(); !ok {
 		v := user.DefaultID()
 		uc.mutation.SetID(v)
@@ -143,6 +210,12 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.Username(); !ok {
 		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "User.username"`)}
 	}
+	if _, ok := uc.mutation.TimeZone(); !ok {
+		return &ValidationError{Name: "time_zone", err: errors.New(`ent: missing required field "User.time_zone"`)}
+	}
+	if _, ok := uc.mutation.HideZoneLeaderboards(); !ok {
+		return &ValidationError{Name: "hide_zone_leaderboards", err: errors.New(`ent: missing required field "User.hide_zone_leaderboards"`)}
+	}
 	return nil
 }
 
@@ -174,6 +247,7 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_node = &User{config: uc.config}
 		_spec = sqlgraph.NewCreateSpec(user.Table, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = uc.conflict
 	if id, ok := uc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -186,6 +260,22 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldUsername, field.TypeString, value)
 		_node.Username = value
 	}
+	if value, ok := uc.mutation.TimeZone(); ok {
+		_spec.SetField(user.FieldTimeZone, field.TypeString, value)
+		_node.TimeZone = value
+	}
+	if value, ok := uc.mutation.HideZoneLeaderboards(); ok {
+		_spec.SetField(user.FieldHideZoneLeaderboards, field.TypeBool, value)
+		_node.HideZoneLeaderboards = value
+	}
+	if value, ok := uc.mutation.QuietHoursStart(); ok {
+		_spec.SetField(user.FieldQuietHoursStart, field.TypeInt, value)
+		_node.QuietHoursStart = &value
+	}
+	if value, ok := uc.mutation.QuietHoursEnd(); ok {
+		_spec.SetField(user.FieldQuietHoursEnd, field.TypeInt, value)
+		_node.QuietHoursEnd = &value
+	}
 	if nodes := uc.mutation.ActivitiesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -237,11 +327,355 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.User.Create().
+//		SetExternalUser(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserUpsert) {
+//			SetExternalUser(v+v).
+//		}).
+//		Exec(ctx)
+func (uc *UserCreate) OnConflict(opts ...sql.ConflictOption) *UserUpsertOne {
+	uc.conflict = opts
+	return &UserUpsertOne{
+		create: uc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (uc *UserCreate) OnConflictColumns(columns ...string) *UserUpsertOne {
+	uc.conflict = append(uc.conflict, sql.ConflictColumns(columns...))
+	return &UserUpsertOne{
+		create: uc,
+	}
+}
+
+type (
+	// UserUpsertOne is the builder for "upsert"-ing
+	//  one User node.
+	UserUpsertOne struct {
+		create *UserCreate
+	}
+
+	// UserUpsert is the "OnConflict" setter.
+	UserUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetExternalUser sets the "external_user" field.
+func (u *UserUpsert) SetExternalUser(v uuid.UUID) *UserUpsert {
+	u.Set(user.FieldExternalUser, v)
+	return u
+}
+
+// UpdateExternalUser sets the "external_user" field to the value that was provided on create.
+func (u *UserUpsert) UpdateExternalUser() *UserUpsert {
+	u.SetExcluded(user.FieldExternalUser)
+	return u
+}
+
+// SetUsername sets the "username" field.
+func (u *UserUpsert) SetUsername(v string) *UserUpsert {
+	u.Set(user.FieldUsername, v)
+	return u
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *UserUpsert) UpdateUsername() *UserUpsert {
+	u.SetExcluded(user.FieldUsername)
+	return u
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (u *UserUpsert) SetTimeZone(v string) *UserUpsert {
+	u.Set(user.FieldTimeZone, v)
+	return u
+}
+
+// UpdateTimeZone sets the "time_zone" field to the value that was provided on create.
+func (u *UserUpsert) UpdateTimeZone() *UserUpsert {
+	u.SetExcluded(user.FieldTimeZone)
+	return u
+}
+
+// SetHideZoneLeaderboards sets the "hide_zone_leaderboards" field.
+func (u *UserUpsert) SetHideZoneLeaderboards(v bool) *UserUpsert {
+	u.Set(user.FieldHideZoneLeaderboards, v)
+	return u
+}
+
+// UpdateHideZoneLeaderboards sets the "hide_zone_leaderboards" field to the value that was provided on create.
+func (u *UserUpsert) UpdateHideZoneLeaderboards() *UserUpsert {
+	u.SetExcluded(user.FieldHideZoneLeaderboards)
+	return u
+}
+
+// SetQuietHoursStart sets the "quiet_hours_start" field.
+func (u *UserUpsert) SetQuietHoursStart(v int) *UserUpsert {
+	u.Set(user.FieldQuietHoursStart, v)
+	return u
+}
+
+// UpdateQuietHoursStart sets the "quiet_hours_start" field to the value that was provided on create.
+func (u *UserUpsert) UpdateQuietHoursStart() *UserUpsert {
+	u.SetExcluded(user.FieldQuietHoursStart)
+	return u
+}
+
+// AddQuietHoursStart adds v to the "quiet_hours_start" field.
+func (u *UserUpsert) AddQuietHoursStart(v int) *UserUpsert {
+	u.Add(user.FieldQuietHoursStart, v)
+	return u
+}
+
+// ClearQuietHoursStart clears the value of the "quiet_hours_start" field.
+func (u *UserUpsert) ClearQuietHoursStart() *UserUpsert {
+	u.SetNull(user.FieldQuietHoursStart)
+	return u
+}
+
+// SetQuietHoursEnd sets the "quiet_hours_end" field.
+func (u *UserUpsert) SetQuietHoursEnd(v int) *UserUpsert {
+	u.Set(user.FieldQuietHoursEnd, v)
+	return u
+}
+
+// UpdateQuietHoursEnd sets the "quiet_hours_end" field to the value that was provided on create.
+func (u *UserUpsert) UpdateQuietHoursEnd() *UserUpsert {
+	u.SetExcluded(user.FieldQuietHoursEnd)
+	return u
+}
+
+// AddQuietHoursEnd adds v to the "quiet_hours_end" field.
+func (u *UserUpsert) AddQuietHoursEnd(v int) *UserUpsert {
+	u.Add(user.FieldQuietHoursEnd, v)
+	return u
+}
+
+// ClearQuietHoursEnd clears the value of the "quiet_hours_end" field.
+func (u *UserUpsert) ClearQuietHoursEnd() *UserUpsert {
+	u.SetNull(user.FieldQuietHoursEnd)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(user.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserUpsertOne) UpdateNewValues() *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(user.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.User.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserUpsertOne) Ignore() *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserUpsertOne) DoNothing() *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserCreate.OnConflict
+// documentation for more info.
+func (u *UserUpsertOne) Update(set func(*UserUpsert)) *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetExternalUser sets the "external_user" field.
+func (u *UserUpsertOne) SetExternalUser(v uuid.UUID) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetExternalUser(v)
+	})
+}
+
+// UpdateExternalUser sets the "external_user" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateExternalUser() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateExternalUser()
+	})
+}
+
+// SetUsername sets the "username" field.
+func (u *UserUpsertOne) SetUsername(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateUsername() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (u *UserUpsertOne) SetTimeZone(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetTimeZone(v)
+	})
+}
+
+// UpdateTimeZone sets the "time_zone" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateTimeZone() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateTimeZone()
+	})
+}
+
+// SetHideZoneLeaderboards sets the "hide_zone_leaderboards" field.
+func (u *UserUpsertOne) SetHideZoneLeaderboards(v bool) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetHideZoneLeaderboards(v)
+	})
+}
+
+// UpdateHideZoneLeaderboards sets the "hide_zone_leaderboards" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateHideZoneLeaderboards() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateHideZoneLeaderboards()
+	})
+}
+
+// SetQuietHoursStart sets the "quiet_hours_start" field.
+func (u *UserUpsertOne) SetQuietHoursStart(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetQuietHoursStart(v)
+	})
+}
+
+// AddQuietHoursStart adds v to the "quiet_hours_start" field.
+func (u *UserUpsertOne) AddQuietHoursStart(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddQuietHoursStart(v)
+	})
+}
+
+// UpdateQuietHoursStart sets the "quiet_hours_start" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateQuietHoursStart() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateQuietHoursStart()
+	})
+}
+
+// ClearQuietHoursStart clears the value of the "quiet_hours_start" field.
+func (u *UserUpsertOne) ClearQuietHoursStart() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearQuietHoursStart()
+	})
+}
+
+// SetQuietHoursEnd sets the "quiet_hours_end" field.
+func (u *UserUpsertOne) SetQuietHoursEnd(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetQuietHoursEnd(v)
+	})
+}
+
+// AddQuietHoursEnd adds v to the "quiet_hours_end" field.
+func (u *UserUpsertOne) AddQuietHoursEnd(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddQuietHoursEnd(v)
+	})
+}
+
+// UpdateQuietHoursEnd sets the "quiet_hours_end" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateQuietHoursEnd() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateQuietHoursEnd()
+	})
+}
+
+// ClearQuietHoursEnd clears the value of the "quiet_hours_end" field.
+func (u *UserUpsertOne) ClearQuietHoursEnd() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearQuietHoursEnd()
+	})
+}
+
+// Exec executes the query.
+func (u *UserUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: UserUpsertOne.ID is not supported by MySQL driver. Use UserUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // UserCreateBulk is the builder for creating many User entities in bulk.
 type UserCreateBulk struct {
 	config
 	err      error
 	builders []*UserCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the User entities in the database.
@@ -271,6 +705,7 @@ func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 					_, err = mutators[i+1].Mutate(root, ucb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ucb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ucb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -320,3 +755,229 @@ func (ucb *UserCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.User.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserUpsert) {
+//			SetExternalUser(v+v).
+//		}).
+//		Exec(ctx)
+func (ucb *UserCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserUpsertBulk {
+	ucb.conflict = opts
+	return &UserUpsertBulk{
+		create: ucb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ucb *UserCreateBulk) OnConflictColumns(columns ...string) *UserUpsertBulk {
+	ucb.conflict = append(ucb.conflict, sql.ConflictColumns(columns...))
+	return &UserUpsertBulk{
+		create: ucb,
+	}
+}
+
+// UserUpsertBulk is the builder for "upsert"-ing
+// a bulk of User nodes.
+type UserUpsertBulk struct {
+	create *UserCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(user.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserUpsertBulk) UpdateNewValues() *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(user.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserUpsertBulk) Ignore() *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserUpsertBulk) DoNothing() *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserUpsertBulk) Update(set func(*UserUpsert)) *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetExternalUser sets the "external_user" field.
+func (u *UserUpsertBulk) SetExternalUser(v uuid.UUID) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetExternalUser(v)
+	})
+}
+
+// UpdateExternalUser sets the "external_user" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateExternalUser() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateExternalUser()
+	})
+}
+
+// SetUsername sets the "username" field.
+func (u *UserUpsertBulk) SetUsername(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateUsername() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (u *UserUpsertBulk) SetTimeZone(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetTimeZone(v)
+	})
+}
+
+// UpdateTimeZone sets the "time_zone" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateTimeZone() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateTimeZone()
+	})
+}
+
+// SetHideZoneLeaderboards sets the "hide_zone_leaderboards" field.
+func (u *UserUpsertBulk) SetHideZoneLeaderboards(v bool) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetHideZoneLeaderboards(v)
+	})
+}
+
+// UpdateHideZoneLeaderboards sets the "hide_zone_leaderboards" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateHideZoneLeaderboards() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateHideZoneLeaderboards()
+	})
+}
+
+// SetQuietHoursStart sets the "quiet_hours_start" field.
+func (u *UserUpsertBulk) SetQuietHoursStart(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetQuietHoursStart(v)
+	})
+}
+
+// AddQuietHoursStart adds v to the "quiet_hours_start" field.
+func (u *UserUpsertBulk) AddQuietHoursStart(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddQuietHoursStart(v)
+	})
+}
+
+// UpdateQuietHoursStart sets the "quiet_hours_start" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateQuietHoursStart() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateQuietHoursStart()
+	})
+}
+
+// ClearQuietHoursStart clears the value of the "quiet_hours_start" field.
+func (u *UserUpsertBulk) ClearQuietHoursStart() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearQuietHoursStart()
+	})
+}
+
+// SetQuietHoursEnd sets the "quiet_hours_end" field.
+func (u *UserUpsertBulk) SetQuietHoursEnd(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetQuietHoursEnd(v)
+	})
+}
+
+// AddQuietHoursEnd adds v to the "quiet_hours_end" field.
+func (u *UserUpsertBulk) AddQuietHoursEnd(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddQuietHoursEnd(v)
+	})
+}
+
+// UpdateQuietHoursEnd sets the "quiet_hours_end" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateQuietHoursEnd() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateQuietHoursEnd()
+	})
+}
+
+// ClearQuietHoursEnd clears the value of the "quiet_hours_end" field.
+func (u *UserUpsertBulk) ClearQuietHoursEnd() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearQuietHoursEnd()
+	})
+}
+
+// Exec executes the query.
+func (u *UserUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -21,8 +21,9 @@ import (
 // UserUpdate is the builder for updating User entities.
 type UserUpdate struct {
 	config
-	hooks    []Hook
-	mutation *UserMutation
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -59,6 +60,88 @@ func (uu *UserUpdate) SetNillableUsername(s *string) *UserUpdate {
 	return uu
 }
 
+// SetTimeZone sets the "time_zone" field.
+func (uu *UserUpdate) SetTimeZone(s string) *UserUpdate {
+	uu.mutation.SetTimeZone(s)
+	return uu
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTimeZone(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetTimeZone(*s)
+	}
+	return uu
+}
+
+// SetHideZoneLeaderboards sets the "hide_zone_leaderboards" field.
+func (uu *UserUpdate) SetHideZoneLeaderboards(b bool) *UserUpdate {
+	uu.mutation.SetHideZoneLeaderboards(b)
+	return uu
+}
+
+// SetNillableHideZoneLeaderboards sets the "hide_zone_leaderboards" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableHideZoneLeaderboards(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetHideZoneLeaderboards(*b)
+	}
+	return uu
+}
+
+// SetQuietHoursStart sets the "quiet_hours_start" field.
+func (uu *UserUpdate) SetQuietHoursStart(i int) *UserUpdate {
+	uu.mutation.ResetQuietHoursStart()
+	uu.mutation.SetQuietHoursStart(i)
+	return uu
+}
+
+// SetNillableQuietHoursStart sets the "quiet_hours_start" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableQuietHoursStart(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetQuietHoursStart(*i)
+	}
+	return uu
+}
+
+// AddQuietHoursStart adds i to the "quiet_hours_start" field.
+func (uu *UserUpdate) AddQuietHoursStart(i int) *UserUpdate {
+	uu.mutation.AddQuietHoursStart(i)
+	return uu
+}
+
+// ClearQuietHoursStart clears the value of the "quiet_hours_start" field.
+func (uu *UserUpdate) ClearQuietHoursStart() *UserUpdate {
+	uu.mutation.ClearQuietHoursStart()
+	return uu
+}
+
+// SetQuietHoursEnd sets the "quiet_hours_end" field.
+func (uu *UserUpdate) SetQuietHoursEnd(i int) *UserUpdate {
+	uu.mutation.ResetQuietHoursEnd()
+	uu.mutation.SetQuietHoursEnd(i)
+	return uu
+}
+
+// SetNillableQuietHoursEnd sets the "quiet_hours_end" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableQuietHoursEnd(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetQuietHoursEnd(*i)
+	}
+	return uu
+}
+
+// AddQuietHoursEnd adds i to the "quiet_hours_end" field.
+func (uu *UserUpdate) AddQuietHoursEnd(i int) *UserUpdate {
+	uu.mutation.AddQuietHoursEnd(i)
+	return uu
+}
+
+// ClearQuietHoursEnd clears the value of the "quiet_hours_end" field.
+func (uu *UserUpdate) ClearQuietHoursEnd() *UserUpdate {
+	uu.mutation.ClearQuietHoursEnd()
+	return uu
+}
+
 // AddActivityIDs adds the "activities" edge to the Activity entity by IDs.
 func (uu *UserUpdate) AddActivityIDs(ids ...uuid.UUID) *UserUpdate {
 	uu.mutation.AddActivityIDs(ids...)
@@ -199,6 +282,12 @@ func (uu *UserUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (uu *UserUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdate {
+	uu.modifiers = append(uu.modifiers, modifiers...)
+	return uu
+}
+
 func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
 	if ps := uu.mutation.predicates; len(ps) > 0 {
@@ -214,6 +303,30 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.Username(); ok {
 		_spec.SetField(user.FieldUsername, field.TypeString, value)
 	}
+	if value, ok := uu.mutation.TimeZone(); ok {
+		_spec.SetField(user.FieldTimeZone, field.TypeString, value)
+	}
+	if value, ok := uu.mutation.HideZoneLeaderboards(); ok {
+		_spec.SetField(user.FieldHideZoneLeaderboards, field.TypeBool, value)
+	}
+	if value, ok := uu.mutation.QuietHoursStart(); ok {
+		_spec.SetField(user.FieldQuietHoursStart, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.AddedQuietHoursStart(); ok {
+		_spec.AddField(user.FieldQuietHoursStart, field.TypeInt, value)
+	}
+	if uu.mutation.QuietHoursStartCleared() {
+		_spec.ClearField(user.FieldQuietHoursStart, field.TypeInt)
+	}
+	if value, ok := uu.mutation.QuietHoursEnd(); ok {
+		_spec.SetField(user.FieldQuietHoursEnd, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.AddedQuietHoursEnd(); ok {
+		_spec.AddField(user.FieldQuietHoursEnd, field.TypeInt, value)
+	}
+	if uu.mutation.QuietHoursEndCleared() {
+		_spec.ClearField(user.FieldQuietHoursEnd, field.TypeInt)
+	}
 	if uu.mutation.ActivitiesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -349,6 +462,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(uu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -364,9 +478,10 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetExternalUser sets the "external_user" field.
@@ -397,6 +512,88 @@ func (uuo *UserUpdateOne) SetNillableUsername(s *string) *UserUpdateOne {
 	return uuo
 }
 
+// SetTimeZone sets the "time_zone" field.
+func (uuo *UserUpdateOne) SetTimeZone(s string) *UserUpdateOne {
+	uuo.mutation.SetTimeZone(s)
+	return uuo
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTimeZone(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetTimeZone(*s)
+	}
+	return uuo
+}
+
+// SetHideZoneLeaderboards sets the "hide_zone_leaderboards" field.
+func (uuo *UserUpdateOne) SetHideZoneLeaderboards(b bool) *UserUpdateOne {
+	uuo.mutation.SetHideZoneLeaderboards(b)
+	return uuo
+}
+
+// SetNillableHideZoneLeaderboards sets the "hide_zone_leaderboards" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableHideZoneLeaderboards(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetHideZoneLeaderboards(*b)
+	}
+	return uuo
+}
+
+// SetQuietHoursStart sets the "quiet_hours_start" field.
+func (uuo *UserUpdateOne) SetQuietHoursStart(i int) *UserUpdateOne {
+	uuo.mutation.ResetQuietHoursStart()
+	uuo.mutation.SetQuietHoursStart(i)
+	return uuo
+}
+
+// SetNillableQuietHoursStart sets the "quiet_hours_start" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableQuietHoursStart(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetQuietHoursStart(*i)
+	}
+	return uuo
+}
+
+// AddQuietHoursStart adds i to the "quiet_hours_start" field.
+func (uuo *UserUpdateOne) AddQuietHoursStart(i int) *UserUpdateOne {
+	uuo.mutation.AddQuietHoursStart(i)
+	return uuo
+}
+
+// ClearQuietHoursStart clears the value of the "quiet_hours_start" field.
+func (uuo *UserUpdateOne) ClearQuietHoursStart() *UserUpdateOne {
+	uuo.mutation.ClearQuietHoursStart()
+	return uuo
+}
+
+// SetQuietHoursEnd sets the "quiet_hours_end" field.
+func (uuo *UserUpdateOne) SetQuietHoursEnd(i int) *UserUpdateOne {
+	uuo.mutation.ResetQuietHoursEnd()
+	uuo.mutation.SetQuietHoursEnd(i)
+	return uuo
+}
+
+// SetNillableQuietHoursEnd sets the "quiet_hours_end" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableQuietHoursEnd(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetQuietHoursEnd(*i)
+	}
+	return uuo
+}
+
+// AddQuietHoursEnd adds i to the "quiet_hours_end" field.
+func (uuo *UserUpdateOne) AddQuietHoursEnd(i int) *UserUpdateOne {
+	uuo.mutation.AddQuietHoursEnd(i)
+	return uuo
+}
+
+// ClearQuietHoursEnd clears the value of the "quiet_hours_end" field.
+func (uuo *UserUpdateOne) ClearQuietHoursEnd() *UserUpdateOne {
+	uuo.mutation.ClearQuietHoursEnd()
+	return uuo
+}
+
 // AddActivityIDs adds the "activities" edge to the Activity entity by IDs.
 func (uuo *UserUpdateOne) AddActivityIDs(ids ...uuid.UUID) *UserUpdateOne {
 	uuo.mutation.AddActivityIDs(ids...)
@@ -550,6 +747,12 @@ func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (uuo *UserUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdateOne {
+	uuo.modifiers = append(uuo.modifiers, modifiers...)
+	return uuo
+}
+
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
 	id, ok := uuo.mutation.ID()
@@ -582,6 +785,30 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if value, ok := uuo.mutation.Username(); ok {
 		_spec.SetField(user.FieldUsername, field.TypeString, value)
 	}
+	if value, ok := uuo.mutation.TimeZone(); ok {
+		_spec.SetField(user.FieldTimeZone, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.HideZoneLeaderboards(); ok {
+		_spec.SetField(user.FieldHideZoneLeaderboards, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.QuietHoursStart(); ok {
+		_spec.SetField(user.FieldQuietHoursStart, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedQuietHoursStart(); ok {
+		_spec.AddField(user.FieldQuietHoursStart, field.TypeInt, value)
+	}
+	if uuo.mutation.QuietHoursStartCleared() {
+		_spec.ClearField(user.FieldQuietHoursStart, field.TypeInt)
+	}
+	if value, ok := uuo.mutation.QuietHoursEnd(); ok {
+		_spec.SetField(user.FieldQuietHoursEnd, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedQuietHoursEnd(); ok {
+		_spec.AddField(user.FieldQuietHoursEnd, field.TypeInt, value)
+	}
+	if uuo.mutation.QuietHoursEndCleared() {
+		_spec.ClearField(user.FieldQuietHoursEnd, field.TypeInt)
+	}
 	if uuo.mutation.ActivitiesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -717,6 +944,7 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(uuo.modifiers...)
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -8,14 +8,72 @@ const (
 	Signin ApiRoute = "/signin"
 
 	// User routes
-	UpdateUsername ApiRoute = "/update"
+	UpdateUsername       ApiRoute = "/update"
+	UpdateTimeZone       ApiRoute = "/timezone"
+	UserInfluenceHistory ApiRoute = "/influence/history"
+	UserCaptures         ApiRoute = "/captures"
+	UserSeasons          ApiRoute = "/seasons"
+
+	// Hex routes
+	HexHistory  ApiRoute = "/{h3}/history"
+	HexCaptures ApiRoute = "/{h3}/captures"
+	HexRegions  ApiRoute = "/{h3}/regions"
 
 	// Activity routes
-	CreateActivity ApiRoute = "/create"
+	CreateActivity        ApiRoute = "/create"
+	CreateActivitiesBatch ApiRoute = "/batch"
+	GetActivity           ApiRoute = "/{id}"
+	DeleteActivity        ApiRoute = "/{id}"
+	GetActivityStats      ApiRoute = "/stats"
+	GetActivityStatus     ApiRoute = "/{id}/status"
+
+	// Activity session routes
+	StartActivitySession        ApiRoute = "/session"
+	GetActivitySession          ApiRoute = "/session/{id}"
+	AppendActivitySessionPoints ApiRoute = "/session/{id}/points"
+	PauseActivitySession        ApiRoute = "/session/{id}/pause"
+	ResumeActivitySession       ApiRoute = "/session/{id}/resume"
+	FinishActivitySession       ApiRoute = "/session/{id}/finish"
+
+	// Progress routes
+	GetStreaks ApiRoute = "/streaks"
+	Goals      ApiRoute = "/goals"
+	DeleteGoal ApiRoute = "/goals/{id}"
+	GetRecords ApiRoute = "/records"
+
+	// Privacy routes
+	PrivacyZones    ApiRoute = "/zones"
+	PrivacyZone     ApiRoute = "/zones/{id}"
+	PrivacySettings ApiRoute = "/settings"
+
+	// Segment routes
+	Segment            ApiRoute = "/{id}"
+	SegmentLeaderboard ApiRoute = "/{id}/leaderboard"
+
+	// Notification routes
+	ReadAllNotifications    ApiRoute = "/read"
+	ReadNotification        ApiRoute = "/{id}/read"
+	Notification            ApiRoute = "/{id}"
+	NotificationPreferences ApiRoute = "/preferences"
+	PushDevices             ApiRoute = "/devices"
+	PushDevice              ApiRoute = "/devices/{device_id}"
+	QuietHours              ApiRoute = "/quiet-hours"
+
+	// Webhook routes
+	Webhook           ApiRoute = "/{id}"
+	WebhookDeliveries ApiRoute = "/{id}/deliveries"
+
+	// Season routes
+	SeasonStandings    ApiRoute = "/{number}/standings"
+	SeasonHexStandings ApiRoute = "/{number}/hex/{h3}"
+
+	// Region routes
+	RegionLeaderboard ApiRoute = "/{slug}/leaderboard"
 
 	// Leaderboard routes
 	GetLeaderboardByBBox ApiRoute = "/bbox"
 	GetGlobalLeaderboard ApiRoute = "/global"
+	GetHexRanking        ApiRoute = "/hex/{h3}"
 
 	// Test route
 	Test ApiRoute = "/test"
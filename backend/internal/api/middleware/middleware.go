@@ -108,8 +108,9 @@ func CORS() func(http.Handler) http.Handler {
 			// Set CORS headers for all responses
 			// w.Header().Set("Access-Control-Allow-Origin", "http://localhost:8081")
 			w.Header().Set("Access-Control-Allow-Origin", "http://192.168.22.230:8081")
-			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Requested-With")
+			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Requested-With, Idempotency-Key")
+			w.Header().Set("Access-Control-Expose-Headers", "Idempotent-Replayed")
 			w.Header().Set("Access-Control-Allow-Credentials", "true")
 			w.Header().Set("Access-Control-Max-Age", "3600")
 
@@ -33,8 +33,19 @@ func (r *Router) Setup(
 	userService *service.UserService,
 	activityHandler *handler.ActivityHandler,
 	activityService *service.ActivityService,
+	activitySessionHandler *handler.ActivitySessionHandler,
+	progressHandler *handler.ProgressHandler,
+	privacyHandler *handler.PrivacyHandler,
+	segmentHandler *handler.SegmentHandler,
 	hexLeaderboardHandler *handler.HexLeaderboardHandler,
 	hexLeaderboardService *service.HexLeaderboardService,
+	influenceHistoryHandler *handler.InfluenceHistoryHandler,
+	hexCaptureHandler *handler.HexCaptureHandler,
+	notificationHandler *handler.NotificationHandler,
+	pushHandler *handler.PushHandler,
+	webhookHandler *handler.WebhookHandler,
+	seasonHandler *handler.SeasonHandler,
+	regionHandler *handler.RegionHandler,
 ) {
 	// CORS must be first to handle preflight requests
 	r.router.Use(middleware.CORS())
@@ -95,16 +106,95 @@ func (r *Router) Setup(
 	users := api.PathPrefix("/user").Subrouter()
 	users.HandleFunc("", userHandler.GetUser).Methods("GET")
 	users.HandleFunc(apiroute.UpdateUsername.String(), userHandler.UpdateUsername).Methods("PUT")
+	users.HandleFunc(apiroute.UpdateTimeZone.String(), userHandler.UpdateTimeZone).Methods("PUT")
+	users.HandleFunc(apiroute.UserInfluenceHistory.String(), influenceHistoryHandler.GetUserHistory).Methods("GET")
+	users.HandleFunc(apiroute.UserCaptures.String(), hexCaptureHandler.GetUserCaptures).Methods("GET")
+	users.HandleFunc(apiroute.UserSeasons.String(), seasonHandler.GetUserSeasons).Methods("GET")
+
+	// Hex routes
+	hex := api.PathPrefix("/hex").Subrouter()
+	hex.HandleFunc(apiroute.HexHistory.String(), influenceHistoryHandler.GetHexHistory).Methods("GET")
+	hex.HandleFunc(apiroute.HexCaptures.String(), hexCaptureHandler.GetHexCaptures).Methods("GET")
+	hex.HandleFunc(apiroute.HexRegions.String(), regionHandler.GetHexRegions).Methods("GET")
 
 	// Activity routes
 	activity := api.PathPrefix("/activity").Subrouter()
 	activity.HandleFunc(apiroute.CreateActivity.String(), activityHandler.CreateActivity).Methods("POST")
+	activity.HandleFunc(apiroute.CreateActivitiesBatch.String(), activityHandler.CreateActivitiesBatch).Methods("POST")
 	activity.HandleFunc("", activityHandler.GetUserActivityStats).Methods("GET")
+	activity.HandleFunc(apiroute.GetActivityStats.String(), activityHandler.GetActivityPeriodStats).Methods("GET")
+	activity.HandleFunc(apiroute.GetActivity.String(), activityHandler.GetActivity).Methods("GET")
+	activity.HandleFunc(apiroute.DeleteActivity.String(), activityHandler.DeleteActivity).Methods("DELETE")
+	activity.HandleFunc(apiroute.GetActivityStatus.String(), activityHandler.GetActivityStatus).Methods("GET")
+
+	// Activity session routes
+	activity.HandleFunc(apiroute.StartActivitySession.String(), activitySessionHandler.StartSession).Methods("POST")
+	activity.HandleFunc(apiroute.GetActivitySession.String(), activitySessionHandler.GetSession).Methods("GET")
+	activity.HandleFunc(apiroute.AppendActivitySessionPoints.String(), activitySessionHandler.AppendPoints).Methods("PATCH")
+	activity.HandleFunc(apiroute.PauseActivitySession.String(), activitySessionHandler.PauseSession).Methods("POST")
+	activity.HandleFunc(apiroute.ResumeActivitySession.String(), activitySessionHandler.ResumeSession).Methods("POST")
+	activity.HandleFunc(apiroute.FinishActivitySession.String(), activitySessionHandler.FinishSession).Methods("POST")
+
+	// Progress routes
+	progress := api.PathPrefix("/progress").Subrouter()
+	progress.HandleFunc(apiroute.GetStreaks.String(), progressHandler.GetStreaks).Methods("GET")
+	progress.HandleFunc(apiroute.Goals.String(), progressHandler.GetGoals).Methods("GET")
+	progress.HandleFunc(apiroute.Goals.String(), progressHandler.SetGoal).Methods("PUT")
+	progress.HandleFunc(apiroute.DeleteGoal.String(), progressHandler.DeleteGoal).Methods("DELETE")
+	progress.HandleFunc(apiroute.GetRecords.String(), progressHandler.GetRecords).Methods("GET")
+
+	// Privacy routes
+	privacy := api.PathPrefix("/privacy").Subrouter()
+	privacy.HandleFunc(apiroute.PrivacyZones.String(), privacyHandler.GetZones).Methods("GET")
+	privacy.HandleFunc(apiroute.PrivacyZones.String(), privacyHandler.CreateZone).Methods("POST")
+	privacy.HandleFunc(apiroute.PrivacyZone.String(), privacyHandler.UpdateZone).Methods("PUT")
+	privacy.HandleFunc(apiroute.PrivacyZone.String(), privacyHandler.DeleteZone).Methods("DELETE")
+	privacy.HandleFunc(apiroute.PrivacySettings.String(), privacyHandler.UpdateSettings).Methods("PUT")
+
+	// Segment routes
+	segments := api.PathPrefix("/segments").Subrouter()
+	segments.HandleFunc("", segmentHandler.CreateSegment).Methods("POST")
+	segments.HandleFunc(apiroute.Segment.String(), segmentHandler.GetSegment).Methods("GET")
+	segments.HandleFunc(apiroute.Segment.String(), segmentHandler.DeleteSegment).Methods("DELETE")
+	segments.HandleFunc(apiroute.SegmentLeaderboard.String(), segmentHandler.GetLeaderboard).Methods("GET")
+
+	// Notification routes
+	notifications := api.PathPrefix("/notifications").Subrouter()
+	notifications.HandleFunc("", notificationHandler.GetNotifications).Methods("GET")
+	notifications.HandleFunc(apiroute.ReadAllNotifications.String(), notificationHandler.MarkAllRead).Methods("POST")
+	notifications.HandleFunc(apiroute.NotificationPreferences.String(), notificationHandler.GetPreferences).Methods("GET")
+	notifications.HandleFunc(apiroute.NotificationPreferences.String(), notificationHandler.UpdatePreferences).Methods("PUT")
+	notifications.HandleFunc(apiroute.PushDevices.String(), pushHandler.GetDevices).Methods("GET")
+	notifications.HandleFunc(apiroute.PushDevices.String(), pushHandler.RegisterDevice).Methods("PUT")
+	notifications.HandleFunc(apiroute.PushDevice.String(), pushHandler.UnregisterDevice).Methods("DELETE")
+	notifications.HandleFunc(apiroute.QuietHours.String(), pushHandler.UpdateQuietHours).Methods("PUT")
+	notifications.HandleFunc(apiroute.ReadNotification.String(), notificationHandler.MarkRead).Methods("POST")
+	notifications.HandleFunc(apiroute.Notification.String(), notificationHandler.DeleteNotification).Methods("DELETE")
+
+	// Webhook routes
+	webhooks := api.PathPrefix("/webhooks").Subrouter()
+	webhooks.HandleFunc("", webhookHandler.GetWebhooks).Methods("GET")
+	webhooks.HandleFunc("", webhookHandler.CreateWebhook).Methods("POST")
+	webhooks.HandleFunc(apiroute.Webhook.String(), webhookHandler.DeleteWebhook).Methods("DELETE")
+	webhooks.HandleFunc(apiroute.WebhookDeliveries.String(), webhookHandler.GetDeliveries).Methods("GET")
+
+	// Season routes
+	seasons := api.PathPrefix("/seasons").Subrouter()
+	seasons.HandleFunc("", seasonHandler.GetSeasons).Methods("GET")
+	seasons.HandleFunc(apiroute.SeasonStandings.String(), seasonHandler.GetStandings).Methods("GET")
+	seasons.HandleFunc(apiroute.SeasonHexStandings.String(), seasonHandler.GetHexStandings).Methods("GET")
+
+	// Region routes
+	regions := api.PathPrefix("/regions").Subrouter()
+	regions.HandleFunc("", regionHandler.GetRegions).Methods("GET")
+	regions.HandleFunc("", regionHandler.UploadRegions).Methods("POST")
+	regions.HandleFunc(apiroute.RegionLeaderboard.String(), regionHandler.GetRegionLeaderboard).Methods("GET")
 
 	// Leaderboard routes
 	leaderboard := api.PathPrefix("/leaderboard").Subrouter()
 	leaderboard.HandleFunc(apiroute.GetLeaderboardByBBox.String(), hexLeaderboardHandler.GetAllLeaderboardsInsideBBox).Methods("GET")
 	leaderboard.HandleFunc(apiroute.GetGlobalLeaderboard.String(), hexLeaderboardHandler.GetGlobalHexLeaderboard).Methods("GET")
+	leaderboard.HandleFunc(apiroute.GetHexRanking.String(), hexLeaderboardHandler.GetHexRanking).Methods("GET")
 }
 
 // Handler returns the HTTP handler for the router
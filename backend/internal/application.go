@@ -7,6 +7,7 @@ import (
 	"os/signal"
 	"stride-wars-app/ent"
 	"stride-wars-app/internal/api/router"
+	"stride-wars-app/internal/config"
 	"stride-wars-app/internal/handler"
 	"stride-wars-app/internal/repository"
 	"stride-wars-app/internal/service"
@@ -22,6 +23,7 @@ import (
 
 type Application struct {
 	Logger         *zap.Logger
+	Config         config.Config
 	SupabaseClient *supabase.Client
 	EntClient      *ent.Client
 	Router         http.Handler
@@ -47,6 +49,12 @@ func New() (*Application, error) {
 }
 
 func (a *Application) Start(ctx context.Context) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+	a.Config = cfg
+
 	if err := a.initializeSupabaseClient(); err != nil {
 		return err
 	}
@@ -56,16 +64,143 @@ func (a *Application) Start(ctx context.Context) error {
 	}
 
 	a.Repositories = repository.Provide(a.EntClient)
-	a.Services = service.Provide(a.Repositories, a.SupabaseClient, a.Logger)
+	a.Services = service.Provide(a.Repositories, a.SupabaseClient, a.Config, a.Logger)
 	a.Handlers = handler.Provide(a.Services, a.Logger)
 
 	if err := a.initializeRouter(); err != nil {
 		return err
 	}
 
+	a.startBackgroundJobs(ctx)
+
 	return nil
 }
 
+func (a *Application) startBackgroundJobs(ctx context.Context) {
+	go func() {
+		indexed, err := a.Services.ActivityService.StatsService.BackfillActivityHexes(ctx)
+		if err != nil {
+			a.Logger.Error("Failed to backfill activity hexes", zap.Error(err))
+		} else if indexed > 0 {
+			a.Logger.Info("Backfilled activity hexes", zap.Int("activities", indexed))
+		}
+	}()
+
+	go func() {
+		rolledUp, err := a.Services.HexRollupService.BackfillRollups(ctx)
+		if err != nil {
+			a.Logger.Error("Failed to backfill hex rollups", zap.Error(err))
+		} else if rolledUp > 0 {
+			a.Logger.Info("Backfilled hex rollups", zap.Int("leaderboards", rolledUp))
+		}
+	}()
+
+	go func() {
+		rebuilt, err := a.Services.HexLeaderboardService.BackfillLeaderboardSize(ctx)
+		if err != nil {
+			a.Logger.Error("Failed to rebuild leaderboards for the leaderboard size", zap.Error(err))
+		} else if rebuilt > 0 {
+			a.Logger.Info("Rebuilt leaderboards for the leaderboard size", zap.Int("size", a.Config.LeaderboardSize), zap.Int("leaderboards", rebuilt))
+		}
+	}()
+
+	if a.Config.RegionsDir != "" {
+		go func() {
+			loaded, err := a.Services.RegionService.LoadRegionsDir(ctx, a.Config.RegionsDir)
+			if err != nil {
+				a.Logger.Error("Failed to load regions", zap.String("dir", a.Config.RegionsDir), zap.Error(err))
+			} else {
+				a.Logger.Info("Loaded regions", zap.Int("count", loaded))
+			}
+		}()
+	}
+
+	go a.Services.ActivityService.JobService.Run(ctx, a.Config.ActivityWorkers, a.Config.ActivityJobPollInterval)
+
+	go a.runPeriodically(ctx, "purge finished activity jobs", time.Hour, func(ctx context.Context) error {
+		purged, err := a.Services.ActivityService.JobService.PurgeFinished(ctx, 7*24*time.Hour)
+		if err == nil && purged > 0 {
+			a.Logger.Info("Purged finished activity jobs", zap.Int("count", purged))
+		}
+		return err
+	})
+
+	go a.runPeriodically(ctx, "purge expired idempotency keys", time.Hour, func(ctx context.Context) error {
+		purged, err := a.Services.ActivityService.IdempotencyService.PurgeExpired(ctx)
+		if err == nil && purged > 0 {
+			a.Logger.Info("Purged expired idempotency keys", zap.Int("count", purged))
+		}
+		return err
+	})
+
+	// Checking hourly lets a sweep cut short by a restart resume soon after; a new sweep only
+	// starts once DecaySweepInterval has passed since the last one.
+	go a.runPeriodically(ctx, "decay sweep", time.Hour, func(ctx context.Context) error {
+		_, err := a.Services.DecaySweepService.SweepIfDue(ctx)
+		return err
+	})
+
+	go a.runPeriodically(ctx, "season rollover", time.Minute, func(ctx context.Context) error {
+		_, err := a.Services.SeasonService.RolloverIfDue(ctx, time.Now())
+		return err
+	})
+
+	go a.runPeriodically(ctx, "refresh region leaderboards", a.Config.RegionRefreshInterval, func(ctx context.Context) error {
+		return a.Services.RegionService.RefreshStandings(ctx)
+	})
+
+	go a.runPeriodically(ctx, "expire stale activity sessions", time.Minute, func(ctx context.Context) error {
+		closed, err := a.Services.ActivitySessionService.ExpireStaleSessions(ctx)
+		if err == nil && closed > 0 {
+			a.Logger.Info("Closed stale activity sessions", zap.Int("count", closed))
+		}
+		return err
+	})
+
+	go a.runPeriodically(ctx, "dispatch push notifications", a.Config.PushDispatchInterval, func(ctx context.Context) error {
+		_, err := a.Services.PushService.Dispatch(ctx)
+		return err
+	})
+
+	go a.runPeriodically(ctx, "purge finished push notifications", time.Hour, func(ctx context.Context) error {
+		purged, err := a.Services.PushService.PurgeFinished(ctx, 7*24*time.Hour)
+		if err == nil && purged > 0 {
+			a.Logger.Info("Purged finished push notifications", zap.Int("count", purged))
+		}
+		return err
+	})
+
+	go a.runPeriodically(ctx, "dispatch webhooks", a.Config.WebhookDispatchInterval, func(ctx context.Context) error {
+		_, err := a.Services.WebhookService.Dispatch(ctx)
+		return err
+	})
+
+	go a.runPeriodically(ctx, "purge webhook deliveries", time.Hour, func(ctx context.Context) error {
+		purged, err := a.Services.WebhookService.PurgeFinished(ctx, 30*24*time.Hour)
+		if err == nil && purged > 0 {
+			a.Logger.Info("Purged webhook deliveries", zap.Int("count", purged))
+		}
+		return err
+	})
+}
+
+// runPeriodically calls fn every interval until ctx is cancelled. Errors are logged and do not stop the loop.
+func (a *Application) runPeriodically(ctx context.Context, name string, interval time.Duration, fn func(ctx context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := fn(ctx); err != nil {
+				a.Logger.Error("Background job failed", zap.String("job", name), zap.Error(err))
+			}
+		}
+	}
+}
+
 func (a *Application) initializeSupabaseClient() error {
 	client, err := supabase.NewClient(os.Getenv("SUPABASE_PROJECT_URL"), os.Getenv("SUPABASE_API_KEY"), &supabase.ClientOptions{})
 	if err != nil {
@@ -81,7 +216,7 @@ func (a *Application) initializeEntClient(ctx context.Context) error {
 		return errors.WrapErr(err, "Failed to initialize Ent client")
 	}
 
-	if err := client.Schema.Create(ctx); err != nil {
+	if err := client.Schema.Create(ctx, repository.DedupeBeforeUniqueIndexes()...); err != nil {
 		return errors.WrapErr(err, "Failed to create Ent schema")
 	}
 
@@ -94,7 +229,18 @@ func (a *Application) initializeRouter() error {
 	router.Setup(a.Handlers.AuthHandler, a.Services.AuthService,
 		a.Handlers.UserHandler, a.Services.UserService,
 		a.Handlers.ActivityHandler, a.Services.ActivityService,
-		a.Handlers.HexLeaderboardHandler, a.Services.HexLeaderboardService)
+		a.Handlers.ActivitySessionHandler,
+		a.Handlers.ProgressHandler,
+		a.Handlers.PrivacyHandler,
+		a.Handlers.SegmentHandler,
+		a.Handlers.HexLeaderboardHandler, a.Services.HexLeaderboardService,
+		a.Handlers.InfluenceHistoryHandler,
+		a.Handlers.HexCaptureHandler,
+		a.Handlers.NotificationHandler,
+		a.Handlers.PushHandler,
+		a.Handlers.WebhookHandler,
+		a.Handlers.SeasonHandler,
+		a.Handlers.RegionHandler)
 	a.Router = router.Handler()
 	return nil
 }
@@ -0,0 +1,326 @@
+package config
+
+import (
+	"math"
+	"os"
+	"strconv"
+	"stride-wars-app/pkg/errors"
+	"time"
+)
+
+// Influence scoring strategies that can be chosen with SCORING_STRATEGY.
+const (
+	ScoringLinear      = "linear"
+	ScoringExponential = "exponential"
+	ScoringLogarithmic = "logarithmic"
+	ScoringCapped      = "capped"
+)
+
+// Config holds the tunable game and server settings, read from the environment.
+type Config struct {
+	// ActivitySessionTimeout is how long a live session may go without updates before it expires.
+	ActivitySessionTimeout time.Duration
+	// ActivitySessionAutoFinish turns expired sessions into activities instead of discarding them.
+	ActivitySessionAutoFinish bool
+	// ActivitySessionLiveInfluence applies influence for cells as soon as they are streamed.
+	ActivitySessionLiveInfluence bool
+	// ActivityWorkers is the number of background workers that score uploaded activities.
+	ActivityWorkers int
+	// ActivityJobPollInterval is how long an idle worker waits before looking for new jobs.
+	ActivityJobPollInterval time.Duration
+	// ScoringStrategy selects how hex influence decays between visits, one of the Scoring* names.
+	ScoringStrategy string
+	// ScoringHalfLife is the time in which the exponential strategy halves an idle score.
+	ScoringHalfLife time.Duration
+	// ScoringCap is the highest score the capped strategy lets a user reach in a hex.
+	ScoringCap float64
+	// LeaderboardSize is the number of top users stored in each hex's leaderboard.
+	LeaderboardSize int
+	// DecaySweepInterval is how often faded influence is pruned in the background.
+	DecaySweepInterval time.Duration
+	// DecaySweepMinIdle is how long an influence must go without a visit before a sweep considers
+	// pruning it and re-ranking its hex.
+	DecaySweepMinIdle time.Duration
+	// DecaySweepChunkSize is the number of influences a sweep processes per transaction.
+	DecaySweepChunkSize int
+	// DecayPruneThreshold is the score below which a sweep deletes an influence.
+	DecayPruneThreshold float64
+	// PushDispatchInterval is how often queued push notifications are sent.
+	PushDispatchInterval time.Duration
+	// PushBatchSize is the number of push notifications sent per request to the provider.
+	PushBatchSize int
+	// PushMaxPerSecond caps the push notifications sent per second.
+	PushMaxPerSecond float64
+	// ExpoPushURL is the endpoint of the Expo push API.
+	ExpoPushURL string
+	// ExpoAccessToken authenticates push requests when the Expo project requires it.
+	ExpoAccessToken string
+	// WebhookDispatchInterval is how often queued webhook deliveries are sent.
+	WebhookDispatchInterval time.Duration
+	// WebhookTimeout is how long a webhook receiver has to answer a delivery.
+	WebhookTimeout time.Duration
+	// WebhookMaxAttempts is how many times a delivery is tried before it is given up on.
+	WebhookMaxAttempts int
+	// WebhookRetryDelay is the wait before a failed delivery's first retry. It doubles with every attempt.
+	WebhookRetryDelay time.Duration
+	// WebhookAllowPrivateTargets lets webhooks post to loopback, private and link-local
+	// addresses, which are refused by default. Only meant for local development.
+	WebhookAllowPrivateTargets bool
+	// SeasonStart is when the first season starts. Seasons are off while it is zero.
+	SeasonStart time.Time
+	// SeasonLength is how long each season lasts. Season n starts (n-1) lengths after SeasonStart.
+	SeasonLength time.Duration
+	// SeasonCarryOver is the fraction of the final scores kept into the next season, 0 resets them.
+	SeasonCarryOver float64
+	// RegionsDir is a directory of GeoJSON region boundaries loaded at startup. None are loaded while it is empty.
+	RegionsDir string
+	// RegionRefreshInterval is how often the region leaderboards are recomputed.
+	RegionRefreshInterval time.Duration
+	// AdminToken authorizes the admin endpoints, sent in the X-Admin-Token header. They are off while it is empty.
+	AdminToken string
+}
+
+// Default returns the configuration used when no environment overrides are set.
+func Default() Config {
+	return Config{
+		ActivitySessionTimeout:       30 * time.Minute,
+		ActivitySessionAutoFinish:    false,
+		ActivitySessionLiveInfluence: false,
+		ActivityWorkers:              4,
+		ActivityJobPollInterval:      time.Second,
+		ScoringStrategy:              ScoringLinear,
+		ScoringHalfLife:              14 * 24 * time.Hour,
+		ScoringCap:                   50,
+		LeaderboardSize:              5,
+		DecaySweepInterval:           24 * time.Hour,
+		DecaySweepMinIdle:            7 * 24 * time.Hour,
+		DecaySweepChunkSize:          500,
+		DecayPruneThreshold:          0.5,
+		PushDispatchInterval:         5 * time.Second,
+		PushBatchSize:                100,
+		PushMaxPerSecond:             100,
+		ExpoPushURL:                  "https://exp.host/--/api/v2/push/send",
+		WebhookDispatchInterval:      5 * time.Second,
+		WebhookTimeout:               10 * time.Second,
+		WebhookMaxAttempts:           8,
+		WebhookRetryDelay:            30 * time.Second,
+		SeasonLength:                 90 * 24 * time.Hour,
+		SeasonCarryOver:              0,
+		RegionRefreshInterval:        10 * time.Minute,
+	}
+}
+
+// Load reads the configuration from the environment, falling back to Default for unset values.
+func Load() (Config, error) {
+	cfg := Default()
+
+	var err error
+	if cfg.ActivitySessionTimeout, err = durationEnv("ACTIVITY_SESSION_TIMEOUT", cfg.ActivitySessionTimeout); err != nil {
+		return cfg, err
+	}
+	if cfg.ActivitySessionTimeout <= 0 {
+		return cfg, errors.New("ACTIVITY_SESSION_TIMEOUT must be positive")
+	}
+	if cfg.ActivitySessionAutoFinish, err = boolEnv("ACTIVITY_SESSION_AUTO_FINISH", cfg.ActivitySessionAutoFinish); err != nil {
+		return cfg, err
+	}
+	if cfg.ActivitySessionLiveInfluence, err = boolEnv("ACTIVITY_SESSION_LIVE_INFLUENCE", cfg.ActivitySessionLiveInfluence); err != nil {
+		return cfg, err
+	}
+	if cfg.ActivityWorkers, err = intEnv("ACTIVITY_WORKERS", cfg.ActivityWorkers); err != nil {
+		return cfg, err
+	}
+	if cfg.ActivityWorkers <= 0 {
+		return cfg, errors.New("ACTIVITY_WORKERS must be positive")
+	}
+	if cfg.ActivityJobPollInterval, err = durationEnv("ACTIVITY_JOB_POLL_INTERVAL", cfg.ActivityJobPollInterval); err != nil {
+		return cfg, err
+	}
+	if cfg.ActivityJobPollInterval <= 0 {
+		return cfg, errors.New("ACTIVITY_JOB_POLL_INTERVAL must be positive")
+	}
+	if value := os.Getenv("SCORING_STRATEGY"); value != "" {
+		switch value {
+		case ScoringLinear, ScoringExponential, ScoringLogarithmic, ScoringCapped:
+			cfg.ScoringStrategy = value
+		default:
+			return cfg, errors.New("Invalid value for SCORING_STRATEGY: " + value)
+		}
+	}
+	if cfg.ScoringHalfLife, err = durationEnv("SCORING_HALF_LIFE", cfg.ScoringHalfLife); err != nil {
+		return cfg, err
+	}
+	if cfg.ScoringHalfLife <= 0 {
+		return cfg, errors.New("SCORING_HALF_LIFE must be positive")
+	}
+	if cfg.ScoringCap, err = floatEnv("SCORING_CAP", cfg.ScoringCap); err != nil {
+		return cfg, err
+	}
+	if cfg.ScoringCap <= 0 {
+		return cfg, errors.New("SCORING_CAP must be positive")
+	}
+	if cfg.LeaderboardSize, err = intEnv("LEADERBOARD_SIZE", cfg.LeaderboardSize); err != nil {
+		return cfg, err
+	}
+	if cfg.LeaderboardSize < 1 || cfg.LeaderboardSize > 50 {
+		return cfg, errors.New("LEADERBOARD_SIZE must be between 1 and 50")
+	}
+	if cfg.DecaySweepInterval, err = durationEnv("DECAY_SWEEP_INTERVAL", cfg.DecaySweepInterval); err != nil {
+		return cfg, err
+	}
+	if cfg.DecaySweepInterval <= 0 {
+		return cfg, errors.New("DECAY_SWEEP_INTERVAL must be positive")
+	}
+	if cfg.DecaySweepMinIdle, err = durationEnv("DECAY_SWEEP_MIN_IDLE", cfg.DecaySweepMinIdle); err != nil {
+		return cfg, err
+	}
+	if cfg.DecaySweepMinIdle <= 0 {
+		return cfg, errors.New("DECAY_SWEEP_MIN_IDLE must be positive")
+	}
+	if cfg.DecaySweepChunkSize, err = intEnv("DECAY_SWEEP_CHUNK_SIZE", cfg.DecaySweepChunkSize); err != nil {
+		return cfg, err
+	}
+	if cfg.DecaySweepChunkSize <= 0 {
+		return cfg, errors.New("DECAY_SWEEP_CHUNK_SIZE must be positive")
+	}
+	if cfg.DecayPruneThreshold, err = floatEnv("DECAY_PRUNE_THRESHOLD", cfg.DecayPruneThreshold); err != nil {
+		return cfg, err
+	}
+	if math.IsNaN(cfg.DecayPruneThreshold) || math.IsInf(cfg.DecayPruneThreshold, 0) || cfg.DecayPruneThreshold < 0 {
+		return cfg, errors.New("DECAY_PRUNE_THRESHOLD must be a finite number of at least 0")
+	}
+	if cfg.PushDispatchInterval, err = durationEnv("PUSH_DISPATCH_INTERVAL", cfg.PushDispatchInterval); err != nil {
+		return cfg, err
+	}
+	if cfg.PushDispatchInterval <= 0 {
+		return cfg, errors.New("PUSH_DISPATCH_INTERVAL must be positive")
+	}
+	if cfg.PushBatchSize, err = intEnv("PUSH_BATCH_SIZE", cfg.PushBatchSize); err != nil {
+		return cfg, err
+	}
+	if cfg.PushBatchSize < 1 || cfg.PushBatchSize > 100 {
+		return cfg, errors.New("PUSH_BATCH_SIZE must be between 1 and 100")
+	}
+	if cfg.PushMaxPerSecond, err = floatEnv("PUSH_MAX_PER_SECOND", cfg.PushMaxPerSecond); err != nil {
+		return cfg, err
+	}
+	if cfg.PushMaxPerSecond <= 0 {
+		return cfg, errors.New("PUSH_MAX_PER_SECOND must be positive")
+	}
+	if value := os.Getenv("EXPO_PUSH_URL"); value != "" {
+		cfg.ExpoPushURL = value
+	}
+	cfg.ExpoAccessToken = os.Getenv("EXPO_ACCESS_TOKEN")
+	if cfg.WebhookDispatchInterval, err = durationEnv("WEBHOOK_DISPATCH_INTERVAL", cfg.WebhookDispatchInterval); err != nil {
+		return cfg, err
+	}
+	if cfg.WebhookDispatchInterval <= 0 {
+		return cfg, errors.New("WEBHOOK_DISPATCH_INTERVAL must be positive")
+	}
+	if cfg.WebhookTimeout, err = durationEnv("WEBHOOK_TIMEOUT", cfg.WebhookTimeout); err != nil {
+		return cfg, err
+	}
+	if cfg.WebhookTimeout <= 0 {
+		return cfg, errors.New("WEBHOOK_TIMEOUT must be positive")
+	}
+	if cfg.WebhookMaxAttempts, err = intEnv("WEBHOOK_MAX_ATTEMPTS", cfg.WebhookMaxAttempts); err != nil {
+		return cfg, err
+	}
+	if cfg.WebhookMaxAttempts <= 0 {
+		return cfg, errors.New("WEBHOOK_MAX_ATTEMPTS must be positive")
+	}
+	if cfg.WebhookRetryDelay, err = durationEnv("WEBHOOK_RETRY_DELAY", cfg.WebhookRetryDelay); err != nil {
+		return cfg, err
+	}
+	if cfg.WebhookRetryDelay <= 0 {
+		return cfg, errors.New("WEBHOOK_RETRY_DELAY must be positive")
+	}
+	if cfg.WebhookAllowPrivateTargets, err = boolEnv("WEBHOOK_ALLOW_PRIVATE_TARGETS", cfg.WebhookAllowPrivateTargets); err != nil {
+		return cfg, err
+	}
+	if cfg.SeasonStart, err = timeEnv("SEASON_START", cfg.SeasonStart); err != nil {
+		return cfg, err
+	}
+	if cfg.SeasonLength, err = durationEnv("SEASON_LENGTH", cfg.SeasonLength); err != nil {
+		return cfg, err
+	}
+	if cfg.SeasonLength <= 0 {
+		return cfg, errors.New("SEASON_LENGTH must be positive")
+	}
+	if cfg.SeasonCarryOver, err = floatEnv("SEASON_CARRY_OVER", cfg.SeasonCarryOver); err != nil {
+		return cfg, err
+	}
+	if cfg.SeasonCarryOver < 0 || cfg.SeasonCarryOver > 1 {
+		return cfg, errors.New("SEASON_CARRY_OVER must be between 0 and 1")
+	}
+	cfg.RegionsDir = os.Getenv("REGIONS_DIR")
+	if cfg.RegionRefreshInterval, err = durationEnv("REGION_REFRESH_INTERVAL", cfg.RegionRefreshInterval); err != nil {
+		return cfg, err
+	}
+	if cfg.RegionRefreshInterval <= 0 {
+		return cfg, errors.New("REGION_REFRESH_INTERVAL must be positive")
+	}
+	cfg.AdminToken = os.Getenv("ADMIN_TOKEN")
+	return cfg, nil
+}
+
+func durationEnv(key string, fallback time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return fallback, errors.WrapErr(err, "Invalid value for "+key)
+	}
+	return d, nil
+}
+
+// timeEnv parses an RFC 3339 timestamp such as 2025-01-01T00:00:00Z.
+func timeEnv(key string, fallback time.Time) (time.Time, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return fallback, errors.WrapErr(err, "Invalid value for "+key)
+	}
+	return t, nil
+}
+
+func boolEnv(key string, fallback bool) (bool, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return fallback, errors.WrapErr(err, "Invalid value for "+key)
+	}
+	return b, nil
+}
+
+func intEnv(key string, fallback int) (int, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return fallback, errors.WrapErr(err, "Invalid value for "+key)
+	}
+	return n, nil
+}
+
+func floatEnv(key string, fallback float64) (float64, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fallback, errors.WrapErr(err, "Invalid value for "+key)
+	}
+	return f, nil
+}
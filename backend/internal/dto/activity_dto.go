@@ -1,14 +1,32 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
+type TrackPoint struct {
+	Lat       float64   `json:"lat"`
+	Lng       float64   `json:"lng"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
 type CreateActivityRequest struct {
 	UserID    uuid.UUID `json:"user_id"`
 	Duration  float64   `json:"duration"` // in seconds
 	Distance  float64   `json:"distance"` // in meters
 	H3Indexes []string  `json:"h3_indexes"`
+	// Track is the optional GPS track the cells were derived from
+	Track []TrackPoint `json:"track,omitempty"`
+	// ActivityType is run, walk or ride, defaults to run
+	ActivityType string `json:"activity_type,omitempty"`
+	// StartedAt and EndedAt are the device clock times of the activity. A missing bound is derived
+	// from the duration, and an activity without either is assumed to have ended at upload time.
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"`
+	// IdempotencyKey deduplicates retried submissions, it can also be sent as the Idempotency-Key header
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
 }
 
 type CreateActivityResponse struct {
@@ -17,6 +35,27 @@ type CreateActivityResponse struct {
 	Duration  float64   `json:"duration"` // in seconds
 	Distance  float64   `json:"distance"` // in meters
 	H3Indexes []string  `json:"h3_indexes"`
+	StartedAt time.Time `json:"started_at"`
+	EndedAt   time.Time `json:"ended_at"`
+	// NewRecords are the personal records the activity set
+	NewRecords []NewPersonalRecord `json:"new_records"`
+	// SegmentEfforts are the segments the activity traversed
+	SegmentEfforts []SegmentEffortResponse `json:"segment_efforts"`
+	// ProcessingStatus tells whether the activity's hexes have been scored and ranked yet
+	ProcessingStatus string `json:"processing_status"`
+	// Replayed is set when the response was returned for an already processed idempotency key
+	Replayed bool `json:"-"`
+}
+
+// ActivityProcessingStatusResponse reports the background scoring of an activity's hexes.
+type ActivityProcessingStatusResponse struct {
+	ActivityID uuid.UUID `json:"activity_id"`
+	Status     string    `json:"status"` // pending, processing, done or dead
+	Attempts   int       `json:"attempts"`
+	LastError  *string   `json:"last_error,omitempty"`
+	// NextAttemptAt is when a pending job will be run
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
 }
 
 type GetUserActivityStatsResponse struct {
@@ -25,3 +64,102 @@ type GetUserActivityStatsResponse struct {
 	DistanceCovered    float64 `json:"distance_covered"` // in meters
 	WeeklyActivities   []int64 `json:"weekly_activities"`
 }
+
+type DeleteActivityResponse struct {
+	ID            uuid.UUID `json:"activity_id"`
+	AffectedHexes []string  `json:"affected_hexes"`
+}
+
+const (
+	BatchActivityCreated   = "created"
+	BatchActivityDuplicate = "duplicate"
+	BatchActivityRejected  = "rejected"
+)
+
+type BatchActivityItem struct {
+	Duration  float64  `json:"duration"` // in seconds
+	Distance  float64  `json:"distance"` // in meters
+	H3Indexes []string `json:"h3_indexes"`
+	// Track is the optional GPS track the cells were derived from
+	Track          []TrackPoint `json:"track,omitempty"`
+	ActivityType   string       `json:"activity_type,omitempty"`
+	StartedAt      *time.Time   `json:"started_at,omitempty"`
+	EndedAt        time.Time    `json:"ended_at"`
+	IdempotencyKey string       `json:"idempotency_key,omitempty"`
+}
+
+type CreateActivitiesBatchRequest struct {
+	UserID     uuid.UUID           `json:"user_id"`
+	Activities []BatchActivityItem `json:"activities"`
+}
+
+type BatchActivityResult struct {
+	Index          int        `json:"index"` // position of the item in the request
+	IdempotencyKey string     `json:"idempotency_key,omitempty"`
+	Status         string     `json:"status"` // created, duplicate or rejected
+	ActivityID     *uuid.UUID `json:"activity_id,omitempty"`
+	Reason         string     `json:"reason,omitempty"`
+}
+
+type CreateActivitiesBatchResponse struct {
+	Results []BatchActivityResult `json:"results"`
+}
+
+type StartActivitySessionRequest struct {
+	UserID       uuid.UUID `json:"user_id"`
+	ActivityType string    `json:"activity_type,omitempty"` // run, walk or ride, defaults to run
+}
+
+type AppendActivitySessionPointsRequest struct {
+	UserID    uuid.UUID    `json:"user_id"`
+	Points    []TrackPoint `json:"points,omitempty"`
+	H3Indexes []string     `json:"h3_indexes,omitempty"`
+	Distance  float64      `json:"distance,omitempty"` // in meters, added on top of the distance between points
+}
+
+type ActivitySessionRequest struct {
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type ActivitySessionResponse struct {
+	ID           uuid.UUID  `json:"session_id"`
+	UserID       uuid.UUID  `json:"user_id"`
+	Status       string     `json:"status"`
+	ActivityType string     `json:"activity_type"`
+	Duration     float64    `json:"duration"` // active seconds, pauses excluded
+	Distance     float64    `json:"distance"` // in meters
+	H3Indexes    []string   `json:"h3_indexes"`
+	StartedAt    time.Time  `json:"started_at"`
+	ActivityID   *uuid.UUID `json:"activity_id,omitempty"` // set once the session is finished
+}
+
+type ActivityPeriodStats struct {
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+	Activities  int64     `json:"activities"`
+	Distance    float64   `json:"distance"`     // in meters
+	Duration    float64   `json:"duration"`     // in seconds
+	AveragePace float64   `json:"average_pace"` // in seconds per kilometer, 0 without distance
+	UniqueHexes int64     `json:"unique_hexes"`
+	NewHexes    int64     `json:"new_hexes"` // hexes visited for the first time ever
+}
+
+type GetActivityPeriodStatsResponse struct {
+	Granularity string                `json:"granularity"`
+	TimeZone    string                `json:"time_zone"`
+	Periods     []ActivityPeriodStats `json:"periods"`
+}
+
+// ActivityDetailResponse is the public view of an activity. Cells and GPS points inside the
+// owner's privacy zones are left out.
+type ActivityDetailResponse struct {
+	ID           uuid.UUID    `json:"id"`
+	UserID       uuid.UUID    `json:"user_id"`
+	ActivityType string       `json:"activity_type"`
+	Duration     float64      `json:"duration"`
+	Distance     float64      `json:"distance"`
+	H3Indexes    []string     `json:"h3_indexes"`
+	Track        []TrackPoint `json:"track"`
+	StartedAt    time.Time    `json:"started_at"`
+	EndedAt      time.Time    `json:"ended_at"`
+}
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type HexCaptureResponse struct {
+	ID              uuid.UUID  `json:"id"`
+	H3Index         string     `json:"h3_index"`
+	PreviousOwnerID *uuid.UUID `json:"previous_owner_id,omitempty"` // empty for a first claim
+	NewOwnerID      uuid.UUID  `json:"new_owner_id"`
+	PreviousScore   float64    `json:"previous_score"`
+	NewScore        float64    `json:"new_score"`
+	ActivityID      *uuid.UUID `json:"activity_id,omitempty"`
+	CapturedAt      time.Time  `json:"captured_at"`
+}
+
+type HexCapturesResponse struct {
+	H3Index  string               `json:"h3_index"`
+	Captures []HexCaptureResponse `json:"captures"`
+}
+
+// UserCapturesResponse lists the hexes a user took from others or claimed first (Made) and the
+// ones others took from them (Suffered) in a time window, newest first.
+type UserCapturesResponse struct {
+	UserID   uuid.UUID            `json:"user_id"`
+	From     time.Time            `json:"from"`
+	To       time.Time            `json:"to"`
+	Made     []HexCaptureResponse `json:"made"`
+	Suffered []HexCaptureResponse `json:"suffered"`
+}
@@ -7,7 +7,10 @@ import (
 type TopUserResponse struct {
 	UserID   uuid.UUID `json:"user_id"`
 	UserName string    `json:"user_name"`
-	Score    float64   `json:"score"`
+	// Score is the stored score as of the user's last visit.
+	Score float64 `json:"score"`
+	// EffectiveScore is Score decayed up to now, by which the users are ranked.
+	EffectiveScore float64 `json:"effective_score"`
 }
 
 type HexLeaderboardResponse struct {
@@ -16,12 +19,60 @@ type HexLeaderboardResponse struct {
 	TopUsers []TopUserResponse `json:"top_users"`
 }
 
+// HexRollupResponse is the ownership of the cells at the default resolution under a coarser cell.
+type HexRollupResponse struct {
+	H3Index    string `json:"h3_index"`
+	Resolution int    `json:"resolution"`
+	// DominantUserID is the user leading the most cells, nil when none is owned.
+	DominantUserID   *uuid.UUID `json:"dominant_user_id,omitempty"`
+	DominantUserName string     `json:"dominant_user_name,omitempty"`
+	DominantHexes    int        `json:"dominant_hexes"`
+	OwnedHexes       int        `json:"owned_hexes"`
+	TotalHexes       int        `json:"total_hexes"`
+	// Coverage is the share of all the cells the dominant user leads, from 0 to 1.
+	Coverage float64 `json:"coverage"`
+}
+
 type GetAllHexLeaderboardsInsideBBoxResponse struct {
+	Resolution   int                      `json:"resolution"`
 	Leaderboards []HexLeaderboardResponse `json:"leaderboards"`
+	// Rollups are returned instead of the leaderboards at a rollup resolution.
+	Rollups []HexRollupResponse `json:"rollups,omitempty"`
 }
 
 type GlobalLeaderboardEntry struct {
 	UserID   uuid.UUID `json:"user_id"`
 	Username string    `json:"username"`
-	TopCount int       `json:"top_count"`
+	// TopCount is the number of hexes the user leads by effective score.
+	TopCount int `json:"top_count"`
+	// Score and EffectiveScore are the user's stored and decayed scores summed over those hexes.
+	Score          float64 `json:"score"`
+	EffectiveScore float64 `json:"effective_score"`
+}
+
+type HexRankingEntry struct {
+	Rank           int       `json:"rank"`
+	UserID         uuid.UUID `json:"user_id"`
+	UserName       string    `json:"user_name"`
+	Score          float64   `json:"score"`
+	EffectiveScore float64   `json:"effective_score"`
+}
+
+// HexRankingPosition is the requesting user's own place in a hex's ranking.
+type HexRankingPosition struct {
+	UserID uuid.UUID `json:"user_id"`
+	// Rank is nil when the user has no influence in the hex.
+	Rank           *int    `json:"rank"`
+	EffectiveScore float64 `json:"effective_score"`
+	// GapToNext is the effective score the user lacks to pass the user ranked right above them,
+	// nil when they rank first or not at all.
+	GapToNext *float64 `json:"gap_to_next,omitempty"`
+}
+
+type GetHexRankingResponse struct {
+	H3Index      string             `json:"h3_index"`
+	Participants int                `json:"participants"`
+	Rankings     []HexRankingEntry  `json:"rankings"`
+	NextOffset   *int               `json:"next_offset,omitempty"`
+	User         HexRankingPosition `json:"user"`
 }
@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InfluenceHistoryPoint is the state of a user's influence at the end of one period.
+type InfluenceHistoryPoint struct {
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+	Score       float64   `json:"score"`   // stored score at the end of the period
+	Change      float64   `json:"change"`  // how much the score changed during the period
+	Changes     int64     `json:"changes"` // number of recorded score changes in the period
+}
+
+// InfluenceHistoryResponse is a time series of a user's score in one hex, or summed over all
+// hexes when H3Index is empty.
+type InfluenceHistoryResponse struct {
+	UserID   uuid.UUID               `json:"user_id"`
+	H3Index  string                  `json:"h3_index,omitempty"`
+	Interval string                  `json:"interval"`
+	TimeZone string                  `json:"time_zone"`
+	Points   []InfluenceHistoryPoint `json:"points"`
+}
@@ -0,0 +1,68 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationResponse struct {
+	ID        uuid.UUID         `json:"id"`
+	Type      string            `json:"type"`
+	Message   string            `json:"message"`
+	Data      map[string]string `json:"data,omitempty"`
+	Read      bool              `json:"read"`
+	ReadAt    *time.Time        `json:"read_at,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+type GetNotificationsResponse struct {
+	Notifications []NotificationResponse `json:"notifications"`
+	Total         int                    `json:"total"`  // matching the unread filter, if any
+	Unread        int                    `json:"unread"` // all the user's unread notifications
+	// NextOffset is the offset of the next page, nil on the last page
+	NextOffset *int `json:"next_offset,omitempty"`
+}
+
+type NotificationPreferencesRequest struct {
+	UserID uuid.UUID `json:"user_id"`
+	// Preferences turns notification types on or off; types left out keep their setting
+	Preferences map[string]bool `json:"preferences"`
+}
+
+type NotificationPreferencesResponse struct {
+	UserID      uuid.UUID       `json:"user_id"`
+	Preferences map[string]bool `json:"preferences"`
+}
+
+type RegisterPushDeviceRequest struct {
+	UserID   uuid.UUID `json:"user_id"`
+	DeviceID string    `json:"device_id"`
+	Token    string    `json:"token"` // Expo push token, like ExponentPushToken[...]
+}
+
+type PushDeviceResponse struct {
+	DeviceID  string    `json:"device_id"`
+	Token     string    `json:"token"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type GetPushDevicesResponse struct {
+	Devices []PushDeviceResponse `json:"devices"`
+}
+
+// QuietHoursRequest sets the local times between which no push notifications are sent, as HH:MM.
+// Leaving both out clears the quiet hours.
+type QuietHoursRequest struct {
+	UserID uuid.UUID `json:"user_id"`
+	Start  *string   `json:"start"`
+	End    *string   `json:"end"`
+}
+
+type QuietHoursResponse struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Start    *string   `json:"start"`
+	End      *string   `json:"end"`
+	TimeZone string    `json:"time_zone"`
+}
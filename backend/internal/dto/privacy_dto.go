@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PrivacyZoneRequest describes a zone as either a point with a radius or a set of H3 cells.
+type PrivacyZoneRequest struct {
+	UserID       uuid.UUID `json:"user_id"`
+	Name         string    `json:"name"`
+	Lat          float64   `json:"lat"`
+	Lng          float64   `json:"lng"`
+	RadiusMeters float64   `json:"radius_meters"`
+	H3Indexes    []string  `json:"h3_indexes"`
+}
+
+type PrivacyZoneResponse struct {
+	ID           uuid.UUID `json:"id"`
+	UserID       uuid.UUID `json:"user_id"`
+	Name         string    `json:"name"`
+	Lat          float64   `json:"lat"`
+	Lng          float64   `json:"lng"`
+	RadiusMeters float64   `json:"radius_meters"`
+	H3Indexes    []string  `json:"h3_indexes"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type GetPrivacyZonesResponse struct {
+	Zones []PrivacyZoneResponse `json:"zones"`
+}
+
+type PrivacySettingsRequest struct {
+	UserID uuid.UUID `json:"user_id"`
+	// HideZoneLeaderboards keeps the user off the leaderboards of hexes inside their zones
+	HideZoneLeaderboards bool `json:"hide_zone_leaderboards"`
+}
+
+type PrivacySettingsResponse struct {
+	UserID               uuid.UUID `json:"user_id"`
+	HideZoneLeaderboards bool      `json:"hide_zone_leaderboards"`
+}
@@ -0,0 +1,60 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type StreakResponse struct {
+	Period  string `json:"period"` // day or week
+	Current int    `json:"current"`
+	Longest int    `json:"longest"`
+	// LastPeriodStart is the start of the last period with a qualifying activity, in the user's time zone
+	LastPeriodStart time.Time `json:"last_period_start"`
+}
+
+type GetStreaksResponse struct {
+	Streaks []StreakResponse `json:"streaks"`
+}
+
+type SetGoalRequest struct {
+	UserID uuid.UUID `json:"user_id"`
+	Metric string    `json:"metric"` // distance, new_hexes or activities
+	Period string    `json:"period"` // day or week
+	Target float64   `json:"target"` // meters for distance, a count otherwise
+}
+
+type GoalResponse struct {
+	ID          uuid.UUID `json:"goal_id"`
+	Metric      string    `json:"metric"`
+	Period      string    `json:"period"`
+	Target      float64   `json:"target"`
+	Progress    float64   `json:"progress"`
+	Completed   bool      `json:"completed"`
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+}
+
+type GetGoalsResponse struct {
+	Goals []GoalResponse `json:"goals"`
+}
+
+type NewPersonalRecord struct {
+	Record string  `json:"record"`
+	Value  float64 `json:"value"` // seconds for fastest efforts and durations, meters for distance, a count for hexes
+	// PreviousValue is the record that was beaten, nil for a first record
+	PreviousValue *float64 `json:"previous_value,omitempty"`
+}
+
+type PersonalRecordResponse struct {
+	ActivityType string    `json:"activity_type"`
+	Record       string    `json:"record"`
+	Value        float64   `json:"value"`
+	ActivityID   uuid.UUID `json:"activity_id"`
+	AchievedAt   time.Time `json:"achieved_at"`
+}
+
+type GetPersonalRecordsResponse struct {
+	Records []PersonalRecordResponse `json:"records"`
+}
@@ -0,0 +1,64 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RegionFeatureCollection is a GeoJSON FeatureCollection of region boundaries. Every feature
+// needs a Polygon or MultiPolygon geometry and a "name" property. The optional "slug" property
+// identifies the region, derived from the name when missing, and "kind" labels it, such as
+// "district" or "city".
+type RegionFeatureCollection struct {
+	Type     string          `json:"type"`
+	Features []RegionFeature `json:"features"`
+}
+
+type RegionFeature struct {
+	Type       string          `json:"type"`
+	Properties map[string]any  `json:"properties"`
+	Geometry   GeoJSONGeometry `json:"geometry"`
+}
+
+// GeoJSONGeometry holds the coordinates of a geometry undecoded, since their nesting depends on
+// its type. Positions are [longitude, latitude].
+type GeoJSONGeometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+type RegionResponse struct {
+	Slug                 string     `json:"slug"`
+	Name                 string     `json:"name"`
+	Kind                 string     `json:"kind,omitempty"`
+	HexCount             int        `json:"hex_count"`
+	StandingsRefreshedAt *time.Time `json:"standings_refreshed_at,omitempty"`
+}
+
+type GetRegionsResponse struct {
+	Regions []RegionResponse `json:"regions"`
+}
+
+type GetHexRegionsResponse struct {
+	H3Index string           `json:"h3_index"`
+	Regions []RegionResponse `json:"regions"`
+}
+
+type RegionStandingResponse struct {
+	Rank           int       `json:"rank"`
+	UserID         uuid.UUID `json:"user_id"`
+	Username       string    `json:"username"`
+	HexesOwned     int       `json:"hexes_owned"`
+	TotalInfluence float64   `json:"total_influence"`
+}
+
+type GetRegionLeaderboardResponse struct {
+	Region       RegionResponse           `json:"region"`
+	Participants int                      `json:"participants"`
+	Standings    []RegionStandingResponse `json:"standings"`
+	NextOffset   *int                     `json:"next_offset,omitempty"`
+	// User is the requesting user's own standing, when a user_id was given and they have one.
+	User *RegionStandingResponse `json:"user,omitempty"`
+}
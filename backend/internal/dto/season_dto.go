@@ -0,0 +1,63 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SeasonResponse struct {
+	Number     int        `json:"number"`
+	Name       string     `json:"name"`
+	StartsAt   time.Time  `json:"starts_at"`
+	EndsAt     time.Time  `json:"ends_at"`
+	CarryOver  float64    `json:"carry_over"`
+	Status     string     `json:"status"`
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+}
+
+type GetSeasonsResponse struct {
+	Seasons []SeasonResponse `json:"seasons"`
+}
+
+type SeasonStandingResponse struct {
+	Rank           int       `json:"rank"`
+	UserID         uuid.UUID `json:"user_id"`
+	Username       string    `json:"username"`
+	HexesOwned     int       `json:"hexes_owned"`
+	TotalInfluence float64   `json:"total_influence"`
+}
+
+type GetSeasonStandingsResponse struct {
+	Season       SeasonResponse           `json:"season"`
+	Participants int                      `json:"participants"`
+	Standings    []SeasonStandingResponse `json:"standings"`
+	NextOffset   *int                     `json:"next_offset,omitempty"`
+}
+
+type SeasonHexStandingResponse struct {
+	Rank     int       `json:"rank"`
+	UserID   uuid.UUID `json:"user_id"`
+	Username string    `json:"username"`
+	Score    float64   `json:"score"`
+}
+
+type GetSeasonHexStandingsResponse struct {
+	Season    SeasonResponse              `json:"season"`
+	H3Index   string                      `json:"h3_index"`
+	Standings []SeasonHexStandingResponse `json:"standings"`
+}
+
+// UserSeasonResponse is a user's final result in one archived season.
+type UserSeasonResponse struct {
+	Season         SeasonResponse `json:"season"`
+	Rank           int            `json:"rank"`
+	Participants   int            `json:"participants"`
+	HexesOwned     int            `json:"hexes_owned"`
+	TotalInfluence float64        `json:"total_influence"`
+}
+
+type GetUserSeasonsResponse struct {
+	UserID  uuid.UUID            `json:"user_id"`
+	Seasons []UserSeasonResponse `json:"seasons"`
+}
@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateSegmentRequest struct {
+	UserID uuid.UUID `json:"user_id"`
+	Name   string    `json:"name"`
+	// H3Indexes is the ordered path of neighbouring cells
+	H3Indexes []string `json:"h3_indexes"`
+}
+
+type SegmentResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	CreatorID uuid.UUID `json:"creator_id"`
+	H3Indexes []string  `json:"h3_indexes"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type SegmentEffortResponse struct {
+	SegmentID      uuid.UUID `json:"segment_id"`
+	SegmentName    string    `json:"segment_name"`
+	ElapsedSeconds float64   `json:"elapsed_seconds"`
+	StartedAt      time.Time `json:"started_at"`
+	// Estimated is set when the activity had no timed track, such efforts do not count on the leaderboard
+	Estimated bool `json:"estimated"`
+}
+
+type SegmentLeaderboardEntry struct {
+	Rank           int       `json:"rank"`
+	UserID         uuid.UUID `json:"user_id"`
+	Username       string    `json:"username"`
+	ElapsedSeconds float64   `json:"elapsed_seconds"` // the user's best effort
+	Efforts        int       `json:"efforts"`
+}
+
+type SegmentLeaderboardResponse struct {
+	SegmentID uuid.UUID                 `json:"segment_id"`
+	Name      string                    `json:"name"`
+	Entries   []SegmentLeaderboardEntry `json:"entries"`
+}
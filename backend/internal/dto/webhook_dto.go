@@ -0,0 +1,92 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateWebhookRequest subscribes a URL to game events. A secret is generated when none is given.
+type CreateWebhookRequest struct {
+	UserID     uuid.UUID `json:"user_id"`
+	URL        string    `json:"url"`
+	EventTypes []string  `json:"event_types"`
+	Secret     string    `json:"secret,omitempty"`
+}
+
+type WebhookResponse struct {
+	ID         uuid.UUID `json:"id"`
+	URL        string    `json:"url"`
+	EventTypes []string  `json:"event_types"`
+	// Secret is only returned when the subscription is created.
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type GetWebhooksResponse struct {
+	Webhooks []WebhookResponse `json:"webhooks"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID             uuid.UUID       `json:"id"`
+	EventID        uuid.UUID       `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	ResponseStatus *int            `json:"response_status,omitempty"`
+	LastError      *string         `json:"last_error,omitempty"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at,omitempty"` // set while a retry is pending
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	Payload        json.RawMessage `json:"payload"`
+}
+
+type GetWebhookDeliveriesResponse struct {
+	WebhookID  uuid.UUID                 `json:"webhook_id"`
+	Deliveries []WebhookDeliveryResponse `json:"deliveries"`
+	NextOffset *int                      `json:"next_offset,omitempty"`
+}
+
+// WebhookEvent is the body of every webhook delivery.
+type WebhookEvent struct {
+	ID        uuid.UUID `json:"id"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+}
+
+type WebhookActivityCreatedData struct {
+	ActivityID   uuid.UUID `json:"activity_id"`
+	UserID       uuid.UUID `json:"user_id"`
+	Username     string    `json:"username"`
+	ActivityType string    `json:"activity_type"`
+	Duration     float64   `json:"duration"`
+	Distance     float64   `json:"distance"`
+	Hexes        int       `json:"hexes"`
+	NewHexes     int       `json:"new_hexes"`
+	StartedAt    time.Time `json:"started_at"`
+	EndedAt      time.Time `json:"ended_at"`
+}
+
+// WebhookHexCapturedData lists the hexes that changed leader in one update, usually one activity.
+type WebhookHexCapturedData struct {
+	Captures []HexCaptureResponse `json:"captures"`
+}
+
+type WebhookLeaderboard struct {
+	H3Index  string                    `json:"h3_index"`
+	TopUsers []WebhookLeaderboardEntry `json:"top_users"`
+}
+
+type WebhookLeaderboardEntry struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Username string    `json:"username"`
+	Score    float64   `json:"score"`
+}
+
+// WebhookLeaderboardChangedData lists the hex leaderboards whose ranking changed in one update.
+type WebhookLeaderboardChangedData struct {
+	ActivityID   *uuid.UUID           `json:"activity_id,omitempty"`
+	Leaderboards []WebhookLeaderboard `json:"leaderboards"`
+}
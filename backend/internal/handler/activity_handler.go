@@ -1,7 +1,9 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
 	"stride-wars-app/ent"
 	"stride-wars-app/internal/api/middleware"
 	"stride-wars-app/internal/dto"
@@ -9,10 +11,18 @@ import (
 	"stride-wars-app/internal/util"
 
 	"github.com/google/uuid"
+	"github.com/gorilla/mux"
 
 	"go.uber.org/zap"
 )
 
+const (
+	// IdempotencyKeyHeader lets clients safely retry activity submissions
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader marks responses returned for an already processed idempotency key
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+)
+
 type ActivityHandler struct {
 	activityService *service.ActivityService
 	logger          *zap.Logger
@@ -36,19 +46,59 @@ func (h *ActivityHandler) CreateActivity(w http.ResponseWriter, r *http.Request)
 	}
 
 	activity := dto.CreateActivityRequest{
-		UserID:    req.UserID,
-		H3Indexes: req.H3Indexes,
-		Duration:  req.Duration,
-		Distance:  req.Distance,
+		UserID:         req.UserID,
+		H3Indexes:      req.H3Indexes,
+		Duration:       req.Duration,
+		Distance:       req.Distance,
+		Track:          req.Track,
+		ActivityType:   req.ActivityType,
+		StartedAt:      req.StartedAt,
+		EndedAt:        req.EndedAt,
+		IdempotencyKey: req.IdempotencyKey,
+	}
+	if key := r.Header.Get(IdempotencyKeyHeader); key != "" {
+		activity.IdempotencyKey = key
 	}
 	resp, err := h.activityService.CreateActivity(r.Context(), activity)
 	if err != nil {
 		h.logger.Error("create activity failed", zap.Error(err))
-		middleware.WriteError(w, http.StatusBadRequest, err.Error())
+		switch {
+		case errors.Is(err, service.ErrIdempotencyKeyReused), errors.Is(err, service.ErrIdempotencyKeyInProgress):
+			middleware.WriteError(w, http.StatusConflict, err.Error())
+		case errors.Is(err, service.ErrInvalidActivity), errors.Is(err, service.ErrIdempotencyKeyTooLong), ent.IsNotFound(err):
+			middleware.WriteError(w, http.StatusBadRequest, err.Error())
+		default:
+			middleware.WriteError(w, http.StatusInternalServerError, "could not create activity")
+		}
+		return
+	}
+
+	if resp.Replayed {
+		w.Header().Set(IdempotentReplayedHeader, "true")
+	}
+	// The hexes are scored in the background, clients poll GetActivityStatus for the outcome.
+	middleware.WriteJSON(w, http.StatusAccepted, resp)
+}
+
+func (h *ActivityHandler) CreateActivitiesBatch(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateActivitiesBatchRequest
+	if err := util.DecodeJSONBody(r.Body, &req); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid request format")
 		return
 	}
 
-	middleware.WriteJSON(w, http.StatusCreated, resp)
+	resp, err := h.activityService.CreateActivitiesBatch(r.Context(), req)
+	if err != nil {
+		h.logger.Error("create activities batch failed", zap.Error(err))
+		if ent.IsNotFound(err) {
+			middleware.WriteError(w, http.StatusNotFound, "user not found")
+		} else {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
 }
 
 func (h *ActivityHandler) GetUserActivityStats(w http.ResponseWriter, r *http.Request) {
@@ -78,3 +128,125 @@ func (h *ActivityHandler) GetUserActivityStats(w http.ResponseWriter, r *http.Re
 
 	middleware.WriteJSON(w, http.StatusOK, ActivityStats)
 }
+
+func (h *ActivityHandler) GetActivityPeriodStats(w http.ResponseWriter, r *http.Request) {
+	idStr := r.URL.Query().Get("user_id")
+	if idStr == "" {
+		middleware.WriteError(w, http.StatusBadRequest, "User ID is required")
+		return
+	}
+
+	userID, err := uuid.Parse(idStr)
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid UUID format for 'user_id'")
+		return
+	}
+
+	granularity := r.URL.Query().Get("granularity")
+	if granularity == "" {
+		granularity = service.GranularityDay
+	}
+
+	periods := 0
+	if rangeStr := r.URL.Query().Get("range"); rangeStr != "" {
+		periods, err = strconv.Atoi(rangeStr)
+		if err != nil {
+			middleware.WriteError(w, http.StatusBadRequest, "Invalid number for 'range'")
+			return
+		}
+	}
+
+	resp, err := h.activityService.StatsService.GetPeriodStats(r.Context(), userID, granularity, periods)
+	if err != nil {
+		h.logger.Error("get activity period stats failed", zap.Error(err))
+		switch {
+		case ent.IsNotFound(err):
+			middleware.WriteError(w, http.StatusNotFound, "user not found")
+		case errors.Is(err, service.ErrInvalidGranularity), errors.Is(err, service.ErrInvalidStatsRange):
+			middleware.WriteError(w, http.StatusBadRequest, err.Error())
+		default:
+			middleware.WriteError(w, http.StatusInternalServerError, "could not compute activity stats")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *ActivityHandler) GetActivity(w http.ResponseWriter, r *http.Request) {
+	activityID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid UUID format for activity 'id'")
+		return
+	}
+
+	resp, err := h.activityService.GetActivity(r.Context(), activityID)
+	if err != nil {
+		h.logger.Error("get activity failed", zap.Error(err))
+		if ent.IsNotFound(err) {
+			middleware.WriteError(w, http.StatusNotFound, "activity not found")
+		} else {
+			middleware.WriteError(w, http.StatusInternalServerError, "could not load activity")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *ActivityHandler) GetActivityStatus(w http.ResponseWriter, r *http.Request) {
+	activityID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid UUID format for activity 'id'")
+		return
+	}
+
+	resp, err := h.activityService.GetProcessingStatus(r.Context(), activityID)
+	if err != nil {
+		h.logger.Error("get activity status failed", zap.Error(err))
+		if ent.IsNotFound(err) {
+			middleware.WriteError(w, http.StatusNotFound, "activity not found")
+		} else {
+			middleware.WriteError(w, http.StatusInternalServerError, "could not load activity status")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *ActivityHandler) DeleteActivity(w http.ResponseWriter, r *http.Request) {
+	activityID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid UUID format for activity 'id'")
+		return
+	}
+
+	idStr := r.URL.Query().Get("user_id")
+	if idStr == "" {
+		middleware.WriteError(w, http.StatusBadRequest, "User ID is required")
+		return
+	}
+
+	userID, err := uuid.Parse(idStr)
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid UUID format for 'user_id'")
+		return
+	}
+
+	resp, err := h.activityService.DeleteActivity(r.Context(), activityID, userID)
+	if err != nil {
+		h.logger.Error("delete activity failed", zap.Error(err))
+		switch {
+		case ent.IsNotFound(err):
+			middleware.WriteError(w, http.StatusNotFound, "activity not found")
+		case errors.Is(err, service.ErrActivityNotOwned):
+			middleware.WriteError(w, http.StatusForbidden, err.Error())
+		default:
+			middleware.WriteError(w, http.StatusInternalServerError, "could not delete activity")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"stride-wars-app/ent"
 	"stride-wars-app/ent/model"
@@ -17,6 +18,7 @@ import (
 	"stride-wars-app/internal/util"
 
 	"github.com/google/uuid"
+	"github.com/gorilla/mux"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -29,12 +31,30 @@ type ActivityAPIResponse struct {
 	Error   string                     `json:"error,omitempty"`
 }
 
+type ActivityStatusAPIResponse struct {
+	Success bool                                 `json:"success"`
+	Data    dto.ActivityProcessingStatusResponse `json:"data"`
+	Error   string                               `json:"error,omitempty"`
+}
+
+type BatchActivityAPIResponse struct {
+	Success bool                              `json:"success"`
+	Data    dto.CreateActivitiesBatchResponse `json:"data"`
+	Error   string                            `json:"error,omitempty"`
+}
+
 type UserActivityStatsAPIResponse struct {
 	Success bool                             `json:"success"`
 	Data    dto.GetUserActivityStatsResponse `json:"data"`
 	Error   string                           `json:"error,omitempty"`
 }
 
+type ActivityPeriodStatsAPIResponse struct {
+	Success bool                               `json:"success"`
+	Data    dto.GetActivityPeriodStatsResponse `json:"data"`
+	Error   string                             `json:"error,omitempty"`
+}
+
 var validH3Indexes = []string{
 	"891e2e6b153ffff",
 	"891e2e6b103ffff",
@@ -94,7 +114,7 @@ func TestCreateActivity(t *testing.T) {
 		http.HandlerFunc(activityHandler.CreateActivity).ServeHTTP(w, req)
 
 		// Assert status code
-		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, http.StatusAccepted, w.Code)
 
 		// Assert response body
 		var resp ActivityAPIResponse
@@ -104,6 +124,50 @@ func TestCreateActivity(t *testing.T) {
 		assert.NotEqual(t, uuid.Nil, resp.Data.ID)
 	})
 
+	// ------------------------
+	// Subtest: IdempotencyKey
+	// ------------------------
+	t.Run("IdempotencyKey", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, client, activityHandler := setupTestActivityHandler(t)
+
+		userRepo := repository.NewUserRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		send := func(createReq dto.CreateActivityRequest) *httptest.ResponseRecorder {
+			reqBody, err := json.Marshal(createReq)
+			require.NoError(t, err)
+			req := httptest.NewRequest("POST", "/activity/create", bytes.NewBuffer(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set(handler.IdempotencyKeyHeader, "9b0c6a1e-retry")
+			w := httptest.NewRecorder()
+			activityHandler.CreateActivity(w, req)
+			return w
+		}
+
+		createReq := dto.CreateActivityRequest{
+			UserID:    createdUser.ID,
+			Duration:  3600,
+			Distance:  10000,
+			H3Indexes: validH3Indexes,
+		}
+		first := send(createReq)
+		require.Equal(t, http.StatusAccepted, first.Code)
+
+		// A retry with the same key returns the original response
+		replay := send(createReq)
+		require.Equal(t, http.StatusAccepted, replay.Code)
+		assert.Equal(t, "true", replay.Header().Get(handler.IdempotentReplayedHeader))
+		assert.JSONEq(t, first.Body.String(), replay.Body.String())
+
+		// The same key with a different payload is rejected
+		createReq.Distance = 12000
+		conflict := send(createReq)
+		assert.Equal(t, http.StatusConflict, conflict.Code)
+	})
+
 	// ------------------------
 	// Subtest: MissingFields
 	// ------------------------
@@ -210,6 +274,78 @@ func TestCreateActivity(t *testing.T) {
 		require.NoError(t, err)
 		assert.Contains(t, resp.Error, "invalid H3 index")
 	})
+
+	// ------------------------
+	// Subtest: StorageFailure
+	// ------------------------
+	t.Run("StorageFailure", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange: setup handler with a closed DB
+		_, client, activityHandler := setupTestActivityHandler(t)
+		require.NoError(t, client.Close())
+
+		createReq := dto.CreateActivityRequest{
+			UserID:    uuid.New(),
+			Duration:  3600,
+			Distance:  10000,
+			H3Indexes: validH3Indexes,
+		}
+		reqBody, err := json.Marshal(createReq)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest("POST", "/activity/create", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		// Act
+		http.HandlerFunc(activityHandler.CreateActivity).ServeHTTP(w, req)
+
+		// Assert: a valid activity that could not be stored is not the client's fault
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestCreateActivitiesBatch(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: HappyPath
+	// ------------------------
+	t.Run("HappyPath", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, client, activityHandler := setupTestActivityHandler(t)
+
+		userRepo := repository.NewUserRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		batchReq := dto.CreateActivitiesBatchRequest{
+			UserID: createdUser.ID,
+			Activities: []dto.BatchActivityItem{
+				{Duration: 1800, Distance: 5000, H3Indexes: validH3Indexes, EndedAt: time.Now().Add(-2 * time.Hour)},
+				{Duration: 1800, Distance: 5000, H3Indexes: validH3Indexes},
+			},
+		}
+		reqBody, err := json.Marshal(batchReq)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest("POST", "/activity/batch", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		activityHandler.CreateActivitiesBatch(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp BatchActivityAPIResponse
+		err = json.Unmarshal(w.Body.Bytes(), &resp)
+		require.NoError(t, err)
+		require.Len(t, resp.Data.Results, 2)
+		assert.Equal(t, dto.BatchActivityCreated, resp.Data.Results[0].Status)
+		assert.Equal(t, dto.BatchActivityRejected, resp.Data.Results[1].Status)
+		assert.Equal(t, "ended_at is required", resp.Data.Results[1].Reason)
+	})
 }
 
 func TestGetUserActivityStats(t *testing.T) {
@@ -255,7 +391,7 @@ func TestGetUserActivityStats(t *testing.T) {
 			w := httptest.NewRecorder()
 
 			activityHandler.CreateActivity(w, req)
-			assert.Equal(t, http.StatusCreated, w.Code)
+			assert.Equal(t, http.StatusAccepted, w.Code)
 
 			var createResp ActivityAPIResponse
 			err = util.DecodeJSONBody(bytes.NewBuffer(w.Body.Bytes()), &createResp)
@@ -279,8 +415,8 @@ func TestGetUserActivityStats(t *testing.T) {
 		// Expect exactly 2 activities recorded
 		assert.Equal(t, int64(2), response.Data.ActivitiesRecorded)
 
-		// Each activity used 2 H3 indexes → HexesVisited = 2 * 2 = 4
-		assert.Equal(t, int64(4), response.Data.HexesVisited)
+		// Both activities visited the same 2 H3 indexes → HexesVisited = 2
+		assert.Equal(t, int64(2), response.Data.HexesVisited)
 
 		// Distance: 5000 + 5000 = 10000
 		assert.Equal(t, 10000.0, response.Data.DistanceCovered)
@@ -388,3 +524,218 @@ func TestGetUserActivityStats(t *testing.T) {
 	})
 
 }
+
+func TestGetActivityPeriodStats(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: HappyPath
+	// ------------------------
+	t.Run("HappyPath", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, client, activityHandler := setupTestActivityHandler(t)
+
+		userRepo := repository.NewUserRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/activity/stats?user_id="+createdUser.ID.String()+"&granularity=week&range=4", nil)
+		w := httptest.NewRecorder()
+		activityHandler.GetActivityPeriodStats(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response ActivityPeriodStatsAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "week", response.Data.Granularity)
+		assert.Len(t, response.Data.Periods, 4)
+	})
+
+	// ------------------------
+	// Subtest: InvalidGranularity
+	// ------------------------
+	t.Run("InvalidGranularity", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, client, activityHandler := setupTestActivityHandler(t)
+
+		userRepo := repository.NewUserRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/activity/stats?user_id="+createdUser.ID.String()+"&granularity=hour", nil)
+		w := httptest.NewRecorder()
+		activityHandler.GetActivityPeriodStats(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestDeleteActivity(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: HappyPath
+	// ------------------------
+	t.Run("HappyPath", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, client, activityHandler := setupTestActivityHandler(t)
+
+		userRepo := repository.NewUserRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{
+			Username:     "alice",
+			ExternalUser: uuid.New(),
+		})
+		require.NoError(t, err)
+
+		createReq := dto.CreateActivityRequest{
+			UserID:    createdUser.ID,
+			Duration:  1800,
+			Distance:  5000,
+			H3Indexes: validH3Indexes,
+		}
+		reqBody, err := json.Marshal(createReq)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest("POST", "/activity/create", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		activityHandler.CreateActivity(w, req)
+		require.Equal(t, http.StatusAccepted, w.Code)
+
+		var createResp ActivityAPIResponse
+		err = json.Unmarshal(w.Body.Bytes(), &createResp)
+		require.NoError(t, err)
+
+		// Act
+		deleteReq := httptest.NewRequest("DELETE", "/activity/"+createResp.Data.ID.String()+"?user_id="+createdUser.ID.String(), nil)
+		deleteReq = mux.SetURLVars(deleteReq, map[string]string{"id": createResp.Data.ID.String()})
+		deleteW := httptest.NewRecorder()
+		activityHandler.DeleteActivity(deleteW, deleteReq)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, deleteW.Code)
+
+		exists, err := client.Activity.Query().Exist(ctx)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		influences, err := client.HexInfluence.Query().All(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, influences)
+	})
+
+	// ------------------------
+	// Subtest: NotOwner
+	// ------------------------
+	t.Run("NotOwner", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, client, activityHandler := setupTestActivityHandler(t)
+
+		userRepo := repository.NewUserRepository(client)
+		owner, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		other, err := userRepo.CreateUser(ctx, &model.User{Username: "bob", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		act, err := client.Activity.Create().
+			SetUserID(owner.ID).
+			SetDurationSeconds(60).
+			SetDistanceMeters(1000).
+			SetH3Indexes(validH3Indexes).
+			Save(ctx)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest("DELETE", "/activity/"+act.ID.String()+"?user_id="+other.ID.String(), nil)
+		req = mux.SetURLVars(req, map[string]string{"id": act.ID.String()})
+		w := httptest.NewRecorder()
+		activityHandler.DeleteActivity(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+
+		exists, err := client.Activity.Query().Exist(ctx)
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	// ------------------------
+	// Subtest: NotFound
+	// ------------------------
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, activityHandler := setupTestActivityHandler(t)
+
+		id := uuid.New().String()
+		req := httptest.NewRequest("DELETE", "/activity/"+id+"?user_id="+uuid.New().String(), nil)
+		req = mux.SetURLVars(req, map[string]string{"id": id})
+		w := httptest.NewRecorder()
+		activityHandler.DeleteActivity(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestGetActivityStatus(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: PendingUntilProcessed
+	// ------------------------
+	t.Run("PendingUntilProcessed", func(t *testing.T) {
+		t.Parallel()
+
+		svc := testutil.NewTestServices(t)
+		ctx := svc.Ctx
+		activityHandler := handler.NewActivityHandler(svc.ActivityService, zap.NewExample())
+
+		createdUser, err := svc.UserRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		reqBody, err := json.Marshal(dto.CreateActivityRequest{
+			UserID:    createdUser.ID,
+			Duration:  1800,
+			Distance:  5000,
+			H3Indexes: validH3Indexes,
+		})
+		require.NoError(t, err)
+		req := httptest.NewRequest("POST", "/activity/create", bytes.NewBuffer(reqBody))
+		w := httptest.NewRecorder()
+		activityHandler.CreateActivity(w, req)
+		require.Equal(t, http.StatusAccepted, w.Code)
+
+		var createResp ActivityAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &createResp))
+		assert.Equal(t, model.ActivityJobPending, createResp.Data.ProcessingStatus)
+
+		getStatus := func(id string) (*httptest.ResponseRecorder, ActivityStatusAPIResponse) {
+			req := httptest.NewRequest("GET", "/activity/"+id+"/status", nil)
+			req = mux.SetURLVars(req, map[string]string{"id": id})
+			w := httptest.NewRecorder()
+			activityHandler.GetActivityStatus(w, req)
+
+			var resp ActivityStatusAPIResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			return w, resp
+		}
+
+		w, statusResp := getStatus(createResp.Data.ID.String())
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, model.ActivityJobPending, statusResp.Data.Status)
+		assert.Zero(t, statusResp.Data.Attempts)
+
+		_, err = svc.ActivityService.JobService.ProcessPending(ctx)
+		require.NoError(t, err)
+
+		w, statusResp = getStatus(createResp.Data.ID.String())
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, model.ActivityJobDone, statusResp.Data.Status)
+		assert.Equal(t, 1, statusResp.Data.Attempts)
+
+		w, _ = getStatus(uuid.New().String())
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
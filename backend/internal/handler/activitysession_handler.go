@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"stride-wars-app/ent"
+	"stride-wars-app/internal/api/middleware"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/service"
+	"stride-wars-app/internal/util"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"go.uber.org/zap"
+)
+
+type ActivitySessionHandler struct {
+	activitySessionService *service.ActivitySessionService
+	logger                 *zap.Logger
+}
+
+func NewActivitySessionHandler(activitySessionService *service.ActivitySessionService, logger *zap.Logger) *ActivitySessionHandler {
+	return &ActivitySessionHandler{
+		activitySessionService: activitySessionService,
+		logger:                 logger,
+	}
+}
+
+func (h *ActivitySessionHandler) StartSession(w http.ResponseWriter, r *http.Request) {
+	var req dto.StartActivitySessionRequest
+	if err := util.DecodeJSONBody(r.Body, &req); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid request format")
+		return
+	}
+
+	resp, err := h.activitySessionService.StartSession(r.Context(), req)
+	if err != nil {
+		h.logger.Error("start activity session failed", zap.Error(err))
+		if ent.IsNotFound(err) {
+			middleware.WriteError(w, http.StatusNotFound, "user not found")
+		} else {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusCreated, resp)
+}
+
+func (h *ActivitySessionHandler) GetSession(w http.ResponseWriter, r *http.Request) {
+	sessionID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid UUID format for session 'id'")
+		return
+	}
+
+	idStr := r.URL.Query().Get("user_id")
+	if idStr == "" {
+		middleware.WriteError(w, http.StatusBadRequest, "User ID is required")
+		return
+	}
+
+	userID, err := uuid.Parse(idStr)
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid UUID format for 'user_id'")
+		return
+	}
+
+	resp, err := h.activitySessionService.GetSession(r.Context(), sessionID, userID)
+	if err != nil {
+		h.logger.Error("get activity session failed", zap.Error(err))
+		h.writeSessionError(w, err)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *ActivitySessionHandler) AppendPoints(w http.ResponseWriter, r *http.Request) {
+	sessionID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid UUID format for session 'id'")
+		return
+	}
+
+	var req dto.AppendActivitySessionPointsRequest
+	if err := util.DecodeJSONBody(r.Body, &req); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid request format")
+		return
+	}
+
+	resp, err := h.activitySessionService.AppendPoints(r.Context(), sessionID, req)
+	if err != nil {
+		h.logger.Error("append activity session points failed", zap.Error(err))
+		h.writeSessionError(w, err)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *ActivitySessionHandler) PauseSession(w http.ResponseWriter, r *http.Request) {
+	h.handleTransition(w, r, "pause", h.activitySessionService.PauseSession)
+}
+
+func (h *ActivitySessionHandler) ResumeSession(w http.ResponseWriter, r *http.Request) {
+	h.handleTransition(w, r, "resume", h.activitySessionService.ResumeSession)
+}
+
+func (h *ActivitySessionHandler) FinishSession(w http.ResponseWriter, r *http.Request) {
+	sessionID, req, ok := h.decodeSessionRequest(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.activitySessionService.FinishSession(r.Context(), sessionID, req.UserID)
+	if err != nil {
+		h.logger.Error("finish activity session failed", zap.Error(err))
+		h.writeSessionError(w, err)
+		return
+	}
+
+	// Like an uploaded activity, the finished one is scored in the background.
+	middleware.WriteJSON(w, http.StatusAccepted, resp)
+}
+
+func (h *ActivitySessionHandler) handleTransition(
+	w http.ResponseWriter,
+	r *http.Request,
+	action string,
+	transition func(ctx context.Context, sessionID uuid.UUID, userID uuid.UUID) (*dto.ActivitySessionResponse, error),
+) {
+	sessionID, req, ok := h.decodeSessionRequest(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := transition(r.Context(), sessionID, req.UserID)
+	if err != nil {
+		h.logger.Error(action+" activity session failed", zap.Error(err))
+		h.writeSessionError(w, err)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *ActivitySessionHandler) decodeSessionRequest(w http.ResponseWriter, r *http.Request) (uuid.UUID, dto.ActivitySessionRequest, bool) {
+	var req dto.ActivitySessionRequest
+	sessionID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid UUID format for session 'id'")
+		return sessionID, req, false
+	}
+
+	if err := util.DecodeJSONBody(r.Body, &req); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid request format")
+		return sessionID, req, false
+	}
+	return sessionID, req, true
+}
+
+func (h *ActivitySessionHandler) writeSessionError(w http.ResponseWriter, err error) {
+	switch {
+	case ent.IsNotFound(err):
+		middleware.WriteError(w, http.StatusNotFound, "activity session not found")
+	case errors.Is(err, service.ErrSessionNotOwned):
+		middleware.WriteError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, service.ErrSessionClosed), errors.Is(err, service.ErrSessionPaused):
+		middleware.WriteError(w, http.StatusConflict, err.Error())
+	default:
+		middleware.WriteError(w, http.StatusBadRequest, err.Error())
+	}
+}
@@ -0,0 +1,157 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/handler"
+	"stride-wars-app/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type ActivitySessionAPIResponse struct {
+	Success bool                        `json:"success"`
+	Data    dto.ActivitySessionResponse `json:"data"`
+	Error   string                      `json:"error,omitempty"`
+}
+
+func setupTestActivitySessionHandler(t *testing.T) (context.Context, *ent.Client, *handler.ActivitySessionHandler, *ent.User) {
+	t.Helper()
+
+	svc := testutil.NewTestServices(t)
+	sessionHandler := handler.NewActivitySessionHandler(svc.ActivitySessionService, zap.NewExample())
+
+	user, err := svc.UserRepo.CreateUser(svc.Ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+	require.NoError(t, err)
+	return svc.Ctx, svc.Client, sessionHandler, user
+}
+
+// sessionRequest builds a request carrying body as JSON and the session id as a path variable.
+func sessionRequest(t *testing.T, method string, path string, sessionID string, body any) *http.Request {
+	t.Helper()
+	reqBody, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(method, path, bytes.NewBuffer(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+	if sessionID != "" {
+		req = mux.SetURLVars(req, map[string]string{"id": sessionID})
+	}
+	return req
+}
+
+func startSession(t *testing.T, sessionHandler *handler.ActivitySessionHandler, userID uuid.UUID) dto.ActivitySessionResponse {
+	t.Helper()
+	w := httptest.NewRecorder()
+	sessionHandler.StartSession(w, sessionRequest(t, "POST", "/activity/session", "", dto.StartActivitySessionRequest{UserID: userID}))
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var resp ActivitySessionAPIResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	return resp.Data
+}
+
+func TestActivitySessionHandler(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: HappyPath
+	// ------------------------
+	t.Run("HappyPath", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, client, sessionHandler, user := setupTestActivitySessionHandler(t)
+		session := startSession(t, sessionHandler, user.ID)
+		id := session.ID.String()
+
+		w := httptest.NewRecorder()
+		sessionHandler.AppendPoints(w, sessionRequest(t, "PATCH", "/activity/session/"+id+"/points", id,
+			dto.AppendActivitySessionPointsRequest{UserID: user.ID, H3Indexes: validH3Indexes, Distance: 1200}))
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var appendResp ActivitySessionAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &appendResp))
+		assert.Equal(t, validH3Indexes, appendResp.Data.H3Indexes)
+		assert.Equal(t, 1200.0, appendResp.Data.Distance)
+
+		w = httptest.NewRecorder()
+		sessionHandler.FinishSession(w, sessionRequest(t, "POST", "/activity/session/"+id+"/finish", id,
+			dto.ActivitySessionRequest{UserID: user.ID}))
+		require.Equal(t, http.StatusAccepted, w.Code)
+
+		var finishResp ActivityAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &finishResp))
+
+		activity, err := client.Activity.Get(ctx, finishResp.Data.ID)
+		require.NoError(t, err)
+		assert.Equal(t, validH3Indexes, activity.H3Indexes)
+	})
+
+	// ------------------------
+	// Subtest: AppendWhilePaused
+	// ------------------------
+	t.Run("AppendWhilePaused", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, sessionHandler, user := setupTestActivitySessionHandler(t)
+		session := startSession(t, sessionHandler, user.ID)
+		id := session.ID.String()
+
+		w := httptest.NewRecorder()
+		sessionHandler.PauseSession(w, sessionRequest(t, "POST", "/activity/session/"+id+"/pause", id,
+			dto.ActivitySessionRequest{UserID: user.ID}))
+		require.Equal(t, http.StatusOK, w.Code)
+
+		w = httptest.NewRecorder()
+		sessionHandler.AppendPoints(w, sessionRequest(t, "PATCH", "/activity/session/"+id+"/points", id,
+			dto.AppendActivitySessionPointsRequest{UserID: user.ID, H3Indexes: validH3Indexes}))
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	// ------------------------
+	// Subtest: NotOwner
+	// ------------------------
+	t.Run("NotOwner", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, sessionHandler, user := setupTestActivitySessionHandler(t)
+		session := startSession(t, sessionHandler, user.ID)
+		id := session.ID.String()
+
+		req := httptest.NewRequest("GET", "/activity/session/"+id+"?user_id="+uuid.New().String(), nil)
+		req = mux.SetURLVars(req, map[string]string{"id": id})
+		w := httptest.NewRecorder()
+		sessionHandler.GetSession(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	// ------------------------
+	// Subtest: NotFound
+	// ------------------------
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, sessionHandler, user := setupTestActivitySessionHandler(t)
+
+		id := uuid.New().String()
+		w := httptest.NewRecorder()
+		sessionHandler.ResumeSession(w, sessionRequest(t, "POST", "/activity/session/"+id+"/resume", id,
+			dto.ActivitySessionRequest{UserID: user.ID}))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -11,6 +11,19 @@ type Handlers struct {
 	UserHandler           *UserHandler
 	ActivityHandler       *ActivityHandler
 	HexLeaderboardHandler *HexLeaderboardHandler
+
+	ActivitySessionHandler *ActivitySessionHandler
+	ProgressHandler        *ProgressHandler
+	PrivacyHandler         *PrivacyHandler
+	SegmentHandler         *SegmentHandler
+
+	InfluenceHistoryHandler *InfluenceHistoryHandler
+	HexCaptureHandler       *HexCaptureHandler
+	NotificationHandler     *NotificationHandler
+	PushHandler             *PushHandler
+	WebhookHandler          *WebhookHandler
+	SeasonHandler           *SeasonHandler
+	RegionHandler           *RegionHandler
 }
 
 func Provide(services *service.Services, logger *zap.Logger) *Handlers {
@@ -19,5 +32,21 @@ func Provide(services *service.Services, logger *zap.Logger) *Handlers {
 		UserHandler:           NewUserHandler(services.UserService, logger),
 		ActivityHandler:       NewActivityHandler(services.ActivityService, logger),
 		HexLeaderboardHandler: NewHexLeaderboardHandler(services.HexLeaderboardService, logger),
+
+		ActivitySessionHandler: NewActivitySessionHandler(services.ActivitySessionService, logger),
+		ProgressHandler: NewProgressHandler(services.ActivityService.StreakService,
+			services.ActivityService.GoalService,
+			services.ActivityService.PersonalRecordService,
+			logger),
+		PrivacyHandler: NewPrivacyHandler(services.PrivacyZoneService, logger),
+		SegmentHandler: NewSegmentHandler(services.SegmentService, logger),
+
+		InfluenceHistoryHandler: NewInfluenceHistoryHandler(services.InfluenceHistoryService, logger),
+		HexCaptureHandler:       NewHexCaptureHandler(services.HexCaptureService, logger),
+		NotificationHandler:     NewNotificationHandler(services.NotificationService, logger),
+		PushHandler:             NewPushHandler(services.PushService, logger),
+		WebhookHandler:          NewWebhookHandler(services.WebhookService, logger),
+		SeasonHandler:           NewSeasonHandler(services.SeasonService, logger),
+		RegionHandler:           NewRegionHandler(services.RegionService, logger),
 	}
 }
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"stride-wars-app/ent"
+	"stride-wars-app/internal/api/middleware"
+	"stride-wars-app/internal/service"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"go.uber.org/zap"
+)
+
+type HexCaptureHandler struct {
+	captureService *service.HexCaptureService
+	logger         *zap.Logger
+}
+
+func NewHexCaptureHandler(captureService *service.HexCaptureService, logger *zap.Logger) *HexCaptureHandler {
+	return &HexCaptureHandler{
+		captureService: captureService,
+		logger:         logger,
+	}
+}
+
+func (h *HexCaptureHandler) GetHexCaptures(w http.ResponseWriter, r *http.Request) {
+	limit := 0
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil {
+			middleware.WriteError(w, http.StatusBadRequest, "Invalid number for 'limit'")
+			return
+		}
+	}
+
+	resp, err := h.captureService.GetHexCaptures(r.Context(), mux.Vars(r)["h3"], limit)
+	if err != nil {
+		h.logger.Error("get hex captures failed", zap.Error(err))
+		switch {
+		case errors.Is(err, service.ErrInvalidHex), errors.Is(err, service.ErrInvalidCapturesLimit):
+			middleware.WriteError(w, http.StatusBadRequest, err.Error())
+		default:
+			middleware.WriteError(w, http.StatusInternalServerError, "could not load hex captures")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+// GetUserCaptures lists the captures a user made or suffered between the optional from and to
+// query parameters, given in RFC 3339.
+func (h *HexCaptureHandler) GetUserCaptures(w http.ResponseWriter, r *http.Request) {
+	userID, ok := userIDFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	var from, to time.Time
+	for name, t := range map[string]*time.Time{"from": &from, "to": &to} {
+		value := r.URL.Query().Get(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			middleware.WriteError(w, http.StatusBadRequest, "Invalid RFC 3339 time for '"+name+"'")
+			return
+		}
+		*t = parsed
+	}
+
+	resp, err := h.captureService.GetUserCaptures(r.Context(), userID, from, to)
+	if err != nil {
+		h.logger.Error("get user captures failed", zap.Error(err))
+		switch {
+		case ent.IsNotFound(err):
+			middleware.WriteError(w, http.StatusNotFound, "user not found")
+		case errors.Is(err, service.ErrInvalidCapturesWindow):
+			middleware.WriteError(w, http.StatusBadRequest, err.Error())
+		default:
+			middleware.WriteError(w, http.StatusInternalServerError, "could not load user captures")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
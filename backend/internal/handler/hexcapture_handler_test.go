@@ -0,0 +1,92 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/handler"
+	"stride-wars-app/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type HexCapturesAPIResponse struct {
+	Success bool                    `json:"success"`
+	Data    dto.HexCapturesResponse `json:"data"`
+	Error   string                  `json:"error,omitempty"`
+}
+
+type UserCapturesAPIResponse struct {
+	Success bool                     `json:"success"`
+	Data    dto.UserCapturesResponse `json:"data"`
+	Error   string                   `json:"error,omitempty"`
+}
+
+func TestHexCaptureHandler(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: HexAndUserCaptures
+	// ------------------------
+	t.Run("HexAndUserCaptures", func(t *testing.T) {
+		t.Parallel()
+
+		svc := testutil.NewTestServices(t)
+		captureHandler := handler.NewHexCaptureHandler(svc.HexCaptureService, zap.NewExample())
+
+		hexID := krakowH3Indexes[0]
+		require.NoError(t, svc.HexService.CreateMissingHexes(svc.Ctx, []string{hexID}))
+		user, err := svc.UserRepo.CreateUser(svc.Ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		influences, err := svc.HexInfluenceService.RecordVisitsAt(svc.Ctx, user.ID, nil, []string{hexID}, time.Now())
+		require.NoError(t, err)
+		_, err = svc.HexLeaderboardService.AddUserToLeaderboards(svc.Ctx, user.Username, nil, influences)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/hex/"+hexID+"/captures", nil)
+		req = mux.SetURLVars(req, map[string]string{"h3": hexID})
+		w := httptest.NewRecorder()
+		captureHandler.GetHexCaptures(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var hexResp HexCapturesAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &hexResp))
+		require.Len(t, hexResp.Data.Captures, 1)
+		assert.Equal(t, user.ID, hexResp.Data.Captures[0].NewOwnerID)
+		assert.Nil(t, hexResp.Data.Captures[0].PreviousOwnerID)
+
+		from := url.QueryEscape(time.Now().Add(-time.Hour).Format(time.RFC3339))
+		req = httptest.NewRequest("GET", "/user/captures?user_id="+user.ID.String()+"&from="+from, nil)
+		w = httptest.NewRecorder()
+		captureHandler.GetUserCaptures(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var userResp UserCapturesAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &userResp))
+		assert.Len(t, userResp.Data.Made, 1)
+		assert.Empty(t, userResp.Data.Suffered)
+
+		// Invalid time
+		req = httptest.NewRequest("GET", "/user/captures?user_id="+user.ID.String()+"&from=yesterday", nil)
+		w = httptest.NewRecorder()
+		captureHandler.GetUserCaptures(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		// Invalid limit
+		req = httptest.NewRequest("GET", "/hex/"+hexID+"/captures?limit=1000", nil)
+		req = mux.SetURLVars(req, map[string]string{"h3": hexID})
+		w = httptest.NewRecorder()
+		captureHandler.GetHexCaptures(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -1,11 +1,15 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 	"stride-wars-app/internal/api/middleware"
+	"stride-wars-app/internal/hex/hexconsts"
 	"stride-wars-app/internal/service"
 
+	"github.com/gorilla/mux"
+
 	"go.uber.org/zap"
 )
 
@@ -69,10 +73,31 @@ func (h HexLeaderboardHandler) GetAllLeaderboardsInsideBBox(w http.ResponseWrite
 		MaxLng: maxLng,
 	}
 
+	// The resolution is given directly or derived from the map's zoom level
+	resolution := hexconsts.DefaultHexResolution
+	if resolutionStr := query.Get("resolution"); resolutionStr != "" {
+		resolution, err = strconv.Atoi(resolutionStr)
+		if err != nil {
+			middleware.WriteError(w, http.StatusBadRequest, "Invalid resolution parameter")
+			return
+		}
+	} else if zoomStr := query.Get("zoom"); zoomStr != "" {
+		zoom, err := strconv.Atoi(zoomStr)
+		if err != nil {
+			middleware.WriteError(w, http.StatusBadRequest, "Invalid zoom parameter")
+			return
+		}
+		resolution = service.ResolutionForZoom(zoom)
+	}
+
 	// Call the service with the bounding box
-	resp, err := h.hexLeaderboardService.GetAllLeaderboardsInsideBBBox(r.Context(), boundingBox)
+	resp, err := h.hexLeaderboardService.GetAllLeaderboardsInsideBBBox(r.Context(), boundingBox, resolution)
 	if err != nil {
 		h.logger.Error("get all leaderboards inside bbox failed", zap.Error(err))
+		if errors.Is(err, service.ErrInvalidResolution) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		middleware.WriteError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -80,6 +105,43 @@ func (h HexLeaderboardHandler) GetAllLeaderboardsInsideBBox(w http.ResponseWrite
 	middleware.WriteJSON(w, http.StatusOK, resp)
 }
 
+// GetHexRanking ranks every user with influence in a hex. It takes the query parameter user_id,
+// whose own place is always included, and the optional limit and offset.
+func (h HexLeaderboardHandler) GetHexRanking(w http.ResponseWriter, r *http.Request) {
+	userID, ok := userIDFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	page := map[string]int{"limit": 0, "offset": 0}
+	for name := range page {
+		value := r.URL.Query().Get(name)
+		if value == "" {
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			middleware.WriteError(w, http.StatusBadRequest, "Invalid number for '"+name+"'")
+			return
+		}
+		page[name] = n
+	}
+
+	resp, err := h.hexLeaderboardService.GetHexRanking(r.Context(), mux.Vars(r)["h3"], userID, page["limit"], page["offset"])
+	if err != nil {
+		h.logger.Error("get hex ranking failed", zap.Error(err))
+		switch {
+		case errors.Is(err, service.ErrInvalidHex), errors.Is(err, service.ErrInvalidRankingPage):
+			middleware.WriteError(w, http.StatusBadRequest, err.Error())
+		default:
+			middleware.WriteError(w, http.StatusInternalServerError, "could not load hex ranking")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
 func (h HexLeaderboardHandler) GetGlobalHexLeaderboard(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	entries, err := h.hexLeaderboardService.GetGlobalLeaderboard(ctx)
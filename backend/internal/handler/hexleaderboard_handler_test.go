@@ -7,9 +7,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"stride-wars-app/ent"
 	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/config"
 	"stride-wars-app/internal/dto"
 	"stride-wars-app/internal/handler"
 	"stride-wars-app/internal/repository"
@@ -17,6 +19,7 @@ import (
 	"stride-wars-app/internal/testutil"
 
 	"github.com/google/uuid"
+	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -32,6 +35,12 @@ type HexLeaderboardAPIResponse struct {
 	Data    dto.GetAllHexLeaderboardsInsideBBoxResponse `json:"data"`
 }
 
+type HexRankingAPIResponse struct {
+	Success bool                      `json:"success"`
+	Data    dto.GetHexRankingResponse `json:"data"`
+	Error   string                    `json:"error,omitempty"`
+}
+
 func setupTestHexLeaderboardHandler(t *testing.T) (context.Context, *ent.Client, *handler.HexLeaderboardHandler) {
 	t.Helper()
 	svc := testutil.NewTestServices(t)
@@ -73,11 +82,9 @@ func TestHexLeaderboardHandler(t *testing.T) {
 
 		// Create two activities with the same H3 indexes (different users)
 		activityService := service.NewActivityService(
-			repository.NewActivityRepository(client),
-			repository.NewHexInfluenceRepository(client),
-			repository.NewHexLeaderboardRepository(client),
-			repository.NewHexRepository(client),
+			repository.Provide(client),
 			service.NewUserService(repository.NewUserRepository(client), zap.NewExample()),
+			config.Default(),
 			zap.NewExample(),
 		)
 
@@ -98,6 +105,8 @@ func TestHexLeaderboardHandler(t *testing.T) {
 		}
 		_, err = activityService.CreateActivity(ctx, activity2)
 		require.NoError(t, err)
+		_, err = activityService.JobService.ProcessPending(ctx)
+		require.NoError(t, err)
 
 		// Build bounding box query
 		bbox := service.BoundingBox{
@@ -133,4 +142,45 @@ func TestHexLeaderboardHandler(t *testing.T) {
 		assert.Equal(t, createdUser1.ID, resp.Data.Leaderboards[1].TopUsers[0].UserID)
 		assert.Equal(t, createdUser2.ID, resp.Data.Leaderboards[1].TopUsers[1].UserID)
 	})
+
+	t.Run("GetHexRanking", func(t *testing.T) {
+		t.Parallel()
+		svc := testutil.NewTestServices(t)
+		hexLeaderboardHandler := handler.NewHexLeaderboardHandler(svc.HexLeaderboardService, zap.NewExample())
+
+		hexID := krakowH3Indexes[0]
+		require.NoError(t, svc.HexService.CreateMissingHexes(svc.Ctx, []string{hexID}))
+		user, err := svc.UserRepo.CreateUser(svc.Ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		_, err = svc.HexInfluenceService.RecordVisitsAt(svc.Ctx, user.ID, nil, []string{hexID}, time.Now())
+		require.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/leaderboard/hex/"+hexID+"?user_id="+user.ID.String(), nil)
+		req = mux.SetURLVars(req, map[string]string{"h3": hexID})
+		w := httptest.NewRecorder()
+		hexLeaderboardHandler.GetHexRanking(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var resp HexRankingAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, 1, resp.Data.Participants)
+		require.Len(t, resp.Data.Rankings, 1)
+		assert.Equal(t, user.ID, resp.Data.Rankings[0].UserID)
+		require.NotNil(t, resp.Data.User.Rank)
+		assert.Equal(t, 1, *resp.Data.User.Rank)
+
+		// Missing user_id
+		req = httptest.NewRequest("GET", "/leaderboard/hex/"+hexID, nil)
+		req = mux.SetURLVars(req, map[string]string{"h3": hexID})
+		w = httptest.NewRecorder()
+		hexLeaderboardHandler.GetHexRanking(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		// Invalid page
+		req = httptest.NewRequest("GET", "/leaderboard/hex/"+hexID+"?user_id="+user.ID.String()+"&limit=0&offset=-1", nil)
+		req = mux.SetURLVars(req, map[string]string{"h3": hexID})
+		w = httptest.NewRecorder()
+		hexLeaderboardHandler.GetHexRanking(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
 }
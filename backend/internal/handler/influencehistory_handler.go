@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"stride-wars-app/ent"
+	"stride-wars-app/internal/api/middleware"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/service"
+
+	"github.com/gorilla/mux"
+
+	"go.uber.org/zap"
+)
+
+type InfluenceHistoryHandler struct {
+	historyService *service.InfluenceHistoryService
+	logger         *zap.Logger
+}
+
+func NewInfluenceHistoryHandler(historyService *service.InfluenceHistoryService, logger *zap.Logger) *InfluenceHistoryHandler {
+	return &InfluenceHistoryHandler{
+		historyService: historyService,
+		logger:         logger,
+	}
+}
+
+func (h *InfluenceHistoryHandler) GetHexHistory(w http.ResponseWriter, r *http.Request) {
+	userID, ok := userIDFromQuery(w, r)
+	if !ok {
+		return
+	}
+	interval, periods, ok := historyRangeFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.historyService.GetHexHistory(r.Context(), userID, mux.Vars(r)["h3"], interval, periods)
+	h.writeHistory(w, resp, err)
+}
+
+func (h *InfluenceHistoryHandler) GetUserHistory(w http.ResponseWriter, r *http.Request) {
+	userID, ok := userIDFromQuery(w, r)
+	if !ok {
+		return
+	}
+	interval, periods, ok := historyRangeFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.historyService.GetUserHistory(r.Context(), userID, interval, periods)
+	h.writeHistory(w, resp, err)
+}
+
+func (h *InfluenceHistoryHandler) writeHistory(w http.ResponseWriter, resp *dto.InfluenceHistoryResponse, err error) {
+	if err != nil {
+		h.logger.Error("get influence history failed", zap.Error(err))
+		switch {
+		case ent.IsNotFound(err):
+			middleware.WriteError(w, http.StatusNotFound, "user not found")
+		case errors.Is(err, service.ErrInvalidHistoryInterval),
+			errors.Is(err, service.ErrInvalidStatsRange),
+			errors.Is(err, service.ErrInvalidHex):
+			middleware.WriteError(w, http.StatusBadRequest, err.Error())
+		default:
+			middleware.WriteError(w, http.StatusInternalServerError, "could not load influence history")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+// historyRangeFromQuery reads the optional interval (default day) and range query parameters.
+// It writes a 400 response and returns false when range is not a number.
+func historyRangeFromQuery(w http.ResponseWriter, r *http.Request) (string, int, bool) {
+	interval := r.URL.Query().Get("interval")
+	if interval == "" {
+		interval = service.GranularityDay
+	}
+
+	periods := 0
+	if rangeStr := r.URL.Query().Get("range"); rangeStr != "" {
+		var err error
+		periods, err = strconv.Atoi(rangeStr)
+		if err != nil {
+			middleware.WriteError(w, http.StatusBadRequest, "Invalid number for 'range'")
+			return "", 0, false
+		}
+	}
+	return interval, periods, true
+}
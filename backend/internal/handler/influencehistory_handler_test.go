@@ -0,0 +1,83 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/handler"
+	"stride-wars-app/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type InfluenceHistoryAPIResponse struct {
+	Success bool                         `json:"success"`
+	Data    dto.InfluenceHistoryResponse `json:"data"`
+	Error   string                       `json:"error,omitempty"`
+}
+
+func TestInfluenceHistoryHandler(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: HexAndUserHistory
+	// ------------------------
+	t.Run("HexAndUserHistory", func(t *testing.T) {
+		t.Parallel()
+
+		svc := testutil.NewTestServices(t)
+		historyHandler := handler.NewInfluenceHistoryHandler(svc.InfluenceHistoryService, zap.NewExample())
+
+		hexID := krakowH3Indexes[0]
+		require.NoError(t, svc.HexService.CreateMissingHexes(svc.Ctx, []string{hexID}))
+		user, err := svc.UserRepo.CreateUser(svc.Ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		_, err = svc.HexInfluenceService.RecordVisitsAt(svc.Ctx, user.ID, nil, []string{hexID, hexID}, time.Now())
+		require.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/hex/"+hexID+"/history?user_id="+user.ID.String()+"&interval=week&range=4", nil)
+		req = mux.SetURLVars(req, map[string]string{"h3": hexID})
+		w := httptest.NewRecorder()
+		historyHandler.GetHexHistory(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var resp InfluenceHistoryAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, hexID, resp.Data.H3Index)
+		assert.Equal(t, "week", resp.Data.Interval)
+		require.Len(t, resp.Data.Points, 4)
+		assert.Equal(t, 0.0, resp.Data.Points[2].Score)
+		assert.Equal(t, 2.0, resp.Data.Points[3].Score)
+
+		req = httptest.NewRequest("GET", "/user/influence/history?user_id="+user.ID.String(), nil)
+		w = httptest.NewRecorder()
+		historyHandler.GetUserHistory(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+		resp = InfluenceHistoryAPIResponse{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Empty(t, resp.Data.H3Index)
+		require.NotEmpty(t, resp.Data.Points)
+		assert.Equal(t, 2.0, resp.Data.Points[len(resp.Data.Points)-1].Score)
+
+		// Invalid interval
+		req = httptest.NewRequest("GET", "/user/influence/history?user_id="+user.ID.String()+"&interval=hour", nil)
+		w = httptest.NewRecorder()
+		historyHandler.GetUserHistory(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		// Unknown user
+		req = httptest.NewRequest("GET", "/user/influence/history?user_id="+uuid.New().String(), nil)
+		w = httptest.NewRecorder()
+		historyHandler.GetUserHistory(w, req)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,184 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"stride-wars-app/ent"
+	"stride-wars-app/internal/api/middleware"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/service"
+	"stride-wars-app/internal/util"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"go.uber.org/zap"
+)
+
+type NotificationHandler struct {
+	notificationService *service.NotificationService
+	logger              *zap.Logger
+}
+
+func NewNotificationHandler(notificationService *service.NotificationService, logger *zap.Logger) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+		logger:              logger,
+	}
+}
+
+// GetNotifications lists the user's inbox. It takes the optional query parameters limit,
+// offset and unread=true.
+func (h *NotificationHandler) GetNotifications(w http.ResponseWriter, r *http.Request) {
+	userID, ok := userIDFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	page := map[string]int{"limit": 0, "offset": 0}
+	for name := range page {
+		value := r.URL.Query().Get(name)
+		if value == "" {
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			middleware.WriteError(w, http.StatusBadRequest, "Invalid number for '"+name+"'")
+			return
+		}
+		page[name] = n
+	}
+	unreadOnly := r.URL.Query().Get("unread") == "true"
+
+	resp, err := h.notificationService.GetNotifications(r.Context(), userID, unreadOnly, page["limit"], page["offset"])
+	if err != nil {
+		h.logger.Error("get notifications failed", zap.Error(err))
+		switch {
+		case ent.IsNotFound(err):
+			middleware.WriteError(w, http.StatusNotFound, "user not found")
+		case errors.Is(err, service.ErrInvalidNotificationsPage):
+			middleware.WriteError(w, http.StatusBadRequest, err.Error())
+		default:
+			middleware.WriteError(w, http.StatusInternalServerError, "could not load notifications")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *NotificationHandler) MarkRead(w http.ResponseWriter, r *http.Request) {
+	notificationID, userID, ok := notificationFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.notificationService.MarkRead(r.Context(), userID, notificationID); err != nil {
+		h.logger.Error("mark notification read failed", zap.Error(err))
+		h.writeNotificationError(w, err, "could not mark notification read")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, map[string]uuid.UUID{"notification_id": notificationID})
+}
+
+func (h *NotificationHandler) MarkAllRead(w http.ResponseWriter, r *http.Request) {
+	userID, ok := userIDFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	marked, err := h.notificationService.MarkAllRead(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("mark all notifications read failed", zap.Error(err))
+		if ent.IsNotFound(err) {
+			middleware.WriteError(w, http.StatusNotFound, "user not found")
+		} else {
+			middleware.WriteError(w, http.StatusInternalServerError, "could not mark notifications read")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, map[string]int{"marked_read": marked})
+}
+
+func (h *NotificationHandler) DeleteNotification(w http.ResponseWriter, r *http.Request) {
+	notificationID, userID, ok := notificationFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.notificationService.DeleteNotification(r.Context(), userID, notificationID); err != nil {
+		h.logger.Error("delete notification failed", zap.Error(err))
+		h.writeNotificationError(w, err, "could not delete notification")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, map[string]uuid.UUID{"notification_id": notificationID})
+}
+
+func (h *NotificationHandler) GetPreferences(w http.ResponseWriter, r *http.Request) {
+	userID, ok := userIDFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.notificationService.GetPreferences(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("get notification preferences failed", zap.Error(err))
+		if ent.IsNotFound(err) {
+			middleware.WriteError(w, http.StatusNotFound, "user not found")
+		} else {
+			middleware.WriteError(w, http.StatusInternalServerError, "could not load notification preferences")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *NotificationHandler) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	var req dto.NotificationPreferencesRequest
+	if err := util.DecodeJSONBody(r.Body, &req); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid request format")
+		return
+	}
+
+	resp, err := h.notificationService.UpdatePreferences(r.Context(), req)
+	if err != nil {
+		h.logger.Error("update notification preferences failed", zap.Error(err))
+		switch {
+		case ent.IsNotFound(err):
+			middleware.WriteError(w, http.StatusNotFound, "user not found")
+		case errors.Is(err, service.ErrInvalidNotificationType):
+			middleware.WriteError(w, http.StatusBadRequest, err.Error())
+		default:
+			middleware.WriteError(w, http.StatusInternalServerError, "could not update notification preferences")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *NotificationHandler) writeNotificationError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case ent.IsNotFound(err):
+		middleware.WriteError(w, http.StatusNotFound, "notification not found")
+	case errors.Is(err, service.ErrNotificationNotOwned):
+		middleware.WriteError(w, http.StatusForbidden, err.Error())
+	default:
+		middleware.WriteError(w, http.StatusInternalServerError, message)
+	}
+}
+
+func notificationFromRequest(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	notificationID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid UUID format for notification 'id'")
+		return uuid.Nil, uuid.Nil, false
+	}
+	userID, ok := userIDFromQuery(w, r)
+	return notificationID, userID, ok
+}
@@ -0,0 +1,116 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/handler"
+	"stride-wars-app/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type NotificationsAPIResponse struct {
+	Success bool                         `json:"success"`
+	Data    dto.GetNotificationsResponse `json:"data"`
+	Error   string                       `json:"error,omitempty"`
+}
+
+type NotificationPreferencesAPIResponse struct {
+	Success bool                                `json:"success"`
+	Data    dto.NotificationPreferencesResponse `json:"data"`
+	Error   string                              `json:"error,omitempty"`
+}
+
+func TestNotificationHandler(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: InboxAndPreferences
+	// ------------------------
+	t.Run("InboxAndPreferences", func(t *testing.T) {
+		t.Parallel()
+
+		svc := testutil.NewTestServices(t)
+		notificationHandler := handler.NewNotificationHandler(svc.NotificationService, zap.NewExample())
+
+		user, err := svc.UserRepo.CreateUser(svc.Ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		created, err := svc.NotificationService.Notify(svc.Ctx,
+			&model.Notification{UserID: user.ID, NotificationType: model.NotificationHexCaptured, Message: "hex taken"},
+			&model.Notification{UserID: user.ID, NotificationType: model.NotificationLeaderboardLost, Message: "out of the top 5"},
+		)
+		require.NoError(t, err)
+		userQuery := "?user_id=" + user.ID.String()
+
+		req := httptest.NewRequest("GET", "/notifications"+userQuery+"&limit=1", nil)
+		w := httptest.NewRecorder()
+		notificationHandler.GetNotifications(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var listResp NotificationsAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listResp))
+		require.Len(t, listResp.Data.Notifications, 1)
+		assert.Equal(t, 2, listResp.Data.Unread)
+		require.NotNil(t, listResp.Data.NextOffset)
+
+		// Mark one read
+		id := created[0].ID.String()
+		req = httptest.NewRequest("POST", "/notifications/"+id+"/read"+userQuery, nil)
+		req = mux.SetURLVars(req, map[string]string{"id": id})
+		w = httptest.NewRecorder()
+		notificationHandler.MarkRead(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		// Another user cannot delete it
+		req = httptest.NewRequest("DELETE", "/notifications/"+id+"?user_id="+uuid.New().String(), nil)
+		req = mux.SetURLVars(req, map[string]string{"id": id})
+		w = httptest.NewRecorder()
+		notificationHandler.DeleteNotification(w, req)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+
+		req = httptest.NewRequest("DELETE", "/notifications/"+id+userQuery, nil)
+		req = mux.SetURLVars(req, map[string]string{"id": id})
+		w = httptest.NewRecorder()
+		notificationHandler.DeleteNotification(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		req = httptest.NewRequest("POST", "/notifications/read"+userQuery, nil)
+		w = httptest.NewRecorder()
+		notificationHandler.MarkAllRead(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"marked_read":1`)
+
+		reqBody, err := json.Marshal(dto.NotificationPreferencesRequest{
+			UserID:      user.ID,
+			Preferences: map[string]bool{model.NotificationPersonalRecord: false},
+		})
+		require.NoError(t, err)
+		req = httptest.NewRequest("PUT", "/notifications/preferences", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		w = httptest.NewRecorder()
+		notificationHandler.UpdatePreferences(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var prefResp NotificationPreferencesAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &prefResp))
+		assert.False(t, prefResp.Data.Preferences[model.NotificationPersonalRecord])
+		assert.True(t, prefResp.Data.Preferences[model.NotificationHexCaptured])
+
+		// Unknown notification type
+		req = httptest.NewRequest("PUT", "/notifications/preferences", bytes.NewBufferString(`{"user_id":"`+user.ID.String()+`","preferences":{"spam":true}}`))
+		req.Header.Set("Content-Type", "application/json")
+		w = httptest.NewRecorder()
+		notificationHandler.UpdatePreferences(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
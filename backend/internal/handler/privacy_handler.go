@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"stride-wars-app/ent"
+	"stride-wars-app/internal/api/middleware"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/service"
+	"stride-wars-app/internal/util"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"go.uber.org/zap"
+)
+
+type PrivacyHandler struct {
+	privacyZoneService *service.PrivacyZoneService
+	logger             *zap.Logger
+}
+
+func NewPrivacyHandler(privacyZoneService *service.PrivacyZoneService, logger *zap.Logger) *PrivacyHandler {
+	return &PrivacyHandler{
+		privacyZoneService: privacyZoneService,
+		logger:             logger,
+	}
+}
+
+func (h *PrivacyHandler) GetZones(w http.ResponseWriter, r *http.Request) {
+	userID, ok := userIDFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.privacyZoneService.GetZones(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("get privacy zones failed", zap.Error(err))
+		if ent.IsNotFound(err) {
+			middleware.WriteError(w, http.StatusNotFound, "user not found")
+		} else {
+			middleware.WriteError(w, http.StatusInternalServerError, "could not load privacy zones")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *PrivacyHandler) CreateZone(w http.ResponseWriter, r *http.Request) {
+	var req dto.PrivacyZoneRequest
+	if err := util.DecodeJSONBody(r.Body, &req); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid request format")
+		return
+	}
+
+	resp, err := h.privacyZoneService.CreateZone(r.Context(), req)
+	if err != nil {
+		h.logger.Error("create privacy zone failed", zap.Error(err))
+		if ent.IsNotFound(err) {
+			middleware.WriteError(w, http.StatusNotFound, "user not found")
+		} else {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusCreated, resp)
+}
+
+func (h *PrivacyHandler) UpdateZone(w http.ResponseWriter, r *http.Request) {
+	zoneID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid UUID format for privacy zone 'id'")
+		return
+	}
+
+	var req dto.PrivacyZoneRequest
+	if err := util.DecodeJSONBody(r.Body, &req); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid request format")
+		return
+	}
+
+	resp, err := h.privacyZoneService.UpdateZone(r.Context(), zoneID, req)
+	if err != nil {
+		h.logger.Error("update privacy zone failed", zap.Error(err))
+		writePrivacyZoneError(w, err)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *PrivacyHandler) DeleteZone(w http.ResponseWriter, r *http.Request) {
+	zoneID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid UUID format for privacy zone 'id'")
+		return
+	}
+
+	userID, ok := userIDFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.privacyZoneService.DeleteZone(r.Context(), zoneID, userID); err != nil {
+		h.logger.Error("delete privacy zone failed", zap.Error(err))
+		writePrivacyZoneError(w, err)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, map[string]uuid.UUID{"zone_id": zoneID})
+}
+
+func (h *PrivacyHandler) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+	var req dto.PrivacySettingsRequest
+	if err := util.DecodeJSONBody(r.Body, &req); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid request format")
+		return
+	}
+
+	resp, err := h.privacyZoneService.UpdateSettings(r.Context(), req)
+	if err != nil {
+		h.logger.Error("update privacy settings failed", zap.Error(err))
+		if ent.IsNotFound(err) {
+			middleware.WriteError(w, http.StatusNotFound, "user not found")
+		} else {
+			middleware.WriteError(w, http.StatusInternalServerError, "could not update privacy settings")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+func writePrivacyZoneError(w http.ResponseWriter, err error) {
+	switch {
+	case ent.IsNotFound(err):
+		middleware.WriteError(w, http.StatusNotFound, "privacy zone not found")
+	case errors.Is(err, service.ErrPrivacyZoneNotOwned):
+		middleware.WriteError(w, http.StatusForbidden, err.Error())
+	default:
+		middleware.WriteError(w, http.StatusBadRequest, err.Error())
+	}
+}
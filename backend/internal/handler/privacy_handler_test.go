@@ -0,0 +1,143 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/handler"
+	"stride-wars-app/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type PrivacyZoneAPIResponse struct {
+	Success bool                    `json:"success"`
+	Data    dto.PrivacyZoneResponse `json:"data"`
+	Error   string                  `json:"error,omitempty"`
+}
+
+type PrivacyZonesAPIResponse struct {
+	Success bool                        `json:"success"`
+	Data    dto.GetPrivacyZonesResponse `json:"data"`
+	Error   string                      `json:"error,omitempty"`
+}
+
+type ActivityDetailAPIResponse struct {
+	Success bool                       `json:"success"`
+	Data    dto.ActivityDetailResponse `json:"data"`
+	Error   string                     `json:"error,omitempty"`
+}
+
+func TestPrivacyHandler(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: ZoneLifecycle
+	// ------------------------
+	t.Run("ZoneLifecycle", func(t *testing.T) {
+		t.Parallel()
+
+		svc := testutil.NewTestServices(t)
+		privacyHandler := handler.NewPrivacyHandler(svc.ActivityService.PrivacyZoneService, zap.NewExample())
+
+		createdUser, err := svc.UserRepo.CreateUser(svc.Ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		reqBody, err := json.Marshal(dto.PrivacyZoneRequest{UserID: createdUser.ID, Name: "home", H3Indexes: []string{validH3Indexes[0]}})
+		require.NoError(t, err)
+		req := httptest.NewRequest("POST", "/privacy/zones", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		privacyHandler.CreateZone(w, req)
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		var zoneResp PrivacyZoneAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &zoneResp))
+		assert.Equal(t, "home", zoneResp.Data.Name)
+		id := zoneResp.Data.ID.String()
+
+		req = httptest.NewRequest("GET", "/privacy/zones?user_id="+createdUser.ID.String(), nil)
+		w = httptest.NewRecorder()
+		privacyHandler.GetZones(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var zonesResp PrivacyZonesAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &zonesResp))
+		require.Len(t, zonesResp.Data.Zones, 1)
+
+		// A zone needs either a circle or cells
+		reqBody, err = json.Marshal(dto.PrivacyZoneRequest{UserID: createdUser.ID})
+		require.NoError(t, err)
+		req = httptest.NewRequest("PUT", "/privacy/zones/"+id, bytes.NewBuffer(reqBody))
+		req = mux.SetURLVars(req, map[string]string{"id": id})
+		w = httptest.NewRecorder()
+		privacyHandler.UpdateZone(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		// Another user cannot delete the zone
+		req = httptest.NewRequest("DELETE", "/privacy/zones/"+id+"?user_id="+uuid.New().String(), nil)
+		req = mux.SetURLVars(req, map[string]string{"id": id})
+		w = httptest.NewRecorder()
+		privacyHandler.DeleteZone(w, req)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+
+		req = httptest.NewRequest("DELETE", "/privacy/zones/"+id+"?user_id="+createdUser.ID.String(), nil)
+		req = mux.SetURLVars(req, map[string]string{"id": id})
+		w = httptest.NewRecorder()
+		privacyHandler.DeleteZone(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	// ------------------------
+	// Subtest: ActivityDetailHidesZone
+	// ------------------------
+	t.Run("ActivityDetailHidesZone", func(t *testing.T) {
+		t.Parallel()
+
+		svc := testutil.NewTestServices(t)
+		activityHandler := handler.NewActivityHandler(svc.ActivityService, zap.NewExample())
+
+		createdUser, err := svc.UserRepo.CreateUser(svc.Ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		created, err := svc.ActivityService.CreateActivity(svc.Ctx, dto.CreateActivityRequest{
+			UserID:    createdUser.ID,
+			Duration:  600,
+			Distance:  2000,
+			H3Indexes: validH3Indexes,
+		})
+		require.NoError(t, err)
+		_, err = svc.ActivityService.PrivacyZoneService.CreateZone(svc.Ctx, dto.PrivacyZoneRequest{
+			UserID:    createdUser.ID,
+			H3Indexes: []string{validH3Indexes[1]},
+		})
+		require.NoError(t, err)
+
+		id := created.ID.String()
+		req := httptest.NewRequest("GET", "/activity/"+id, nil)
+		req = mux.SetURLVars(req, map[string]string{"id": id})
+		w := httptest.NewRecorder()
+		activityHandler.GetActivity(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var detailResp ActivityDetailAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &detailResp))
+		assert.Equal(t, []string{validH3Indexes[0]}, detailResp.Data.H3Indexes)
+
+		unknown := uuid.New().String()
+		req = httptest.NewRequest("GET", "/activity/"+unknown, nil)
+		req = mux.SetURLVars(req, map[string]string{"id": unknown})
+		w = httptest.NewRecorder()
+		activityHandler.GetActivity(w, req)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"stride-wars-app/ent"
+	"stride-wars-app/internal/api/middleware"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/service"
+	"stride-wars-app/internal/util"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"go.uber.org/zap"
+)
+
+type ProgressHandler struct {
+	streakService *service.StreakService
+	goalService   *service.GoalService
+	recordService *service.PersonalRecordService
+	logger        *zap.Logger
+}
+
+func NewProgressHandler(streakService *service.StreakService, goalService *service.GoalService, recordService *service.PersonalRecordService, logger *zap.Logger) *ProgressHandler {
+	return &ProgressHandler{
+		streakService: streakService,
+		goalService:   goalService,
+		recordService: recordService,
+		logger:        logger,
+	}
+}
+
+func (h *ProgressHandler) GetStreaks(w http.ResponseWriter, r *http.Request) {
+	userID, ok := userIDFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.streakService.GetStreaks(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("get streaks failed", zap.Error(err))
+		if ent.IsNotFound(err) {
+			middleware.WriteError(w, http.StatusNotFound, "user not found")
+		} else {
+			middleware.WriteError(w, http.StatusInternalServerError, "could not load streaks")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *ProgressHandler) GetGoals(w http.ResponseWriter, r *http.Request) {
+	userID, ok := userIDFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.goalService.GetGoals(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("get goals failed", zap.Error(err))
+		if ent.IsNotFound(err) {
+			middleware.WriteError(w, http.StatusNotFound, "user not found")
+		} else {
+			middleware.WriteError(w, http.StatusInternalServerError, "could not load goals")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *ProgressHandler) SetGoal(w http.ResponseWriter, r *http.Request) {
+	var req dto.SetGoalRequest
+	if err := util.DecodeJSONBody(r.Body, &req); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid request format")
+		return
+	}
+
+	resp, err := h.goalService.SetGoal(r.Context(), req)
+	if err != nil {
+		h.logger.Error("set goal failed", zap.Error(err))
+		if ent.IsNotFound(err) {
+			middleware.WriteError(w, http.StatusNotFound, "user not found")
+		} else {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *ProgressHandler) DeleteGoal(w http.ResponseWriter, r *http.Request) {
+	goalID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid UUID format for goal 'id'")
+		return
+	}
+
+	userID, ok := userIDFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.goalService.DeleteGoal(r.Context(), goalID, userID); err != nil {
+		h.logger.Error("delete goal failed", zap.Error(err))
+		switch {
+		case ent.IsNotFound(err):
+			middleware.WriteError(w, http.StatusNotFound, "goal not found")
+		case errors.Is(err, service.ErrGoalNotOwned):
+			middleware.WriteError(w, http.StatusForbidden, err.Error())
+		default:
+			middleware.WriteError(w, http.StatusInternalServerError, "could not delete goal")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, map[string]uuid.UUID{"goal_id": goalID})
+}
+
+func (h *ProgressHandler) GetRecords(w http.ResponseWriter, r *http.Request) {
+	userID, ok := userIDFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.recordService.GetRecords(r.Context(), userID, r.URL.Query().Get("activity_type"))
+	if err != nil {
+		h.logger.Error("get personal records failed", zap.Error(err))
+		if errors.Is(err, service.ErrInvalidActivityType) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error())
+		} else {
+			middleware.WriteError(w, http.StatusInternalServerError, "could not load personal records")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+// userIDFromQuery parses the required user_id query parameter, writing a 400 response when it is invalid.
+func userIDFromQuery(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	idStr := r.URL.Query().Get("user_id")
+	if idStr == "" {
+		middleware.WriteError(w, http.StatusBadRequest, "User ID is required")
+		return uuid.Nil, false
+	}
+
+	userID, err := uuid.Parse(idStr)
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid UUID format for 'user_id'")
+		return uuid.Nil, false
+	}
+	return userID, true
+}
@@ -0,0 +1,152 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/handler"
+	"stride-wars-app/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type GoalAPIResponse struct {
+	Success bool             `json:"success"`
+	Data    dto.GoalResponse `json:"data"`
+	Error   string           `json:"error,omitempty"`
+}
+
+type StreaksAPIResponse struct {
+	Success bool                   `json:"success"`
+	Data    dto.GetStreaksResponse `json:"data"`
+	Error   string                 `json:"error,omitempty"`
+}
+
+type RecordsAPIResponse struct {
+	Success bool                           `json:"success"`
+	Data    dto.GetPersonalRecordsResponse `json:"data"`
+	Error   string                         `json:"error,omitempty"`
+}
+
+func TestProgressHandler(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: SetGoalAndGetStreaks
+	// ------------------------
+	t.Run("SetGoalAndGetStreaks", func(t *testing.T) {
+		t.Parallel()
+
+		svc := testutil.NewTestServices(t)
+		progressHandler := handler.NewProgressHandler(svc.ActivityService.StreakService, svc.ActivityService.GoalService, svc.ActivityService.PersonalRecordService, zap.NewExample())
+
+		createdUser, err := svc.UserRepo.CreateUser(svc.Ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		reqBody, err := json.Marshal(dto.SetGoalRequest{
+			UserID: createdUser.ID,
+			Metric: model.GoalMetricActivities,
+			Period: model.PeriodWeek,
+			Target: 3,
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest("PUT", "/progress/goals", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		progressHandler.SetGoal(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var goalResp GoalAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &goalResp))
+		assert.Equal(t, 3.0, goalResp.Data.Target)
+		assert.Equal(t, 0.0, goalResp.Data.Progress)
+
+		req = httptest.NewRequest("GET", "/progress/streaks?user_id="+createdUser.ID.String(), nil)
+		w = httptest.NewRecorder()
+		progressHandler.GetStreaks(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var streaksResp StreaksAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &streaksResp))
+		require.Len(t, streaksResp.Data.Streaks, 2)
+		assert.Equal(t, 0, streaksResp.Data.Streaks[0].Current)
+
+		// Another user cannot delete the goal
+		id := goalResp.Data.ID.String()
+		req = httptest.NewRequest("DELETE", "/progress/goals/"+id+"?user_id="+uuid.New().String(), nil)
+		req = mux.SetURLVars(req, map[string]string{"id": id})
+		w = httptest.NewRecorder()
+		progressHandler.DeleteGoal(w, req)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	// ------------------------
+	// Subtest: InvalidGoal
+	// ------------------------
+	t.Run("InvalidGoal", func(t *testing.T) {
+		t.Parallel()
+
+		svc := testutil.NewTestServices(t)
+		progressHandler := handler.NewProgressHandler(svc.ActivityService.StreakService, svc.ActivityService.GoalService, svc.ActivityService.PersonalRecordService, zap.NewExample())
+
+		createdUser, err := svc.UserRepo.CreateUser(svc.Ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		reqBody, err := json.Marshal(dto.SetGoalRequest{UserID: createdUser.ID, Metric: "steps", Period: model.PeriodDay, Target: 1})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest("PUT", "/progress/goals", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		progressHandler.SetGoal(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	// ------------------------
+	// Subtest: GetRecords
+	// ------------------------
+	t.Run("GetRecords", func(t *testing.T) {
+		t.Parallel()
+
+		svc := testutil.NewTestServices(t)
+		progressHandler := handler.NewProgressHandler(svc.ActivityService.StreakService, svc.ActivityService.GoalService, svc.ActivityService.PersonalRecordService, zap.NewExample())
+
+		createdUser, err := svc.UserRepo.CreateUser(svc.Ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		_, err = svc.ActivityService.CreateActivity(svc.Ctx, dto.CreateActivityRequest{
+			UserID:       createdUser.ID,
+			Duration:     600,
+			Distance:     2000,
+			H3Indexes:    []string{"8928308280fffff"},
+			ActivityType: model.ActivityTypeRide,
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/progress/records?user_id="+createdUser.ID.String()+"&activity_type=ride", nil)
+		w := httptest.NewRecorder()
+		progressHandler.GetRecords(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var recordsResp RecordsAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &recordsResp))
+		require.Len(t, recordsResp.Data.Records, 3)
+		for _, record := range recordsResp.Data.Records {
+			assert.Equal(t, model.ActivityTypeRide, record.ActivityType)
+		}
+
+		req = httptest.NewRequest("GET", "/progress/records?user_id="+createdUser.ID.String()+"&activity_type=swim", nil)
+		w = httptest.NewRecorder()
+		progressHandler.GetRecords(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"stride-wars-app/ent"
+	"stride-wars-app/internal/api/middleware"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/service"
+	"stride-wars-app/internal/util"
+
+	"github.com/gorilla/mux"
+
+	"go.uber.org/zap"
+)
+
+type PushHandler struct {
+	pushService *service.PushService
+	logger      *zap.Logger
+}
+
+func NewPushHandler(pushService *service.PushService, logger *zap.Logger) *PushHandler {
+	return &PushHandler{
+		pushService: pushService,
+		logger:      logger,
+	}
+}
+
+// RegisterDevice stores the Expo push token of one of the user's devices.
+func (h *PushHandler) RegisterDevice(w http.ResponseWriter, r *http.Request) {
+	var req dto.RegisterPushDeviceRequest
+	if err := util.DecodeJSONBody(r.Body, &req); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid request format")
+		return
+	}
+
+	resp, err := h.pushService.RegisterDevice(r.Context(), req)
+	if err != nil {
+		h.logger.Error("register push device failed", zap.Error(err))
+		switch {
+		case ent.IsNotFound(err):
+			middleware.WriteError(w, http.StatusNotFound, "user not found")
+		case errors.Is(err, service.ErrInvalidDeviceID), errors.Is(err, service.ErrInvalidPushToken):
+			middleware.WriteError(w, http.StatusBadRequest, err.Error())
+		default:
+			middleware.WriteError(w, http.StatusInternalServerError, "could not register device")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *PushHandler) GetDevices(w http.ResponseWriter, r *http.Request) {
+	userID, ok := userIDFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.pushService.GetDevices(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("get push devices failed", zap.Error(err))
+		if ent.IsNotFound(err) {
+			middleware.WriteError(w, http.StatusNotFound, "user not found")
+		} else {
+			middleware.WriteError(w, http.StatusInternalServerError, "could not load devices")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *PushHandler) UnregisterDevice(w http.ResponseWriter, r *http.Request) {
+	userID, ok := userIDFromQuery(w, r)
+	if !ok {
+		return
+	}
+	deviceID := mux.Vars(r)["device_id"]
+
+	if err := h.pushService.UnregisterDevice(r.Context(), userID, deviceID); err != nil {
+		h.logger.Error("unregister push device failed", zap.Error(err))
+		if errors.Is(err, service.ErrPushDeviceNotFound) {
+			middleware.WriteError(w, http.StatusNotFound, err.Error())
+		} else {
+			middleware.WriteError(w, http.StatusInternalServerError, "could not unregister device")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, map[string]string{"device_id": deviceID})
+}
+
+// UpdateQuietHours sets or clears the local times during which the user gets no push notifications.
+func (h *PushHandler) UpdateQuietHours(w http.ResponseWriter, r *http.Request) {
+	var req dto.QuietHoursRequest
+	if err := util.DecodeJSONBody(r.Body, &req); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid request format")
+		return
+	}
+
+	resp, err := h.pushService.UpdateQuietHours(r.Context(), req)
+	if err != nil {
+		h.logger.Error("update quiet hours failed", zap.Error(err))
+		switch {
+		case ent.IsNotFound(err):
+			middleware.WriteError(w, http.StatusNotFound, "user not found")
+		case errors.Is(err, service.ErrInvalidQuietHours):
+			middleware.WriteError(w, http.StatusBadRequest, err.Error())
+		default:
+			middleware.WriteError(w, http.StatusInternalServerError, "could not update quiet hours")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
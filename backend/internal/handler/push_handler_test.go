@@ -0,0 +1,100 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/handler"
+	"stride-wars-app/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type PushDevicesAPIResponse struct {
+	Success bool                       `json:"success"`
+	Data    dto.GetPushDevicesResponse `json:"data"`
+	Error   string                     `json:"error,omitempty"`
+}
+
+type QuietHoursAPIResponse struct {
+	Success bool                   `json:"success"`
+	Data    dto.QuietHoursResponse `json:"data"`
+	Error   string                 `json:"error,omitempty"`
+}
+
+func TestPushHandler(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: DevicesAndQuietHours
+	// ------------------------
+	t.Run("DevicesAndQuietHours", func(t *testing.T) {
+		t.Parallel()
+
+		svc := testutil.NewTestServices(t)
+		pushHandler := handler.NewPushHandler(svc.PushService, zap.NewExample())
+
+		user, err := svc.UserRepo.CreateUser(svc.Ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		userQuery := "?user_id=" + user.ID.String()
+
+		put := func(path string, body any, handle http.HandlerFunc) *httptest.ResponseRecorder {
+			reqBody, err := json.Marshal(body)
+			require.NoError(t, err)
+			req := httptest.NewRequest("PUT", path, bytes.NewBuffer(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			handle(w, req)
+			return w
+		}
+
+		w := put("/notifications/devices", dto.RegisterPushDeviceRequest{UserID: user.ID, DeviceID: "phone", Token: "ExponentPushToken[abc]"}, pushHandler.RegisterDevice)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		w = put("/notifications/devices", dto.RegisterPushDeviceRequest{UserID: user.ID, DeviceID: "tablet", Token: "abc"}, pushHandler.RegisterDevice)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = put("/notifications/devices", dto.RegisterPushDeviceRequest{UserID: uuid.New(), DeviceID: "tablet", Token: "ExponentPushToken[def]"}, pushHandler.RegisterDevice)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		req := httptest.NewRequest("GET", "/notifications/devices"+userQuery, nil)
+		w = httptest.NewRecorder()
+		pushHandler.GetDevices(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var devicesResp PushDevicesAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &devicesResp))
+		require.Len(t, devicesResp.Data.Devices, 1)
+		assert.Equal(t, "phone", devicesResp.Data.Devices[0].DeviceID)
+
+		start, end := "22:00", "07:00"
+		w = put("/notifications/quiet-hours", dto.QuietHoursRequest{UserID: user.ID, Start: &start, End: &end}, pushHandler.UpdateQuietHours)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var quietResp QuietHoursAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &quietResp))
+		require.NotNil(t, quietResp.Data.Start)
+		assert.Equal(t, "22:00", *quietResp.Data.Start)
+		assert.Equal(t, "07:00", *quietResp.Data.End)
+
+		w = put("/notifications/quiet-hours", dto.QuietHoursRequest{UserID: user.ID, Start: &start}, pushHandler.UpdateQuietHours)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		for _, want := range []int{http.StatusOK, http.StatusNotFound} {
+			req = httptest.NewRequest("DELETE", "/notifications/devices/phone"+userQuery, nil)
+			req = mux.SetURLVars(req, map[string]string{"device_id": "phone"})
+			w = httptest.NewRecorder()
+			pushHandler.UnregisterDevice(w, req)
+			assert.Equal(t, want, w.Code)
+		}
+	})
+}
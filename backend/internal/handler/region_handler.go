@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"stride-wars-app/ent"
+	"stride-wars-app/internal/api/middleware"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/service"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"go.uber.org/zap"
+)
+
+// AdminTokenHeader carries the token that authorizes the admin endpoints.
+const AdminTokenHeader = "X-Admin-Token"
+
+type RegionHandler struct {
+	regionService *service.RegionService
+	logger        *zap.Logger
+}
+
+func NewRegionHandler(regionService *service.RegionService, logger *zap.Logger) *RegionHandler {
+	return &RegionHandler{
+		regionService: regionService,
+		logger:        logger,
+	}
+}
+
+// GetRegions lists the loaded regions. It takes the optional query parameter kind.
+func (h *RegionHandler) GetRegions(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.regionService.GetRegions(r.Context(), r.URL.Query().Get("kind"))
+	if err != nil {
+		h.logger.Error("get regions failed", zap.Error(err))
+		middleware.WriteError(w, http.StatusInternalServerError, "could not load regions")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+// UploadRegions loads the regions of a GeoJSON FeatureCollection. It needs the admin token.
+func (h *RegionHandler) UploadRegions(w http.ResponseWriter, r *http.Request) {
+	if err := h.regionService.AuthorizeAdmin(r.Header.Get(AdminTokenHeader)); err != nil {
+		if errors.Is(err, service.ErrRegionUploadsDisabled) {
+			middleware.WriteError(w, http.StatusForbidden, err.Error())
+		} else {
+			middleware.WriteError(w, http.StatusUnauthorized, err.Error())
+		}
+		return
+	}
+
+	// GeoJSON allows foreign members, so unknown fields are not rejected here.
+	var req dto.RegionFeatureCollection
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid request format")
+		return
+	}
+
+	resp, err := h.regionService.LoadRegions(r.Context(), req)
+	if err != nil {
+		h.logger.Error("upload regions failed", zap.Error(err))
+		switch {
+		case errors.Is(err, service.ErrInvalidRegions), errors.Is(err, service.ErrRegionTooLarge):
+			middleware.WriteError(w, http.StatusBadRequest, err.Error())
+		default:
+			middleware.WriteError(w, http.StatusInternalServerError, "could not load regions")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusCreated, resp)
+}
+
+// GetRegionLeaderboard lists a region's standings. It takes the optional query parameters limit,
+// offset and user_id, whose own standing is included.
+func (h *RegionHandler) GetRegionLeaderboard(w http.ResponseWriter, r *http.Request) {
+	page := map[string]int{"limit": 0, "offset": 0}
+	for name := range page {
+		value := r.URL.Query().Get(name)
+		if value == "" {
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			middleware.WriteError(w, http.StatusBadRequest, "Invalid number for '"+name+"'")
+			return
+		}
+		page[name] = n
+	}
+
+	var userID *uuid.UUID
+	if idStr := r.URL.Query().Get("user_id"); idStr != "" {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			middleware.WriteError(w, http.StatusBadRequest, "Invalid UUID format for 'user_id'")
+			return
+		}
+		userID = &id
+	}
+
+	resp, err := h.regionService.GetRegionLeaderboard(r.Context(), mux.Vars(r)["slug"], userID, page["limit"], page["offset"])
+	if err != nil {
+		h.logger.Error("get region leaderboard failed", zap.Error(err))
+		switch {
+		case ent.IsNotFound(err):
+			middleware.WriteError(w, http.StatusNotFound, "region not found")
+		case errors.Is(err, service.ErrInvalidRegionLeaderboardPage):
+			middleware.WriteError(w, http.StatusBadRequest, err.Error())
+		default:
+			middleware.WriteError(w, http.StatusInternalServerError, "could not load region leaderboard")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+// GetHexRegions lists the regions containing a hex.
+func (h *RegionHandler) GetHexRegions(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.regionService.GetHexRegions(r.Context(), mux.Vars(r)["h3"])
+	if err != nil {
+		h.logger.Error("get hex regions failed", zap.Error(err))
+		if errors.Is(err, service.ErrInvalidHex) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error())
+		} else {
+			middleware.WriteError(w, http.StatusInternalServerError, "could not load hex regions")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,148 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/config"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/handler"
+	"stride-wars-app/internal/service"
+	"stride-wars-app/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber/h3-go/v4"
+	"go.uber.org/zap"
+)
+
+type RegionLeaderboardAPIResponse struct {
+	Success bool                             `json:"success"`
+	Data    dto.GetRegionLeaderboardResponse `json:"data"`
+	Error   string                           `json:"error,omitempty"`
+}
+
+type HexRegionsAPIResponse struct {
+	Success bool                      `json:"success"`
+	Data    dto.GetHexRegionsResponse `json:"data"`
+	Error   string                    `json:"error,omitempty"`
+}
+
+func TestRegionHandler(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: UploadAndLeaderboard
+	// ------------------------
+	t.Run("UploadAndLeaderboard", func(t *testing.T) {
+		t.Parallel()
+
+		svc := testutil.NewTestServices(t)
+		cfg := config.Default()
+		cfg.AdminToken = "secret"
+		regionService := service.NewRegionService(svc.Repositories, svc.ActivityService.PrivacyZoneService, service.NewScoringStrategy(cfg), cfg, zap.NewExample())
+		regionHandler := handler.NewRegionHandler(regionService, zap.NewExample())
+
+		hexID := krakowH3Indexes[0]
+		require.NoError(t, svc.HexService.CreateMissingHexes(svc.Ctx, []string{hexID}))
+		user, err := svc.UserRepo.CreateUser(svc.Ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		influences, err := svc.HexInfluenceService.RecordVisitsAt(svc.Ctx, user.ID, nil, []string{hexID}, time.Now())
+		require.NoError(t, err)
+		_, err = svc.HexLeaderboardService.AddUserToLeaderboards(svc.Ctx, user.Username, nil, influences)
+		require.NoError(t, err)
+
+		center, err := h3.Cell(h3.IndexFromString(hexID)).LatLng()
+		require.NoError(t, err)
+		body := fmt.Sprintf(`{
+			"type": "FeatureCollection",
+			"features": [{
+				"type": "Feature",
+				"id": 1,
+				"properties": {"name": "Stare Miasto", "kind": "district"},
+				"geometry": {"type": "Polygon", "coordinates": [[[%[1]f, %[2]f], [%[3]f, %[2]f], [%[3]f, %[4]f], [%[1]f, %[4]f], [%[1]f, %[2]f]]]}
+			}]
+		}`, center.Lng-0.0005, center.Lat-0.0005, center.Lng+0.0005, center.Lat+0.0005)
+		upload := func(token string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest("POST", "/regions", bytes.NewBufferString(body))
+			req.Header.Set("Content-Type", "application/json")
+			if token != "" {
+				req.Header.Set(handler.AdminTokenHeader, token)
+			}
+			w := httptest.NewRecorder()
+			regionHandler.UploadRegions(w, req)
+			return w
+		}
+
+		assert.Equal(t, http.StatusUnauthorized, upload("").Code)
+		assert.Equal(t, http.StatusUnauthorized, upload("guess").Code)
+		require.Equal(t, http.StatusCreated, upload("secret").Code)
+
+		req := httptest.NewRequest("GET", "/regions/stare-miasto/leaderboard?user_id="+user.ID.String(), nil)
+		req = mux.SetURLVars(req, map[string]string{"slug": "stare-miasto"})
+		w := httptest.NewRecorder()
+		regionHandler.GetRegionLeaderboard(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var boardResp RegionLeaderboardAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &boardResp))
+		require.Len(t, boardResp.Data.Standings, 1)
+		assert.Equal(t, user.ID, boardResp.Data.Standings[0].UserID)
+		assert.Equal(t, 1, boardResp.Data.Standings[0].HexesOwned)
+		require.NotNil(t, boardResp.Data.User)
+		assert.Equal(t, 1, boardResp.Data.User.Rank)
+
+		req = httptest.NewRequest("GET", "/hex/"+hexID+"/regions", nil)
+		req = mux.SetURLVars(req, map[string]string{"h3": hexID})
+		w = httptest.NewRecorder()
+		regionHandler.GetHexRegions(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var hexResp HexRegionsAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &hexResp))
+		require.Len(t, hexResp.Data.Regions, 1)
+		assert.Equal(t, "Stare Miasto", hexResp.Data.Regions[0].Name)
+
+		// Unknown region
+		req = httptest.NewRequest("GET", "/regions/mokotow/leaderboard", nil)
+		req = mux.SetURLVars(req, map[string]string{"slug": "mokotow"})
+		w = httptest.NewRecorder()
+		regionHandler.GetRegionLeaderboard(w, req)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		// Invalid boundaries
+		req = httptest.NewRequest("POST", "/regions", bytes.NewBufferString(`{"type": "FeatureCollection", "features": []}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(handler.AdminTokenHeader, "secret")
+		w = httptest.NewRecorder()
+		regionHandler.UploadRegions(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	// ------------------------
+	// Subtest: UploadDisabledWithoutToken
+	// ------------------------
+	t.Run("UploadDisabledWithoutToken", func(t *testing.T) {
+		t.Parallel()
+
+		svc := testutil.NewTestServices(t)
+		cfg := config.Default()
+		regionService := service.NewRegionService(svc.Repositories, svc.ActivityService.PrivacyZoneService, service.NewScoringStrategy(cfg), cfg, zap.NewExample())
+		regionHandler := handler.NewRegionHandler(regionService, zap.NewExample())
+
+		req := httptest.NewRequest("POST", "/regions", bytes.NewBufferString(`{"type": "FeatureCollection", "features": []}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(handler.AdminTokenHeader, "anything")
+		w := httptest.NewRecorder()
+		regionHandler.UploadRegions(w, req)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
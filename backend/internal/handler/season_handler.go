@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"stride-wars-app/ent"
+	"stride-wars-app/internal/api/middleware"
+	"stride-wars-app/internal/service"
+
+	"github.com/gorilla/mux"
+
+	"go.uber.org/zap"
+)
+
+type SeasonHandler struct {
+	seasonService *service.SeasonService
+	logger        *zap.Logger
+}
+
+func NewSeasonHandler(seasonService *service.SeasonService, logger *zap.Logger) *SeasonHandler {
+	return &SeasonHandler{
+		seasonService: seasonService,
+		logger:        logger,
+	}
+}
+
+func (h *SeasonHandler) GetSeasons(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.seasonService.GetSeasons(r.Context())
+	if err != nil {
+		h.logger.Error("get seasons failed", zap.Error(err))
+		middleware.WriteError(w, http.StatusInternalServerError, "could not load seasons")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+// GetStandings lists an archived season's final global standings. It takes the optional query
+// parameters limit and offset.
+func (h *SeasonHandler) GetStandings(w http.ResponseWriter, r *http.Request) {
+	number, ok := seasonNumberFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	page := map[string]int{"limit": 0, "offset": 0}
+	for name := range page {
+		value := r.URL.Query().Get(name)
+		if value == "" {
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			middleware.WriteError(w, http.StatusBadRequest, "Invalid number for '"+name+"'")
+			return
+		}
+		page[name] = n
+	}
+
+	resp, err := h.seasonService.GetStandings(r.Context(), number, page["limit"], page["offset"])
+	if err != nil {
+		h.logger.Error("get season standings failed", zap.Error(err))
+		h.writeSeasonError(w, err, "could not load season standings")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *SeasonHandler) GetHexStandings(w http.ResponseWriter, r *http.Request) {
+	number, ok := seasonNumberFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.seasonService.GetHexStandings(r.Context(), number, mux.Vars(r)["h3"])
+	if err != nil {
+		h.logger.Error("get season hex standings failed", zap.Error(err))
+		h.writeSeasonError(w, err, "could not load season hex standings")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+// GetUserSeasons lists the user's final results in all archived seasons they took part in.
+func (h *SeasonHandler) GetUserSeasons(w http.ResponseWriter, r *http.Request) {
+	userID, ok := userIDFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.seasonService.GetUserSeasons(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("get user seasons failed", zap.Error(err))
+		switch {
+		case ent.IsNotFound(err):
+			middleware.WriteError(w, http.StatusNotFound, "user not found")
+		default:
+			middleware.WriteError(w, http.StatusInternalServerError, "could not load user seasons")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *SeasonHandler) writeSeasonError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case ent.IsNotFound(err):
+		middleware.WriteError(w, http.StatusNotFound, "season not found")
+	case errors.Is(err, service.ErrSeasonNotArchived):
+		middleware.WriteError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, service.ErrInvalidSeasonStandingPage), errors.Is(err, service.ErrInvalidHex):
+		middleware.WriteError(w, http.StatusBadRequest, err.Error())
+	default:
+		middleware.WriteError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// seasonNumberFromPath reads the season number from the route. It writes a 400 response and
+// returns false when it is not a number.
+func seasonNumberFromPath(w http.ResponseWriter, r *http.Request) (int, bool) {
+	number, err := strconv.Atoi(mux.Vars(r)["number"])
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid season number")
+		return 0, false
+	}
+	return number, true
+}
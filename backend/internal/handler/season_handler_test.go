@@ -0,0 +1,111 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/config"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/handler"
+	"stride-wars-app/internal/service"
+	"stride-wars-app/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type SeasonStandingsAPIResponse struct {
+	Success bool                           `json:"success"`
+	Data    dto.GetSeasonStandingsResponse `json:"data"`
+	Error   string                         `json:"error,omitempty"`
+}
+
+type UserSeasonsAPIResponse struct {
+	Success bool                       `json:"success"`
+	Data    dto.GetUserSeasonsResponse `json:"data"`
+	Error   string                     `json:"error,omitempty"`
+}
+
+func TestSeasonHandler(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: StandingsAndUserSeasons
+	// ------------------------
+	t.Run("StandingsAndUserSeasons", func(t *testing.T) {
+		t.Parallel()
+
+		svc := testutil.NewTestServices(t)
+		start := time.Now().Add(-time.Hour)
+		cfg := config.Default()
+		cfg.SeasonStart = start
+		cfg.SeasonLength = 24 * time.Hour
+		seasonService := service.NewSeasonService(svc.Repositories, svc.HexLeaderboardService, svc.ActivityService.PrivacyZoneService, cfg, zap.NewExample())
+		seasonHandler := handler.NewSeasonHandler(seasonService, zap.NewExample())
+
+		_, err := seasonService.RolloverIfDue(svc.Ctx, time.Now())
+		require.NoError(t, err)
+
+		hexID := krakowH3Indexes[0]
+		require.NoError(t, svc.HexService.CreateMissingHexes(svc.Ctx, []string{hexID}))
+		user, err := svc.UserRepo.CreateUser(svc.Ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		influences, err := svc.HexInfluenceService.RecordVisitsAt(svc.Ctx, user.ID, nil, []string{hexID}, time.Now())
+		require.NoError(t, err)
+		_, err = svc.HexLeaderboardService.AddUserToLeaderboards(svc.Ctx, user.Username, nil, influences)
+		require.NoError(t, err)
+
+		// The first season is still running.
+		req := httptest.NewRequest("GET", "/seasons/1/standings", nil)
+		req = mux.SetURLVars(req, map[string]string{"number": "1"})
+		w := httptest.NewRecorder()
+		seasonHandler.GetStandings(w, req)
+		assert.Equal(t, http.StatusConflict, w.Code)
+
+		_, err = seasonService.RolloverIfDue(svc.Ctx, start.Add(cfg.SeasonLength))
+		require.NoError(t, err)
+
+		req = httptest.NewRequest("GET", "/seasons/1/standings?limit=10", nil)
+		req = mux.SetURLVars(req, map[string]string{"number": "1"})
+		w = httptest.NewRecorder()
+		seasonHandler.GetStandings(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var standingsResp SeasonStandingsAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &standingsResp))
+		require.Len(t, standingsResp.Data.Standings, 1)
+		assert.Equal(t, user.ID, standingsResp.Data.Standings[0].UserID)
+		assert.Equal(t, 1, standingsResp.Data.Standings[0].HexesOwned)
+
+		req = httptest.NewRequest("GET", "/user/seasons?user_id="+user.ID.String(), nil)
+		w = httptest.NewRecorder()
+		seasonHandler.GetUserSeasons(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var userResp UserSeasonsAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &userResp))
+		require.Len(t, userResp.Data.Seasons, 1)
+		assert.Equal(t, 1, userResp.Data.Seasons[0].Rank)
+
+		// Unknown season
+		req = httptest.NewRequest("GET", "/seasons/9/hex/"+hexID, nil)
+		req = mux.SetURLVars(req, map[string]string{"number": "9", "h3": hexID})
+		w = httptest.NewRecorder()
+		seasonHandler.GetHexStandings(w, req)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		// Invalid season number
+		req = httptest.NewRequest("GET", "/seasons/first/standings", nil)
+		req = mux.SetURLVars(req, map[string]string{"number": "first"})
+		w = httptest.NewRecorder()
+		seasonHandler.GetStandings(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"stride-wars-app/ent"
+	"stride-wars-app/internal/api/middleware"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/service"
+	"stride-wars-app/internal/util"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"go.uber.org/zap"
+)
+
+type SegmentHandler struct {
+	segmentService *service.SegmentService
+	logger         *zap.Logger
+}
+
+func NewSegmentHandler(segmentService *service.SegmentService, logger *zap.Logger) *SegmentHandler {
+	return &SegmentHandler{
+		segmentService: segmentService,
+		logger:         logger,
+	}
+}
+
+func (h *SegmentHandler) CreateSegment(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateSegmentRequest
+	if err := util.DecodeJSONBody(r.Body, &req); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid request format")
+		return
+	}
+
+	resp, err := h.segmentService.CreateSegment(r.Context(), req)
+	if err != nil {
+		h.logger.Error("create segment failed", zap.Error(err))
+		if ent.IsNotFound(err) {
+			middleware.WriteError(w, http.StatusNotFound, "user not found")
+		} else {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusCreated, resp)
+}
+
+func (h *SegmentHandler) GetSegment(w http.ResponseWriter, r *http.Request) {
+	segmentID, ok := segmentIDFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.segmentService.GetSegment(r.Context(), segmentID)
+	if err != nil {
+		h.logger.Error("get segment failed", zap.Error(err))
+		if ent.IsNotFound(err) {
+			middleware.WriteError(w, http.StatusNotFound, "segment not found")
+		} else {
+			middleware.WriteError(w, http.StatusInternalServerError, "could not load segment")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *SegmentHandler) DeleteSegment(w http.ResponseWriter, r *http.Request) {
+	segmentID, ok := segmentIDFromPath(w, r)
+	if !ok {
+		return
+	}
+	userID, ok := userIDFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.segmentService.DeleteSegment(r.Context(), segmentID, userID); err != nil {
+		h.logger.Error("delete segment failed", zap.Error(err))
+		switch {
+		case ent.IsNotFound(err):
+			middleware.WriteError(w, http.StatusNotFound, "segment not found")
+		case errors.Is(err, service.ErrSegmentNotOwned):
+			middleware.WriteError(w, http.StatusForbidden, err.Error())
+		default:
+			middleware.WriteError(w, http.StatusInternalServerError, "could not delete segment")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, map[string]uuid.UUID{"segment_id": segmentID})
+}
+
+func (h *SegmentHandler) GetLeaderboard(w http.ResponseWriter, r *http.Request) {
+	segmentID, ok := segmentIDFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	limit := service.DefaultSegmentLeaderboardSize
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil {
+			middleware.WriteError(w, http.StatusBadRequest, "Invalid number for 'limit'")
+			return
+		}
+	}
+
+	resp, err := h.segmentService.GetLeaderboard(r.Context(), segmentID, limit)
+	if err != nil {
+		h.logger.Error("get segment leaderboard failed", zap.Error(err))
+		switch {
+		case ent.IsNotFound(err):
+			middleware.WriteError(w, http.StatusNotFound, "segment not found")
+		case errors.Is(err, service.ErrInvalidLeaderboardSize):
+			middleware.WriteError(w, http.StatusBadRequest, err.Error())
+		default:
+			middleware.WriteError(w, http.StatusInternalServerError, "could not load segment leaderboard")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+func segmentIDFromPath(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	segmentID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid UUID format for segment 'id'")
+		return uuid.Nil, false
+	}
+	return segmentID, true
+}
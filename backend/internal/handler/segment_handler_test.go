@@ -0,0 +1,109 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/handler"
+	"stride-wars-app/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber/h3-go/v4"
+	"go.uber.org/zap"
+)
+
+type SegmentAPIResponse struct {
+	Success bool                `json:"success"`
+	Data    dto.SegmentResponse `json:"data"`
+	Error   string              `json:"error,omitempty"`
+}
+
+type SegmentLeaderboardAPIResponse struct {
+	Success bool                           `json:"success"`
+	Data    dto.SegmentLeaderboardResponse `json:"data"`
+	Error   string                         `json:"error,omitempty"`
+}
+
+func TestSegmentHandler(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: SegmentLifecycle
+	// ------------------------
+	t.Run("SegmentLifecycle", func(t *testing.T) {
+		t.Parallel()
+
+		svc := testutil.NewTestServices(t)
+		segmentHandler := handler.NewSegmentHandler(svc.ActivityService.SegmentService, zap.NewExample())
+
+		createdUser, err := svc.UserRepo.CreateUser(svc.Ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		start := h3.Cell(h3.IndexFromString(validH3Indexes[0]))
+		disk, err := start.GridDisk(1)
+		require.NoError(t, err)
+		var path []string
+		for _, cell := range disk {
+			path = append(path, cell.String())
+		}
+		path = path[:2] // the origin and one of its neighbours
+
+		reqBody, err := json.Marshal(dto.CreateSegmentRequest{UserID: createdUser.ID, Name: "Park Loop", H3Indexes: path})
+		require.NoError(t, err)
+		req := httptest.NewRequest("POST", "/segments", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		segmentHandler.CreateSegment(w, req)
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		var segmentResp SegmentAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &segmentResp))
+		assert.Equal(t, path, segmentResp.Data.H3Indexes)
+		id := segmentResp.Data.ID.String()
+
+		req = httptest.NewRequest("GET", "/segments/"+id+"/leaderboard?limit=5", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": id})
+		w = httptest.NewRecorder()
+		segmentHandler.GetLeaderboard(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var leaderboardResp SegmentLeaderboardAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &leaderboardResp))
+		assert.Equal(t, "Park Loop", leaderboardResp.Data.Name)
+		assert.Empty(t, leaderboardResp.Data.Entries)
+
+		req = httptest.NewRequest("GET", "/segments/"+id+"/leaderboard?limit=500", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": id})
+		w = httptest.NewRecorder()
+		segmentHandler.GetLeaderboard(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		// Only the creator can delete the segment
+		req = httptest.NewRequest("DELETE", "/segments/"+id+"?user_id="+uuid.New().String(), nil)
+		req = mux.SetURLVars(req, map[string]string{"id": id})
+		w = httptest.NewRecorder()
+		segmentHandler.DeleteSegment(w, req)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+
+		req = httptest.NewRequest("DELETE", "/segments/"+id+"?user_id="+createdUser.ID.String(), nil)
+		req = mux.SetURLVars(req, map[string]string{"id": id})
+		w = httptest.NewRecorder()
+		segmentHandler.DeleteSegment(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		req = httptest.NewRequest("GET", "/segments/"+id, nil)
+		req = mux.SetURLVars(req, map[string]string{"id": id})
+		w = httptest.NewRecorder()
+		segmentHandler.GetSegment(w, req)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
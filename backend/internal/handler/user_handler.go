@@ -2,6 +2,7 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"stride-wars-app/internal/api/middleware"
 	"stride-wars-app/internal/service"
@@ -109,4 +110,41 @@ func (h *UserHandler) UpdateUsername(w http.ResponseWriter, r *http.Request) {
 
 }
 
+func (h *UserHandler) UpdateTimeZone(w http.ResponseWriter, r *http.Request) {
+	data, ok := middleware.GetJSONBody(r)
+	if !ok {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	// Convert the generic data to JSON bytes
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid request format")
+		return
+	}
+
+	var req service.UpdateTimeZoneRequest
+	if err := json.Unmarshal(jsonData, &req); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid request format")
+		return
+	}
+
+	resp, err := h.userService.UpdateTimeZone(r.Context(), &req)
+	if err == nil {
+		middleware.WriteJSON(w, http.StatusOK, resp)
+		return
+	}
+
+	h.logger.Error("update time zone failed", zap.Error(err))
+	switch {
+	case errors.Is(err, service.ErrInvalidTimeZone):
+		middleware.WriteError(w, http.StatusBadRequest, err.Error())
+	case ent.IsNotFound(err):
+		middleware.WriteError(w, http.StatusNotFound, "user not found")
+	default:
+		middleware.WriteError(w, http.StatusInternalServerError, "could not update time zone")
+	}
+}
+
 // merge get into one func
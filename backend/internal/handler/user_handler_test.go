@@ -296,4 +296,59 @@ func TestUserHandler(t *testing.T) {
 
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 	})
+
+	// ------------------------
+	// Subtest: UpdateTimeZone/HappyPath
+	// ------------------------
+	t.Run("UpdateTimeZone/HappyPath", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, client, userHandler := setupTestUserHandler(t)
+
+		repo := repository.NewUserRepository(client)
+		createdUser, err := repo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		assert.Equal(t, "UTC", createdUser.TimeZone)
+
+		reqBody, err := json.Marshal(service.UpdateTimeZoneRequest{UserID: createdUser.ID, TimeZone: "Europe/Warsaw"})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest("PUT", "/user/timezone", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		handlerWithMiddleware := middleware.ParseJSON(http.HandlerFunc(userHandler.UpdateTimeZone))
+		handlerWithMiddleware.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		updated, err := repo.FindByID(ctx, createdUser.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Europe/Warsaw", updated.TimeZone)
+	})
+
+	// ------------------------
+	// Subtest: UpdateTimeZone/InvalidZone
+	// ------------------------
+	t.Run("UpdateTimeZone/InvalidZone", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, client, userHandler := setupTestUserHandler(t)
+
+		repo := repository.NewUserRepository(client)
+		createdUser, err := repo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		reqBody, err := json.Marshal(service.UpdateTimeZoneRequest{UserID: createdUser.ID, TimeZone: "Mars/Olympus_Mons"})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest("PUT", "/user/timezone", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		handlerWithMiddleware := middleware.ParseJSON(http.HandlerFunc(userHandler.UpdateTimeZone))
+		handlerWithMiddleware.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
 }
@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"stride-wars-app/ent"
+	"stride-wars-app/internal/api/middleware"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/service"
+	"stride-wars-app/internal/util"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"go.uber.org/zap"
+)
+
+type WebhookHandler struct {
+	webhookService *service.WebhookService
+	logger         *zap.Logger
+}
+
+func NewWebhookHandler(webhookService *service.WebhookService, logger *zap.Logger) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+		logger:         logger,
+	}
+}
+
+// CreateWebhook subscribes a URL to game events. The response carries the signing secret, which
+// is not shown again.
+func (h *WebhookHandler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateWebhookRequest
+	if err := util.DecodeJSONBody(r.Body, &req); err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid request format")
+		return
+	}
+
+	resp, err := h.webhookService.CreateWebhook(r.Context(), req)
+	if err != nil {
+		h.logger.Error("create webhook failed", zap.Error(err))
+		switch {
+		case ent.IsNotFound(err):
+			middleware.WriteError(w, http.StatusNotFound, "user not found")
+		case errors.Is(err, service.ErrInvalidWebhookURL),
+			errors.Is(err, service.ErrForbiddenWebhookTarget),
+			errors.Is(err, service.ErrInvalidWebhookEventTypes),
+			errors.Is(err, service.ErrInvalidWebhookSecret):
+			middleware.WriteError(w, http.StatusBadRequest, err.Error())
+		default:
+			middleware.WriteError(w, http.StatusInternalServerError, "could not create webhook")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusCreated, resp)
+}
+
+func (h *WebhookHandler) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+	userID, ok := userIDFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.webhookService.GetWebhooks(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("get webhooks failed", zap.Error(err))
+		if ent.IsNotFound(err) {
+			middleware.WriteError(w, http.StatusNotFound, "user not found")
+		} else {
+			middleware.WriteError(w, http.StatusInternalServerError, "could not load webhooks")
+		}
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *WebhookHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	webhookID, userID, ok := webhookFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.webhookService.DeleteWebhook(r.Context(), userID, webhookID); err != nil {
+		h.logger.Error("delete webhook failed", zap.Error(err))
+		h.writeWebhookError(w, err, "could not delete webhook")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, map[string]uuid.UUID{"webhook_id": webhookID})
+}
+
+// GetDeliveries lists a webhook's delivery log. It takes the optional query parameters limit and offset.
+func (h *WebhookHandler) GetDeliveries(w http.ResponseWriter, r *http.Request) {
+	webhookID, userID, ok := webhookFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	page := map[string]int{"limit": 0, "offset": 0}
+	for name := range page {
+		value := r.URL.Query().Get(name)
+		if value == "" {
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			middleware.WriteError(w, http.StatusBadRequest, "Invalid number for '"+name+"'")
+			return
+		}
+		page[name] = n
+	}
+
+	resp, err := h.webhookService.GetDeliveries(r.Context(), userID, webhookID, page["limit"], page["offset"])
+	if err != nil {
+		h.logger.Error("get webhook deliveries failed", zap.Error(err))
+		if errors.Is(err, service.ErrInvalidWebhookDeliveriesPage) {
+			middleware.WriteError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		h.writeWebhookError(w, err, "could not load webhook deliveries")
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *WebhookHandler) writeWebhookError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case ent.IsNotFound(err):
+		middleware.WriteError(w, http.StatusNotFound, "webhook not found")
+	case errors.Is(err, service.ErrWebhookNotOwned):
+		middleware.WriteError(w, http.StatusForbidden, err.Error())
+	default:
+		middleware.WriteError(w, http.StatusInternalServerError, message)
+	}
+}
+
+func webhookFromRequest(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	webhookID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		middleware.WriteError(w, http.StatusBadRequest, "Invalid UUID format for webhook 'id'")
+		return uuid.Nil, uuid.Nil, false
+	}
+	userID, ok := userIDFromQuery(w, r)
+	return webhookID, userID, ok
+}
@@ -0,0 +1,112 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/handler"
+	"stride-wars-app/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type WebhookAPIResponse struct {
+	Success bool                `json:"success"`
+	Data    dto.WebhookResponse `json:"data"`
+	Error   string              `json:"error,omitempty"`
+}
+
+type WebhookDeliveriesAPIResponse struct {
+	Success bool                             `json:"success"`
+	Data    dto.GetWebhookDeliveriesResponse `json:"data"`
+	Error   string                           `json:"error,omitempty"`
+}
+
+func TestWebhookHandler(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: SubscriptionsAndDeliveries
+	// ------------------------
+	t.Run("SubscriptionsAndDeliveries", func(t *testing.T) {
+		t.Parallel()
+
+		svc := testutil.NewTestServices(t)
+		webhookHandler := handler.NewWebhookHandler(svc.WebhookService, zap.NewExample())
+
+		user, err := svc.UserRepo.CreateUser(svc.Ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		userQuery := "?user_id=" + user.ID.String()
+
+		create := func(req dto.CreateWebhookRequest) *httptest.ResponseRecorder {
+			reqBody, err := json.Marshal(req)
+			require.NoError(t, err)
+			r := httptest.NewRequest("POST", "/webhooks", bytes.NewBuffer(reqBody))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			webhookHandler.CreateWebhook(w, r)
+			return w
+		}
+
+		w := create(dto.CreateWebhookRequest{UserID: user.ID, URL: "https://example.com/hooks", EventTypes: []string{model.WebhookActivityCreated}})
+		require.Equal(t, http.StatusCreated, w.Code)
+		var createResp WebhookAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &createResp))
+		assert.NotEmpty(t, createResp.Data.Secret)
+
+		w = create(dto.CreateWebhookRequest{UserID: user.ID, URL: "not a url", EventTypes: []string{model.WebhookActivityCreated}})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		w = create(dto.CreateWebhookRequest{UserID: user.ID, URL: "https://example.com/hooks", EventTypes: []string{"spam"}})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		req := httptest.NewRequest("GET", "/webhooks"+userQuery, nil)
+		w = httptest.NewRecorder()
+		webhookHandler.GetWebhooks(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.NotContains(t, w.Body.String(), createResp.Data.Secret)
+
+		_, err = svc.ActivityService.CreateActivity(svc.Ctx, dto.CreateActivityRequest{
+			UserID:    user.ID,
+			Duration:  600,
+			Distance:  2000,
+			H3Indexes: validH3Indexes[:1],
+		})
+		require.NoError(t, err)
+
+		id := createResp.Data.ID.String()
+		req = httptest.NewRequest("GET", "/webhooks/"+id+"/deliveries"+userQuery, nil)
+		req = mux.SetURLVars(req, map[string]string{"id": id})
+		w = httptest.NewRecorder()
+		webhookHandler.GetDeliveries(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+		var deliveriesResp WebhookDeliveriesAPIResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &deliveriesResp))
+		require.Len(t, deliveriesResp.Data.Deliveries, 1)
+		assert.Equal(t, model.WebhookActivityCreated, deliveriesResp.Data.Deliveries[0].EventType)
+		assert.Equal(t, model.WebhookDeliveryPending, deliveriesResp.Data.Deliveries[0].Status)
+
+		// Another user cannot delete it
+		req = httptest.NewRequest("DELETE", "/webhooks/"+id+"?user_id="+uuid.New().String(), nil)
+		req = mux.SetURLVars(req, map[string]string{"id": id})
+		w = httptest.NewRecorder()
+		webhookHandler.DeleteWebhook(w, req)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+
+		for _, want := range []int{http.StatusOK, http.StatusNotFound} {
+			req = httptest.NewRequest("DELETE", "/webhooks/"+id+userQuery, nil)
+			req = mux.SetURLVars(req, map[string]string{"id": id})
+			w = httptest.NewRecorder()
+			webhookHandler.DeleteWebhook(w, req)
+			assert.Equal(t, want, w.Code)
+		}
+	})
+}
@@ -1,3 +1,7 @@
 package hexconsts
 
 const DefaultHexResolution = 9
+
+// RollupResolutions are the coarser resolutions ownership is rolled up to for zoomed-out maps,
+// finest first. Each one is aggregated from the one before it.
+var RollupResolutions = []int{7, 5}
@@ -5,21 +5,29 @@ import (
 	"sort"
 
 	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
 	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/hex/hexconsts"
 )
 
-func MapHexLeaderboardsToResponse(hexLeaderboards []*ent.HexLeaderboard) *dto.GetAllHexLeaderboardsInsideBBoxResponse {
+// MapHexLeaderboardsToResponse maps leaderboards to the response, ranking each one's users by
+// the effective score the given function computes for them.
+func MapHexLeaderboardsToResponse(hexLeaderboards []*ent.HexLeaderboard, effectiveScore func(model.TopUser) float64) *dto.GetAllHexLeaderboardsInsideBBoxResponse {
 	leaderboards := make([]dto.HexLeaderboardResponse, 0, len(hexLeaderboards))
 
 	for _, hexLeaderboard := range hexLeaderboards {
 		topUsers := make([]dto.TopUserResponse, 0, len(hexLeaderboard.TopUsers))
 		for _, user := range hexLeaderboard.TopUsers {
 			topUsers = append(topUsers, dto.TopUserResponse{
-				UserID:   user.UserID,
-				UserName: user.UserName,
-				Score:    user.Score,
+				UserID:         user.UserID,
+				UserName:       user.UserName,
+				Score:          user.Score,
+				EffectiveScore: effectiveScore(user),
 			})
 		}
+		sort.SliceStable(topUsers, func(i, j int) bool {
+			return topUsers[i].EffectiveScore > topUsers[j].EffectiveScore
+		})
 
 		leaderboards = append(leaderboards, dto.HexLeaderboardResponse{
 			ID:       hexLeaderboard.ID,
@@ -33,6 +41,7 @@ func MapHexLeaderboardsToResponse(hexLeaderboards []*ent.HexLeaderboard) *dto.Ge
 	})
 
 	return &dto.GetAllHexLeaderboardsInsideBBoxResponse{
+		Resolution:   hexconsts.DefaultHexResolution,
 		Leaderboards: leaderboards,
 	}
 }
@@ -5,10 +5,26 @@ import (
 	"stride-wars-app/ent"
 	entActivity "stride-wars-app/ent/activity"
 	"stride-wars-app/ent/model"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 )
 
+// TimeBucket is a half-open time range [Start, End) that activities are aggregated over.
+type TimeBucket struct {
+	Start time.Time
+	End   time.Time
+}
+
+// PeriodTotals are the activity totals of one time bucket.
+type PeriodTotals struct {
+	Bucket     int     `json:"bucket"`
+	Activities int64   `json:"activities"`
+	Distance   float64 `json:"distance"`
+	Duration   float64 `json:"duration"`
+}
+
 type ActivityRepository struct {
 	client *ent.Client
 }
@@ -17,18 +33,103 @@ func NewActivityRepository(client *ent.Client) ActivityRepository {
 	return ActivityRepository{client: client}
 }
 
+func (r ActivityRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
 func (r ActivityRepository) FindByID(ctx context.Context, uuid uuid.UUID) (*ent.Activity, error) {
-	return r.client.Activity.Query().Where(entActivity.IDEQ(uuid)).First(ctx)
+	return r.db(ctx).Activity.Query().Where(entActivity.IDEQ(uuid)).First(ctx)
 }
 
 func (r ActivityRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*ent.Activity, error) {
-	return r.client.Activity.Query().Where(entActivity.IDIn(ids...)).All(ctx)
+	return r.db(ctx).Activity.Query().Where(entActivity.IDIn(ids...)).All(ctx)
 }
 
 func (r ActivityRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*ent.Activity, error) {
-	return r.client.Activity.Query().Where(entActivity.UserIDIn(userID)).All(ctx)
+	return r.db(ctx).Activity.Query().Where(entActivity.UserIDIn(userID)).All(ctx)
+}
+
+// FindWithoutHexes returns up to limit activities with an ID greater than afterID whose hexes have
+// not been indexed yet, ordered by ID. Activities without any hexes never get indexed, so callers
+// page past them with the last returned ID instead of querying from the start again.
+func (r ActivityRepository) FindWithoutHexes(ctx context.Context, afterID uuid.UUID, limit int) ([]*ent.Activity, error) {
+	return r.db(ctx).Activity.Query().
+		Where(entActivity.IDGT(afterID), entActivity.Not(entActivity.HasHexes())).
+		Order(ent.Asc(entActivity.FieldID)).
+		Limit(limit).
+		All(ctx)
 }
 
 func (r ActivityRepository) CreateActivity(ctx context.Context, activity *model.Activity) (*ent.Activity, error) {
-	return r.client.Activity.Create().SetID(uuid.New()).SetUserID(activity.UserID).SetDurationSeconds(activity.Duration).SetDistanceMeters(activity.Distance).SetH3Indexes(activity.H3Indexes).Save(ctx)
+	create := r.db(ctx).Activity.Create().SetID(uuid.New()).SetUserID(activity.UserID).SetDurationSeconds(activity.Duration).SetDistanceMeters(activity.Distance).SetH3Indexes(activity.H3Indexes)
+	if activity.ActivityType != "" {
+		create.SetActivityType(entActivity.ActivityType(activity.ActivityType))
+	}
+	create.SetNewHexes(activity.NewHexes)
+	if len(activity.Track) > 0 {
+		create.SetTrack(activity.Track)
+	}
+	if !activity.StartedAt.IsZero() {
+		create.SetStartedAt(activity.StartedAt.UTC())
+	}
+	if !activity.EndedAt.IsZero() {
+		create.SetEndedAt(activity.EndedAt.UTC())
+	}
+	return create.Save(ctx)
+}
+
+func (r ActivityRepository) DeleteActivity(ctx context.Context, id uuid.UUID) error {
+	return r.db(ctx).Activity.DeleteOneID(id).Exec(ctx)
+}
+
+// AggregateByPeriod sums the user's activities per bucket, by the time each activity started.
+// Buckets must be sorted and contiguous. Buckets without activities are omitted.
+func (r ActivityRepository) AggregateByPeriod(ctx context.Context, userID uuid.UUID, buckets []TimeBucket) ([]PeriodTotals, error) {
+	var totals []PeriodTotals
+	if len(buckets) == 0 {
+		return totals, nil
+	}
+
+	err := r.db(ctx).Activity.Query().
+		Where(entActivity.UserIDEQ(userID)).
+		Modify(func(s *sql.Selector) {
+			// Activities uploaded before started_at existed fall back to their upload time.
+			startedAt := sql.ExprFunc(func(b *sql.Builder) {
+				b.WriteString("COALESCE(").
+					WriteString(s.C(entActivity.FieldStartedAt)).
+					Comma().
+					WriteString(s.C(entActivity.FieldCreatedAt)).
+					WriteString(")")
+			})
+			s.Where(inBuckets(startedAt, buckets))
+			s.Select().
+				AppendSelectExprAs(bucketCase(startedAt, buckets), "bucket").
+				AppendSelectAs(sql.Count("*"), "activities").
+				AppendSelectAs(sql.Sum(s.C(entActivity.FieldDistanceMeters)), "distance").
+				AppendSelectAs(sql.Sum(s.C(entActivity.FieldDurationSeconds)), "duration").
+				GroupBy("bucket")
+		}).
+		Scan(ctx, &totals)
+	return totals, err
+}
+
+// bucketCase maps a time expression to the index of the bucket it falls into.
+func bucketCase(expr sql.Querier, buckets []TimeBucket) sql.Querier {
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.WriteString("CASE")
+		for i, bucket := range buckets {
+			b.WriteString(" WHEN ").Join(expr).WriteOp(sql.OpGTE).Arg(bucket.Start.UTC()).
+				WriteString(" AND ").Join(expr).WriteOp(sql.OpLT).Arg(bucket.End.UTC()).
+				WriteString(" THEN ").Arg(i)
+		}
+		b.WriteString(" END")
+	})
+}
+
+// inBuckets limits a time expression to the range covered by contiguous buckets.
+func inBuckets(expr sql.Querier, buckets []TimeBucket) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		b.Join(expr).WriteOp(sql.OpGTE).Arg(buckets[0].Start.UTC()).
+			WriteString(" AND ").Join(expr).WriteOp(sql.OpLT).Arg(buckets[len(buckets)-1].End.UTC())
+	})
 }
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	entActivityHex "stride-wars-app/ent/activityhex"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// PeriodHexCount is the number of hexes counted in one time bucket.
+type PeriodHexCount struct {
+	Bucket int   `json:"bucket"`
+	Hexes  int64 `json:"hexes"`
+}
+
+type ActivityHexRepository struct {
+	client *ent.Client
+}
+
+func NewActivityHexRepository(client *ent.Client) ActivityHexRepository {
+	return ActivityHexRepository{client: client}
+}
+
+func (r ActivityHexRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+// CreateActivityHexes records the visits of an activity, h3Indexes must be distinct.
+func (r ActivityHexRepository) CreateActivityHexes(ctx context.Context, activityID uuid.UUID, userID uuid.UUID, h3Indexes []string, visitedAt time.Time) error {
+	builders := make([]*ent.ActivityHexCreate, len(h3Indexes))
+	for i, h3Index := range h3Indexes {
+		builders[i] = r.db(ctx).ActivityHex.Create().
+			SetActivityID(activityID).
+			SetUserID(userID).
+			SetH3Index(h3Index).
+			SetVisitedAt(visitedAt.UTC())
+	}
+	return r.db(ctx).ActivityHex.CreateBulk(builders...).Exec(ctx)
+}
+
+// CountVisitedBefore counts how many of the hexes the user had already visited before the given time.
+func (r ActivityHexRepository) CountVisitedBefore(ctx context.Context, userID uuid.UUID, h3Indexes []string, before time.Time) (int, error) {
+	var counts []struct {
+		Hexes int `json:"hexes"`
+	}
+	err := r.db(ctx).ActivityHex.Query().
+		Where(
+			entActivityHex.UserIDEQ(userID),
+			entActivityHex.H3IndexIn(h3Indexes...),
+			entActivityHex.VisitedAtLT(before.UTC()),
+		).
+		Modify(func(s *sql.Selector) {
+			s.Select().AppendSelectAs(sql.Count(sql.Distinct(s.C(entActivityHex.FieldH3Index))), "hexes")
+		}).
+		Scan(ctx, &counts)
+	if err != nil || len(counts) == 0 {
+		return 0, err
+	}
+	return counts[0].Hexes, nil
+}
+
+// CountUniqueByPeriod counts the distinct hexes the user visited in each bucket.
+func (r ActivityHexRepository) CountUniqueByPeriod(ctx context.Context, userID uuid.UUID, buckets []TimeBucket) ([]PeriodHexCount, error) {
+	return r.countByPeriod(ctx, userID, buckets, false)
+}
+
+// CountNewByPeriod counts the hexes the user visited for the first time in each bucket.
+func (r ActivityHexRepository) CountNewByPeriod(ctx context.Context, userID uuid.UUID, buckets []TimeBucket) ([]PeriodHexCount, error) {
+	return r.countByPeriod(ctx, userID, buckets, true)
+}
+
+func (r ActivityHexRepository) countByPeriod(ctx context.Context, userID uuid.UUID, buckets []TimeBucket, firstVisitsOnly bool) ([]PeriodHexCount, error) {
+	var counts []PeriodHexCount
+	if len(buckets) == 0 {
+		return counts, nil
+	}
+
+	err := r.db(ctx).ActivityHex.Query().
+		Where(entActivityHex.UserIDEQ(userID)).
+		Modify(func(s *sql.Selector) {
+			visitedAt := sql.Expr(s.C(entActivityHex.FieldVisitedAt))
+			s.Where(inBuckets(visitedAt, buckets))
+			if firstVisitsOnly {
+				earlier := sql.Table(entActivityHex.Table).As("earlier")
+				s.Where(sql.NotExists(
+					sql.Select(earlier.C(entActivityHex.FieldID)).
+						From(earlier).
+						Where(sql.And(
+							sql.ColumnsEQ(earlier.C(entActivityHex.FieldUserID), s.C(entActivityHex.FieldUserID)),
+							sql.ColumnsEQ(earlier.C(entActivityHex.FieldH3Index), s.C(entActivityHex.FieldH3Index)),
+							sql.ColumnsLT(earlier.C(entActivityHex.FieldVisitedAt), s.C(entActivityHex.FieldVisitedAt)),
+						)),
+				))
+			}
+			s.Select().
+				AppendSelectExprAs(bucketCase(visitedAt, buckets), "bucket").
+				AppendSelectAs(sql.Count(sql.Distinct(s.C(entActivityHex.FieldH3Index))), "hexes").
+				GroupBy("bucket")
+		}).
+		Scan(ctx, &counts)
+	return counts, err
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	entActivityJob "stride-wars-app/ent/activityjob"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+type ActivityJobRepository struct {
+	client *ent.Client
+}
+
+func NewActivityJobRepository(client *ent.Client) ActivityJobRepository {
+	return ActivityJobRepository{client: client}
+}
+
+func (r ActivityJobRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+func (r ActivityJobRepository) FindByActivityID(ctx context.Context, activityID uuid.UUID) (*ent.ActivityJob, error) {
+	return r.db(ctx).ActivityJob.Query().Where(entActivityJob.ActivityIDEQ(activityID)).Only(ctx)
+}
+
+// FindUnfinishedActivityIDs returns the user's activities whose influence has not been applied,
+// either because their job has yet to run or because it is dead.
+func (r ActivityJobRepository) FindUnfinishedActivityIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	var activityIDs []uuid.UUID
+	err := r.db(ctx).ActivityJob.Query().
+		Where(
+			entActivityJob.UserIDEQ(userID),
+			entActivityJob.StatusNEQ(entActivityJob.Status(model.ActivityJobDone)),
+		).
+		Select(entActivityJob.FieldActivityID).
+		Scan(ctx, &activityIDs)
+	return activityIDs, err
+}
+
+func (r ActivityJobRepository) CreateActivityJob(ctx context.Context, job *model.ActivityJob) (*ent.ActivityJob, error) {
+	return r.db(ctx).ActivityJob.Create().
+		SetID(uuid.New()).
+		SetActivityID(job.ActivityID).
+		SetUserID(job.UserID).
+		SetAlreadyScored(job.AlreadyScored).
+		SetRunAt(job.RunAt.UTC()).
+		SetCreatedAt(job.CreatedAt.UTC()).
+		SetUpdatedAt(job.CreatedAt.UTC()).
+		Save(ctx)
+}
+
+// FindNextDue returns the job that has waited longest among the pending jobs that are due and the
+// claimed jobs whose lock has run out. Only a user's oldest unfinished job is returned, so each
+// user's activities are applied one at a time in the order they were stored, while the jobs of
+// different users run in parallel.
+func (r ActivityJobRepository) FindNextDue(ctx context.Context, now time.Time) (*ent.ActivityJob, error) {
+	return r.db(ctx).ActivityJob.Query().
+		Where(
+			entActivityJob.Or(
+				entActivityJob.And(
+					entActivityJob.StatusEQ(entActivityJob.Status(model.ActivityJobPending)),
+					entActivityJob.RunAtLTE(now),
+				),
+				entActivityJob.And(
+					entActivityJob.StatusEQ(entActivityJob.Status(model.ActivityJobProcessing)),
+					entActivityJob.LockedUntilLT(now),
+				),
+			),
+			predicate.ActivityJob(oldestUnfinishedOfUser),
+			predicate.ActivityJob(skipLocked),
+		).
+		Order(ent.Asc(entActivityJob.FieldRunAt)).
+		First(ctx)
+}
+
+// ClaimActivityJob marks a job found by FindNextDue as processing until lockedUntil. It returns 0
+// when another worker claimed the job first.
+func (r ActivityJobRepository) ClaimActivityJob(ctx context.Context, job *ent.ActivityJob, now, lockedUntil time.Time) (int, error) {
+	return r.db(ctx).ActivityJob.Update().
+		Where(
+			entActivityJob.IDEQ(job.ID),
+			entActivityJob.StatusEQ(job.Status),
+			entActivityJob.AttemptsEQ(job.Attempts),
+		).
+		SetStatus(entActivityJob.Status(model.ActivityJobProcessing)).
+		AddAttempts(1).
+		SetLockedUntil(lockedUntil.UTC()).
+		SetUpdatedAt(now.UTC()).
+		Save(ctx)
+}
+
+// FinishActivityJob moves a claimed job to the given status. The attempt the job was claimed with
+// must still be the current one, so a worker whose lock ran out cannot overwrite the outcome of
+// the worker that took over. It returns 0 when that is the case or the job no longer exists.
+func (r ActivityJobRepository) FinishActivityJob(ctx context.Context, job *ent.ActivityJob, status string, runAt time.Time, lastError *string, now time.Time) (int, error) {
+	return r.db(ctx).ActivityJob.Update().
+		Where(
+			entActivityJob.IDEQ(job.ID),
+			entActivityJob.StatusEQ(entActivityJob.Status(model.ActivityJobProcessing)),
+			entActivityJob.AttemptsEQ(job.Attempts),
+		).
+		SetStatus(entActivityJob.Status(status)).
+		SetRunAt(runAt.UTC()).
+		ClearLockedUntil().
+		SetNillableLastError(lastError).
+		SetUpdatedAt(now.UTC()).
+		Save(ctx)
+}
+
+// DeleteFinishedBefore removes done jobs last updated before the given time.
+func (r ActivityJobRepository) DeleteFinishedBefore(ctx context.Context, before time.Time) (int, error) {
+	return r.db(ctx).ActivityJob.Delete().Where(
+		entActivityJob.StatusEQ(entActivityJob.Status(model.ActivityJobDone)),
+		entActivityJob.UpdatedAtLT(before),
+	).Exec(ctx)
+}
+
+// oldestUnfinishedOfUser selects the jobs that no earlier pending or processing job of the same
+// user is queued before. Dead jobs are given up on and do not hold the user's later jobs back.
+func oldestUnfinishedOfUser(s *sql.Selector) {
+	earlier := sql.Table(entActivityJob.Table).As("earlier")
+	s.Where(sql.NotExists(
+		sql.Select(earlier.C(entActivityJob.FieldID)).From(earlier).Where(sql.And(
+			sql.ColumnsEQ(earlier.C(entActivityJob.FieldUserID), s.C(entActivityJob.FieldUserID)),
+			sql.In(earlier.C(entActivityJob.FieldStatus), model.ActivityJobPending, model.ActivityJobProcessing),
+			sql.Or(
+				sql.ColumnsLT(earlier.C(entActivityJob.FieldCreatedAt), s.C(entActivityJob.FieldCreatedAt)),
+				sql.And(
+					sql.ColumnsEQ(earlier.C(entActivityJob.FieldCreatedAt), s.C(entActivityJob.FieldCreatedAt)),
+					sql.ColumnsLT(earlier.C(entActivityJob.FieldID), s.C(entActivityJob.FieldID)),
+				),
+			),
+		)),
+	))
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	entActivitySession "stride-wars-app/ent/activitysession"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/ent/predicate"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ActivitySessionRepository struct {
+	client *ent.Client
+}
+
+func NewActivitySessionRepository(client *ent.Client) ActivitySessionRepository {
+	return ActivitySessionRepository{client: client}
+}
+
+func (r ActivitySessionRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+func (r ActivitySessionRepository) FindByID(ctx context.Context, id uuid.UUID) (*ent.ActivitySession, error) {
+	return r.db(ctx).ActivitySession.Query().Where(entActivitySession.IDEQ(id)).First(ctx)
+}
+
+// FindByIDForUpdate returns a session and locks it until the end of the transaction, so updates
+// to it are applied one at a time.
+func (r ActivitySessionRepository) FindByIDForUpdate(ctx context.Context, id uuid.UUID) (*ent.ActivitySession, error) {
+	return r.db(ctx).ActivitySession.Query().
+		Where(entActivitySession.IDEQ(id), predicate.ActivitySession(forUpdate)).
+		Only(ctx)
+}
+
+// FindStale returns open sessions that have not received any update since before.
+func (r ActivitySessionRepository) FindStale(ctx context.Context, before time.Time) ([]*ent.ActivitySession, error) {
+	return r.db(ctx).ActivitySession.Query().Where(
+		entActivitySession.StatusIn(entActivitySession.StatusActive, entActivitySession.StatusPaused),
+		entActivitySession.LastSeenAtLT(before),
+	).All(ctx)
+}
+
+func (r ActivitySessionRepository) CreateActivitySession(ctx context.Context, session *model.ActivitySession) (*ent.ActivitySession, error) {
+	return r.db(ctx).ActivitySession.Create().
+		SetID(uuid.New()).
+		SetUserID(session.UserID).
+		SetActivityType(entActivitySession.ActivityType(session.ActivityType)).
+		SetStartedAt(session.StartedAt).
+		SetNillableResumedAt(session.ResumedAt).
+		SetLastSeenAt(session.LastSeenAt).
+		Save(ctx)
+}
+
+// UpdateActivitySession persists the mutable state of a session.
+func (r ActivitySessionRepository) UpdateActivitySession(ctx context.Context, session *model.ActivitySession) (*ent.ActivitySession, error) {
+	update := r.db(ctx).ActivitySession.UpdateOneID(session.ID).
+		SetStatus(entActivitySession.Status(session.Status)).
+		SetH3Indexes(session.H3Indexes).
+		SetTrack(session.Track).
+		SetDistanceMeters(session.DistanceMeters).
+		SetActiveSeconds(session.ActiveSeconds).
+		SetScoredCells(session.ScoredCells).
+		SetLastSeenAt(session.LastSeenAt).
+		SetNillableActivityID(session.ActivityID)
+	if session.ResumedAt == nil {
+		update.ClearResumedAt()
+	} else {
+		update.SetResumedAt(*session.ResumedAt)
+	}
+	return update.Save(ctx)
+}
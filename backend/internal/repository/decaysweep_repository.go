@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	entDecaySweep "stride-wars-app/ent/decaysweep"
+	"stride-wars-app/ent/predicate"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DecaySweepRepository struct {
+	client *ent.Client
+}
+
+func NewDecaySweepRepository(client *ent.Client) DecaySweepRepository {
+	return DecaySweepRepository{client: client}
+}
+
+func (r DecaySweepRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+func (r DecaySweepRepository) FindByID(ctx context.Context, id uuid.UUID) (*ent.DecaySweep, error) {
+	return r.db(ctx).DecaySweep.Get(ctx, id)
+}
+
+// FindByIDForUpdate returns a sweep and locks it until the end of the transaction, so only one
+// instance advances it at a time.
+func (r DecaySweepRepository) FindByIDForUpdate(ctx context.Context, id uuid.UUID) (*ent.DecaySweep, error) {
+	return r.db(ctx).DecaySweep.Query().
+		Where(entDecaySweep.IDEQ(id), predicate.DecaySweep(forUpdate)).
+		Only(ctx)
+}
+
+// FindLatest returns the most recently started sweep.
+func (r DecaySweepRepository) FindLatest(ctx context.Context) (*ent.DecaySweep, error) {
+	return r.db(ctx).DecaySweep.Query().
+		Order(ent.Desc(entDecaySweep.FieldStartedAt)).
+		First(ctx)
+}
+
+func (r DecaySweepRepository) CreateDecaySweep(ctx context.Context, startedAt time.Time) (*ent.DecaySweep, error) {
+	return r.db(ctx).DecaySweep.Create().
+		SetID(uuid.New()).
+		SetStartedAt(startedAt.UTC()).
+		SetUpdatedAt(startedAt.UTC()).
+		Save(ctx)
+}
+
+// SaveProgress checkpoints a sweep after a chunk: the cursor moves past the chunk and its
+// counts are added to the sweep's totals.
+func (r DecaySweepRepository) SaveProgress(ctx context.Context, id uuid.UUID, cursor uuid.UUID, processed, decayed, pruned, ownerChanges int, now time.Time) error {
+	return r.db(ctx).DecaySweep.UpdateOneID(id).
+		SetCursor(cursor).
+		AddProcessed(processed).
+		AddDecayed(decayed).
+		AddPruned(pruned).
+		AddOwnerChanges(ownerChanges).
+		SetUpdatedAt(now.UTC()).
+		Exec(ctx)
+}
+
+func (r DecaySweepRepository) FinishDecaySweep(ctx context.Context, id uuid.UUID, now time.Time) (*ent.DecaySweep, error) {
+	return r.db(ctx).DecaySweep.UpdateOneID(id).
+		SetFinishedAt(now.UTC()).
+		SetUpdatedAt(now.UTC()).
+		Save(ctx)
+}
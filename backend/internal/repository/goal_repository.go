@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	entGoal "stride-wars-app/ent/goal"
+	"stride-wars-app/ent/model"
+
+	"github.com/google/uuid"
+)
+
+type GoalRepository struct {
+	client *ent.Client
+}
+
+func NewGoalRepository(client *ent.Client) GoalRepository {
+	return GoalRepository{client: client}
+}
+
+func (r GoalRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+func (r GoalRepository) FindByID(ctx context.Context, id uuid.UUID) (*ent.Goal, error) {
+	return r.db(ctx).Goal.Query().Where(entGoal.IDEQ(id)).First(ctx)
+}
+
+func (r GoalRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*ent.Goal, error) {
+	return r.db(ctx).Goal.Query().Where(entGoal.UserIDEQ(userID)).Order(ent.Asc(entGoal.FieldCreatedAt)).All(ctx)
+}
+
+func (r GoalRepository) FindByUserIDMetricAndPeriod(ctx context.Context, userID uuid.UUID, metric string, period string) (*ent.Goal, error) {
+	return r.db(ctx).Goal.Query().Where(
+		entGoal.UserIDEQ(userID),
+		entGoal.MetricEQ(entGoal.Metric(metric)),
+		entGoal.PeriodEQ(entGoal.Period(period)),
+	).First(ctx)
+}
+
+func (r GoalRepository) CreateGoal(ctx context.Context, goal *model.Goal) (*ent.Goal, error) {
+	return r.db(ctx).Goal.Create().
+		SetID(uuid.New()).
+		SetUserID(goal.UserID).
+		SetMetric(entGoal.Metric(goal.Metric)).
+		SetPeriod(entGoal.Period(goal.Period)).
+		SetTarget(goal.Target).
+		SetProgress(goal.Progress).
+		SetPeriodStart(goal.PeriodStart).
+		Save(ctx)
+}
+
+func (r GoalRepository) UpdateGoal(ctx context.Context, goal *model.Goal) (*ent.Goal, error) {
+	return r.db(ctx).Goal.UpdateOneID(goal.ID).
+		SetTarget(goal.Target).
+		SetProgress(goal.Progress).
+		SetPeriodStart(goal.PeriodStart).
+		Save(ctx)
+}
+
+func (r GoalRepository) DeleteByID(ctx context.Context, id uuid.UUID) error {
+	return r.db(ctx).Goal.DeleteOneID(id).Exec(ctx)
+}
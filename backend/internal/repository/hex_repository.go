@@ -14,22 +14,26 @@ func NewHexRepository(client *ent.Client) HexRepository {
 	return HexRepository{client: client}
 }
 
+func (r HexRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
 func (r HexRepository) FindByID(ctx context.Context, hex_id string) (*ent.Hex, error) {
-	return r.client.Hex.Query().Where(entHex.IDEQ(hex_id)).First(ctx)
+	return r.db(ctx).Hex.Query().Where(entHex.IDEQ(hex_id)).First(ctx)
 }
 
 func (r HexRepository) FindByIDs(ctx context.Context, ids []string) ([]*ent.Hex, error) {
-	return r.client.Hex.Query().Where(entHex.IDIn(ids...)).All(ctx)
+	return r.db(ctx).Hex.Query().Where(entHex.IDIn(ids...)).All(ctx)
 }
 
 func (r HexRepository) CreateHex(ctx context.Context, h3_index string) (*ent.Hex, error) {
-	return r.client.Hex.Create().SetID(h3_index).Save(ctx)
+	return r.db(ctx).Hex.Create().SetID(h3_index).Save(ctx)
 }
 func (r HexRepository) CreateHexes(ctx context.Context, hexes []*ent.Hex) ([]*ent.Hex, error) {
 	createdHexes := make([]*ent.Hex, len(hexes))
 
 	for i, hex := range hexes {
-		createdHex, err := r.client.Hex.Create().SetID(hex.ID).Save(ctx)
+		createdHex, err := r.db(ctx).Hex.Create().SetID(hex.ID).Save(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -38,3 +42,21 @@ func (r HexRepository) CreateHexes(ctx context.Context, hexes []*ent.Hex) ([]*en
 
 	return createdHexes, nil
 }
+
+// CreateMissingHexes inserts the hexes that do not exist yet, leaving existing rows untouched.
+func (r HexRepository) CreateMissingHexes(ctx context.Context, h3Indexes []string) error {
+	for _, c := range chunks(len(h3Indexes)) {
+		builders := make([]*ent.HexCreate, 0, c[1]-c[0])
+		for _, h3Index := range h3Indexes[c[0]:c[1]] {
+			builders = append(builders, r.db(ctx).Hex.Create().SetID(h3Index))
+		}
+		err := r.db(ctx).Hex.CreateBulk(builders...).
+			OnConflictColumns(entHex.FieldID).
+			DoNothing().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
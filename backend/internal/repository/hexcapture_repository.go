@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	entHexCapture "stride-wars-app/ent/hexcapture"
+	"stride-wars-app/ent/model"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type HexCaptureRepository struct {
+	client *ent.Client
+}
+
+func NewHexCaptureRepository(client *ent.Client) HexCaptureRepository {
+	return HexCaptureRepository{client: client}
+}
+
+func (r HexCaptureRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+// CreateHexCaptures appends the given captures in bulk, setting the IDs of those without one.
+func (r HexCaptureRepository) CreateHexCaptures(ctx context.Context, captures []*model.HexCapture) error {
+	for _, c := range chunks(len(captures)) {
+		builders := make([]*ent.HexCaptureCreate, 0, c[1]-c[0])
+		for _, capture := range captures[c[0]:c[1]] {
+			if capture.ID == uuid.Nil {
+				capture.ID = uuid.New()
+			}
+			builders = append(builders, r.db(ctx).HexCapture.Create().
+				SetID(capture.ID).
+				SetH3Index(capture.H3Index).
+				SetNillablePreviousOwnerID(capture.PreviousOwnerID).
+				SetNewOwnerID(capture.NewOwnerID).
+				SetPreviousScore(capture.PreviousScore).
+				SetNewScore(capture.NewScore).
+				SetNillableActivityID(capture.ActivityID).
+				SetCapturedAt(capture.CapturedAt.UTC()))
+		}
+		if err := r.db(ctx).HexCapture.CreateBulk(builders...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindByH3Index returns up to limit of a hex's most recent captures, newest first.
+func (r HexCaptureRepository) FindByH3Index(ctx context.Context, h3Index string, limit int) ([]*ent.HexCapture, error) {
+	return r.db(ctx).HexCapture.Query().
+		Where(entHexCapture.H3IndexEQ(h3Index)).
+		Order(ent.Desc(entHexCapture.FieldCapturedAt)).
+		Limit(limit).
+		All(ctx)
+}
+
+// FindByUserIDBetween returns the captures made or suffered by the user in [from, to), newest first.
+func (r HexCaptureRepository) FindByUserIDBetween(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]*ent.HexCapture, error) {
+	return r.db(ctx).HexCapture.Query().
+		Where(
+			entHexCapture.Or(entHexCapture.NewOwnerIDEQ(userID), entHexCapture.PreviousOwnerIDEQ(userID)),
+			entHexCapture.CapturedAtGTE(from.UTC()),
+			entHexCapture.CapturedAtLT(to.UTC()),
+		).
+		Order(ent.Desc(entHexCapture.FieldCapturedAt)).
+		All(ctx)
+}
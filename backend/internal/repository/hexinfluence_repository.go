@@ -2,21 +2,75 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 	"stride-wars-app/ent"
 	entHexInfluence "stride-wars-app/ent/hexinfluence"
 	"stride-wars-app/ent/model"
+	"stride-wars-app/ent/predicate"
+	entUser "stride-wars-app/ent/user"
 
-	"math"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 )
 
-// DecayRatePerWeek is the fraction by which a score decays each week.
-const DecayRatePerWeek = 0.1
+// ScoreSQL is a scoring strategy expressed in SQL, so that visits can be scored by the database
+// in an upsert instead of being read and written back.
+type ScoreSQL interface {
+	// DecaySQL returns an expression for score after elapsedHours hours without a visit.
+	DecaySQL(score, elapsedHours string) string
+	// VisitsSQL returns an expression for score after visits more visits.
+	VisitsSQL(score, visits string) string
+}
+
+// elapsedHoursSQL returns an expression for the hours between two timestamp columns.
+func elapsedHoursSQL(driver, from, to string) string {
+	if driver == dialect.Postgres {
+		return fmt.Sprintf("(EXTRACT(EPOCH FROM (%s - %s)) / 3600.0)", to, from)
+	}
+	return fmt.Sprintf("((julianday(%s) - julianday(%s)) * 24.0)", to, from)
+}
+
+// hoursSinceSQL returns an expression for the hours from a timestamp column up to now. now is
+// written into the expression as a number, so it can be repeated without binding arguments.
+func hoursSinceSQL(driver, from string, now time.Time) string {
+	seconds := float64(now.UnixNano()) / 1e9
+	if driver == dialect.Postgres {
+		return fmt.Sprintf("((%s - EXTRACT(EPOCH FROM %s)) / 3600.0)", strconv.FormatFloat(seconds, 'f', -1, 64), from)
+	}
+	// julianday counts days from noon, November 24, 4714 BC, of which 2440587.5 passed before the Unix epoch.
+	return fmt.Sprintf("((%s - julianday(%s)) * 24.0)", strconv.FormatFloat(seconds/86400+2440587.5, 'f', -1, 64), from)
+}
+
+// effectiveScoreSQL returns an expression for a stored score decayed from its last update up to
+// now, as the leaderboard readers compute it in Go. Influences stored before last updates were
+// recorded carry the zero time and keep their score.
+func effectiveScoreSQL(driver string, scoring ScoreSQL, score, lastUpdated string, now time.Time) string {
+	elapsed := hoursSinceSQL(driver, lastUpdated, now)
+	sinceZeroTime := float64(now.Unix()-time.Time{}.Unix()) / 3600
+	return fmt.Sprintf("(CASE WHEN %s > 0 AND %s < %s THEN %s ELSE %s END)",
+		elapsed, elapsed, strconv.FormatFloat(sinceZeroTime, 'f', -1, 64), scoring.DecaySQL(score, elapsed), score)
+}
+
+// LeaderTotals are a user's totals over the hexes they lead.
+type LeaderTotals struct {
+	UserID         uuid.UUID `json:"user_id"`
+	Username       string    `json:"username"`
+	Hexes          int       `json:"hexes"`
+	Score          float64   `json:"score"`
+	EffectiveScore float64   `json:"effective_score"`
+}
 
-// HoursPerWeek is the total number of hours in one week.
-const HoursPerWeek = 24.0 * 7.0
+// HexRankingRow is a user's entry in the ranking of a hex.
+type HexRankingRow struct {
+	UserID         uuid.UUID `json:"user_id"`
+	Username       string    `json:"username"`
+	Score          float64   `json:"score"`
+	EffectiveScore float64   `json:"effective_score"`
+}
 
 type HexInfluenceRepository struct {
 	client *ent.Client
@@ -25,27 +79,239 @@ type HexInfluenceRepository struct {
 func NewHexInfluenceRepository(client *ent.Client) HexInfluenceRepository {
 	return HexInfluenceRepository{client: client}
 }
+
+func (r HexInfluenceRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
 func (r HexInfluenceRepository) FindByID(ctx context.Context, uuid uuid.UUID) (*ent.HexInfluence, error) {
-	return r.client.HexInfluence.Query().Where(entHexInfluence.IDEQ(uuid)).First(ctx)
+	return r.db(ctx).HexInfluence.Query().Where(entHexInfluence.IDEQ(uuid)).First(ctx)
 }
 func (r HexInfluenceRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*ent.HexInfluence, error) {
-	return r.client.HexInfluence.Query().Where(entHexInfluence.IDIn(ids...)).All(ctx)
+	return r.db(ctx).HexInfluence.Query().Where(entHexInfluence.IDIn(ids...)).All(ctx)
 }
 func (r HexInfluenceRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*ent.HexInfluence, error) {
-	return r.client.HexInfluence.Query().Where(entHexInfluence.UserIDEQ(userID)).All(ctx)
+	return r.db(ctx).HexInfluence.Query().Where(entHexInfluence.UserIDEQ(userID)).All(ctx)
 }
 func (r HexInfluenceRepository) FindByUserIDAndHexID(ctx context.Context, userID uuid.UUID, hexID string) (*ent.HexInfluence, error) {
-	return r.client.HexInfluence.Query().Where(
+	return r.db(ctx).HexInfluence.Query().Where(
 		entHexInfluence.H3IndexEQ(hexID),
 		entHexInfluence.UserIDEQ(userID),
 	).First(ctx)
 }
+
+// FindByUserIDAndHexIDs returns the user's influences in any of the given hexes.
+func (r HexInfluenceRepository) FindByUserIDAndHexIDs(ctx context.Context, userID uuid.UUID, hexIDs []string) ([]*ent.HexInfluence, error) {
+	return r.db(ctx).HexInfluence.Query().Where(
+		entHexInfluence.UserIDEQ(userID),
+		entHexInfluence.H3IndexIn(hexIDs...),
+	).All(ctx)
+}
+
+// FindByUserIDAndHexIDsForUpdate returns the user's influences in any of the given hexes and
+// locks them until the end of the transaction.
+func (r HexInfluenceRepository) FindByUserIDAndHexIDsForUpdate(ctx context.Context, userID uuid.UUID, hexIDs []string) ([]*ent.HexInfluence, error) {
+	return r.db(ctx).HexInfluence.Query().Where(
+		entHexInfluence.UserIDEQ(userID),
+		entHexInfluence.H3IndexIn(hexIDs...),
+		predicate.HexInfluence(forUpdate),
+	).All(ctx)
+}
 func (r HexInfluenceRepository) FindByHexID(ctx context.Context, hexID string) ([]*ent.HexInfluence, error) {
-	return r.client.HexInfluence.Query().Where(entHexInfluence.H3IndexEQ(hexID)).All(ctx)
+	return r.db(ctx).HexInfluence.Query().Where(entHexInfluence.H3IndexEQ(hexID)).All(ctx)
+}
+
+// SumScoreByUserID returns the total of the user's stored scores over all hexes.
+func (r HexInfluenceRepository) SumScoreByUserID(ctx context.Context, userID uuid.UUID) (float64, error) {
+	var totals []struct {
+		Score float64 `json:"score"`
+	}
+	err := r.db(ctx).HexInfluence.Query().
+		Where(entHexInfluence.UserIDEQ(userID)).
+		Modify(func(s *sql.Selector) {
+			s.Select().AppendSelectExprAs(sql.Expr("COALESCE(SUM("+s.C(entHexInfluence.FieldScore)+"), 0)"), "score")
+		}).
+		Scan(ctx, &totals)
+	if err != nil || len(totals) == 0 {
+		return 0, err
+	}
+	return totals[0].Score, nil
+}
+
+// FindInRegion returns the influences of all users in the hexes inside the region.
+func (r HexInfluenceRepository) FindInRegion(ctx context.Context, regionID uuid.UUID) ([]*ent.HexInfluence, error) {
+	return r.db(ctx).HexInfluence.Query().Where(predicate.HexInfluence(inRegion(regionID))).All(ctx)
+}
+
+// FindByHexIDWithUsers returns all influences in a hex with their users loaded.
+func (r HexInfluenceRepository) FindByHexIDWithUsers(ctx context.Context, hexID string) ([]*ent.HexInfluence, error) {
+	return r.db(ctx).HexInfluence.Query().
+		Where(entHexInfluence.H3IndexEQ(hexID)).
+		WithUsers().
+		All(ctx)
+}
+
+// FindByHexIDsWithUsers returns all influences in any of the given hexes with their users loaded.
+// The hexes are looked up in chunks, so a large viewport stays below the bind parameter limits.
+func (r HexInfluenceRepository) FindByHexIDsWithUsers(ctx context.Context, hexIDs []string) ([]*ent.HexInfluence, error) {
+	var influences []*ent.HexInfluence
+	for _, c := range chunks(len(hexIDs)) {
+		found, err := r.db(ctx).HexInfluence.Query().
+			Where(entHexInfluence.H3IndexIn(hexIDs[c[0]:c[1]]...)).
+			WithUsers().
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		influences = append(influences, found...)
+	}
+	return influences, nil
+}
+
+// FindAllWithUsers returns the influences of all users in all hexes with their users loaded.
+func (r HexInfluenceRepository) FindAllWithUsers(ctx context.Context) ([]*ent.HexInfluence, error) {
+	return r.db(ctx).HexInfluence.Query().WithUsers().All(ctx)
+}
+
+// FindTopLeaders returns the limit users who lead the most hexes, ties broken by the higher total
+// effective score. A hex's leader is the user with the highest effective score at now in it,
+// following scoring. The hexes are ranked and counted by the database in a single query.
+func (r HexInfluenceRepository) FindTopLeaders(ctx context.Context, scoring ScoreSQL, now time.Time, limit int) ([]LeaderTotals, error) {
+	var totals []LeaderTotals
+	err := r.db(ctx).HexInfluence.Query().
+		Modify(func(s *sql.Selector) {
+			b := sql.Dialect(s.Dialect())
+			t := b.Table(entHexInfluence.Table)
+			effective := effectiveScoreSQL(s.Dialect(), scoring, t.C(entHexInfluence.FieldScore), t.C(entHexInfluence.FieldLastUpdated), now)
+			ranked := b.Select(t.C(entHexInfluence.FieldUserID), t.C(entHexInfluence.FieldScore)).
+				AppendSelectExprAs(sql.Expr(effective), "effective_score").
+				AppendSelectExprAs(sql.Expr(fmt.Sprintf(
+					"ROW_NUMBER() OVER (PARTITION BY %s ORDER BY %s DESC, %s)",
+					t.C(entHexInfluence.FieldH3Index), effective, t.C(entHexInfluence.FieldUserID),
+				)), "place").
+				From(t).
+				As("ranked")
+			users := b.Table(entUser.Table)
+			s.From(ranked).
+				Join(users).On(ranked.C(entHexInfluence.FieldUserID), users.C(entUser.FieldID)).
+				Where(sql.EQ(ranked.C("place"), 1)).
+				Select(ranked.C(entHexInfluence.FieldUserID)).
+				AppendSelectAs(users.C(entUser.FieldUsername), "username").
+				AppendSelectAs(sql.Count("*"), "hexes").
+				AppendSelectAs(sql.Sum(ranked.C(entHexInfluence.FieldScore)), "score").
+				AppendSelectAs(sql.Sum(ranked.C("effective_score")), "effective_score").
+				GroupBy(ranked.C(entHexInfluence.FieldUserID), users.C(entUser.FieldUsername)).
+				OrderBy(sql.Desc("hexes"), sql.Desc("effective_score")).
+				Limit(limit)
+		}).
+		Scan(ctx, &totals)
+	return totals, err
+}
+
+// FindZoneHidingUserIDsByHexID returns the users with influence in a hex who keep the hexes
+// inside their privacy zones off the leaderboards.
+func (r HexInfluenceRepository) FindZoneHidingUserIDsByHexID(ctx context.Context, hexID string) ([]uuid.UUID, error) {
+	return r.db(ctx).HexInfluence.Query().
+		Where(entHexInfluence.H3IndexEQ(hexID)).
+		QueryUsers().
+		Where(entUser.HideZoneLeaderboards(true)).
+		IDs(ctx)
+}
+
+// CountHexRanking counts the users ranked in a hex, leaving out the excluded ones.
+func (r HexInfluenceRepository) CountHexRanking(ctx context.Context, hexID string, excluded []uuid.UUID) (int, error) {
+	return r.db(ctx).HexInfluence.Query().Where(rankedIn(hexID, excluded)...).Count(ctx)
+}
+
+// FindHexRankingPage returns a page of the ranking of a hex by effective score at now, following
+// scoring, with ties ranked by user ID. The excluded users are left out. The database sorts
+// and pages the ranking, so only the page is read.
+func (r HexInfluenceRepository) FindHexRankingPage(ctx context.Context, scoring ScoreSQL, hexID string, excluded []uuid.UUID, now time.Time, limit, offset int) ([]HexRankingRow, error) {
+	return r.findHexRanking(ctx, scoring, now, rankedIn(hexID, excluded), func(s *sql.Selector) {
+		s.OrderBy(sql.Desc("effective_score"), s.C(entHexInfluence.FieldUserID)).
+			Limit(limit).
+			Offset(offset)
+	})
+}
+
+// FindHexRankingOf returns the user's entry in the ranking of a hex, or nil if the user has no
+// influence in it.
+func (r HexInfluenceRepository) FindHexRankingOf(ctx context.Context, scoring ScoreSQL, hexID string, userID uuid.UUID, now time.Time) (*HexRankingRow, error) {
+	rows, err := r.findHexRanking(ctx, scoring, now, []predicate.HexInfluence{
+		entHexInfluence.H3IndexEQ(hexID),
+		entHexInfluence.UserIDEQ(userID),
+	}, func(*sql.Selector) {})
+	if err != nil || len(rows) == 0 {
+		return nil, err
+	}
+	return &rows[0], nil
+}
+
+// CountHexRankingAbove counts the users ranked above an entry of a hex's ranking by effective
+// score at now: those with a higher effective score, or an equal one and a smaller ID. The
+// excluded users are left out.
+func (r HexInfluenceRepository) CountHexRankingAbove(ctx context.Context, scoring ScoreSQL, hexID string, excluded []uuid.UUID, now time.Time, entry HexRankingRow) (int, error) {
+	return r.db(ctx).HexInfluence.Query().
+		Where(rankedIn(hexID, excluded)...).
+		Where(predicate.HexInfluence(func(s *sql.Selector) {
+			effective := effectiveScoreSQL(s.Dialect(), scoring, s.C(entHexInfluence.FieldScore), s.C(entHexInfluence.FieldLastUpdated), now)
+			s.Where(sql.Or(
+				sql.P(func(b *sql.Builder) {
+					b.WriteString(effective).WriteOp(sql.OpGT).Arg(entry.EffectiveScore)
+				}),
+				sql.And(
+					sql.P(func(b *sql.Builder) {
+						b.WriteString(effective).WriteOp(sql.OpEQ).Arg(entry.EffectiveScore)
+					}),
+					sql.LT(s.C(entHexInfluence.FieldUserID), entry.UserID),
+				),
+			))
+		})).
+		Count(ctx)
+}
+
+// findHexRanking selects the influences matching predicates as ranking entries, with their
+// user's name and effective score at now, and lets page order and limit them.
+func (r HexInfluenceRepository) findHexRanking(ctx context.Context, scoring ScoreSQL, now time.Time, predicates []predicate.HexInfluence, page func(*sql.Selector)) ([]HexRankingRow, error) {
+	var rows []HexRankingRow
+	err := r.db(ctx).HexInfluence.Query().
+		Where(predicates...).
+		Modify(func(s *sql.Selector) {
+			effective := effectiveScoreSQL(s.Dialect(), scoring, s.C(entHexInfluence.FieldScore), s.C(entHexInfluence.FieldLastUpdated), now)
+			users := sql.Dialect(s.Dialect()).Table(entUser.Table)
+			s.Join(users).On(s.C(entHexInfluence.FieldUserID), users.C(entUser.FieldID)).
+				Select(s.C(entHexInfluence.FieldUserID)).
+				AppendSelectAs(users.C(entUser.FieldUsername), "username").
+				AppendSelectAs(s.C(entHexInfluence.FieldScore), "score").
+				AppendSelectExprAs(sql.Expr(effective), "effective_score")
+			page(s)
+		}).
+		Scan(ctx, &rows)
+	return rows, err
+}
+
+func rankedIn(hexID string, excluded []uuid.UUID) []predicate.HexInfluence {
+	predicates := []predicate.HexInfluence{entHexInfluence.H3IndexEQ(hexID)}
+	if len(excluded) > 0 {
+		predicates = append(predicates, entHexInfluence.UserIDNotIn(excluded...))
+	}
+	return predicates
+}
+
+// FindAfterIDForUpdate returns up to limit influences in ID order, starting after the given ID or
+// from the first one if it is nil, and locks them until the end of the transaction.
+func (r HexInfluenceRepository) FindAfterIDForUpdate(ctx context.Context, after *uuid.UUID, limit int) ([]*ent.HexInfluence, error) {
+	query := r.db(ctx).HexInfluence.Query().Where(predicate.HexInfluence(forUpdate))
+	if after != nil {
+		query = query.Where(entHexInfluence.IDGT(*after))
+	}
+	return query.
+		Order(ent.Asc(entHexInfluence.FieldID)).
+		Limit(limit).
+		All(ctx)
 }
 
 func (r HexInfluenceRepository) CreateHexInfluence(ctx context.Context, hexInfluence *model.HexInfluence) (*ent.HexInfluence, error) {
-	return r.client.HexInfluence.Create().
+	return r.db(ctx).HexInfluence.Create().
 		SetH3Index(hexInfluence.H3Index).
 		SetUserID(hexInfluence.UserID).
 		SetScore(hexInfluence.Score).
@@ -53,76 +319,126 @@ func (r HexInfluenceRepository) CreateHexInfluence(ctx context.Context, hexInflu
 		SetID(uuid.New()).
 		Save(ctx)
 }
-func (r HexInfluenceRepository) UpdateHexInfluence(ctx context.Context, userID uuid.UUID, hexID string) (int, error) {
-	hexInfluence, err := r.FindByUserIDAndHexID(ctx, userID, hexID)
-	if err != nil {
-		return 0, err
-	}
-	if hexInfluence == nil {
-		return 0, nil
-	}
-
-	now := time.Now()
-	// Calculate how much to multiply the old score by, based on hours elapsed:
-	elapsedHours := now.Sub(hexInfluence.LastUpdated).Hours()
-	multiplier := 1 - DecayRatePerWeek*(elapsedHours/HoursPerWeek)
-	// Round to one decimal place:
-	multiplier = math.Round(multiplier*10) / 10
-	if multiplier < 0 {
-		multiplier = 0.1
-	}
 
-	new_score := hexInfluence.Score*multiplier + 1.0
-
-	return r.client.HexInfluence.Update().
-		Where(entHexInfluence.IDEQ(hexInfluence.ID)).
-		SetLastUpdated(now).
-		SetScore(new_score).
+// UpdateHexInfluenceScore sets the score and last update time of an existing influence.
+func (r HexInfluenceRepository) UpdateHexInfluenceScore(ctx context.Context, id uuid.UUID, score float64, lastUpdated time.Time) (*ent.HexInfluence, error) {
+	return r.db(ctx).HexInfluence.UpdateOneID(id).
+		SetScore(score).
+		SetLastUpdated(lastUpdated).
 		Save(ctx)
 }
 
-func (r HexInfluenceRepository) UpdateHexInfluences(ctx context.Context, userID uuid.UUID, hexIDs []string) (int, error) {
-	totalUpdated := 0
-	for _, h3id := range hexIDs {
-		n, err := r.UpdateHexInfluence(ctx, userID, h3id)
-		if err != nil {
-			return totalUpdated, err
+// UpsertHexInfluencesAt records visits[i] visits of the user to each of hexIDs[i] at the given
+// time with one INSERT ... ON CONFLICT statement per chunk, so existing scores are decayed and
+// raised by the database following scoring. New influences start at the scores given in
+// created. As in applyVisits, a visit older than the last update adds to the score without
+// decaying it.
+func (r HexInfluenceRepository) UpsertHexInfluencesAt(ctx context.Context, scoring ScoreSQL, userID uuid.UUID, hexIDs []string, visits []int, created []float64, at time.Time) error {
+	at = at.UTC()
+	// The visit count is part of the conflict expression, so each statement covers hexes that
+	// were visited equally often.
+	byVisits := make(map[int][]int)
+	var counts []int
+	for i, n := range visits {
+		if _, ok := byVisits[n]; !ok {
+			counts = append(counts, n)
 		}
-		totalUpdated += n
+		byVisits[n] = append(byVisits[n], i)
 	}
+	for _, n := range counts {
+		indexes := byVisits[n]
+		for _, c := range chunks(len(indexes)) {
+			builders := make([]*ent.HexInfluenceCreate, 0, c[1]-c[0])
+			for _, i := range indexes[c[0]:c[1]] {
+				builders = append(builders, r.db(ctx).HexInfluence.Create().
+					SetID(uuid.New()).
+					SetH3Index(hexIDs[i]).
+					SetUserID(userID).
+					SetScore(created[i]).
+					SetLastUpdated(at))
+			}
+			err := r.db(ctx).HexInfluence.CreateBulk(builders...).
+				OnConflict(
+					sql.ConflictColumns(entHexInfluence.FieldUserID, entHexInfluence.FieldH3Index),
+					sql.ResolveWith(func(u *sql.UpdateSet) {
+						existing := u.Table()
+						excluded := sql.Dialect(u.Dialect()).Table("excluded")
+						lastUpdated := existing.C(entHexInfluence.FieldLastUpdated)
+						elapsed := elapsedHoursSQL(u.Dialect(), lastUpdated, excluded.C(entHexInfluence.FieldLastUpdated))
+						score := existing.C(entHexInfluence.FieldScore)
+						count := fmt.Sprint(n)
 
-	return totalUpdated, nil
+						u.Set(entHexInfluence.FieldScore, sql.Expr(fmt.Sprintf(
+							"CASE WHEN %s > 0 THEN %s ELSE %s END",
+							elapsed, scoring.VisitsSQL(scoring.DecaySQL(score, elapsed), count), scoring.VisitsSQL(score, count),
+						)))
+						u.Set(entHexInfluence.FieldLastUpdated, sql.Expr(fmt.Sprintf(
+							"CASE WHEN %s > 0 THEN %s ELSE %s END",
+							elapsed, excluded.C(entHexInfluence.FieldLastUpdated), lastUpdated,
+						)))
+					}),
+				).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
-func (r HexInfluenceRepository) UpdateOrCreateHexInfluence(ctx context.Context, userID uuid.UUID, hexID string) (*ent.HexInfluence, error) {
-	updatedInfluence, err := r.UpdateHexInfluence(ctx, userID, hexID)
+
+// SetHexInfluence overwrites the user's score in a hex, creating the row if it does not exist yet.
+func (r HexInfluenceRepository) SetHexInfluence(ctx context.Context, hexInfluence *model.HexInfluence) (*ent.HexInfluence, error) {
+	existing, err := r.FindByUserIDAndHexID(ctx, hexInfluence.UserID, hexInfluence.H3Index)
 	if err != nil {
-		// Check if the error is due to no rows being updated (i.e., not found)
 		if !ent.IsNotFound(err) {
-			// Real error — return it
 			return nil, err
 		}
-		// ent.ErrNotFound means no update happened — fall through to create
+		return r.CreateHexInfluence(ctx, hexInfluence)
 	}
-	if updatedInfluence == 0 {
-		// log that it attempts to create a hexinfluence
-		newInfluence := &model.HexInfluence{
-			UserID:      userID,
-			H3Index:     hexID,
-			Score:       1.0,
-			LastUpdated: time.Now(),
-		}
-		return r.CreateHexInfluence(ctx, newInfluence)
-	}
-	return r.FindByUserIDAndHexID(ctx, userID, hexID)
+	return r.db(ctx).HexInfluence.UpdateOne(existing).
+		SetScore(hexInfluence.Score).
+		SetLastUpdated(hexInfluence.LastUpdated).
+		Save(ctx)
 }
-func (r HexInfluenceRepository) UpdateOrCreateHexInfluences(ctx context.Context, userID uuid.UUID, hexIDs []string) ([]*ent.HexInfluence, error) {
-	updatedInfluences := make([]*ent.HexInfluence, 0, len(hexIDs))
-	for _, h3id := range hexIDs {
-		influence, err := r.UpdateOrCreateHexInfluence(ctx, userID, h3id)
+
+func (r HexInfluenceRepository) DeleteByUserIDAndHexID(ctx context.Context, userID uuid.UUID, hexID string) (int, error) {
+	return r.db(ctx).HexInfluence.Delete().Where(
+		entHexInfluence.H3IndexEQ(hexID),
+		entHexInfluence.UserIDEQ(userID),
+	).Exec(ctx)
+}
+
+func (r HexInfluenceRepository) DeleteByIDs(ctx context.Context, ids []uuid.UUID) (int, error) {
+	deleted := 0
+	for _, c := range chunks(len(ids)) {
+		n, err := r.db(ctx).HexInfluence.Delete().Where(entHexInfluence.IDIn(ids[c[0]:c[1]]...)).Exec(ctx)
 		if err != nil {
-			return nil, err
+			return deleted, err
 		}
-		updatedInfluences = append(updatedInfluences, influence)
+		deleted += n
 	}
-	return updatedInfluences, nil
+	return deleted, nil
+}
+
+// CarryOverScores multiplies every stored score by factor in a single UPDATE, and keeps the
+// result and the last update as the score each influence was carried over with.
+func (r HexInfluenceRepository) CarryOverScores(ctx context.Context, factor float64) (int, error) {
+	return r.db(ctx).HexInfluence.Update().
+		Modify(func(u *sql.UpdateBuilder) {
+			scaled := sql.ExprFunc(func(b *sql.Builder) {
+				b.Ident(entHexInfluence.FieldScore).WriteString(" * ").Arg(factor)
+			})
+			u.Set(entHexInfluence.FieldScore, scaled)
+			u.Set(entHexInfluence.FieldCarriedScore, scaled)
+			u.Set(entHexInfluence.FieldCarriedAt, sql.ExprFunc(func(b *sql.Builder) {
+				b.Ident(entHexInfluence.FieldLastUpdated)
+			}))
+		}).
+		Save(ctx)
+}
+
+// DeleteAll removes the influences of all users in all hexes.
+func (r HexInfluenceRepository) DeleteAll(ctx context.Context) (int, error) {
+	return r.db(ctx).HexInfluence.Delete().Exec(ctx)
 }
@@ -1,6 +1,7 @@
 package repository_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -57,8 +58,8 @@ func TestHexInfluenceRepository(t *testing.T) {
 		createdInfluence, err := inflRepo.CreateHexInfluence(ctx, newInfluence)
 		require.NoError(t, err)
 
-		// 6) Update the influence (should decrement Score to 9.0 and set LastUpdated=now):
-		rowsChanged, err := inflRepo.UpdateHexInfluence(ctx, createdInfluence.UserID, createdInfluence.H3Index)
+		// 6) Update the influence with the default linear scoring (should decrement Score to 9.0 and set LastUpdated=now):
+		rowsChanged, err := tdb.HexInfluenceService.UpdateHexInfluence(ctx, createdInfluence.UserID, createdInfluence.H3Index)
 		require.NoError(t, err)
 		require.Equal(t, 1, rowsChanged)
 
@@ -74,4 +75,35 @@ func TestHexInfluenceRepository(t *testing.T) {
 		// 8) LastUpdated was set to “now”:
 		require.WithinDuration(t, time.Now(), updated.LastUpdated, time.Second*2)
 	})
+	t.Run("find influences of more hexes than fit in one statement", func(t *testing.T) {
+		t.Parallel()
+
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+
+		first, last := "85283473fffffff", "85283447fffffff"
+		require.NoError(t, tdb.HexRepo.CreateMissingHexes(ctx, []string{first, last}))
+		createdUser, err := tdb.UserRepo.CreateUser(ctx, &model.User{Username: "bob", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		for _, h3Index := range []string{first, last} {
+			_, err := tdb.HexInfluenceRepo.CreateHexInfluence(ctx, &model.HexInfluence{
+				UserID: createdUser.ID, H3Index: h3Index, Score: 1.0, LastUpdated: time.Now(),
+			})
+			require.NoError(t, err)
+		}
+
+		// The two hexes end up in different chunks.
+		hexIDs := []string{first}
+		for i := 0; i < 2500; i++ {
+			hexIDs = append(hexIDs, fmt.Sprintf("unknown-%d", i))
+		}
+		hexIDs = append(hexIDs, last)
+
+		influences, err := tdb.HexInfluenceRepo.FindByHexIDsWithUsers(ctx, hexIDs)
+		require.NoError(t, err)
+		require.Len(t, influences, 2)
+		for _, influence := range influences {
+			require.Equal(t, "bob", influence.Edges.Users.Username)
+		}
+	})
 }
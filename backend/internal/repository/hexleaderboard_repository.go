@@ -2,11 +2,11 @@ package repository
 
 import (
 	"context"
-	"sort"
+	"slices"
 	"stride-wars-app/ent"
 	entHexLeaderboard "stride-wars-app/ent/hexleaderboard"
 	"stride-wars-app/ent/model"
-	"stride-wars-app/internal/dto"
+	"stride-wars-app/ent/predicate"
 
 	"github.com/google/uuid"
 )
@@ -18,72 +18,102 @@ type HexLeaderboardRepository struct {
 func NewHexLeaderboardRepository(client *ent.Client) HexLeaderboardRepository {
 	return HexLeaderboardRepository{client: client}
 }
+
+func (r HexLeaderboardRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
 func (r HexLeaderboardRepository) FindByID(ctx context.Context, id uuid.UUID) (*ent.HexLeaderboard, error) {
-	return r.client.HexLeaderboard.Query().Where(entHexLeaderboard.IDEQ(id)).First(ctx)
+	return r.db(ctx).HexLeaderboard.Query().Where(entHexLeaderboard.IDEQ(id)).First(ctx)
 }
 func (r HexLeaderboardRepository) FindByH3Index(ctx context.Context, hexID string) (*ent.HexLeaderboard, error) {
-	return r.client.HexLeaderboard.Query().Where(entHexLeaderboard.H3IndexEQ(hexID)).First(ctx)
+	return r.db(ctx).HexLeaderboard.Query().Where(entHexLeaderboard.H3IndexEQ(hexID)).First(ctx)
 }
 func (r HexLeaderboardRepository) CreateHexLeaderboard(ctx context.Context, hexLeaderboard *model.HexLeaderboard) (*ent.HexLeaderboard, error) {
-	return r.client.HexLeaderboard.Create().
+	return r.db(ctx).HexLeaderboard.Create().
 		SetH3Index(hexLeaderboard.H3Index).
 		SetTopUsers(hexLeaderboard.TopUsers).
 		Save(ctx)
 }
 func (r HexLeaderboardRepository) UpdateHexLeaderboard(ctx context.Context, hexLeaderboard *model.HexLeaderboard) (int, error) {
-	return r.client.HexLeaderboard.Update().Where(entHexLeaderboard.IDEQ(hexLeaderboard.ID)).SetTopUsers(hexLeaderboard.TopUsers).Save(ctx)
+	return r.db(ctx).HexLeaderboard.Update().Where(entHexLeaderboard.IDEQ(hexLeaderboard.ID)).SetTopUsers(hexLeaderboard.TopUsers).Save(ctx)
 }
-func (r HexLeaderboardRepository) FindByH3Indexes(ctx context.Context, h3Indexes []string) ([]*ent.HexLeaderboard, error) {
-	return r.client.HexLeaderboard.Query().Where(entHexLeaderboard.H3IndexIn(h3Indexes...)).All(ctx)
-}
-
-// Return users position in a particular hex's leaderboard, returns nil if the user is not in the leaderboard / in case of an error
-func (r HexLeaderboardRepository) GetUserPositionInLeaderboard(ctx context.Context, hexID string, userID uuid.UUID) (*int, error) {
-	hexLeaderboard, err := r.FindByH3Index(ctx, hexID)
-	if err != nil {
-		return nil, err
-	}
-	if hexLeaderboard == nil {
-		return nil, nil
-	}
 
-	topUsers := hexLeaderboard.TopUsers
-	for idx, user := range topUsers {
-		if user.UserID == userID {
-			pos := idx + 1
-			return &pos, nil
+// UpsertHexLeaderboards writes the given leaderboards in bulk, replacing the top users of the
+// hexes that already have a leaderboard.
+func (r HexLeaderboardRepository) UpsertHexLeaderboards(ctx context.Context, hexLeaderboards []*model.HexLeaderboard) error {
+	for _, c := range chunks(len(hexLeaderboards)) {
+		builders := make([]*ent.HexLeaderboardCreate, 0, c[1]-c[0])
+		for _, hexLeaderboard := range hexLeaderboards[c[0]:c[1]] {
+			builders = append(builders, r.db(ctx).HexLeaderboard.Create().
+				SetH3Index(hexLeaderboard.H3Index).
+				SetTopUsers(hexLeaderboard.TopUsers))
+		}
+		err := r.db(ctx).HexLeaderboard.CreateBulk(builders...).
+			OnConflictColumns(entHexLeaderboard.FieldH3Index).
+			Update(func(u *ent.HexLeaderboardUpsert) {
+				u.UpdateTopUsers()
+			}).
+			Exec(ctx)
+		if err != nil {
+			return err
 		}
 	}
-	return nil, nil
+	return nil
 }
 
-func (r HexLeaderboardRepository) GetGlobalHexLeaderboard(ctx context.Context) ([]dto.GlobalLeaderboardEntry, error) {
-	leaderboards, err := r.client.HexLeaderboard.Query().All(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	userCounts := make(map[uuid.UUID]int)
-	for _, lb := range leaderboards {
-		if len(lb.TopUsers) > 0 {
-			userCounts[lb.TopUsers[0].UserID]++
+// LockByH3Indexes returns the leaderboards of the given hexes locked until the end of the
+// transaction, first creating the missing ones without any top users so they can be locked too.
+// Rows are created and locked in h3_index order, so callers locking overlapping hexes wait for
+// each other instead of deadlocking. It must run in a transaction.
+func (r HexLeaderboardRepository) LockByH3Indexes(ctx context.Context, h3Indexes []string) ([]*ent.HexLeaderboard, error) {
+	sorted := slices.Clone(h3Indexes)
+	slices.Sort(sorted)
+	sorted = slices.Compact(sorted)
+	for _, c := range chunks(len(sorted)) {
+		builders := make([]*ent.HexLeaderboardCreate, 0, c[1]-c[0])
+		for _, h3Index := range sorted[c[0]:c[1]] {
+			builders = append(builders, r.db(ctx).HexLeaderboard.Create().
+				SetH3Index(h3Index).
+				SetTopUsers([]model.TopUser{}))
 		}
-	}
-	//ent
-	var entries []dto.GlobalLeaderboardEntry
-	for userID, count := range userCounts {
-		username := ""
-		if user, err := r.client.User.Get(ctx, userID); err == nil {
-			username = user.Username
+		err := r.db(ctx).HexLeaderboard.CreateBulk(builders...).
+			OnConflictColumns(entHexLeaderboard.FieldH3Index).
+			DoNothing().
+			Exec(ctx)
+		if err != nil {
+			return nil, err
 		}
-		entries = append(entries, dto.GlobalLeaderboardEntry{UserID: userID, Username: username, TopCount: count})
 	}
-	sort.Slice(entries, func(i, j int) bool {
-		return entries[i].TopCount > entries[j].TopCount
-	})
+	return r.db(ctx).HexLeaderboard.Query().
+		Where(entHexLeaderboard.H3IndexIn(sorted...), predicate.HexLeaderboard(forUpdate)).
+		Order(ent.Asc(entHexLeaderboard.FieldH3Index)).
+		All(ctx)
+}
 
-	if len(entries) > 10 {
-		entries = entries[:10]
+// FindByH3Indexes returns the leaderboards of the given hexes, looked up in chunks.
+func (r HexLeaderboardRepository) FindByH3Indexes(ctx context.Context, h3Indexes []string) ([]*ent.HexLeaderboard, error) {
+	var leaderboards []*ent.HexLeaderboard
+	for _, c := range chunks(len(h3Indexes)) {
+		found, err := r.db(ctx).HexLeaderboard.Query().Where(entHexLeaderboard.H3IndexIn(h3Indexes[c[0]:c[1]]...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		leaderboards = append(leaderboards, found...)
 	}
-	return entries, nil
+	return leaderboards, nil
+}
+
+// FindInRegion returns the leaderboards of the hexes inside the region.
+func (r HexLeaderboardRepository) FindInRegion(ctx context.Context, regionID uuid.UUID) ([]*ent.HexLeaderboard, error) {
+	return r.db(ctx).HexLeaderboard.Query().Where(predicate.HexLeaderboard(inRegion(regionID))).All(ctx)
+}
+
+// FindAll returns the leaderboards of all hexes.
+func (r HexLeaderboardRepository) FindAll(ctx context.Context) ([]*ent.HexLeaderboard, error) {
+	return r.db(ctx).HexLeaderboard.Query().All(ctx)
+}
+
+// DeleteAll removes the leaderboards of all hexes.
+func (r HexLeaderboardRepository) DeleteAll(ctx context.Context) (int, error) {
+	return r.db(ctx).HexLeaderboard.Delete().Exec(ctx)
 }
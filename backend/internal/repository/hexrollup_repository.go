@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"stride-wars-app/ent"
+	entHexRollup "stride-wars-app/ent/hexrollup"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/ent/predicate"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type HexRollupRepository struct {
+	client *ent.Client
+}
+
+func NewHexRollupRepository(client *ent.Client) HexRollupRepository {
+	return HexRollupRepository{client: client}
+}
+
+func (r HexRollupRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+// FindByH3Indexes returns the rollups of the given cells, looked up in chunks.
+func (r HexRollupRepository) FindByH3Indexes(ctx context.Context, h3Indexes []string) ([]*ent.HexRollup, error) {
+	var rollups []*ent.HexRollup
+	for _, c := range chunks(len(h3Indexes)) {
+		found, err := r.db(ctx).HexRollup.Query().Where(entHexRollup.H3IndexIn(h3Indexes[c[0]:c[1]]...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rollups = append(rollups, found...)
+	}
+	return rollups, nil
+}
+
+// LockByH3Indexes locks the rollups of the given cells at resolution until the end of the
+// transaction, first creating the missing ones without any owners so they can be locked too. Rows
+// are created and locked in h3_index order, so callers locking overlapping cells wait for each
+// other instead of deadlocking. It must run in a transaction.
+func (r HexRollupRepository) LockByH3Indexes(ctx context.Context, h3Indexes []string, resolution int, now time.Time) ([]*ent.HexRollup, error) {
+	sorted := slices.Clone(h3Indexes)
+	slices.Sort(sorted)
+	sorted = slices.Compact(sorted)
+	for _, c := range chunks(len(sorted)) {
+		builders := make([]*ent.HexRollupCreate, 0, c[1]-c[0])
+		for _, h3Index := range sorted[c[0]:c[1]] {
+			builders = append(builders, r.db(ctx).HexRollup.Create().
+				SetH3Index(h3Index).
+				SetResolution(resolution).
+				SetOwners([]model.RollupOwner{}).
+				SetOwnedHexes(0).
+				SetUpdatedAt(now.UTC()))
+		}
+		err := r.db(ctx).HexRollup.CreateBulk(builders...).
+			OnConflictColumns(entHexRollup.FieldH3Index).
+			DoNothing().
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return r.db(ctx).HexRollup.Query().
+		Where(entHexRollup.H3IndexIn(sorted...), predicate.HexRollup(forUpdate)).
+		Order(ent.Asc(entHexRollup.FieldH3Index)).
+		All(ctx)
+}
+
+// Exists reports whether any rollup has been stored.
+func (r HexRollupRepository) Exists(ctx context.Context) (bool, error) {
+	return r.db(ctx).HexRollup.Query().Exist(ctx)
+}
+
+// UpsertHexRollups writes the given rollups in bulk, replacing the owners of the cells that
+// already have a rollup.
+func (r HexRollupRepository) UpsertHexRollups(ctx context.Context, rollups []*model.HexRollup) error {
+	for _, c := range chunks(len(rollups)) {
+		builders := make([]*ent.HexRollupCreate, 0, c[1]-c[0])
+		for _, rollup := range rollups[c[0]:c[1]] {
+			builders = append(builders, r.db(ctx).HexRollup.Create().
+				SetID(uuid.New()).
+				SetH3Index(rollup.H3Index).
+				SetResolution(rollup.Resolution).
+				SetOwners(rollup.Owners).
+				SetOwnedHexes(rollup.OwnedHexes).
+				SetUpdatedAt(rollup.UpdatedAt.UTC()))
+		}
+		err := r.db(ctx).HexRollup.CreateBulk(builders...).
+			OnConflictColumns(entHexRollup.FieldH3Index).
+			Update(func(u *ent.HexRollupUpsert) {
+				u.UpdateOwners()
+				u.UpdateOwnedHexes()
+				u.UpdateUpdatedAt()
+			}).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r HexRollupRepository) DeleteByH3Indexes(ctx context.Context, h3Indexes []string) (int, error) {
+	deleted := 0
+	for _, c := range chunks(len(h3Indexes)) {
+		n, err := r.db(ctx).HexRollup.Delete().Where(entHexRollup.H3IndexIn(h3Indexes[c[0]:c[1]]...)).Exec(ctx)
+		if err != nil {
+			return deleted, err
+		}
+		deleted += n
+	}
+	return deleted, nil
+}
+
+// DeleteAll removes the rollups of all cells.
+func (r HexRollupRepository) DeleteAll(ctx context.Context) (int, error) {
+	return r.db(ctx).HexRollup.Delete().Exec(ctx)
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	entIdempotencyKey "stride-wars-app/ent/idempotencykey"
+	"stride-wars-app/ent/model"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type IdempotencyKeyRepository struct {
+	client *ent.Client
+}
+
+func NewIdempotencyKeyRepository(client *ent.Client) IdempotencyKeyRepository {
+	return IdempotencyKeyRepository{client: client}
+}
+
+func (r IdempotencyKeyRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+func (r IdempotencyKeyRepository) FindByUserIDAndKey(ctx context.Context, userID uuid.UUID, key string) (*ent.IdempotencyKey, error) {
+	return r.db(ctx).IdempotencyKey.Query().Where(
+		entIdempotencyKey.UserIDEQ(userID),
+		entIdempotencyKey.KeyEQ(key),
+	).First(ctx)
+}
+
+// CreateIdempotencyKey reserves a key. It fails with a constraint error if the user already holds it.
+func (r IdempotencyKeyRepository) CreateIdempotencyKey(ctx context.Context, idempotencyKey *model.IdempotencyKey) (*ent.IdempotencyKey, error) {
+	return r.db(ctx).IdempotencyKey.Create().
+		SetID(uuid.New()).
+		SetKey(idempotencyKey.Key).
+		SetUserID(idempotencyKey.UserID).
+		SetRequestHash(idempotencyKey.RequestHash).
+		SetExpiresAt(idempotencyKey.ExpiresAt).
+		Save(ctx)
+}
+
+func (r IdempotencyKeyRepository) SetResponse(ctx context.Context, id uuid.UUID, response []byte, expiresAt time.Time) error {
+	return r.db(ctx).IdempotencyKey.UpdateOneID(id).SetResponse(response).SetExpiresAt(expiresAt).Exec(ctx)
+}
+
+func (r IdempotencyKeyRepository) DeleteByID(ctx context.Context, id uuid.UUID) error {
+	return r.db(ctx).IdempotencyKey.DeleteOneID(id).Exec(ctx)
+}
+
+// DeleteIfExpired deletes the key with the given ID if it expired by now. It deletes nothing when
+// another request already took the key over, so racing requests do not fail on each other.
+func (r IdempotencyKeyRepository) DeleteIfExpired(ctx context.Context, id uuid.UUID, now time.Time) error {
+	_, err := r.db(ctx).IdempotencyKey.Delete().Where(
+		entIdempotencyKey.IDEQ(id),
+		entIdempotencyKey.ExpiresAtLTE(now),
+	).Exec(ctx)
+	return err
+}
+
+func (r IdempotencyKeyRepository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	return r.db(ctx).IdempotencyKey.Delete().Where(entIdempotencyKey.ExpiresAtLTE(now)).Exec(ctx)
+}
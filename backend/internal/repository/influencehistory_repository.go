@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	entInfluenceHistory "stride-wars-app/ent/influencehistory"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// InfluencePeriodTotals are the score changes of one time bucket.
+type InfluencePeriodTotals struct {
+	Bucket  int     `json:"bucket"`
+	Changes int64   `json:"changes"`
+	Delta   float64 `json:"delta"`
+}
+
+type InfluenceHistoryRepository struct {
+	client *ent.Client
+}
+
+func NewInfluenceHistoryRepository(client *ent.Client) InfluenceHistoryRepository {
+	return InfluenceHistoryRepository{client: client}
+}
+
+func (r InfluenceHistoryRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+// CreateInfluenceHistories appends the given score changes in bulk.
+func (r InfluenceHistoryRepository) CreateInfluenceHistories(ctx context.Context, histories []*model.InfluenceHistory) error {
+	for _, c := range chunks(len(histories)) {
+		builders := make([]*ent.InfluenceHistoryCreate, 0, c[1]-c[0])
+		for _, history := range histories[c[0]:c[1]] {
+			builders = append(builders, r.db(ctx).InfluenceHistory.Create().
+				SetID(uuid.New()).
+				SetUserID(history.UserID).
+				SetH3Index(history.H3Index).
+				SetNillableActivityID(history.ActivityID).
+				SetScoreBefore(history.ScoreBefore).
+				SetScoreAfter(history.ScoreAfter).
+				SetRecordedAt(history.RecordedAt.UTC()))
+		}
+		if err := r.db(ctx).InfluenceHistory.CreateBulk(builders...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindByUserIDAndHexID returns the user's score changes in a hex, oldest first.
+func (r InfluenceHistoryRepository) FindByUserIDAndHexID(ctx context.Context, userID uuid.UUID, h3Index string) ([]*ent.InfluenceHistory, error) {
+	return r.db(ctx).InfluenceHistory.Query().
+		Where(entInfluenceHistory.UserIDEQ(userID), entInfluenceHistory.H3IndexEQ(h3Index)).
+		Order(ent.Asc(entInfluenceHistory.FieldRecordedAt)).
+		All(ctx)
+}
+
+// AggregateByPeriod counts and sums the user's score changes per bucket, in one hex or in all of
+// them if h3Index is empty. Buckets must be sorted and contiguous. Buckets without changes are omitted.
+func (r InfluenceHistoryRepository) AggregateByPeriod(ctx context.Context, userID uuid.UUID, h3Index string, buckets []TimeBucket) ([]InfluencePeriodTotals, error) {
+	var totals []InfluencePeriodTotals
+	if len(buckets) == 0 {
+		return totals, nil
+	}
+
+	err := r.db(ctx).InfluenceHistory.Query().
+		Where(historyOf(userID, h3Index)...).
+		Modify(func(s *sql.Selector) {
+			recordedAt := sql.Expr(s.C(entInfluenceHistory.FieldRecordedAt))
+			s.Where(inBuckets(recordedAt, buckets))
+			s.Select().
+				AppendSelectExprAs(bucketCase(recordedAt, buckets), "bucket").
+				AppendSelectAs(sql.Count("*"), "changes").
+				AppendSelectExprAs(scoreDelta(s), "delta").
+				GroupBy("bucket")
+		}).
+		Scan(ctx, &totals)
+	return totals, err
+}
+
+// SumDeltaSince returns by how much the user's score changed from the given time on, in one hex
+// or in all of them if h3Index is empty.
+func (r InfluenceHistoryRepository) SumDeltaSince(ctx context.Context, userID uuid.UUID, h3Index string, since time.Time) (float64, error) {
+	var totals []struct {
+		Delta float64 `json:"delta"`
+	}
+	err := r.db(ctx).InfluenceHistory.Query().
+		Where(append(historyOf(userID, h3Index), entInfluenceHistory.RecordedAtGTE(since.UTC()))...).
+		Modify(func(s *sql.Selector) {
+			s.Select().AppendSelectExprAs(scoreDelta(s), "delta")
+		}).
+		Scan(ctx, &totals)
+	if err != nil || len(totals) == 0 {
+		return 0, err
+	}
+	return totals[0].Delta, nil
+}
+
+func historyOf(userID uuid.UUID, h3Index string) []predicate.InfluenceHistory {
+	predicates := []predicate.InfluenceHistory{entInfluenceHistory.UserIDEQ(userID)}
+	if h3Index != "" {
+		predicates = append(predicates, entInfluenceHistory.H3IndexEQ(h3Index))
+	}
+	return predicates
+}
+
+// scoreDelta sums the score changes of the selected rows, 0 if there are none.
+func scoreDelta(s *sql.Selector) sql.Querier {
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.WriteString("COALESCE(SUM(").
+			WriteString(s.C(entInfluenceHistory.FieldScoreAfter)).
+			WriteString(" - ").
+			WriteString(s.C(entInfluenceHistory.FieldScoreBefore)).
+			WriteString("), 0)")
+	})
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"ariga.io/atlas/sql/migrate"
+	atlas "ariga.io/atlas/sql/schema"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+)
+
+// uniqueIndexDedupe removes the duplicate rows a table gained before its unique index existed.
+type uniqueIndexDedupe struct {
+	table      string
+	index      string
+	statements []string
+}
+
+var uniqueIndexDedupes = []uniqueIndexDedupe{
+	{
+		// Every copy of a user's influence in a hex is given the highest score and the latest
+		// visit, then all but the copy with the smallest ID are deleted. IDs are random, so which
+		// copy is kept is arbitrary, but they are identical by then.
+		table: "hex_influences",
+		index: "hexinfluence_user_id_h3_index",
+		statements: []string{
+			`UPDATE hex_influences SET
+				score = (SELECT MAX(d.score) FROM hex_influences AS d WHERE d.user_id = hex_influences.user_id AND d.h3_index = hex_influences.h3_index),
+				last_updated = (SELECT MAX(d.last_updated) FROM hex_influences AS d WHERE d.user_id = hex_influences.user_id AND d.h3_index = hex_influences.h3_index)
+			WHERE EXISTS (SELECT 1 FROM hex_influences AS d WHERE d.user_id = hex_influences.user_id AND d.h3_index = hex_influences.h3_index AND d.id <> hex_influences.id)`,
+			`DELETE FROM hex_influences
+			WHERE EXISTS (SELECT 1 FROM hex_influences AS d WHERE d.user_id = hex_influences.user_id AND d.h3_index = hex_influences.h3_index AND d.id < hex_influences.id)`,
+		},
+	},
+	{
+		// Leaderboards are derived from the influences, so any one of a hex's copies will do
+		// until the hex is ranked again.
+		table: "hex_leaderboards",
+		index: "hexleaderboard_h3_index",
+		statements: []string{
+			`DELETE FROM hex_leaderboards
+			WHERE EXISTS (SELECT 1 FROM hex_leaderboards AS d WHERE d.h3_index = hex_leaderboards.h3_index AND d.id < hex_leaderboards.id)`,
+		},
+	},
+}
+
+// DedupeBeforeUniqueIndexes returns the schema migration options that remove duplicate influences
+// and leaderboards before the unique indexes on hex_influences(user_id, h3_index) and
+// hex_leaderboards(h3_index) are created, which would fail on databases holding duplicates
+// from before the indexes existed. The dedupe runs only while an index is missing from an
+// existing table, in the migration's own transaction.
+func DedupeBeforeUniqueIndexes() []schema.MigrateOption {
+	var pending []uniqueIndexDedupe
+	diffHook := func(next schema.Differ) schema.Differ {
+		return schema.DiffFunc(func(current, desired *atlas.Schema) ([]atlas.Change, error) {
+			pending = nil
+			for _, dedupe := range uniqueIndexDedupes {
+				table, ok := current.Table(dedupe.table)
+				if !ok {
+					continue
+				}
+				if _, ok := table.Index(dedupe.index); !ok {
+					pending = append(pending, dedupe)
+				}
+			}
+			return next.Diff(current, desired)
+		})
+	}
+	applyHook := func(next schema.Applier) schema.Applier {
+		return schema.ApplyFunc(func(ctx context.Context, conn dialect.ExecQuerier, plan *migrate.Plan) error {
+			for _, dedupe := range pending {
+				for _, statement := range dedupe.statements {
+					if err := conn.Exec(ctx, statement, []any{}, nil); err != nil {
+						return fmt.Errorf("deduplicating %s before creating %s: %w", dedupe.table, dedupe.index, err)
+					}
+				}
+			}
+			return next.Apply(ctx, conn, plan)
+		})
+	}
+	return []schema.MigrateOption{schema.WithDiffHook(diffHook), schema.WithApplyHook(applyHook)}
+}
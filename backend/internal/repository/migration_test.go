@@ -0,0 +1,85 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/repository"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDedupeBeforeUniqueIndexes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	drv, err := entsql.Open("sqlite3", fmt.Sprintf("file:ent_%s?mode=memory&cache=private&_fk=1", uuid.New().String()))
+	require.NoError(t, err)
+	// Every connection to a private in-memory database sees its own empty database.
+	drv.DB().SetMaxOpenConns(1)
+	client := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() { _ = client.Close() })
+	require.NoError(t, client.Schema.Create(ctx))
+
+	// A database from before the unique indexes existed
+	_, err = drv.DB().ExecContext(ctx, "DROP INDEX hexinfluence_user_id_h3_index")
+	require.NoError(t, err)
+	_, err = drv.DB().ExecContext(ctx, "DROP INDEX hexleaderboard_h3_index")
+	require.NoError(t, err)
+
+	h3Index := "891e2e6b153ffff"
+	_, err = client.Hex.Create().SetID(h3Index).Save(ctx)
+	require.NoError(t, err)
+	user, err := client.User.Create().SetUsername("alice").SetExternalUser(uuid.New()).Save(ctx)
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	for _, influence := range []struct {
+		score       float64
+		lastUpdated time.Time
+	}{
+		{3, now.Add(-2 * time.Hour)},
+		{1, now.Add(-time.Hour)},
+		{2, now.Add(-3 * time.Hour)},
+	} {
+		_, err := client.HexInfluence.Create().
+			SetUserID(user.ID).
+			SetH3Index(h3Index).
+			SetScore(influence.score).
+			SetLastUpdated(influence.lastUpdated).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+	for range 2 {
+		_, err := client.HexLeaderboard.Create().SetH3Index(h3Index).SetTopUsers([]model.TopUser{}).Save(ctx)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, client.Schema.Create(ctx, repository.DedupeBeforeUniqueIndexes()...))
+
+	influences, err := client.HexInfluence.Query().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, influences, 1)
+	require.Equal(t, 3.0, influences[0].Score)
+	require.True(t, now.Add(-time.Hour).Equal(influences[0].LastUpdated))
+
+	leaderboards, err := client.HexLeaderboard.Query().Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, leaderboards)
+
+	// The unique indexes are in place again
+	_, err = client.HexInfluence.Create().
+		SetUserID(user.ID).
+		SetH3Index(h3Index).
+		SetScore(1).
+		SetLastUpdated(now).
+		Save(ctx)
+	require.True(t, ent.IsConstraintError(err))
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	entNotification "stride-wars-app/ent/notification"
+	"stride-wars-app/ent/predicate"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationRepository struct {
+	client *ent.Client
+}
+
+func NewNotificationRepository(client *ent.Client) NotificationRepository {
+	return NotificationRepository{client: client}
+}
+
+func (r NotificationRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+func (r NotificationRepository) FindByID(ctx context.Context, id uuid.UUID) (*ent.Notification, error) {
+	return r.db(ctx).Notification.Get(ctx, id)
+}
+
+// CreateNotifications stores the given notifications in bulk.
+func (r NotificationRepository) CreateNotifications(ctx context.Context, notifications []*model.Notification) error {
+	for _, c := range chunks(len(notifications)) {
+		builders := make([]*ent.NotificationCreate, 0, c[1]-c[0])
+		for _, notification := range notifications[c[0]:c[1]] {
+			builders = append(builders, r.db(ctx).Notification.Create().
+				SetID(notification.ID).
+				SetUserID(notification.UserID).
+				SetType(entNotification.Type(notification.NotificationType)).
+				SetMessage(notification.Message).
+				SetData(notification.Data).
+				SetCreatedAt(notification.CreatedAt.UTC()))
+		}
+		if err := r.db(ctx).Notification.CreateBulk(builders...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindPageByUserID returns up to limit of the user's notifications after skipping offset, newest
+// first, optionally only the unread ones.
+func (r NotificationRepository) FindPageByUserID(ctx context.Context, userID uuid.UUID, unreadOnly bool, limit, offset int) ([]*ent.Notification, error) {
+	return r.db(ctx).Notification.Query().
+		Where(inboxOf(userID, unreadOnly)...).
+		Order(ent.Desc(entNotification.FieldCreatedAt), ent.Desc(entNotification.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+}
+
+func (r NotificationRepository) CountByUserID(ctx context.Context, userID uuid.UUID, unreadOnly bool) (int, error) {
+	return r.db(ctx).Notification.Query().
+		Where(inboxOf(userID, unreadOnly)...).
+		Count(ctx)
+}
+
+// MarkRead marks the user's notification as read unless it already was. It reports how many
+// notifications it changed.
+func (r NotificationRepository) MarkRead(ctx context.Context, userID uuid.UUID, id uuid.UUID, now time.Time) (int, error) {
+	return r.db(ctx).Notification.Update().
+		Where(append(inboxOf(userID, true), entNotification.IDEQ(id))...).
+		SetReadAt(now.UTC()).
+		Save(ctx)
+}
+
+// MarkAllRead marks all the user's unread notifications as read and returns how many there were.
+func (r NotificationRepository) MarkAllRead(ctx context.Context, userID uuid.UUID, now time.Time) (int, error) {
+	return r.db(ctx).Notification.Update().
+		Where(inboxOf(userID, true)...).
+		SetReadAt(now.UTC()).
+		Save(ctx)
+}
+
+func (r NotificationRepository) DeleteByID(ctx context.Context, id uuid.UUID) error {
+	return r.db(ctx).Notification.DeleteOneID(id).Exec(ctx)
+}
+
+func inboxOf(userID uuid.UUID, unreadOnly bool) []predicate.Notification {
+	predicates := []predicate.Notification{entNotification.UserIDEQ(userID)}
+	if unreadOnly {
+		predicates = append(predicates, entNotification.ReadAtIsNil())
+	}
+	return predicates
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	entNotificationPreference "stride-wars-app/ent/notificationpreference"
+
+	"github.com/google/uuid"
+)
+
+type NotificationPreferenceRepository struct {
+	client *ent.Client
+}
+
+func NewNotificationPreferenceRepository(client *ent.Client) NotificationPreferenceRepository {
+	return NotificationPreferenceRepository{client: client}
+}
+
+func (r NotificationPreferenceRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+func (r NotificationPreferenceRepository) FindByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]*ent.NotificationPreference, error) {
+	return r.db(ctx).NotificationPreference.Query().
+		Where(entNotificationPreference.UserIDIn(userIDs...)).
+		All(ctx)
+}
+
+// UpsertPreferences creates or overwrites the given preferences in bulk.
+func (r NotificationPreferenceRepository) UpsertPreferences(ctx context.Context, preferences []*model.NotificationPreference) error {
+	for _, c := range chunks(len(preferences)) {
+		builders := make([]*ent.NotificationPreferenceCreate, 0, c[1]-c[0])
+		for _, preference := range preferences[c[0]:c[1]] {
+			builders = append(builders, r.db(ctx).NotificationPreference.Create().
+				SetID(uuid.New()).
+				SetUserID(preference.UserID).
+				SetType(entNotificationPreference.Type(preference.NotificationType)).
+				SetEnabled(preference.Enabled))
+		}
+		err := r.db(ctx).NotificationPreference.CreateBulk(builders...).
+			OnConflictColumns(entNotificationPreference.FieldUserID, entNotificationPreference.FieldType).
+			Update(func(u *ent.NotificationPreferenceUpsert) {
+				u.UpdateEnabled()
+			}).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	entPersonalRecord "stride-wars-app/ent/personalrecord"
+
+	"github.com/google/uuid"
+)
+
+type PersonalRecordRepository struct {
+	client *ent.Client
+}
+
+func NewPersonalRecordRepository(client *ent.Client) PersonalRecordRepository {
+	return PersonalRecordRepository{client: client}
+}
+
+func (r PersonalRecordRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+func (r PersonalRecordRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*ent.PersonalRecord, error) {
+	return r.db(ctx).PersonalRecord.Query().Where(entPersonalRecord.UserIDEQ(userID)).All(ctx)
+}
+
+func (r PersonalRecordRepository) FindByUserIDAndActivityType(ctx context.Context, userID uuid.UUID, activityType string) ([]*ent.PersonalRecord, error) {
+	return r.db(ctx).PersonalRecord.Query().Where(
+		entPersonalRecord.UserIDEQ(userID),
+		entPersonalRecord.ActivityTypeEQ(entPersonalRecord.ActivityType(activityType)),
+	).All(ctx)
+}
+
+func (r PersonalRecordRepository) FindByActivityID(ctx context.Context, activityID uuid.UUID) ([]*ent.PersonalRecord, error) {
+	return r.db(ctx).PersonalRecord.Query().Where(entPersonalRecord.ActivityIDEQ(activityID)).All(ctx)
+}
+
+func (r PersonalRecordRepository) CreatePersonalRecord(ctx context.Context, record *model.PersonalRecord) (*ent.PersonalRecord, error) {
+	return r.db(ctx).PersonalRecord.Create().
+		SetID(uuid.New()).
+		SetUserID(record.UserID).
+		SetActivityType(entPersonalRecord.ActivityType(record.ActivityType)).
+		SetRecord(entPersonalRecord.Record(record.Record)).
+		SetValue(record.Value).
+		SetActivityID(record.ActivityID).
+		SetAchievedAt(record.AchievedAt).
+		Save(ctx)
+}
+
+func (r PersonalRecordRepository) UpdatePersonalRecord(ctx context.Context, record *model.PersonalRecord) (*ent.PersonalRecord, error) {
+	return r.db(ctx).PersonalRecord.UpdateOneID(record.ID).
+		SetValue(record.Value).
+		SetActivityID(record.ActivityID).
+		SetAchievedAt(record.AchievedAt).
+		Save(ctx)
+}
+
+func (r PersonalRecordRepository) DeleteByID(ctx context.Context, id uuid.UUID) error {
+	return r.db(ctx).PersonalRecord.DeleteOneID(id).Exec(ctx)
+}
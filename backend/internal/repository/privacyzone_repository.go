@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	entPrivacyZone "stride-wars-app/ent/privacyzone"
+
+	"github.com/google/uuid"
+)
+
+type PrivacyZoneRepository struct {
+	client *ent.Client
+}
+
+func NewPrivacyZoneRepository(client *ent.Client) PrivacyZoneRepository {
+	return PrivacyZoneRepository{client: client}
+}
+
+func (r PrivacyZoneRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+func (r PrivacyZoneRepository) FindByID(ctx context.Context, id uuid.UUID) (*ent.PrivacyZone, error) {
+	return r.db(ctx).PrivacyZone.Query().Where(entPrivacyZone.IDEQ(id)).First(ctx)
+}
+
+func (r PrivacyZoneRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*ent.PrivacyZone, error) {
+	return r.db(ctx).PrivacyZone.Query().
+		Where(entPrivacyZone.UserIDEQ(userID)).
+		Order(ent.Asc(entPrivacyZone.FieldCreatedAt)).
+		All(ctx)
+}
+
+func (r PrivacyZoneRepository) FindByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]*ent.PrivacyZone, error) {
+	return r.db(ctx).PrivacyZone.Query().Where(entPrivacyZone.UserIDIn(userIDs...)).All(ctx)
+}
+
+func (r PrivacyZoneRepository) CountByUserID(ctx context.Context, userID uuid.UUID) (int, error) {
+	return r.db(ctx).PrivacyZone.Query().Where(entPrivacyZone.UserIDEQ(userID)).Count(ctx)
+}
+
+func (r PrivacyZoneRepository) CreatePrivacyZone(ctx context.Context, zone *model.PrivacyZone) (*ent.PrivacyZone, error) {
+	return r.db(ctx).PrivacyZone.Create().
+		SetID(uuid.New()).
+		SetUserID(zone.UserID).
+		SetName(zone.Name).
+		SetLat(zone.Lat).
+		SetLng(zone.Lng).
+		SetRadiusMeters(zone.RadiusMeters).
+		SetH3Indexes(zone.H3Indexes).
+		Save(ctx)
+}
+
+func (r PrivacyZoneRepository) UpdatePrivacyZone(ctx context.Context, zone *model.PrivacyZone) (*ent.PrivacyZone, error) {
+	return r.db(ctx).PrivacyZone.UpdateOneID(zone.ID).
+		SetName(zone.Name).
+		SetLat(zone.Lat).
+		SetLng(zone.Lng).
+		SetRadiusMeters(zone.RadiusMeters).
+		SetH3Indexes(zone.H3Indexes).
+		Save(ctx)
+}
+
+func (r PrivacyZoneRepository) DeleteByID(ctx context.Context, id uuid.UUID) error {
+	return r.db(ctx).PrivacyZone.DeleteOneID(id).Exec(ctx)
+}
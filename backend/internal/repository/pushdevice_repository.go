@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	entPushDevice "stride-wars-app/ent/pushdevice"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PushDeviceRepository struct {
+	client *ent.Client
+}
+
+func NewPushDeviceRepository(client *ent.Client) PushDeviceRepository {
+	return PushDeviceRepository{client: client}
+}
+
+func (r PushDeviceRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+func (r PushDeviceRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*ent.PushDevice, error) {
+	return r.db(ctx).PushDevice.Query().
+		Where(entPushDevice.UserIDEQ(userID)).
+		Order(ent.Asc(entPushDevice.FieldCreatedAt)).
+		All(ctx)
+}
+
+func (r PushDeviceRepository) FindByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]*ent.PushDevice, error) {
+	return r.db(ctx).PushDevice.Query().Where(entPushDevice.UserIDIn(userIDs...)).All(ctx)
+}
+
+// FindUserIDsWithDevices returns which of the given users have at least one device.
+func (r PushDeviceRepository) FindUserIDsWithDevices(ctx context.Context, userIDs []uuid.UUID) ([]uuid.UUID, error) {
+	var withDevices []uuid.UUID
+	err := r.db(ctx).PushDevice.Query().
+		Where(entPushDevice.UserIDIn(userIDs...)).
+		Unique(true).
+		Select(entPushDevice.FieldUserID).
+		Scan(ctx, &withDevices)
+	return withDevices, err
+}
+
+// UpsertPushDevice registers a user's device, or gives it a new token if it is already registered.
+func (r PushDeviceRepository) UpsertPushDevice(ctx context.Context, device *model.PushDevice, now time.Time) (*ent.PushDevice, error) {
+	err := r.db(ctx).PushDevice.Create().
+		SetID(uuid.New()).
+		SetUserID(device.UserID).
+		SetDeviceID(device.DeviceID).
+		SetToken(device.Token).
+		SetCreatedAt(now.UTC()).
+		SetUpdatedAt(now.UTC()).
+		OnConflictColumns(entPushDevice.FieldUserID, entPushDevice.FieldDeviceID).
+		Update(func(u *ent.PushDeviceUpsert) {
+			u.UpdateToken()
+			u.UpdateUpdatedAt()
+		}).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.db(ctx).PushDevice.Query().
+		Where(entPushDevice.UserIDEQ(device.UserID), entPushDevice.DeviceIDEQ(device.DeviceID)).
+		Only(ctx)
+}
+
+// DeleteOtherDevicesWithToken removes the registrations of a token other than the given user's
+// device, left behind when the device was handed over or its app reinstalled.
+func (r PushDeviceRepository) DeleteOtherDevicesWithToken(ctx context.Context, token string, userID uuid.UUID, deviceID string) (int, error) {
+	return r.db(ctx).PushDevice.Delete().
+		Where(
+			entPushDevice.TokenEQ(token),
+			entPushDevice.Not(entPushDevice.And(entPushDevice.UserIDEQ(userID), entPushDevice.DeviceIDEQ(deviceID))),
+		).
+		Exec(ctx)
+}
+
+func (r PushDeviceRepository) DeleteByUserIDAndDeviceID(ctx context.Context, userID uuid.UUID, deviceID string) (int, error) {
+	return r.db(ctx).PushDevice.Delete().
+		Where(entPushDevice.UserIDEQ(userID), entPushDevice.DeviceIDEQ(deviceID)).
+		Exec(ctx)
+}
+
+func (r PushDeviceRepository) DeleteByTokens(ctx context.Context, tokens []string) (int, error) {
+	return r.db(ctx).PushDevice.Delete().Where(entPushDevice.TokenIn(tokens...)).Exec(ctx)
+}
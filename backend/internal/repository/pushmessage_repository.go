@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/ent/predicate"
+	entPushMessage "stride-wars-app/ent/pushmessage"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PushMessageRepository struct {
+	client *ent.Client
+}
+
+func NewPushMessageRepository(client *ent.Client) PushMessageRepository {
+	return PushMessageRepository{client: client}
+}
+
+func (r PushMessageRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+// CreatePushMessages queues the given messages in bulk.
+func (r PushMessageRepository) CreatePushMessages(ctx context.Context, messages []*model.PushMessage) error {
+	for _, c := range chunks(len(messages)) {
+		builders := make([]*ent.PushMessageCreate, 0, c[1]-c[0])
+		for _, message := range messages[c[0]:c[1]] {
+			builders = append(builders, r.db(ctx).PushMessage.Create().
+				SetID(uuid.New()).
+				SetUserID(message.UserID).
+				SetNotificationID(message.NotificationID).
+				SetTitle(message.Title).
+				SetBody(message.Body).
+				SetData(message.Data).
+				SetCreatedAt(message.CreatedAt.UTC()).
+				SetUpdatedAt(message.CreatedAt.UTC()))
+		}
+		if err := r.db(ctx).PushMessage.CreateBulk(builders...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindDue returns up to limit of the oldest pending messages whose retry is due and messages
+// whose sender's lock has run out, skipping the ones locked by another transaction.
+func (r PushMessageRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*ent.PushMessage, error) {
+	return r.db(ctx).PushMessage.Query().
+		Where(
+			entPushMessage.Or(
+				entPushMessage.And(
+					entPushMessage.StatusEQ(entPushMessage.Status(model.PushMessagePending)),
+					entPushMessage.Or(entPushMessage.LockedUntilIsNil(), entPushMessage.LockedUntilLT(now.UTC())),
+				),
+				entPushMessage.And(
+					entPushMessage.StatusEQ(entPushMessage.Status(model.PushMessageSending)),
+					entPushMessage.LockedUntilLT(now.UTC()),
+				),
+			),
+			predicate.PushMessage(skipLocked),
+		).
+		Order(ent.Asc(entPushMessage.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+}
+
+// ClaimPushMessages marks messages found by FindDue as being sent until lockedUntil.
+func (r PushMessageRepository) ClaimPushMessages(ctx context.Context, ids []uuid.UUID, now, lockedUntil time.Time) (int, error) {
+	return r.db(ctx).PushMessage.Update().
+		Where(entPushMessage.IDIn(ids...)).
+		SetStatus(entPushMessage.Status(model.PushMessageSending)).
+		AddAttempts(1).
+		SetLockedUntil(lockedUntil.UTC()).
+		SetUpdatedAt(now.UTC()).
+		Save(ctx)
+}
+
+// FinishPushMessages moves claimed messages to a final status.
+func (r PushMessageRepository) FinishPushMessages(ctx context.Context, ids []uuid.UUID, status string, lastError *string, now time.Time) (int, error) {
+	return r.db(ctx).PushMessage.Update().
+		Where(
+			entPushMessage.IDIn(ids...),
+			entPushMessage.StatusEQ(entPushMessage.Status(model.PushMessageSending)),
+		).
+		SetStatus(entPushMessage.Status(status)).
+		ClearLockedUntil().
+		SetNillableLastError(lastError).
+		SetUpdatedAt(now.UTC()).
+		Save(ctx)
+}
+
+// RetryPushMessage puts a claimed message back in the queue, to be sent again from retryAt.
+func (r PushMessageRepository) RetryPushMessage(ctx context.Context, id uuid.UUID, lastError string, retryAt, now time.Time) (int, error) {
+	return r.db(ctx).PushMessage.Update().
+		Where(
+			entPushMessage.IDEQ(id),
+			entPushMessage.StatusEQ(entPushMessage.Status(model.PushMessageSending)),
+		).
+		SetStatus(entPushMessage.Status(model.PushMessagePending)).
+		SetLockedUntil(retryAt.UTC()).
+		SetLastError(lastError).
+		SetUpdatedAt(now.UTC()).
+		Save(ctx)
+}
+
+// DeleteFinishedBefore removes sent, skipped and failed messages last updated before the given time.
+func (r PushMessageRepository) DeleteFinishedBefore(ctx context.Context, before time.Time) (int, error) {
+	return r.db(ctx).PushMessage.Delete().Where(
+		entPushMessage.StatusIn(
+			entPushMessage.Status(model.PushMessageSent),
+			entPushMessage.Status(model.PushMessageSkipped),
+			entPushMessage.Status(model.PushMessageFailed),
+		),
+		entPushMessage.UpdatedAtLT(before.UTC()),
+	).Exec(ctx)
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	entRegion "stride-wars-app/ent/region"
+	entRegionHex "stride-wars-app/ent/regionhex"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+type RegionRepository struct {
+	client *ent.Client
+}
+
+func NewRegionRepository(client *ent.Client) RegionRepository {
+	return RegionRepository{client: client}
+}
+
+func (r RegionRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+func (r RegionRepository) FindBySlug(ctx context.Context, slug string) (*ent.Region, error) {
+	return r.db(ctx).Region.Query().Where(entRegion.SlugEQ(slug)).Only(ctx)
+}
+
+// FindAll returns all regions, or only those of the given kind when it is not empty, by name.
+func (r RegionRepository) FindAll(ctx context.Context, kind string) ([]*ent.Region, error) {
+	query := r.db(ctx).Region.Query()
+	if kind != "" {
+		query = query.Where(entRegion.KindEQ(kind))
+	}
+	return query.Order(ent.Asc(entRegion.FieldName), ent.Asc(entRegion.FieldSlug)).All(ctx)
+}
+
+// FindByH3Index returns the regions containing the cell, by name.
+func (r RegionRepository) FindByH3Index(ctx context.Context, h3Index string) ([]*ent.Region, error) {
+	return r.db(ctx).Region.Query().
+		Where(entRegion.HasHexesWith(entRegionHex.H3IndexEQ(h3Index))).
+		Order(ent.Asc(entRegion.FieldName), ent.Asc(entRegion.FieldSlug)).
+		All(ctx)
+}
+
+// UpsertRegion creates the region with the given slug, or renames and updates the existing one.
+func (r RegionRepository) UpsertRegion(ctx context.Context, region *model.Region, now time.Time) (*ent.Region, error) {
+	id, err := r.db(ctx).Region.Create().
+		SetID(uuid.New()).
+		SetSlug(region.Slug).
+		SetName(region.Name).
+		SetKind(region.Kind).
+		SetHexCount(region.HexCount).
+		SetCreatedAt(now.UTC()).
+		SetUpdatedAt(now.UTC()).
+		OnConflictColumns(entRegion.FieldSlug).
+		Update(func(u *ent.RegionUpsert) {
+			u.UpdateName()
+			u.UpdateKind()
+			u.UpdateHexCount()
+			u.UpdateUpdatedAt()
+		}).
+		ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.db(ctx).Region.Get(ctx, id)
+}
+
+func (r RegionRepository) SetStandingsRefreshedAt(ctx context.Context, id uuid.UUID, refreshedAt time.Time) error {
+	return r.db(ctx).Region.UpdateOneID(id).SetStandingsRefreshedAt(refreshedAt.UTC()).Exec(ctx)
+}
+
+// inRegion selects the rows of a table keyed by h3_index whose cell is inside the region.
+func inRegion(regionID uuid.UUID) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		t := sql.Table(entRegionHex.Table)
+		s.Where(sql.In(
+			s.C(entRegionHex.FieldH3Index),
+			sql.Select(t.C(entRegionHex.FieldH3Index)).From(t).Where(sql.EQ(t.C(entRegionHex.FieldRegionID), regionID)),
+		))
+	}
+}
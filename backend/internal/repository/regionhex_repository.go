@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	entRegionHex "stride-wars-app/ent/regionhex"
+
+	"github.com/google/uuid"
+)
+
+type RegionHexRepository struct {
+	client *ent.Client
+}
+
+func NewRegionHexRepository(client *ent.Client) RegionHexRepository {
+	return RegionHexRepository{client: client}
+}
+
+func (r RegionHexRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+// ReplaceRegionHexes replaces the cells of a region with the given ones.
+func (r RegionHexRepository) ReplaceRegionHexes(ctx context.Context, regionID uuid.UUID, h3Indexes []string) error {
+	if _, err := r.db(ctx).RegionHex.Delete().Where(entRegionHex.RegionIDEQ(regionID)).Exec(ctx); err != nil {
+		return err
+	}
+	for _, c := range chunks(len(h3Indexes)) {
+		builders := make([]*ent.RegionHexCreate, 0, c[1]-c[0])
+		for _, h3Index := range h3Indexes[c[0]:c[1]] {
+			builders = append(builders, r.db(ctx).RegionHex.Create().
+				SetID(uuid.New()).
+				SetRegionID(regionID).
+				SetH3Index(h3Index))
+		}
+		if err := r.db(ctx).RegionHex.CreateBulk(builders...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
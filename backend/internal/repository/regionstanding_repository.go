@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	entRegionStanding "stride-wars-app/ent/regionstanding"
+
+	"github.com/google/uuid"
+)
+
+type RegionStandingRepository struct {
+	client *ent.Client
+}
+
+func NewRegionStandingRepository(client *ent.Client) RegionStandingRepository {
+	return RegionStandingRepository{client: client}
+}
+
+func (r RegionStandingRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+// ReplaceRegionStandings replaces the standings of a region with the given ones.
+func (r RegionStandingRepository) ReplaceRegionStandings(ctx context.Context, regionID uuid.UUID, standings []*model.RegionStanding) error {
+	if _, err := r.db(ctx).RegionStanding.Delete().Where(entRegionStanding.RegionIDEQ(regionID)).Exec(ctx); err != nil {
+		return err
+	}
+	for _, c := range chunks(len(standings)) {
+		builders := make([]*ent.RegionStandingCreate, 0, c[1]-c[0])
+		for _, standing := range standings[c[0]:c[1]] {
+			builders = append(builders, r.db(ctx).RegionStanding.Create().
+				SetID(uuid.New()).
+				SetRegionID(regionID).
+				SetRank(standing.Rank).
+				SetUserID(standing.UserID).
+				SetUsername(standing.Username).
+				SetHexesOwned(standing.HexesOwned).
+				SetTotalInfluence(standing.TotalInfluence))
+		}
+		if err := r.db(ctx).RegionStanding.CreateBulk(builders...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindPageByRegionID returns a page of a region's standings, best rank first.
+func (r RegionStandingRepository) FindPageByRegionID(ctx context.Context, regionID uuid.UUID, limit, offset int) ([]*ent.RegionStanding, error) {
+	return r.db(ctx).RegionStanding.Query().
+		Where(entRegionStanding.RegionIDEQ(regionID)).
+		Order(ent.Asc(entRegionStanding.FieldRank)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+}
+
+func (r RegionStandingRepository) CountByRegionID(ctx context.Context, regionID uuid.UUID) (int, error) {
+	return r.db(ctx).RegionStanding.Query().Where(entRegionStanding.RegionIDEQ(regionID)).Count(ctx)
+}
+
+func (r RegionStandingRepository) FindByRegionIDAndUserID(ctx context.Context, regionID, userID uuid.UUID) (*ent.RegionStanding, error) {
+	return r.db(ctx).RegionStanding.Query().
+		Where(entRegionStanding.RegionIDEQ(regionID), entRegionStanding.UserIDEQ(userID)).
+		Only(ctx)
+}
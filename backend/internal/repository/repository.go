@@ -2,31 +2,207 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"stride-wars-app/ent"
 	"stride-wars-app/ent/model"
+	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
+// MaxTxAttempts is how many times WithRetry runs a transaction that keeps failing transiently.
+const MaxTxAttempts = 3
+
+// txRetryBackoff is the pause before the second attempt. It doubles with every further attempt.
+const txRetryBackoff = 20 * time.Millisecond
+
+// bulkChunkSize caps the rows written by a single bulk statement, keeping it well below the
+// bind parameter limits of both Postgres and SQLite.
+const bulkChunkSize = 1000
+
 type Repositories struct {
-	UserRepository           UserRepository
-	ActivityRepository       ActivityRepository
-	HexRepository            HexRepository
-	HexInfluenceRepository   HexInfluenceRepository
-	HexLeaderboardRepository HexLeaderboardRepository
+	Transactor                 Transactor
+	UserRepository             UserRepository
+	ActivityRepository         ActivityRepository
+	HexRepository              HexRepository
+	HexInfluenceRepository     HexInfluenceRepository
+	HexLeaderboardRepository   HexLeaderboardRepository
+	IdempotencyKeyRepository   IdempotencyKeyRepository
+	ActivitySessionRepository  ActivitySessionRepository
+	ActivityHexRepository      ActivityHexRepository
+	StreakRepository           StreakRepository
+	GoalRepository             GoalRepository
+	PersonalRecordRepository   PersonalRecordRepository
+	PrivacyZoneRepository      PrivacyZoneRepository
+	SegmentRepository          SegmentRepository
+	SegmentEffortRepository    SegmentEffortRepository
+	ActivityJobRepository      ActivityJobRepository
+	DecaySweepRepository       DecaySweepRepository
+	InfluenceHistoryRepository InfluenceHistoryRepository
+	HexCaptureRepository       HexCaptureRepository
+	NotificationRepository     NotificationRepository
+
+	NotificationPreferenceRepository NotificationPreferenceRepository
+	PushDeviceRepository             PushDeviceRepository
+	PushMessageRepository            PushMessageRepository
+	WebhookSubscriptionRepository    WebhookSubscriptionRepository
+	WebhookDeliveryRepository        WebhookDeliveryRepository
+	SeasonRepository                 SeasonRepository
+	SeasonStandingRepository         SeasonStandingRepository
+	SeasonHexStandingRepository      SeasonHexStandingRepository
+	HexRollupRepository              HexRollupRepository
+	RegionRepository                 RegionRepository
+	RegionHexRepository              RegionHexRepository
+	RegionStandingRepository         RegionStandingRepository
+	SettingRepository                SettingRepository
 	// FriendshipRepository *FriendshipRepository
 }
 
 func Provide(client *ent.Client) *Repositories {
 	return &Repositories{
-		UserRepository:           NewUserRepository(client),
-		ActivityRepository:       NewActivityRepository(client),
-		HexRepository:            NewHexRepository(client),
-		HexInfluenceRepository:   NewHexInfluenceRepository(client),
-		HexLeaderboardRepository: NewHexLeaderboardRepository(client),
+		Transactor:                 NewTransactor(client),
+		UserRepository:             NewUserRepository(client),
+		ActivityRepository:         NewActivityRepository(client),
+		HexRepository:              NewHexRepository(client),
+		HexInfluenceRepository:     NewHexInfluenceRepository(client),
+		HexLeaderboardRepository:   NewHexLeaderboardRepository(client),
+		IdempotencyKeyRepository:   NewIdempotencyKeyRepository(client),
+		ActivitySessionRepository:  NewActivitySessionRepository(client),
+		ActivityHexRepository:      NewActivityHexRepository(client),
+		StreakRepository:           NewStreakRepository(client),
+		GoalRepository:             NewGoalRepository(client),
+		PersonalRecordRepository:   NewPersonalRecordRepository(client),
+		PrivacyZoneRepository:      NewPrivacyZoneRepository(client),
+		SegmentRepository:          NewSegmentRepository(client),
+		SegmentEffortRepository:    NewSegmentEffortRepository(client),
+		ActivityJobRepository:      NewActivityJobRepository(client),
+		DecaySweepRepository:       NewDecaySweepRepository(client),
+		InfluenceHistoryRepository: NewInfluenceHistoryRepository(client),
+		HexCaptureRepository:       NewHexCaptureRepository(client),
+		NotificationRepository:     NewNotificationRepository(client),
+
+		NotificationPreferenceRepository: NewNotificationPreferenceRepository(client),
+		PushDeviceRepository:             NewPushDeviceRepository(client),
+		PushMessageRepository:            NewPushMessageRepository(client),
+		WebhookSubscriptionRepository:    NewWebhookSubscriptionRepository(client),
+		WebhookDeliveryRepository:        NewWebhookDeliveryRepository(client),
+		SeasonRepository:                 NewSeasonRepository(client),
+		SeasonStandingRepository:         NewSeasonStandingRepository(client),
+		SeasonHexStandingRepository:      NewSeasonHexStandingRepository(client),
+		HexRollupRepository:              NewHexRollupRepository(client),
+		RegionRepository:                 NewRegionRepository(client),
+		RegionHexRepository:              NewRegionHexRepository(client),
+		RegionStandingRepository:         NewRegionStandingRepository(client),
+		SettingRepository:                NewSettingRepository(client),
+	}
+}
+
+// Transactor runs units of work inside a single database transaction.
+type Transactor struct {
+	client *ent.Client
+}
+
+func NewTransactor(client *ent.Client) Transactor {
+	return Transactor{client: client}
+}
+
+// WithTx runs fn inside a transaction. Repositories called with the context passed to fn
+// use the transactional client, so all their reads and writes commit or roll back together.
+// If ctx already carries a transaction, fn joins it instead of starting a new one.
+func (t Transactor) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ent.TxFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	tx, err := t.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := fn(ent.NewTxContext(ctx, tx)); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// WithRetry runs fn in a transaction like WithTx, and runs it again in a fresh transaction
+// when it fails with a transient error. fn must not have side effects outside the database.
+// Joining a transaction already in ctx is never retried, as only its owner can restart it.
+func (t Transactor) WithRetry(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ent.TxFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	backoff := txRetryBackoff
+	for attempt := 1; ; attempt++ {
+		err := t.WithTx(ctx, fn)
+		if err == nil || attempt == MaxTxAttempts || !IsTransientError(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
 	}
 }
 
+// ErrInsertRace marks a row that could not be inserted because a concurrent transaction inserted
+// it first. WithRetry runs the transaction again, which then finds the row.
+var ErrInsertRace = errors.New("row was inserted by a concurrent transaction")
+
+// IsTransientError reports whether a transaction failed only because it conflicted with a
+// concurrent one: a serialization failure, a deadlock, or an ErrInsertRace.
+func IsTransientError(err error) bool {
+	if errors.Is(err, ErrInsertRace) {
+		return true
+	}
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return false
+	}
+	switch pqErr.Code {
+	case "40001", // serialization_failure
+		"40P01": // deadlock_detected
+		return true
+	}
+	return false
+}
+
+// InsertRace wraps a unique violation from an insert with ErrInsertRace. Callers use it only for
+// inserts of a row they looked up and did not find, as a duplicate the request itself causes
+// would fail again on every attempt.
+func InsertRace(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" { // unique_violation
+		return fmt.Errorf("%w: %v", ErrInsertRace, err)
+	}
+	return err
+}
+
+// clientFromContext returns the transactional client stored in ctx by WithTx, or client otherwise.
+func clientFromContext(ctx context.Context, client *ent.Client) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+	return client
+}
+
 type IUserRepository interface {
 	FindByID(ctx context.Context, uuid uuid.UUID) (*ent.User, error)
 	FindByIDs(ctx context.Context, uuids []uuid.UUID) ([]*ent.User, error)
@@ -72,3 +248,29 @@ type IHexLeaderboardRepository interface {
 }
 
 type IFriendship interface{}
+
+// chunks splits n rows into consecutive [start, end) ranges of at most bulkChunkSize rows.
+func chunks(n int) [][2]int {
+	var ranges [][2]int
+	for start := 0; start < n; start += bulkChunkSize {
+		ranges = append(ranges, [2]int{start, min(start+bulkChunkSize, n)})
+	}
+	return ranges
+}
+
+// forUpdate locks the selected rows until the end of the transaction. SQLite has no row locks
+// and serializes writers instead, so it is left out there.
+func forUpdate(s *sql.Selector) {
+	if s.Dialect() == dialect.Postgres {
+		s.ForUpdate()
+	}
+}
+
+// skipLocked locks the selected rows and skips rows locked by other transactions. SQLite has no
+// row locks and serializes writers instead, so it is left out there and callers must make sure
+// their updates still apply, for example by checking the row did not change since it was read.
+func skipLocked(s *sql.Selector) {
+	if s.Dialect() == dialect.Postgres {
+		s.ForUpdate(sql.WithLockAction(sql.SkipLocked))
+	}
+}
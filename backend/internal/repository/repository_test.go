@@ -0,0 +1,113 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/repository"
+	"stride-wars-app/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("rolls back every write when the transaction fails", func(t *testing.T) {
+		t.Parallel()
+		svc := testutil.NewTestServices(t)
+		transactor := repository.NewTransactor(svc.Client)
+
+		failure := errors.New("boom")
+		err := transactor.WithTx(svc.Ctx, func(ctx context.Context) error {
+			if _, err := svc.UserRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()}); err != nil {
+				return err
+			}
+			return failure
+		})
+		require.ErrorIs(t, err, failure)
+
+		_, err = svc.UserRepo.FindByUsername(svc.Ctx, "alice")
+		require.Error(t, err)
+	})
+
+	t.Run("retries transient failures in a fresh transaction", func(t *testing.T) {
+		t.Parallel()
+		svc := testutil.NewTestServices(t)
+		transactor := repository.NewTransactor(svc.Client)
+
+		attempts := 0
+		err := transactor.WithRetry(svc.Ctx, func(ctx context.Context) error {
+			attempts++
+			if _, err := svc.UserRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()}); err != nil {
+				return err
+			}
+			if attempts == 1 {
+				return &pq.Error{Code: "40001"}
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, attempts)
+
+		// Only the user from the committed attempt exists
+		users, err := svc.Client.User.Query().All(svc.Ctx)
+		require.NoError(t, err)
+		require.Len(t, users, 1)
+	})
+
+	t.Run("gives up after the maximum number of attempts", func(t *testing.T) {
+		t.Parallel()
+		svc := testutil.NewTestServices(t)
+		transactor := repository.NewTransactor(svc.Client)
+
+		attempts := 0
+		err := transactor.WithRetry(svc.Ctx, func(ctx context.Context) error {
+			attempts++
+			return &pq.Error{Code: "40P01"}
+		})
+		require.True(t, repository.IsTransientError(err))
+		require.Equal(t, repository.MaxTxAttempts, attempts)
+	})
+
+	t.Run("does not retry permanent failures", func(t *testing.T) {
+		t.Parallel()
+		svc := testutil.NewTestServices(t)
+		transactor := repository.NewTransactor(svc.Client)
+
+		attempts := 0
+		err := transactor.WithRetry(svc.Ctx, func(ctx context.Context) error {
+			attempts++
+			return &pq.Error{Code: "23503"} // foreign_key_violation
+		})
+		require.Error(t, err)
+		require.Equal(t, 1, attempts)
+	})
+
+	t.Run("retries unique violations only when the caller marks them as insert races", func(t *testing.T) {
+		t.Parallel()
+		svc := testutil.NewTestServices(t)
+		transactor := repository.NewTransactor(svc.Client)
+
+		attempts := 0
+		err := transactor.WithRetry(svc.Ctx, func(ctx context.Context) error {
+			attempts++
+			return &pq.Error{Code: "23505"} // unique_violation
+		})
+		require.Error(t, err)
+		require.Equal(t, 1, attempts)
+
+		attempts = 0
+		err = transactor.WithRetry(svc.Ctx, func(ctx context.Context) error {
+			attempts++
+			return repository.InsertRace(&pq.Error{Code: "23505"})
+		})
+		require.ErrorIs(t, err, repository.ErrInsertRace)
+		require.Equal(t, repository.MaxTxAttempts, attempts)
+	})
+}
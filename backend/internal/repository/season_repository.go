@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/ent/predicate"
+	entSeason "stride-wars-app/ent/season"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SeasonRepository struct {
+	client *ent.Client
+}
+
+func NewSeasonRepository(client *ent.Client) SeasonRepository {
+	return SeasonRepository{client: client}
+}
+
+func (r SeasonRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+func (r SeasonRepository) FindByNumber(ctx context.Context, number int) (*ent.Season, error) {
+	return r.db(ctx).Season.Query().Where(entSeason.NumberEQ(number)).Only(ctx)
+}
+
+func (r SeasonRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*ent.Season, error) {
+	return r.db(ctx).Season.Query().Where(entSeason.IDIn(ids...)).All(ctx)
+}
+
+// FindActive returns the season being played.
+func (r SeasonRepository) FindActive(ctx context.Context) (*ent.Season, error) {
+	return r.db(ctx).Season.Query().Where(entSeason.StatusEQ(entSeason.StatusActive)).First(ctx)
+}
+
+// FindActiveForUpdate returns the season being played and locks it until the end of the
+// transaction, so only one instance rolls it over.
+func (r SeasonRepository) FindActiveForUpdate(ctx context.Context) (*ent.Season, error) {
+	return r.db(ctx).Season.Query().
+		Where(entSeason.StatusEQ(entSeason.StatusActive), predicate.Season(forUpdate)).
+		First(ctx)
+}
+
+// FindLatest returns the season with the highest number.
+func (r SeasonRepository) FindLatest(ctx context.Context) (*ent.Season, error) {
+	return r.db(ctx).Season.Query().Order(ent.Desc(entSeason.FieldNumber)).First(ctx)
+}
+
+// FindAll returns all seasons, the latest first.
+func (r SeasonRepository) FindAll(ctx context.Context) ([]*ent.Season, error) {
+	return r.db(ctx).Season.Query().Order(ent.Desc(entSeason.FieldNumber)).All(ctx)
+}
+
+// CreateSeason starts the given season. It fails with a constraint error if a season with the
+// same number was created in the meantime.
+func (r SeasonRepository) CreateSeason(ctx context.Context, season *model.Season) (*ent.Season, error) {
+	return r.db(ctx).Season.Create().
+		SetNumber(season.Number).
+		SetName(season.Name).
+		SetStartsAt(season.StartsAt.UTC()).
+		SetEndsAt(season.EndsAt.UTC()).
+		SetCarryOver(season.CarryOver).
+		SetStatus(entSeason.StatusActive).
+		Save(ctx)
+}
+
+func (r SeasonRepository) ArchiveSeason(ctx context.Context, id uuid.UUID, now time.Time) (*ent.Season, error) {
+	return r.db(ctx).Season.UpdateOneID(id).
+		SetStatus(entSeason.StatusArchived).
+		SetArchivedAt(now.UTC()).
+		Save(ctx)
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	entSeasonHexStanding "stride-wars-app/ent/seasonhexstanding"
+
+	"github.com/google/uuid"
+)
+
+type SeasonHexStandingRepository struct {
+	client *ent.Client
+}
+
+func NewSeasonHexStandingRepository(client *ent.Client) SeasonHexStandingRepository {
+	return SeasonHexStandingRepository{client: client}
+}
+
+func (r SeasonHexStandingRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+// CreateSeasonHexStandings stores the given standings in bulk.
+func (r SeasonHexStandingRepository) CreateSeasonHexStandings(ctx context.Context, standings []*model.SeasonHexStanding) error {
+	for _, c := range chunks(len(standings)) {
+		builders := make([]*ent.SeasonHexStandingCreate, 0, c[1]-c[0])
+		for _, standing := range standings[c[0]:c[1]] {
+			builders = append(builders, r.db(ctx).SeasonHexStanding.Create().
+				SetID(uuid.New()).
+				SetSeasonID(standing.SeasonID).
+				SetH3Index(standing.H3Index).
+				SetRank(standing.Rank).
+				SetUserID(standing.UserID).
+				SetUsername(standing.Username).
+				SetScore(standing.Score))
+		}
+		if err := r.db(ctx).SeasonHexStanding.CreateBulk(builders...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindBySeasonIDAndH3Index returns a hex's final leaderboard in a season, best rank first.
+func (r SeasonHexStandingRepository) FindBySeasonIDAndH3Index(ctx context.Context, seasonID uuid.UUID, h3Index string) ([]*ent.SeasonHexStanding, error) {
+	return r.db(ctx).SeasonHexStanding.Query().
+		Where(
+			entSeasonHexStanding.SeasonIDEQ(seasonID),
+			entSeasonHexStanding.H3IndexEQ(h3Index),
+		).
+		Order(ent.Asc(entSeasonHexStanding.FieldRank)).
+		All(ctx)
+}
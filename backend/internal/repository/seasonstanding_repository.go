@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	entSeasonStanding "stride-wars-app/ent/seasonstanding"
+
+	"github.com/google/uuid"
+)
+
+type SeasonStandingRepository struct {
+	client *ent.Client
+}
+
+func NewSeasonStandingRepository(client *ent.Client) SeasonStandingRepository {
+	return SeasonStandingRepository{client: client}
+}
+
+func (r SeasonStandingRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+// CreateSeasonStandings stores the given standings in bulk.
+func (r SeasonStandingRepository) CreateSeasonStandings(ctx context.Context, standings []*model.SeasonStanding) error {
+	for _, c := range chunks(len(standings)) {
+		builders := make([]*ent.SeasonStandingCreate, 0, c[1]-c[0])
+		for _, standing := range standings[c[0]:c[1]] {
+			builders = append(builders, r.db(ctx).SeasonStanding.Create().
+				SetID(uuid.New()).
+				SetSeasonID(standing.SeasonID).
+				SetRank(standing.Rank).
+				SetUserID(standing.UserID).
+				SetUsername(standing.Username).
+				SetHexesOwned(standing.HexesOwned).
+				SetTotalInfluence(standing.TotalInfluence))
+		}
+		if err := r.db(ctx).SeasonStanding.CreateBulk(builders...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindPageBySeasonID returns a page of a season's standings, best rank first.
+func (r SeasonStandingRepository) FindPageBySeasonID(ctx context.Context, seasonID uuid.UUID, limit, offset int) ([]*ent.SeasonStanding, error) {
+	return r.db(ctx).SeasonStanding.Query().
+		Where(entSeasonStanding.SeasonIDEQ(seasonID)).
+		Order(ent.Asc(entSeasonStanding.FieldRank)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+}
+
+func (r SeasonStandingRepository) CountBySeasonID(ctx context.Context, seasonID uuid.UUID) (int, error) {
+	return r.db(ctx).SeasonStanding.Query().Where(entSeasonStanding.SeasonIDEQ(seasonID)).Count(ctx)
+}
+
+// FindByUserID returns the user's standings in all archived seasons they took part in.
+func (r SeasonStandingRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*ent.SeasonStanding, error) {
+	return r.db(ctx).SeasonStanding.Query().Where(entSeasonStanding.UserIDEQ(userID)).All(ctx)
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	entSegment "stride-wars-app/ent/segment"
+
+	"github.com/google/uuid"
+)
+
+type SegmentRepository struct {
+	client *ent.Client
+}
+
+func NewSegmentRepository(client *ent.Client) SegmentRepository {
+	return SegmentRepository{client: client}
+}
+
+func (r SegmentRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+func (r SegmentRepository) FindByID(ctx context.Context, id uuid.UUID) (*ent.Segment, error) {
+	return r.db(ctx).Segment.Query().Where(entSegment.IDEQ(id)).First(ctx)
+}
+
+// FindByStartH3Indexes returns the segments that start in any of the given cells.
+func (r SegmentRepository) FindByStartH3Indexes(ctx context.Context, h3Indexes []string) ([]*ent.Segment, error) {
+	return r.db(ctx).Segment.Query().Where(entSegment.StartH3IndexIn(h3Indexes...)).All(ctx)
+}
+
+func (r SegmentRepository) CreateSegment(ctx context.Context, segment *model.Segment) (*ent.Segment, error) {
+	return r.db(ctx).Segment.Create().
+		SetID(uuid.New()).
+		SetName(segment.Name).
+		SetCreatorID(segment.CreatorID).
+		SetH3Indexes(segment.H3Indexes).
+		SetStartH3Index(segment.StartH3Index).
+		Save(ctx)
+}
+
+func (r SegmentRepository) DeleteByID(ctx context.Context, id uuid.UUID) error {
+	return r.db(ctx).Segment.DeleteOneID(id).Exec(ctx)
+}
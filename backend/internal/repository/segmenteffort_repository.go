@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	entSegmentEffort "stride-wars-app/ent/segmenteffort"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+type SegmentEffortRepository struct {
+	client *ent.Client
+}
+
+func NewSegmentEffortRepository(client *ent.Client) SegmentEffortRepository {
+	return SegmentEffortRepository{client: client}
+}
+
+func (r SegmentEffortRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+// BestEffort is a user's fastest time on a segment.
+type BestEffort struct {
+	UserID         uuid.UUID `json:"user_id"`
+	ElapsedSeconds float64   `json:"elapsed_seconds"`
+	Efforts        int       `json:"efforts"`
+}
+
+func (r SegmentEffortRepository) FindByActivityID(ctx context.Context, activityID uuid.UUID) ([]*ent.SegmentEffort, error) {
+	return r.db(ctx).SegmentEffort.Query().Where(entSegmentEffort.ActivityIDEQ(activityID)).All(ctx)
+}
+
+func (r SegmentEffortRepository) CreateSegmentEfforts(ctx context.Context, efforts []*model.SegmentEffort) ([]*ent.SegmentEffort, error) {
+	builders := make([]*ent.SegmentEffortCreate, len(efforts))
+	for i, effort := range efforts {
+		builders[i] = r.db(ctx).SegmentEffort.Create().
+			SetID(uuid.New()).
+			SetSegmentID(effort.SegmentID).
+			SetUserID(effort.UserID).
+			SetActivityID(effort.ActivityID).
+			SetElapsedSeconds(effort.ElapsedSeconds).
+			SetStartedAt(effort.StartedAt.UTC()).
+			SetEstimated(effort.Estimated)
+	}
+	return r.db(ctx).SegmentEffort.CreateBulk(builders...).Save(ctx)
+}
+
+// BestEffortsBySegment returns each user's fastest time on the segment, fastest first. Estimated
+// efforts are left out.
+func (r SegmentEffortRepository) BestEffortsBySegment(ctx context.Context, segmentID uuid.UUID, limit int) ([]BestEffort, error) {
+	var efforts []BestEffort
+	err := r.db(ctx).SegmentEffort.Query().
+		Where(entSegmentEffort.SegmentIDEQ(segmentID), entSegmentEffort.EstimatedEQ(false)).
+		Modify(func(s *sql.Selector) {
+			s.Select(s.C(entSegmentEffort.FieldUserID)).
+				AppendSelectAs(sql.Min(s.C(entSegmentEffort.FieldElapsedSeconds)), "elapsed_seconds").
+				AppendSelectAs(sql.Count("*"), "efforts").
+				GroupBy(s.C(entSegmentEffort.FieldUserID)).
+				OrderBy(sql.Min(s.C(entSegmentEffort.FieldElapsedSeconds))).
+				Limit(limit)
+		}).
+		Scan(ctx, &efforts)
+	return efforts, err
+}
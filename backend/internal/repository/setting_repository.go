@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	entSetting "stride-wars-app/ent/setting"
+	"time"
+)
+
+type SettingRepository struct {
+	client *ent.Client
+}
+
+func NewSettingRepository(client *ent.Client) SettingRepository {
+	return SettingRepository{client: client}
+}
+
+func (r SettingRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+// FindByKey returns the setting stored under key.
+func (r SettingRepository) FindByKey(ctx context.Context, key string) (*ent.Setting, error) {
+	return r.db(ctx).Setting.Get(ctx, key)
+}
+
+// SetValue stores value under key, replacing the value stored there before.
+func (r SettingRepository) SetValue(ctx context.Context, key, value string, now time.Time) error {
+	return r.db(ctx).Setting.Create().
+		SetID(key).
+		SetValue(value).
+		SetUpdatedAt(now.UTC()).
+		OnConflictColumns(entSetting.FieldID).
+		UpdateNewValues().
+		Exec(ctx)
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	entStreak "stride-wars-app/ent/streak"
+
+	"github.com/google/uuid"
+)
+
+type StreakRepository struct {
+	client *ent.Client
+}
+
+func NewStreakRepository(client *ent.Client) StreakRepository {
+	return StreakRepository{client: client}
+}
+
+func (r StreakRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+func (r StreakRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*ent.Streak, error) {
+	return r.db(ctx).Streak.Query().Where(entStreak.UserIDEQ(userID)).All(ctx)
+}
+
+func (r StreakRepository) FindByUserIDAndPeriod(ctx context.Context, userID uuid.UUID, period string) (*ent.Streak, error) {
+	return r.db(ctx).Streak.Query().Where(
+		entStreak.UserIDEQ(userID),
+		entStreak.PeriodEQ(entStreak.Period(period)),
+	).First(ctx)
+}
+
+func (r StreakRepository) CreateStreak(ctx context.Context, streak *model.Streak) (*ent.Streak, error) {
+	return r.db(ctx).Streak.Create().
+		SetID(uuid.New()).
+		SetUserID(streak.UserID).
+		SetPeriod(entStreak.Period(streak.Period)).
+		SetCurrent(streak.Current).
+		SetLongest(streak.Longest).
+		SetLastPeriod(streak.LastPeriod).
+		Save(ctx)
+}
+
+func (r StreakRepository) UpdateStreak(ctx context.Context, streak *model.Streak) (*ent.Streak, error) {
+	return r.db(ctx).Streak.UpdateOneID(streak.ID).
+		SetCurrent(streak.Current).
+		SetLongest(streak.Longest).
+		SetLastPeriod(streak.LastPeriod).
+		Save(ctx)
+}
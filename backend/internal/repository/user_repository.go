@@ -17,26 +17,47 @@ func NewUserRepository(client *ent.Client) UserRepository {
 	return UserRepository{client: client}
 }
 
+func (r UserRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
 func (r UserRepository) FindByID(ctx context.Context, uuid uuid.UUID) (*ent.User, error) {
-	return r.client.User.Query().Where(entUser.IDEQ(uuid)).First(ctx)
+	return r.db(ctx).User.Query().Where(entUser.IDEQ(uuid)).First(ctx)
 }
 
 func (r UserRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*ent.User, error) {
-	return r.client.User.Query().Where(entUser.IDIn(ids...)).All(ctx)
+	return r.db(ctx).User.Query().Where(entUser.IDIn(ids...)).All(ctx)
 }
 
 func (r UserRepository) FindByExternalUserID(ctx context.Context, uuid uuid.UUID) (*ent.User, error) {
-	return r.client.User.Query().Where(entUser.ExternalUserEQ(uuid)).First(ctx)
+	return r.db(ctx).User.Query().Where(entUser.ExternalUserEQ(uuid)).First(ctx)
 }
 
 func (r UserRepository) FindByUsername(ctx context.Context, username string) (*ent.User, error) {
-	return r.client.User.Query().Where(entUser.UsernameEQ(username)).First(ctx)
+	return r.db(ctx).User.Query().Where(entUser.UsernameEQ(username)).First(ctx)
 }
 
 func (r UserRepository) CreateUser(ctx context.Context, user *model.User) (*ent.User, error) {
-	return r.client.User.Create().SetUsername(user.Username).SetExternalUser(user.ExternalUser).SetID(uuid.New()).Save(ctx)
+	return r.db(ctx).User.Create().SetUsername(user.Username).SetExternalUser(user.ExternalUser).SetID(uuid.New()).Save(ctx)
 }
 
 func (r UserRepository) UpdateUsername(ctx context.Context, user *model.User) (int, error) {
-	return r.client.User.Update().Where(entUser.IDEQ(user.ID)).SetUsername(user.Username).Save(ctx)
+	return r.db(ctx).User.Update().Where(entUser.IDEQ(user.ID)).SetUsername(user.Username).Save(ctx)
+}
+
+func (r UserRepository) UpdateTimeZone(ctx context.Context, id uuid.UUID, timeZone string) (int, error) {
+	return r.db(ctx).User.Update().Where(entUser.IDEQ(id)).SetTimeZone(timeZone).Save(ctx)
+}
+
+// UpdateQuietHours sets the user's quiet hours, or clears them when start and end are nil.
+func (r UserRepository) UpdateQuietHours(ctx context.Context, id uuid.UUID, start, end *int) (int, error) {
+	update := r.db(ctx).User.Update().Where(entUser.IDEQ(id))
+	if start == nil || end == nil {
+		return update.ClearQuietHoursStart().ClearQuietHoursEnd().Save(ctx)
+	}
+	return update.SetQuietHoursStart(*start).SetQuietHoursEnd(*end).Save(ctx)
+}
+
+func (r UserRepository) UpdateHideZoneLeaderboards(ctx context.Context, id uuid.UUID, hide bool) (int, error) {
+	return r.db(ctx).User.Update().Where(entUser.IDEQ(id)).SetHideZoneLeaderboards(hide).Save(ctx)
 }
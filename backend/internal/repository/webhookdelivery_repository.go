@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/ent/predicate"
+	entWebhookDelivery "stride-wars-app/ent/webhookdelivery"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WebhookDeliveryRepository struct {
+	client *ent.Client
+}
+
+func NewWebhookDeliveryRepository(client *ent.Client) WebhookDeliveryRepository {
+	return WebhookDeliveryRepository{client: client}
+}
+
+func (r WebhookDeliveryRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+// CreateWebhookDeliveries queues the given deliveries in bulk.
+func (r WebhookDeliveryRepository) CreateWebhookDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) error {
+	for _, c := range chunks(len(deliveries)) {
+		builders := make([]*ent.WebhookDeliveryCreate, 0, c[1]-c[0])
+		for _, delivery := range deliveries[c[0]:c[1]] {
+			builders = append(builders, r.db(ctx).WebhookDelivery.Create().
+				SetID(uuid.New()).
+				SetSubscriptionID(delivery.SubscriptionID).
+				SetEventID(delivery.EventID).
+				SetEventType(entWebhookDelivery.EventType(delivery.EventType)).
+				SetPayload(delivery.Payload).
+				SetNextAttemptAt(delivery.CreatedAt.UTC()).
+				SetCreatedAt(delivery.CreatedAt.UTC()).
+				SetUpdatedAt(delivery.CreatedAt.UTC()))
+		}
+		if err := r.db(ctx).WebhookDelivery.CreateBulk(builders...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindPageBySubscriptionID returns a page of a subscription's deliveries, newest first.
+func (r WebhookDeliveryRepository) FindPageBySubscriptionID(ctx context.Context, subscriptionID uuid.UUID, limit, offset int) ([]*ent.WebhookDelivery, error) {
+	return r.db(ctx).WebhookDelivery.Query().
+		Where(entWebhookDelivery.SubscriptionIDEQ(subscriptionID)).
+		Order(ent.Desc(entWebhookDelivery.FieldCreatedAt), ent.Desc(entWebhookDelivery.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+}
+
+// FindDue returns up to limit of the oldest deliveries whose next attempt is due and deliveries
+// whose sender's lock has run out, skipping the ones locked by another transaction.
+func (r WebhookDeliveryRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*ent.WebhookDelivery, error) {
+	return r.db(ctx).WebhookDelivery.Query().
+		Where(
+			entWebhookDelivery.Or(
+				entWebhookDelivery.And(
+					entWebhookDelivery.StatusEQ(entWebhookDelivery.Status(model.WebhookDeliveryPending)),
+					entWebhookDelivery.NextAttemptAtLTE(now.UTC()),
+				),
+				entWebhookDelivery.And(
+					entWebhookDelivery.StatusEQ(entWebhookDelivery.Status(model.WebhookDeliveryDelivering)),
+					entWebhookDelivery.LockedUntilLT(now.UTC()),
+				),
+			),
+			predicate.WebhookDelivery(skipLocked),
+		).
+		Order(ent.Asc(entWebhookDelivery.FieldNextAttemptAt)).
+		Limit(limit).
+		All(ctx)
+}
+
+// ClaimWebhookDeliveries marks deliveries found by FindDue as being sent until lockedUntil.
+func (r WebhookDeliveryRepository) ClaimWebhookDeliveries(ctx context.Context, ids []uuid.UUID, now, lockedUntil time.Time) (int, error) {
+	return r.db(ctx).WebhookDelivery.Update().
+		Where(entWebhookDelivery.IDIn(ids...)).
+		SetStatus(entWebhookDelivery.Status(model.WebhookDeliveryDelivering)).
+		AddAttempts(1).
+		SetLockedUntil(lockedUntil.UTC()).
+		SetUpdatedAt(now.UTC()).
+		Save(ctx)
+}
+
+// FinishWebhookDelivery records the outcome of a claimed delivery's attempt. A pending status
+// retries it at nextAttemptAt, and a delivered one is stamped as delivered at now.
+func (r WebhookDeliveryRepository) FinishWebhookDelivery(ctx context.Context, id uuid.UUID, status string, responseStatus *int, lastError *string, nextAttemptAt, now time.Time) (int, error) {
+	update := r.db(ctx).WebhookDelivery.Update().
+		Where(
+			entWebhookDelivery.IDEQ(id),
+			entWebhookDelivery.StatusEQ(entWebhookDelivery.Status(model.WebhookDeliveryDelivering)),
+		).
+		SetStatus(entWebhookDelivery.Status(status)).
+		ClearLockedUntil().
+		SetNillableResponseStatus(responseStatus).
+		SetNillableLastError(lastError).
+		SetNextAttemptAt(nextAttemptAt.UTC()).
+		SetUpdatedAt(now.UTC())
+	if status == model.WebhookDeliveryDelivered {
+		update = update.SetDeliveredAt(now.UTC()).ClearLastError()
+	}
+	return update.Save(ctx)
+}
+
+// DeleteBySubscriptionID removes a subscription's delivery log, including unsent deliveries.
+func (r WebhookDeliveryRepository) DeleteBySubscriptionID(ctx context.Context, subscriptionID uuid.UUID) (int, error) {
+	return r.db(ctx).WebhookDelivery.Delete().Where(entWebhookDelivery.SubscriptionIDEQ(subscriptionID)).Exec(ctx)
+}
+
+// DeleteFinishedBefore removes delivered and failed deliveries last updated before the given time.
+func (r WebhookDeliveryRepository) DeleteFinishedBefore(ctx context.Context, before time.Time) (int, error) {
+	return r.db(ctx).WebhookDelivery.Delete().Where(
+		entWebhookDelivery.StatusIn(
+			entWebhookDelivery.Status(model.WebhookDeliveryDelivered),
+			entWebhookDelivery.Status(model.WebhookDeliveryFailed),
+		),
+		entWebhookDelivery.UpdatedAtLT(before.UTC()),
+	).Exec(ctx)
+}
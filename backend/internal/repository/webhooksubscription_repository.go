@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/ent/predicate"
+	entWebhookSubscription "stride-wars-app/ent/webhooksubscription"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/google/uuid"
+)
+
+type WebhookSubscriptionRepository struct {
+	client *ent.Client
+}
+
+func NewWebhookSubscriptionRepository(client *ent.Client) WebhookSubscriptionRepository {
+	return WebhookSubscriptionRepository{client: client}
+}
+
+func (r WebhookSubscriptionRepository) db(ctx context.Context) *ent.Client {
+	return clientFromContext(ctx, r.client)
+}
+
+func (r WebhookSubscriptionRepository) FindByID(ctx context.Context, id uuid.UUID) (*ent.WebhookSubscription, error) {
+	return r.db(ctx).WebhookSubscription.Get(ctx, id)
+}
+
+func (r WebhookSubscriptionRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*ent.WebhookSubscription, error) {
+	return r.db(ctx).WebhookSubscription.Query().Where(entWebhookSubscription.IDIn(ids...)).All(ctx)
+}
+
+func (r WebhookSubscriptionRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*ent.WebhookSubscription, error) {
+	return r.db(ctx).WebhookSubscription.Query().
+		Where(entWebhookSubscription.UserIDEQ(userID)).
+		Order(ent.Asc(entWebhookSubscription.FieldCreatedAt)).
+		All(ctx)
+}
+
+// FindByEventType returns the subscriptions whose event types include eventType.
+func (r WebhookSubscriptionRepository) FindByEventType(ctx context.Context, eventType string) ([]*ent.WebhookSubscription, error) {
+	return r.db(ctx).WebhookSubscription.Query().
+		Where(predicate.WebhookSubscription(func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(s.C(entWebhookSubscription.FieldEventTypes), eventType))
+		})).
+		All(ctx)
+}
+
+func (r WebhookSubscriptionRepository) CreateWebhookSubscription(ctx context.Context, subscription *model.WebhookSubscription) (*ent.WebhookSubscription, error) {
+	return r.db(ctx).WebhookSubscription.Create().
+		SetUserID(subscription.UserID).
+		SetURL(subscription.URL).
+		SetEventTypes(subscription.EventTypes).
+		SetSecret(subscription.Secret).
+		SetCreatedAt(subscription.CreatedAt.UTC()).
+		Save(ctx)
+}
+
+func (r WebhookSubscriptionRepository) DeleteByID(ctx context.Context, id uuid.UUID) error {
+	return r.db(ctx).WebhookSubscription.DeleteOneID(id).Exec(ctx)
+}
@@ -2,13 +2,17 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"stride-wars-app/ent"
 	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/config"
 	"stride-wars-app/internal/dto"
 	"stride-wars-app/internal/hex/hexconsts"
 	"stride-wars-app/internal/repository"
 
 	"errors"
+	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
@@ -17,34 +21,95 @@ import (
 	"go.uber.org/zap"
 )
 
+// MaxClockSkew is how far ahead of the server clock a client-recorded timestamp may be.
+const MaxClockSkew = 5 * time.Minute
+
+// MaxActivityAge is how long after it ended an activity can still be uploaded.
+const MaxActivityAge = 30 * 24 * time.Hour
+
+// MaxActivitySpan is the longest time an activity can take from start to end.
+const MaxActivitySpan = 48 * time.Hour
+
+// MaxBatchActivities is the largest number of activities accepted in one batch.
+const MaxBatchActivities = 100
+
+var (
+	ErrActivityNotOwned = errors.New("activity does not belong to this user")
+	ErrInvalidActivity  = errors.New("invalid activity")
+)
+
 type ActivityService struct {
 	repository            repository.ActivityRepository
+	activityHexRepository repository.ActivityHexRepository
+	seasonRepository      repository.SeasonRepository
+	transactor            repository.Transactor
 	HexService            *HexService
 	HexInfluenceService   *HexInfluenceService
 	HexLeaderboardService *HexLeaderboardService
+	HexRollupService      *HexRollupService
+	IdempotencyService    *IdempotencyService
+	StatsService          *ActivityStatsService
+	StreakService         *StreakService
+	GoalService           *GoalService
+	PersonalRecordService *PersonalRecordService
+	PrivacyZoneService    *PrivacyZoneService
+	NotificationService   *NotificationService
+	WebhookService        *WebhookService
+	SegmentService        *SegmentService
+	JobService            *ActivityJobService
 	UserService           *UserService
+	scoring               ScoringStrategy
 	logger                *zap.Logger
 }
 
 func NewActivityService(
-	activityRepo repository.ActivityRepository,
-	hexInfluenceRepo repository.HexInfluenceRepository,
-	hexLeaderboardRepo repository.HexLeaderboardRepository,
-	hexRepo repository.HexRepository,
+	repositories *repository.Repositories,
 	userService *UserService, // Fixed: pass already constructed service
+	cfg config.Config,
 	logger *zap.Logger,
 ) *ActivityService {
-	return &ActivityService{
-		repository:            activityRepo,
-		HexService:            NewHexService(hexRepo, logger),
-		HexInfluenceService:   NewHexInfluenceService(hexInfluenceRepo, logger),
-		HexLeaderboardService: NewHexLeaderboardService(hexLeaderboardRepo, hexInfluenceRepo, logger),
+	privacyZoneService := NewPrivacyZoneService(repositories, logger)
+	notificationService := NewNotificationService(repositories, logger)
+	webhookService := NewWebhookService(repositories, cfg, logger)
+	scoring := NewScoringStrategy(cfg)
+	rollupService := NewHexRollupService(repositories, privacyZoneService, scoring, logger)
+	privacyZoneService.refreshRollups = rollupService.RefreshRollups
+	as := &ActivityService{
+		repository:            repositories.ActivityRepository,
+		activityHexRepository: repositories.ActivityHexRepository,
+		seasonRepository:      repositories.SeasonRepository,
+		transactor:            repositories.Transactor,
+		HexService:            NewHexService(repositories.HexRepository, logger),
+		HexInfluenceService:   NewHexInfluenceService(repositories.HexInfluenceRepository, repositories.InfluenceHistoryRepository, scoring, logger),
+		HexLeaderboardService: NewHexLeaderboardService(repositories.HexLeaderboardRepository, repositories.HexInfluenceRepository, repositories.HexCaptureRepository, repositories.SettingRepository, privacyZoneService, notificationService, webhookService, rollupService, scoring, cfg, logger),
+		HexRollupService:      rollupService,
+		IdempotencyService:    NewIdempotencyService(repositories.IdempotencyKeyRepository, logger),
+		StatsService:          NewActivityStatsService(repositories, userService, logger),
+		StreakService:         NewStreakService(repositories.StreakRepository, userService, logger),
+		GoalService:           NewGoalService(repositories, userService, logger),
+		PersonalRecordService: NewPersonalRecordService(repositories.PersonalRecordRepository, logger),
+		PrivacyZoneService:    privacyZoneService,
+		NotificationService:   notificationService,
+		WebhookService:        webhookService,
+		SegmentService:        NewSegmentService(repositories, logger),
 		UserService:           userService, // Fixed: use passed-in service
+		scoring:               scoring,
 		logger:                logger,
 	}
+	as.JobService = NewActivityJobService(repositories, as.scoreActivity, logger)
+	return as
 }
 
+// validateCreateActivity checks an activity before it is ingested. Its errors wrap
+// ErrInvalidActivity, so they can be told apart from failures to store the activity.
 func (a *ActivityService) validateCreateActivity(req dto.CreateActivityRequest) error {
+	if err := checkCreateActivity(req); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidActivity, err)
+	}
+	return nil
+}
+
+func checkCreateActivity(req dto.CreateActivityRequest) error {
 	if (req.UserID == uuid.Nil || req.UserID == uuid.UUID{}) {
 		return errors.New("UserID is required")
 	}
@@ -57,26 +122,124 @@ func (a *ActivityService) validateCreateActivity(req dto.CreateActivityRequest)
 		return errors.New("distance must be positive")
 	}
 
+	for _, point := range req.Track {
+		if err := validateTrackPoint(point); err != nil {
+			return err
+		}
+	}
+
+	if req.ActivityType != "" && !isValidActivityType(req.ActivityType) {
+		return ErrInvalidActivityType
+	}
+
+	if err := validateActivityWindow(req); err != nil {
+		return err
+	}
+
 	if len(req.H3Indexes) == 0 {
 		return errors.New("at least one H3 index is required")
 	}
 	for _, h3Index := range req.H3Indexes {
-		cell := h3.Cell(h3.IndexFromString(h3Index))
-		if !cell.IsValid() {
-			return errors.New("invalid H3 index: " + h3Index)
+		if err := validateH3Index(h3Index); err != nil {
+			return err
 		}
+	}
 
-		if cell.Resolution() != hexconsts.DefaultHexResolution {
-			return errors.New("H3 index " + h3Index + " is not at resolution " + strconv.Itoa(hexconsts.DefaultHexResolution))
-		}
+	return nil
+}
+
+// validateActivityWindow checks the client-reported timestamps against the server clock and the
+// reported duration, which excludes pauses and so cannot exceed the time between start and end.
+// The window is checked as resolved, so a bound derived from the duration is held to the same
+// limits as a reported one.
+func validateActivityWindow(req dto.CreateActivityRequest) error {
+	now := time.Now()
+	startedAt, endedAt := activityWindow(req, now)
+	if startedAt.After(now.Add(MaxClockSkew)) {
+		return errors.New("started_at cannot be in the future")
+	}
+	if endedAt.After(now.Add(MaxClockSkew)) {
+		return errors.New("ended_at cannot be in the future")
+	}
+	if now.Sub(endedAt) > MaxActivityAge {
+		return errors.New("activity ended too long ago to be uploaded")
+	}
+	if !startedAt.Before(endedAt) {
+		return errors.New("started_at must be before ended_at")
+	}
+	if endedAt.Sub(startedAt) > MaxActivitySpan {
+		return errors.New("activity cannot last longer than " + MaxActivitySpan.String())
+	}
+	if req.Duration > endedAt.Sub(startedAt).Seconds()+1 {
+		return errors.New("duration cannot exceed the time between started_at and ended_at")
+	}
+	return nil
+}
+
+// activityWindow resolves when an activity started and ended, deriving a missing bound from the duration.
+func activityWindow(req dto.CreateActivityRequest, now time.Time) (time.Time, time.Time) {
+	duration := time.Duration(req.Duration * float64(time.Second))
+	switch {
+	case req.StartedAt != nil && req.EndedAt != nil:
+		return *req.StartedAt, *req.EndedAt
+	case req.EndedAt != nil:
+		return req.EndedAt.Add(-duration), *req.EndedAt
+	case req.StartedAt != nil:
+		return *req.StartedAt, req.StartedAt.Add(duration)
+	default:
+		return now.Add(-duration), now
+	}
+}
+
+// activityStartedAt is when the activity started, activities uploaded without timestamps fall
+// back to their upload time.
+func activityStartedAt(activity *ent.Activity) time.Time {
+	if activity.StartedAt != nil {
+		return *activity.StartedAt
 	}
+	return activity.CreatedAt
+}
 
+// activityEndedAt is when the activity ended, the time its influence is scored at.
+func activityEndedAt(activity *ent.Activity) time.Time {
+	if activity.EndedAt != nil {
+		return *activity.EndedAt
+	}
+	return activity.CreatedAt
+}
+
+// calendarDay is midnight UTC of the date t falls on in location, so whole days between two
+// dates can be counted without daylight saving shifts.
+func calendarDay(t time.Time, location *time.Location) time.Time {
+	year, month, day := t.In(location).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func validateH3Index(h3Index string) error {
+	cell := h3.Cell(h3.IndexFromString(h3Index))
+	if !cell.IsValid() {
+		return errors.New("invalid H3 index: " + h3Index)
+	}
+
+	if cell.Resolution() != hexconsts.DefaultHexResolution {
+		return errors.New("H3 index " + h3Index + " is not at resolution " + strconv.Itoa(hexconsts.DefaultHexResolution))
+	}
 	return nil
 }
 
 func (as *ActivityService) FindByID(ctx context.Context, uuid uuid.UUID) (*ent.Activity, error) {
 	return as.repository.FindByID(ctx, uuid)
 }
+
+// GetActivity returns the public view of an activity, with its privacy zones left out.
+func (as *ActivityService) GetActivity(ctx context.Context, id uuid.UUID) (*dto.ActivityDetailResponse, error) {
+	activity, err := as.repository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return as.PrivacyZoneService.RedactActivity(ctx, activity)
+}
+
 func (as *ActivityService) FindByIDs(ctx context.Context, uuids []uuid.UUID) ([]*ent.Activity, error) {
 	return as.repository.FindByIDs(ctx, uuids)
 }
@@ -84,22 +247,94 @@ func (as *ActivityService) FindByUserID(ctx context.Context, userID uuid.UUID) (
 	return as.repository.FindByUserID(ctx, userID)
 }
 
+// CreateActivity stores an activity and queues the scoring of its hexes. When the request carries an
+// idempotency key, a replay of an already completed request returns the original response
+// instead of creating the activity again.
 func (as *ActivityService) CreateActivity(ctx context.Context, req dto.CreateActivityRequest) (*dto.CreateActivityResponse, error) {
 	if err := as.validateCreateActivity(req); err != nil {
 		return nil, err
 	}
+	if req.IdempotencyKey == "" {
+		return as.createActivity(ctx, req, uuid.Nil)
+	}
+
+	// The key itself is not part of the payload that replays are compared against.
+	payload := req
+	payload.IdempotencyKey = ""
+	reservationID, storedResponse, err := as.IdempotencyService.Begin(ctx, req.UserID, req.IdempotencyKey, payload)
+	if err != nil {
+		return nil, err
+	}
+	if storedResponse != nil {
+		var resp dto.CreateActivityResponse
+		if err := json.Unmarshal(storedResponse, &resp); err != nil {
+			return nil, err
+		}
+		resp.Replayed = true
+		status, err := as.JobService.GetStatus(ctx, resp.ID)
+		if err != nil {
+			return nil, err
+		}
+		resp.ProcessingStatus = status.Status
+		return &resp, nil
+	}
+
+	resp, err := as.createActivity(ctx, req, reservationID)
+	if err != nil {
+		as.IdempotencyService.Abort(ctx, reservationID)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// createActivity ingests the activity in a single transaction, so it is either stored with its
+// progress applied and its scoring job queued, or not stored at all. The response is stored for
+// the idempotency reservation, if any, in the same transaction.
+func (as *ActivityService) createActivity(ctx context.Context, req dto.CreateActivityRequest, reservationID uuid.UUID) (*dto.CreateActivityResponse, error) {
+	var resp *dto.CreateActivityResponse
+	err := as.transactor.WithRetry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = as.ingestActivity(ctx, req, 0)
+		if err != nil || reservationID == uuid.Nil {
+			return err
+		}
+		if err := as.IdempotencyService.Complete(ctx, reservationID, resp); err != nil {
+			return fmt.Errorf("storing idempotent response: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ingestActivity stores the activity and queues a job that applies its influence. The first
+// alreadyScored cells are skipped, their influence was applied while the activity was still
+// being recorded live.
+func (as *ActivityService) ingestActivity(ctx context.Context, req dto.CreateActivityRequest, alreadyScored int) (*dto.CreateActivityResponse, error) {
+	// Influence is scored at the time the activity ended, so late uploads decay correctly.
+	startedAt, endedAt := activityWindow(req, time.Now())
 
 	activityInput := &model.Activity{
 		UserID:    req.UserID,
 		Duration:  req.Duration,
 		Distance:  req.Distance,
 		H3Indexes: req.H3Indexes,
+		Track:     toModelTrack(req.Track),
+		StartedAt: startedAt,
+		EndedAt:   endedAt,
+
+		ActivityType: req.ActivityType,
+	}
+	if activityInput.ActivityType == "" {
+		activityInput.ActivityType = model.ActivityTypeRun
 	}
 	if len(activityInput.H3Indexes) == 0 {
 		return nil, errors.New("activity must contain at least one H3 index")
 	}
 	// validate if user exists
-	_, err := as.UserService.FindByID(ctx, activityInput.UserID)
+	user, err := as.UserService.FindByID(ctx, activityInput.UserID)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, err
@@ -107,59 +342,52 @@ func (as *ActivityService) CreateActivity(ctx context.Context, req dto.CreateAct
 		return nil, err
 	}
 
-	createdActivity, err := as.repository.CreateActivity(ctx, activityInput)
+	visitedHexes := uniqueH3Indexes(activityInput.H3Indexes)
+	visitedBefore, err := as.activityHexRepository.CountVisitedBefore(ctx, user.ID, visitedHexes, startedAt)
 	if err != nil {
 		return nil, err
 	}
+	activityInput.NewHexes = len(visitedHexes) - visitedBefore
 
-	userID := activityInput.UserID
-	h3Indexes := activityInput.H3Indexes
-	userName, _ := as.UserService.FindByID(ctx, userID)
-
-	as.logger.Debug("Checking and creating hexes if necessary", zap.Any("h3Indexes", h3Indexes))
-	existingHexEntities, err := as.HexService.FindByIDs(ctx, h3Indexes)
+	createdActivity, err := as.repository.CreateActivity(ctx, activityInput)
 	if err != nil {
-		as.logger.Warn("Failed to pre-fetch existing hexes by IDs. Will attempt creation individually.",
-			zap.Error(err), zap.Any("h3Indexes", h3Indexes))
+		return nil, err
 	}
-
-	existingHexMap := make(map[string]bool)
-	for _, eh := range existingHexEntities {
-		existingHexMap[eh.ID] = true
+	err = as.activityHexRepository.CreateActivityHexes(ctx, createdActivity.ID, createdActivity.UserID, visitedHexes, startedAt)
+	if err != nil {
+		return nil, err
 	}
-
-	for _, h3Index := range h3Indexes {
-		if _, exists := existingHexMap[h3Index]; !exists {
-			as.logger.Info("Hex not found in database, attempting to create.", zap.String("h3Index", h3Index))
-			_, createHexErr := as.HexService.CreateHex(ctx, h3Index)
-			if createHexErr != nil {
-				as.logger.Error("Failed to create hex, or it was created concurrently by another process.",
-					zap.Error(createHexErr), zap.String("h3Index", h3Index))
-			} else {
-				as.logger.Info("Successfully created new hex in database.", zap.String("h3Index", h3Index))
-			}
-		}
+	if err := as.WebhookService.Emit(ctx, model.WebhookActivityCreated, activityCreatedData(createdActivity, user, len(visitedHexes))); err != nil {
+		return nil, fmt.Errorf("emitting webhook: %w", err)
+	}
+	newRecords, err := as.PersonalRecordService.RecordActivity(ctx, createdActivity)
+	if err != nil {
+		return nil, err
+	}
+	if err := as.notifyRecords(ctx, createdActivity, newRecords); err != nil {
+		return nil, err
+	}
+	segmentEfforts, err := as.SegmentService.MatchActivity(ctx, createdActivity)
+	if err != nil {
+		return nil, err
 	}
-	as.logger.Debug("Hex existence check and creation phase complete.")
-
-	for _, h3Index := range h3Indexes {
 
-		// Update or create hex influence
-		_, err := as.HexInfluenceService.UpdateOrCreateHexInfluence(ctx, userID, h3Index)
-		if err != nil {
-			as.logger.Error("Failed to update or create hex influence.", zap.Error(err), zap.String("h3Index", h3Index))
-			continue
+	processingStatus := model.ActivityJobDone
+	if alreadyScored < len(activityInput.H3Indexes) {
+		if _, err := as.JobService.Enqueue(ctx, createdActivity, alreadyScored); err != nil {
+			return nil, err
 		}
+		processingStatus = model.ActivityJobPending
+	}
 
-		// Add user to leaderboard
-		_, err = as.HexLeaderboardService.AddUserToLeaderboardOrCreateLeaderboard(ctx, h3Index, userID, userName.Username)
-		if err != nil {
-			as.logger.Error("Failed to add user to leaderboard.", zap.Error(err), zap.String("h3Index", h3Index))
-			continue
-		}
-		as.logger.Info("Successfully added user to leaderboard.", zap.String("h3Index", h3Index))
+	err = as.recordProgress(ctx, user, recordedActivity{
+		StartedAt: startedAt,
+		Distance:  activityInput.Distance,
+		NewHexes:  activityInput.NewHexes,
+	})
+	if err != nil {
+		return nil, err
 	}
-	as.logger.Info("Finished processing all H3 indexes for activity.", zap.Stringer("activityID", createdActivity.ID))
 
 	return &dto.CreateActivityResponse{
 		ID:        createdActivity.ID,
@@ -167,10 +395,125 @@ func (as *ActivityService) CreateActivity(ctx context.Context, req dto.CreateAct
 		Duration:  createdActivity.DurationSeconds,
 		Distance:  createdActivity.DistanceMeters,
 		H3Indexes: createdActivity.H3Indexes,
+		StartedAt: startedAt,
+		EndedAt:   endedAt,
+
+		NewRecords:       newRecords,
+		SegmentEfforts:   segmentEfforts,
+		ProcessingStatus: processingStatus,
 	}, nil
 }
 
+// notifyRecords notifies the user of the records the activity beat. First records of a kind are
+// not worth a notification, every early activity would set some.
+func (as *ActivityService) notifyRecords(ctx context.Context, activity *ent.Activity, newRecords []dto.NewPersonalRecord) error {
+	notifications := make([]*model.Notification, 0, len(newRecords))
+	for _, record := range newRecords {
+		if record.PreviousValue != nil {
+			notifications = append(notifications, personalRecordNotification(activity, record))
+		}
+	}
+	if _, err := as.NotificationService.Notify(ctx, notifications...); err != nil {
+		return fmt.Errorf("notifying records: %w", err)
+	}
+	return nil
+}
+
+// recordProgress updates the user's streaks and goals with a newly stored activity.
+func (as *ActivityService) recordProgress(ctx context.Context, user *ent.User, activity recordedActivity) error {
+	if err := as.StreakService.RecordActivity(ctx, user, activity); err != nil {
+		return fmt.Errorf("updating streaks: %w", err)
+	}
+	if err := as.GoalService.RecordActivity(ctx, user, activity); err != nil {
+		return fmt.Errorf("updating goal progress: %w", err)
+	}
+	return nil
+}
+
+// scoreActivity is the job that applies the influence of a stored activity and ranks its hexes.
+func (as *ActivityService) scoreActivity(ctx context.Context, job *ent.ActivityJob) error {
+	activity, err := as.repository.FindByID(ctx, job.ActivityID)
+	if err != nil {
+		return fmt.Errorf("loading activity %s: %w", job.ActivityID, err)
+	}
+	user, err := as.UserService.FindByID(ctx, activity.UserID)
+	if err != nil {
+		return fmt.Errorf("loading user %s: %w", activity.UserID, err)
+	}
+	if job.AlreadyScored >= len(activity.H3Indexes) {
+		return nil
+	}
+	seasonStart, err := as.seasonStart(ctx)
+	if err != nil {
+		return err
+	}
+	if activityEndedAt(activity).Before(seasonStart) {
+		as.logger.Info("Skipped scoring activity that ended before the active season.", zap.Stringer("activityID", activity.ID))
+		return nil
+	}
+	if err := as.applyInfluence(ctx, user, &activity.ID, activity.H3Indexes[job.AlreadyScored:], activityEndedAt(activity)); err != nil {
+		return err
+	}
+	as.logger.Info("Finished processing all H3 indexes for activity.", zap.Stringer("activityID", activity.ID))
+	return nil
+}
+
+// seasonStart returns when the active season started, or the zero time before the first one.
+// Activities only add influence to the season they ended in, so the ones that ended before it
+// are neither scored nor replayed.
+func (as *ActivityService) seasonStart(ctx context.Context) (time.Time, error) {
+	season, err := as.seasonRepository.FindActive(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, err
+	}
+	return season.StartsAt, nil
+}
+
+// GetProcessingStatus reports whether the activity's hexes have been scored and ranked yet.
+func (as *ActivityService) GetProcessingStatus(ctx context.Context, activityID uuid.UUID) (*dto.ActivityProcessingStatusResponse, error) {
+	if _, err := as.repository.FindByID(ctx, activityID); err != nil {
+		return nil, err
+	}
+	return as.JobService.GetStatus(ctx, activityID)
+}
+
+// applyInfluence records a visit of the user to each of the cells at the given time and updates
+// the leaderboards of those hexes. The score changes are attributed to activityID, if any.
+// Hexes, influences and leaderboards are each written in bulk, so the number of statements does
+// not grow with the number of cells. It stops at the first failure, so callers must run it in a
+// transaction to avoid applying only part of an activity.
+func (as *ActivityService) applyInfluence(ctx context.Context, user *ent.User, activityID *uuid.UUID, h3Indexes []string, at time.Time) error {
+	if len(h3Indexes) == 0 {
+		return nil
+	}
+	if err := as.HexService.CreateMissingHexes(ctx, h3Indexes); err != nil {
+		return fmt.Errorf("creating hexes: %w", err)
+	}
+
+	influences, err := as.HexInfluenceService.RecordVisitsAt(ctx, user.ID, activityID, h3Indexes, at)
+	if err != nil {
+		return fmt.Errorf("updating influences: %w", err)
+	}
+
+	captures, err := as.HexLeaderboardService.AddUserToLeaderboards(ctx, user.Username, activityID, influences)
+	if err != nil {
+		return fmt.Errorf("updating leaderboards: %w", err)
+	}
+	as.logger.Debug("Applied influence to hexes.", zap.Int("hexes", len(influences)), zap.Int("captures", len(captures)))
+	return nil
+}
+
+// GetUserActivityStats summarizes the user's activities. Weekly activities are counted per
+// calendar day in the user's time zone, by the day each activity started.
 func (as *ActivityService) GetUserActivityStats(ctx context.Context, userID uuid.UUID) (*dto.GetUserActivityStatsResponse, error) {
+	user, err := as.UserService.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
 	activities, err := as.repository.FindByUserID(ctx, userID)
 	if err != nil {
 		return nil, err
@@ -192,19 +535,263 @@ func (as *ActivityService) GetUserActivityStats(ctx context.Context, userID uuid
 		WeeklyActivities:   make([]int64, 7),
 	}
 
-	now := time.Now()
-	startOfToday := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	location := UserLocation(user)
+	today := calendarDay(time.Now(), location)
+	visitedHexes := make(map[string]bool)
 
 	for _, activity := range activities {
 		stats.DistanceCovered += activity.DistanceMeters
-		stats.HexesVisited += int64(len(activity.H3Indexes))
+		for _, h3Index := range activity.H3Indexes {
+			visitedHexes[h3Index] = true
+		}
 
 		// Calculate how many days ago this activity was
-		daysAgo := int(startOfToday.Sub(activity.CreatedAt).Hours() / 24)
+		daysAgo := int(today.Sub(calendarDay(activityStartedAt(activity), location)).Hours() / 24)
 		if daysAgo >= 0 && daysAgo < 7 {
 			stats.WeeklyActivities[6-daysAgo]++ // Reverse so index 6 is today
 		}
 	}
 
+	stats.HexesVisited = int64(len(visitedHexes))
+
 	return stats, nil
 }
+
+// DeleteActivity removes an activity and reverses its influence contributions. The user's
+// influence in every hex the activity touched is replayed from their remaining activities
+// under the decay rules, and the leaderboards of those hexes are rebuilt. Everything happens
+// in one transaction.
+func (as *ActivityService) DeleteActivity(ctx context.Context, activityID uuid.UUID, userID uuid.UUID) (*dto.DeleteActivityResponse, error) {
+	var affectedHexes []string
+	err := as.transactor.WithTx(ctx, func(ctx context.Context) error {
+		activity, err := as.repository.FindByID(ctx, activityID)
+		if err != nil {
+			return err
+		}
+		if activity.UserID != userID {
+			return ErrActivityNotOwned
+		}
+
+		if err := as.repository.DeleteActivity(ctx, activityID); err != nil {
+			return err
+		}
+
+		remaining, err := as.repository.FindByUserID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		affectedHexes = uniqueH3Indexes(activity.H3Indexes)
+		if err := as.replayInfluence(ctx, userID, remaining, affectedHexes, &activityID); err != nil {
+			return err
+		}
+		return as.PersonalRecordService.RebuildForDeletedActivity(ctx, activityID, remaining)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	as.logger.Info("Deleted activity and recomputed influence.",
+		zap.Stringer("activityID", activityID), zap.Int("affectedHexes", len(affectedHexes)))
+	return &dto.DeleteActivityResponse{
+		ID:            activityID,
+		AffectedHexes: affectedHexes,
+	}, nil
+}
+
+// replayInfluence recomputes the user's influence in the given hexes from their stored activities
+// of the active season and rebuilds the leaderboards of those hexes. It drops any influence that
+// neither an activity nor the carry over from the previous season accounts for. The changes are
+// attributed to activityID, if any.
+func (as *ActivityService) replayInfluence(ctx context.Context, userID uuid.UUID, activities []*ent.Activity, h3Indexes []string, activityID *uuid.UUID) error {
+	// Activities still waiting for their job have not added any influence yet; the job adds
+	// it once it runs.
+	unfinished, err := as.JobService.UnfinishedActivityIDs(ctx, userID)
+	if err != nil {
+		return err
+	}
+	seasonStart, err := as.seasonStart(ctx)
+	if err != nil {
+		return err
+	}
+	scored := make([]*ent.Activity, 0, len(activities))
+	for _, a := range activities {
+		if !unfinished[a.ID] && !activityEndedAt(a).Before(seasonStart) {
+			scored = append(scored, a)
+		}
+	}
+	sort.Slice(scored, func(i, j int) bool {
+		return activityEndedAt(scored[i]).Before(activityEndedAt(scored[j]))
+	})
+
+	for _, h3Index := range h3Indexes {
+		if err := as.recomputeHexInfluence(ctx, userID, h3Index, scored, activityID); err != nil {
+			return err
+		}
+		if err := as.HexLeaderboardService.RebuildLeaderboard(ctx, h3Index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recomputeHexInfluence replays the user's visits to a hex from activities sorted by the time
+// they ended, on top of the score carried over into the season, if any. Every occurrence of the
+// hex in an activity counts as one visit, as it does on ingestion. Activities that ended before
+// the carried over score's last update are already part of it. The change is attributed to the
+// activity that made the replay necessary, if any.
+func (as *ActivityService) recomputeHexInfluence(ctx context.Context, userID uuid.UUID, h3Index string, activities []*ent.Activity, activityID *uuid.UUID) error {
+	score := 0.0
+	var lastVisit, carriedAt time.Time
+	visited := false
+	existing, err := as.HexInfluenceService.FindByUserIDAndHexID(ctx, userID, h3Index)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	if existing != nil && existing.CarriedAt != nil {
+		score, lastVisit, carriedAt, visited = existing.CarriedScore, *existing.CarriedAt, *existing.CarriedAt, true
+	}
+	for _, activity := range activities {
+		if !activityEndedAt(activity).After(carriedAt) {
+			continue
+		}
+		for _, idx := range activity.H3Indexes {
+			if idx != h3Index {
+				continue
+			}
+			if visited {
+				score = as.scoring.Decay(score, activityEndedAt(activity).Sub(lastVisit))
+			}
+			score = as.scoring.Visit(score)
+			lastVisit = activityEndedAt(activity)
+			visited = true
+		}
+	}
+
+	if !visited {
+		_, err := as.HexInfluenceService.DeleteByUserIDAndHexID(ctx, userID, h3Index, activityID)
+		return err
+	}
+	_, err = as.HexInfluenceService.SetHexInfluence(ctx, &model.HexInfluence{
+		UserID:      userID,
+		H3Index:     h3Index,
+		Score:       score,
+		LastUpdated: lastVisit,
+	}, activityID)
+	return err
+}
+
+func uniqueH3Indexes(h3Indexes []string) []string {
+	seen := make(map[string]bool, len(h3Indexes))
+	unique := make([]string, 0, len(h3Indexes))
+	for _, h3Index := range h3Indexes {
+		if !seen[h3Index] {
+			seen[h3Index] = true
+			unique = append(unique, h3Index)
+		}
+	}
+	return unique
+}
+
+// CreateActivitiesBatch ingests activities that were queued on a device while it was offline.
+// Items are processed in the order they ended, so decay between them is computed
+// correctly, and a bad item is rejected without failing the rest of the batch. Results are
+// returned in request order.
+func (as *ActivityService) CreateActivitiesBatch(ctx context.Context, req dto.CreateActivitiesBatchRequest) (*dto.CreateActivitiesBatchResponse, error) {
+	if (req.UserID == uuid.Nil || req.UserID == uuid.UUID{}) {
+		return nil, errors.New("UserID is required")
+	}
+	if len(req.Activities) == 0 {
+		return nil, errors.New("at least one activity is required")
+	}
+	if len(req.Activities) > MaxBatchActivities {
+		return nil, errors.New("a batch can contain at most " + strconv.Itoa(MaxBatchActivities) + " activities")
+	}
+	if _, err := as.UserService.FindByID(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+
+	order := make([]int, len(req.Activities))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return req.Activities[order[i]].EndedAt.Before(req.Activities[order[j]].EndedAt)
+	})
+
+	results := make([]dto.BatchActivityResult, len(req.Activities))
+	for _, i := range order {
+		item := req.Activities[i]
+		result := dto.BatchActivityResult{Index: i, IdempotencyKey: item.IdempotencyKey}
+
+		if item.EndedAt.IsZero() {
+			result.Status = dto.BatchActivityRejected
+			result.Reason = "ended_at is required"
+			results[i] = result
+			continue
+		}
+
+		endedAt := item.EndedAt
+		resp, err := as.CreateActivity(ctx, dto.CreateActivityRequest{
+			UserID:         req.UserID,
+			Duration:       item.Duration,
+			Distance:       item.Distance,
+			H3Indexes:      item.H3Indexes,
+			Track:          item.Track,
+			ActivityType:   item.ActivityType,
+			StartedAt:      item.StartedAt,
+			EndedAt:        &endedAt,
+			IdempotencyKey: item.IdempotencyKey,
+		})
+		switch {
+		case err != nil:
+			result.Status = dto.BatchActivityRejected
+			result.Reason = batchRejectionReason(err)
+			if result.Reason == batchInternalErrorReason {
+				as.logger.Error("Failed to create batched activity.", zap.Error(err), zap.Int("index", i))
+			}
+		case resp.Replayed:
+			result.Status = dto.BatchActivityDuplicate
+			result.ActivityID = &resp.ID
+		default:
+			result.Status = dto.BatchActivityCreated
+			result.ActivityID = &resp.ID
+		}
+		results[i] = result
+	}
+
+	return &dto.CreateActivitiesBatchResponse{Results: results}, nil
+}
+
+// batchInternalErrorReason is reported for batched activities that failed for reasons other than
+// the activity itself, so storage errors are not leaked to the client.
+const batchInternalErrorReason = "internal error"
+
+// batchRejectionReason returns the reason reported to the client for a rejected batched activity.
+func batchRejectionReason(err error) string {
+	switch {
+	case errors.Is(err, ErrInvalidActivity),
+		errors.Is(err, ErrIdempotencyKeyTooLong),
+		errors.Is(err, ErrIdempotencyKeyReused),
+		errors.Is(err, ErrIdempotencyKeyInProgress):
+		return err.Error()
+	}
+	return batchInternalErrorReason
+}
+
+func validateTrackPoint(point dto.TrackPoint) error {
+	if point.Lat < -90 || point.Lat > 90 || point.Lng < -180 || point.Lng > 180 {
+		return errors.New("track point coordinates are out of range")
+	}
+	return nil
+}
+
+func toModelTrack(track []dto.TrackPoint) []model.TrackPoint {
+	if len(track) == 0 {
+		return nil
+	}
+	points := make([]model.TrackPoint, len(track))
+	for i, point := range track {
+		points[i] = model.TrackPoint{Lat: point.Lat, Lng: point.Lng, Timestamp: point.Timestamp}
+	}
+	return points
+}
@@ -2,14 +2,20 @@ package service_test
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
 	"stride-wars-app/ent"
+	"stride-wars-app/ent/activityjob"
+	"stride-wars-app/ent/hexinfluence"
+	"stride-wars-app/ent/hook"
 	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/config"
 	"stride-wars-app/internal/dto"
 	"stride-wars-app/internal/repository"
 	"stride-wars-app/internal/service"
@@ -31,16 +37,21 @@ func setupTest(t *testing.T) (context.Context, *ent.Client, *service.ActivitySer
 	client := svc.Client
 	ctx := svc.Ctx
 	activityService := service.NewActivityService(
-		svc.ActivityRepo,
-		svc.HexInfluenceRepo,
-		svc.HexLeaderboardRepo,
-		svc.HexRepo,
+		svc.Repositories,
 		svc.UserService,
+		config.Default(),
 		zap.NewExample(),
 	)
 	return ctx, client, activityService
 }
 
+// processJobs runs the queued scoring jobs, as the background workers would.
+func processJobs(t *testing.T, ctx context.Context, svc *service.ActivityService) {
+	t.Helper()
+	_, err := svc.JobService.ProcessPending(ctx)
+	require.NoError(t, err)
+}
+
 func TestActivityService(t *testing.T) {
 	t.Parallel()
 
@@ -135,6 +146,7 @@ func TestActivityService(t *testing.T) {
 		}
 		created, err := svc.CreateActivity(ctx, input)
 		require.NoError(t, err)
+		processJobs(t, ctx, svc)
 
 		stored, err := client.Activity.Get(ctx, created.ID)
 		require.NoError(t, err)
@@ -188,9 +200,11 @@ func TestActivityService(t *testing.T) {
 		}
 		firstActivity, err := svc.CreateActivity(ctx, input)
 		require.NoError(t, err)
+		processJobs(t, ctx, svc)
 
 		_, err = svc.CreateActivity(ctx, input)
 		require.NoError(t, err)
+		processJobs(t, ctx, svc)
 
 		hexRepo := repository.NewHexRepository(client)
 		for _, idx := range validH3Indexes {
@@ -247,6 +261,7 @@ func TestActivityService(t *testing.T) {
 			}
 			_, err = svc.CreateActivity(ctx, activityReq)
 			require.NoError(t, err)
+			processJobs(t, ctx, svc)
 		}
 
 		for _, user := range createdUsers {
@@ -258,6 +273,7 @@ func TestActivityService(t *testing.T) {
 			}
 			_, err := svc.CreateActivity(ctx, activityReq)
 			require.NoError(t, err)
+			processJobs(t, ctx, svc)
 		}
 
 		for _, idx := range validH3Indexes {
@@ -314,6 +330,7 @@ func TestActivityService(t *testing.T) {
 			}
 			_, err = svc.CreateActivity(ctx, activityReq)
 			require.NoError(t, err)
+			processJobs(t, ctx, svc)
 
 			leaderboard, err := hexLeaderboardRepo.FindByH3Index(ctx, validH3Indexes[0])
 			require.NoError(t, err)
@@ -329,7 +346,6 @@ func TestActivityService(t *testing.T) {
 		ctx, client, svc := setupTest(t)
 
 		userRepo := repository.NewUserRepository(client)
-		hexLeaderboardRepo := repository.NewHexLeaderboardRepository(client)
 
 		usernames := []string{
 			"grzegorzbraun",
@@ -359,6 +375,7 @@ func TestActivityService(t *testing.T) {
 			if name != "andrzejleper" {
 				_, err = svc.CreateActivity(ctx, activityReq)
 				require.NoError(t, err)
+				processJobs(t, ctx, svc)
 			}
 		}
 
@@ -372,8 +389,9 @@ func TestActivityService(t *testing.T) {
 			}
 			_, err := svc.CreateActivity(ctx, activityReq)
 			require.NoError(t, err)
+			processJobs(t, ctx, svc)
 
-			positionPtr, err := hexLeaderboardRepo.GetUserPositionInLeaderboard(ctx, validH3Indexes[0], leper.ID)
+			positionPtr, err := svc.HexLeaderboardService.GetUserPositionInLeaderboard(ctx, validH3Indexes[0], leper.ID)
 			require.NoError(t, err)
 
 			if i == 0 {
@@ -384,4 +402,632 @@ func TestActivityService(t *testing.T) {
 			}
 		}
 	})
+
+	// ------------------------
+	// Subtest: DeleteActivity_RecomputesInfluenceAndLeaderboard
+	// ------------------------
+	t.Run("DeleteActivity_RecomputesInfluenceAndLeaderboard", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		hexInfluenceRepo := repository.NewHexInfluenceRepository(client)
+		hexLeaderboardRepo := repository.NewHexLeaderboardRepository(client)
+
+		alice, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		bob, err := userRepo.CreateUser(ctx, &model.User{Username: "bob", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		input := dto.CreateActivityRequest{
+			UserID:    alice.ID,
+			Duration:  150.0,
+			Distance:  2500.0,
+			H3Indexes: validH3Indexes,
+		}
+		first, err := svc.CreateActivity(ctx, input)
+		require.NoError(t, err)
+		processJobs(t, ctx, svc)
+		second, err := svc.CreateActivity(ctx, input)
+		require.NoError(t, err)
+		processJobs(t, ctx, svc)
+
+		// Bob visits only the first hex once, so Alice leads it 2.0 to 1.0
+		_, err = svc.CreateActivity(ctx, dto.CreateActivityRequest{
+			UserID:    bob.ID,
+			Duration:  150.0,
+			Distance:  2500.0,
+			H3Indexes: validH3Indexes[:1],
+		})
+		require.NoError(t, err)
+		processJobs(t, ctx, svc)
+
+		_, err = svc.DeleteActivity(ctx, second.ID, alice.ID)
+		require.NoError(t, err)
+
+		for _, idx := range validH3Indexes {
+			infl, err := hexInfluenceRepo.FindByUserIDAndHexID(ctx, alice.ID, idx)
+			require.NoError(t, err)
+			require.Equal(t, 1.0, infl.Score)
+		}
+
+		_, err = svc.DeleteActivity(ctx, first.ID, alice.ID)
+		require.NoError(t, err)
+
+		for _, idx := range validH3Indexes {
+			_, err := hexInfluenceRepo.FindByUserIDAndHexID(ctx, alice.ID, idx)
+			require.True(t, ent.IsNotFound(err))
+		}
+
+		leaderboard, err := hexLeaderboardRepo.FindByH3Index(ctx, validH3Indexes[0])
+		require.NoError(t, err)
+		require.Len(t, leaderboard.TopUsers, 1)
+		require.Equal(t, bob.ID, leaderboard.TopUsers[0].UserID)
+
+		leaderboard, err = hexLeaderboardRepo.FindByH3Index(ctx, validH3Indexes[1])
+		require.NoError(t, err)
+		require.Empty(t, leaderboard.TopUsers)
+	})
+
+	// ------------------------
+	// Subtest: DeleteActivity_NotOwned
+	// ------------------------
+	t.Run("DeleteActivity_NotOwned", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		alice, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		bob, err := userRepo.CreateUser(ctx, &model.User{Username: "bob", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		created, err := svc.CreateActivity(ctx, dto.CreateActivityRequest{
+			UserID:    alice.ID,
+			Duration:  150.0,
+			Distance:  2500.0,
+			H3Indexes: validH3Indexes,
+		})
+		require.NoError(t, err)
+
+		_, err = svc.DeleteActivity(ctx, created.ID, bob.ID)
+		require.ErrorIs(t, err, service.ErrActivityNotOwned)
+
+		_, err = client.Activity.Get(ctx, created.ID)
+		require.NoError(t, err)
+	})
+
+	// ------------------------
+	// Subtest: CreateActivity_IdempotentReplay
+	// ------------------------
+	t.Run("CreateActivity_IdempotentReplay", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		hexInfluenceRepo := repository.NewHexInfluenceRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		input := dto.CreateActivityRequest{
+			UserID:         createdUser.ID,
+			Duration:       150.0,
+			Distance:       2500.0,
+			H3Indexes:      validH3Indexes,
+			IdempotencyKey: "run-2025-06-01",
+		}
+		first, err := svc.CreateActivity(ctx, input)
+		require.NoError(t, err)
+		processJobs(t, ctx, svc)
+		require.False(t, first.Replayed)
+
+		replay, err := svc.CreateActivity(ctx, input)
+		require.NoError(t, err)
+		processJobs(t, ctx, svc)
+		require.True(t, replay.Replayed)
+		require.Equal(t, first.ID, replay.ID)
+
+		count, err := client.Activity.Query().Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		for _, idx := range validH3Indexes {
+			infl, err := hexInfluenceRepo.FindByUserIDAndHexID(ctx, createdUser.ID, idx)
+			require.NoError(t, err)
+			require.Equal(t, 1.0, infl.Score)
+		}
+
+		changed := input
+		changed.Distance = 3000.0
+		_, err = svc.CreateActivity(ctx, changed)
+		require.ErrorIs(t, err, service.ErrIdempotencyKeyReused)
+	})
+
+	// ------------------------
+	// Subtest: CreateActivity_AbandonedReservationExpires
+	// ------------------------
+	t.Run("CreateActivity_AbandonedReservationExpires", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		input := dto.CreateActivityRequest{
+			UserID:         createdUser.ID,
+			Duration:       150.0,
+			Distance:       2500.0,
+			H3Indexes:      validH3Indexes,
+			IdempotencyKey: "run-2025-06-02",
+		}
+		payload := input
+		payload.IdempotencyKey = ""
+
+		// A request that crashed after reserving the key never completes or aborts it
+		reservationID, _, err := svc.IdempotencyService.Begin(ctx, createdUser.ID, input.IdempotencyKey, payload)
+		require.NoError(t, err)
+		reservation, err := client.IdempotencyKey.Get(ctx, reservationID)
+		require.NoError(t, err)
+		require.WithinDuration(t, time.Now().Add(service.IdempotencyKeyLease), reservation.ExpiresAt, time.Second)
+
+		_, err = svc.CreateActivity(ctx, input)
+		require.ErrorIs(t, err, service.ErrIdempotencyKeyInProgress)
+
+		_, err = client.IdempotencyKey.UpdateOneID(reservationID).SetExpiresAt(time.Now().Add(-time.Second)).Save(ctx)
+		require.NoError(t, err)
+
+		resp, err := svc.CreateActivity(ctx, input)
+		require.NoError(t, err)
+		require.False(t, resp.Replayed)
+
+		completed, err := client.IdempotencyKey.Query().Only(ctx)
+		require.NoError(t, err)
+		require.NotEmpty(t, completed.Response)
+		require.WithinDuration(t, time.Now().Add(service.IdempotencyKeyTTL), completed.ExpiresAt, time.Second)
+
+		// The abandoned request can no longer store a response for the key
+		require.True(t, ent.IsNotFound(svc.IdempotencyService.Complete(ctx, reservationID, resp)))
+	})
+
+	// ------------------------
+	// Subtest: CreateActivitiesBatch_ChronologicalWithPerItemResults
+	// ------------------------
+	t.Run("CreateActivitiesBatch_ChronologicalWithPerItemResults", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		hexInfluenceRepo := repository.NewHexInfluenceRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		now := time.Now()
+		twoWeeksAgo := now.Add(-14 * 24 * time.Hour)
+		req := dto.CreateActivitiesBatchRequest{
+			UserID: createdUser.ID,
+			Activities: []dto.BatchActivityItem{
+				// Sent newest first, must still be scored oldest first
+				{Duration: 150, Distance: 2500, H3Indexes: validH3Indexes[:1], EndedAt: now, IdempotencyKey: "b"},
+				{Duration: 150, Distance: 2500, H3Indexes: []string{"invalid"}, EndedAt: now},
+				{Duration: 150, Distance: 2500, H3Indexes: validH3Indexes[:1], EndedAt: twoWeeksAgo, IdempotencyKey: "a"},
+				{Duration: 150, Distance: 2500, H3Indexes: validH3Indexes[:1], EndedAt: twoWeeksAgo, IdempotencyKey: "a"},
+			},
+		}
+		resp, err := svc.CreateActivitiesBatch(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 4)
+		processJobs(t, ctx, svc)
+
+		require.Equal(t, dto.BatchActivityCreated, resp.Results[0].Status)
+		require.Equal(t, dto.BatchActivityRejected, resp.Results[1].Status)
+		require.Contains(t, resp.Results[1].Reason, "invalid H3 index")
+		require.Equal(t, dto.BatchActivityCreated, resp.Results[2].Status)
+		require.Equal(t, dto.BatchActivityDuplicate, resp.Results[3].Status)
+		require.Equal(t, *resp.Results[2].ActivityID, *resp.Results[3].ActivityID)
+
+		// 1.0 two weeks ago, decayed by 0.8 and visited again now
+		infl, err := hexInfluenceRepo.FindByUserIDAndHexID(ctx, createdUser.ID, validH3Indexes[0])
+		require.NoError(t, err)
+		require.InDelta(t, 1.8, infl.Score, 1e-9)
+		require.WithinDuration(t, now, infl.LastUpdated, time.Second)
+
+		stored, err := client.Activity.Get(ctx, *resp.Results[2].ActivityID)
+		require.NoError(t, err)
+		require.WithinDuration(t, twoWeeksAgo, *stored.EndedAt, time.Second)
+	})
+
+	// ------------------------
+	// Subtest: CreateActivitiesBatch_ForwardsTrack
+	// ------------------------
+	t.Run("CreateActivitiesBatch_ForwardsTrack", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		// 1.5 km at 30 s per 100 m
+		startedAt := time.Now().Add(-time.Hour)
+		endedAt := startedAt.Add(15 * 30 * time.Second)
+		resp, err := svc.CreateActivitiesBatch(ctx, dto.CreateActivitiesBatchRequest{
+			UserID: createdUser.ID,
+			Activities: []dto.BatchActivityItem{{
+				Duration:  endedAt.Sub(startedAt).Seconds(),
+				Distance:  1500,
+				H3Indexes: validH3Indexes,
+				Track:     straightTrack(startedAt, 16, 30*time.Second),
+				StartedAt: &startedAt,
+				EndedAt:   endedAt,
+			}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, dto.BatchActivityCreated, resp.Results[0].Status)
+
+		stored, err := client.Activity.Get(ctx, *resp.Results[0].ActivityID)
+		require.NoError(t, err)
+		require.Len(t, stored.Track, 16)
+
+		// The fastest kilometer can only be timed from the track
+		records, err := svc.PersonalRecordService.GetRecords(ctx, createdUser.ID, model.ActivityTypeRun)
+		require.NoError(t, err)
+		var fastest1K *float64
+		for _, record := range records.Records {
+			if record.Record == model.RecordFastest1K {
+				fastest1K = &record.Value
+			}
+		}
+		require.NotNil(t, fastest1K)
+		require.InDelta(t, 300, *fastest1K, 1)
+	})
+
+	// ------------------------
+	// Subtest: CreateActivity_RejectsImplausibleTimestamps
+	// ------------------------
+	t.Run("CreateActivity_RejectsImplausibleTimestamps", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		now := time.Now()
+		hourAgo := now.Add(-time.Hour)
+		tomorrow := now.Add(24 * time.Hour)
+		longAgo := now.Add(-2 * service.MaxActivityAge)
+		cases := map[string]dto.CreateActivityRequest{
+			"future end":            {EndedAt: &tomorrow},
+			"start after end":       {StartedAt: &now, EndedAt: &hourAgo},
+			"duration too long":     {StartedAt: &hourAgo, EndedAt: &now, Duration: 2 * 3600},
+			"too old":               {EndedAt: &longAgo},
+			"derived future end":    {StartedAt: &now, Duration: 3600},
+			"derived span too long": {EndedAt: &now, Duration: 2 * service.MaxActivitySpan.Seconds()},
+		}
+		for name, req := range cases {
+			req.UserID = createdUser.ID
+			req.H3Indexes = validH3Indexes
+			req.Distance = 1000
+			if req.Duration == 0 {
+				req.Duration = 600
+			}
+			_, err := svc.CreateActivity(ctx, req)
+			require.Error(t, err, name)
+		}
+
+		resp, err := svc.CreateActivity(ctx, dto.CreateActivityRequest{
+			UserID:    createdUser.ID,
+			Duration:  1800,
+			Distance:  5000,
+			H3Indexes: validH3Indexes,
+			StartedAt: &hourAgo,
+		})
+		require.NoError(t, err)
+		require.WithinDuration(t, hourAgo.Add(30*time.Minute), resp.EndedAt, time.Second)
+	})
+
+	// ------------------------
+	// Subtest: GetUserActivityStats_BucketsByUserTimeZone
+	// ------------------------
+	t.Run("GetUserActivityStats_BucketsByUserTimeZone", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		_, err = svc.UserService.UpdateTimeZone(ctx, &service.UpdateTimeZoneRequest{UserID: createdUser.ID, TimeZone: "Europe/Warsaw"})
+		require.NoError(t, err)
+
+		// 00:30 yesterday in Warsaw is still the day before in UTC
+		warsaw, err := time.LoadLocation("Europe/Warsaw")
+		require.NoError(t, err)
+		nowInWarsaw := time.Now().In(warsaw)
+		startedAt := time.Date(nowInWarsaw.Year(), nowInWarsaw.Month(), nowInWarsaw.Day()-1, 0, 30, 0, 0, warsaw)
+
+		_, err = svc.CreateActivity(ctx, dto.CreateActivityRequest{
+			UserID:    createdUser.ID,
+			Duration:  1800,
+			Distance:  5000,
+			H3Indexes: validH3Indexes,
+			StartedAt: &startedAt,
+		})
+		require.NoError(t, err)
+
+		stats, err := svc.GetUserActivityStats(ctx, createdUser.ID)
+		require.NoError(t, err)
+		require.Equal(t, []int64{0, 0, 0, 0, 0, 1, 0}, stats.WeeklyActivities)
+	})
+	// ------------------------
+	// Subtest: CreateActivity_FailureStoresNothing
+	// ------------------------
+	t.Run("CreateActivity_FailureStoresNothing", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		// The scoring job cannot be queued after everything else was stored
+		client.ActivityJob.Use(func(next ent.Mutator) ent.Mutator {
+			return hook.ActivityJobFunc(func(ctx context.Context, m *ent.ActivityJobMutation) (ent.Value, error) {
+				if m.Op().Is(ent.OpCreate) {
+					return nil, errors.New("queue unavailable")
+				}
+				return next.Mutate(ctx, m)
+			})
+		})
+
+		_, err = svc.CreateActivity(ctx, dto.CreateActivityRequest{
+			UserID:    createdUser.ID,
+			Duration:  600,
+			Distance:  2000,
+			H3Indexes: validH3Indexes,
+		})
+		require.ErrorContains(t, err, "queue unavailable")
+
+		activities, err := client.Activity.Query().Count(ctx)
+		require.NoError(t, err)
+		require.Zero(t, activities)
+		hexes, err := client.Hex.Query().Count(ctx)
+		require.NoError(t, err)
+		require.Zero(t, hexes)
+		influences, err := client.HexInfluence.Query().Count(ctx)
+		require.NoError(t, err)
+		require.Zero(t, influences)
+		leaderboards, err := client.HexLeaderboard.Query().Count(ctx)
+		require.NoError(t, err)
+		require.Zero(t, leaderboards)
+		records, err := client.PersonalRecord.Query().Count(ctx)
+		require.NoError(t, err)
+		require.Zero(t, records)
+	})
+
+	// ------------------------
+	// Subtest: ProcessJob_RetriesThenDies
+	// ------------------------
+	t.Run("ProcessJob_RetriesThenDies", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		// The second hex's leaderboard cannot be written, after the first hex was fully scored
+		failing := true
+		client.HexLeaderboard.Use(func(next ent.Mutator) ent.Mutator {
+			return hook.HexLeaderboardFunc(func(ctx context.Context, m *ent.HexLeaderboardMutation) (ent.Value, error) {
+				if failing && m.Op().Is(ent.OpCreate) {
+					if h3Index, _ := m.H3Index(); h3Index == validH3Indexes[1] {
+						return nil, errors.New("leaderboard unavailable")
+					}
+				}
+				return next.Mutate(ctx, m)
+			})
+		})
+
+		created, err := svc.CreateActivity(ctx, dto.CreateActivityRequest{
+			UserID:    createdUser.ID,
+			Duration:  600,
+			Distance:  2000,
+			H3Indexes: validH3Indexes,
+		})
+		require.NoError(t, err)
+		require.Equal(t, model.ActivityJobPending, created.ProcessingStatus)
+
+		_, err = svc.JobService.ProcessPending(ctx)
+		require.ErrorContains(t, err, "leaderboard unavailable")
+
+		// The failed attempt left nothing behind and is retried later
+		influences, err := client.HexInfluence.Query().Count(ctx)
+		require.NoError(t, err)
+		require.Zero(t, influences)
+		status, err := svc.GetProcessingStatus(ctx, created.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.ActivityJobPending, status.Status)
+		require.Equal(t, 1, status.Attempts)
+		require.Contains(t, *status.LastError, "leaderboard unavailable")
+		require.True(t, status.NextAttemptAt.After(time.Now()))
+
+		processed, err := svc.JobService.ProcessPending(ctx)
+		require.NoError(t, err)
+		require.Zero(t, processed, "the retry is not due yet")
+
+		makeDue := func() {
+			_, err := client.ActivityJob.Update().SetRunAt(time.Now().Add(-time.Second)).Save(ctx)
+			require.NoError(t, err)
+		}
+		for attempt := 2; attempt <= service.MaxActivityJobAttempts; attempt++ {
+			makeDue()
+			_, err = svc.JobService.ProcessPending(ctx)
+			require.Error(t, err)
+		}
+
+		status, err = svc.GetProcessingStatus(ctx, created.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.ActivityJobDead, status.Status)
+		require.Equal(t, service.MaxActivityJobAttempts, status.Attempts)
+
+		// Dead jobs are not picked up again
+		failing = false
+		makeDue()
+		processed, err = svc.JobService.ProcessPending(ctx)
+		require.NoError(t, err)
+		require.Zero(t, processed)
+	})
+
+	// ------------------------
+	// Subtest: ProcessJob_RecoversAbandonedJob
+	// ------------------------
+	t.Run("ProcessJob_RecoversAbandonedJob", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		created, err := svc.CreateActivity(ctx, dto.CreateActivityRequest{
+			UserID:    createdUser.ID,
+			Duration:  600,
+			Distance:  2000,
+			H3Indexes: validH3Indexes,
+		})
+		require.NoError(t, err)
+
+		// A worker claimed the job and crashed before its lock ran out
+		_, err = client.ActivityJob.Update().
+			SetStatus(activityjob.StatusProcessing).
+			SetAttempts(1).
+			SetLockedUntil(time.Now().Add(-time.Second)).
+			Save(ctx)
+		require.NoError(t, err)
+
+		processed, err := svc.JobService.ProcessPending(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, processed)
+
+		status, err := svc.GetProcessingStatus(ctx, created.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.ActivityJobDone, status.Status)
+		require.Equal(t, 2, status.Attempts)
+		for _, idx := range validH3Indexes {
+			infl, err := client.HexInfluence.Query().Where(hexinfluence.H3IndexEQ(idx)).Only(ctx)
+			require.NoError(t, err)
+			require.Equal(t, 1.0, infl.Score)
+		}
+	})
+
+	// ------------------------
+	// Subtest: ProcessJob_OneUserInOrder
+	// ------------------------
+	t.Run("ProcessJob_OneUserInOrder", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		alice, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		bob, err := userRepo.CreateUser(ctx, &model.User{Username: "bob", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		create := func(userID uuid.UUID) *dto.CreateActivityResponse {
+			resp, err := svc.CreateActivity(ctx, dto.CreateActivityRequest{
+				UserID:    userID,
+				Duration:  600,
+				Distance:  2000,
+				H3Indexes: validH3Indexes,
+			})
+			require.NoError(t, err)
+			return resp
+		}
+		first := create(alice.ID)
+		second := create(alice.ID)
+		other := create(bob.ID)
+
+		// Another worker holds alice's first job
+		_, err = client.ActivityJob.Update().
+			Where(activityjob.ActivityIDEQ(first.ID)).
+			SetStatus(activityjob.StatusProcessing).
+			SetAttempts(1).
+			SetLockedUntil(time.Now().Add(time.Minute)).
+			Save(ctx)
+		require.NoError(t, err)
+
+		// Only bob's job can run, alice's second waits for her first
+		processed, err := svc.JobService.ProcessPending(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, processed)
+		status, err := svc.GetProcessingStatus(ctx, other.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.ActivityJobDone, status.Status)
+		status, err = svc.GetProcessingStatus(ctx, second.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.ActivityJobPending, status.Status)
+
+		// Once the lock runs out the first job is taken over, then the second can run
+		_, err = client.ActivityJob.Update().
+			Where(activityjob.ActivityIDEQ(first.ID)).
+			SetLockedUntil(time.Now().Add(-time.Second)).
+			Save(ctx)
+		require.NoError(t, err)
+		processed, err = svc.JobService.ProcessPending(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2, processed)
+		for _, id := range []uuid.UUID{first.ID, second.ID} {
+			status, err := svc.GetProcessingStatus(ctx, id)
+			require.NoError(t, err)
+			require.Equal(t, model.ActivityJobDone, status.Status)
+		}
+	})
+
+	// ------------------------
+	// Subtest: DeleteActivity_BeforeProcessing
+	// ------------------------
+	t.Run("DeleteActivity_BeforeProcessing", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		input := dto.CreateActivityRequest{
+			UserID:    createdUser.ID,
+			Duration:  600,
+			Distance:  2000,
+			H3Indexes: validH3Indexes,
+		}
+		first, err := svc.CreateActivity(ctx, input)
+		require.NoError(t, err)
+		processJobs(t, ctx, svc)
+		second, err := svc.CreateActivity(ctx, input)
+		require.NoError(t, err)
+		third, err := svc.CreateActivity(ctx, input)
+		require.NoError(t, err)
+
+		// The third activity's visits are not replayed, its job adds them when it runs
+		_, err = svc.DeleteActivity(ctx, second.ID, createdUser.ID)
+		require.NoError(t, err)
+		infl, err := client.HexInfluence.Query().Where(hexinfluence.H3IndexEQ(validH3Indexes[0])).Only(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1.0, infl.Score)
+
+		processJobs(t, ctx, svc)
+		infl, err = client.HexInfluence.Query().Where(hexinfluence.H3IndexEQ(validH3Indexes[0])).Only(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2.0, infl.Score)
+
+		jobs, err := client.ActivityJob.Query().Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2, jobs, "the deleted activity's job goes with it")
+		for _, id := range []uuid.UUID{first.ID, third.ID} {
+			status, err := svc.GetProcessingStatus(ctx, id)
+			require.NoError(t, err)
+			require.Equal(t, model.ActivityJobDone, status.Status)
+		}
+	})
 }
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/repository"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// MaxActivityJobAttempts is how many times a job is run before it is moved to the dead state.
+const MaxActivityJobAttempts = 5
+
+// activityJobBackoff is the pause before a failed job is retried. It doubles with every further attempt.
+const activityJobBackoff = 10 * time.Second
+
+// activityJobLease is how long a worker may take to process a claimed job before another worker
+// may claim it, which is how jobs held by a crashed worker are picked up again.
+const activityJobLease = 5 * time.Minute
+
+// errActivityJobLost reports that a worker finished a job it no longer held.
+var errActivityJobLost = errors.New("activity job was claimed by another worker or deleted")
+
+// ActivityJobHandler does the work of a claimed job. It runs inside the transaction that marks
+// the job done, so its effects are kept only if the job is.
+type ActivityJobHandler func(ctx context.Context, job *ent.ActivityJob) error
+
+// ActivityJobService runs the background jobs that score and rank stored activities.
+type ActivityJobService struct {
+	repository repository.ActivityJobRepository
+	transactor repository.Transactor
+	handler    ActivityJobHandler
+	logger     *zap.Logger
+}
+
+func NewActivityJobService(repositories *repository.Repositories, handler ActivityJobHandler, logger *zap.Logger) *ActivityJobService {
+	return &ActivityJobService{
+		repository: repositories.ActivityJobRepository,
+		transactor: repositories.Transactor,
+		handler:    handler,
+		logger:     logger,
+	}
+}
+
+// Enqueue adds a job for an activity that was just stored. Call it in the transaction that stores
+// the activity, so the job exists exactly when the activity does.
+func (s *ActivityJobService) Enqueue(ctx context.Context, activity *ent.Activity, alreadyScored int) (*ent.ActivityJob, error) {
+	now := time.Now()
+	return s.repository.CreateActivityJob(ctx, &model.ActivityJob{
+		ActivityID:    activity.ID,
+		UserID:        activity.UserID,
+		AlreadyScored: alreadyScored,
+		RunAt:         now,
+		CreatedAt:     now,
+	})
+}
+
+// GetStatus reports how far the processing of an activity has come. Activities without a job were
+// stored before processing moved to the queue, or their finished job was purged, so they are done.
+func (s *ActivityJobService) GetStatus(ctx context.Context, activityID uuid.UUID) (*dto.ActivityProcessingStatusResponse, error) {
+	job, err := s.repository.FindByActivityID(ctx, activityID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &dto.ActivityProcessingStatusResponse{ActivityID: activityID, Status: model.ActivityJobDone}, nil
+		}
+		return nil, err
+	}
+
+	resp := &dto.ActivityProcessingStatusResponse{
+		ActivityID: activityID,
+		Status:     job.Status.String(),
+		Attempts:   job.Attempts,
+		LastError:  job.LastError,
+		UpdatedAt:  &job.UpdatedAt,
+	}
+	if job.Status.String() == model.ActivityJobPending {
+		resp.NextAttemptAt = &job.RunAt
+	}
+	return resp, nil
+}
+
+// UnfinishedActivityIDs returns the user's activities whose influence has not been applied.
+func (s *ActivityJobService) UnfinishedActivityIDs(ctx context.Context, userID uuid.UUID) (map[uuid.UUID]bool, error) {
+	ids, err := s.repository.FindUnfinishedActivityIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	unfinished := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		unfinished[id] = true
+	}
+	return unfinished, nil
+}
+
+// ProcessNext claims one due job and runs it. It reports false when there was no job to claim.
+func (s *ActivityJobService) ProcessNext(ctx context.Context) (bool, error) {
+	job, err := s.claim(ctx)
+	if err != nil || job == nil {
+		return false, err
+	}
+
+	err = s.transactor.WithRetry(ctx, func(ctx context.Context) error {
+		if err := s.handler(ctx, job); err != nil {
+			return err
+		}
+		updated, err := s.repository.FinishActivityJob(ctx, job, model.ActivityJobDone, job.RunAt, nil, time.Now())
+		if err != nil {
+			return err
+		}
+		if updated == 0 {
+			return errActivityJobLost
+		}
+		return nil
+	})
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, errActivityJobLost) {
+		s.logger.Warn("Dropped the outcome of an activity job that is no longer held.", zap.Stringer("jobID", job.ID))
+		return true, nil
+	}
+	return true, s.fail(ctx, job, err)
+}
+
+// ProcessPending runs due jobs until none are left and returns how many were run.
+func (s *ActivityJobService) ProcessPending(ctx context.Context) (int, error) {
+	processed := 0
+	for {
+		found, err := s.ProcessNext(ctx)
+		if err != nil {
+			return processed, err
+		}
+		if !found {
+			return processed, nil
+		}
+		processed++
+	}
+}
+
+// Run processes jobs with the given number of workers until ctx is cancelled. A worker that finds
+// no due job waits pollInterval before looking again.
+func (s *ActivityJobService) Run(ctx context.Context, workers int, pollInterval time.Duration) {
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.work(ctx, pollInterval)
+		}()
+	}
+	wg.Wait()
+}
+
+func (s *ActivityJobService) work(ctx context.Context, pollInterval time.Duration) {
+	for {
+		found, err := s.ProcessNext(ctx)
+		if err != nil {
+			s.logger.Error("Activity job failed", zap.Error(err))
+		}
+		if found {
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// PurgeFinished deletes done jobs that finished more than olderThan ago.
+func (s *ActivityJobService) PurgeFinished(ctx context.Context, olderThan time.Duration) (int, error) {
+	return s.repository.DeleteFinishedBefore(ctx, time.Now().Add(-olderThan))
+}
+
+// claim finds the next due job and marks it as processing, returning nil when there is none.
+func (s *ActivityJobService) claim(ctx context.Context) (*ent.ActivityJob, error) {
+	var claimed *ent.ActivityJob
+	err := s.transactor.WithTx(ctx, func(ctx context.Context) error {
+		now := time.Now()
+		job, err := s.repository.FindNextDue(ctx, now)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		updated, err := s.repository.ClaimActivityJob(ctx, job, now, now.Add(activityJobLease))
+		if err != nil || updated == 0 {
+			return err
+		}
+		job.Attempts++
+		job.Status = model.ActivityJobProcessing
+		claimed = job
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
+
+// fail records a failed attempt, scheduling a retry with backoff or moving the job to the dead
+// state once it has used up its attempts. It returns the job's error.
+func (s *ActivityJobService) fail(ctx context.Context, job *ent.ActivityJob, jobErr error) error {
+	now := time.Now()
+	status := model.ActivityJobPending
+	runAt := now.Add(activityJobBackoff << (job.Attempts - 1))
+	if job.Attempts >= MaxActivityJobAttempts {
+		status = model.ActivityJobDead
+		runAt = now
+	}
+
+	message := jobErr.Error()
+	if _, err := s.repository.FinishActivityJob(ctx, job, status, runAt, &message, now); err != nil {
+		s.logger.Error("Failed to record activity job failure.", zap.Stringer("jobID", job.ID), zap.Error(err))
+	}
+	if status == model.ActivityJobDead {
+		s.logger.Error("Activity job is dead after too many attempts.",
+			zap.Stringer("jobID", job.ID), zap.Stringer("activityID", job.ActivityID), zap.Error(jobErr))
+	}
+	return jobErr
+}
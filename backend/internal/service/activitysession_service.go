@@ -0,0 +1,457 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/config"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/hex/hexconsts"
+	"stride-wars-app/internal/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uber/h3-go/v4"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrSessionNotOwned = errors.New("activity session does not belong to this user")
+	ErrSessionClosed   = errors.New("activity session is already finished or expired")
+	ErrSessionPaused   = errors.New("activity session is paused")
+)
+
+// ActivitySessionService records activities live: points are streamed while the user moves and
+// the session is turned into a regular activity when it is finished.
+type ActivitySessionService struct {
+	repository      repository.ActivitySessionRepository
+	transactor      repository.Transactor
+	activityService *ActivityService
+	config          config.Config
+	logger          *zap.Logger
+}
+
+func NewActivitySessionService(
+	repositories *repository.Repositories,
+	activityService *ActivityService,
+	cfg config.Config,
+	logger *zap.Logger,
+) *ActivitySessionService {
+	return &ActivitySessionService{
+		repository:      repositories.ActivitySessionRepository,
+		transactor:      repositories.Transactor,
+		activityService: activityService,
+		config:          cfg,
+		logger:          logger,
+	}
+}
+
+func (ss *ActivitySessionService) StartSession(ctx context.Context, req dto.StartActivitySessionRequest) (*dto.ActivitySessionResponse, error) {
+	if (req.UserID == uuid.Nil || req.UserID == uuid.UUID{}) {
+		return nil, errors.New("UserID is required")
+	}
+	activityType := req.ActivityType
+	if activityType == "" {
+		activityType = model.ActivityTypeRun
+	}
+	if !isValidActivityType(activityType) {
+		return nil, ErrInvalidActivityType
+	}
+	if _, err := ss.activityService.UserService.FindByID(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	session, err := ss.repository.CreateActivitySession(ctx, &model.ActivitySession{
+		UserID:       req.UserID,
+		ActivityType: activityType,
+		StartedAt:    now,
+		ResumedAt:    &now,
+		LastSeenAt:   now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toActivitySessionResponse(session, now), nil
+}
+
+func (ss *ActivitySessionService) GetSession(ctx context.Context, sessionID uuid.UUID, userID uuid.UUID) (*dto.ActivitySessionResponse, error) {
+	session, err := ss.findOwnedSession(ctx, sessionID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toActivitySessionResponse(session, time.Now()), nil
+}
+
+// AppendPoints adds a chunk of GPS points and/or cells to an active session. Points are converted
+// to cells at the default resolution and extend the distance covered. Consecutive repeats of the
+// same cell are collapsed, so the session keeps the ordered path the user took.
+func (ss *ActivitySessionService) AppendPoints(ctx context.Context, sessionID uuid.UUID, req dto.AppendActivitySessionPointsRequest) (*dto.ActivitySessionResponse, error) {
+	for _, point := range req.Points {
+		if err := validateTrackPoint(point); err != nil {
+			return nil, err
+		}
+	}
+	for _, h3Index := range req.H3Indexes {
+		if err := validateH3Index(h3Index); err != nil {
+			return nil, err
+		}
+	}
+	if req.Distance < 0 {
+		return nil, errors.New("distance cannot be negative")
+	}
+
+	var resp *dto.ActivitySessionResponse
+	err := ss.transactor.WithRetry(ctx, func(ctx context.Context) error {
+		session, err := ss.lockOwnedSession(ctx, sessionID, req.UserID)
+		if err != nil {
+			return err
+		}
+		switch session.Status {
+		case model.ActivitySessionPaused:
+			return ErrSessionPaused
+		case model.ActivitySessionFinished, model.ActivitySessionExpired:
+			return ErrSessionClosed
+		}
+
+		state := toModelSession(session)
+		for _, point := range req.Points {
+			trackPoint := model.TrackPoint{Lat: point.Lat, Lng: point.Lng, Timestamp: point.Timestamp}
+			if n := len(state.Track); n > 0 {
+				previous := state.Track[n-1]
+				state.DistanceMeters += h3.GreatCircleDistanceM(
+					h3.NewLatLng(previous.Lat, previous.Lng),
+					h3.NewLatLng(trackPoint.Lat, trackPoint.Lng),
+				)
+			}
+			state.Track = append(state.Track, trackPoint)
+
+			cell, err := h3.LatLngToCell(h3.NewLatLng(point.Lat, point.Lng), hexconsts.DefaultHexResolution)
+			if err != nil {
+				return err
+			}
+			state.H3Indexes = appendCell(state.H3Indexes, cell.String())
+		}
+		for _, h3Index := range req.H3Indexes {
+			state.H3Indexes = appendCell(state.H3Indexes, h3Index)
+		}
+		state.DistanceMeters += req.Distance
+
+		now := time.Now()
+		state.LastSeenAt = now
+
+		if ss.config.ActivitySessionLiveInfluence && state.ScoredCells < len(state.H3Indexes) {
+			user, err := ss.activityService.UserService.FindByID(ctx, state.UserID)
+			if err != nil {
+				return err
+			}
+			if err := ss.activityService.applyInfluence(ctx, user, nil, state.H3Indexes[state.ScoredCells:], now); err != nil {
+				return err
+			}
+			state.ScoredCells = len(state.H3Indexes)
+		}
+
+		updated, err := ss.repository.UpdateActivitySession(ctx, state)
+		if err != nil {
+			return err
+		}
+		resp = toActivitySessionResponse(updated, now)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// PauseSession stops the clock of an active session. Pausing a paused session is a no-op.
+func (ss *ActivitySessionService) PauseSession(ctx context.Context, sessionID uuid.UUID, userID uuid.UUID) (*dto.ActivitySessionResponse, error) {
+	return ss.transition(ctx, sessionID, userID, func(state *model.ActivitySession, now time.Time) {
+		if state.ResumedAt != nil {
+			state.ActiveSeconds += now.Sub(*state.ResumedAt).Seconds()
+			state.ResumedAt = nil
+		}
+		state.Status = model.ActivitySessionPaused
+	})
+}
+
+// ResumeSession restarts the clock of a paused session. Resuming an active session is a no-op.
+func (ss *ActivitySessionService) ResumeSession(ctx context.Context, sessionID uuid.UUID, userID uuid.UUID) (*dto.ActivitySessionResponse, error) {
+	return ss.transition(ctx, sessionID, userID, func(state *model.ActivitySession, now time.Time) {
+		if state.ResumedAt == nil {
+			state.ResumedAt = &now
+		}
+		state.Status = model.ActivitySessionActive
+	})
+}
+
+func (ss *ActivitySessionService) transition(ctx context.Context, sessionID uuid.UUID, userID uuid.UUID, apply func(state *model.ActivitySession, now time.Time)) (*dto.ActivitySessionResponse, error) {
+	var resp *dto.ActivitySessionResponse
+	err := ss.transactor.WithTx(ctx, func(ctx context.Context) error {
+		session, err := ss.lockOwnedSession(ctx, sessionID, userID)
+		if err != nil {
+			return err
+		}
+		if isClosedSession(session) {
+			return ErrSessionClosed
+		}
+
+		now := time.Now()
+		state := toModelSession(session)
+		apply(state, now)
+		state.LastSeenAt = now
+
+		updated, err := ss.repository.UpdateActivitySession(ctx, state)
+		if err != nil {
+			return err
+		}
+		resp = toActivitySessionResponse(updated, now)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// FinishSession turns the session into a regular activity.
+func (ss *ActivitySessionService) FinishSession(ctx context.Context, sessionID uuid.UUID, userID uuid.UUID) (*dto.CreateActivityResponse, error) {
+	var resp *dto.CreateActivityResponse
+	err := ss.transactor.WithRetry(ctx, func(ctx context.Context) error {
+		session, err := ss.lockOwnedSession(ctx, sessionID, userID)
+		if err != nil {
+			return err
+		}
+		if isClosedSession(session) {
+			return ErrSessionClosed
+		}
+
+		resp, err = ss.finish(ctx, toModelSession(session), time.Now())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (ss *ActivitySessionService) finish(ctx context.Context, state *model.ActivitySession, at time.Time) (*dto.CreateActivityResponse, error) {
+	track := make([]dto.TrackPoint, len(state.Track))
+	for i, point := range state.Track {
+		track[i] = dto.TrackPoint{Lat: point.Lat, Lng: point.Lng, Timestamp: point.Timestamp}
+	}
+	req := dto.CreateActivityRequest{
+		UserID:       state.UserID,
+		Duration:     activeDuration(state, at).Seconds(),
+		Distance:     state.DistanceMeters,
+		H3Indexes:    state.H3Indexes,
+		Track:        track,
+		ActivityType: state.ActivityType,
+		StartedAt:    &state.StartedAt,
+		EndedAt:      &at,
+	}
+	if err := ss.activityService.validateCreateActivity(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := ss.activityService.ingestActivity(ctx, req, state.ScoredCells)
+	if err != nil {
+		return nil, err
+	}
+
+	state.ActiveSeconds = req.Duration
+	state.ResumedAt = nil
+	state.Status = model.ActivitySessionFinished
+	state.ScoredCells = len(state.H3Indexes)
+	state.ActivityID = &resp.ID
+	state.LastSeenAt = at
+	if _, err := ss.repository.UpdateActivitySession(ctx, state); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ExpireStaleSessions closes sessions that have not been updated within the configured timeout.
+// With auto-finish enabled, sessions that hold a valid activity are finished as of their last
+// update instead of being discarded. The live influence of a discarded session is rolled back.
+// Returns the number of sessions closed.
+func (ss *ActivitySessionService) ExpireStaleSessions(ctx context.Context) (int, error) {
+	staleBefore := time.Now().Add(-ss.config.ActivitySessionTimeout)
+	stale, err := ss.repository.FindStale(ctx, staleBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	closed := 0
+	for _, session := range stale {
+		expired, err := ss.expireStaleSession(ctx, session.ID, staleBefore)
+		if err != nil {
+			ss.logger.Error("Failed to expire activity session.", zap.Stringer("sessionID", session.ID), zap.Error(err))
+			continue
+		}
+		if expired {
+			closed++
+		}
+	}
+	return closed, nil
+}
+
+// expireStaleSession finishes or expires a session found stale. Each step re-reads the session
+// in its own transaction and leaves it alone if it was closed or updated in the meantime. A
+// session that cannot be finished is expired in a separate transaction, so nothing the failed
+// finish wrote is kept.
+func (ss *ActivitySessionService) expireStaleSession(ctx context.Context, sessionID uuid.UUID, staleBefore time.Time) (bool, error) {
+	if ss.config.ActivitySessionAutoFinish {
+		closed := false
+		err := ss.transactor.WithRetry(ctx, func(ctx context.Context) error {
+			state, err := ss.lockStaleSession(ctx, sessionID, staleBefore)
+			if err != nil || state == nil {
+				return err
+			}
+			if _, err := ss.finish(ctx, state, state.LastSeenAt); err != nil {
+				return err
+			}
+			closed = true
+			return nil
+		})
+		if err == nil {
+			return closed, nil
+		}
+		ss.logger.Info("Stale activity session cannot be finished, expiring it.",
+			zap.Stringer("sessionID", sessionID), zap.Error(err))
+	}
+
+	closed := false
+	err := ss.transactor.WithTx(ctx, func(ctx context.Context) error {
+		state, err := ss.lockStaleSession(ctx, sessionID, staleBefore)
+		if err != nil || state == nil {
+			return err
+		}
+		if err := ss.rollBackLiveInfluence(ctx, state); err != nil {
+			return err
+		}
+		state.ActiveSeconds = activeDuration(state, state.LastSeenAt).Seconds()
+		state.ResumedAt = nil
+		state.Status = model.ActivitySessionExpired
+		if _, err := ss.repository.UpdateActivitySession(ctx, state); err != nil {
+			return err
+		}
+		closed = true
+		return nil
+	})
+	return closed, err
+}
+
+// lockStaleSession re-reads a session and locks it for the rest of the transaction. It returns
+// nil when the session was closed or received an update since it was found stale.
+func (ss *ActivitySessionService) lockStaleSession(ctx context.Context, sessionID uuid.UUID, staleBefore time.Time) (*model.ActivitySession, error) {
+	session, err := ss.repository.FindByIDForUpdate(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if isClosedSession(session) || !session.LastSeenAt.Before(staleBefore) {
+		return nil, nil
+	}
+	return toModelSession(session), nil
+}
+
+// rollBackLiveInfluence removes the influence a session applied while it was streamed, by
+// replaying the user's stored activities in the cells it scored.
+func (ss *ActivitySessionService) rollBackLiveInfluence(ctx context.Context, state *model.ActivitySession) error {
+	if state.ScoredCells == 0 {
+		return nil
+	}
+	activities, err := ss.activityService.repository.FindByUserID(ctx, state.UserID)
+	if err != nil {
+		return err
+	}
+	scoredHexes := uniqueH3Indexes(state.H3Indexes[:state.ScoredCells])
+	if err := ss.activityService.replayInfluence(ctx, state.UserID, activities, scoredHexes, nil); err != nil {
+		return fmt.Errorf("rolling back live influence: %w", err)
+	}
+	state.ScoredCells = 0
+	return nil
+}
+
+func (ss *ActivitySessionService) findOwnedSession(ctx context.Context, sessionID uuid.UUID, userID uuid.UUID) (*ent.ActivitySession, error) {
+	session, err := ss.repository.FindByID(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session.UserID != userID {
+		return nil, ErrSessionNotOwned
+	}
+	return session, nil
+}
+
+// lockOwnedSession is findOwnedSession for updates, it locks the session until the end of the
+// transaction.
+func (ss *ActivitySessionService) lockOwnedSession(ctx context.Context, sessionID uuid.UUID, userID uuid.UUID) (*ent.ActivitySession, error) {
+	session, err := ss.repository.FindByIDForUpdate(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session.UserID != userID {
+		return nil, ErrSessionNotOwned
+	}
+	return session, nil
+}
+
+func isClosedSession(session *ent.ActivitySession) bool {
+	return session.Status == model.ActivitySessionFinished || session.Status == model.ActivitySessionExpired
+}
+
+// activeDuration is the recorded time of a session up to at, excluding pauses.
+func activeDuration(state *model.ActivitySession, at time.Time) time.Duration {
+	active := time.Duration(state.ActiveSeconds * float64(time.Second))
+	if state.ResumedAt != nil && at.After(*state.ResumedAt) {
+		active += at.Sub(*state.ResumedAt)
+	}
+	return active
+}
+
+func appendCell(h3Indexes []string, h3Index string) []string {
+	if n := len(h3Indexes); n > 0 && h3Indexes[n-1] == h3Index {
+		return h3Indexes
+	}
+	return append(h3Indexes, h3Index)
+}
+
+func toModelSession(session *ent.ActivitySession) *model.ActivitySession {
+	return &model.ActivitySession{
+		ID:             session.ID,
+		UserID:         session.UserID,
+		Status:         session.Status.String(),
+		ActivityType:   session.ActivityType.String(),
+		H3Indexes:      session.H3Indexes,
+		Track:          session.Track,
+		DistanceMeters: session.DistanceMeters,
+		ActiveSeconds:  session.ActiveSeconds,
+		ScoredCells:    session.ScoredCells,
+		StartedAt:      session.StartedAt,
+		ResumedAt:      session.ResumedAt,
+		LastSeenAt:     session.LastSeenAt,
+		ActivityID:     session.ActivityID,
+	}
+}
+
+func toActivitySessionResponse(session *ent.ActivitySession, now time.Time) *dto.ActivitySessionResponse {
+	state := toModelSession(session)
+	h3Indexes := session.H3Indexes
+	if h3Indexes == nil {
+		h3Indexes = []string{}
+	}
+	return &dto.ActivitySessionResponse{
+		ID:           session.ID,
+		UserID:       session.UserID,
+		Status:       state.Status,
+		ActivityType: state.ActivityType,
+		Duration:     activeDuration(state, now).Seconds(),
+		Distance:     session.DistanceMeters,
+		H3Indexes:    h3Indexes,
+		StartedAt:    session.StartedAt,
+		ActivityID:   session.ActivityID,
+	}
+}
@@ -0,0 +1,284 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uber/h3-go/v4"
+	"go.uber.org/zap"
+
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/activitysession"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/config"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/repository"
+	"stride-wars-app/internal/service"
+	"stride-wars-app/internal/testutil"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// setupSessionTest returns an ActivitySessionService using cfg, the ActivityService whose jobs
+// score finished sessions, and a freshly created user.
+func setupSessionTest(t *testing.T, cfg config.Config) (context.Context, *ent.Client, *service.ActivitySessionService, *service.ActivityService, *ent.User) {
+	t.Helper()
+	svc := testutil.NewTestServices(t)
+	sessionService := service.NewActivitySessionService(svc.Repositories, svc.ActivityService, cfg, zap.NewExample())
+
+	user, err := svc.UserRepo.CreateUser(svc.Ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+	require.NoError(t, err)
+	return svc.Ctx, svc.Client, sessionService, svc.ActivityService, user
+}
+
+// cellCenter returns a track point in the middle of the given cell.
+func cellCenter(t *testing.T, h3Index string, at time.Time) dto.TrackPoint {
+	t.Helper()
+	latLng, err := h3.Cell(h3.IndexFromString(h3Index)).LatLng()
+	require.NoError(t, err)
+	return dto.TrackPoint{Lat: latLng.Lat, Lng: latLng.Lng, Timestamp: at}
+}
+
+func TestActivitySessionService(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: Lifecycle_FinishCreatesActivity
+	// ------------------------
+	t.Run("Lifecycle_FinishCreatesActivity", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, sessionService, activityService, user := setupSessionTest(t, config.Default())
+		hexInfluenceRepo := repository.NewHexInfluenceRepository(client)
+
+		started, err := sessionService.StartSession(ctx, dto.StartActivitySessionRequest{UserID: user.ID})
+		require.NoError(t, err)
+		require.Equal(t, model.ActivitySessionActive, started.Status)
+
+		now := time.Now()
+		appended, err := sessionService.AppendPoints(ctx, started.ID, dto.AppendActivitySessionPointsRequest{
+			UserID: user.ID,
+			Points: []dto.TrackPoint{
+				cellCenter(t, validH3Indexes[0], now),
+				cellCenter(t, validH3Indexes[0], now.Add(time.Second)),
+				cellCenter(t, validH3Indexes[1], now.Add(2*time.Second)),
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, validH3Indexes, appended.H3Indexes)
+		require.Greater(t, appended.Distance, 0.0)
+
+		// Influence is only applied on finish by default
+		exists, err := client.HexInfluence.Query().Exist(ctx)
+		require.NoError(t, err)
+		require.False(t, exists)
+
+		paused, err := sessionService.PauseSession(ctx, started.ID, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.ActivitySessionPaused, paused.Status)
+
+		_, err = sessionService.AppendPoints(ctx, started.ID, dto.AppendActivitySessionPointsRequest{
+			UserID:    user.ID,
+			H3Indexes: validH3Indexes[:1],
+		})
+		require.ErrorIs(t, err, service.ErrSessionPaused)
+
+		resumed, err := sessionService.ResumeSession(ctx, started.ID, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.ActivitySessionActive, resumed.Status)
+
+		finished, err := sessionService.FinishSession(ctx, started.ID, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.ActivityJobPending, finished.ProcessingStatus)
+		processJobs(t, ctx, activityService)
+
+		activity, err := client.Activity.Get(ctx, finished.ID)
+		require.NoError(t, err)
+		require.Equal(t, validH3Indexes, activity.H3Indexes)
+		require.Len(t, activity.Track, 3)
+
+		for _, idx := range validH3Indexes {
+			infl, err := hexInfluenceRepo.FindByUserIDAndHexID(ctx, user.ID, idx)
+			require.NoError(t, err)
+			require.Equal(t, 1.0, infl.Score)
+		}
+
+		session, err := sessionService.GetSession(ctx, started.ID, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.ActivitySessionFinished, session.Status)
+		require.Equal(t, finished.ID, *session.ActivityID)
+
+		_, err = sessionService.FinishSession(ctx, started.ID, user.ID)
+		require.ErrorIs(t, err, service.ErrSessionClosed)
+	})
+
+	// ------------------------
+	// Subtest: LiveInfluence_ScoresCellsOnce
+	// ------------------------
+	t.Run("LiveInfluence_ScoresCellsOnce", func(t *testing.T) {
+		t.Parallel()
+		cfg := config.Default()
+		cfg.ActivitySessionLiveInfluence = true
+		ctx, client, sessionService, activityService, user := setupSessionTest(t, cfg)
+		hexInfluenceRepo := repository.NewHexInfluenceRepository(client)
+
+		started, err := sessionService.StartSession(ctx, dto.StartActivitySessionRequest{UserID: user.ID})
+		require.NoError(t, err)
+
+		_, err = sessionService.AppendPoints(ctx, started.ID, dto.AppendActivitySessionPointsRequest{
+			UserID:    user.ID,
+			H3Indexes: validH3Indexes[:1],
+			Distance:  500,
+		})
+		require.NoError(t, err)
+
+		infl, err := hexInfluenceRepo.FindByUserIDAndHexID(ctx, user.ID, validH3Indexes[0])
+		require.NoError(t, err)
+		require.Equal(t, 1.0, infl.Score)
+
+		_, err = sessionService.AppendPoints(ctx, started.ID, dto.AppendActivitySessionPointsRequest{
+			UserID:    user.ID,
+			H3Indexes: validH3Indexes[1:],
+			Distance:  500,
+		})
+		require.NoError(t, err)
+
+		_, err = sessionService.FinishSession(ctx, started.ID, user.ID)
+		require.NoError(t, err)
+		processJobs(t, ctx, activityService)
+
+		for _, idx := range validH3Indexes {
+			infl, err := hexInfluenceRepo.FindByUserIDAndHexID(ctx, user.ID, idx)
+			require.NoError(t, err)
+			require.Equal(t, 1.0, infl.Score)
+		}
+	})
+
+	// ------------------------
+	// Subtest: NotOwned
+	// ------------------------
+	t.Run("NotOwned", func(t *testing.T) {
+		t.Parallel()
+		ctx, _, sessionService, _, user := setupSessionTest(t, config.Default())
+
+		started, err := sessionService.StartSession(ctx, dto.StartActivitySessionRequest{UserID: user.ID})
+		require.NoError(t, err)
+
+		_, err = sessionService.AppendPoints(ctx, started.ID, dto.AppendActivitySessionPointsRequest{
+			UserID:    uuid.New(),
+			H3Indexes: validH3Indexes,
+		})
+		require.ErrorIs(t, err, service.ErrSessionNotOwned)
+	})
+
+	// ------------------------
+	// Subtest: ExpireStaleSessions
+	// ------------------------
+	t.Run("ExpireStaleSessions", func(t *testing.T) {
+		t.Parallel()
+		cfg := config.Default()
+		cfg.ActivitySessionAutoFinish = true
+		ctx, client, sessionService, _, user := setupSessionTest(t, cfg)
+
+		withCells, err := sessionService.StartSession(ctx, dto.StartActivitySessionRequest{UserID: user.ID})
+		require.NoError(t, err)
+		_, err = sessionService.AppendPoints(ctx, withCells.ID, dto.AppendActivitySessionPointsRequest{
+			UserID:    user.ID,
+			H3Indexes: validH3Indexes,
+			Distance:  1000,
+		})
+		require.NoError(t, err)
+
+		empty, err := sessionService.StartSession(ctx, dto.StartActivitySessionRequest{UserID: user.ID})
+		require.NoError(t, err)
+
+		fresh, err := sessionService.StartSession(ctx, dto.StartActivitySessionRequest{UserID: user.ID})
+		require.NoError(t, err)
+
+		abandonedAt := time.Now().Add(-time.Hour)
+		_, err = client.ActivitySession.Update().
+			Where(activitysession.IDIn(withCells.ID, empty.ID)).
+			SetStartedAt(abandonedAt.Add(-10 * time.Minute)).
+			SetResumedAt(abandonedAt.Add(-10 * time.Minute)).
+			SetLastSeenAt(abandonedAt).
+			Save(ctx)
+		require.NoError(t, err)
+
+		closed, err := sessionService.ExpireStaleSessions(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2, closed)
+
+		finished, err := sessionService.GetSession(ctx, withCells.ID, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.ActivitySessionFinished, finished.Status)
+		require.NotNil(t, finished.ActivityID)
+
+		activity, err := client.Activity.Get(ctx, *finished.ActivityID)
+		require.NoError(t, err)
+		require.WithinDuration(t, abandonedAt, *activity.EndedAt, time.Second)
+
+		expired, err := sessionService.GetSession(ctx, empty.ID, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.ActivitySessionExpired, expired.Status)
+
+		stillOpen, err := sessionService.GetSession(ctx, fresh.ID, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.ActivitySessionActive, stillOpen.Status)
+	})
+
+	// ------------------------
+	// Subtest: ExpireStaleSessions_RollsBackLiveInfluence
+	// ------------------------
+	t.Run("ExpireStaleSessions_RollsBackLiveInfluence", func(t *testing.T) {
+		t.Parallel()
+		cfg := config.Default()
+		cfg.ActivitySessionLiveInfluence = true
+		ctx, client, sessionService, activityService, user := setupSessionTest(t, cfg)
+		hexInfluenceRepo := repository.NewHexInfluenceRepository(client)
+
+		_, err := activityService.CreateActivity(ctx, dto.CreateActivityRequest{
+			UserID:    user.ID,
+			Duration:  600,
+			Distance:  2000,
+			H3Indexes: validH3Indexes[:1],
+		})
+		require.NoError(t, err)
+		processJobs(t, ctx, activityService)
+
+		started, err := sessionService.StartSession(ctx, dto.StartActivitySessionRequest{UserID: user.ID})
+		require.NoError(t, err)
+		_, err = sessionService.AppendPoints(ctx, started.ID, dto.AppendActivitySessionPointsRequest{
+			UserID:    user.ID,
+			H3Indexes: validH3Indexes,
+			Distance:  1000,
+		})
+		require.NoError(t, err)
+
+		infl, err := hexInfluenceRepo.FindByUserIDAndHexID(ctx, user.ID, validH3Indexes[0])
+		require.NoError(t, err)
+		require.Equal(t, 2.0, infl.Score)
+
+		_, err = client.ActivitySession.UpdateOneID(started.ID).SetLastSeenAt(time.Now().Add(-time.Hour)).Save(ctx)
+		require.NoError(t, err)
+		closed, err := sessionService.ExpireStaleSessions(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, closed)
+
+		expired, err := sessionService.GetSession(ctx, started.ID, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.ActivitySessionExpired, expired.Status)
+
+		// Only the stored activity's visit is left
+		infl, err = hexInfluenceRepo.FindByUserIDAndHexID(ctx, user.ID, validH3Indexes[0])
+		require.NoError(t, err)
+		require.Equal(t, 1.0, infl.Score)
+		_, err = hexInfluenceRepo.FindByUserIDAndHexID(ctx, user.ID, validH3Indexes[1])
+		require.True(t, ent.IsNotFound(err))
+
+		leaderboard, err := repository.NewHexLeaderboardRepository(client).FindByH3Index(ctx, validH3Indexes[1])
+		require.NoError(t, err)
+		require.Empty(t, leaderboard.TopUsers)
+	})
+}
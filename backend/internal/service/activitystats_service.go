@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	GranularityDay   = "day"
+	GranularityWeek  = "week"
+	GranularityMonth = "month"
+	GranularityYear  = "year"
+)
+
+// MaxStatsPeriods is the largest number of periods returned by one stats query.
+const MaxStatsPeriods = 366
+
+// backfillBatchSize is how many activities are indexed per step of BackfillActivityHexes.
+const backfillBatchSize = 500
+
+var (
+	ErrInvalidGranularity = errors.New("granularity must be one of day, week, month or year")
+	ErrInvalidStatsRange  = errors.New("range must be between 1 and " + strconv.Itoa(MaxStatsPeriods) + " periods")
+)
+
+// defaultStatsPeriods is how many periods are returned when no range is given.
+var defaultStatsPeriods = map[string]int{
+	GranularityDay:   30,
+	GranularityWeek:  12,
+	GranularityMonth: 12,
+	GranularityYear:  5,
+}
+
+// ActivityStatsService aggregates a user's activities into calendar periods of their time zone.
+type ActivityStatsService struct {
+	activityRepository    repository.ActivityRepository
+	activityHexRepository repository.ActivityHexRepository
+	userService           *UserService
+	logger                *zap.Logger
+}
+
+func NewActivityStatsService(repositories *repository.Repositories, userService *UserService, logger *zap.Logger) *ActivityStatsService {
+	return &ActivityStatsService{
+		activityRepository:    repositories.ActivityRepository,
+		activityHexRepository: repositories.ActivityHexRepository,
+		userService:           userService,
+		logger:                logger,
+	}
+}
+
+// GetPeriodStats returns the user's totals for the last periods periods of the given
+// granularity, oldest first and ending with the current one. Empty periods are included.
+func (ss *ActivityStatsService) GetPeriodStats(ctx context.Context, userID uuid.UUID, granularity string, periods int) (*dto.GetActivityPeriodStatsResponse, error) {
+	if _, ok := defaultStatsPeriods[granularity]; !ok {
+		return nil, ErrInvalidGranularity
+	}
+	if periods == 0 {
+		periods = defaultStatsPeriods[granularity]
+	}
+	if periods < 1 || periods > MaxStatsPeriods {
+		return nil, ErrInvalidStatsRange
+	}
+
+	user, err := ss.userService.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	location := UserLocation(user)
+	buckets := periodBuckets(time.Now().In(location), granularity, periods)
+
+	totals, err := ss.activityRepository.AggregateByPeriod(ctx, userID, buckets)
+	if err != nil {
+		return nil, err
+	}
+	uniqueHexes, err := ss.activityHexRepository.CountUniqueByPeriod(ctx, userID, buckets)
+	if err != nil {
+		return nil, err
+	}
+	newHexes, err := ss.activityHexRepository.CountNewByPeriod(ctx, userID, buckets)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]dto.ActivityPeriodStats, len(buckets))
+	for i, bucket := range buckets {
+		stats[i] = dto.ActivityPeriodStats{PeriodStart: bucket.Start, PeriodEnd: bucket.End}
+	}
+	for _, total := range totals {
+		period := &stats[total.Bucket]
+		period.Activities = total.Activities
+		period.Distance = total.Distance
+		period.Duration = total.Duration
+		if total.Distance > 0 {
+			period.AveragePace = total.Duration / (total.Distance / 1000)
+		}
+	}
+	for _, count := range uniqueHexes {
+		stats[count.Bucket].UniqueHexes = count.Hexes
+	}
+	for _, count := range newHexes {
+		stats[count.Bucket].NewHexes = count.Hexes
+	}
+
+	return &dto.GetActivityPeriodStatsResponse{
+		Granularity: granularity,
+		TimeZone:    location.String(),
+		Periods:     stats,
+	}, nil
+}
+
+// BackfillActivityHexes indexes the hexes of activities stored before activity hexes were
+// recorded on ingestion. Returns the number of activities indexed.
+func (ss *ActivityStatsService) BackfillActivityHexes(ctx context.Context) (int, error) {
+	indexed := 0
+	cursor := uuid.Nil
+	for {
+		activities, err := ss.activityRepository.FindWithoutHexes(ctx, cursor, backfillBatchSize)
+		if err != nil {
+			return indexed, err
+		}
+		for _, activity := range activities {
+			cursor = activity.ID
+			if len(activity.H3Indexes) == 0 {
+				continue
+			}
+			err := ss.activityHexRepository.CreateActivityHexes(ctx, activity.ID, activity.UserID,
+				uniqueH3Indexes(activity.H3Indexes), activityStartedAt(activity))
+			if err != nil {
+				return indexed, err
+			}
+			indexed++
+		}
+		if len(activities) < backfillBatchSize {
+			return indexed, nil
+		}
+	}
+}
+
+// periodBuckets returns periods consecutive periods ending with the one containing now,
+// with boundaries at local midnight of now's location.
+func periodBuckets(now time.Time, granularity string, periods int) []repository.TimeBucket {
+	current := periodStart(now, granularity)
+	buckets := make([]repository.TimeBucket, periods)
+	for i := range buckets {
+		start := addPeriods(current, granularity, i-periods+1)
+		buckets[i] = repository.TimeBucket{Start: start, End: addPeriods(start, granularity, 1)}
+	}
+	return buckets
+}
+
+// periodStart is the start of the period containing t. Weeks start on Monday.
+func periodStart(t time.Time, granularity string) time.Time {
+	year, month, day := t.Date()
+	switch granularity {
+	case GranularityWeek:
+		daysSinceMonday := (int(t.Weekday()) + 6) % 7
+		return time.Date(year, month, day-daysSinceMonday, 0, 0, 0, 0, t.Location())
+	case GranularityMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+	case GranularityYear:
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+	}
+}
+
+func addPeriods(t time.Time, granularity string, n int) time.Time {
+	switch granularity {
+	case GranularityWeek:
+		return t.AddDate(0, 0, 7*n)
+	case GranularityMonth:
+		return t.AddDate(0, n, 0)
+	case GranularityYear:
+		return t.AddDate(n, 0, 0)
+	default:
+		return t.AddDate(0, 0, n)
+	}
+}
@@ -0,0 +1,132 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/repository"
+	"stride-wars-app/internal/service"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestActivityStatsService(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: GetPeriodStats_Daily
+	// ------------------------
+	t.Run("GetPeriodStats_Daily", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		now := time.Now()
+		yesterday := now.AddDate(0, 0, -1)
+		activities := []dto.CreateActivityRequest{
+			{Duration: 1500, Distance: 5000, H3Indexes: validH3Indexes[:1], StartedAt: &yesterday},
+			{Duration: 60, Distance: 200, H3Indexes: validH3Indexes, StartedAt: &now},
+			{Duration: 60, Distance: 200, H3Indexes: []string{validH3Indexes[0], validH3Indexes[0]}, StartedAt: &now},
+		}
+		for _, req := range activities {
+			req.UserID = createdUser.ID
+			_, err := svc.CreateActivity(ctx, req)
+			require.NoError(t, err)
+		}
+
+		stats, err := svc.StatsService.GetPeriodStats(ctx, createdUser.ID, service.GranularityDay, 3)
+		require.NoError(t, err)
+		require.Equal(t, "UTC", stats.TimeZone)
+		require.Len(t, stats.Periods, 3)
+
+		require.Equal(t, int64(0), stats.Periods[0].Activities)
+
+		yesterdayStats := stats.Periods[1]
+		require.Equal(t, int64(1), yesterdayStats.Activities)
+		require.Equal(t, 5000.0, yesterdayStats.Distance)
+		require.InDelta(t, 300.0, yesterdayStats.AveragePace, 1e-9)
+		require.Equal(t, int64(1), yesterdayStats.UniqueHexes)
+		require.Equal(t, int64(1), yesterdayStats.NewHexes)
+
+		todayStats := stats.Periods[2]
+		require.Equal(t, int64(2), todayStats.Activities)
+		require.Equal(t, 400.0, todayStats.Distance)
+		require.Equal(t, 120.0, todayStats.Duration)
+		require.Equal(t, int64(2), todayStats.UniqueHexes)
+		require.Equal(t, int64(1), todayStats.NewHexes)
+		require.True(t, todayStats.PeriodStart.Before(now) && todayStats.PeriodEnd.After(now))
+	})
+
+	// ------------------------
+	// Subtest: GetPeriodStats_InvalidArguments
+	// ------------------------
+	t.Run("GetPeriodStats_InvalidArguments", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		_, err = svc.StatsService.GetPeriodStats(ctx, createdUser.ID, "fortnight", 0)
+		require.ErrorIs(t, err, service.ErrInvalidGranularity)
+
+		_, err = svc.StatsService.GetPeriodStats(ctx, createdUser.ID, service.GranularityMonth, service.MaxStatsPeriods+1)
+		require.ErrorIs(t, err, service.ErrInvalidStatsRange)
+
+		stats, err := svc.StatsService.GetPeriodStats(ctx, createdUser.ID, service.GranularityYear, 0)
+		require.NoError(t, err)
+		require.Len(t, stats.Periods, 5)
+		require.Equal(t, time.January, stats.Periods[0].PeriodStart.Month())
+	})
+
+	// ------------------------
+	// Subtest: BackfillActivityHexes
+	// ------------------------
+	t.Run("BackfillActivityHexes", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		_, err = client.Activity.Create().
+			SetUserID(createdUser.ID).
+			SetDurationSeconds(60).
+			SetDistanceMeters(1000).
+			SetH3Indexes(append(validH3Indexes, validH3Indexes[0])).
+			Save(ctx)
+		require.NoError(t, err)
+		// Activities without hexes never get any indexed and must not stall the backfill
+		for range 3 {
+			_, err = client.Activity.Create().
+				SetUserID(createdUser.ID).
+				SetDurationSeconds(60).
+				SetDistanceMeters(1000).
+				SetH3Indexes([]string{}).
+				Save(ctx)
+			require.NoError(t, err)
+		}
+
+		indexed, err := svc.StatsService.BackfillActivityHexes(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, indexed)
+
+		count, err := client.ActivityHex.Query().Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+
+		indexed, err = svc.StatsService.BackfillActivityHexes(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 0, indexed)
+	})
+}
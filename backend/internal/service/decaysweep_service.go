@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/config"
+	"stride-wars-app/internal/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// DecaySweepService runs the background sweep that prunes the hex influences whose decayed score
+// faded below a threshold and rebuilds the leaderboards of the hexes with idle influences, whose
+// ranking decay alone may have changed. Stored scores are left as the last visit wrote them:
+// decay is applied from the last visit whenever a score is read or visited again, and writing
+// a decayed score back would decay it twice. Without the sweep, the table only grows.
+type DecaySweepService struct {
+	repository             repository.DecaySweepRepository
+	hexInfluenceRepository repository.HexInfluenceRepository
+	historyRepository      repository.InfluenceHistoryRepository
+	transactor             repository.Transactor
+	hexLeaderboardService  *HexLeaderboardService
+	scoring                ScoringStrategy
+	interval               time.Duration
+	minIdle                time.Duration
+	chunkSize              int
+	pruneThreshold         float64
+	logger                 *zap.Logger
+}
+
+func NewDecaySweepService(repositories *repository.Repositories, hexLeaderboardService *HexLeaderboardService, scoring ScoringStrategy, cfg config.Config, logger *zap.Logger) *DecaySweepService {
+	return &DecaySweepService{
+		repository:             repositories.DecaySweepRepository,
+		hexInfluenceRepository: repositories.HexInfluenceRepository,
+		historyRepository:      repositories.InfluenceHistoryRepository,
+		transactor:             repositories.Transactor,
+		hexLeaderboardService:  hexLeaderboardService,
+		scoring:                scoring,
+		interval:               cfg.DecaySweepInterval,
+		minIdle:                cfg.DecaySweepMinIdle,
+		chunkSize:              cfg.DecaySweepChunkSize,
+		pruneThreshold:         cfg.DecayPruneThreshold,
+		logger:                 logger,
+	}
+}
+
+// SweepIfDue resumes the sweep that was interrupted, or starts a new one if the last one started
+// at least the sweep interval ago, and runs it to the end. It returns nil when no sweep was due.
+func (s *DecaySweepService) SweepIfDue(ctx context.Context) (*ent.DecaySweep, error) {
+	sweep, err := s.repository.FindLatest(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if sweep == nil || (sweep.FinishedAt != nil && time.Since(sweep.StartedAt) >= s.interval) {
+		if sweep, err = s.repository.CreateDecaySweep(ctx, time.Now()); err != nil {
+			return nil, err
+		}
+	} else if sweep.FinishedAt != nil {
+		return nil, nil
+	}
+
+	for {
+		done, err := s.ProcessChunk(ctx, sweep.ID)
+		if err != nil {
+			return nil, err
+		}
+		if done {
+			break
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+	}
+
+	sweep, err = s.repository.FindByID(ctx, sweep.ID)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("Finished decay sweep.",
+		zap.Stringer("sweepID", sweep.ID),
+		zap.Int("processed", sweep.Processed),
+		zap.Int("decayed", sweep.Decayed),
+		zap.Int("pruned", sweep.Pruned),
+		zap.Int("ownerChanges", sweep.OwnerChanges))
+	return sweep, nil
+}
+
+// ProcessChunk sweeps the next chunk of influences after the sweep's cursor and checkpoints the
+// sweep in the same transaction, so a chunk is either fully applied and recorded or not at all.
+// It reports true once the sweep has finished.
+func (s *DecaySweepService) ProcessChunk(ctx context.Context, sweepID uuid.UUID) (bool, error) {
+	done := false
+	err := s.transactor.WithRetry(ctx, func(ctx context.Context) error {
+		sweep, err := s.repository.FindByIDForUpdate(ctx, sweepID)
+		if err != nil {
+			return err
+		}
+		if sweep.FinishedAt != nil {
+			done = true
+			return nil
+		}
+
+		influences, err := s.hexInfluenceRepository.FindAfterIDForUpdate(ctx, sweep.Cursor, s.chunkSize)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		if len(influences) == 0 {
+			_, err := s.repository.FinishDecaySweep(ctx, sweep.ID, now)
+			done = err == nil
+			return err
+		}
+
+		decayed, pruned := s.idle(influences, sweep.StartedAt)
+		prunedIDs := make([]uuid.UUID, len(pruned))
+		for i, influence := range pruned {
+			prunedIDs[i] = influence.ID
+		}
+		if _, err := s.hexInfluenceRepository.DeleteByIDs(ctx, prunedIDs); err != nil {
+			return err
+		}
+		if err := s.historyRepository.CreateInfluenceHistories(ctx, sweepHistory(pruned, sweep.StartedAt)); err != nil {
+			return err
+		}
+
+		ownerChanges, err := s.hexLeaderboardService.RebuildLeaderboards(ctx, affectedHexes(decayed, pruned))
+		if err != nil {
+			return err
+		}
+		cursor := influences[len(influences)-1].ID
+		return s.repository.SaveProgress(ctx, sweep.ID, cursor, len(influences), len(decayed), len(pruned), ownerChanges, now)
+	})
+	return done, err
+}
+
+// idle returns the influences idle for at least the minimum idle time at asOf, split into the
+// ones kept and the ones whose score decayed up to asOf fell below the prune threshold.
+// Influences visited since asOf are left alone.
+func (s *DecaySweepService) idle(influences []*ent.HexInfluence, asOf time.Time) (decayed, pruned []*model.HexInfluence) {
+	for _, influence := range influences {
+		idle := asOf.Sub(influence.LastUpdated)
+		if idle < s.minIdle {
+			continue
+		}
+		swept := &model.HexInfluence{
+			ID:          influence.ID,
+			UserID:      influence.UserID,
+			H3Index:     influence.H3Index,
+			Score:       influence.Score,
+			LastUpdated: influence.LastUpdated,
+		}
+		if s.scoring.Decay(influence.Score, idle) < s.pruneThreshold {
+			pruned = append(pruned, swept)
+		} else {
+			decayed = append(decayed, swept)
+		}
+	}
+	return decayed, pruned
+}
+
+// sweepHistory returns the history entries of the pruned influences dropping to 0.
+func sweepHistory(pruned []*model.HexInfluence, asOf time.Time) []*model.InfluenceHistory {
+	history := make([]*model.InfluenceHistory, 0, len(pruned))
+	for _, influence := range pruned {
+		history = append(history, &model.InfluenceHistory{
+			UserID:      influence.UserID,
+			H3Index:     influence.H3Index,
+			ScoreBefore: influence.Score,
+			ScoreAfter:  0,
+			RecordedAt:  asOf,
+		})
+	}
+	return history
+}
+
+// affectedHexes returns the sorted hexes of the given influences without duplicates.
+func affectedHexes(groups ...[]*model.HexInfluence) []string {
+	seen := make(map[string]bool)
+	hexes := make([]string, 0)
+	for _, influences := range groups {
+		for _, influence := range influences {
+			if !seen[influence.H3Index] {
+				seen[influence.H3Index] = true
+				hexes = append(hexes, influence.H3Index)
+			}
+		}
+	}
+	sort.Strings(hexes)
+	return hexes
+}
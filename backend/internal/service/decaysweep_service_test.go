@@ -0,0 +1,109 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/config"
+	"stride-wars-app/internal/service"
+	"stride-wars-app/internal/testutil"
+)
+
+func TestDecaySweepService(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: Sweep_ResumesAndPrunes
+	// ------------------------
+	t.Run("Sweep_ResumesAndPrunes", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+
+		cfg := config.Default()
+		cfg.DecaySweepChunkSize = 2
+		newSweepService := func() *service.DecaySweepService {
+			return service.NewDecaySweepService(tdb.Repositories, tdb.HexLeaderboardService, service.NewScoringStrategy(cfg), cfg, zap.NewExample())
+		}
+
+		alice, err := tdb.UserRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		bob, err := tdb.UserRepo.CreateUser(ctx, &model.User{Username: "bob", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		abandoned, contested, quiet := "85283473fffffff", "85283447fffffff", "8528340bfffffff"
+		require.NoError(t, tdb.HexService.CreateMissingHexes(ctx, []string{abandoned, contested, quiet}))
+
+		now := time.Now()
+		influence := func(user *ent.User, hexID string, score float64, idle time.Duration) {
+			_, err := tdb.HexInfluenceRepo.CreateHexInfluence(ctx, &model.HexInfluence{
+				UserID:      user.ID,
+				H3Index:     hexID,
+				Score:       score,
+				LastUpdated: now.Add(-idle),
+			})
+			require.NoError(t, err)
+		}
+		// Decays to 0.48, below the prune threshold, leaving the hex without an owner.
+		influence(alice, abandoned, 0.6, 15*24*time.Hour)
+		// Decays to 8 and keeps the lead over a fresh score of 5, which is too recent to decay.
+		influence(alice, contested, 10, 15*24*time.Hour)
+		influence(bob, contested, 5, time.Hour)
+		// Decays to 1.8, above the prune threshold.
+		influence(bob, quiet, 2, 8*24*time.Hour)
+		for _, hexID := range []string{abandoned, contested, quiet} {
+			require.NoError(t, tdb.HexLeaderboardService.RebuildLeaderboard(ctx, hexID))
+		}
+
+		// A sweep that stopped after its first chunk is resumed instead of started over.
+		interrupted, err := tdb.Repositories.DecaySweepRepository.CreateDecaySweep(ctx, time.Now())
+		require.NoError(t, err)
+		done, err := newSweepService().ProcessChunk(ctx, interrupted.ID)
+		require.NoError(t, err)
+		require.False(t, done)
+
+		sweep, err := newSweepService().SweepIfDue(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, sweep)
+		require.Equal(t, interrupted.ID, sweep.ID)
+		require.NotNil(t, sweep.FinishedAt)
+		require.Equal(t, 4, sweep.Processed)
+		require.Equal(t, 2, sweep.Decayed)
+		require.Equal(t, 1, sweep.Pruned)
+		require.Equal(t, 1, sweep.OwnerChanges)
+
+		_, err = tdb.HexInfluenceRepo.FindByUserIDAndHexID(ctx, alice.ID, abandoned)
+		require.True(t, ent.IsNotFound(err))
+		// Kept influences still hold the score and time of their last visit, which decay applies to.
+		decayed, err := tdb.HexInfluenceRepo.FindByUserIDAndHexID(ctx, alice.ID, contested)
+		require.NoError(t, err)
+		require.Equal(t, 10.0, decayed.Score)
+		require.True(t, now.Add(-15*24*time.Hour).Equal(decayed.LastUpdated))
+		fresh, err := tdb.HexInfluenceRepo.FindByUserIDAndHexID(ctx, bob.ID, contested)
+		require.NoError(t, err)
+		require.Equal(t, 5.0, fresh.Score)
+		decayed, err = tdb.HexInfluenceRepo.FindByUserIDAndHexID(ctx, bob.ID, quiet)
+		require.NoError(t, err)
+		require.Equal(t, 2.0, decayed.Score)
+
+		leaderboard, err := tdb.HexLeaderboardService.FindByH3Index(ctx, abandoned)
+		require.NoError(t, err)
+		require.Empty(t, leaderboard.TopUsers)
+		leaderboard, err = tdb.HexLeaderboardService.FindByH3Index(ctx, contested)
+		require.NoError(t, err)
+		require.Len(t, leaderboard.TopUsers, 2)
+		require.Equal(t, alice.ID, leaderboard.TopUsers[0].UserID)
+		require.InDelta(t, 8.0, tdb.HexLeaderboardService.EffectiveScore(leaderboard.TopUsers[0], sweep.StartedAt), 1e-9)
+
+		// The next sweep is not due until the interval has passed.
+		sweep, err = newSweepService().SweepIfDue(ctx)
+		require.NoError(t, err)
+		require.Nil(t, sweep)
+	})
+}
@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidGoalMetric = errors.New("metric must be one of distance, new_hexes or activities")
+	ErrInvalidGoalPeriod = errors.New("period must be day or week")
+	ErrInvalidGoalTarget = errors.New("target must be positive")
+	ErrGoalNotOwned      = errors.New("goal does not belong to this user")
+)
+
+// GoalService manages user-defined daily and weekly goals. Progress is accumulated as
+// activities come in and restarts when an activity falls into a new period.
+type GoalService struct {
+	repository            repository.GoalRepository
+	activityRepository    repository.ActivityRepository
+	activityHexRepository repository.ActivityHexRepository
+	userService           *UserService
+	logger                *zap.Logger
+}
+
+func NewGoalService(repositories *repository.Repositories, userService *UserService, logger *zap.Logger) *GoalService {
+	return &GoalService{
+		repository:            repositories.GoalRepository,
+		activityRepository:    repositories.ActivityRepository,
+		activityHexRepository: repositories.ActivityHexRepository,
+		userService:           userService,
+		logger:                logger,
+	}
+}
+
+// SetGoal creates the user's goal for a metric and period, or changes the target of an
+// existing one. A new goal starts with the progress already made in the current period.
+func (gs *GoalService) SetGoal(ctx context.Context, req dto.SetGoalRequest) (*dto.GoalResponse, error) {
+	switch req.Metric {
+	case model.GoalMetricDistance, model.GoalMetricNewHexes, model.GoalMetricActivities:
+	default:
+		return nil, ErrInvalidGoalMetric
+	}
+	if req.Period != model.PeriodDay && req.Period != model.PeriodWeek {
+		return nil, ErrInvalidGoalPeriod
+	}
+	if req.Target <= 0 {
+		return nil, ErrInvalidGoalTarget
+	}
+
+	user, err := gs.userService.FindByID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	location := UserLocation(user)
+
+	existing, err := gs.repository.FindByUserIDMetricAndPeriod(ctx, req.UserID, req.Metric, req.Period)
+	if err == nil {
+		state := toModelGoal(existing)
+		state.Target = req.Target
+		updated, err := gs.repository.UpdateGoal(ctx, state)
+		if err != nil {
+			return nil, err
+		}
+		return toGoalResponse(updated, location, time.Now()), nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	key := periodKey(time.Now(), location, req.Period)
+	progress, err := gs.periodProgress(ctx, req.UserID, req.Metric, key, location, req.Period)
+	if err != nil {
+		return nil, err
+	}
+	created, err := gs.repository.CreateGoal(ctx, &model.Goal{
+		UserID:      req.UserID,
+		Metric:      req.Metric,
+		Period:      req.Period,
+		Target:      req.Target,
+		Progress:    progress,
+		PeriodStart: key,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toGoalResponse(created, location, time.Now()), nil
+}
+
+func (gs *GoalService) GetGoals(ctx context.Context, userID uuid.UUID) (*dto.GetGoalsResponse, error) {
+	user, err := gs.userService.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	goals, err := gs.repository.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	location := UserLocation(user)
+	now := time.Now()
+	resp := &dto.GetGoalsResponse{Goals: make([]dto.GoalResponse, len(goals))}
+	for i, goal := range goals {
+		resp.Goals[i] = *toGoalResponse(goal, location, now)
+	}
+	return resp, nil
+}
+
+func (gs *GoalService) DeleteGoal(ctx context.Context, goalID uuid.UUID, userID uuid.UUID) error {
+	goal, err := gs.repository.FindByID(ctx, goalID)
+	if err != nil {
+		return err
+	}
+	if goal.UserID != userID {
+		return ErrGoalNotOwned
+	}
+	return gs.repository.DeleteByID(ctx, goalID)
+}
+
+// RecordActivity adds a new activity to the progress of the user's goals. An activity uploaded
+// for a period before the one a goal is tracking does not count.
+func (gs *GoalService) RecordActivity(ctx context.Context, user *ent.User, activity recordedActivity) error {
+	goals, err := gs.repository.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	location := UserLocation(user)
+	for _, goal := range goals {
+		state := toModelGoal(goal)
+		key := periodKey(activity.StartedAt, location, state.Period)
+		switch {
+		case key.Equal(state.PeriodStart):
+			state.Progress += goalValue(state.Metric, activity)
+		case key.After(state.PeriodStart):
+			state.Progress = goalValue(state.Metric, activity)
+			state.PeriodStart = key
+		default:
+			continue
+		}
+		if _, err := gs.repository.UpdateGoal(ctx, state); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// periodProgress computes a goal's progress in the period identified by key from the stored activities.
+func (gs *GoalService) periodProgress(ctx context.Context, userID uuid.UUID, metric string, key time.Time, location *time.Location, period string) (float64, error) {
+	start, end := periodBounds(key, location, period)
+	buckets := []repository.TimeBucket{{Start: start, End: end}}
+
+	if metric == model.GoalMetricNewHexes {
+		counts, err := gs.activityHexRepository.CountNewByPeriod(ctx, userID, buckets)
+		if err != nil || len(counts) == 0 {
+			return 0, err
+		}
+		return float64(counts[0].Hexes), nil
+	}
+
+	totals, err := gs.activityRepository.AggregateByPeriod(ctx, userID, buckets)
+	if err != nil || len(totals) == 0 {
+		return 0, err
+	}
+	if metric == model.GoalMetricDistance {
+		return totals[0].Distance, nil
+	}
+	return float64(totals[0].Activities), nil
+}
+
+func goalValue(metric string, activity recordedActivity) float64 {
+	switch metric {
+	case model.GoalMetricDistance:
+		return activity.Distance
+	case model.GoalMetricNewHexes:
+		return float64(activity.NewHexes)
+	default:
+		return 1
+	}
+}
+
+func toModelGoal(goal *ent.Goal) *model.Goal {
+	return &model.Goal{
+		ID:          goal.ID,
+		UserID:      goal.UserID,
+		Metric:      goal.Metric.String(),
+		Period:      goal.Period.String(),
+		Target:      goal.Target,
+		Progress:    goal.Progress,
+		PeriodStart: goal.PeriodStart,
+	}
+}
+
+// toGoalResponse reports the goal for the period containing now. A goal that has seen no
+// activity in that period yet has no progress.
+func toGoalResponse(goal *ent.Goal, location *time.Location, now time.Time) *dto.GoalResponse {
+	period := goal.Period.String()
+	key := periodKey(now, location, period)
+	progress := goal.Progress
+	if !goal.PeriodStart.Equal(key) {
+		progress = 0
+	}
+	start, end := periodBounds(key, location, period)
+	return &dto.GoalResponse{
+		ID:          goal.ID,
+		Metric:      goal.Metric.String(),
+		Period:      period,
+		Target:      goal.Target,
+		Progress:    progress,
+		Completed:   progress >= goal.Target,
+		PeriodStart: start,
+		PeriodEnd:   end,
+	}
+}
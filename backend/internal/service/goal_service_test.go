@@ -0,0 +1,109 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/repository"
+	"stride-wars-app/internal/service"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestGoalService(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: GoalProgress_SeededAndIncremental
+	// ------------------------
+	t.Run("GoalProgress_SeededAndIncremental", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		record := func(distance float64, h3Indexes []string) {
+			now := time.Now()
+			_, err := svc.CreateActivity(ctx, dto.CreateActivityRequest{
+				UserID:    createdUser.ID,
+				Duration:  600,
+				Distance:  distance,
+				H3Indexes: h3Indexes,
+				EndedAt:   &now,
+			})
+			require.NoError(t, err)
+		}
+
+		record(3000, validH3Indexes[:1])
+
+		distanceGoal, err := svc.GoalService.SetGoal(ctx, dto.SetGoalRequest{
+			UserID: createdUser.ID,
+			Metric: model.GoalMetricDistance,
+			Period: model.PeriodWeek,
+			Target: 5000,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 3000.0, distanceGoal.Progress)
+		require.False(t, distanceGoal.Completed)
+
+		hexGoal, err := svc.GoalService.SetGoal(ctx, dto.SetGoalRequest{
+			UserID: createdUser.ID,
+			Metric: model.GoalMetricNewHexes,
+			Period: model.PeriodDay,
+			Target: 2,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1.0, hexGoal.Progress)
+
+		// Only the second hex is new
+		record(2500, validH3Indexes)
+
+		goals, err := svc.GoalService.GetGoals(ctx, createdUser.ID)
+		require.NoError(t, err)
+		require.Len(t, goals.Goals, 2)
+		require.Equal(t, 5500.0, goals.Goals[0].Progress)
+		require.True(t, goals.Goals[0].Completed)
+		require.Equal(t, 2.0, goals.Goals[1].Progress)
+		require.True(t, goals.Goals[1].Completed)
+
+		// Setting the goal again only changes the target
+		updated, err := svc.GoalService.SetGoal(ctx, dto.SetGoalRequest{
+			UserID: createdUser.ID,
+			Metric: model.GoalMetricDistance,
+			Period: model.PeriodWeek,
+			Target: 10000,
+		})
+		require.NoError(t, err)
+		require.Equal(t, distanceGoal.ID, updated.ID)
+		require.Equal(t, 5500.0, updated.Progress)
+		require.False(t, updated.Completed)
+	})
+
+	// ------------------------
+	// Subtest: SetGoal_Invalid
+	// ------------------------
+	t.Run("SetGoal_Invalid", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		_, err = svc.GoalService.SetGoal(ctx, dto.SetGoalRequest{UserID: createdUser.ID, Metric: "calories", Period: model.PeriodWeek, Target: 1})
+		require.ErrorIs(t, err, service.ErrInvalidGoalMetric)
+
+		_, err = svc.GoalService.SetGoal(ctx, dto.SetGoalRequest{UserID: createdUser.ID, Metric: model.GoalMetricActivities, Period: "month", Target: 1})
+		require.ErrorIs(t, err, service.ErrInvalidGoalPeriod)
+
+		_, err = svc.GoalService.SetGoal(ctx, dto.SetGoalRequest{UserID: createdUser.ID, Metric: model.GoalMetricActivities, Period: model.PeriodDay, Target: 0})
+		require.ErrorIs(t, err, service.ErrInvalidGoalTarget)
+	})
+}
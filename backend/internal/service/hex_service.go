@@ -32,3 +32,8 @@ func (hs *HexService) CreateHex(ctx context.Context, h3_index string) (*ent.Hex,
 func (hs *HexService) CreateHexes(ctx context.Context, hexes []*ent.Hex) ([]*ent.Hex, error) {
 	return hs.repository.CreateHexes(ctx, hexes)
 }
+
+// CreateMissingHexes makes sure a row exists for each of the given hexes.
+func (hs *HexService) CreateMissingHexes(ctx context.Context, h3_indexes []string) error {
+	return hs.repository.CreateMissingHexes(ctx, h3_indexes)
+}
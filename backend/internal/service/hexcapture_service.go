@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stride-wars-app/ent"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultCapturesLimit is how many of a hex's captures are returned when no limit is given.
+	DefaultCapturesLimit = 20
+	MaxCapturesLimit     = 100
+	// DefaultCapturesWindow is how far back a user's captures go when no window is given.
+	DefaultCapturesWindow = 7 * 24 * time.Hour
+	MaxCapturesWindow     = 366 * 24 * time.Hour
+)
+
+var (
+	ErrInvalidCapturesLimit  = fmt.Errorf("limit must be between 1 and %d", MaxCapturesLimit)
+	ErrInvalidCapturesWindow = errors.New("from must be before to and at most a year apart")
+)
+
+// HexCaptureService answers queries about hexes changing owner. Captures are recorded by
+// HexLeaderboardService while activities are ingested, and redacted by PrivacyZoneService
+// before they are shown.
+type HexCaptureService struct {
+	repository         repository.HexCaptureRepository
+	userService        *UserService
+	privacyZoneService *PrivacyZoneService
+	logger             *zap.Logger
+}
+
+func NewHexCaptureService(repositories *repository.Repositories, userService *UserService, privacyZoneService *PrivacyZoneService, logger *zap.Logger) *HexCaptureService {
+	return &HexCaptureService{
+		repository:         repositories.HexCaptureRepository,
+		userService:        userService,
+		privacyZoneService: privacyZoneService,
+		logger:             logger,
+	}
+}
+
+// GetHexCaptures returns up to limit of the hex's most recent captures, newest first. A limit
+// of 0 uses DefaultCapturesLimit. Captures by users hidden in the hex are left out, so fewer
+// than limit may be returned.
+func (s *HexCaptureService) GetHexCaptures(ctx context.Context, h3Index string, limit int) (*dto.HexCapturesResponse, error) {
+	if err := validateH3Index(h3Index); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidHex, err)
+	}
+	if limit == 0 {
+		limit = DefaultCapturesLimit
+	}
+	if limit < 1 || limit > MaxCapturesLimit {
+		return nil, ErrInvalidCapturesLimit
+	}
+
+	captures, err := s.repository.FindByH3Index(ctx, h3Index, limit)
+	if err != nil {
+		return nil, err
+	}
+	if captures, err = s.privacyZoneService.RedactCaptures(ctx, captures); err != nil {
+		return nil, err
+	}
+	resp := &dto.HexCapturesResponse{H3Index: h3Index, Captures: make([]dto.HexCaptureResponse, len(captures))}
+	for i, capture := range captures {
+		resp.Captures[i] = captureResponse(capture)
+	}
+	return resp, nil
+}
+
+// GetUserCaptures returns the captures the user made or suffered in [from, to). A zero to means
+// now and a zero from means DefaultCapturesWindow before to. Captures of hexes the user is hidden
+// in are left out.
+func (s *HexCaptureService) GetUserCaptures(ctx context.Context, userID uuid.UUID, from, to time.Time) (*dto.UserCapturesResponse, error) {
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.Add(-DefaultCapturesWindow)
+	}
+	if !from.Before(to) || to.Sub(from) > MaxCapturesWindow {
+		return nil, ErrInvalidCapturesWindow
+	}
+	if _, err := s.userService.FindByID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	captures, err := s.repository.FindByUserIDBetween(ctx, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if captures, err = s.privacyZoneService.RedactCaptures(ctx, captures); err != nil {
+		return nil, err
+	}
+	resp := &dto.UserCapturesResponse{
+		UserID:   userID,
+		From:     from,
+		To:       to,
+		Made:     make([]dto.HexCaptureResponse, 0),
+		Suffered: make([]dto.HexCaptureResponse, 0),
+	}
+	for _, capture := range captures {
+		if capture.NewOwnerID == userID {
+			resp.Made = append(resp.Made, captureResponse(capture))
+		} else if capture.PreviousOwnerID != nil && *capture.PreviousOwnerID == userID {
+			resp.Suffered = append(resp.Suffered, captureResponse(capture))
+		}
+	}
+	return resp, nil
+}
+
+func captureResponse(capture *ent.HexCapture) dto.HexCaptureResponse {
+	return dto.HexCaptureResponse{
+		ID:              capture.ID,
+		H3Index:         capture.H3Index,
+		PreviousOwnerID: capture.PreviousOwnerID,
+		NewOwnerID:      capture.NewOwnerID,
+		PreviousScore:   capture.PreviousScore,
+		NewScore:        capture.NewScore,
+		ActivityID:      capture.ActivityID,
+		CapturedAt:      capture.CapturedAt,
+	}
+}
@@ -0,0 +1,139 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/service"
+	"stride-wars-app/internal/testutil"
+)
+
+func TestHexCaptureService(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: Captures_RecordedOnIngestion
+	// ------------------------
+	t.Run("Captures_RecordedOnIngestion", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+
+		hexID := validH3Indexes[0]
+		require.NoError(t, tdb.HexService.CreateMissingHexes(ctx, []string{hexID}))
+		alice, err := tdb.UserRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		bob, err := tdb.UserRepo.CreateUser(ctx, &model.User{Username: "bob", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		ingest := func(user *ent.User, visits int) (uuid.UUID, []*model.HexCapture) {
+			activityID := uuid.New()
+			hexIDs := make([]string, visits)
+			for i := range hexIDs {
+				hexIDs[i] = hexID
+			}
+			influences, err := tdb.HexInfluenceService.RecordVisitsAt(ctx, user.ID, &activityID, hexIDs, time.Now())
+			require.NoError(t, err)
+			captures, err := tdb.HexLeaderboardService.AddUserToLeaderboards(ctx, user.Username, &activityID, influences)
+			require.NoError(t, err)
+			return activityID, captures
+		}
+
+		// Alice claims the hex first.
+		claim, captures := ingest(alice, 2)
+		require.Len(t, captures, 1)
+		require.Nil(t, captures[0].PreviousOwnerID)
+		require.Equal(t, alice.ID, captures[0].NewOwnerID)
+
+		// Bob falls short of the lead, then overtakes Alice.
+		_, captures = ingest(bob, 1)
+		require.Empty(t, captures)
+		takeover, captures := ingest(bob, 2)
+		require.Len(t, captures, 1)
+
+		// Visiting a hex one already leads is not a capture.
+		_, captures = ingest(bob, 1)
+		require.Empty(t, captures)
+
+		hexCaptures, err := tdb.HexCaptureService.GetHexCaptures(ctx, hexID, 0)
+		require.NoError(t, err)
+		require.Len(t, hexCaptures.Captures, 2)
+		latest := hexCaptures.Captures[0]
+		require.Equal(t, bob.ID, latest.NewOwnerID)
+		require.Equal(t, alice.ID, *latest.PreviousOwnerID)
+		require.InDelta(t, 2.0, latest.PreviousScore, 1e-9)
+		require.InDelta(t, 3.0, latest.NewScore, 1e-9)
+		require.Equal(t, takeover, *latest.ActivityID)
+		require.Equal(t, claim, *hexCaptures.Captures[1].ActivityID)
+
+		userCaptures, err := tdb.HexCaptureService.GetUserCaptures(ctx, alice.ID, time.Time{}, time.Time{})
+		require.NoError(t, err)
+		require.Len(t, userCaptures.Made, 1)
+		require.Len(t, userCaptures.Suffered, 1)
+		require.Equal(t, bob.ID, userCaptures.Suffered[0].NewOwnerID)
+
+		// Captures outside the window are left out.
+		userCaptures, err = tdb.HexCaptureService.GetUserCaptures(ctx, bob.ID, time.Now().Add(-48*time.Hour), time.Now().Add(-24*time.Hour))
+		require.NoError(t, err)
+		require.Empty(t, userCaptures.Made)
+
+		_, err = tdb.HexCaptureService.GetUserCaptures(ctx, bob.ID, time.Now(), time.Now().Add(-time.Hour))
+		require.ErrorIs(t, err, service.ErrInvalidCapturesWindow)
+		_, err = tdb.HexCaptureService.GetHexCaptures(ctx, hexID, service.MaxCapturesLimit+1)
+		require.ErrorIs(t, err, service.ErrInvalidCapturesLimit)
+	})
+	// ------------------------
+	// Subtest: Captures_RedactedInsideHiddenZones
+	// ------------------------
+	t.Run("Captures_RedactedInsideHiddenZones", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+
+		hexID := validH3Indexes[0]
+		require.NoError(t, tdb.HexService.CreateMissingHexes(ctx, []string{hexID}))
+		alice, err := tdb.UserRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		bob, err := tdb.UserRepo.CreateUser(ctx, &model.User{Username: "bob", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		for _, visit := range []struct {
+			user   *ent.User
+			visits int
+		}{{alice, 1}, {bob, 2}} {
+			hexIDs := make([]string, visit.visits)
+			for i := range hexIDs {
+				hexIDs[i] = hexID
+			}
+			influences, err := tdb.HexInfluenceService.RecordVisitsAt(ctx, visit.user.ID, nil, hexIDs, time.Now())
+			require.NoError(t, err)
+			_, err = tdb.HexLeaderboardService.AddUserToLeaderboards(ctx, visit.user.Username, nil, influences)
+			require.NoError(t, err)
+		}
+
+		// Alice hides the hex: her claim disappears and she is no longer named as the one Bob
+		// took it from.
+		privacy := tdb.ActivityService.PrivacyZoneService
+		_, err = privacy.CreateZone(ctx, dto.PrivacyZoneRequest{UserID: alice.ID, H3Indexes: []string{hexID}})
+		require.NoError(t, err)
+		_, err = privacy.UpdateSettings(ctx, dto.PrivacySettingsRequest{UserID: alice.ID, HideZoneLeaderboards: true})
+		require.NoError(t, err)
+
+		hexCaptures, err := tdb.HexCaptureService.GetHexCaptures(ctx, hexID, 0)
+		require.NoError(t, err)
+		require.Len(t, hexCaptures.Captures, 1)
+		require.Equal(t, bob.ID, hexCaptures.Captures[0].NewOwnerID)
+		require.Nil(t, hexCaptures.Captures[0].PreviousOwnerID)
+		require.Zero(t, hexCaptures.Captures[0].PreviousScore)
+
+		userCaptures, err := tdb.HexCaptureService.GetUserCaptures(ctx, alice.ID, time.Time{}, time.Time{})
+		require.NoError(t, err)
+		require.Empty(t, userCaptures.Made)
+		require.Empty(t, userCaptures.Suffered)
+	})
+}
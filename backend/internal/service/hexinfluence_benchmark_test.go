@@ -0,0 +1,93 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uber/h3-go/v4"
+)
+
+// benchmarkCells returns the 331 cells within 10 steps of a cell, about the size of a long run.
+func benchmarkCells(b *testing.B) []string {
+	b.Helper()
+	disk, err := h3.GridDisk(h3.Cell(h3.IndexFromString(validH3Indexes[0])), 10)
+	require.NoError(b, err)
+
+	cells := make([]string, len(disk))
+	for i, cell := range disk {
+		cells[i] = cell.String()
+	}
+	return cells
+}
+
+// BenchmarkApplyInfluence compares writing an activity's influence hex by hex, as ingestion
+// used to, with the bulk upserts on an in-memory SQLite database.
+func BenchmarkApplyInfluence(b *testing.B) {
+	cells := benchmarkCells(b)
+
+	run := func(b *testing.B, apply func(ctx context.Context, svc *testutil.TestServices, user *ent.User, at time.Time) error) {
+		svc := testutil.NewTestServices(b)
+		user, err := svc.UserRepo.CreateUser(svc.Ctx, &model.User{Username: "bench", ExternalUser: uuid.New()})
+		require.NoError(b, err)
+
+		at := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			at = at.Add(time.Hour)
+			err := svc.Repositories.Transactor.WithTx(svc.Ctx, func(ctx context.Context) error {
+				return apply(ctx, svc, user, at)
+			})
+			require.NoError(b, err)
+		}
+	}
+
+	b.Run("PerHex", func(b *testing.B) {
+		run(b, func(ctx context.Context, svc *testutil.TestServices, user *ent.User, at time.Time) error {
+			existing, err := svc.HexService.FindByIDs(ctx, cells)
+			if err != nil {
+				return err
+			}
+			exists := make(map[string]bool, len(existing))
+			for _, hex := range existing {
+				exists[hex.ID] = true
+			}
+			for _, cell := range cells {
+				if !exists[cell] {
+					if _, err := svc.HexService.CreateHex(ctx, cell); err != nil {
+						return err
+					}
+				}
+			}
+			for _, cell := range cells {
+				if _, err := svc.HexInfluenceService.UpdateOrCreateHexInfluenceAt(ctx, user.ID, cell, at); err != nil {
+					return err
+				}
+				if _, err := svc.HexLeaderboardService.AddUserToLeaderboardOrCreateLeaderboard(ctx, cell, user.ID, user.Username); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+
+	b.Run("Bulk", func(b *testing.B) {
+		run(b, func(ctx context.Context, svc *testutil.TestServices, user *ent.User, at time.Time) error {
+			if err := svc.HexService.CreateMissingHexes(ctx, cells); err != nil {
+				return err
+			}
+			influences, err := svc.HexInfluenceService.RecordVisitsAt(ctx, user.ID, nil, cells, at)
+			if err != nil {
+				return err
+			}
+			_, err = svc.HexLeaderboardService.AddUserToLeaderboards(ctx, user.Username, nil, influences)
+			return err
+		})
+	})
+}
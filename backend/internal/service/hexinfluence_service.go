@@ -2,23 +2,31 @@ package service
 
 import (
 	"context"
+	"sort"
 	"stride-wars-app/ent"
 	"stride-wars-app/ent/model"
 	"stride-wars-app/internal/repository"
+	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
+// HexInfluenceService keeps users' scores in hexes. Every score change it makes is appended to
+// the influence history.
 type HexInfluenceService struct {
-	repository repository.HexInfluenceRepository
-	logger     *zap.Logger
+	repository        repository.HexInfluenceRepository
+	historyRepository repository.InfluenceHistoryRepository
+	scoring           ScoringStrategy
+	logger            *zap.Logger
 }
 
-func NewHexInfluenceService(repository repository.HexInfluenceRepository, logger *zap.Logger) *HexInfluenceService {
+func NewHexInfluenceService(repository repository.HexInfluenceRepository, historyRepository repository.InfluenceHistoryRepository, scoring ScoringStrategy, logger *zap.Logger) *HexInfluenceService {
 	return &HexInfluenceService{
-		repository: repository,
-		logger:     logger,
+		repository:        repository,
+		historyRepository: historyRepository,
+		scoring:           scoring,
+		logger:            logger,
 	}
 }
 func (his *HexInfluenceService) FindByID(ctx context.Context, id uuid.UUID) (*ent.HexInfluence, error) {
@@ -37,17 +45,194 @@ func (his *HexInfluenceService) CreateHexInfluence(ctx context.Context, hexInflu
 	return his.repository.CreateHexInfluence(ctx, hexInfluence)
 }
 func (his *HexInfluenceService) UpdateHexInfluence(ctx context.Context, userID uuid.UUID, hexID string) (int, error) {
-	return his.repository.UpdateHexInfluence(ctx, userID, hexID)
+	return his.UpdateHexInfluenceAt(ctx, userID, hexID, time.Now())
+}
+
+// UpdateHexInfluenceAt records a visit to an existing influence that happened at the given time.
+// A visit older than the last update adds to the score without decaying it.
+func (his *HexInfluenceService) UpdateHexInfluenceAt(ctx context.Context, userID uuid.UUID, hexID string, at time.Time) (int, error) {
+	hexInfluence, err := his.repository.FindByUserIDAndHexID(ctx, userID, hexID)
+	if err != nil {
+		return 0, err
+	}
+	score, lastUpdated := applyVisits(his.scoring, hexInfluence.Score, hexInfluence.LastUpdated, 1, at)
+	if _, err := his.repository.UpdateHexInfluenceScore(ctx, hexInfluence.ID, score, lastUpdated); err != nil {
+		return 0, err
+	}
+	if err := his.recordChange(ctx, userID, hexID, nil, hexInfluence.Score, score, at); err != nil {
+		return 0, err
+	}
+	return 1, nil
 }
 func (his *HexInfluenceService) FindByUserIDAndHexID(ctx context.Context, userID uuid.UUID, hexID string) (*ent.HexInfluence, error) {
 	return his.repository.FindByUserIDAndHexID(ctx, userID, hexID)
 }
 func (his *HexInfluenceService) UpdateHexInfluences(ctx context.Context, userID uuid.UUID, hexIDs []string) (int, error) {
-	return his.repository.UpdateHexInfluences(ctx, userID, hexIDs)
+	totalUpdated := 0
+	for _, h3id := range hexIDs {
+		n, err := his.UpdateHexInfluence(ctx, userID, h3id)
+		if err != nil {
+			return totalUpdated, err
+		}
+		totalUpdated += n
+	}
+	return totalUpdated, nil
 }
 func (his *HexInfluenceService) UpdateOrCreateHexInfluence(ctx context.Context, userID uuid.UUID, hexID string) (*ent.HexInfluence, error) {
-	return his.repository.UpdateOrCreateHexInfluence(ctx, userID, hexID)
+	return his.UpdateOrCreateHexInfluenceAt(ctx, userID, hexID, time.Now())
+}
+
+// UpdateOrCreateHexInfluenceAt records a single visit at the given time, creating the influence
+// on the user's first visit to the hex.
+func (his *HexInfluenceService) UpdateOrCreateHexInfluenceAt(ctx context.Context, userID uuid.UUID, hexID string, at time.Time) (*ent.HexInfluence, error) {
+	hexInfluence, err := his.repository.FindByUserIDAndHexID(ctx, userID, hexID)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return nil, err
+		}
+		created, err := his.repository.CreateHexInfluence(ctx, &model.HexInfluence{
+			UserID:      userID,
+			H3Index:     hexID,
+			Score:       his.scoring.Visit(0),
+			LastUpdated: at,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return created, his.recordChange(ctx, userID, hexID, nil, 0, created.Score, at)
+	}
+	score, lastUpdated := applyVisits(his.scoring, hexInfluence.Score, hexInfluence.LastUpdated, 1, at)
+	updated, err := his.repository.UpdateHexInfluenceScore(ctx, hexInfluence.ID, score, lastUpdated)
+	if err != nil {
+		return nil, err
+	}
+	return updated, his.recordChange(ctx, userID, hexID, nil, hexInfluence.Score, score, at)
+}
+
+// RecordVisitsAt records the user's visits to the given hexes at the given time and returns the
+// resulting influences. A hex listed several times is visited that many times. The influences are
+// scored by the database in a bulk upsert, so the number of statements does not grow with the
+// hexes. The changes are attributed to activityID, which is nil for visits without an activity yet.
+func (his *HexInfluenceService) RecordVisitsAt(ctx context.Context, userID uuid.UUID, activityID *uuid.UUID, hexIDs []string, at time.Time) ([]*model.HexInfluence, error) {
+	visitsByHex := make(map[string]int, len(hexIDs))
+	for _, hexID := range hexIDs {
+		visitsByHex[hexID]++
+	}
+	uniqueHexIDs := make([]string, 0, len(visitsByHex))
+	for hexID := range visitsByHex {
+		uniqueHexIDs = append(uniqueHexIDs, hexID)
+	}
+	sort.Strings(uniqueHexIDs)
+
+	// The scores before the visits are only needed for the history, and the lock keeps them from
+	// changing until the upsert.
+	existing, err := his.repository.FindByUserIDAndHexIDsForUpdate(ctx, userID, uniqueHexIDs)
+	if err != nil {
+		return nil, err
+	}
+	scoresBefore := make(map[string]float64, len(existing))
+	for _, hexInfluence := range existing {
+		scoresBefore[hexInfluence.H3Index] = hexInfluence.Score
+	}
+
+	at = at.UTC()
+	visits := make([]int, len(uniqueHexIDs))
+	created := make([]float64, len(uniqueHexIDs))
+	for i, hexID := range uniqueHexIDs {
+		visits[i] = visitsByHex[hexID]
+		created[i], _ = applyVisits(his.scoring, 0, at, visits[i], at)
+	}
+	if err := his.repository.UpsertHexInfluencesAt(ctx, his.scoring, userID, uniqueHexIDs, visits, created, at); err != nil {
+		return nil, err
+	}
+
+	updated, err := his.repository.FindByUserIDAndHexIDs(ctx, userID, uniqueHexIDs)
+	if err != nil {
+		return nil, err
+	}
+	influences := make([]*model.HexInfluence, 0, len(updated))
+	history := make([]*model.InfluenceHistory, 0, len(updated))
+	for _, hexInfluence := range updated {
+		influences = append(influences, &model.HexInfluence{
+			ID:          hexInfluence.ID,
+			UserID:      hexInfluence.UserID,
+			H3Index:     hexInfluence.H3Index,
+			Score:       hexInfluence.Score,
+			LastUpdated: hexInfluence.LastUpdated,
+		})
+		history = append(history, &model.InfluenceHistory{
+			UserID:      userID,
+			H3Index:     hexInfluence.H3Index,
+			ActivityID:  activityID,
+			ScoreBefore: scoresBefore[hexInfluence.H3Index],
+			ScoreAfter:  hexInfluence.Score,
+			RecordedAt:  at,
+		})
+	}
+	if err := his.historyRepository.CreateInfluenceHistories(ctx, history); err != nil {
+		return nil, err
+	}
+	return influences, nil
 }
 func (his *HexInfluenceService) UpdateOrCreateHexInfluences(ctx context.Context, userID uuid.UUID, hexIDs []string) ([]*ent.HexInfluence, error) {
-	return his.repository.UpdateOrCreateHexInfluences(ctx, userID, hexIDs)
+	updatedInfluences := make([]*ent.HexInfluence, 0, len(hexIDs))
+	for _, h3id := range hexIDs {
+		influence, err := his.UpdateOrCreateHexInfluence(ctx, userID, h3id)
+		if err != nil {
+			return nil, err
+		}
+		updatedInfluences = append(updatedInfluences, influence)
+	}
+	return updatedInfluences, nil
+}
+
+// SetHexInfluence overwrites the user's score in a hex, creating the influence if it does not exist
+// yet. The change is attributed to activityID.
+func (his *HexInfluenceService) SetHexInfluence(ctx context.Context, hexInfluence *model.HexInfluence, activityID *uuid.UUID) (*ent.HexInfluence, error) {
+	scoreBefore, err := his.currentScore(ctx, hexInfluence.UserID, hexInfluence.H3Index)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := his.repository.SetHexInfluence(ctx, hexInfluence)
+	if err != nil {
+		return nil, err
+	}
+	return updated, his.recordChange(ctx, hexInfluence.UserID, hexInfluence.H3Index, activityID, scoreBefore, updated.Score, time.Now())
+}
+
+// DeleteByUserIDAndHexID removes the user's influence in a hex, recording its score dropping to 0
+// and attributing the change to activityID.
+func (his *HexInfluenceService) DeleteByUserIDAndHexID(ctx context.Context, userID uuid.UUID, hexID string, activityID *uuid.UUID) (int, error) {
+	scoreBefore, err := his.currentScore(ctx, userID, hexID)
+	if err != nil {
+		return 0, err
+	}
+	deleted, err := his.repository.DeleteByUserIDAndHexID(ctx, userID, hexID)
+	if err != nil || deleted == 0 {
+		return deleted, err
+	}
+	return deleted, his.recordChange(ctx, userID, hexID, activityID, scoreBefore, 0, time.Now())
+}
+
+// currentScore returns the user's stored score in a hex, 0 if they have none.
+func (his *HexInfluenceService) currentScore(ctx context.Context, userID uuid.UUID, hexID string) (float64, error) {
+	hexInfluence, err := his.repository.FindByUserIDAndHexID(ctx, userID, hexID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return hexInfluence.Score, nil
+}
+
+func (his *HexInfluenceService) recordChange(ctx context.Context, userID uuid.UUID, hexID string, activityID *uuid.UUID, scoreBefore, scoreAfter float64, at time.Time) error {
+	return his.historyRepository.CreateInfluenceHistories(ctx, []*model.InfluenceHistory{{
+		UserID:      userID,
+		H3Index:     hexID,
+		ActivityID:  activityID,
+		ScoreBefore: scoreBefore,
+		ScoreAfter:  scoreAfter,
+		RecordedAt:  at,
+	}})
 }
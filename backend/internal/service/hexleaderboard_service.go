@@ -2,19 +2,39 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
 	"sort"
+	"strconv"
 	"stride-wars-app/ent"
 	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/config"
 	"stride-wars-app/internal/dto"
 	"stride-wars-app/internal/hex/hexconsts"
 	"stride-wars-app/internal/mappers"
 	"stride-wars-app/internal/repository"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/uber/h3-go/v4"
 	"go.uber.org/zap"
 )
 
+const (
+	DefaultHexRankingLimit = 50
+	MaxHexRankingLimit     = 200
+)
+
+// leaderboardSizeSetting is the key of the setting holding the size the stored leaderboards
+// were ranked with.
+const leaderboardSizeSetting = "leaderboard_size"
+
+var (
+	ErrInvalidResolution  = errors.New("resolution must be " + strconv.Itoa(hexconsts.DefaultHexResolution) + " or one of the rollup resolutions")
+	ErrInvalidRankingPage = errors.New("limit must be between 1 and " + strconv.Itoa(MaxHexRankingLimit) + " and offset must not be negative")
+)
+
 type BoundingBox struct {
 	MinLat float64 `json:"min_lat"`
 	MinLng float64 `json:"min_lng"`
@@ -25,13 +45,30 @@ type BoundingBox struct {
 type HexLeaderboardService struct {
 	hexLeaderboardRepository repository.HexLeaderboardRepository
 	hexInfluenceRepository   repository.HexInfluenceRepository
-	logger                   *zap.Logger
+	hexCaptureRepository     repository.HexCaptureRepository
+	settingRepository        repository.SettingRepository
+	privacyZoneService       *PrivacyZoneService
+	notificationService      *NotificationService
+	webhookService           *WebhookService
+	rollupService            *HexRollupService
+	scoring                  ScoringStrategy
+	// leaderboardSize is the number of users kept in a hex's leaderboard.
+	leaderboardSize int
+	logger          *zap.Logger
 }
 
-func NewHexLeaderboardService(hexLeaderboardRepository repository.HexLeaderboardRepository, hexInfluenceRepository repository.HexInfluenceRepository, logger *zap.Logger) *HexLeaderboardService {
+func NewHexLeaderboardService(hexLeaderboardRepository repository.HexLeaderboardRepository, hexInfluenceRepository repository.HexInfluenceRepository, hexCaptureRepository repository.HexCaptureRepository, settingRepository repository.SettingRepository, privacyZoneService *PrivacyZoneService, notificationService *NotificationService, webhookService *WebhookService, rollupService *HexRollupService, scoring ScoringStrategy, cfg config.Config, logger *zap.Logger) *HexLeaderboardService {
 	return &HexLeaderboardService{
 		hexLeaderboardRepository: hexLeaderboardRepository,
 		hexInfluenceRepository:   hexInfluenceRepository,
+		hexCaptureRepository:     hexCaptureRepository,
+		settingRepository:        settingRepository,
+		privacyZoneService:       privacyZoneService,
+		notificationService:      notificationService,
+		webhookService:           webhookService,
+		rollupService:            rollupService,
+		scoring:                  scoring,
+		leaderboardSize:          cfg.LeaderboardSize,
 		logger:                   logger,
 	}
 }
@@ -53,7 +90,7 @@ func (hls *HexLeaderboardService) FindByH3Indexes(ctx context.Context, h3Indexes
 	return hls.hexLeaderboardRepository.FindByH3Indexes(ctx, h3Indexes)
 }
 
-// Ads a given user to the leaderboard of a hexagon with the given hexID - if the user has enough points to go into top 5.
+// Ads a given user to the leaderboard of a hexagon with the given hexID - if the user has enough points to go into the top.
 // Return users position in the leaderboard - nil otherwise
 func (hls *HexLeaderboardService) AddUserToLeaderboard(ctx context.Context, hexID string, userID uuid.UUID, userName string) (*int, error) {
 	hexLeaderboard, err := hls.hexLeaderboardRepository.FindByH3Index(ctx, hexID)
@@ -71,66 +108,310 @@ func (hls *HexLeaderboardService) AddUserToLeaderboard(ctx context.Context, hexI
 	if hexInfluence == nil {
 		return nil, nil
 	}
-	score := hexInfluence.Score
-	newTopUsers := make([]model.TopUser, 0, len(hexLeaderboard.TopUsers))
-	addedOrUpdated := false
+	now := time.Now()
+	user := topUserOf(hexInfluence, userName)
+	newTopUsers, inTop := hls.mergeTopUser(hexLeaderboard.TopUsers, user, now)
+	if !inTop {
+		return nil, nil
+	}
+	hidden, err := hls.privacyZoneService.HiddenIn(ctx, []uuid.UUID{userID})
+	if err != nil {
+		return nil, err
+	}
+	capture, notifications := hls.leaderboardChanges(hexID, hexLeaderboard.TopUsers, newTopUsers, user, nil, hidden, now)
 
-	for _, u := range hexLeaderboard.TopUsers {
+	hexLeaderboard.TopUsers = newTopUsers
+	updatedModel := &model.HexLeaderboard{
+		ID:       hexLeaderboard.ID,
+		H3Index:  hexLeaderboard.H3Index,
+		TopUsers: newTopUsers,
+	}
+
+	_, err = hls.hexLeaderboardRepository.UpdateHexLeaderboard(ctx, updatedModel)
+	if err != nil {
+		return nil, err
+	}
+	var captures []*model.HexCapture
+	if capture != nil {
+		captures = append(captures, capture)
+	}
+	if err := hls.recordCaptures(ctx, captures); err != nil {
+		return nil, err
+	}
+	if _, err := hls.notificationService.Notify(ctx, notifications...); err != nil {
+		return nil, err
+	}
+	var leaderboards []dto.WebhookLeaderboard
+	if hls.rankingChanged(hexLeaderboard.TopUsers, newTopUsers, now) {
+		leaderboards = append(leaderboards, hls.webhookLeaderboard(hexID, newTopUsers, now))
+	}
+	if err := hls.emitChanges(ctx, nil, captures, leaderboards); err != nil {
+		return nil, err
+	}
+
+	for idx, u := range newTopUsers {
 		if u.UserID == userID {
-			if u.Score != score {
-				newTopUsers = append(newTopUsers, model.TopUser{UserID: userID, UserName: userName, Score: score})
-				addedOrUpdated = true
+			pos := idx + 1
+			return &pos, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// topUserOf returns the leaderboard entry for a user's influence in a hex.
+func topUserOf(hexInfluence *ent.HexInfluence, userName string) model.TopUser {
+	return model.TopUser{UserID: hexInfluence.UserID, UserName: userName, Score: hexInfluence.Score, LastUpdated: hexInfluence.LastUpdated}
+}
+
+// captureOf returns the capture of hexID by userID when merging them into the previous top users
+// made them the leader at now, or nil if they did not take the lead. An empty previous leaderboard
+// makes it a first claim.
+func (hls *HexLeaderboardService) captureOf(hexID string, previous, newTopUsers []model.TopUser, userID uuid.UUID, activityID *uuid.UUID, now time.Time) *model.HexCapture {
+	if len(newTopUsers) == 0 || newTopUsers[0].UserID != userID {
+		return nil
+	}
+	capture := &model.HexCapture{
+		H3Index:    hexID,
+		NewOwnerID: userID,
+		NewScore:   hls.EffectiveScore(newTopUsers[0], now),
+		ActivityID: activityID,
+		CapturedAt: now,
+	}
+	if len(previous) != 0 {
+		ranked := append([]model.TopUser(nil), previous...)
+		hls.rankTopUsers(ranked, now)
+		leader := ranked[0]
+		if leader.UserID == userID {
+			return nil
+		}
+		capture.PreviousOwnerID = &leader.UserID
+		capture.PreviousScore = hls.EffectiveScore(leader, now)
+	}
+	return capture
+}
+
+// leaderboardChanges returns the capture made by merging user into the previous top users of a
+// hex, if any, and the notifications for the users who lost the lead or their place in the top.
+// The notifications do not name the user if hidden reports them hidden in the hex.
+func (hls *HexLeaderboardService) leaderboardChanges(hexID string, previous, newTopUsers []model.TopUser, user model.TopUser, activityID *uuid.UUID, hidden func(uuid.UUID, string) bool, now time.Time) (*model.HexCapture, []*model.Notification) {
+	by := &user
+	if hidden(user.UserID, hexID) {
+		by = nil
+	}
+	var notifications []*model.Notification
+	capture := hls.captureOf(hexID, previous, newTopUsers, user.UserID, activityID, now)
+	if capture != nil && capture.PreviousOwnerID != nil {
+		notifications = append(notifications, hexCapturedNotification(*capture.PreviousOwnerID, hexID, by))
+	}
+
+	kept := make(map[uuid.UUID]bool, len(newTopUsers))
+	for _, u := range newTopUsers {
+		kept[u.UserID] = true
+	}
+	for _, u := range previous {
+		if !kept[u.UserID] {
+			notifications = append(notifications, leaderboardLostNotification(u.UserID, hexID, by, hls.leaderboardSize))
+		}
+	}
+	return capture, notifications
+}
+
+// rankingChanged reports whether the users in the top, or their order, differ between the
+// previous top users ranked at now and the new ones.
+func (hls *HexLeaderboardService) rankingChanged(previous, newTopUsers []model.TopUser, now time.Time) bool {
+	if len(previous) != len(newTopUsers) {
+		return true
+	}
+	ranked := append([]model.TopUser(nil), previous...)
+	hls.rankTopUsers(ranked, now)
+	for i := range ranked {
+		if ranked[i].UserID != newTopUsers[i].UserID {
+			return true
+		}
+	}
+	return false
+}
+
+func (hls *HexLeaderboardService) webhookLeaderboard(hexID string, topUsers []model.TopUser, now time.Time) dto.WebhookLeaderboard {
+	leaderboard := dto.WebhookLeaderboard{H3Index: hexID, TopUsers: make([]dto.WebhookLeaderboardEntry, len(topUsers))}
+	for i, u := range topUsers {
+		leaderboard.TopUsers[i] = dto.WebhookLeaderboardEntry{UserID: u.UserID, Username: u.UserName, Score: hls.EffectiveScore(u, now)}
+	}
+	return leaderboard
+}
+
+// recordCaptures stores the captures and refreshes the rollups of the areas of the captured hexes,
+// as only a capture changes which user leads a hex when scores go up.
+func (hls *HexLeaderboardService) recordCaptures(ctx context.Context, captures []*model.HexCapture) error {
+	if err := hls.hexCaptureRepository.CreateHexCaptures(ctx, captures); err != nil {
+		return err
+	}
+	h3Indexes := make([]string, len(captures))
+	for i, capture := range captures {
+		h3Indexes[i] = capture.H3Index
+	}
+	return hls.rollupService.RefreshRollups(ctx, h3Indexes)
+}
+
+// emitChanges sends the stored captures and the leaderboards whose ranking changed to the
+// subscribed webhooks, one event of each type per update. Users hidden by their privacy zones are
+// redacted from the events as they are from leaderboards.
+func (hls *HexLeaderboardService) emitChanges(ctx context.Context, activityID *uuid.UUID, captures []*model.HexCapture, leaderboards []dto.WebhookLeaderboard) error {
+	if len(captures) == 0 && len(leaderboards) == 0 {
+		return nil
+	}
+	userIDs := make([]uuid.UUID, 0)
+	for _, capture := range captures {
+		userIDs = append(userIDs, capture.NewOwnerID)
+		if capture.PreviousOwnerID != nil {
+			userIDs = append(userIDs, *capture.PreviousOwnerID)
+		}
+	}
+	for _, leaderboard := range leaderboards {
+		for _, u := range leaderboard.TopUsers {
+			userIDs = append(userIDs, u.UserID)
+		}
+	}
+	hidden, err := hls.privacyZoneService.HiddenIn(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+
+	if data := hexCapturedData(captures, hidden); len(data.Captures) > 0 {
+		if err := hls.webhookService.Emit(ctx, model.WebhookHexCaptured, data); err != nil {
+			return err
+		}
+	}
+	if leaderboards = redactWebhookLeaderboards(leaderboards, hidden); len(leaderboards) > 0 {
+		data := dto.WebhookLeaderboardChangedData{ActivityID: activityID, Leaderboards: leaderboards}
+		if err := hls.webhookService.Emit(ctx, model.WebhookLeaderboardChanged, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EffectiveScore returns a leaderboard entry's score decayed up to now. Entries without a last
+// update time keep their stored score.
+func (hls *HexLeaderboardService) EffectiveScore(user model.TopUser, now time.Time) float64 {
+	return effectiveScore(hls.scoring, user, now)
+}
+
+func effectiveScore(scoring ScoringStrategy, user model.TopUser, now time.Time) float64 {
+	if user.LastUpdated.IsZero() || !now.After(user.LastUpdated) {
+		return user.Score
+	}
+	return scoring.Decay(user.Score, now.Sub(user.LastUpdated))
+}
+
+// rankTopUsers sorts topUsers in place by their effective score at now, highest first.
+func (hls *HexLeaderboardService) rankTopUsers(topUsers []model.TopUser, now time.Time) {
+	sort.SliceStable(topUsers, func(i, j int) bool {
+		return hls.EffectiveScore(topUsers[i], now) > hls.EffectiveScore(topUsers[j], now)
+	})
+}
+
+// mergeTopUser places user into a copy of topUsers, replacing their previous entry, and reports
+// whether they made it into the top hls.leaderboardSize by effective score at now.
+func (hls *HexLeaderboardService) mergeTopUser(topUsers []model.TopUser, user model.TopUser, now time.Time) ([]model.TopUser, bool) {
+	newTopUsers := make([]model.TopUser, 0, len(topUsers)+1)
+	addedOrUpdated := false
+
+	for _, u := range topUsers {
+		if u.UserID == user.UserID {
+			if u.Score != user.Score || !u.LastUpdated.Equal(user.LastUpdated) {
+				newTopUsers = append(newTopUsers, user)
 			} else {
 				newTopUsers = append(newTopUsers, u)
 			}
+			addedOrUpdated = true
 		} else {
 			newTopUsers = append(newTopUsers, u)
 		}
 	}
 
 	if !addedOrUpdated {
-		newTopUsers = append(newTopUsers, model.TopUser{UserID: userID, UserName: userName, Score: score})
+		newTopUsers = append(newTopUsers, user)
 	}
 
-	sort.Slice(newTopUsers, func(i, j int) bool {
-		return newTopUsers[i].Score > newTopUsers[j].Score
-	})
+	hls.rankTopUsers(newTopUsers, now)
 
-	if len(newTopUsers) > 5 {
-		newTopUsers = newTopUsers[:5]
+	if len(newTopUsers) > hls.leaderboardSize {
+		newTopUsers = newTopUsers[:hls.leaderboardSize]
 	}
 
-	inTop := false
 	for _, u := range newTopUsers {
-		if u.UserID == userID {
-			inTop = true
-			break
+		if u.UserID == user.UserID {
+			return newTopUsers, true
 		}
 	}
-	if !inTop {
+	return newTopUsers, false
+}
+
+// AddUserToLeaderboards places the user into the leaderboards of all the given influences'
+// hexes, creating missing leaderboards. The leaderboards are read and written in bulk, and the
+// ones the user does not make it into are left untouched. The hexes the user took the lead of
+// are recorded as captures by activityID, if any, and returned. The users who lost the lead or
+// their place in the top are notified, without naming the user in hexes they are hidden in.
+// The leaderboards stay locked until the end of the transaction, so concurrent workers merge
+// into each other's results instead of overwriting them; it must run in a transaction.
+func (hls *HexLeaderboardService) AddUserToLeaderboards(ctx context.Context, userName string, activityID *uuid.UUID, influences []*model.HexInfluence) ([]*model.HexCapture, error) {
+	if len(influences) == 0 {
 		return nil, nil
 	}
-
-	hexLeaderboard.TopUsers = newTopUsers
-	updatedModel := &model.HexLeaderboard{
-		ID:       hexLeaderboard.ID,
-		H3Index:  hexLeaderboard.H3Index,
-		TopUsers: newTopUsers,
+	h3Indexes := make([]string, len(influences))
+	for i, influence := range influences {
+		h3Indexes[i] = influence.H3Index
 	}
-
-	_, err = hls.hexLeaderboardRepository.UpdateHexLeaderboard(ctx, updatedModel)
+	hexLeaderboards, err := hls.hexLeaderboardRepository.LockByH3Indexes(ctx, h3Indexes)
+	if err != nil {
+		return nil, err
+	}
+	topUsersByHex := make(map[string][]model.TopUser, len(hexLeaderboards))
+	for _, hexLeaderboard := range hexLeaderboards {
+		topUsersByHex[hexLeaderboard.H3Index] = hexLeaderboard.TopUsers
+	}
+	hidden, err := hls.privacyZoneService.HiddenIn(ctx, []uuid.UUID{influences[0].UserID})
 	if err != nil {
 		return nil, err
 	}
 
-	for idx, u := range newTopUsers {
-		if u.UserID == userID {
-			pos := idx + 1
-			return &pos, nil
+	now := time.Now()
+	changed := make([]*model.HexLeaderboard, 0, len(influences))
+	var captures []*model.HexCapture
+	var notifications []*model.Notification
+	var leaderboards []dto.WebhookLeaderboard
+	for _, influence := range influences {
+		user := model.TopUser{UserID: influence.UserID, UserName: userName, Score: influence.Score, LastUpdated: influence.LastUpdated}
+		previous := topUsersByHex[influence.H3Index]
+		newTopUsers, inTop := hls.mergeTopUser(previous, user, now)
+		if !inTop {
+			continue
+		}
+		changed = append(changed, &model.HexLeaderboard{H3Index: influence.H3Index, TopUsers: newTopUsers})
+		capture, hexNotifications := hls.leaderboardChanges(influence.H3Index, previous, newTopUsers, user, activityID, hidden, now)
+		if capture != nil {
+			captures = append(captures, capture)
+		}
+		notifications = append(notifications, hexNotifications...)
+		if hls.rankingChanged(previous, newTopUsers, now) {
+			leaderboards = append(leaderboards, hls.webhookLeaderboard(influence.H3Index, newTopUsers, now))
 		}
 	}
-
-	return nil, nil
+	if err := hls.hexLeaderboardRepository.UpsertHexLeaderboards(ctx, changed); err != nil {
+		return nil, err
+	}
+	if err := hls.recordCaptures(ctx, captures); err != nil {
+		return nil, err
+	}
+	if _, err := hls.notificationService.Notify(ctx, notifications...); err != nil {
+		return nil, err
+	}
+	if err := hls.emitChanges(ctx, activityID, captures, leaderboards); err != nil {
+		return nil, err
+	}
+	return captures, nil
 }
 
 // func AddUserToLeaderboardOrCreate
@@ -150,16 +431,24 @@ func (hls *HexLeaderboardService) AddUserToLeaderboardOrCreateLeaderboard(ctx co
 
 			// Create leaderboard with current user as first entry
 			leaderboard := &model.HexLeaderboard{
-				H3Index: hexID,
-				TopUsers: []model.TopUser{
-					{UserID: userID, UserName: userName, Score: hexInfluence.Score},
-				},
+				H3Index:  hexID,
+				TopUsers: []model.TopUser{topUserOf(hexInfluence, userName)},
 			}
 
 			_, err = hls.hexLeaderboardRepository.CreateHexLeaderboard(ctx, leaderboard)
 			if err != nil {
 				return nil, err
 			}
+			// First claim of the hex
+			now := time.Now()
+			captures := []*model.HexCapture{hls.captureOf(hexID, nil, leaderboard.TopUsers, userID, nil, now)}
+			if err := hls.recordCaptures(ctx, captures); err != nil {
+				return nil, err
+			}
+			leaderboards := []dto.WebhookLeaderboard{hls.webhookLeaderboard(hexID, leaderboard.TopUsers, now)}
+			if err := hls.emitChanges(ctx, nil, captures, leaderboards); err != nil {
+				return nil, err
+			}
 			pos := 1
 
 			return &pos, nil // user is the first and only one in the leaderboard
@@ -175,13 +464,252 @@ func (hls *HexLeaderboardService) AddUserToLeaderboardOrCreateLeaderboard(ctx co
 	return position, nil
 }
 
-// Return users position in a particular hex's leaderboard, returns nil if the user is not in the leaderboard / in case of an error
+// RebuildLeaderboard recomputes a hex's leaderboard from the influences currently stored for it.
+// Used when scores go down, which AddUserToLeaderboard cannot account for.
+func (hls *HexLeaderboardService) RebuildLeaderboard(ctx context.Context, hexID string) error {
+	_, err := hls.RebuildLeaderboards(ctx, []string{hexID})
+	return err
+}
+
+// RebuildLeaderboards rebuilds the leaderboards of the given hexes like RebuildLeaderboard and
+// returns how many of them changed leader. The rollups of all the given hexes are refreshed, as
+// their leader may have changed through decay alone since the rollups were last computed, which
+// comparing the old and new leaderboards at now does not tell.
+func (hls *HexLeaderboardService) RebuildLeaderboards(ctx context.Context, hexIDs []string) (int, error) {
+	now := time.Now()
+	changedHexes := make([]string, 0)
+	for _, hexID := range hexIDs {
+		changed, err := hls.rebuildLeaderboard(ctx, hexID, now)
+		if err != nil {
+			return len(changedHexes), err
+		}
+		if changed {
+			changedHexes = append(changedHexes, hexID)
+		}
+	}
+	return len(changedHexes), hls.rollupService.RefreshRollups(ctx, hexIDs)
+}
+
+// BackfillLeaderboardSize rebuilds all leaderboards when they were stored with another size than
+// the configured one, or with a size that was not recorded, and returns how many it rebuilt. The
+// size is recorded once they are rebuilt, so a restart part way through rebuilds them again.
+func (hls *HexLeaderboardService) BackfillLeaderboardSize(ctx context.Context) (int, error) {
+	size := strconv.Itoa(hls.leaderboardSize)
+	setting, err := hls.settingRepository.FindByKey(ctx, leaderboardSizeSetting)
+	if err != nil && !ent.IsNotFound(err) {
+		return 0, err
+	}
+	if setting != nil && setting.Value == size {
+		return 0, nil
+	}
+
+	leaderboards, err := hls.hexLeaderboardRepository.FindAll(ctx)
+	if err != nil {
+		return 0, err
+	}
+	h3Indexes := make([]string, len(leaderboards))
+	for i, leaderboard := range leaderboards {
+		h3Indexes[i] = leaderboard.H3Index
+	}
+	if _, err := hls.RebuildLeaderboards(ctx, h3Indexes); err != nil {
+		return 0, err
+	}
+	return len(h3Indexes), hls.settingRepository.SetValue(ctx, leaderboardSizeSetting, size, time.Now())
+}
+
+// rebuildLeaderboard rebuilds a hex's leaderboard ranked at now and reports whether the hex's
+// leader changed, including the hex losing its last owner.
+func (hls *HexLeaderboardService) rebuildLeaderboard(ctx context.Context, hexID string, now time.Time) (bool, error) {
+	influences, err := hls.hexInfluenceRepository.FindByHexIDWithUsers(ctx, hexID)
+	if err != nil {
+		return false, err
+	}
+
+	topUsers := make([]model.TopUser, 0, len(influences))
+	for _, influence := range influences {
+		userName := ""
+		if influence.Edges.Users != nil {
+			userName = influence.Edges.Users.Username
+		}
+		topUsers = append(topUsers, topUserOf(influence, userName))
+	}
+	hls.rankTopUsers(topUsers, now)
+	topUsers = hls.top(topUsers)
+
+	hexLeaderboard, err := hls.hexLeaderboardRepository.FindByH3Index(ctx, hexID)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return false, err
+		}
+		if len(topUsers) == 0 {
+			return false, nil
+		}
+		_, err = hls.hexLeaderboardRepository.CreateHexLeaderboard(ctx, &model.HexLeaderboard{H3Index: hexID, TopUsers: topUsers})
+		return true, err
+	}
+
+	previous := hexLeaderboard.TopUsers
+	hls.rankTopUsers(previous, now)
+	changed := len(previous) != 0 || len(topUsers) != 0
+	if len(previous) != 0 && len(topUsers) != 0 {
+		changed = previous[0].UserID != topUsers[0].UserID
+	}
+
+	_, err = hls.hexLeaderboardRepository.UpdateHexLeaderboard(ctx, &model.HexLeaderboard{
+		ID:       hexLeaderboard.ID,
+		H3Index:  hexLeaderboard.H3Index,
+		TopUsers: topUsers,
+	})
+	return changed, err
+}
+
+// Return users position in a particular hex's leaderboard ranked by effective score, returns nil if the user is not in the leaderboard / in case of an error
 func (hls *HexLeaderboardService) GetUserPositionInLeaderboard(ctx context.Context, hexID string, userID uuid.UUID) (*int, error) {
-	return hls.hexLeaderboardRepository.GetUserPositionInLeaderboard(ctx, hexID, userID)
+	hexLeaderboard, err := hls.hexLeaderboardRepository.FindByH3Index(ctx, hexID)
+	if err != nil {
+		return nil, err
+	}
+	influences, err := hls.hexInfluenceRepository.FindByHexIDWithUsers(ctx, hexID)
+	if err != nil {
+		return nil, err
+	}
+	hls.currentTopUsers([]*ent.HexLeaderboard{hexLeaderboard}, influences, time.Now())
+
+	for idx, user := range hls.top(hexLeaderboard.TopUsers) {
+		if user.UserID == userID {
+			pos := idx + 1
+			return &pos, nil
+		}
+	}
+	return nil, nil
+}
+
+// currentTopUsers replaces the top users of each leaderboard by every user with influence in its
+// hex, under their current name and ranked by effective score at now. The stored top users are
+// only the top as of the hex's last update, and decay can reorder users since, so readers rank
+// the influences instead.
+func (hls *HexLeaderboardService) currentTopUsers(leaderboards []*ent.HexLeaderboard, influences []*ent.HexInfluence, now time.Time) {
+	byHex := make(map[string][]model.TopUser, len(leaderboards))
+	for _, influence := range influences {
+		userName := ""
+		if influence.Edges.Users != nil {
+			userName = influence.Edges.Users.Username
+		}
+		byHex[influence.H3Index] = append(byHex[influence.H3Index], topUserOf(influence, userName))
+	}
+	for _, leaderboard := range leaderboards {
+		topUsers := byHex[leaderboard.H3Index]
+		hls.rankTopUsers(topUsers, now)
+		leaderboard.TopUsers = topUsers
+	}
+}
+
+// top returns the first hls.leaderboardSize of the ranked top users.
+func (hls *HexLeaderboardService) top(topUsers []model.TopUser) []model.TopUser {
+	if len(topUsers) > hls.leaderboardSize {
+		return topUsers[:hls.leaderboardSize]
+	}
+	return topUsers
 }
 
-// returns all existing hex leaderboards inside a given bounding box
-func (hls *HexLeaderboardService) GetAllLeaderboardsInsideBBBox(ctx context.Context, bbox BoundingBox) (*dto.GetAllHexLeaderboardsInsideBBoxResponse, error) {
+// GetHexRanking returns a page of the ranking of every user with influence in a hex, by effective
+// score, unlike the leaderboard that keeps only the top users. The requesting user's own place and
+// the effective score they lack to move up one place are always included. Other users hiding their
+// privacy zones from leaderboards are left out of the hexes inside them. The ranking is sorted and
+// paged by the database. A limit of 0 uses DefaultHexRankingLimit.
+func (hls *HexLeaderboardService) GetHexRanking(ctx context.Context, hexID string, userID uuid.UUID, limit, offset int) (*dto.GetHexRankingResponse, error) {
+	if err := validateH3Index(hexID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidHex, err)
+	}
+	if limit == 0 {
+		limit = DefaultHexRankingLimit
+	}
+	if limit < 1 || limit > MaxHexRankingLimit || offset < 0 {
+		return nil, ErrInvalidRankingPage
+	}
+
+	hidden, err := hls.hiddenInHex(ctx, hexID, userID)
+	if err != nil {
+		return nil, err
+	}
+	participants, err := hls.hexInfluenceRepository.CountHexRanking(ctx, hexID, hidden)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	page, err := hls.hexInfluenceRepository.FindHexRankingPage(ctx, hls.scoring, hexID, hidden, now, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.GetHexRankingResponse{
+		H3Index:      hexID,
+		Participants: participants,
+		Rankings:     make([]dto.HexRankingEntry, 0, len(page)),
+		User:         dto.HexRankingPosition{UserID: userID},
+	}
+	for i, row := range page {
+		resp.Rankings = append(resp.Rankings, dto.HexRankingEntry{
+			Rank:           offset + i + 1,
+			UserID:         row.UserID,
+			UserName:       row.Username,
+			Score:          row.Score,
+			EffectiveScore: row.EffectiveScore,
+		})
+	}
+	if next := offset + len(page); next < participants {
+		resp.NextOffset = &next
+	}
+
+	own, err := hls.hexInfluenceRepository.FindHexRankingOf(ctx, hls.scoring, hexID, userID, now)
+	if err != nil || own == nil {
+		return resp, err
+	}
+	above, err := hls.hexInfluenceRepository.CountHexRankingAbove(ctx, hls.scoring, hexID, hidden, now, *own)
+	if err != nil {
+		return nil, err
+	}
+	rank := above + 1
+	resp.User.Rank = &rank
+	resp.User.EffectiveScore = own.EffectiveScore
+	if above > 0 {
+		next, err := hls.hexInfluenceRepository.FindHexRankingPage(ctx, hls.scoring, hexID, hidden, now, 1, above-1)
+		if err != nil {
+			return nil, err
+		}
+		if len(next) > 0 {
+			gap := next[0].EffectiveScore - own.EffectiveScore
+			resp.User.GapToNext = &gap
+		}
+	}
+	return resp, nil
+}
+
+// hiddenInHex returns the users other than userID whose privacy zones hide them in the hex.
+func (hls *HexLeaderboardService) hiddenInHex(ctx context.Context, hexID string, userID uuid.UUID) ([]uuid.UUID, error) {
+	optedIn, err := hls.hexInfluenceRepository.FindZoneHidingUserIDsByHexID(ctx, hexID)
+	if err != nil {
+		return nil, err
+	}
+	hiddenIn, err := hls.privacyZoneService.HiddenIn(ctx, optedIn)
+	if err != nil {
+		return nil, err
+	}
+	hidden := make([]uuid.UUID, 0, len(optedIn))
+	for _, optedInID := range optedIn {
+		if optedInID != userID && hiddenIn(optedInID, hexID) {
+			hidden = append(hidden, optedInID)
+		}
+	}
+	return hidden, nil
+}
+
+// returns all existing hex leaderboards inside a given bounding box. At a rollup resolution the
+// rollups of the coarser cells inside it are returned instead.
+func (hls *HexLeaderboardService) GetAllLeaderboardsInsideBBBox(ctx context.Context, bbox BoundingBox, resolution int) (*dto.GetAllHexLeaderboardsInsideBBoxResponse, error) {
+	if !slices.Contains(hexconsts.RollupResolutions, resolution) && resolution != hexconsts.DefaultHexResolution {
+		return nil, ErrInvalidResolution
+	}
 
 	verts := h3.GeoLoop{
 		{Lat: bbox.MinLat, Lng: bbox.MinLng},
@@ -196,7 +724,7 @@ func (hls *HexLeaderboardService) GetAllLeaderboardsInsideBBBox(ctx context.Cont
 		Holes:   nil,
 	}
 
-	h3Cells, err := h3.PolygonToCells(poly, hexconsts.DefaultHexResolution) // 9 is the resolution, adjust as needed
+	h3Cells, err := h3.PolygonToCells(poly, resolution)
 	if err != nil {
 		hls.logger.Error("Failed to convert polygon to H3 cells", zap.Error(err))
 		return nil, err
@@ -205,16 +733,79 @@ func (hls *HexLeaderboardService) GetAllLeaderboardsInsideBBBox(ctx context.Cont
 	for i, cell := range h3Cells {
 		h3Indexes[i] = cell.String()
 	}
+
+	if resolution != hexconsts.DefaultHexResolution {
+		rollups, err := hls.rollupService.GetRollups(ctx, h3Indexes)
+		if err != nil {
+			hls.logger.Error("Failed to fetch hex rollups by H3 indexes", zap.Error(err))
+			return nil, err
+		}
+		return &dto.GetAllHexLeaderboardsInsideBBoxResponse{
+			Resolution:   resolution,
+			Leaderboards: []dto.HexLeaderboardResponse{},
+			Rollups:      rollups,
+		}, nil
+	}
+
 	// Fetch all hex leaderboards for the given H3 indexes
 	hexLeaderboards, err := hls.hexLeaderboardRepository.FindByH3Indexes(ctx, h3Indexes)
 	if err != nil {
 		hls.logger.Error("Failed to fetch hex leaderboards by H3 indexes", zap.Error(err))
 		return nil, err
 	}
+	leaderboardHexes := make([]string, len(hexLeaderboards))
+	for i, hexLeaderboard := range hexLeaderboards {
+		leaderboardHexes[i] = hexLeaderboard.H3Index
+	}
+	influences, err := hls.hexInfluenceRepository.FindByHexIDsWithUsers(ctx, leaderboardHexes)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	hls.currentTopUsers(hexLeaderboards, influences, now)
+	// Hidden users are left out before cutting the top, so the next users move up in their place.
+	if err := hls.privacyZoneService.RedactLeaderboards(ctx, hexLeaderboards); err != nil {
+		return nil, err
+	}
+	for _, hexLeaderboard := range hexLeaderboards {
+		hexLeaderboard.TopUsers = hls.top(hexLeaderboard.TopUsers)
+	}
 	// Map the hex leaderboards to the response format
-	return mappers.MapHexLeaderboardsToResponse(hexLeaderboards), nil
+	return mappers.MapHexLeaderboardsToResponse(hexLeaderboards, func(user model.TopUser) float64 {
+		return hls.EffectiveScore(user, now)
+	}), nil
 }
 
+// ResolutionForZoom returns the resolution a map at the given zoom level shows: the default
+// resolution when zoomed in and the rollup resolutions as the map zooms out.
+func ResolutionForZoom(zoom int) int {
+	switch {
+	case zoom >= 13:
+		return hexconsts.DefaultHexResolution
+	case zoom >= 10:
+		return hexconsts.RollupResolutions[0]
+	default:
+		return hexconsts.RollupResolutions[len(hexconsts.RollupResolutions)-1]
+	}
+}
+
+// GetGlobalLeaderboard returns the ten users leading the most hexes, where a hex's leader is the
+// user with the highest effective score in it.
 func (hls *HexLeaderboardService) GetGlobalLeaderboard(ctx context.Context) ([]dto.GlobalLeaderboardEntry, error) {
-	return hls.hexLeaderboardRepository.GetGlobalHexLeaderboard(ctx)
+	leaders, err := hls.hexInfluenceRepository.FindTopLeaders(ctx, hls.scoring, time.Now(), 10)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]dto.GlobalLeaderboardEntry, 0, len(leaders))
+	for _, leader := range leaders {
+		entries = append(entries, dto.GlobalLeaderboardEntry{
+			UserID:         leader.UserID,
+			Username:       leader.Username,
+			TopCount:       leader.Hexes,
+			Score:          leader.Score,
+			EffectiveScore: leader.EffectiveScore,
+		})
+	}
+	return entries, nil
 }
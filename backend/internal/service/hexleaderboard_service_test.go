@@ -5,11 +5,17 @@ import (
 	"strconv"
 	"stride-wars-app/ent"
 	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/config"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/hex/hexconsts"
+	"stride-wars-app/internal/service"
 	"stride-wars-app/internal/testutil"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 )
 
 func TestHexLeaderboardService_GlobalLeaderboard_ManyUsers(t *testing.T) {
@@ -33,17 +39,23 @@ func TestHexLeaderboardService_GlobalLeaderboard_ManyUsers(t *testing.T) {
 	}
 
 	for h := 0; h < 30; h++ {
-		topUserID := users[rand.Intn(len(users))].ID
-		topUserUsername := users[rand.Intn(len(users))].Username
+		topUser := users[rand.Intn(len(users))]
 
 		// Now using string H3 index instead of int64
 		idxStr := strconv.Itoa(1000 + h)
 		_, err := hexSvc.CreateHex(ctx, idxStr)
 		require.NoError(t, err)
 
+		influence, err := tdb.HexInfluenceRepo.CreateHexInfluence(ctx, &model.HexInfluence{
+			UserID:      topUser.ID,
+			H3Index:     idxStr,
+			Score:       float64(1 + rand.Intn(100)),
+			LastUpdated: time.Now(),
+		})
+		require.NoError(t, err)
 		_, err = hexLeaderboardService.CreateHexLeaderboard(ctx, &model.HexLeaderboard{
 			H3Index:  idxStr,
-			TopUsers: []model.TopUser{{UserID: topUserID, UserName: topUserUsername, Score: float64(rand.Intn(100))}},
+			TopUsers: []model.TopUser{{UserID: topUser.ID, UserName: topUser.Username, Score: influence.Score, LastUpdated: influence.LastUpdated}},
 		})
 		require.NoError(t, err)
 	}
@@ -55,3 +67,227 @@ func TestHexLeaderboardService_GlobalLeaderboard_ManyUsers(t *testing.T) {
 
 	require.GreaterOrEqual(t, entries[0].TopCount, entries[len(entries)-1].TopCount)
 }
+
+func TestHexLeaderboardService_EffectiveScores(t *testing.T) {
+	t.Parallel()
+
+	tdb := testutil.NewTestServices(t)
+	ctx := tdb.Ctx
+	hexLeaderboardService := tdb.HexLeaderboardService
+
+	veteran, err := tdb.UserRepo.CreateUser(ctx, &model.User{Username: "veteran", ExternalUser: uuid.New()})
+	require.NoError(t, err)
+	newcomer, err := tdb.UserRepo.CreateUser(ctx, &model.User{Username: "newcomer", ExternalUser: uuid.New()})
+	require.NoError(t, err)
+	legacy, err := tdb.UserRepo.CreateUser(ctx, &model.User{Username: "legacy", ExternalUser: uuid.New()})
+	require.NoError(t, err)
+
+	hexID := validH3Indexes[0]
+	require.NoError(t, tdb.HexService.CreateMissingHexes(ctx, []string{hexID}))
+
+	// The veteran ran the hex a lot a year ago; linear decay has since cut the score to a tenth.
+	now := time.Now()
+	yearAgo := now.Add(-365 * 24 * time.Hour)
+	for _, influence := range []*model.HexInfluence{
+		{UserID: veteran.ID, H3Index: hexID, Score: 20, LastUpdated: yearAgo},
+		{UserID: newcomer.ID, H3Index: hexID, Score: 3, LastUpdated: now},
+		// Influences stored before last_updated was recorded keep their score.
+		{UserID: legacy.ID, H3Index: hexID, Score: 2.5},
+	} {
+		_, err = tdb.HexInfluenceRepo.CreateHexInfluence(ctx, influence)
+		require.NoError(t, err)
+	}
+	// The stored leaderboard was last updated when the veteran led the hex under an old name, so
+	// readers rank the influences instead.
+	_, err = hexLeaderboardService.CreateHexLeaderboard(ctx, &model.HexLeaderboard{
+		H3Index:  hexID,
+		TopUsers: []model.TopUser{{UserID: veteran.ID, UserName: "rookie", Score: 20, LastUpdated: yearAgo}},
+	})
+	require.NoError(t, err)
+
+	center := cellCenter(t, hexID, now)
+	resp, err := hexLeaderboardService.GetAllLeaderboardsInsideBBBox(ctx, service.BoundingBox{
+		MinLat: center.Lat - 0.001,
+		MinLng: center.Lng - 0.001,
+		MaxLat: center.Lat + 0.001,
+		MaxLng: center.Lng + 0.001,
+	}, hexconsts.DefaultHexResolution)
+	require.NoError(t, err)
+	require.Len(t, resp.Leaderboards, 1)
+	topUsers := resp.Leaderboards[0].TopUsers
+	require.Len(t, topUsers, 3)
+	require.Equal(t, newcomer.ID, topUsers[0].UserID)
+	require.Equal(t, 3.0, topUsers[0].EffectiveScore)
+	require.Equal(t, legacy.ID, topUsers[1].UserID)
+	require.Equal(t, 2.5, topUsers[1].EffectiveScore)
+	require.Equal(t, veteran.ID, topUsers[2].UserID)
+	require.Equal(t, veteran.Username, topUsers[2].UserName)
+	require.Equal(t, 20.0, topUsers[2].Score)
+	require.InDelta(t, 2.0, topUsers[2].EffectiveScore, 1e-9)
+
+	position, err := hexLeaderboardService.GetUserPositionInLeaderboard(ctx, hexID, newcomer.ID)
+	require.NoError(t, err)
+	require.NotNil(t, position)
+	require.Equal(t, 1, *position)
+	position, err = hexLeaderboardService.GetUserPositionInLeaderboard(ctx, hexID, veteran.ID)
+	require.NoError(t, err)
+	require.NotNil(t, position)
+	require.Equal(t, 3, *position)
+
+	entries, err := hexLeaderboardService.GetGlobalLeaderboard(ctx)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, newcomer.ID, entries[0].UserID)
+	require.Equal(t, newcomer.Username, entries[0].Username)
+	require.Equal(t, 1, entries[0].TopCount)
+	require.Equal(t, 3.0, entries[0].Score)
+	require.Equal(t, 3.0, entries[0].EffectiveScore)
+
+	// Rebuilding from the stored influences ranks them the same way.
+	require.NoError(t, hexLeaderboardService.RebuildLeaderboard(ctx, hexID))
+
+	rebuilt, err := hexLeaderboardService.FindByH3Index(ctx, hexID)
+	require.NoError(t, err)
+	require.Len(t, rebuilt.TopUsers, 3)
+	require.Equal(t, newcomer.ID, rebuilt.TopUsers[0].UserID)
+	require.Equal(t, veteran.ID, rebuilt.TopUsers[2].UserID)
+	require.True(t, yearAgo.Equal(rebuilt.TopUsers[2].LastUpdated))
+}
+
+func TestHexLeaderboardService_HexRanking(t *testing.T) {
+	t.Parallel()
+
+	tdb := testutil.NewTestServices(t)
+	ctx := tdb.Ctx
+	hexID := validH3Indexes[0]
+	require.NoError(t, tdb.HexService.CreateMissingHexes(ctx, []string{hexID}))
+
+	// Seven users with scores 70, 60, ..., 10 in the hex, more than the stored top 5.
+	var users []*ent.User
+	for i := 0; i < 7; i++ {
+		user, err := tdb.UserRepo.CreateUser(ctx, &model.User{Username: "runner" + strconv.Itoa(i), ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		_, err = tdb.HexInfluenceRepo.CreateHexInfluence(ctx, &model.HexInfluence{
+			UserID:      user.ID,
+			H3Index:     hexID,
+			Score:       float64(70 - 10*i),
+			LastUpdated: time.Now(),
+		})
+		require.NoError(t, err)
+		users = append(users, user)
+	}
+	require.NoError(t, tdb.HexLeaderboardService.RebuildLeaderboard(ctx, hexID))
+
+	leaderboard, err := tdb.HexLeaderboardService.FindByH3Index(ctx, hexID)
+	require.NoError(t, err)
+	require.Len(t, leaderboard.TopUsers, 5)
+	position, err := tdb.HexLeaderboardService.GetUserPositionInLeaderboard(ctx, hexID, users[6].ID)
+	require.NoError(t, err)
+	require.Nil(t, position)
+
+	// The seventh user is ranked all the same, 10 points behind the sixth.
+	ranking, err := tdb.HexLeaderboardService.GetHexRanking(ctx, hexID, users[6].ID, 3, 3)
+	require.NoError(t, err)
+	require.Equal(t, 7, ranking.Participants)
+	require.Len(t, ranking.Rankings, 3)
+	require.Equal(t, 4, ranking.Rankings[0].Rank)
+	require.Equal(t, users[3].ID, ranking.Rankings[0].UserID)
+	require.Equal(t, "runner3", ranking.Rankings[0].UserName)
+	require.NotNil(t, ranking.NextOffset)
+	require.Equal(t, 6, *ranking.NextOffset)
+	require.NotNil(t, ranking.User.Rank)
+	require.Equal(t, 7, *ranking.User.Rank)
+	require.InDelta(t, 10.0, ranking.User.EffectiveScore, 1e-6)
+	require.NotNil(t, ranking.User.GapToNext)
+	require.InDelta(t, 10.0, *ranking.User.GapToNext, 1e-6)
+
+	// The leader has no one to catch up with.
+	ranking, err = tdb.HexLeaderboardService.GetHexRanking(ctx, hexID, users[0].ID, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, ranking.Rankings, 7)
+	require.Nil(t, ranking.NextOffset)
+	require.Equal(t, 1, *ranking.User.Rank)
+	require.Nil(t, ranking.User.GapToNext)
+
+	// A user without influence in the hex is not ranked.
+	ranking, err = tdb.HexLeaderboardService.GetHexRanking(ctx, hexID, uuid.New(), 0, 0)
+	require.NoError(t, err)
+	require.Nil(t, ranking.User.Rank)
+
+	// The runner-up hides the hex inside a privacy zone from everyone but themselves.
+	privacy := tdb.ActivityService.PrivacyZoneService
+	_, err = privacy.UpdateSettings(ctx, dto.PrivacySettingsRequest{UserID: users[1].ID, HideZoneLeaderboards: true})
+	require.NoError(t, err)
+	_, err = privacy.CreateZone(ctx, dto.PrivacyZoneRequest{UserID: users[1].ID, H3Indexes: []string{hexID}})
+	require.NoError(t, err)
+	ranking, err = tdb.HexLeaderboardService.GetHexRanking(ctx, hexID, users[6].ID, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 6, ranking.Participants)
+	require.Len(t, ranking.Rankings, 6)
+	require.Equal(t, users[2].ID, ranking.Rankings[1].UserID)
+	require.Equal(t, 2, ranking.Rankings[1].Rank)
+	require.Equal(t, 6, *ranking.User.Rank)
+	ranking, err = tdb.HexLeaderboardService.GetHexRanking(ctx, hexID, users[1].ID, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, 7, ranking.Participants)
+	require.Equal(t, 2, *ranking.User.Rank)
+	require.InDelta(t, 10.0, *ranking.User.GapToNext, 1e-6)
+
+	_, err = tdb.HexLeaderboardService.GetHexRanking(ctx, hexID, users[0].ID, 500, 0)
+	require.ErrorIs(t, err, service.ErrInvalidRankingPage)
+	_, err = tdb.HexLeaderboardService.GetHexRanking(ctx, "not-a-hex", users[0].ID, 0, 0)
+	require.ErrorIs(t, err, service.ErrInvalidHex)
+}
+
+func TestHexLeaderboardService_ConfigurableSize(t *testing.T) {
+	t.Parallel()
+
+	tdb := testutil.NewTestServices(t)
+	ctx := tdb.Ctx
+	cfg := config.Default()
+	cfg.LeaderboardSize = 2
+	hexLeaderboardService := service.NewHexLeaderboardService(
+		tdb.Repositories.HexLeaderboardRepository,
+		tdb.Repositories.HexInfluenceRepository,
+		tdb.Repositories.HexCaptureRepository,
+		tdb.Repositories.SettingRepository,
+		tdb.ActivityService.PrivacyZoneService,
+		tdb.ActivityService.NotificationService,
+		tdb.ActivityService.WebhookService,
+		tdb.ActivityService.HexRollupService,
+		service.NewScoringStrategy(cfg),
+		cfg,
+		zap.NewExample(),
+	)
+
+	// No leaderboard to rebuild yet, but the size is recorded.
+	rebuilt, err := hexLeaderboardService.BackfillLeaderboardSize(ctx)
+	require.NoError(t, err)
+	require.Zero(t, rebuilt)
+
+	hexID := validH3Indexes[0]
+	require.NoError(t, tdb.HexService.CreateMissingHexes(ctx, []string{hexID}))
+	for i := 0; i < 3; i++ {
+		user, err := tdb.UserRepo.CreateUser(ctx, &model.User{Username: "runner" + strconv.Itoa(i), ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		influences, err := tdb.HexInfluenceService.RecordVisitsAt(ctx, user.ID, nil, []string{hexID}, time.Now())
+		require.NoError(t, err)
+		_, err = hexLeaderboardService.AddUserToLeaderboards(ctx, user.Username, nil, influences)
+		require.NoError(t, err)
+	}
+
+	leaderboard, err := hexLeaderboardService.FindByH3Index(ctx, hexID)
+	require.NoError(t, err)
+	require.Len(t, leaderboard.TopUsers, 2)
+
+	// Restarting with another size rebuilds the stored leaderboards once.
+	rebuilt, err = tdb.HexLeaderboardService.BackfillLeaderboardSize(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, rebuilt)
+	leaderboard, err = tdb.HexLeaderboardService.FindByH3Index(ctx, hexID)
+	require.NoError(t, err)
+	require.Len(t, leaderboard.TopUsers, 3)
+	rebuilt, err = tdb.HexLeaderboardService.BackfillLeaderboardSize(ctx)
+	require.NoError(t, err)
+	require.Zero(t, rebuilt)
+}
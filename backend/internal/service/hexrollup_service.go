@@ -0,0 +1,330 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/hex/hexconsts"
+	"stride-wars-app/internal/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uber/h3-go/v4"
+	"go.uber.org/zap"
+)
+
+// HexRollupService keeps the ownership of the cells at the default resolution rolled up to the
+// coarser hexconsts.RollupResolutions. A cell's owner is its leader by effective score, and the
+// rollups of an area are refreshed whenever one of its cells may have changed leader: on captures,
+// leaderboard rebuilds, including the decay sweep's, and privacy changes. Users hiding their
+// privacy zones from leaderboards are not counted in the cells inside them.
+type HexRollupService struct {
+	repository               repository.HexRollupRepository
+	hexLeaderboardRepository repository.HexLeaderboardRepository
+	hexInfluenceRepository   repository.HexInfluenceRepository
+	privacyZoneService       *PrivacyZoneService
+	scoring                  ScoringStrategy
+	logger                   *zap.Logger
+}
+
+func NewHexRollupService(repositories *repository.Repositories, privacyZoneService *PrivacyZoneService, scoring ScoringStrategy, logger *zap.Logger) *HexRollupService {
+	return &HexRollupService{
+		repository:               repositories.HexRollupRepository,
+		hexLeaderboardRepository: repositories.HexLeaderboardRepository,
+		hexInfluenceRepository:   repositories.HexInfluenceRepository,
+		privacyZoneService:       privacyZoneService,
+		scoring:                  scoring,
+		logger:                   logger,
+	}
+}
+
+// RefreshRollups recomputes the rollups of the areas containing the given cells at every rollup
+// resolution, from the influences for the finest one and from the finer rollups for the rest.
+// Areas left without an owned cell lose their rollup. The rollups are locked before their cells
+// are read, so concurrent workers refreshing the same area take turns and each one sees what the
+// previous one wrote instead of overwriting it with a stale count; it must run in a transaction.
+func (s *HexRollupService) RefreshRollups(ctx context.Context, h3Indexes []string) error {
+	now := time.Now()
+	cells := h3Indexes
+	source := hexconsts.DefaultHexResolution
+	for _, resolution := range hexconsts.RollupResolutions {
+		parents := parentCells(cells, source, resolution)
+		if len(parents) == 0 {
+			return nil
+		}
+		if _, err := s.repository.LockByH3Indexes(ctx, parents, resolution, now); err != nil {
+			return err
+		}
+
+		var rollups []*model.HexRollup
+		var err error
+		if source == hexconsts.DefaultHexResolution {
+			rollups, err = s.rollUpLeaderboards(ctx, parents, resolution, now)
+		} else {
+			rollups, err = s.rollUpRollups(ctx, parents, source, resolution, now)
+		}
+		if err != nil {
+			return err
+		}
+
+		owned := make([]*model.HexRollup, 0, len(rollups))
+		empty := make([]string, 0)
+		for _, rollup := range rollups {
+			if rollup.OwnedHexes > 0 {
+				owned = append(owned, rollup)
+			} else {
+				empty = append(empty, rollup.H3Index)
+			}
+		}
+		if err := s.repository.UpsertHexRollups(ctx, owned); err != nil {
+			return err
+		}
+		if _, err := s.repository.DeleteByH3Indexes(ctx, empty); err != nil {
+			return err
+		}
+
+		cells = parents
+		source = resolution
+	}
+	return nil
+}
+
+// BackfillRollups rolls up all existing leaderboards when no rollup has been stored yet, and
+// returns how many leaderboards it rolled up.
+func (s *HexRollupService) BackfillRollups(ctx context.Context) (int, error) {
+	exists, err := s.repository.Exists(ctx)
+	if err != nil || exists {
+		return 0, err
+	}
+	leaderboards, err := s.hexLeaderboardRepository.FindAll(ctx)
+	if err != nil {
+		return 0, err
+	}
+	h3Indexes := make([]string, len(leaderboards))
+	for i, leaderboard := range leaderboards {
+		h3Indexes[i] = leaderboard.H3Index
+	}
+	return len(h3Indexes), s.RefreshRollups(ctx, h3Indexes)
+}
+
+// GetRollups returns the rollups of the given cells, sorted by cell.
+func (s *HexRollupService) GetRollups(ctx context.Context, h3Indexes []string) ([]dto.HexRollupResponse, error) {
+	rollups, err := s.repository.FindByH3Indexes(ctx, h3Indexes)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]dto.HexRollupResponse, 0, len(rollups))
+	for _, rollup := range rollups {
+		cell := h3.Cell(h3.IndexFromString(rollup.H3Index))
+		total := childCount(cell, hexconsts.DefaultHexResolution)
+		entry := dto.HexRollupResponse{
+			H3Index:    rollup.H3Index,
+			Resolution: rollup.Resolution,
+			OwnedHexes: rollup.OwnedHexes,
+			TotalHexes: total,
+		}
+		if len(rollup.Owners) != 0 {
+			dominant := rollup.Owners[0]
+			entry.DominantUserID = &dominant.UserID
+			entry.DominantUserName = dominant.UserName
+			entry.DominantHexes = dominant.Hexes
+			entry.Coverage = float64(dominant.Hexes) / float64(total)
+		}
+		resp = append(resp, entry)
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].H3Index < resp[j].H3Index
+	})
+	return resp, nil
+}
+
+// rollUpLeaderboards counts the cells each user leads under each of the parents, ranking the
+// influences in the cells rather than their stored leaderboards, which decay may have reordered.
+func (s *HexRollupService) rollUpLeaderboards(ctx context.Context, parents []string, resolution int, now time.Time) ([]*model.HexRollup, error) {
+	children, err := childCells(parents, hexconsts.DefaultHexResolution)
+	if err != nil {
+		return nil, err
+	}
+	influences, err := s.hexInfluenceRepository.FindByHexIDsWithUsers(ctx, children)
+	if err != nil {
+		return nil, err
+	}
+	byHex := make(map[string]*ent.HexLeaderboard)
+	leaderboards := make([]*ent.HexLeaderboard, 0)
+	for _, influence := range influences {
+		leaderboard, ok := byHex[influence.H3Index]
+		if !ok {
+			leaderboard = &ent.HexLeaderboard{H3Index: influence.H3Index}
+			byHex[influence.H3Index] = leaderboard
+			leaderboards = append(leaderboards, leaderboard)
+		}
+		userName := ""
+		if influence.Edges.Users != nil {
+			userName = influence.Edges.Users.Username
+		}
+		leaderboard.TopUsers = append(leaderboard.TopUsers, topUserOf(influence, userName))
+	}
+	if err := s.privacyZoneService.RedactLeaderboards(ctx, leaderboards); err != nil {
+		return nil, err
+	}
+
+	rollups := newRollups(parents, resolution, now)
+	for _, leaderboard := range leaderboards {
+		leader, ok := leaderOf(s.scoring, leaderboard, now)
+		if !ok {
+			continue
+		}
+		parent, err := h3.Cell(h3.IndexFromString(leaderboard.H3Index)).Parent(resolution)
+		if err != nil {
+			return nil, err
+		}
+		rollups[parent.String()].add(model.RollupOwner{UserID: leader.UserID, UserName: leader.UserName, Hexes: 1})
+	}
+	return rollups.sorted(), nil
+}
+
+// rollUpRollups sums the owners of the finer rollups under each of the parents.
+func (s *HexRollupService) rollUpRollups(ctx context.Context, parents []string, source, resolution int, now time.Time) ([]*model.HexRollup, error) {
+	children, err := childCells(parents, source)
+	if err != nil {
+		return nil, err
+	}
+	finer, err := s.repository.FindByH3Indexes(ctx, children)
+	if err != nil {
+		return nil, err
+	}
+
+	rollups := newRollups(parents, resolution, now)
+	for _, child := range finer {
+		parent, err := h3.Cell(h3.IndexFromString(child.H3Index)).Parent(resolution)
+		if err != nil {
+			return nil, err
+		}
+		for _, owner := range child.Owners {
+			rollups[parent.String()].add(owner)
+		}
+	}
+	return rollups.sorted(), nil
+}
+
+// leaderOf returns the user of a leaderboard with the highest effective score at now.
+func leaderOf(scoring ScoringStrategy, leaderboard *ent.HexLeaderboard, now time.Time) (model.TopUser, bool) {
+	var leader model.TopUser
+	best := math.Inf(-1)
+	for _, topUser := range leaderboard.TopUsers {
+		if score := effectiveScore(scoring, topUser, now); score > best {
+			leader, best = topUser, score
+		}
+	}
+	return leader, len(leaderboard.TopUsers) != 0
+}
+
+// rollupSet holds the rollups being computed, by cell, with each one's owners by user.
+type rollupSet map[string]*pendingRollup
+
+type pendingRollup struct {
+	rollup *model.HexRollup
+	owners map[uuid.UUID]*model.RollupOwner
+}
+
+func newRollups(cells []string, resolution int, now time.Time) rollupSet {
+	rollups := make(rollupSet, len(cells))
+	for _, cell := range cells {
+		rollups[cell] = &pendingRollup{
+			rollup: &model.HexRollup{H3Index: cell, Resolution: resolution, UpdatedAt: now},
+			owners: make(map[uuid.UUID]*model.RollupOwner),
+		}
+	}
+	return rollups
+}
+
+func (p *pendingRollup) add(owner model.RollupOwner) {
+	existing, ok := p.owners[owner.UserID]
+	if !ok {
+		existing = &model.RollupOwner{UserID: owner.UserID, UserName: owner.UserName}
+		p.owners[owner.UserID] = existing
+	}
+	existing.Hexes += owner.Hexes
+	p.rollup.OwnedHexes += owner.Hexes
+}
+
+// sorted returns the rollups with their owners ordered by the cells they lead, most first.
+func (rollups rollupSet) sorted() []*model.HexRollup {
+	result := make([]*model.HexRollup, 0, len(rollups))
+	for _, pending := range rollups {
+		owners := make([]model.RollupOwner, 0, len(pending.owners))
+		for _, owner := range pending.owners {
+			owners = append(owners, *owner)
+		}
+		sort.Slice(owners, func(i, j int) bool {
+			if owners[i].Hexes != owners[j].Hexes {
+				return owners[i].Hexes > owners[j].Hexes
+			}
+			return owners[i].UserID.String() < owners[j].UserID.String()
+		})
+		pending.rollup.Owners = owners
+		result = append(result, pending.rollup)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].H3Index < result[j].H3Index
+	})
+	return result
+}
+
+// parentCells returns the distinct parents at resolution of the given cells at source resolution.
+// Strings that are not valid cells at the source resolution are skipped.
+func parentCells(h3Indexes []string, source, resolution int) []string {
+	seen := make(map[string]bool)
+	parents := make([]string, 0)
+	for _, h3Index := range h3Indexes {
+		cell := h3.Cell(h3.IndexFromString(h3Index))
+		if !cell.IsValid() || cell.Resolution() != source {
+			continue
+		}
+		parent, err := cell.Parent(resolution)
+		if err != nil {
+			continue
+		}
+		if !seen[parent.String()] {
+			seen[parent.String()] = true
+			parents = append(parents, parent.String())
+		}
+	}
+	sort.Strings(parents)
+	return parents
+}
+
+// childCells returns all the children at resolution of the given cells.
+func childCells(h3Indexes []string, resolution int) ([]string, error) {
+	children := make([]string, 0)
+	for _, h3Index := range h3Indexes {
+		cells, err := h3.Cell(h3.IndexFromString(h3Index)).Children(resolution)
+		if err != nil {
+			return nil, err
+		}
+		for _, cell := range cells {
+			children = append(children, cell.String())
+		}
+	}
+	return children, nil
+}
+
+// childCount returns the number of children a cell has at resolution. Hexagons have 7 per
+// resolution step; the few pentagons have fewer and are counted.
+func childCount(cell h3.Cell, resolution int) int {
+	if cell.IsPentagon() {
+		children, err := cell.Children(resolution)
+		if err == nil {
+			return len(children)
+		}
+	}
+	count := 1
+	for r := cell.Resolution(); r < resolution; r++ {
+		count *= 7
+	}
+	return count
+}
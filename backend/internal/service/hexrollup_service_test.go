@@ -0,0 +1,245 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/hex/hexconsts"
+	"stride-wars-app/internal/service"
+	"stride-wars-app/internal/testutil"
+)
+
+func TestHexRollupService(t *testing.T) {
+	t.Parallel()
+
+	// rollupsAt returns the rollups of the bounding box around the center of h3Index.
+	rollupsAt := func(t *testing.T, tdb *testutil.TestServices, h3Index string, resolution int) []dto.HexRollupResponse {
+		t.Helper()
+		center := cellCenter(t, h3Index, time.Now())
+		resp, err := tdb.HexLeaderboardService.GetAllLeaderboardsInsideBBBox(tdb.Ctx, service.BoundingBox{
+			MinLat: center.Lat - 0.001,
+			MinLng: center.Lng - 0.001,
+			MaxLat: center.Lat + 0.001,
+			MaxLng: center.Lng + 0.001,
+		}, resolution)
+		require.NoError(t, err)
+		require.Equal(t, resolution, resp.Resolution)
+		require.Empty(t, resp.Leaderboards)
+		return resp.Rollups
+	}
+
+	// setup gives alice the lead in three hexes and bob in one. The first three hexes share the
+	// resolution 7 parent 871e2e6b1ffffff, the last one is under 871e2e6b0ffffff, and all four
+	// share the resolution 5 parent 851e2e6bfffffff.
+	setup := func(t *testing.T, tdb *testutil.TestServices) (alice, bob *ent.User, hexes []string) {
+		ctx := tdb.Ctx
+		var err error
+		alice, err = tdb.UserRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		bob, err = tdb.UserRepo.CreateUser(ctx, &model.User{Username: "bob", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		hexes = []string{"891e2e6b153ffff", "891e2e6b103ffff", "891e2e6b15bffff", "891e2e6b027ffff"}
+		require.NoError(t, tdb.HexService.CreateMissingHexes(ctx, hexes))
+		for i, hexID := range hexes {
+			leader := alice
+			if i == 2 {
+				leader = bob
+			}
+			_, err := tdb.HexInfluenceRepo.CreateHexInfluence(ctx, &model.HexInfluence{
+				UserID:      leader.ID,
+				H3Index:     hexID,
+				Score:       10,
+				LastUpdated: time.Now(),
+			})
+			require.NoError(t, err)
+			require.NoError(t, tdb.HexLeaderboardService.RebuildLeaderboard(ctx, hexID))
+		}
+		return alice, bob, hexes
+	}
+
+	// ------------------------
+	// Subtest: Rollups_CountLeadersPerArea
+	// ------------------------
+	t.Run("Rollups_CountLeadersPerArea", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		alice, _, _ := setup(t, tdb)
+
+		rollups := rollupsAt(t, tdb, "871e2e6b1ffffff", 7)
+		require.Len(t, rollups, 1)
+		require.Equal(t, "871e2e6b1ffffff", rollups[0].H3Index)
+		require.Equal(t, alice.ID, *rollups[0].DominantUserID)
+		require.Equal(t, "alice", rollups[0].DominantUserName)
+		require.Equal(t, 2, rollups[0].DominantHexes)
+		require.Equal(t, 3, rollups[0].OwnedHexes)
+		require.Equal(t, 49, rollups[0].TotalHexes)
+		require.InDelta(t, 2.0/49, rollups[0].Coverage, 1e-9)
+
+		rollups = rollupsAt(t, tdb, "851e2e6bfffffff", 5)
+		require.Len(t, rollups, 1)
+		require.Equal(t, alice.ID, *rollups[0].DominantUserID)
+		require.Equal(t, 3, rollups[0].DominantHexes)
+		require.Equal(t, 4, rollups[0].OwnedHexes)
+		require.Equal(t, 2401, rollups[0].TotalHexes)
+	})
+
+	// ------------------------
+	// Subtest: Rollups_FollowLeaderChanges
+	// ------------------------
+	t.Run("Rollups_FollowLeaderChanges", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+		_, bob, hexes := setup(t, tdb)
+
+		// Bob overtakes alice in the first hex and now leads two of the three.
+		_, err := tdb.HexInfluenceRepo.CreateHexInfluence(ctx, &model.HexInfluence{
+			UserID:      bob.ID,
+			H3Index:     hexes[0],
+			Score:       50,
+			LastUpdated: time.Now(),
+		})
+		require.NoError(t, err)
+		require.NoError(t, tdb.HexLeaderboardService.RebuildLeaderboard(ctx, hexes[0]))
+
+		rollups := rollupsAt(t, tdb, "871e2e6b1ffffff", 7)
+		require.Len(t, rollups, 1)
+		require.Equal(t, bob.ID, *rollups[0].DominantUserID)
+		require.Equal(t, 2, rollups[0].DominantHexes)
+		require.Equal(t, 3, rollups[0].OwnedHexes)
+
+		// The resolution 5 rollup is now tied; ties go to the lower user ID.
+		rollups = rollupsAt(t, tdb, "851e2e6bfffffff", 5)
+		require.Len(t, rollups, 1)
+		require.Equal(t, 2, rollups[0].DominantHexes)
+		require.Equal(t, 4, rollups[0].OwnedHexes)
+	})
+
+	// ------------------------
+	// Subtest: Rollups_FollowDecay
+	// ------------------------
+	t.Run("Rollups_FollowDecay", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+		alice, bob, hexes := setup(t, tdb)
+		_, err := tdb.HexInfluenceRepo.CreateHexInfluence(ctx, &model.HexInfluence{
+			UserID:      bob.ID,
+			H3Index:     hexes[0],
+			Score:       5,
+			LastUpdated: time.Now(),
+		})
+		require.NoError(t, err)
+		require.NoError(t, tdb.HexLeaderboardService.RebuildLeaderboard(ctx, hexes[0]))
+
+		// Alice's lead in the first hex decays away without any new visit, as if her last visit
+		// was long ago, so the stored leaderboard and the influences agree bob leads it now.
+		lastVisit := time.Now().Add(-60 * 24 * time.Hour)
+		influence, err := tdb.HexInfluenceRepo.FindByUserIDAndHexID(ctx, alice.ID, hexes[0])
+		require.NoError(t, err)
+		_, err = tdb.HexInfluenceRepo.UpdateHexInfluenceScore(ctx, influence.ID, influence.Score, lastVisit)
+		require.NoError(t, err)
+		leaderboard, err := tdb.HexLeaderboardRepo.FindByH3Index(ctx, hexes[0])
+		require.NoError(t, err)
+		for i := range leaderboard.TopUsers {
+			if leaderboard.TopUsers[i].UserID == alice.ID {
+				leaderboard.TopUsers[i].LastUpdated = lastVisit
+			}
+		}
+		_, err = tdb.HexLeaderboardRepo.UpdateHexLeaderboard(ctx, &model.HexLeaderboard{ID: leaderboard.ID, H3Index: hexes[0], TopUsers: leaderboard.TopUsers})
+		require.NoError(t, err)
+		require.Equal(t, alice.ID, *rollupsAt(t, tdb, "871e2e6b1ffffff", 7)[0].DominantUserID)
+
+		changed, err := tdb.HexLeaderboardService.RebuildLeaderboards(ctx, hexes[:1])
+		require.NoError(t, err)
+		require.Zero(t, changed)
+
+		rollups := rollupsAt(t, tdb, "871e2e6b1ffffff", 7)
+		require.Len(t, rollups, 1)
+		require.Equal(t, bob.ID, *rollups[0].DominantUserID)
+		require.Equal(t, 2, rollups[0].DominantHexes)
+	})
+
+	// ------------------------
+	// Subtest: Rollups_FollowPrivacyChanges
+	// ------------------------
+	t.Run("Rollups_FollowPrivacyChanges", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+		_, bob, hexes := setup(t, tdb)
+		privacy := tdb.ActivityService.PrivacyZoneService
+
+		// Bob hides the hex he leads.
+		_, err := privacy.UpdateSettings(ctx, dto.PrivacySettingsRequest{UserID: bob.ID, HideZoneLeaderboards: true})
+		require.NoError(t, err)
+		require.Equal(t, 3, rollupsAt(t, tdb, "871e2e6b1ffffff", 7)[0].OwnedHexes)
+		zone, err := privacy.CreateZone(ctx, dto.PrivacyZoneRequest{UserID: bob.ID, H3Indexes: hexes[2:3]})
+		require.NoError(t, err)
+		rollups := rollupsAt(t, tdb, "871e2e6b1ffffff", 7)
+		require.Equal(t, 2, rollups[0].OwnedHexes)
+		require.NotEqual(t, bob.ID, *rollups[0].DominantUserID)
+
+		// He shows up again when he stops hiding his zones.
+		_, err = privacy.UpdateSettings(ctx, dto.PrivacySettingsRequest{UserID: bob.ID, HideZoneLeaderboards: false})
+		require.NoError(t, err)
+		require.Equal(t, 3, rollupsAt(t, tdb, "871e2e6b1ffffff", 7)[0].OwnedHexes)
+
+		// And when he deletes the zone.
+		_, err = privacy.UpdateSettings(ctx, dto.PrivacySettingsRequest{UserID: bob.ID, HideZoneLeaderboards: true})
+		require.NoError(t, err)
+		require.Equal(t, 2, rollupsAt(t, tdb, "871e2e6b1ffffff", 7)[0].OwnedHexes)
+		require.NoError(t, privacy.DeleteZone(ctx, zone.ID, bob.ID))
+		require.Equal(t, 3, rollupsAt(t, tdb, "871e2e6b1ffffff", 7)[0].OwnedHexes)
+	})
+
+	// ------------------------
+	// Subtest: Backfill_RollsUpExistingLeaderboards
+	// ------------------------
+	t.Run("Backfill_RollsUpExistingLeaderboards", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+		alice, _, _ := setup(t, tdb)
+		rollupService := tdb.ActivityService.HexRollupService
+
+		// Nothing to do while rollups exist.
+		rolledUp, err := rollupService.BackfillRollups(ctx)
+		require.NoError(t, err)
+		require.Zero(t, rolledUp)
+
+		_, err = tdb.Repositories.HexRollupRepository.DeleteAll(ctx)
+		require.NoError(t, err)
+		require.Empty(t, rollupsAt(t, tdb, "871e2e6b1ffffff", 7))
+
+		rolledUp, err = rollupService.BackfillRollups(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 4, rolledUp)
+		rollups := rollupsAt(t, tdb, "871e2e6b1ffffff", 7)
+		require.Len(t, rollups, 1)
+		require.Equal(t, alice.ID, *rollups[0].DominantUserID)
+	})
+
+	// ------------------------
+	// Subtest: InvalidResolution
+	// ------------------------
+	t.Run("InvalidResolution", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+
+		_, err := tdb.HexLeaderboardService.GetAllLeaderboardsInsideBBBox(tdb.Ctx, service.BoundingBox{
+			MinLat: 50.0, MinLng: 19.9, MaxLat: 50.01, MaxLng: 19.91,
+		}, 8)
+		require.ErrorIs(t, err, service.ErrInvalidResolution)
+		require.Equal(t, hexconsts.DefaultHexResolution, service.ResolutionForZoom(15))
+		require.Equal(t, 7, service.ResolutionForZoom(11))
+		require.Equal(t, 5, service.ResolutionForZoom(4))
+	})
+}
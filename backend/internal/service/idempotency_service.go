@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// IdempotencyKeyTTL is how long a key is remembered after its request completed.
+const IdempotencyKeyTTL = 24 * time.Hour
+
+// IdempotencyKeyLease is how long a key stays reserved while its request is being processed. A
+// reservation left behind by a crashed request expires after it, so the client can retry.
+const IdempotencyKeyLease = 2 * time.Minute
+
+// MaxIdempotencyKeyLength is the longest key a client may send.
+const MaxIdempotencyKeyLength = 255
+
+var (
+	ErrIdempotencyKeyReused     = errors.New("idempotency key was already used with a different request")
+	ErrIdempotencyKeyInProgress = errors.New("a request with this idempotency key is still being processed")
+	ErrIdempotencyKeyTooLong    = errors.New("idempotency key is too long")
+)
+
+type IdempotencyService struct {
+	repository repository.IdempotencyKeyRepository
+	logger     *zap.Logger
+}
+
+func NewIdempotencyService(repository repository.IdempotencyKeyRepository, logger *zap.Logger) *IdempotencyService {
+	return &IdempotencyService{
+		repository: repository,
+		logger:     logger,
+	}
+}
+
+// Begin reserves key for the given request. If the key already completed a request with the same
+// payload, the stored response is returned and no reservation is made. A reservation must be
+// finished with either Complete or Abort, otherwise it expires after IdempotencyKeyLease.
+func (is *IdempotencyService) Begin(ctx context.Context, userID uuid.UUID, key string, request any) (reservationID uuid.UUID, storedResponse []byte, err error) {
+	if len(key) > MaxIdempotencyKeyLength {
+		return uuid.Nil, nil, ErrIdempotencyKeyTooLong
+	}
+
+	requestHash, err := hashRequest(request)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	now := time.Now()
+	existing, err := is.repository.FindByUserIDAndKey(ctx, userID, key)
+	if err != nil && !ent.IsNotFound(err) {
+		return uuid.Nil, nil, err
+	}
+	if existing != nil {
+		switch {
+		case !existing.ExpiresAt.After(now):
+			// The key outlived its TTL, or its reservation's lease ran out, so it is free to be used again.
+			// A racing request may have freed it first, in which case creating the reservation below
+			// decides which of the two gets it.
+			if err := is.repository.DeleteIfExpired(ctx, existing.ID, now); err != nil {
+				return uuid.Nil, nil, err
+			}
+		case existing.RequestHash != requestHash:
+			return uuid.Nil, nil, ErrIdempotencyKeyReused
+		case len(existing.Response) == 0:
+			return uuid.Nil, nil, ErrIdempotencyKeyInProgress
+		default:
+			return uuid.Nil, existing.Response, nil
+		}
+	}
+
+	reservation, err := is.repository.CreateIdempotencyKey(ctx, &model.IdempotencyKey{
+		Key:         key,
+		UserID:      userID,
+		RequestHash: requestHash,
+		ExpiresAt:   now.Add(IdempotencyKeyLease),
+	})
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			// Another request with the same key reserved it in the meantime.
+			return uuid.Nil, nil, ErrIdempotencyKeyInProgress
+		}
+		return uuid.Nil, nil, err
+	}
+	return reservation.ID, nil, nil
+}
+
+// Complete stores the response for a reservation so that replays can return it, and keeps the key
+// for IdempotencyKeyTTL. It should run in the transaction that performs the request, so the
+// response is stored exactly when the request's effects are. It fails with a not found error when
+// the reservation's lease ran out and another request took the key over.
+func (is *IdempotencyService) Complete(ctx context.Context, reservationID uuid.UUID, response any) error {
+	data, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	return is.repository.SetResponse(ctx, reservationID, data, time.Now().Add(IdempotencyKeyTTL))
+}
+
+// Abort releases a reservation after the request failed, so the client can retry with the same key.
+func (is *IdempotencyService) Abort(ctx context.Context, reservationID uuid.UUID) {
+	if err := is.repository.DeleteByID(ctx, reservationID); err != nil && !ent.IsNotFound(err) {
+		is.logger.Error("Failed to release idempotency key.", zap.Error(err), zap.Stringer("reservationID", reservationID))
+	}
+}
+
+// PurgeExpired deletes keys whose TTL has passed.
+func (is *IdempotencyService) PurgeExpired(ctx context.Context) (int, error) {
+	return is.repository.DeleteExpired(ctx, time.Now())
+}
+
+func hashRequest(request any) (string, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stride-wars-app/ent"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidHistoryInterval = errors.New("interval must be one of day, week, month or year")
+	ErrInvalidHex             = errors.New("invalid hex")
+)
+
+// InfluenceHistoryService turns the recorded score changes into time series of a user's influence.
+type InfluenceHistoryService struct {
+	repository             repository.InfluenceHistoryRepository
+	hexInfluenceRepository repository.HexInfluenceRepository
+	userService            *UserService
+	privacyZoneService     *PrivacyZoneService
+	logger                 *zap.Logger
+}
+
+func NewInfluenceHistoryService(repositories *repository.Repositories, userService *UserService, privacyZoneService *PrivacyZoneService, logger *zap.Logger) *InfluenceHistoryService {
+	return &InfluenceHistoryService{
+		repository:             repositories.InfluenceHistoryRepository,
+		hexInfluenceRepository: repositories.HexInfluenceRepository,
+		userService:            userService,
+		privacyZoneService:     privacyZoneService,
+		logger:                 logger,
+	}
+}
+
+// GetHexHistory returns the user's score in a hex at the end of each of the last periods
+// periods of the given interval, oldest first and ending with the current one. A hex the user
+// is hidden in by their privacy zones shows no influence, as if they had never been there.
+func (s *InfluenceHistoryService) GetHexHistory(ctx context.Context, userID uuid.UUID, h3Index string, interval string, periods int) (*dto.InfluenceHistoryResponse, error) {
+	if err := validateH3Index(h3Index); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidHex, err)
+	}
+	hidden, err := s.privacyZoneService.HiddenIn(ctx, []uuid.UUID{userID})
+	if err != nil {
+		return nil, err
+	}
+	if hidden(userID, h3Index) {
+		return s.history(ctx, userID, h3Index, interval, periods, nil)
+	}
+	current := 0.0
+	hexInfluence, err := s.hexInfluenceRepository.FindByUserIDAndHexID(ctx, userID, h3Index)
+	if err == nil {
+		current = hexInfluence.Score
+	} else if !ent.IsNotFound(err) {
+		return nil, err
+	}
+	return s.history(ctx, userID, h3Index, interval, periods, &current)
+}
+
+// GetUserHistory returns the user's score summed over all hexes at the end of each of the last
+// periods periods of the given interval, oldest first and ending with the current one.
+func (s *InfluenceHistoryService) GetUserHistory(ctx context.Context, userID uuid.UUID, interval string, periods int) (*dto.InfluenceHistoryResponse, error) {
+	current, err := s.hexInfluenceRepository.SumScoreByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.history(ctx, userID, "", interval, periods, &current)
+}
+
+// history buckets the changes into calendar periods of the user's time zone. The score at the
+// end of each period is worked out backwards from the current score, so influence gained before
+// the history was recorded is still counted. A nil current score leaves every period empty.
+func (s *InfluenceHistoryService) history(ctx context.Context, userID uuid.UUID, h3Index string, interval string, periods int, current *float64) (*dto.InfluenceHistoryResponse, error) {
+	if _, ok := defaultStatsPeriods[interval]; !ok {
+		return nil, ErrInvalidHistoryInterval
+	}
+	if periods == 0 {
+		periods = defaultStatsPeriods[interval]
+	}
+	if periods < 1 || periods > MaxStatsPeriods {
+		return nil, ErrInvalidStatsRange
+	}
+
+	user, err := s.userService.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	location := UserLocation(user)
+	buckets := periodBuckets(time.Now().In(location), interval, periods)
+
+	points := make([]dto.InfluenceHistoryPoint, len(buckets))
+	for i, bucket := range buckets {
+		points[i] = dto.InfluenceHistoryPoint{PeriodStart: bucket.Start, PeriodEnd: bucket.End}
+	}
+	resp := &dto.InfluenceHistoryResponse{
+		UserID:   userID,
+		H3Index:  h3Index,
+		Interval: interval,
+		TimeZone: location.String(),
+		Points:   points,
+	}
+	if current == nil {
+		return resp, nil
+	}
+
+	totals, err := s.repository.AggregateByPeriod(ctx, userID, h3Index, buckets)
+	if err != nil {
+		return nil, err
+	}
+	later, err := s.repository.SumDeltaSince(ctx, userID, h3Index, buckets[len(buckets)-1].End)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, total := range totals {
+		points[total.Bucket].Change = total.Delta
+		points[total.Bucket].Changes = total.Changes
+	}
+	score := *current - later
+	for i := len(points) - 1; i >= 0; i-- {
+		points[i].Score = score
+		score -= points[i].Change
+	}
+	return resp, nil
+}
@@ -0,0 +1,93 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/service"
+	"stride-wars-app/internal/testutil"
+)
+
+func TestInfluenceHistoryService(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: Series_FromRecordedChanges
+	// ------------------------
+	t.Run("Series_FromRecordedChanges", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+
+		owned, visited := validH3Indexes[0], validH3Indexes[1]
+		require.NoError(t, tdb.HexService.CreateMissingHexes(ctx, []string{owned, visited}))
+		user, err := tdb.UserRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		// Influence gained before history was recorded has no history rows of its own.
+		now := time.Now()
+		_, err = tdb.HexInfluenceRepo.CreateHexInfluence(ctx, &model.HexInfluence{
+			UserID:      user.ID,
+			H3Index:     owned,
+			Score:       5,
+			LastUpdated: now,
+		})
+		require.NoError(t, err)
+
+		activityID := uuid.New()
+		_, err = tdb.HexInfluenceService.RecordVisitsAt(ctx, user.ID, &activityID, []string{owned, visited}, now)
+		require.NoError(t, err)
+		_, err = tdb.HexInfluenceService.DeleteByUserIDAndHexID(ctx, user.ID, visited, &activityID)
+		require.NoError(t, err)
+
+		entries, err := tdb.Repositories.InfluenceHistoryRepository.FindByUserIDAndHexID(ctx, user.ID, visited)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		require.Equal(t, activityID, *entries[0].ActivityID)
+		require.Equal(t, 0.0, entries[0].ScoreBefore)
+		require.Equal(t, 1.0, entries[0].ScoreAfter)
+		require.Equal(t, 0.0, entries[1].ScoreAfter)
+
+		history, err := tdb.InfluenceHistoryService.GetHexHistory(ctx, user.ID, owned, service.GranularityDay, 3)
+		require.NoError(t, err)
+		require.Equal(t, owned, history.H3Index)
+		require.Len(t, history.Points, 3)
+		require.Equal(t, 5.0, history.Points[0].Score)
+		require.Equal(t, 5.0, history.Points[1].Score)
+		require.Equal(t, 6.0, history.Points[2].Score)
+		require.Equal(t, 1.0, history.Points[2].Change)
+		require.Equal(t, int64(1), history.Points[2].Changes)
+
+		history, err = tdb.InfluenceHistoryService.GetUserHistory(ctx, user.ID, service.GranularityWeek, 2)
+		require.NoError(t, err)
+		require.Len(t, history.Points, 2)
+		require.Equal(t, 5.0, history.Points[0].Score)
+		require.Equal(t, 6.0, history.Points[1].Score)
+		require.Equal(t, 1.0, history.Points[1].Change)
+		require.Equal(t, int64(3), history.Points[1].Changes)
+
+		// A hex the user hides in their privacy zones shows no influence.
+		privacy := tdb.ActivityService.PrivacyZoneService
+		_, err = privacy.CreateZone(ctx, dto.PrivacyZoneRequest{UserID: user.ID, H3Indexes: []string{owned}})
+		require.NoError(t, err)
+		_, err = privacy.UpdateSettings(ctx, dto.PrivacySettingsRequest{UserID: user.ID, HideZoneLeaderboards: true})
+		require.NoError(t, err)
+		history, err = tdb.InfluenceHistoryService.GetHexHistory(ctx, user.ID, owned, service.GranularityDay, 3)
+		require.NoError(t, err)
+		require.Len(t, history.Points, 3)
+		for _, point := range history.Points {
+			require.Zero(t, point.Score)
+			require.Zero(t, point.Changes)
+		}
+
+		_, err = tdb.InfluenceHistoryService.GetUserHistory(ctx, user.ID, "hour", 0)
+		require.ErrorIs(t, err, service.ErrInvalidHistoryInterval)
+		_, err = tdb.InfluenceHistoryService.GetHexHistory(ctx, user.ID, "not-a-hex", service.GranularityDay, 0)
+		require.ErrorIs(t, err, service.ErrInvalidHex)
+	})
+}
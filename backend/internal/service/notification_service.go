@@ -0,0 +1,338 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/repository"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultNotificationsLimit = 20
+	MaxNotificationsLimit     = 100
+)
+
+var (
+	ErrNotificationNotOwned     = errors.New("notification does not belong to this user")
+	ErrInvalidNotificationsPage = fmt.Errorf("limit must be between 1 and %d and offset must not be negative", MaxNotificationsLimit)
+	ErrInvalidNotificationType  = errors.New("notification type must be one of " + strings.Join(model.NotificationTypes, ", "))
+)
+
+// pushContent is the title and body of the push notification sent for a notification type.
+type pushContent struct {
+	title string
+	body  func(notification *model.Notification) string
+}
+
+// pushContents are the notification types that are also sent as push notifications. Pushes leave
+// through the provider and show on lock screens, so they are built from the notification without
+// the hex it is about; the app opens the notification from the inbox by its ID for the rest.
+var pushContents = map[string]pushContent{
+	model.NotificationHexCaptured: {
+		title: "Hex captured",
+		body: func(notification *model.Notification) string {
+			name := "Someone"
+			if username, ok := notification.Data["username"]; ok {
+				name = username
+			}
+			return name + " took the lead of one of your hexes"
+		},
+	},
+}
+
+// NotificationService keeps the users' in-app inboxes. Producers create notifications through
+// Notify, which drops the types a user turned off and queues push notifications for PushService.
+type NotificationService struct {
+	repository            repository.NotificationRepository
+	preferenceRepository  repository.NotificationPreferenceRepository
+	pushDeviceRepository  repository.PushDeviceRepository
+	pushMessageRepository repository.PushMessageRepository
+	userRepository        repository.UserRepository
+	logger                *zap.Logger
+}
+
+func NewNotificationService(repositories *repository.Repositories, logger *zap.Logger) *NotificationService {
+	return &NotificationService{
+		repository:            repositories.NotificationRepository,
+		preferenceRepository:  repositories.NotificationPreferenceRepository,
+		pushDeviceRepository:  repositories.PushDeviceRepository,
+		pushMessageRepository: repositories.PushMessageRepository,
+		userRepository:        repositories.UserRepository,
+		logger:                logger,
+	}
+}
+
+// Notify stores the notifications whose recipients have their type turned on and returns them.
+// Those of a type with push content are also queued as push notifications to users with devices.
+// It runs in the caller's transaction, so notifications are only kept if what they are about is.
+func (ns *NotificationService) Notify(ctx context.Context, notifications ...*model.Notification) ([]*model.Notification, error) {
+	if len(notifications) == 0 {
+		return nil, nil
+	}
+	userIDs := make([]uuid.UUID, 0, len(notifications))
+	for _, notification := range notifications {
+		userIDs = append(userIDs, notification.UserID)
+	}
+	preferences, err := ns.preferenceRepository.FindByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	disabled := make(map[uuid.UUID]map[string]bool)
+	for _, preference := range preferences {
+		if !preference.Enabled {
+			if disabled[preference.UserID] == nil {
+				disabled[preference.UserID] = make(map[string]bool)
+			}
+			disabled[preference.UserID][preference.Type.String()] = true
+		}
+	}
+
+	now := time.Now()
+	enabled := make([]*model.Notification, 0, len(notifications))
+	for _, notification := range notifications {
+		if disabled[notification.UserID][notification.NotificationType] {
+			continue
+		}
+		notification.ID = uuid.New()
+		if notification.CreatedAt.IsZero() {
+			notification.CreatedAt = now
+		}
+		enabled = append(enabled, notification)
+	}
+	if err := ns.repository.CreateNotifications(ctx, enabled); err != nil {
+		return nil, err
+	}
+	if err := ns.queuePushes(ctx, enabled); err != nil {
+		return nil, err
+	}
+	return enabled, nil
+}
+
+// queuePushes adds the push messages of the given notifications to the outbox PushService sends.
+func (ns *NotificationService) queuePushes(ctx context.Context, notifications []*model.Notification) error {
+	var userIDs []uuid.UUID
+	for _, notification := range notifications {
+		if _, ok := pushContents[notification.NotificationType]; ok {
+			userIDs = append(userIDs, notification.UserID)
+		}
+	}
+	if len(userIDs) == 0 {
+		return nil
+	}
+	withDevices, err := ns.pushDeviceRepository.FindUserIDsWithDevices(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	hasDevice := make(map[uuid.UUID]bool, len(withDevices))
+	for _, userID := range withDevices {
+		hasDevice[userID] = true
+	}
+
+	var messages []*model.PushMessage
+	for _, notification := range notifications {
+		content, ok := pushContents[notification.NotificationType]
+		if !ok || !hasDevice[notification.UserID] {
+			continue
+		}
+		messages = append(messages, &model.PushMessage{
+			UserID:         notification.UserID,
+			NotificationID: notification.ID,
+			Title:          content.title,
+			Body:           content.body(notification),
+			Data:           map[string]string{"type": notification.NotificationType},
+			CreatedAt:      notification.CreatedAt,
+		})
+	}
+	return ns.pushMessageRepository.CreatePushMessages(ctx, messages)
+}
+
+// GetNotifications returns a page of the user's inbox, newest first. A limit of 0 uses
+// DefaultNotificationsLimit.
+func (ns *NotificationService) GetNotifications(ctx context.Context, userID uuid.UUID, unreadOnly bool, limit, offset int) (*dto.GetNotificationsResponse, error) {
+	if limit == 0 {
+		limit = DefaultNotificationsLimit
+	}
+	if limit < 1 || limit > MaxNotificationsLimit || offset < 0 {
+		return nil, ErrInvalidNotificationsPage
+	}
+	if _, err := ns.userRepository.FindByID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	notifications, err := ns.repository.FindPageByUserID(ctx, userID, unreadOnly, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	total, err := ns.repository.CountByUserID(ctx, userID, unreadOnly)
+	if err != nil {
+		return nil, err
+	}
+	unread, err := ns.repository.CountByUserID(ctx, userID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.GetNotificationsResponse{
+		Notifications: make([]dto.NotificationResponse, len(notifications)),
+		Total:         total,
+		Unread:        unread,
+	}
+	for i, notification := range notifications {
+		resp.Notifications[i] = dto.NotificationResponse{
+			ID:        notification.ID,
+			Type:      notification.Type.String(),
+			Message:   notification.Message,
+			Data:      notification.Data,
+			Read:      notification.ReadAt != nil,
+			ReadAt:    notification.ReadAt,
+			CreatedAt: notification.CreatedAt,
+		}
+	}
+	if next := offset + len(notifications); next < total {
+		resp.NextOffset = &next
+	}
+	return resp, nil
+}
+
+// MarkRead marks one of the user's notifications as read. Marking it again has no effect.
+func (ns *NotificationService) MarkRead(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+	if _, err := ns.ownNotification(ctx, userID, id); err != nil {
+		return err
+	}
+	_, err := ns.repository.MarkRead(ctx, userID, id, time.Now())
+	return err
+}
+
+// MarkAllRead marks all the user's notifications as read and returns how many were unread.
+func (ns *NotificationService) MarkAllRead(ctx context.Context, userID uuid.UUID) (int, error) {
+	if _, err := ns.userRepository.FindByID(ctx, userID); err != nil {
+		return 0, err
+	}
+	return ns.repository.MarkAllRead(ctx, userID, time.Now())
+}
+
+func (ns *NotificationService) DeleteNotification(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+	if _, err := ns.ownNotification(ctx, userID, id); err != nil {
+		return err
+	}
+	return ns.repository.DeleteByID(ctx, id)
+}
+
+func (ns *NotificationService) ownNotification(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*ent.Notification, error) {
+	notification, err := ns.repository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if notification.UserID != userID {
+		return nil, ErrNotificationNotOwned
+	}
+	return notification, nil
+}
+
+// GetPreferences returns whether each notification type is on for the user.
+func (ns *NotificationService) GetPreferences(ctx context.Context, userID uuid.UUID) (*dto.NotificationPreferencesResponse, error) {
+	if _, err := ns.userRepository.FindByID(ctx, userID); err != nil {
+		return nil, err
+	}
+	preferences, err := ns.preferenceRepository.FindByUserIDs(ctx, []uuid.UUID{userID})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.NotificationPreferencesResponse{UserID: userID, Preferences: make(map[string]bool, len(model.NotificationTypes))}
+	for _, notificationType := range model.NotificationTypes {
+		resp.Preferences[notificationType] = true
+	}
+	for _, preference := range preferences {
+		resp.Preferences[preference.Type.String()] = preference.Enabled
+	}
+	return resp, nil
+}
+
+// UpdatePreferences turns the given notification types on or off and returns all the user's
+// preferences.
+func (ns *NotificationService) UpdatePreferences(ctx context.Context, req dto.NotificationPreferencesRequest) (*dto.NotificationPreferencesResponse, error) {
+	preferences := make([]*model.NotificationPreference, 0, len(req.Preferences))
+	for notificationType, enabled := range req.Preferences {
+		if !isNotificationType(notificationType) {
+			return nil, ErrInvalidNotificationType
+		}
+		preferences = append(preferences, &model.NotificationPreference{
+			UserID:           req.UserID,
+			NotificationType: notificationType,
+			Enabled:          enabled,
+		})
+	}
+	if _, err := ns.userRepository.FindByID(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := ns.preferenceRepository.UpsertPreferences(ctx, preferences); err != nil {
+		return nil, err
+	}
+	return ns.GetPreferences(ctx, req.UserID)
+}
+
+func isNotificationType(notificationType string) bool {
+	for _, t := range model.NotificationTypes {
+		if t == notificationType {
+			return true
+		}
+	}
+	return false
+}
+
+// hexCapturedNotification tells userID that by took the lead of a hex they led. A nil by, for a
+// user hidden in the hex by their privacy zones, leaves them unnamed.
+func hexCapturedNotification(userID uuid.UUID, h3Index string, by *model.TopUser) *model.Notification {
+	return &model.Notification{
+		UserID:           userID,
+		NotificationType: model.NotificationHexCaptured,
+		Message:          fmt.Sprintf("%s took the lead of your hex %s", notifiedUserName(by), h3Index),
+		Data:             notifiedHexData(h3Index, by),
+	}
+}
+
+// leaderboardLostNotification tells userID that by pushed them out of a hex's leaderboard of the
+// given size. A nil by leaves them unnamed, as in hexCapturedNotification.
+func leaderboardLostNotification(userID uuid.UUID, h3Index string, by *model.TopUser, size int) *model.Notification {
+	return &model.Notification{
+		UserID:           userID,
+		NotificationType: model.NotificationLeaderboardLost,
+		Message:          fmt.Sprintf("%s pushed you out of the top %d of hex %s", notifiedUserName(by), size, h3Index),
+		Data:             notifiedHexData(h3Index, by),
+	}
+}
+
+func notifiedUserName(by *model.TopUser) string {
+	if by == nil {
+		return "Someone"
+	}
+	return by.UserName
+}
+
+func notifiedHexData(h3Index string, by *model.TopUser) map[string]string {
+	data := map[string]string{"h3_index": h3Index}
+	if by != nil {
+		data["user_id"] = by.UserID.String()
+		data["username"] = by.UserName
+	}
+	return data
+}
+
+// personalRecordNotification tells the owner of an activity that it beat one of their records.
+func personalRecordNotification(activity *ent.Activity, record dto.NewPersonalRecord) *model.Notification {
+	return &model.Notification{
+		UserID:           activity.UserID,
+		NotificationType: model.NotificationPersonalRecord,
+		Message:          fmt.Sprintf("New %s record for a %s", strings.ReplaceAll(record.Record, "_", " "), activity.ActivityType),
+		Data:             map[string]string{"record": record.Record, "activity_type": activity.ActivityType.String(), "activity_id": activity.ID.String()},
+	}
+}
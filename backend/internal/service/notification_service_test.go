@@ -0,0 +1,199 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/service"
+	"stride-wars-app/internal/testutil"
+)
+
+func TestNotificationService(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: LeaderboardNotifications
+	// ------------------------
+	t.Run("LeaderboardNotifications", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+
+		hexID := validH3Indexes[0]
+		require.NoError(t, tdb.HexService.CreateMissingHexes(ctx, []string{hexID}))
+		users := make([]*ent.User, 7)
+		for i := range users {
+			var err error
+			users[i], err = tdb.UserRepo.CreateUser(ctx, &model.User{Username: "user" + string(rune('a'+i)), ExternalUser: uuid.New()})
+			require.NoError(t, err)
+		}
+		visit := func(user *ent.User, visits int) {
+			hexIDs := make([]string, visits)
+			for i := range hexIDs {
+				hexIDs[i] = hexID
+			}
+			influences, err := tdb.HexInfluenceService.RecordVisitsAt(ctx, user.ID, nil, hexIDs, time.Now())
+			require.NoError(t, err)
+			_, err = tdb.HexLeaderboardService.AddUserToLeaderboards(ctx, user.Username, nil, influences)
+			require.NoError(t, err)
+		}
+		inbox := func(user *ent.User) []dto.NotificationResponse {
+			resp, err := tdb.NotificationService.GetNotifications(ctx, user.ID, false, 0, 0)
+			require.NoError(t, err)
+			return resp.Notifications
+		}
+
+		// users[1] turned off leaderboard notifications.
+		_, err := tdb.NotificationService.UpdatePreferences(ctx, dto.NotificationPreferencesRequest{
+			UserID:      users[1].ID,
+			Preferences: map[string]bool{model.NotificationLeaderboardLost: false},
+		})
+		require.NoError(t, err)
+
+		// users[0] leads with 10 and users[1] to users[5] join with 2 to 6, the last one pushing
+		// users[1] out of the top 5.
+		visit(users[0], 10)
+		for i := 1; i <= 5; i++ {
+			visit(users[i], i+1)
+		}
+		require.Empty(t, inbox(users[0]))
+		require.Empty(t, inbox(users[1]))
+		preferences, err := tdb.NotificationService.GetPreferences(ctx, users[1].ID)
+		require.NoError(t, err)
+		require.False(t, preferences.Preferences[model.NotificationLeaderboardLost])
+		require.True(t, preferences.Preferences[model.NotificationHexCaptured])
+
+		// users[6] takes the lead, pushing users[2] out of the top 5.
+		visit(users[6], 11)
+		notifications := inbox(users[0])
+		require.Len(t, notifications, 1)
+		require.Equal(t, model.NotificationHexCaptured, notifications[0].Type)
+		require.Equal(t, hexID, notifications[0].Data["h3_index"])
+		require.Equal(t, users[6].ID.String(), notifications[0].Data["user_id"])
+		notifications = inbox(users[2])
+		require.Len(t, notifications, 1)
+		require.Equal(t, model.NotificationLeaderboardLost, notifications[0].Type)
+		require.Empty(t, inbox(users[3]))
+
+		_, err = tdb.NotificationService.UpdatePreferences(ctx, dto.NotificationPreferencesRequest{
+			UserID:      users[5].ID,
+			Preferences: map[string]bool{"friend_request": true},
+		})
+		require.ErrorIs(t, err, service.ErrInvalidNotificationType)
+	})
+
+	// ------------------------
+	// Subtest: LeaderboardNotifications_HiddenCapturer
+	// ------------------------
+	t.Run("LeaderboardNotifications_HiddenCapturer", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+
+		hexID := validH3Indexes[0]
+		require.NoError(t, tdb.HexService.CreateMissingHexes(ctx, []string{hexID}))
+		alice, err := tdb.UserRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		bob, err := tdb.UserRepo.CreateUser(ctx, &model.User{Username: "bob", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		// Bob hides the hex, which lies in his privacy zone, from leaderboards.
+		privacy := tdb.ActivityService.PrivacyZoneService
+		_, err = privacy.CreateZone(ctx, dto.PrivacyZoneRequest{UserID: bob.ID, H3Indexes: []string{hexID}})
+		require.NoError(t, err)
+		_, err = privacy.UpdateSettings(ctx, dto.PrivacySettingsRequest{UserID: bob.ID, HideZoneLeaderboards: true})
+		require.NoError(t, err)
+
+		for _, visit := range []struct {
+			user   *ent.User
+			visits int
+		}{{alice, 1}, {bob, 2}} {
+			hexIDs := make([]string, visit.visits)
+			for i := range hexIDs {
+				hexIDs[i] = hexID
+			}
+			influences, err := tdb.HexInfluenceService.RecordVisitsAt(ctx, visit.user.ID, nil, hexIDs, time.Now())
+			require.NoError(t, err)
+			_, err = tdb.HexLeaderboardService.AddUserToLeaderboards(ctx, visit.user.Username, nil, influences)
+			require.NoError(t, err)
+		}
+
+		// Alice learns she lost her hex, but not to whom.
+		resp, err := tdb.NotificationService.GetNotifications(ctx, alice.ID, false, 0, 0)
+		require.NoError(t, err)
+		require.Len(t, resp.Notifications, 1)
+		notification := resp.Notifications[0]
+		require.Equal(t, model.NotificationHexCaptured, notification.Type)
+		require.Equal(t, hexID, notification.Data["h3_index"])
+		require.NotContains(t, notification.Data, "user_id")
+		require.NotContains(t, notification.Message, bob.Username)
+	})
+
+	// ------------------------
+	// Subtest: Inbox
+	// ------------------------
+	t.Run("Inbox", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+
+		alice, err := tdb.UserRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		bob, err := tdb.UserRepo.CreateUser(ctx, &model.User{Username: "bob", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		now := time.Now()
+		notifications := make([]*model.Notification, 5)
+		for i := range notifications {
+			notifications[i] = &model.Notification{
+				UserID:           alice.ID,
+				NotificationType: model.NotificationHexCaptured,
+				Message:          "hex taken",
+				CreatedAt:        now.Add(time.Duration(i) * time.Minute),
+			}
+		}
+		created, err := tdb.NotificationService.Notify(ctx, notifications...)
+		require.NoError(t, err)
+		require.Len(t, created, 5)
+
+		page, err := tdb.NotificationService.GetNotifications(ctx, alice.ID, false, 2, 0)
+		require.NoError(t, err)
+		require.Len(t, page.Notifications, 2)
+		require.Equal(t, created[4].ID, page.Notifications[0].ID, "newest first")
+		require.Equal(t, 5, page.Total)
+		require.Equal(t, 5, page.Unread)
+		require.Equal(t, 2, *page.NextOffset)
+		page, err = tdb.NotificationService.GetNotifications(ctx, alice.ID, false, 2, 4)
+		require.NoError(t, err)
+		require.Len(t, page.Notifications, 1)
+		require.Nil(t, page.NextOffset)
+
+		require.NoError(t, tdb.NotificationService.MarkRead(ctx, alice.ID, created[0].ID))
+		require.ErrorIs(t, tdb.NotificationService.MarkRead(ctx, bob.ID, created[1].ID), service.ErrNotificationNotOwned)
+		page, err = tdb.NotificationService.GetNotifications(ctx, alice.ID, true, 0, 0)
+		require.NoError(t, err)
+		require.Len(t, page.Notifications, 4)
+		require.Equal(t, 4, page.Unread)
+
+		marked, err := tdb.NotificationService.MarkAllRead(ctx, alice.ID)
+		require.NoError(t, err)
+		require.Equal(t, 4, marked)
+
+		require.ErrorIs(t, tdb.NotificationService.DeleteNotification(ctx, bob.ID, created[0].ID), service.ErrNotificationNotOwned)
+		require.NoError(t, tdb.NotificationService.DeleteNotification(ctx, alice.ID, created[0].ID))
+		page, err = tdb.NotificationService.GetNotifications(ctx, alice.ID, false, 0, 0)
+		require.NoError(t, err)
+		require.Equal(t, 4, page.Total)
+		require.Zero(t, page.Unread)
+		require.True(t, page.Notifications[0].Read)
+
+		_, err = tdb.NotificationService.GetNotifications(ctx, alice.ID, false, service.MaxNotificationsLimit+1, 0)
+		require.ErrorIs(t, err, service.ErrInvalidNotificationsPage)
+	})
+}
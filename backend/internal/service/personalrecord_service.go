@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/uber/h3-go/v4"
+	"go.uber.org/zap"
+)
+
+var ErrInvalidActivityType = errors.New("activity_type must be one of run, walk or ride")
+
+// bestEfforts are the distances in meters whose fastest time is tracked from GPS tracks.
+var bestEfforts = []struct {
+	record string
+	meters float64
+}{
+	{model.RecordFastest1K, 1000},
+	{model.RecordFastest5K, 5000},
+	{model.RecordFastest10K, 10000},
+	{model.RecordFastestHalfMarathon, 21097.5},
+}
+
+// PersonalRecordService keeps the user's best results per activity type.
+type PersonalRecordService struct {
+	repository repository.PersonalRecordRepository
+	logger     *zap.Logger
+}
+
+func NewPersonalRecordService(repository repository.PersonalRecordRepository, logger *zap.Logger) *PersonalRecordService {
+	return &PersonalRecordService{repository: repository, logger: logger}
+}
+
+// RecordActivity stores every result of the activity that beats the user's record for its
+// activity type, and returns those new records.
+func (ps *PersonalRecordService) RecordActivity(ctx context.Context, activity *ent.Activity) ([]dto.NewPersonalRecord, error) {
+	existing, err := ps.repository.FindByUserIDAndActivityType(ctx, activity.UserID, activity.ActivityType.String())
+	if err != nil {
+		return nil, err
+	}
+	current := make(map[string]*ent.PersonalRecord, len(existing))
+	for _, record := range existing {
+		current[record.Record.String()] = record
+	}
+
+	newRecords := []dto.NewPersonalRecord{}
+	for _, result := range activityResults(activity) {
+		previous, ok := current[result.record]
+		if ok && !isImprovement(result.record, result.value, previous.Value) {
+			continue
+		}
+
+		state := &model.PersonalRecord{
+			UserID:       activity.UserID,
+			ActivityType: activity.ActivityType.String(),
+			Record:       result.record,
+			Value:        result.value,
+			ActivityID:   activity.ID,
+			AchievedAt:   activityEndedAt(activity),
+		}
+		newRecord := dto.NewPersonalRecord{Record: result.record, Value: result.value}
+		if ok {
+			previousValue := previous.Value
+			newRecord.PreviousValue = &previousValue
+			state.ID = previous.ID
+			_, err = ps.repository.UpdatePersonalRecord(ctx, state)
+		} else {
+			_, err = ps.repository.CreatePersonalRecord(ctx, state)
+			err = repository.InsertRace(err)
+		}
+		if err != nil {
+			return nil, err
+		}
+		newRecords = append(newRecords, newRecord)
+	}
+	return newRecords, nil
+}
+
+// RebuildForDeletedActivity hands the records held by a deleted activity to the best of the
+// user's remaining activities, or removes them when no other activity qualifies.
+func (ps *PersonalRecordService) RebuildForDeletedActivity(ctx context.Context, activityID uuid.UUID, remaining []*ent.Activity) error {
+	held, err := ps.repository.FindByActivityID(ctx, activityID)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range held {
+		var best *ent.Activity
+		bestValue := 0.0
+		for _, activity := range remaining {
+			if activity.ActivityType.String() != record.ActivityType.String() {
+				continue
+			}
+			for _, result := range activityResults(activity) {
+				if result.record == record.Record.String() && (best == nil || isImprovement(result.record, result.value, bestValue)) {
+					best = activity
+					bestValue = result.value
+				}
+			}
+		}
+
+		if best == nil {
+			if err := ps.repository.DeleteByID(ctx, record.ID); err != nil {
+				return err
+			}
+			continue
+		}
+		_, err := ps.repository.UpdatePersonalRecord(ctx, &model.PersonalRecord{
+			ID:         record.ID,
+			Value:      bestValue,
+			ActivityID: best.ID,
+			AchievedAt: activityEndedAt(best),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetRecords returns the user's records, limited to one activity type when it is not empty.
+func (ps *PersonalRecordService) GetRecords(ctx context.Context, userID uuid.UUID, activityType string) (*dto.GetPersonalRecordsResponse, error) {
+	var records []*ent.PersonalRecord
+	var err error
+	if activityType == "" {
+		records, err = ps.repository.FindByUserID(ctx, userID)
+	} else {
+		if !isValidActivityType(activityType) {
+			return nil, ErrInvalidActivityType
+		}
+		records, err = ps.repository.FindByUserIDAndActivityType(ctx, userID, activityType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.GetPersonalRecordsResponse{Records: make([]dto.PersonalRecordResponse, len(records))}
+	for i, record := range records {
+		resp.Records[i] = dto.PersonalRecordResponse{
+			ActivityType: record.ActivityType.String(),
+			Record:       record.Record.String(),
+			Value:        record.Value,
+			ActivityID:   record.ActivityID,
+			AchievedAt:   record.AchievedAt,
+		}
+	}
+	return resp, nil
+}
+
+type activityResult struct {
+	record string
+	value  float64
+}
+
+// activityResults lists the record candidates of an activity. Best efforts are only
+// available for activities with a timed GPS track long enough to cover them.
+func activityResults(activity *ent.Activity) []activityResult {
+	results := []activityResult{
+		{model.RecordLongestDistance, activity.DistanceMeters},
+		{model.RecordLongestDuration, activity.DurationSeconds},
+	}
+	if activity.NewHexes > 0 {
+		results = append(results, activityResult{model.RecordMostNewHexes, float64(activity.NewHexes)})
+	}
+	for _, effort := range bestEfforts {
+		if seconds, ok := fastestEffort(activity.Track, effort.meters); ok {
+			results = append(results, activityResult{effort.record, seconds})
+		}
+	}
+	return results
+}
+
+// isImprovement reports whether value beats previous. Fastest efforts improve by going down.
+func isImprovement(record string, value float64, previous float64) bool {
+	for _, effort := range bestEfforts {
+		if effort.record == record {
+			return value < previous
+		}
+	}
+	return value > previous
+}
+
+// fastestEffort returns the shortest time in seconds in which the track covered the given
+// distance. The start of the effort is interpolated between the two points around it.
+func fastestEffort(track []model.TrackPoint, meters float64) (float64, bool) {
+	if len(track) < 2 {
+		return 0, false
+	}
+	points := make([]model.TrackPoint, len(track))
+	copy(points, track)
+	for _, point := range points {
+		if point.Timestamp.IsZero() {
+			return 0, false
+		}
+	}
+	sort.SliceStable(points, func(i, j int) bool {
+		return points[i].Timestamp.Before(points[j].Timestamp)
+	})
+
+	cumulative := make([]float64, len(points))
+	for i := 1; i < len(points); i++ {
+		cumulative[i] = cumulative[i-1] + h3.GreatCircleDistanceM(
+			h3.NewLatLng(points[i-1].Lat, points[i-1].Lng),
+			h3.NewLatLng(points[i].Lat, points[i].Lng),
+		)
+	}
+
+	best := math.Inf(1)
+	start := 0
+	for end := 1; end < len(points); end++ {
+		target := cumulative[end] - meters
+		if target < 0 {
+			continue
+		}
+		for cumulative[start+1] <= target {
+			start++
+		}
+
+		elapsed := points[end].Timestamp.Sub(points[start].Timestamp).Seconds()
+		if segment := cumulative[start+1] - cumulative[start]; segment > 0 {
+			fraction := (target - cumulative[start]) / segment
+			elapsed -= fraction * points[start+1].Timestamp.Sub(points[start].Timestamp).Seconds()
+		}
+		best = math.Min(best, elapsed)
+	}
+	if math.IsInf(best, 1) {
+		return 0, false
+	}
+	return best, true
+}
+
+func isValidActivityType(activityType string) bool {
+	switch activityType {
+	case model.ActivityTypeRun, model.ActivityTypeWalk, model.ActivityTypeRide:
+		return true
+	}
+	return false
+}
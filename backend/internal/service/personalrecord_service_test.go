@@ -0,0 +1,143 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/repository"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// straightTrack returns points about 100 m apart heading north, spaced by the given interval.
+func straightTrack(startedAt time.Time, points int, interval time.Duration) []dto.TrackPoint {
+	const metersPerDegree = 111195.0
+	track := make([]dto.TrackPoint, points)
+	for i := range track {
+		track[i] = dto.TrackPoint{
+			Lat:       52.0 + float64(i)*100/metersPerDegree,
+			Lng:       4.0,
+			Timestamp: startedAt.Add(time.Duration(i) * interval),
+		}
+	}
+	return track
+}
+
+func recordsByName(records []dto.NewPersonalRecord) map[string]dto.NewPersonalRecord {
+	byName := make(map[string]dto.NewPersonalRecord, len(records))
+	for _, record := range records {
+		byName[record.Record] = record
+	}
+	return byName
+}
+
+func TestPersonalRecordService(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: DetectsAndRestoresRecords
+	// ------------------------
+	t.Run("DetectsAndRestoresRecords", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		create := func(startedAt time.Time, points int, interval time.Duration, activityType string, h3Indexes []string) *dto.CreateActivityResponse {
+			duration := time.Duration(points-1) * interval
+			endedAt := startedAt.Add(duration)
+			resp, err := svc.CreateActivity(ctx, dto.CreateActivityRequest{
+				UserID:       createdUser.ID,
+				Duration:     duration.Seconds(),
+				Distance:     float64(points-1) * 100,
+				H3Indexes:    h3Indexes,
+				Track:        straightTrack(startedAt, points, interval),
+				ActivityType: activityType,
+				StartedAt:    &startedAt,
+				EndedAt:      &endedAt,
+			})
+			require.NoError(t, err)
+			return resp
+		}
+
+		now := time.Now()
+		// 1.5 km at 30 s per 100 m
+		first := create(now.Add(-3*time.Hour), 16, 30*time.Second, "", validH3Indexes)
+		records := recordsByName(first.NewRecords)
+		require.Len(t, records, 4)
+		require.InDelta(t, 300, records[model.RecordFastest1K].Value, 1)
+		require.Nil(t, records[model.RecordFastest1K].PreviousValue)
+		require.InDelta(t, 1500, records[model.RecordLongestDistance].Value, 0.001)
+		require.InDelta(t, 450, records[model.RecordLongestDuration].Value, 0.001)
+		require.Equal(t, float64(len(validH3Indexes)), records[model.RecordMostNewHexes].Value)
+
+		// 1.1 km at 20 s per 100 m beats only the fastest kilometer
+		second := create(now.Add(-2*time.Hour), 12, 20*time.Second, model.ActivityTypeRun, validH3Indexes)
+		records = recordsByName(second.NewRecords)
+		require.Len(t, records, 1)
+		require.InDelta(t, 200, records[model.RecordFastest1K].Value, 1)
+		require.NotNil(t, records[model.RecordFastest1K].PreviousValue)
+		require.InDelta(t, 300, *records[model.RecordFastest1K].PreviousValue, 1)
+
+		// Only the beaten record is worth a notification, not the first records
+		inbox, err := svc.NotificationService.GetNotifications(ctx, createdUser.ID, false, 0, 0)
+		require.NoError(t, err)
+		require.Len(t, inbox.Notifications, 1)
+		require.Equal(t, model.NotificationPersonalRecord, inbox.Notifications[0].Type)
+		require.Equal(t, second.ID.String(), inbox.Notifications[0].Data["activity_id"])
+
+		// Records are kept per activity type
+		walk := create(now.Add(-time.Hour), 3, time.Minute, model.ActivityTypeWalk, validH3Indexes)
+		records = recordsByName(walk.NewRecords)
+		require.Contains(t, records, model.RecordLongestDistance)
+		require.NotContains(t, records, model.RecordFastest1K)
+
+		runs, err := svc.PersonalRecordService.GetRecords(ctx, createdUser.ID, model.ActivityTypeRun)
+		require.NoError(t, err)
+		require.Len(t, runs.Records, 4)
+
+		// Deleting the faster run hands the record back to the first one
+		_, err = svc.DeleteActivity(ctx, second.ID, createdUser.ID)
+		require.NoError(t, err)
+		runs, err = svc.PersonalRecordService.GetRecords(ctx, createdUser.ID, model.ActivityTypeRun)
+		require.NoError(t, err)
+		for _, record := range runs.Records {
+			require.Equal(t, first.ID, record.ActivityID)
+			if record.Record == model.RecordFastest1K {
+				require.InDelta(t, 300, record.Value, 1)
+			}
+		}
+
+		// Deleting the last walk removes its records
+		_, err = svc.DeleteActivity(ctx, walk.ID, createdUser.ID)
+		require.NoError(t, err)
+		walks, err := svc.PersonalRecordService.GetRecords(ctx, createdUser.ID, model.ActivityTypeWalk)
+		require.NoError(t, err)
+		require.Empty(t, walks.Records)
+	})
+
+	// ------------------------
+	// Subtest: InvalidActivityType
+	// ------------------------
+	t.Run("InvalidActivityType", func(t *testing.T) {
+		t.Parallel()
+		ctx, _, svc := setupTest(t)
+
+		_, err := svc.CreateActivity(ctx, dto.CreateActivityRequest{
+			UserID:       uuid.New(),
+			Duration:     600,
+			Distance:     2000,
+			H3Indexes:    validH3Indexes,
+			ActivityType: "swim",
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "activity_type")
+	})
+}
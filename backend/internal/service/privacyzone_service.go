@@ -0,0 +1,458 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/uber/h3-go/v4"
+	"go.uber.org/zap"
+)
+
+// MinPrivacyZoneRadius and MaxPrivacyZoneRadius bound the radius of circular zones in meters.
+const (
+	MinPrivacyZoneRadius = 100
+	MaxPrivacyZoneRadius = 2000
+)
+
+// MaxPrivacyZones is the largest number of zones a user can have.
+const MaxPrivacyZones = 10
+
+// MaxPrivacyZoneCells is the largest number of cells in a single zone.
+const MaxPrivacyZoneCells = 100
+
+var (
+	ErrInvalidPrivacyZone  = errors.New("a privacy zone needs either a point with radius_meters or h3_indexes")
+	ErrPrivacyZoneRadius   = errors.New("radius_meters must be between 100 and 2000")
+	ErrPrivacyZoneCells    = errors.New("a privacy zone can have at most 100 h3_indexes")
+	ErrTooManyPrivacyZones = errors.New("a user can have at most 10 privacy zones")
+	ErrPrivacyZoneNotOwned = errors.New("privacy zone does not belong to this user")
+	ErrInvalidZoneLocation = errors.New("privacy zone coordinates are out of range")
+)
+
+// PrivacyZoneService manages privacy zones and is the single place that hides what lies
+// inside them. Every reader that shows a user's activities or positions to others goes
+// through it.
+type PrivacyZoneService struct {
+	repository             repository.PrivacyZoneRepository
+	userRepository         repository.UserRepository
+	hexInfluenceRepository repository.HexInfluenceRepository
+	transactor             repository.Transactor
+	// refreshRollups refreshes the rollups of the areas containing the given cells. The rollup
+	// service hides users through this service, so NewActivityService sets it once both exist.
+	refreshRollups func(ctx context.Context, h3Indexes []string) error
+	logger         *zap.Logger
+}
+
+func NewPrivacyZoneService(repositories *repository.Repositories, logger *zap.Logger) *PrivacyZoneService {
+	return &PrivacyZoneService{
+		repository:             repositories.PrivacyZoneRepository,
+		userRepository:         repositories.UserRepository,
+		hexInfluenceRepository: repositories.HexInfluenceRepository,
+		transactor:             repositories.Transactor,
+		logger:                 logger,
+	}
+}
+
+// CreateZone adds a zone for the user. If they hide their zones from leaderboards, the rollups
+// of the areas where the zone hides them are refreshed along with it.
+func (ps *PrivacyZoneService) CreateZone(ctx context.Context, req dto.PrivacyZoneRequest) (*dto.PrivacyZoneResponse, error) {
+	if err := validatePrivacyZone(req); err != nil {
+		return nil, err
+	}
+	user, err := ps.userRepository.FindByID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	count, err := ps.repository.CountByUserID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= MaxPrivacyZones {
+		return nil, ErrTooManyPrivacyZones
+	}
+
+	var zone *ent.PrivacyZone
+	err = ps.transactor.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		zone, err = ps.repository.CreatePrivacyZone(ctx, toModelPrivacyZone(uuid.Nil, req))
+		if err != nil || !user.HideZoneLeaderboards {
+			return err
+		}
+		return ps.refreshZoneRollups(ctx, user.ID, privacyZones{zone})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toPrivacyZoneResponse(zone), nil
+}
+
+// UpdateZone replaces a zone of the user. If they hide their zones from leaderboards, the rollups
+// of the areas where the zone hid or now hides them are refreshed along with it.
+func (ps *PrivacyZoneService) UpdateZone(ctx context.Context, zoneID uuid.UUID, req dto.PrivacyZoneRequest) (*dto.PrivacyZoneResponse, error) {
+	if err := validatePrivacyZone(req); err != nil {
+		return nil, err
+	}
+	existing, err := ps.repository.FindByID(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.UserID != req.UserID {
+		return nil, ErrPrivacyZoneNotOwned
+	}
+	user, err := ps.userRepository.FindByID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var zone *ent.PrivacyZone
+	err = ps.transactor.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		zone, err = ps.repository.UpdatePrivacyZone(ctx, toModelPrivacyZone(zoneID, req))
+		if err != nil || !user.HideZoneLeaderboards {
+			return err
+		}
+		return ps.refreshZoneRollups(ctx, user.ID, privacyZones{existing, zone})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toPrivacyZoneResponse(zone), nil
+}
+
+func (ps *PrivacyZoneService) GetZones(ctx context.Context, userID uuid.UUID) (*dto.GetPrivacyZonesResponse, error) {
+	if _, err := ps.userRepository.FindByID(ctx, userID); err != nil {
+		return nil, err
+	}
+	zones, err := ps.repository.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.GetPrivacyZonesResponse{Zones: make([]dto.PrivacyZoneResponse, len(zones))}
+	for i, zone := range zones {
+		resp.Zones[i] = *toPrivacyZoneResponse(zone)
+	}
+	return resp, nil
+}
+
+// DeleteZone removes a zone of the user. If they hide their zones from leaderboards, the rollups
+// of the areas where the zone hid them are refreshed along with it.
+func (ps *PrivacyZoneService) DeleteZone(ctx context.Context, zoneID uuid.UUID, userID uuid.UUID) error {
+	zone, err := ps.repository.FindByID(ctx, zoneID)
+	if err != nil {
+		return err
+	}
+	if zone.UserID != userID {
+		return ErrPrivacyZoneNotOwned
+	}
+	user, err := ps.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return ps.transactor.WithTx(ctx, func(ctx context.Context) error {
+		if err := ps.repository.DeleteByID(ctx, zoneID); err != nil || !user.HideZoneLeaderboards {
+			return err
+		}
+		return ps.refreshZoneRollups(ctx, user.ID, privacyZones{zone})
+	})
+}
+
+// UpdateSettings sets whether the user is hidden from the leaderboards of hexes inside their zones,
+// and refreshes the rollups of the areas where that hides or reveals them.
+func (ps *PrivacyZoneService) UpdateSettings(ctx context.Context, req dto.PrivacySettingsRequest) (*dto.PrivacySettingsResponse, error) {
+	user, err := ps.userRepository.FindByID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	err = ps.transactor.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := ps.userRepository.UpdateHideZoneLeaderboards(ctx, req.UserID, req.HideZoneLeaderboards); err != nil {
+			return err
+		}
+		if user.HideZoneLeaderboards == req.HideZoneLeaderboards {
+			return nil
+		}
+		zones, err := ps.repository.FindByUserID(ctx, req.UserID)
+		if err != nil {
+			return err
+		}
+		return ps.refreshZoneRollups(ctx, req.UserID, zones)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &dto.PrivacySettingsResponse{UserID: req.UserID, HideZoneLeaderboards: req.HideZoneLeaderboards}, nil
+}
+
+// refreshZoneRollups refreshes the rollups of the areas where the user holds influence inside the
+// given zones, whose leaders may change when the zones start or stop hiding the user.
+func (ps *PrivacyZoneService) refreshZoneRollups(ctx context.Context, userID uuid.UUID, zones privacyZones) error {
+	if ps.refreshRollups == nil || len(zones) == 0 {
+		return nil
+	}
+	influences, err := ps.hexInfluenceRepository.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	cells := make([]string, 0)
+	for _, influence := range influences {
+		if zones.containsCell(influence.H3Index) {
+			cells = append(cells, influence.H3Index)
+		}
+	}
+	return ps.refreshRollups(ctx, cells)
+}
+
+// RedactActivity returns the public view of an activity without the cells and GPS points
+// that lie inside the owner's privacy zones.
+func (ps *PrivacyZoneService) RedactActivity(ctx context.Context, activity *ent.Activity) (*dto.ActivityDetailResponse, error) {
+	zones, err := ps.repository.FindByUserID(ctx, activity.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	h3Indexes := make([]string, 0, len(activity.H3Indexes))
+	for _, h3Index := range activity.H3Indexes {
+		if !privacyZones(zones).containsCell(h3Index) {
+			h3Indexes = append(h3Indexes, h3Index)
+		}
+	}
+	track := make([]dto.TrackPoint, 0, len(activity.Track))
+	for _, point := range activity.Track {
+		if !privacyZones(zones).containsPoint(point.Lat, point.Lng) {
+			track = append(track, dto.TrackPoint{Lat: point.Lat, Lng: point.Lng, Timestamp: point.Timestamp})
+		}
+	}
+
+	return &dto.ActivityDetailResponse{
+		ID:           activity.ID,
+		UserID:       activity.UserID,
+		ActivityType: activity.ActivityType.String(),
+		Duration:     activity.DurationSeconds,
+		Distance:     activity.DistanceMeters,
+		H3Indexes:    h3Indexes,
+		Track:        track,
+		StartedAt:    activityStartedAt(activity),
+		EndedAt:      activityEndedAt(activity),
+	}, nil
+}
+
+// RedactLeaderboards removes users who opted in from the top users of hexes inside their
+// privacy zones. The leaderboards are changed in place and are not written back.
+func (ps *PrivacyZoneService) RedactLeaderboards(ctx context.Context, leaderboards []*ent.HexLeaderboard) error {
+	userIDs := make([]uuid.UUID, 0)
+	for _, leaderboard := range leaderboards {
+		for _, topUser := range leaderboard.TopUsers {
+			userIDs = append(userIDs, topUser.UserID)
+		}
+	}
+	hidden, err := ps.HiddenIn(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, leaderboard := range leaderboards {
+		topUsers := make([]model.TopUser, 0, len(leaderboard.TopUsers))
+		for _, topUser := range leaderboard.TopUsers {
+			if !hidden(topUser.UserID, leaderboard.H3Index) {
+				topUsers = append(topUsers, topUser)
+			}
+		}
+		leaderboard.TopUsers = topUsers
+	}
+	return nil
+}
+
+// RedactCaptures returns the captures without the users who opted in in hexes inside their
+// privacy zones: a capture by a hidden new owner is left out, and a hidden previous owner is
+// removed from the capture along with their score. The captures are not written back.
+func (ps *PrivacyZoneService) RedactCaptures(ctx context.Context, captures []*ent.HexCapture) ([]*ent.HexCapture, error) {
+	userIDs := make([]uuid.UUID, 0, 2*len(captures))
+	for _, capture := range captures {
+		userIDs = append(userIDs, capture.NewOwnerID)
+		if capture.PreviousOwnerID != nil {
+			userIDs = append(userIDs, *capture.PreviousOwnerID)
+		}
+	}
+	hidden, err := ps.HiddenIn(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	redacted := make([]*ent.HexCapture, 0, len(captures))
+	for _, capture := range captures {
+		if hidden(capture.NewOwnerID, capture.H3Index) {
+			continue
+		}
+		if capture.PreviousOwnerID != nil && hidden(*capture.PreviousOwnerID, capture.H3Index) {
+			capture.PreviousOwnerID = nil
+			capture.PreviousScore = 0
+		}
+		redacted = append(redacted, capture)
+	}
+	return redacted, nil
+}
+
+// HiddenIn loads the privacy settings and zones of the given users and returns a function
+// reporting whether one of them is hidden in a cell, that is whether they opted out of the
+// leaderboards of hexes inside their zones and the cell lies in one. Readers showing others
+// where a user holds influence drop what it reports as hidden.
+func (ps *PrivacyZoneService) HiddenIn(ctx context.Context, userIDs []uuid.UUID) (func(userID uuid.UUID, h3Index string) bool, error) {
+	unique := make([]uuid.UUID, 0, len(userIDs))
+	seen := make(map[uuid.UUID]bool, len(userIDs))
+	for _, userID := range userIDs {
+		if !seen[userID] {
+			seen[userID] = true
+			unique = append(unique, userID)
+		}
+	}
+	zonesByUser := make(map[uuid.UUID]privacyZones)
+	hidden := func(userID uuid.UUID, h3Index string) bool {
+		return zonesByUser[userID].containsCell(h3Index)
+	}
+	if len(unique) == 0 {
+		return hidden, nil
+	}
+
+	users, err := ps.userRepository.FindByIDs(ctx, unique)
+	if err != nil {
+		return nil, err
+	}
+	optedIn := make([]uuid.UUID, 0)
+	for _, user := range users {
+		if user.HideZoneLeaderboards {
+			optedIn = append(optedIn, user.ID)
+		}
+	}
+	if len(optedIn) == 0 {
+		return hidden, nil
+	}
+
+	zones, err := ps.repository.FindByUserIDs(ctx, optedIn)
+	if err != nil {
+		return nil, err
+	}
+	for _, zone := range zones {
+		zonesByUser[zone.UserID] = append(zonesByUser[zone.UserID], zone)
+	}
+	return hidden, nil
+}
+
+type privacyZones []*ent.PrivacyZone
+
+func (zones privacyZones) containsPoint(lat float64, lng float64) bool {
+	point := h3.NewLatLng(lat, lng)
+	for _, zone := range zones {
+		if zone.RadiusMeters > 0 {
+			if h3.GreatCircleDistanceM(point, h3.NewLatLng(zone.Lat, zone.Lng)) <= zone.RadiusMeters {
+				return true
+			}
+			continue
+		}
+		for _, h3Index := range zone.H3Indexes {
+			cell := h3.Cell(h3.IndexFromString(h3Index))
+			pointCell, err := point.Cell(cell.Resolution())
+			if err == nil && pointCell == cell {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// containsCell reports whether a cell lies in any zone. A cell counts as inside a circular
+// zone when any part of it may overlap the circle.
+func (zones privacyZones) containsCell(h3Index string) bool {
+	cell := h3.Cell(h3.IndexFromString(h3Index))
+	center, err := cell.LatLng()
+	if err != nil {
+		return false
+	}
+	edge, err := h3.HexagonEdgeLengthAvgM(cell.Resolution())
+	if err != nil {
+		return false
+	}
+
+	for _, zone := range zones {
+		if zone.RadiusMeters > 0 {
+			if h3.GreatCircleDistanceM(center, h3.NewLatLng(zone.Lat, zone.Lng)) <= zone.RadiusMeters+edge {
+				return true
+			}
+			continue
+		}
+		for _, zoneIndex := range zone.H3Indexes {
+			if zoneIndex == h3Index {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func validatePrivacyZone(req dto.PrivacyZoneRequest) error {
+	if (req.UserID == uuid.UUID{}) {
+		return errors.New("UserID is required")
+	}
+	circle := req.RadiusMeters != 0
+	cells := len(req.H3Indexes) > 0
+	if circle == cells {
+		return ErrInvalidPrivacyZone
+	}
+
+	if circle {
+		if req.RadiusMeters < MinPrivacyZoneRadius || req.RadiusMeters > MaxPrivacyZoneRadius {
+			return ErrPrivacyZoneRadius
+		}
+		if req.Lat < -90 || req.Lat > 90 || req.Lng < -180 || req.Lng > 180 {
+			return ErrInvalidZoneLocation
+		}
+		return nil
+	}
+
+	if len(req.H3Indexes) > MaxPrivacyZoneCells {
+		return ErrPrivacyZoneCells
+	}
+	for _, h3Index := range req.H3Indexes {
+		if err := validateH3Index(h3Index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toModelPrivacyZone(id uuid.UUID, req dto.PrivacyZoneRequest) *model.PrivacyZone {
+	zone := &model.PrivacyZone{
+		ID:           id,
+		UserID:       req.UserID,
+		Name:         req.Name,
+		Lat:          req.Lat,
+		Lng:          req.Lng,
+		RadiusMeters: req.RadiusMeters,
+		H3Indexes:    uniqueH3Indexes(req.H3Indexes),
+	}
+	if zone.RadiusMeters == 0 {
+		zone.Lat, zone.Lng = 0, 0
+	}
+	return zone
+}
+
+func toPrivacyZoneResponse(zone *ent.PrivacyZone) *dto.PrivacyZoneResponse {
+	h3Indexes := zone.H3Indexes
+	if h3Indexes == nil {
+		h3Indexes = []string{}
+	}
+	return &dto.PrivacyZoneResponse{
+		ID:           zone.ID,
+		UserID:       zone.UserID,
+		Name:         zone.Name,
+		Lat:          zone.Lat,
+		Lng:          zone.Lng,
+		RadiusMeters: zone.RadiusMeters,
+		H3Indexes:    h3Indexes,
+		CreatedAt:    zone.CreatedAt,
+	}
+}
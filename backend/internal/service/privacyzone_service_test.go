@@ -0,0 +1,185 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/hex/hexconsts"
+	"stride-wars-app/internal/repository"
+	"stride-wars-app/internal/service"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestPrivacyZoneService(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: RedactActivity
+	// ------------------------
+	t.Run("RedactActivity", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		startedAt := time.Now().Add(-time.Hour)
+		home := cellCenter(t, validH3Indexes[0], startedAt)
+		away := cellCenter(t, validH3Indexes[1], startedAt.Add(5*time.Minute))
+		created, err := svc.CreateActivity(ctx, dto.CreateActivityRequest{
+			UserID:    createdUser.ID,
+			Duration:  600,
+			Distance:  2000,
+			H3Indexes: validH3Indexes,
+			Track:     []dto.TrackPoint{home, away},
+			StartedAt: &startedAt,
+		})
+		require.NoError(t, err)
+
+		detail, err := svc.GetActivity(ctx, created.ID)
+		require.NoError(t, err)
+		require.Equal(t, validH3Indexes, detail.H3Indexes)
+		require.Len(t, detail.Track, 2)
+
+		zone, err := svc.PrivacyZoneService.CreateZone(ctx, dto.PrivacyZoneRequest{
+			UserID:    createdUser.ID,
+			Name:      "home",
+			H3Indexes: []string{validH3Indexes[0]},
+		})
+		require.NoError(t, err)
+
+		detail, err = svc.GetActivity(ctx, created.ID)
+		require.NoError(t, err)
+		require.Equal(t, []string{validH3Indexes[1]}, detail.H3Indexes)
+		require.Len(t, detail.Track, 1)
+		require.InDelta(t, away.Lat, detail.Track[0].Lat, 1e-9)
+
+		// A circle around the other end hides that end as well
+		_, err = svc.PrivacyZoneService.UpdateZone(ctx, zone.ID, dto.PrivacyZoneRequest{
+			UserID:       createdUser.ID,
+			Name:         "work",
+			Lat:          away.Lat,
+			Lng:          away.Lng,
+			RadiusMeters: 100,
+		})
+		require.NoError(t, err)
+
+		detail, err = svc.GetActivity(ctx, created.ID)
+		require.NoError(t, err)
+		require.NotContains(t, detail.H3Indexes, validH3Indexes[1])
+		for _, point := range detail.Track {
+			require.NotEqual(t, away.Lat, point.Lat)
+		}
+		// Distance and duration are still reported in full
+		require.Equal(t, 2000.0, detail.Distance)
+	})
+
+	// ------------------------
+	// Subtest: RedactLeaderboards_OptIn
+	// ------------------------
+	t.Run("RedactLeaderboards_OptIn", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		alice, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		bob, err := userRepo.CreateUser(ctx, &model.User{Username: "bob", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		for _, user := range []uuid.UUID{alice.ID, bob.ID} {
+			_, err := svc.CreateActivity(ctx, dto.CreateActivityRequest{
+				UserID:    user,
+				Duration:  600,
+				Distance:  2000,
+				H3Indexes: validH3Indexes,
+			})
+			require.NoError(t, err)
+		}
+		processJobs(t, ctx, svc)
+
+		home := cellCenter(t, validH3Indexes[0], time.Now())
+		_, err = svc.PrivacyZoneService.CreateZone(ctx, dto.PrivacyZoneRequest{
+			UserID:       alice.ID,
+			Lat:          home.Lat,
+			Lng:          home.Lng,
+			RadiusMeters: 100,
+		})
+		require.NoError(t, err)
+
+		bbox := service.BoundingBox{
+			MinLat: home.Lat - 0.001,
+			MinLng: home.Lng - 0.001,
+			MaxLat: home.Lat + 0.001,
+			MaxLng: home.Lng + 0.001,
+		}
+		topUsers := func() []dto.TopUserResponse {
+			resp, err := svc.HexLeaderboardService.GetAllLeaderboardsInsideBBBox(ctx, bbox, hexconsts.DefaultHexResolution)
+			require.NoError(t, err)
+			for _, leaderboard := range resp.Leaderboards {
+				if leaderboard.H3Index == validH3Indexes[0] {
+					return leaderboard.TopUsers
+				}
+			}
+			t.Fatalf("no leaderboard for %s", validH3Indexes[0])
+			return nil
+		}
+
+		// Zones alone do not hide the user from leaderboards
+		require.Len(t, topUsers(), 2)
+
+		_, err = svc.PrivacyZoneService.UpdateSettings(ctx, dto.PrivacySettingsRequest{UserID: alice.ID, HideZoneLeaderboards: true})
+		require.NoError(t, err)
+		users := topUsers()
+		require.Len(t, users, 1)
+		require.Equal(t, bob.ID, users[0].UserID)
+
+		// Influence is still scored inside the zone
+		influence, err := svc.HexInfluenceService.FindByUserIDAndHexID(ctx, alice.ID, validH3Indexes[0])
+		require.NoError(t, err)
+		require.NotNil(t, influence)
+	})
+
+	// ------------------------
+	// Subtest: Validation
+	// ------------------------
+	t.Run("Validation", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		_, err = svc.PrivacyZoneService.CreateZone(ctx, dto.PrivacyZoneRequest{UserID: createdUser.ID})
+		require.ErrorIs(t, err, service.ErrInvalidPrivacyZone)
+
+		_, err = svc.PrivacyZoneService.CreateZone(ctx, dto.PrivacyZoneRequest{
+			UserID:       createdUser.ID,
+			RadiusMeters: 200,
+			H3Indexes:    validH3Indexes,
+		})
+		require.ErrorIs(t, err, service.ErrInvalidPrivacyZone)
+
+		_, err = svc.PrivacyZoneService.CreateZone(ctx, dto.PrivacyZoneRequest{UserID: createdUser.ID, Lat: 52, Lng: 4, RadiusMeters: 50})
+		require.ErrorIs(t, err, service.ErrPrivacyZoneRadius)
+
+		zone, err := svc.PrivacyZoneService.CreateZone(ctx, dto.PrivacyZoneRequest{UserID: createdUser.ID, Lat: 52, Lng: 4, RadiusMeters: 200})
+		require.NoError(t, err)
+
+		err = svc.PrivacyZoneService.DeleteZone(ctx, zone.ID, uuid.New())
+		require.ErrorIs(t, err, service.ErrPrivacyZoneNotOwned)
+		require.NoError(t, svc.PrivacyZoneService.DeleteZone(ctx, zone.ID, createdUser.ID))
+
+		zones, err := svc.PrivacyZoneService.GetZones(ctx, createdUser.ID)
+		require.NoError(t, err)
+		require.Empty(t, zones.Zones)
+	})
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"stride-wars-app/internal/config"
+	"time"
+)
+
+// Push is one push notification to one device.
+type Push struct {
+	To    string
+	Title string
+	Body  string
+	Data  map[string]string
+}
+
+// PushResult is the provider's answer for one push. Error is empty when the push was accepted.
+type PushResult struct {
+	Error string
+	// InvalidToken is set when the provider reports that the token no longer reaches a device.
+	InvalidToken bool
+}
+
+// PushSender delivers push notifications to a provider.
+type PushSender interface {
+	// Send delivers the pushes in one request and returns one result per push, in order. An error
+	// means the request as a whole failed and none of the pushes can be assumed delivered.
+	Send(ctx context.Context, pushes []Push) ([]PushResult, error)
+}
+
+// MaxExpoBatch is the most messages the Expo push API accepts in one request.
+const MaxExpoBatch = 100
+
+// ExpoPushSender sends push notifications through the Expo push API.
+type ExpoPushSender struct {
+	url         string
+	accessToken string
+	client      *http.Client
+}
+
+func NewExpoPushSender(cfg config.Config) *ExpoPushSender {
+	return &ExpoPushSender{
+		url:         cfg.ExpoPushURL,
+		accessToken: cfg.ExpoAccessToken,
+		client:      &http.Client{Timeout: 15 * time.Second},
+	}
+}
+
+type expoMessage struct {
+	To    string            `json:"to"`
+	Title string            `json:"title"`
+	Body  string            `json:"body"`
+	Data  map[string]string `json:"data,omitempty"`
+	Sound string            `json:"sound"`
+}
+
+type expoTicket struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	Details struct {
+		Error string `json:"error"`
+	} `json:"details"`
+}
+
+func (s *ExpoPushSender) Send(ctx context.Context, pushes []Push) ([]PushResult, error) {
+	if len(pushes) > MaxExpoBatch {
+		return nil, fmt.Errorf("expo accepts at most %d messages per request, got %d", MaxExpoBatch, len(pushes))
+	}
+	messages := make([]expoMessage, len(pushes))
+	for i, push := range pushes {
+		messages[i] = expoMessage{To: push.To, Title: push.Title, Body: push.Body, Data: push.Data, Sound: "default"}
+	}
+	body, err := json.Marshal(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	if s.accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+s.accessToken)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("expo push API answered %s", resp.Status)
+	}
+
+	var tickets struct {
+		Data []expoTicket `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tickets); err != nil {
+		return nil, fmt.Errorf("decoding expo push tickets: %w", err)
+	}
+	if len(tickets.Data) != len(pushes) {
+		return nil, fmt.Errorf("expo push API returned %d tickets for %d messages", len(tickets.Data), len(pushes))
+	}
+
+	results := make([]PushResult, len(pushes))
+	for i, ticket := range tickets.Data {
+		if ticket.Status == "ok" {
+			continue
+		}
+		results[i] = PushResult{
+			Error:        ticket.Message,
+			InvalidToken: ticket.Details.Error == "DeviceNotRegistered",
+		}
+		if results[i].Error == "" {
+			results[i].Error = ticket.Details.Error
+		}
+	}
+	return results, nil
+}
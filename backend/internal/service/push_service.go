@@ -0,0 +1,398 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/config"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/repository"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// MaxPushAttempts is how many times a push message is tried before it is given up on.
+const MaxPushAttempts = 3
+
+// pushLease is how long a dispatcher may take to send a batch before another one claims it again.
+const pushLease = time.Minute
+
+// pushRetryDelay is how long a message that could not be sent waits before its first retry. The
+// delay doubles with every attempt.
+const pushRetryDelay = 30 * time.Second
+
+// clockLayout is the HH:MM layout quiet hours are given in.
+const clockLayout = "15:04"
+
+var (
+	ErrInvalidPushToken   = errors.New("token must be an Expo push token, like ExponentPushToken[...]")
+	ErrInvalidDeviceID    = errors.New("device_id is required")
+	ErrPushDeviceNotFound = errors.New("device not found")
+	ErrInvalidQuietHours  = errors.New("quiet hours need a different start and end, both as HH:MM, or neither")
+)
+
+var expoPushTokenPattern = regexp.MustCompile(`^Expo(nent)?PushToken\[[^\]]+\]$`)
+
+// PushService manages the devices push notifications go to and sends the queued push messages
+// through a PushSender, in batches and at a limited rate. Messages are queued by
+// NotificationService.
+type PushService struct {
+	deviceRepository  repository.PushDeviceRepository
+	messageRepository repository.PushMessageRepository
+	userRepository    repository.UserRepository
+	transactor        repository.Transactor
+	sender            PushSender
+	batchSize         int
+	limiter           *rateLimiter
+	logger            *zap.Logger
+}
+
+func NewPushService(repositories *repository.Repositories, sender PushSender, cfg config.Config, logger *zap.Logger) *PushService {
+	return &PushService{
+		deviceRepository:  repositories.PushDeviceRepository,
+		messageRepository: repositories.PushMessageRepository,
+		userRepository:    repositories.UserRepository,
+		transactor:        repositories.Transactor,
+		sender:            sender,
+		batchSize:         cfg.PushBatchSize,
+		limiter:           &rateLimiter{perSecond: cfg.PushMaxPerSecond},
+		logger:            logger,
+	}
+}
+
+// RegisterDevice stores the push token of a user's device, replacing the device's previous
+// token. A token registered for another user or device is moved to this one.
+func (ps *PushService) RegisterDevice(ctx context.Context, req dto.RegisterPushDeviceRequest) (*dto.PushDeviceResponse, error) {
+	if req.DeviceID == "" {
+		return nil, ErrInvalidDeviceID
+	}
+	if !expoPushTokenPattern.MatchString(req.Token) {
+		return nil, ErrInvalidPushToken
+	}
+	if _, err := ps.userRepository.FindByID(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+
+	var device *ent.PushDevice
+	err := ps.transactor.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := ps.deviceRepository.DeleteOtherDevicesWithToken(ctx, req.Token, req.UserID, req.DeviceID); err != nil {
+			return err
+		}
+		var err error
+		device, err = ps.deviceRepository.UpsertPushDevice(ctx, &model.PushDevice{
+			UserID:   req.UserID,
+			DeviceID: req.DeviceID,
+			Token:    req.Token,
+		}, time.Now())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pushDeviceResponse(device), nil
+}
+
+func (ps *PushService) UnregisterDevice(ctx context.Context, userID uuid.UUID, deviceID string) error {
+	deleted, err := ps.deviceRepository.DeleteByUserIDAndDeviceID(ctx, userID, deviceID)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrPushDeviceNotFound
+	}
+	return nil
+}
+
+func (ps *PushService) GetDevices(ctx context.Context, userID uuid.UUID) (*dto.GetPushDevicesResponse, error) {
+	if _, err := ps.userRepository.FindByID(ctx, userID); err != nil {
+		return nil, err
+	}
+	devices, err := ps.deviceRepository.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &dto.GetPushDevicesResponse{Devices: make([]dto.PushDeviceResponse, len(devices))}
+	for i, device := range devices {
+		resp.Devices[i] = *pushDeviceResponse(device)
+	}
+	return resp, nil
+}
+
+func pushDeviceResponse(device *ent.PushDevice) *dto.PushDeviceResponse {
+	return &dto.PushDeviceResponse{
+		DeviceID:  device.DeviceID,
+		Token:     device.Token,
+		CreatedAt: device.CreatedAt,
+		UpdatedAt: device.UpdatedAt,
+	}
+}
+
+// UpdateQuietHours sets the local times between which the user gets no push notifications. The
+// hours may span midnight, e.g. 22:00 to 07:00.
+func (ps *PushService) UpdateQuietHours(ctx context.Context, req dto.QuietHoursRequest) (*dto.QuietHoursResponse, error) {
+	var start, end *int
+	if req.Start != nil || req.End != nil {
+		if req.Start == nil || req.End == nil {
+			return nil, ErrInvalidQuietHours
+		}
+		startMinute, err := parseClock(*req.Start)
+		if err != nil {
+			return nil, ErrInvalidQuietHours
+		}
+		endMinute, err := parseClock(*req.End)
+		if err != nil || startMinute == endMinute {
+			return nil, ErrInvalidQuietHours
+		}
+		start, end = &startMinute, &endMinute
+	}
+
+	user, err := ps.userRepository.FindByID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ps.userRepository.UpdateQuietHours(ctx, req.UserID, start, end); err != nil {
+		return nil, err
+	}
+	return &dto.QuietHoursResponse{
+		UserID:   req.UserID,
+		Start:    formatClock(start),
+		End:      formatClock(end),
+		TimeZone: UserLocation(user).String(),
+	}, nil
+}
+
+// parseClock returns the minutes after midnight of a HH:MM time.
+func parseClock(clock string) (int, error) {
+	t, err := time.Parse(clockLayout, clock)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func formatClock(minutes *int) *string {
+	if minutes == nil {
+		return nil
+	}
+	clock := fmt.Sprintf("%02d:%02d", *minutes/60, *minutes%60)
+	return &clock
+}
+
+// inQuietHours reports whether now falls in the user's quiet hours in their time zone.
+func inQuietHours(user *ent.User, now time.Time) bool {
+	if user.QuietHoursStart == nil || user.QuietHoursEnd == nil {
+		return false
+	}
+	local := now.In(UserLocation(user))
+	minute := local.Hour()*60 + local.Minute()
+	start, end := *user.QuietHoursStart, *user.QuietHoursEnd
+	if start < end {
+		return minute >= start && minute < end
+	}
+	return minute >= start || minute < end
+}
+
+// Dispatch sends the queued push messages, a batch at a time, until none are due. It returns how
+// many messages it handled.
+func (ps *PushService) Dispatch(ctx context.Context) (int, error) {
+	handled := 0
+	for {
+		messages, err := ps.claimBatch(ctx)
+		if err != nil || len(messages) == 0 {
+			return handled, err
+		}
+		if err := ps.deliver(ctx, messages); err != nil {
+			return handled, err
+		}
+		handled += len(messages)
+	}
+}
+
+// claimBatch locks up to a batch of due messages for this dispatcher.
+func (ps *PushService) claimBatch(ctx context.Context) ([]*ent.PushMessage, error) {
+	var messages []*ent.PushMessage
+	err := ps.transactor.WithTx(ctx, func(ctx context.Context) error {
+		now := time.Now()
+		var err error
+		messages, err = ps.messageRepository.FindDue(ctx, now, ps.batchSize)
+		if err != nil || len(messages) == 0 {
+			return err
+		}
+		_, err = ps.messageRepository.ClaimPushMessages(ctx, pushMessageIDs(messages), now, now.Add(pushLease))
+		return err
+	})
+	return messages, err
+}
+
+// pushOutcome collects the results of the pushes of one message to all its user's devices.
+type pushOutcome struct {
+	delivered bool
+	invalid   int
+	pushes    int
+	lastError string
+}
+
+// deliver sends claimed messages to every device of their users and records the outcome. Users in
+// their quiet hours or without devices are skipped, and tokens the provider rejects are removed.
+func (ps *PushService) deliver(ctx context.Context, messages []*ent.PushMessage) error {
+	userIDs := make([]uuid.UUID, 0, len(messages))
+	for _, message := range messages {
+		userIDs = append(userIDs, message.UserID)
+	}
+	users, err := ps.userRepository.FindByIDs(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	usersByID := make(map[uuid.UUID]*ent.User, len(users))
+	for _, user := range users {
+		usersByID[user.ID] = user
+	}
+	devices, err := ps.deviceRepository.FindByUserIDs(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	tokensByUser := make(map[uuid.UUID][]string)
+	for _, device := range devices {
+		tokensByUser[device.UserID] = append(tokensByUser[device.UserID], device.Token)
+	}
+
+	now := time.Now()
+	var skipped []uuid.UUID
+	var pushes []Push
+	var pushMessages []uuid.UUID
+	outcomes := make(map[uuid.UUID]*pushOutcome, len(messages))
+	for _, message := range messages {
+		user := usersByID[message.UserID]
+		tokens := tokensByUser[message.UserID]
+		if user == nil || len(tokens) == 0 || inQuietHours(user, now) {
+			skipped = append(skipped, message.ID)
+			continue
+		}
+		data := map[string]string{"notification_id": message.NotificationID.String()}
+		for key, value := range message.Data {
+			data[key] = value
+		}
+		outcomes[message.ID] = &pushOutcome{pushes: len(tokens)}
+		for _, token := range tokens {
+			pushes = append(pushes, Push{To: token, Title: message.Title, Body: message.Body, Data: data})
+			pushMessages = append(pushMessages, message.ID)
+		}
+	}
+
+	var invalidTokens []string
+	for start := 0; start < len(pushes); start += ps.batchSize {
+		end := min(start+ps.batchSize, len(pushes))
+		if err := ps.limiter.wait(ctx, end-start); err != nil {
+			return err
+		}
+		results, err := ps.sender.Send(ctx, pushes[start:end])
+		for i := start; i < end; i++ {
+			outcome := outcomes[pushMessages[i]]
+			switch {
+			case err != nil:
+				outcome.lastError = err.Error()
+			case results[i-start].InvalidToken:
+				outcome.invalid++
+				invalidTokens = append(invalidTokens, pushes[i].To)
+			case results[i-start].Error != "":
+				outcome.lastError = results[i-start].Error
+			default:
+				outcome.delivered = true
+			}
+		}
+	}
+
+	if len(invalidTokens) > 0 {
+		removed, err := ps.deviceRepository.DeleteByTokens(ctx, invalidTokens)
+		if err != nil {
+			return err
+		}
+		ps.logger.Info("Removed devices with invalid push tokens.", zap.Int("count", removed))
+	}
+	return ps.finish(ctx, messages, outcomes, skipped, now)
+}
+
+// finish records how each message went: sent when it reached at least one device, skipped when
+// none of its devices exist anymore, retried later or failed otherwise.
+func (ps *PushService) finish(ctx context.Context, messages []*ent.PushMessage, outcomes map[uuid.UUID]*pushOutcome, skipped []uuid.UUID, now time.Time) error {
+	if len(skipped) > 0 {
+		if _, err := ps.messageRepository.FinishPushMessages(ctx, skipped, model.PushMessageSkipped, nil, now); err != nil {
+			return err
+		}
+	}
+	for _, message := range messages {
+		outcome, ok := outcomes[message.ID]
+		if !ok {
+			continue
+		}
+		// Attempts does not count the current one yet, it was added when the message was claimed.
+		attempts := message.Attempts + 1
+		var err error
+		switch {
+		case outcome.delivered:
+			_, err = ps.messageRepository.FinishPushMessages(ctx, []uuid.UUID{message.ID}, model.PushMessageSent, nil, now)
+		case outcome.invalid == outcome.pushes:
+			_, err = ps.messageRepository.FinishPushMessages(ctx, []uuid.UUID{message.ID}, model.PushMessageSkipped, nil, now)
+		case attempts >= MaxPushAttempts:
+			_, err = ps.messageRepository.FinishPushMessages(ctx, []uuid.UUID{message.ID}, model.PushMessageFailed, &outcome.lastError, now)
+		default:
+			retryAt := now.Add(pushRetryDelay << (attempts - 1))
+			_, err = ps.messageRepository.RetryPushMessage(ctx, message.ID, outcome.lastError, retryAt, now)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PurgeFinished deletes sent, skipped and failed push messages older than the given age.
+func (ps *PushService) PurgeFinished(ctx context.Context, age time.Duration) (int, error) {
+	return ps.messageRepository.DeleteFinishedBefore(ctx, time.Now().Add(-age))
+}
+
+func pushMessageIDs(messages []*ent.PushMessage) []uuid.UUID {
+	ids := make([]uuid.UUID, len(messages))
+	for i, message := range messages {
+		ids[i] = message.ID
+	}
+	return ids
+}
+
+// rateLimiter spaces out sends so they average at most perSecond pushes per second.
+type rateLimiter struct {
+	perSecond float64
+	mu        sync.Mutex
+	next      time.Time
+}
+
+// wait blocks until n more pushes may be sent, or ctx is done.
+func (l *rateLimiter) wait(ctx context.Context, n int) error {
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	at := l.next
+	l.next = l.next.Add(time.Duration(float64(n) / l.perSecond * float64(time.Second)))
+	l.mu.Unlock()
+
+	delay := time.Until(at)
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,273 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	entPushDevice "stride-wars-app/ent/pushdevice"
+	entPushMessage "stride-wars-app/ent/pushmessage"
+	"stride-wars-app/internal/config"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/service"
+	"stride-wars-app/internal/testutil"
+)
+
+// failingPushSender fails every request, like an unreachable provider.
+type failingPushSender struct{}
+
+func (failingPushSender) Send(context.Context, []service.Push) ([]service.PushResult, error) {
+	return nil, errors.New("provider unavailable")
+}
+
+func TestPushService(t *testing.T) {
+	t.Parallel()
+
+	createUser := func(t *testing.T, tdb *testutil.TestServices, name string) *ent.User {
+		user, err := tdb.UserRepo.CreateUser(tdb.Ctx, &model.User{Username: name, ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		return user
+	}
+	register := func(t *testing.T, tdb *testutil.TestServices, user *ent.User, deviceID, token string) {
+		_, err := tdb.PushService.RegisterDevice(tdb.Ctx, dto.RegisterPushDeviceRequest{UserID: user.ID, DeviceID: deviceID, Token: token})
+		require.NoError(t, err)
+	}
+	notify := func(t *testing.T, tdb *testutil.TestServices, user *ent.User) {
+		_, err := tdb.NotificationService.Notify(tdb.Ctx, &model.Notification{
+			UserID:           user.ID,
+			NotificationType: model.NotificationHexCaptured,
+			Message:          "someone took the lead of your hex",
+		})
+		require.NoError(t, err)
+	}
+	statuses := func(t *testing.T, tdb *testutil.TestServices) []string {
+		messages, err := tdb.Client.PushMessage.Query().Order(ent.Asc(entPushMessage.FieldCreatedAt)).All(tdb.Ctx)
+		require.NoError(t, err)
+		result := make([]string, len(messages))
+		for i, message := range messages {
+			result[i] = message.Status.String()
+		}
+		return result
+	}
+
+	// ------------------------
+	// Subtest: Devices
+	// ------------------------
+	t.Run("Devices", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+		alice := createUser(t, tdb, "alice")
+		bob := createUser(t, tdb, "bob")
+
+		_, err := tdb.PushService.RegisterDevice(ctx, dto.RegisterPushDeviceRequest{UserID: alice.ID, DeviceID: "phone", Token: "not-a-token"})
+		require.ErrorIs(t, err, service.ErrInvalidPushToken)
+		_, err = tdb.PushService.RegisterDevice(ctx, dto.RegisterPushDeviceRequest{UserID: alice.ID, Token: "ExponentPushToken[a]"})
+		require.ErrorIs(t, err, service.ErrInvalidDeviceID)
+
+		// Registering a device again replaces its token.
+		register(t, tdb, alice, "phone", "ExponentPushToken[a]")
+		register(t, tdb, alice, "phone", "ExponentPushToken[b]")
+		register(t, tdb, alice, "tablet", "ExpoPushToken[c]")
+		devices, err := tdb.PushService.GetDevices(ctx, alice.ID)
+		require.NoError(t, err)
+		require.Len(t, devices.Devices, 2)
+
+		// A token signed in to another account moves to it.
+		register(t, tdb, bob, "phone", "ExpoPushToken[c]")
+		devices, err = tdb.PushService.GetDevices(ctx, alice.ID)
+		require.NoError(t, err)
+		require.Len(t, devices.Devices, 1)
+		require.Equal(t, "ExponentPushToken[b]", devices.Devices[0].Token)
+
+		require.NoError(t, tdb.PushService.UnregisterDevice(ctx, alice.ID, "phone"))
+		require.ErrorIs(t, tdb.PushService.UnregisterDevice(ctx, alice.ID, "phone"), service.ErrPushDeviceNotFound)
+	})
+
+	// ------------------------
+	// Subtest: HexCapturedPush
+	// ------------------------
+	t.Run("HexCapturedPush", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+		hexID := validH3Indexes[0]
+		require.NoError(t, tdb.HexService.CreateMissingHexes(ctx, []string{hexID}))
+		alice := createUser(t, tdb, "alice")
+		bob := createUser(t, tdb, "bob")
+		register(t, tdb, alice, "phone", "ExponentPushToken[phone]")
+		register(t, tdb, alice, "old-phone", "ExponentPushToken[uninstalled]")
+		tdb.PushSender.InvalidTokens["ExponentPushToken[uninstalled]"] = true
+
+		visit := func(user *ent.User, visits int) {
+			hexIDs := make([]string, visits)
+			for i := range hexIDs {
+				hexIDs[i] = hexID
+			}
+			err := tdb.Repositories.Transactor.WithTx(ctx, func(ctx context.Context) error {
+				influences, err := tdb.HexInfluenceService.RecordVisitsAt(ctx, user.ID, nil, hexIDs, time.Now())
+				if err != nil {
+					return err
+				}
+				_, err = tdb.HexLeaderboardService.AddUserToLeaderboards(ctx, user.Username, nil, influences)
+				return err
+			})
+			require.NoError(t, err)
+		}
+		visit(alice, 2)
+		visit(bob, 3)
+
+		// Nothing is sent until the dispatcher runs.
+		require.Empty(t, tdb.PushSender.Pushes())
+		handled, err := tdb.PushService.Dispatch(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, handled)
+
+		pushes := tdb.PushSender.Pushes()
+		require.Len(t, pushes, 2)
+		for _, push := range pushes {
+			require.Equal(t, "Hex captured", push.Title)
+			require.Contains(t, push.Body, "bob")
+			// The hex stays in the inbox, out of the provider's hands.
+			require.NotContains(t, push.Body, hexID)
+			require.NotContains(t, push.Data, "h3_index")
+			require.Equal(t, model.NotificationHexCaptured, push.Data["type"])
+			require.NotEmpty(t, push.Data["notification_id"])
+		}
+		require.Equal(t, []string{model.PushMessageSent}, statuses(t, tdb))
+
+		// The uninstalled app's token was removed.
+		tokens, err := tdb.Client.PushDevice.Query().Where(entPushDevice.UserIDEQ(alice.ID)).Select(entPushDevice.FieldToken).Strings(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{"ExponentPushToken[phone]"}, tokens)
+
+		handled, err = tdb.PushService.Dispatch(ctx)
+		require.NoError(t, err)
+		require.Zero(t, handled)
+	})
+
+	// ------------------------
+	// Subtest: QuietHours
+	// ------------------------
+	t.Run("QuietHours", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+		alice := createUser(t, tdb, "alice")
+		register(t, tdb, alice, "phone", "ExponentPushToken[phone]")
+
+		clock := func(d time.Duration) *string {
+			s := time.Now().UTC().Add(d).Format("15:04")
+			return &s
+		}
+		invalid := "25:00"
+		_, err := tdb.PushService.UpdateQuietHours(ctx, dto.QuietHoursRequest{UserID: alice.ID, Start: clock(-time.Hour), End: nil})
+		require.ErrorIs(t, err, service.ErrInvalidQuietHours)
+		_, err = tdb.PushService.UpdateQuietHours(ctx, dto.QuietHoursRequest{UserID: alice.ID, Start: clock(0), End: clock(0)})
+		require.ErrorIs(t, err, service.ErrInvalidQuietHours)
+		_, err = tdb.PushService.UpdateQuietHours(ctx, dto.QuietHoursRequest{UserID: alice.ID, Start: clock(0), End: &invalid})
+		require.ErrorIs(t, err, service.ErrInvalidQuietHours)
+
+		// Quiet hours around the current time, spanning midnight when it is near.
+		resp, err := tdb.PushService.UpdateQuietHours(ctx, dto.QuietHoursRequest{UserID: alice.ID, Start: clock(-time.Hour), End: clock(time.Hour)})
+		require.NoError(t, err)
+		require.Equal(t, "UTC", resp.TimeZone)
+
+		notify(t, tdb, alice)
+		_, err = tdb.PushService.Dispatch(ctx)
+		require.NoError(t, err)
+		require.Empty(t, tdb.PushSender.Pushes())
+		require.Equal(t, []string{model.PushMessageSkipped}, statuses(t, tdb))
+
+		// The inbox still has the notification.
+		inbox, err := tdb.NotificationService.GetNotifications(ctx, alice.ID, false, 0, 0)
+		require.NoError(t, err)
+		require.Len(t, inbox.Notifications, 1)
+
+		resp, err = tdb.PushService.UpdateQuietHours(ctx, dto.QuietHoursRequest{UserID: alice.ID})
+		require.NoError(t, err)
+		require.Nil(t, resp.Start)
+		notify(t, tdb, alice)
+		_, err = tdb.PushService.Dispatch(ctx)
+		require.NoError(t, err)
+		require.Len(t, tdb.PushSender.Pushes(), 1)
+	})
+
+	// ------------------------
+	// Subtest: BatchesAndRate
+	// ------------------------
+	t.Run("BatchesAndRate", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+
+		cfg := config.Default()
+		cfg.PushBatchSize = 2
+		cfg.PushMaxPerSecond = 20
+		sender := testutil.NewRecordingPushSender()
+		pushService := service.NewPushService(tdb.Repositories, sender, cfg, zap.NewExample())
+
+		// Users without devices get no push messages at all.
+		createUser(t, tdb, "no-devices")
+		for i := 0; i < 5; i++ {
+			user := createUser(t, tdb, fmt.Sprintf("user%d", i))
+			register(t, tdb, user, "phone", fmt.Sprintf("ExponentPushToken[%d]", i))
+			notify(t, tdb, user)
+		}
+
+		start := time.Now()
+		handled, err := pushService.Dispatch(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 5, handled)
+		// 5 pushes at 20 a second: the third batch waits for the first four to have used 200ms.
+		require.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+		require.Len(t, sender.Batches, 3)
+		for _, batch := range sender.Batches {
+			require.LessOrEqual(t, len(batch), 2)
+		}
+		require.Len(t, sender.Pushes(), 5)
+	})
+
+	// ------------------------
+	// Subtest: Retries
+	// ------------------------
+	t.Run("Retries", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+		pushService := service.NewPushService(tdb.Repositories, failingPushSender{}, config.Default(), zap.NewExample())
+
+		alice := createUser(t, tdb, "alice")
+		register(t, tdb, alice, "phone", "ExponentPushToken[phone]")
+		notify(t, tdb, alice)
+
+		for attempt := 1; attempt <= service.MaxPushAttempts; attempt++ {
+			handled, err := pushService.Dispatch(ctx)
+			require.NoError(t, err)
+			require.Equal(t, 1, handled)
+			if attempt == service.MaxPushAttempts {
+				break
+			}
+			// The retry waits for its backoff.
+			require.Equal(t, []string{model.PushMessagePending}, statuses(t, tdb))
+			handled, err = pushService.Dispatch(ctx)
+			require.NoError(t, err)
+			require.Zero(t, handled)
+			_, err = tdb.Client.PushMessage.Update().SetLockedUntil(time.Now().Add(-time.Second)).Save(ctx)
+			require.NoError(t, err)
+		}
+		require.Equal(t, []string{model.PushMessageFailed}, statuses(t, tdb))
+		message, err := tdb.Client.PushMessage.Query().Only(ctx)
+		require.NoError(t, err)
+		require.Equal(t, service.MaxPushAttempts, message.Attempts)
+		require.Equal(t, "provider unavailable", *message.LastError)
+	})
+}
@@ -0,0 +1,492 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/config"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/hex/hexconsts"
+	"stride-wars-app/internal/repository"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/uber/h3-go/v4"
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultRegionLeaderboardLimit = 50
+	MaxRegionLeaderboardLimit     = 200
+	// MaxRegionCells caps the cells a single region may cover, about 10,000 km² at the default
+	// resolution.
+	MaxRegionCells = 100000
+)
+
+var (
+	ErrInvalidRegions               = errors.New("invalid region boundaries")
+	ErrRegionTooLarge               = fmt.Errorf("a region may cover at most %d cells", MaxRegionCells)
+	ErrInvalidRegionLeaderboardPage = fmt.Errorf("limit must be between 1 and %d and offset must not be negative", MaxRegionLeaderboardLimit)
+	ErrRegionUploadsDisabled        = errors.New("region uploads are disabled")
+	ErrInvalidAdminToken            = errors.New("invalid admin token")
+)
+
+// RegionService loads named region boundaries, such as districts and cities, and keeps a
+// leaderboard for each. A region's boundary is stored as the cells at the default resolution it
+// covers, and its users are ranked by the hexes they lead there, then by their total influence
+// there. The leaderboards are recomputed periodically rather than on every visit.
+type RegionService struct {
+	repository               repository.RegionRepository
+	hexRepository            repository.RegionHexRepository
+	standingRepository       repository.RegionStandingRepository
+	hexInfluenceRepository   repository.HexInfluenceRepository
+	hexLeaderboardRepository repository.HexLeaderboardRepository
+	userRepository           repository.UserRepository
+	transactor               repository.Transactor
+	privacyZoneService       *PrivacyZoneService
+	scoring                  ScoringStrategy
+	adminToken               string
+	logger                   *zap.Logger
+}
+
+func NewRegionService(repositories *repository.Repositories, privacyZoneService *PrivacyZoneService, scoring ScoringStrategy, cfg config.Config, logger *zap.Logger) *RegionService {
+	return &RegionService{
+		repository:               repositories.RegionRepository,
+		hexRepository:            repositories.RegionHexRepository,
+		standingRepository:       repositories.RegionStandingRepository,
+		hexInfluenceRepository:   repositories.HexInfluenceRepository,
+		hexLeaderboardRepository: repositories.HexLeaderboardRepository,
+		userRepository:           repositories.UserRepository,
+		transactor:               repositories.Transactor,
+		privacyZoneService:       privacyZoneService,
+		scoring:                  scoring,
+		adminToken:               cfg.AdminToken,
+		logger:                   logger,
+	}
+}
+
+// AuthorizeAdmin checks a token sent to an admin endpoint against the configured AdminToken.
+func (s *RegionService) AuthorizeAdmin(token string) error {
+	if s.adminToken == "" {
+		return ErrRegionUploadsDisabled
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(s.adminToken)) != 1 {
+		return ErrInvalidAdminToken
+	}
+	return nil
+}
+
+// LoadRegions stores the regions of a GeoJSON FeatureCollection, replacing the boundaries of
+// those already loaded with the same slug, and computes their leaderboards. Either all of them
+// are stored or none is.
+func (s *RegionService) LoadRegions(ctx context.Context, collection dto.RegionFeatureCollection) (*dto.GetRegionsResponse, error) {
+	boundaries, err := parseRegions(collection)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	regions := make([]*ent.Region, 0, len(boundaries))
+	err = s.transactor.WithRetry(ctx, func(ctx context.Context) error {
+		regions = regions[:0]
+		for _, boundary := range boundaries {
+			region, err := s.repository.UpsertRegion(ctx, &model.Region{
+				Slug:     boundary.slug,
+				Name:     boundary.name,
+				Kind:     boundary.kind,
+				HexCount: len(boundary.cells),
+			}, now)
+			if err != nil {
+				return err
+			}
+			if err := s.hexRepository.ReplaceRegionHexes(ctx, region.ID, boundary.cells); err != nil {
+				return err
+			}
+			regions = append(regions, region)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.GetRegionsResponse{Regions: make([]dto.RegionResponse, 0, len(regions))}
+	for _, region := range regions {
+		refreshed, err := s.refreshStandings(ctx, region, now)
+		if err != nil {
+			return nil, err
+		}
+		resp.Regions = append(resp.Regions, regionResponse(refreshed))
+		s.logger.Info("Loaded region.", zap.String("slug", region.Slug), zap.Int("hexes", region.HexCount))
+	}
+	return resp, nil
+}
+
+// LoadRegionsDir loads the regions of every .geojson and .json file in dir, in file name order,
+// and returns how many it loaded.
+func (s *RegionService) LoadRegionsDir(ctx context.Context, dir string) (int, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0, err
+	}
+	loaded := 0
+	for _, entry := range entries {
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if entry.IsDir() || (ext != ".geojson" && ext != ".json") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return loaded, err
+		}
+		var collection dto.RegionFeatureCollection
+		if err := json.Unmarshal(data, &collection); err != nil {
+			return loaded, fmt.Errorf("%w: %s: %v", ErrInvalidRegions, entry.Name(), err)
+		}
+		resp, err := s.LoadRegions(ctx, collection)
+		if err != nil {
+			return loaded, fmt.Errorf("%s: %w", entry.Name(), err)
+		}
+		loaded += len(resp.Regions)
+	}
+	return loaded, nil
+}
+
+// RefreshStandings recomputes the leaderboards of all regions.
+func (s *RegionService) RefreshStandings(ctx context.Context) error {
+	regions, err := s.repository.FindAll(ctx, "")
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, region := range regions {
+		if _, err := s.refreshStandings(ctx, region, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// refreshStandings ranks the users of a region by the hexes they lead there at now, then by their
+// total influence there. Users hiding their privacy zones from leaderboards neither lead the hexes
+// inside them nor count their influence there.
+func (s *RegionService) refreshStandings(ctx context.Context, region *ent.Region, now time.Time) (*ent.Region, error) {
+	leaderboards, err := s.hexLeaderboardRepository.FindInRegion(ctx, region.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.privacyZoneService.RedactLeaderboards(ctx, leaderboards); err != nil {
+		return nil, err
+	}
+	influences, err := s.hexInfluenceRepository.FindInRegion(ctx, region.ID)
+	if err != nil {
+		return nil, err
+	}
+	userIDs := make([]uuid.UUID, len(influences))
+	for i, influence := range influences {
+		userIDs[i] = influence.UserID
+	}
+	hidden, err := s.privacyZoneService.HiddenIn(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	tally := make(standingTally)
+	for _, leaderboard := range leaderboards {
+		if leader, ok := leaderOf(s.scoring, leaderboard, now); ok {
+			tally.of(leader.UserID).HexesOwned++
+		}
+	}
+	for _, influence := range influences {
+		if !hidden(influence.UserID, influence.H3Index) {
+			tally.of(influence.UserID).TotalInfluence += influence.Score
+		}
+	}
+
+	ranked, err := tally.rank(ctx, s.userRepository)
+	if err != nil {
+		return nil, err
+	}
+	standings := make([]*model.RegionStanding, len(ranked))
+	for i, standing := range ranked {
+		standings[i] = &model.RegionStanding{
+			RegionID:       region.ID,
+			Rank:           standing.Rank,
+			UserID:         standing.UserID,
+			Username:       standing.Username,
+			HexesOwned:     standing.HexesOwned,
+			TotalInfluence: standing.TotalInfluence,
+		}
+	}
+	err = s.transactor.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.standingRepository.ReplaceRegionStandings(ctx, region.ID, standings); err != nil {
+			return err
+		}
+		return s.repository.SetStandingsRefreshedAt(ctx, region.ID, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	refreshedAt := now.UTC()
+	region.StandingsRefreshedAt = &refreshedAt
+	return region, nil
+}
+
+// GetRegions returns all regions, or only those of the given kind when it is not empty.
+func (s *RegionService) GetRegions(ctx context.Context, kind string) (*dto.GetRegionsResponse, error) {
+	regions, err := s.repository.FindAll(ctx, kind)
+	if err != nil {
+		return nil, err
+	}
+	resp := &dto.GetRegionsResponse{Regions: make([]dto.RegionResponse, len(regions))}
+	for i, region := range regions {
+		resp.Regions[i] = regionResponse(region)
+	}
+	return resp, nil
+}
+
+// GetHexRegions returns the regions containing a hex.
+func (s *RegionService) GetHexRegions(ctx context.Context, h3Index string) (*dto.GetHexRegionsResponse, error) {
+	if err := validateH3Index(h3Index); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidHex, err)
+	}
+	regions, err := s.repository.FindByH3Index(ctx, h3Index)
+	if err != nil {
+		return nil, err
+	}
+	resp := &dto.GetHexRegionsResponse{H3Index: h3Index, Regions: make([]dto.RegionResponse, len(regions))}
+	for i, region := range regions {
+		resp.Regions[i] = regionResponse(region)
+	}
+	return resp, nil
+}
+
+// GetRegionLeaderboard returns a page of a region's standings as of their last refresh, best rank
+// first, along with the given user's own standing. A limit of 0 uses DefaultRegionLeaderboardLimit.
+func (s *RegionService) GetRegionLeaderboard(ctx context.Context, slug string, userID *uuid.UUID, limit, offset int) (*dto.GetRegionLeaderboardResponse, error) {
+	if limit == 0 {
+		limit = DefaultRegionLeaderboardLimit
+	}
+	if limit < 1 || limit > MaxRegionLeaderboardLimit || offset < 0 {
+		return nil, ErrInvalidRegionLeaderboardPage
+	}
+	region, err := s.repository.FindBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	standings, err := s.standingRepository.FindPageByRegionID(ctx, region.ID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	participants, err := s.standingRepository.CountByRegionID(ctx, region.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.GetRegionLeaderboardResponse{
+		Region:       regionResponse(region),
+		Participants: participants,
+		Standings:    make([]dto.RegionStandingResponse, len(standings)),
+	}
+	for i, standing := range standings {
+		resp.Standings[i] = regionStandingResponse(standing)
+	}
+	if next := offset + len(standings); next < participants {
+		resp.NextOffset = &next
+	}
+	if userID != nil {
+		standing, err := s.standingRepository.FindByRegionIDAndUserID(ctx, region.ID, *userID)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+		if standing != nil {
+			own := regionStandingResponse(standing)
+			resp.User = &own
+		}
+	}
+	return resp, nil
+}
+
+func regionResponse(region *ent.Region) dto.RegionResponse {
+	return dto.RegionResponse{
+		Slug:                 region.Slug,
+		Name:                 region.Name,
+		Kind:                 region.Kind,
+		HexCount:             region.HexCount,
+		StandingsRefreshedAt: region.StandingsRefreshedAt,
+	}
+}
+
+func regionStandingResponse(standing *ent.RegionStanding) dto.RegionStandingResponse {
+	return dto.RegionStandingResponse{
+		Rank:           standing.Rank,
+		UserID:         standing.UserID,
+		Username:       standing.Username,
+		HexesOwned:     standing.HexesOwned,
+		TotalInfluence: standing.TotalInfluence,
+	}
+}
+
+// regionBoundary is a region read from a GeoJSON feature, with the cells it covers.
+type regionBoundary struct {
+	slug  string
+	name  string
+	kind  string
+	cells []string
+}
+
+// parseRegions reads the regions of a FeatureCollection and maps their boundaries to the cells
+// at the default resolution whose centers lie inside them.
+func parseRegions(collection dto.RegionFeatureCollection) ([]regionBoundary, error) {
+	if collection.Type != "FeatureCollection" {
+		return nil, fmt.Errorf("%w: expected a FeatureCollection", ErrInvalidRegions)
+	}
+	if len(collection.Features) == 0 {
+		return nil, fmt.Errorf("%w: no features", ErrInvalidRegions)
+	}
+
+	boundaries := make([]regionBoundary, 0, len(collection.Features))
+	seen := make(map[string]bool)
+	for i, feature := range collection.Features {
+		name, _ := feature.Properties["name"].(string)
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return nil, fmt.Errorf("%w: feature %d has no name", ErrInvalidRegions, i)
+		}
+		slug, _ := feature.Properties["slug"].(string)
+		if slug == "" {
+			slug = name
+		}
+		slug = slugify(slug)
+		if slug == "" {
+			return nil, fmt.Errorf("%w: feature %d has no usable slug", ErrInvalidRegions, i)
+		}
+		if seen[slug] {
+			return nil, fmt.Errorf("%w: slug %q is used twice", ErrInvalidRegions, slug)
+		}
+		seen[slug] = true
+		kind, _ := feature.Properties["kind"].(string)
+
+		cells, err := geometryCells(feature.Geometry)
+		if err != nil {
+			return nil, fmt.Errorf("%w: region %q: %v", ErrInvalidRegions, slug, err)
+		}
+		if len(cells) == 0 {
+			return nil, fmt.Errorf("%w: region %q covers no cells", ErrInvalidRegions, slug)
+		}
+		if len(cells) > MaxRegionCells {
+			return nil, fmt.Errorf("%w: region %q covers %d", ErrRegionTooLarge, slug, len(cells))
+		}
+		boundaries = append(boundaries, regionBoundary{
+			slug:  slug,
+			name:  name,
+			kind:  strings.TrimSpace(kind),
+			cells: cells,
+		})
+	}
+	return boundaries, nil
+}
+
+// geometryCells returns the sorted cells covered by a Polygon or MultiPolygon geometry.
+func geometryCells(geometry dto.GeoJSONGeometry) ([]string, error) {
+	var polygons [][][][]float64
+	switch geometry.Type {
+	case "Polygon":
+		var polygon [][][]float64
+		if err := json.Unmarshal(geometry.Coordinates, &polygon); err != nil {
+			return nil, err
+		}
+		polygons = [][][][]float64{polygon}
+	case "MultiPolygon":
+		if err := json.Unmarshal(geometry.Coordinates, &polygons); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported geometry type %q", geometry.Type)
+	}
+
+	seen := make(map[h3.Cell]bool)
+	cells := make([]string, 0)
+	for _, rings := range polygons {
+		polygon, err := geoPolygon(rings)
+		if err != nil {
+			return nil, err
+		}
+		covered, err := h3.PolygonToCells(polygon, hexconsts.DefaultHexResolution)
+		if err != nil {
+			return nil, err
+		}
+		if len(seen)+len(covered) > MaxRegionCells {
+			return nil, ErrRegionTooLarge
+		}
+		for _, cell := range covered {
+			if !seen[cell] {
+				seen[cell] = true
+				cells = append(cells, cell.String())
+			}
+		}
+	}
+	sort.Strings(cells)
+	return cells, nil
+}
+
+// geoPolygon converts the rings of a GeoJSON polygon, the outer boundary first and then its holes,
+// to an h3 polygon.
+func geoPolygon(rings [][][]float64) (h3.GeoPolygon, error) {
+	if len(rings) == 0 {
+		return h3.GeoPolygon{}, errors.New("polygon has no rings")
+	}
+	loops := make([]h3.GeoLoop, len(rings))
+	for i, ring := range rings {
+		// GeoJSON rings repeat their first position at the end.
+		if len(ring) > 1 && slices.Equal(ring[0], ring[len(ring)-1]) {
+			ring = ring[:len(ring)-1]
+		}
+		if len(ring) < 3 {
+			return h3.GeoPolygon{}, errors.New("a ring needs at least 3 distinct positions")
+		}
+		loop := make(h3.GeoLoop, len(ring))
+		for j, position := range ring {
+			if len(position) < 2 {
+				return h3.GeoPolygon{}, errors.New("a position needs a longitude and a latitude")
+			}
+			lng, lat := position[0], position[1]
+			if lat < -90 || lat > 90 || lng < -180 || lng > 180 {
+				return h3.GeoPolygon{}, fmt.Errorf("position [%g, %g] is out of range", lng, lat)
+			}
+			loop[j] = h3.LatLng{Lat: lat, Lng: lng}
+		}
+		loops[i] = loop
+	}
+	return h3.GeoPolygon{GeoLoop: loops[0], Holes: loops[1:]}, nil
+}
+
+// slugify lowercases s and joins its runs of letters and digits with hyphens, so "Stare Miasto"
+// becomes "stare-miasto".
+func slugify(s string) string {
+	var b strings.Builder
+	pendingHyphen := false
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if pendingHyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			pendingHyphen = false
+			b.WriteRune(r)
+		} else {
+			pendingHyphen = true
+		}
+	}
+	return b.String()
+}
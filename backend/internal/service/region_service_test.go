@@ -0,0 +1,312 @@
+package service_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/config"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/service"
+	"stride-wars-app/internal/testutil"
+)
+
+// regionFeature returns a rectangular region spanning the centers of the given cells.
+func regionFeature(t *testing.T, name, kind string, h3Indexes ...string) dto.RegionFeature {
+	t.Helper()
+	center := cellCenter(t, h3Indexes[0], time.Now())
+	minLat, maxLat, minLng, maxLng := center.Lat, center.Lat, center.Lng, center.Lng
+	for _, h3Index := range h3Indexes[1:] {
+		center := cellCenter(t, h3Index, time.Now())
+		minLat, maxLat = min(minLat, center.Lat), max(maxLat, center.Lat)
+		minLng, maxLng = min(minLng, center.Lng), max(maxLng, center.Lng)
+	}
+	const margin = 0.0005
+	coordinates, err := json.Marshal([][][]float64{{
+		{minLng - margin, minLat - margin},
+		{maxLng + margin, minLat - margin},
+		{maxLng + margin, maxLat + margin},
+		{minLng - margin, maxLat + margin},
+		{minLng - margin, minLat - margin},
+	}})
+	require.NoError(t, err)
+	return dto.RegionFeature{
+		Type:       "Feature",
+		Properties: map[string]any{"name": name, "kind": kind},
+		Geometry:   dto.GeoJSONGeometry{Type: "Polygon", Coordinates: coordinates},
+	}
+}
+
+func regionCollection(features ...dto.RegionFeature) dto.RegionFeatureCollection {
+	return dto.RegionFeatureCollection{Type: "FeatureCollection", Features: features}
+}
+
+func TestRegionService(t *testing.T) {
+	t.Parallel()
+
+	newRegionService := func(tdb *testutil.TestServices) *service.RegionService {
+		cfg := config.Default()
+		return service.NewRegionService(tdb.Repositories, tdb.ActivityService.PrivacyZoneService, service.NewScoringStrategy(cfg), cfg, zap.NewExample())
+	}
+
+	// setup gives alice the lead in the first two hexes and bob in the third, with bob also
+	// holding some influence in the first.
+	setup := func(t *testing.T, tdb *testutil.TestServices) (alice, bob *ent.User, hexes []string) {
+		ctx := tdb.Ctx
+		var err error
+		alice, err = tdb.UserRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		bob, err = tdb.UserRepo.CreateUser(ctx, &model.User{Username: "bob", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		hexes = []string{"891e2e6b153ffff", "891e2e6b103ffff", "891e2e6b15bffff"}
+		require.NoError(t, tdb.HexService.CreateMissingHexes(ctx, hexes))
+		influence := func(user *ent.User, hexID string, score float64) {
+			_, err := tdb.HexInfluenceRepo.CreateHexInfluence(ctx, &model.HexInfluence{
+				UserID:      user.ID,
+				H3Index:     hexID,
+				Score:       score,
+				LastUpdated: time.Now(),
+			})
+			require.NoError(t, err)
+		}
+		influence(alice, hexes[0], 10)
+		influence(bob, hexes[0], 4)
+		influence(alice, hexes[1], 6)
+		influence(bob, hexes[2], 30)
+		for _, hexID := range hexes {
+			require.NoError(t, tdb.HexLeaderboardService.RebuildLeaderboard(ctx, hexID))
+		}
+		return alice, bob, hexes
+	}
+
+	// ------------------------
+	// Subtest: Load_RanksRegionsAndFindsHexRegions
+	// ------------------------
+	t.Run("Load_RanksRegionsAndFindsHexRegions", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+		regions := newRegionService(tdb)
+		alice, bob, hexes := setup(t, tdb)
+
+		loaded, err := regions.LoadRegions(ctx, regionCollection(
+			regionFeature(t, "Kraków", "city", hexes...),
+			regionFeature(t, "Stare Miasto", "district", hexes[0]),
+		))
+		require.NoError(t, err)
+		require.Len(t, loaded.Regions, 2)
+		require.Equal(t, "kraków", loaded.Regions[0].Slug)
+		require.Equal(t, "stare-miasto", loaded.Regions[1].Slug)
+		require.Equal(t, 1, loaded.Regions[1].HexCount)
+		require.NotNil(t, loaded.Regions[1].StandingsRefreshedAt)
+
+		// Alice leads two of the city's hexes, bob one with more influence.
+		board, err := regions.GetRegionLeaderboard(ctx, "kraków", &bob.ID, 1, 0)
+		require.NoError(t, err)
+		require.Equal(t, 2, board.Participants)
+		require.Len(t, board.Standings, 1)
+		require.Equal(t, alice.ID, board.Standings[0].UserID)
+		require.Equal(t, 2, board.Standings[0].HexesOwned)
+		require.Equal(t, 16.0, board.Standings[0].TotalInfluence)
+		require.NotNil(t, board.NextOffset)
+		require.Equal(t, 1, *board.NextOffset)
+		require.NotNil(t, board.User)
+		require.Equal(t, 2, board.User.Rank)
+		require.Equal(t, 1, board.User.HexesOwned)
+		require.Equal(t, 34.0, board.User.TotalInfluence)
+
+		// Bob leads nothing in the district but still places on influence.
+		board, err = regions.GetRegionLeaderboard(ctx, "stare-miasto", nil, 0, 0)
+		require.NoError(t, err)
+		require.Len(t, board.Standings, 2)
+		require.Equal(t, alice.ID, board.Standings[0].UserID)
+		require.Equal(t, bob.ID, board.Standings[1].UserID)
+		require.Equal(t, 0, board.Standings[1].HexesOwned)
+		require.Equal(t, 4.0, board.Standings[1].TotalInfluence)
+		require.Nil(t, board.NextOffset)
+		require.Nil(t, board.User)
+
+		hexRegions, err := regions.GetHexRegions(ctx, hexes[0])
+		require.NoError(t, err)
+		require.Len(t, hexRegions.Regions, 2)
+		hexRegions, err = regions.GetHexRegions(ctx, hexes[2])
+		require.NoError(t, err)
+		require.Len(t, hexRegions.Regions, 1)
+		require.Equal(t, "kraków", hexRegions.Regions[0].Slug)
+
+		districts, err := regions.GetRegions(ctx, "district")
+		require.NoError(t, err)
+		require.Len(t, districts.Regions, 1)
+
+		_, err = regions.GetRegionLeaderboard(ctx, "mokotów", nil, 0, 0)
+		require.True(t, ent.IsNotFound(err))
+		_, err = regions.GetRegionLeaderboard(ctx, "kraków", nil, 500, 0)
+		require.ErrorIs(t, err, service.ErrInvalidRegionLeaderboardPage)
+		_, err = regions.GetHexRegions(ctx, "not-a-hex")
+		require.ErrorIs(t, err, service.ErrInvalidHex)
+	})
+
+	// ------------------------
+	// Subtest: Standings_SkipHiddenCells
+	// ------------------------
+	t.Run("Standings_SkipHiddenCells", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+		regions := newRegionService(tdb)
+		alice, bob, hexes := setup(t, tdb)
+
+		// Bob hides the hex he leads, which neither counts as led nor adds to his influence.
+		privacy := tdb.ActivityService.PrivacyZoneService
+		_, err := privacy.CreateZone(ctx, dto.PrivacyZoneRequest{UserID: bob.ID, H3Indexes: hexes[2:3]})
+		require.NoError(t, err)
+		_, err = privacy.UpdateSettings(ctx, dto.PrivacySettingsRequest{UserID: bob.ID, HideZoneLeaderboards: true})
+		require.NoError(t, err)
+
+		_, err = regions.LoadRegions(ctx, regionCollection(regionFeature(t, "Kraków", "city", hexes...)))
+		require.NoError(t, err)
+		board, err := regions.GetRegionLeaderboard(ctx, "kraków", nil, 0, 0)
+		require.NoError(t, err)
+		require.Len(t, board.Standings, 2)
+		require.Equal(t, alice.ID, board.Standings[0].UserID)
+		require.Equal(t, 16.0, board.Standings[0].TotalInfluence)
+		require.Equal(t, bob.ID, board.Standings[1].UserID)
+		require.Equal(t, 0, board.Standings[1].HexesOwned)
+		require.Equal(t, 4.0, board.Standings[1].TotalInfluence)
+	})
+
+	// ------------------------
+	// Subtest: Reload_ReplacesBoundaryAndRefreshes
+	// ------------------------
+	t.Run("Reload_ReplacesBoundaryAndRefreshes", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+		regions := newRegionService(tdb)
+		alice, bob, hexes := setup(t, tdb)
+
+		district := regionFeature(t, "Stare Miasto", "district", hexes[0])
+		_, err := regions.LoadRegions(ctx, regionCollection(district))
+		require.NoError(t, err)
+
+		// The district is moved onto bob's hex under the same slug.
+		moved := regionFeature(t, "Old Town", "district", hexes[2])
+		moved.Properties["slug"] = "stare-miasto"
+		_, err = regions.LoadRegions(ctx, regionCollection(moved))
+		require.NoError(t, err)
+
+		all, err := regions.GetRegions(ctx, "")
+		require.NoError(t, err)
+		require.Len(t, all.Regions, 1)
+		require.Equal(t, "Old Town", all.Regions[0].Name)
+
+		board, err := regions.GetRegionLeaderboard(ctx, "stare-miasto", &alice.ID, 0, 0)
+		require.NoError(t, err)
+		require.Len(t, board.Standings, 1)
+		require.Equal(t, bob.ID, board.Standings[0].UserID)
+		require.Nil(t, board.User)
+
+		hexRegions, err := regions.GetHexRegions(ctx, hexes[0])
+		require.NoError(t, err)
+		require.Empty(t, hexRegions.Regions)
+
+		// A new lead shows up on the next refresh.
+		_, err = tdb.HexInfluenceRepo.CreateHexInfluence(ctx, &model.HexInfluence{
+			UserID:      alice.ID,
+			H3Index:     hexes[2],
+			Score:       50,
+			LastUpdated: time.Now(),
+		})
+		require.NoError(t, err)
+		require.NoError(t, tdb.HexLeaderboardService.RebuildLeaderboard(ctx, hexes[2]))
+		require.NoError(t, regions.RefreshStandings(ctx))
+
+		board, err = regions.GetRegionLeaderboard(ctx, "stare-miasto", nil, 0, 0)
+		require.NoError(t, err)
+		require.Len(t, board.Standings, 2)
+		require.Equal(t, alice.ID, board.Standings[0].UserID)
+		require.Equal(t, 1, board.Standings[0].HexesOwned)
+	})
+
+	// ------------------------
+	// Subtest: LoadDir_ReadsGeoJSONFiles
+	// ------------------------
+	t.Run("LoadDir_ReadsGeoJSONFiles", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		regions := newRegionService(tdb)
+
+		dir := t.TempDir()
+		data, err := json.Marshal(regionCollection(
+			regionFeature(t, "Kraków", "city", "891e2e6b153ffff", "891e2e6b15bffff"),
+		))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "krakow.geojson"), data, 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "README.txt"), []byte("not a region"), 0o600))
+
+		loaded, err := regions.LoadRegionsDir(tdb.Ctx, dir)
+		require.NoError(t, err)
+		require.Equal(t, 1, loaded)
+	})
+
+	// ------------------------
+	// Subtest: Load_RejectsInvalidBoundaries
+	// ------------------------
+	t.Run("Load_RejectsInvalidBoundaries", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+		regions := newRegionService(tdb)
+
+		valid := regionFeature(t, "Kraków", "city", "891e2e6b153ffff")
+		unnamed := regionFeature(t, "", "city", "891e2e6b153ffff")
+		line := regionFeature(t, "Wisła", "river", "891e2e6b153ffff")
+		line.Geometry = dto.GeoJSONGeometry{Type: "LineString", Coordinates: json.RawMessage(`[[19.9, 50.0], [19.95, 50.05]]`)}
+		degenerate := regionFeature(t, "Rynek", "square", "891e2e6b153ffff")
+		degenerate.Geometry.Coordinates = json.RawMessage(`[[[19.9, 50.0], [19.95, 50.05], [19.9, 50.0]]]`)
+
+		for _, collection := range []dto.RegionFeatureCollection{
+			{Type: "Feature"},
+			regionCollection(),
+			regionCollection(unnamed),
+			regionCollection(line),
+			regionCollection(degenerate),
+			regionCollection(valid, valid),
+		} {
+			_, err := regions.LoadRegions(ctx, collection)
+			require.ErrorIs(t, err, service.ErrInvalidRegions)
+		}
+
+		// Nothing is stored when one of the features is invalid.
+		_, err := regions.LoadRegions(ctx, regionCollection(valid, line))
+		require.ErrorIs(t, err, service.ErrInvalidRegions)
+		all, err := regions.GetRegions(ctx, "")
+		require.NoError(t, err)
+		require.Empty(t, all.Regions)
+	})
+
+	// ------------------------
+	// Subtest: AuthorizeAdmin
+	// ------------------------
+	t.Run("AuthorizeAdmin", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+
+		require.ErrorIs(t, newRegionService(tdb).AuthorizeAdmin(""), service.ErrRegionUploadsDisabled)
+
+		cfg := config.Default()
+		cfg.AdminToken = "secret"
+		regions := service.NewRegionService(tdb.Repositories, tdb.ActivityService.PrivacyZoneService, service.NewScoringStrategy(cfg), cfg, zap.NewExample())
+		require.ErrorIs(t, regions.AuthorizeAdmin("guess"), service.ErrInvalidAdminToken)
+		require.NoError(t, regions.AuthorizeAdmin("secret"))
+	})
+}
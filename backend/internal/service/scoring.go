@@ -0,0 +1,173 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"stride-wars-app/internal/config"
+	"stride-wars-app/internal/repository"
+	"time"
+)
+
+// DecayRatePerWeek is the fraction by which the linear strategy decays a score each week.
+const DecayRatePerWeek = 0.1
+
+// HoursPerWeek is the total number of hours in one week.
+const HoursPerWeek = 24.0 * 7.0
+
+// ScoringStrategy decides how a user's influence in a hex fades while they stay away and how
+// much each visit adds to it. Its SQL expressions must agree with Decay and Visit, since visits
+// are scored by the database while the readers decay scores in Go.
+type ScoringStrategy interface {
+	repository.ScoreSQL
+	// Decay returns score after elapsed time without a visit.
+	Decay(score float64, elapsed time.Duration) float64
+	// Visit returns score after one more visit.
+	Visit(score float64) float64
+}
+
+// sqlFloat formats a constant for a SQL expression.
+func sqlFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// addVisitsSQL is VisitsSQL for strategies whose every visit adds 1.
+func addVisitsSQL(score, visits string) string {
+	return fmt.Sprintf("(%s + %s)", score, visits)
+}
+
+// NewScoringStrategy returns the strategy selected in the configuration, linear if none is.
+func NewScoringStrategy(cfg config.Config) ScoringStrategy {
+	switch cfg.ScoringStrategy {
+	case config.ScoringExponential:
+		return ExponentialDecay{HalfLife: cfg.ScoringHalfLife}
+	case config.ScoringLogarithmic:
+		return LogarithmicDecay{Rate: 1}
+	case config.ScoringCapped:
+		return CappedScore{Strategy: LinearDecay{RatePerWeek: DecayRatePerWeek}, Max: cfg.ScoringCap}
+	default:
+		return LinearDecay{RatePerWeek: DecayRatePerWeek}
+	}
+}
+
+// LinearDecay takes RatePerWeek off the score for every week without a visit. The multiplier is
+// rounded to one decimal and never drops below 0.1, so a score never fully resets.
+type LinearDecay struct {
+	RatePerWeek float64
+}
+
+func (s LinearDecay) Decay(score float64, elapsed time.Duration) float64 {
+	// Calculate how much to multiply the old score by, based on hours elapsed:
+	multiplier := 1 - s.RatePerWeek*(elapsed.Hours()/HoursPerWeek)
+	// Round to one decimal place:
+	multiplier = math.Round(multiplier*10) / 10
+	if multiplier < 0 {
+		multiplier = 0.1
+	}
+	return score * multiplier
+}
+
+func (LinearDecay) Visit(score float64) float64 {
+	return score + 1
+}
+
+func (s LinearDecay) DecaySQL(score, elapsedHours string) string {
+	// The multiplier is rounded as a numeric so that Postgres, like Go, rounds halves away from 0.
+	multiplier := fmt.Sprintf("(ROUND(CAST((1 - %s * %s / %s) * 10 AS NUMERIC)) / 10)", sqlFloat(s.RatePerWeek), elapsedHours, sqlFloat(HoursPerWeek))
+	return fmt.Sprintf("(%s * CASE WHEN %s < 0 THEN 0.1 ELSE %s END)", score, multiplier, multiplier)
+}
+
+func (LinearDecay) VisitsSQL(score, visits string) string {
+	return addVisitsSQL(score, visits)
+}
+
+// ExponentialDecay halves the score every HalfLife without a visit.
+type ExponentialDecay struct {
+	HalfLife time.Duration
+}
+
+func (s ExponentialDecay) Decay(score float64, elapsed time.Duration) float64 {
+	if s.HalfLife <= 0 {
+		return score
+	}
+	return score * math.Exp2(-float64(elapsed)/float64(s.HalfLife))
+}
+
+func (ExponentialDecay) Visit(score float64) float64 {
+	return score + 1
+}
+
+func (s ExponentialDecay) DecaySQL(score, elapsedHours string) string {
+	if s.HalfLife <= 0 {
+		return score
+	}
+	return fmt.Sprintf("(%s * POWER(2.0, -%s / %s))", score, elapsedHours, sqlFloat(s.HalfLife.Hours()))
+}
+
+func (ExponentialDecay) VisitsSQL(score, visits string) string {
+	return addVisitsSQL(score, visits)
+}
+
+// LogarithmicDecay divides the score by 1 + Rate·ln(1 + weeks without a visit). It bites hardest
+// in the first weeks and then flattens out, so long-time owners fade slowly.
+type LogarithmicDecay struct {
+	Rate float64
+}
+
+func (s LogarithmicDecay) Decay(score float64, elapsed time.Duration) float64 {
+	weeks := math.Max(elapsed.Hours()/HoursPerWeek, 0)
+	return score / (1 + s.Rate*math.Log1p(weeks))
+}
+
+func (LogarithmicDecay) Visit(score float64) float64 {
+	return score + 1
+}
+
+func (s LogarithmicDecay) DecaySQL(score, elapsedHours string) string {
+	weeks := fmt.Sprintf("(%s / %s)", elapsedHours, sqlFloat(HoursPerWeek))
+	return fmt.Sprintf("(%s / (1 + %s * LN(1 + CASE WHEN %s > 0 THEN %s ELSE 0 END)))", score, sqlFloat(s.Rate), weeks, weeks)
+}
+
+func (LogarithmicDecay) VisitsSQL(score, visits string) string {
+	return addVisitsSQL(score, visits)
+}
+
+// CappedScore decays and grows like Strategy but never lets a visit raise the score above Max,
+// so grinding a single hex stops paying off.
+type CappedScore struct {
+	Strategy ScoringStrategy
+	Max      float64
+}
+
+func (s CappedScore) Decay(score float64, elapsed time.Duration) float64 {
+	return s.Strategy.Decay(score, elapsed)
+}
+
+func (s CappedScore) Visit(score float64) float64 {
+	return math.Min(s.Strategy.Visit(score), math.Max(score, s.Max))
+}
+
+func (s CappedScore) DecaySQL(score, elapsedHours string) string {
+	return s.Strategy.DecaySQL(score, elapsedHours)
+}
+
+// VisitsSQL clamps the visits of Strategy once, which matches clamping each of them as long as
+// every visit raises the score.
+func (s CappedScore) VisitsSQL(score, visits string) string {
+	limit := fmt.Sprintf("(CASE WHEN %s > %s THEN %s ELSE %s END)", score, sqlFloat(s.Max), score, sqlFloat(s.Max))
+	raised := s.Strategy.VisitsSQL(score, visits)
+	return fmt.Sprintf("(CASE WHEN %s < %s THEN %s ELSE %s END)", raised, limit, raised, limit)
+}
+
+// applyVisits returns the score and last update time after visits visits at the given time. The
+// score decays first if the visits are newer than the last update; older visits only add to it.
+func applyVisits(strategy ScoringStrategy, score float64, lastUpdated time.Time, visits int, at time.Time) (float64, time.Time) {
+	if at.After(lastUpdated) {
+		score = strategy.Decay(score, at.Sub(lastUpdated))
+		lastUpdated = at
+	}
+	for i := 0; i < visits; i++ {
+		score = strategy.Visit(score)
+	}
+	return score, lastUpdated
+}
@@ -0,0 +1,185 @@
+package service_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/config"
+	"stride-wars-app/internal/service"
+	"stride-wars-app/internal/testutil"
+)
+
+func TestScoringStrategy(t *testing.T) {
+	t.Parallel()
+
+	const day = 24 * time.Hour
+
+	// ------------------------
+	// Subtest: Linear
+	// ------------------------
+	t.Run("Linear", func(t *testing.T) {
+		t.Parallel()
+		s := service.LinearDecay{RatePerWeek: service.DecayRatePerWeek}
+
+		// 15 days is ~0.214 of a weekly rate, rounded to a multiplier of 0.8.
+		require.InDelta(t, 8.0, s.Decay(10, 15*day), 1e-9)
+		require.InDelta(t, 10.0, s.Decay(10, 0), 1e-9)
+		// After ten weeks the multiplier would go negative and is clamped.
+		require.InDelta(t, 1.0, s.Decay(10, 11*7*day), 1e-9)
+		require.Equal(t, 11.0, s.Visit(10))
+	})
+
+	// ------------------------
+	// Subtest: Exponential
+	// ------------------------
+	t.Run("Exponential", func(t *testing.T) {
+		t.Parallel()
+		s := service.ExponentialDecay{HalfLife: 14 * day}
+
+		require.InDelta(t, 5.0, s.Decay(10, 14*day), 1e-9)
+		require.InDelta(t, 2.5, s.Decay(10, 28*day), 1e-9)
+		require.InDelta(t, 10*math.Exp2(-0.5), s.Decay(10, 7*day), 1e-9)
+		require.Equal(t, 11.0, s.Visit(10))
+	})
+
+	// ------------------------
+	// Subtest: Logarithmic
+	// ------------------------
+	t.Run("Logarithmic", func(t *testing.T) {
+		t.Parallel()
+		s := service.LogarithmicDecay{Rate: 1}
+
+		require.InDelta(t, 10/(1+math.Log(2)), s.Decay(10, 7*day), 1e-9)
+		require.InDelta(t, 10/(1+math.Log(5)), s.Decay(10, 28*day), 1e-9)
+		require.InDelta(t, 10.0, s.Decay(10, 0), 1e-9)
+		require.Equal(t, 11.0, s.Visit(10))
+	})
+
+	// ------------------------
+	// Subtest: Capped
+	// ------------------------
+	t.Run("Capped", func(t *testing.T) {
+		t.Parallel()
+		s := service.CappedScore{Strategy: service.LinearDecay{RatePerWeek: service.DecayRatePerWeek}, Max: 3}
+
+		require.Equal(t, 3.0, s.Visit(2))
+		require.Equal(t, 3.0, s.Visit(2.5))
+		require.Equal(t, 3.0, s.Visit(3))
+		// A score already above the cap, e.g. from before it was lowered, is not cut by a visit.
+		require.Equal(t, 5.0, s.Visit(5))
+		require.InDelta(t, 4.0, s.Decay(5, 15*day), 1e-9)
+	})
+
+	// ------------------------
+	// Subtest: NewScoringStrategy
+	// ------------------------
+	t.Run("NewScoringStrategy", func(t *testing.T) {
+		t.Parallel()
+		cfg := config.Default()
+		require.IsType(t, service.LinearDecay{}, service.NewScoringStrategy(cfg))
+
+		cfg.ScoringStrategy = config.ScoringExponential
+		require.Equal(t, service.ExponentialDecay{HalfLife: cfg.ScoringHalfLife}, service.NewScoringStrategy(cfg))
+
+		cfg.ScoringStrategy = config.ScoringLogarithmic
+		require.IsType(t, service.LogarithmicDecay{}, service.NewScoringStrategy(cfg))
+
+		cfg.ScoringStrategy = config.ScoringCapped
+		capped, ok := service.NewScoringStrategy(cfg).(service.CappedScore)
+		require.True(t, ok)
+		require.Equal(t, cfg.ScoringCap, capped.Max)
+	})
+
+	// ------------------------
+	// Subtest: RecordVisits
+	// ------------------------
+	t.Run("RecordVisits", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+
+		visited := validH3Indexes[0]
+		fresh := validH3Indexes[1]
+		require.NoError(t, tdb.HexService.CreateMissingHexes(ctx, []string{visited, fresh}))
+		user, err := tdb.UserRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		lastUpdated := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+		_, err = tdb.HexInfluenceRepo.CreateHexInfluence(ctx, &model.HexInfluence{
+			UserID:      user.ID,
+			H3Index:     visited,
+			Score:       10,
+			LastUpdated: lastUpdated,
+		})
+		require.NoError(t, err)
+
+		// The visited hex appears twice, so it is visited twice after decaying once.
+		at := lastUpdated.Add(15 * day)
+		influences, err := tdb.HexInfluenceService.RecordVisitsAt(ctx, user.ID, nil, []string{visited, fresh, visited}, at)
+		require.NoError(t, err)
+		require.Len(t, influences, 2)
+		for _, influence := range influences {
+			require.True(t, at.Equal(influence.LastUpdated))
+			if influence.H3Index == visited {
+				require.InDelta(t, 10.0, influence.Score, 1e-9)
+			} else {
+				require.Equal(t, 1.0, influence.Score)
+			}
+		}
+
+		// A visit older than the last update adds to the score without decaying it.
+		_, err = tdb.HexInfluenceService.RecordVisitsAt(ctx, user.ID, nil, []string{visited}, lastUpdated)
+		require.NoError(t, err)
+		influence, err := tdb.HexInfluenceService.FindByUserIDAndHexID(ctx, user.ID, visited)
+		require.NoError(t, err)
+		require.InDelta(t, 11.0, influence.Score, 1e-9)
+		require.True(t, at.Equal(influence.LastUpdated))
+	})
+	// ------------------------
+	// Subtest: RecordVisitsInSQL
+	// ------------------------
+	t.Run("RecordVisitsInSQL", func(t *testing.T) {
+		t.Parallel()
+
+		strategies := map[string]service.ScoringStrategy{
+			"linear":      service.LinearDecay{RatePerWeek: service.DecayRatePerWeek},
+			"exponential": service.ExponentialDecay{HalfLife: 14 * day},
+			"logarithmic": service.LogarithmicDecay{Rate: 1},
+			"capped":      service.CappedScore{Strategy: service.LinearDecay{RatePerWeek: service.DecayRatePerWeek}, Max: 12},
+		}
+		for name, strategy := range strategies {
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+				tdb := testutil.NewTestServices(t)
+				ctx := tdb.Ctx
+				influenceService := service.NewHexInfluenceService(tdb.HexInfluenceRepo, tdb.Repositories.InfluenceHistoryRepository, strategy, zap.NewExample())
+
+				hexID := validH3Indexes[0]
+				require.NoError(t, tdb.HexService.CreateMissingHexes(ctx, []string{hexID}))
+				user, err := tdb.UserRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+				require.NoError(t, err)
+
+				// Fresh influences are created at the strategy's score, existing ones are decayed
+				// and raised by the database exactly as the strategy would in Go.
+				at := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+				want := strategy.Visit(strategy.Visit(0))
+				_, err = influenceService.RecordVisitsAt(ctx, user.ID, nil, []string{hexID, hexID}, at)
+				require.NoError(t, err)
+				for _, elapsed := range []time.Duration{10 * day, 3*day + 12*time.Hour, 40 * day} {
+					at = at.Add(elapsed)
+					want = strategy.Visit(strategy.Visit(strategy.Visit(strategy.Decay(want, elapsed))))
+					influences, err := influenceService.RecordVisitsAt(ctx, user.ID, nil, []string{hexID, hexID, hexID}, at)
+					require.NoError(t, err)
+					require.Len(t, influences, 1)
+					require.InDelta(t, want, influences[0].Score, 1e-9)
+				}
+			})
+		}
+	})
+}
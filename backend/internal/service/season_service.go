@@ -0,0 +1,399 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/config"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultSeasonStandingsLimit = 50
+	MaxSeasonStandingsLimit     = 200
+)
+
+var (
+	ErrSeasonNotArchived         = errors.New("season has not ended yet")
+	ErrInvalidSeasonStandingPage = fmt.Errorf("limit must be between 1 and %d and offset must not be negative", MaxSeasonStandingsLimit)
+)
+
+// SeasonService starts and ends the competitive seasons. The live hex influences and leaderboards
+// always belong to the active season. When it ends, its final global and per-hex standings are
+// archived and the live scores are reset, or scaled down by the season's carry over fraction,
+// before the next season starts.
+type SeasonService struct {
+	repository               repository.SeasonRepository
+	standingRepository       repository.SeasonStandingRepository
+	hexStandingRepository    repository.SeasonHexStandingRepository
+	hexInfluenceRepository   repository.HexInfluenceRepository
+	hexLeaderboardRepository repository.HexLeaderboardRepository
+	hexRollupRepository      repository.HexRollupRepository
+	userRepository           repository.UserRepository
+	transactor               repository.Transactor
+	hexLeaderboardService    *HexLeaderboardService
+	privacyZoneService       *PrivacyZoneService
+	start                    time.Time
+	length                   time.Duration
+	carryOver                float64
+	logger                   *zap.Logger
+}
+
+func NewSeasonService(repositories *repository.Repositories, hexLeaderboardService *HexLeaderboardService, privacyZoneService *PrivacyZoneService, cfg config.Config, logger *zap.Logger) *SeasonService {
+	return &SeasonService{
+		repository:               repositories.SeasonRepository,
+		standingRepository:       repositories.SeasonStandingRepository,
+		hexStandingRepository:    repositories.SeasonHexStandingRepository,
+		hexInfluenceRepository:   repositories.HexInfluenceRepository,
+		hexLeaderboardRepository: repositories.HexLeaderboardRepository,
+		hexRollupRepository:      repositories.HexRollupRepository,
+		userRepository:           repositories.UserRepository,
+		transactor:               repositories.Transactor,
+		hexLeaderboardService:    hexLeaderboardService,
+		privacyZoneService:       privacyZoneService,
+		start:                    cfg.SeasonStart,
+		length:                   cfg.SeasonLength,
+		carryOver:                cfg.SeasonCarryOver,
+		logger:                   logger,
+	}
+}
+
+// RolloverIfDue starts the first season once SeasonStart has passed, or archives the active
+// season once it has ended and starts the one in progress now. It returns the season it started,
+// or nil when none was due. Seasons skipped while the server was down are never played.
+func (s *SeasonService) RolloverIfDue(ctx context.Context, now time.Time) (*ent.Season, error) {
+	if s.start.IsZero() || now.Before(s.start) {
+		return nil, nil
+	}
+
+	var started *ent.Season
+	err := s.transactor.WithRetry(ctx, func(ctx context.Context) error {
+		started = nil
+		active, err := s.repository.FindActiveForUpdate(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+		number := s.numberAt(now)
+		if active != nil {
+			if now.Before(active.EndsAt) {
+				return nil
+			}
+			if err := s.archive(ctx, active, now); err != nil {
+				return err
+			}
+			number = max(number, active.Number+1)
+		} else if latest, err := s.repository.FindLatest(ctx); err == nil {
+			number = max(number, latest.Number+1)
+		} else if !ent.IsNotFound(err) {
+			return err
+		}
+
+		startsAt := s.start.Add(time.Duration(number-1) * s.length)
+		started, err = s.repository.CreateSeason(ctx, &model.Season{
+			Number:    number,
+			Name:      fmt.Sprintf("Season %d", number),
+			StartsAt:  startsAt,
+			EndsAt:    startsAt.Add(s.length),
+			CarryOver: s.carryOver,
+		})
+		// Another instance started the season first, the retry finds it active.
+		return repository.InsertRace(err)
+	})
+	if err != nil || started == nil {
+		return nil, err
+	}
+	s.logger.Info("Started season.", zap.Int("number", started.Number), zap.Time("endsAt", started.EndsAt))
+	return started, nil
+}
+
+// numberAt returns the number of the season in progress at t, counting from 1 at SeasonStart.
+func (s *SeasonService) numberAt(t time.Time) int {
+	return int(t.Sub(s.start)/s.length) + 1
+}
+
+// archive stores the final standings of season, ranked by the effective scores of the live
+// influences at its end, and resets or carries over the live scores for the next season.
+func (s *SeasonService) archive(ctx context.Context, season *ent.Season, now time.Time) error {
+	leaderboards, err := s.hexLeaderboardRepository.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+	influences, err := s.hexInfluenceRepository.FindAllWithUsers(ctx)
+	if err != nil {
+		return err
+	}
+	s.hexLeaderboardService.currentTopUsers(leaderboards, influences, season.EndsAt)
+
+	tally := make(standingTally)
+	hexStandings := make([]*model.SeasonHexStanding, 0)
+	for _, leaderboard := range leaderboards {
+		leaderboard.TopUsers = s.hexLeaderboardService.top(leaderboard.TopUsers)
+		for i, topUser := range leaderboard.TopUsers {
+			hexStandings = append(hexStandings, &model.SeasonHexStanding{
+				SeasonID: season.ID,
+				H3Index:  leaderboard.H3Index,
+				Rank:     i + 1,
+				UserID:   topUser.UserID,
+				Username: topUser.UserName,
+				Score:    s.hexLeaderboardService.EffectiveScore(topUser, season.EndsAt),
+			})
+		}
+		if len(leaderboard.TopUsers) != 0 {
+			tally.of(leaderboard.TopUsers[0].UserID).HexesOwned++
+		}
+	}
+	for _, influence := range influences {
+		tally.of(influence.UserID).TotalInfluence += s.hexLeaderboardService.EffectiveScore(topUserOf(influence, ""), season.EndsAt)
+	}
+
+	ranked, err := tally.rank(ctx, s.userRepository)
+	if err != nil {
+		return err
+	}
+	standings := make([]*model.SeasonStanding, len(ranked))
+	for i, standing := range ranked {
+		standings[i] = &model.SeasonStanding{
+			SeasonID:       season.ID,
+			Rank:           standing.Rank,
+			UserID:         standing.UserID,
+			Username:       standing.Username,
+			HexesOwned:     standing.HexesOwned,
+			TotalInfluence: standing.TotalInfluence,
+		}
+	}
+	if err := s.standingRepository.CreateSeasonStandings(ctx, standings); err != nil {
+		return err
+	}
+	if err := s.hexStandingRepository.CreateSeasonHexStandings(ctx, hexStandings); err != nil {
+		return err
+	}
+	if err := s.carryOverScores(ctx, leaderboards, season.CarryOver); err != nil {
+		return err
+	}
+	if _, err := s.repository.ArchiveSeason(ctx, season.ID, now); err != nil {
+		return err
+	}
+
+	s.logger.Info("Archived season.",
+		zap.Int("number", season.Number),
+		zap.Int("participants", len(standings)),
+		zap.Int("hexes", len(leaderboards)),
+		zap.Float64("carryOver", season.CarryOver))
+	return nil
+}
+
+// carryOverScores keeps the given fraction of every live score into the next season. A fraction
+// of 0 deletes all influences, leaderboards and rollups instead. The scores keep their last update
+// time, so the carried over influence goes on decaying as before and every hex keeps its leader.
+// The influences remember what they were carried over with, which replays of the next season
+// start from.
+func (s *SeasonService) carryOverScores(ctx context.Context, leaderboards []*ent.HexLeaderboard, fraction float64) error {
+	if fraction <= 0 {
+		if _, err := s.hexRollupRepository.DeleteAll(ctx); err != nil {
+			return err
+		}
+		if _, err := s.hexLeaderboardRepository.DeleteAll(ctx); err != nil {
+			return err
+		}
+		_, err := s.hexInfluenceRepository.DeleteAll(ctx)
+		return err
+	}
+
+	fraction = min(fraction, 1)
+	if _, err := s.hexInfluenceRepository.CarryOverScores(ctx, fraction); err != nil {
+		return err
+	}
+	scaled := make([]*model.HexLeaderboard, 0, len(leaderboards))
+	for _, leaderboard := range leaderboards {
+		topUsers := make([]model.TopUser, len(leaderboard.TopUsers))
+		for i, topUser := range leaderboard.TopUsers {
+			topUser.Score *= fraction
+			topUsers[i] = topUser
+		}
+		scaled = append(scaled, &model.HexLeaderboard{H3Index: leaderboard.H3Index, TopUsers: topUsers})
+	}
+	return s.hexLeaderboardRepository.UpsertHexLeaderboards(ctx, scaled)
+}
+
+// GetSeasons returns all seasons, the latest first.
+func (s *SeasonService) GetSeasons(ctx context.Context) (*dto.GetSeasonsResponse, error) {
+	seasons, err := s.repository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &dto.GetSeasonsResponse{Seasons: make([]dto.SeasonResponse, len(seasons))}
+	for i, season := range seasons {
+		resp.Seasons[i] = seasonResponse(season)
+	}
+	return resp, nil
+}
+
+// GetStandings returns a page of an archived season's final global standings, best rank first.
+// A limit of 0 uses DefaultSeasonStandingsLimit.
+func (s *SeasonService) GetStandings(ctx context.Context, number int, limit, offset int) (*dto.GetSeasonStandingsResponse, error) {
+	if limit == 0 {
+		limit = DefaultSeasonStandingsLimit
+	}
+	if limit < 1 || limit > MaxSeasonStandingsLimit || offset < 0 {
+		return nil, ErrInvalidSeasonStandingPage
+	}
+	season, err := s.archivedSeason(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+
+	standings, err := s.standingRepository.FindPageBySeasonID(ctx, season.ID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	participants, err := s.standingRepository.CountBySeasonID(ctx, season.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.GetSeasonStandingsResponse{
+		Season:       seasonResponse(season),
+		Participants: participants,
+		Standings:    make([]dto.SeasonStandingResponse, len(standings)),
+	}
+	for i, standing := range standings {
+		resp.Standings[i] = dto.SeasonStandingResponse{
+			Rank:           standing.Rank,
+			UserID:         standing.UserID,
+			Username:       standing.Username,
+			HexesOwned:     standing.HexesOwned,
+			TotalInfluence: standing.TotalInfluence,
+		}
+	}
+	if next := offset + len(standings); next < participants {
+		resp.NextOffset = &next
+	}
+	return resp, nil
+}
+
+// GetHexStandings returns a hex's final leaderboard in an archived season. Users who hide their
+// privacy zones from leaderboards are left out of the hexes inside them.
+func (s *SeasonService) GetHexStandings(ctx context.Context, number int, h3Index string) (*dto.GetSeasonHexStandingsResponse, error) {
+	if err := validateH3Index(h3Index); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidHex, err)
+	}
+	season, err := s.archivedSeason(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+
+	standings, err := s.hexStandingRepository.FindBySeasonIDAndH3Index(ctx, season.ID, h3Index)
+	if err != nil {
+		return nil, err
+	}
+	leaderboard := &ent.HexLeaderboard{H3Index: h3Index, TopUsers: make([]model.TopUser, len(standings))}
+	for i, standing := range standings {
+		leaderboard.TopUsers[i] = model.TopUser{UserID: standing.UserID, UserName: standing.Username, Score: standing.Score}
+	}
+	if err := s.privacyZoneService.RedactLeaderboards(ctx, []*ent.HexLeaderboard{leaderboard}); err != nil {
+		return nil, err
+	}
+	visible := make(map[uuid.UUID]bool, len(leaderboard.TopUsers))
+	for _, topUser := range leaderboard.TopUsers {
+		visible[topUser.UserID] = true
+	}
+
+	resp := &dto.GetSeasonHexStandingsResponse{
+		Season:    seasonResponse(season),
+		H3Index:   h3Index,
+		Standings: make([]dto.SeasonHexStandingResponse, 0, len(standings)),
+	}
+	for _, standing := range standings {
+		if !visible[standing.UserID] {
+			continue
+		}
+		resp.Standings = append(resp.Standings, dto.SeasonHexStandingResponse{
+			Rank:     standing.Rank,
+			UserID:   standing.UserID,
+			Username: standing.Username,
+			Score:    standing.Score,
+		})
+	}
+	return resp, nil
+}
+
+// GetUserSeasons returns the user's final results in every archived season they took part in,
+// the latest season first.
+func (s *SeasonService) GetUserSeasons(ctx context.Context, userID uuid.UUID) (*dto.GetUserSeasonsResponse, error) {
+	if _, err := s.userRepository.FindByID(ctx, userID); err != nil {
+		return nil, err
+	}
+	standings, err := s.standingRepository.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	seasonIDs := make([]uuid.UUID, len(standings))
+	for i, standing := range standings {
+		seasonIDs[i] = standing.SeasonID
+	}
+	seasons, err := s.repository.FindByIDs(ctx, seasonIDs)
+	if err != nil {
+		return nil, err
+	}
+	seasonsByID := make(map[uuid.UUID]*ent.Season, len(seasons))
+	for _, season := range seasons {
+		seasonsByID[season.ID] = season
+	}
+
+	resp := &dto.GetUserSeasonsResponse{UserID: userID, Seasons: make([]dto.UserSeasonResponse, 0, len(standings))}
+	for _, standing := range standings {
+		season, ok := seasonsByID[standing.SeasonID]
+		if !ok {
+			continue
+		}
+		participants, err := s.standingRepository.CountBySeasonID(ctx, season.ID)
+		if err != nil {
+			return nil, err
+		}
+		resp.Seasons = append(resp.Seasons, dto.UserSeasonResponse{
+			Season:         seasonResponse(season),
+			Rank:           standing.Rank,
+			Participants:   participants,
+			HexesOwned:     standing.HexesOwned,
+			TotalInfluence: standing.TotalInfluence,
+		})
+	}
+	sort.Slice(resp.Seasons, func(i, j int) bool {
+		return resp.Seasons[i].Season.Number > resp.Seasons[j].Season.Number
+	})
+	return resp, nil
+}
+
+// archivedSeason returns the season with the given number, or ErrSeasonNotArchived if it is
+// still being played.
+func (s *SeasonService) archivedSeason(ctx context.Context, number int) (*ent.Season, error) {
+	season, err := s.repository.FindByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	if season.ArchivedAt == nil {
+		return nil, ErrSeasonNotArchived
+	}
+	return season, nil
+}
+
+func seasonResponse(season *ent.Season) dto.SeasonResponse {
+	return dto.SeasonResponse{
+		Number:     season.Number,
+		Name:       season.Name,
+		StartsAt:   season.StartsAt,
+		EndsAt:     season.EndsAt,
+		CarryOver:  season.CarryOver,
+		Status:     season.Status.String(),
+		ArchivedAt: season.ArchivedAt,
+	}
+}
@@ -0,0 +1,262 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/config"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/service"
+	"stride-wars-app/internal/testutil"
+)
+
+func TestSeasonService(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	newSeasonService := func(tdb *testutil.TestServices, carryOver float64) *service.SeasonService {
+		cfg := config.Default()
+		cfg.SeasonStart = start
+		cfg.SeasonLength = 30 * 24 * time.Hour
+		cfg.SeasonCarryOver = carryOver
+		return service.NewSeasonService(tdb.Repositories, tdb.HexLeaderboardService, tdb.ActivityService.PrivacyZoneService, cfg, zap.NewExample())
+	}
+
+	// setup gives alice the lead in two hexes and bob the lead in one, with bob second in
+	// alice's first hex.
+	setup := func(t *testing.T, tdb *testutil.TestServices) (alice, bob *ent.User, hexes []string) {
+		ctx := tdb.Ctx
+		var err error
+		alice, err = tdb.UserRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		bob, err = tdb.UserRepo.CreateUser(ctx, &model.User{Username: "bob", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		hexes = []string{"891e2e6b153ffff", "891e2e6b103ffff", "891e2e6b15bffff"}
+		require.NoError(t, tdb.HexService.CreateMissingHexes(ctx, hexes))
+		influence := func(user *ent.User, hexID string, score float64) {
+			_, err := tdb.HexInfluenceRepo.CreateHexInfluence(ctx, &model.HexInfluence{
+				UserID:      user.ID,
+				H3Index:     hexID,
+				Score:       score,
+				LastUpdated: time.Now(),
+			})
+			require.NoError(t, err)
+		}
+		influence(alice, hexes[0], 10)
+		influence(bob, hexes[0], 4)
+		influence(alice, hexes[1], 6)
+		influence(bob, hexes[2], 20)
+		for _, hexID := range hexes {
+			require.NoError(t, tdb.HexLeaderboardService.RebuildLeaderboard(ctx, hexID))
+		}
+		return alice, bob, hexes
+	}
+
+	// ------------------------
+	// Subtest: Rollover_ArchivesAndResets
+	// ------------------------
+	t.Run("Rollover_ArchivesAndResets", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+		seasons := newSeasonService(tdb, 0)
+
+		// No season before the configured start.
+		season, err := seasons.RolloverIfDue(ctx, start.Add(-time.Hour))
+		require.NoError(t, err)
+		require.Nil(t, season)
+
+		season, err = seasons.RolloverIfDue(ctx, start.Add(time.Hour))
+		require.NoError(t, err)
+		require.NotNil(t, season)
+		require.Equal(t, 1, season.Number)
+		require.True(t, start.Equal(season.StartsAt))
+
+		alice, bob, hexes := setup(t, tdb)
+
+		// Nothing happens while the season is running.
+		season, err = seasons.RolloverIfDue(ctx, start.Add(29*24*time.Hour))
+		require.NoError(t, err)
+		require.Nil(t, season)
+
+		// Ending two seasons late skips the season that was never played.
+		season, err = seasons.RolloverIfDue(ctx, start.Add(61*24*time.Hour))
+		require.NoError(t, err)
+		require.NotNil(t, season)
+		require.Equal(t, 3, season.Number)
+		require.True(t, start.Add(60*24*time.Hour).Equal(season.StartsAt))
+
+		standings, err := seasons.GetStandings(ctx, 1, 0, 0)
+		require.NoError(t, err)
+		require.Equal(t, "archived", standings.Season.Status)
+		require.Equal(t, 2, standings.Participants)
+		require.Len(t, standings.Standings, 2)
+		require.Equal(t, alice.ID, standings.Standings[0].UserID)
+		require.Equal(t, 2, standings.Standings[0].HexesOwned)
+		require.InDelta(t, 16.0, standings.Standings[0].TotalInfluence, 1e-9)
+		require.Equal(t, bob.ID, standings.Standings[1].UserID)
+		require.Equal(t, 2, standings.Standings[1].Rank)
+		require.Nil(t, standings.NextOffset)
+
+		hexStandings, err := seasons.GetHexStandings(ctx, 1, hexes[0])
+		require.NoError(t, err)
+		require.Len(t, hexStandings.Standings, 2)
+		require.Equal(t, alice.ID, hexStandings.Standings[0].UserID)
+		require.Equal(t, bob.ID, hexStandings.Standings[1].UserID)
+		require.Equal(t, 2, hexStandings.Standings[1].Rank)
+
+		// The live scores start over.
+		influences, err := tdb.HexInfluenceRepo.FindByUserID(ctx, alice.ID)
+		require.NoError(t, err)
+		require.Empty(t, influences)
+		_, err = tdb.HexLeaderboardRepo.FindByH3Index(ctx, hexes[0])
+		require.True(t, ent.IsNotFound(err))
+
+		history, err := seasons.GetUserSeasons(ctx, bob.ID)
+		require.NoError(t, err)
+		require.Len(t, history.Seasons, 1)
+		require.Equal(t, 1, history.Seasons[0].Season.Number)
+		require.Equal(t, 2, history.Seasons[0].Rank)
+		require.Equal(t, 2, history.Seasons[0].Participants)
+		require.Equal(t, 1, history.Seasons[0].HexesOwned)
+
+		// The running season has no results yet.
+		_, err = seasons.GetStandings(ctx, 3, 0, 0)
+		require.ErrorIs(t, err, service.ErrSeasonNotArchived)
+		_, err = seasons.GetStandings(ctx, 2, 0, 0)
+		require.True(t, ent.IsNotFound(err))
+
+		all, err := seasons.GetSeasons(ctx)
+		require.NoError(t, err)
+		require.Len(t, all.Seasons, 2)
+		require.Equal(t, 3, all.Seasons[0].Number)
+		require.Equal(t, "active", all.Seasons[0].Status)
+	})
+
+	// ------------------------
+	// Subtest: Rollover_CarriesOver
+	// ------------------------
+	t.Run("Rollover_CarriesOver", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+		seasons := newSeasonService(tdb, 0.25)
+
+		_, err := seasons.RolloverIfDue(ctx, start)
+		require.NoError(t, err)
+		alice, bob, hexes := setup(t, tdb)
+
+		season, err := seasons.RolloverIfDue(ctx, start.Add(30*24*time.Hour))
+		require.NoError(t, err)
+		require.NotNil(t, season)
+		require.Equal(t, 2, season.Number)
+
+		influence, err := tdb.HexInfluenceRepo.FindByUserIDAndHexID(ctx, alice.ID, hexes[0])
+		require.NoError(t, err)
+		require.InDelta(t, 2.5, influence.Score, 1e-9)
+		influence, err = tdb.HexInfluenceRepo.FindByUserIDAndHexID(ctx, bob.ID, hexes[2])
+		require.NoError(t, err)
+		require.InDelta(t, 5.0, influence.Score, 1e-9)
+
+		leaderboard, err := tdb.HexLeaderboardRepo.FindByH3Index(ctx, hexes[0])
+		require.NoError(t, err)
+		require.Len(t, leaderboard.TopUsers, 2)
+		require.Equal(t, alice.ID, leaderboard.TopUsers[0].UserID)
+		require.InDelta(t, 2.5, leaderboard.TopUsers[0].Score, 1e-9)
+		require.InDelta(t, 1.0, leaderboard.TopUsers[1].Score, 1e-9)
+
+		// Replays of the new season start from the carried over score.
+		endedAt := time.Now()
+		created, err := tdb.ActivityService.CreateActivity(ctx, dto.CreateActivityRequest{
+			UserID: alice.ID, Duration: 600, Distance: 2000, EndedAt: &endedAt, H3Indexes: hexes[:1],
+		})
+		require.NoError(t, err)
+		_, err = tdb.ActivityService.JobService.ProcessPending(ctx)
+		require.NoError(t, err)
+		_, err = tdb.ActivityService.DeleteActivity(ctx, created.ID, alice.ID)
+		require.NoError(t, err)
+		influence, err = tdb.HexInfluenceRepo.FindByUserIDAndHexID(ctx, alice.ID, hexes[0])
+		require.NoError(t, err)
+		require.InDelta(t, 2.5, influence.Score, 1e-9)
+
+		// The archived standings keep the full scores.
+		hexStandings, err := seasons.GetHexStandings(ctx, 1, hexes[2])
+		require.NoError(t, err)
+		require.Len(t, hexStandings.Standings, 1)
+		require.InDelta(t, 20.0, hexStandings.Standings[0].Score, 1e-9)
+	})
+	// ------------------------
+	// Subtest: Activities_ScopedToActiveSeason
+	// ------------------------
+	t.Run("Activities_ScopedToActiveSeason", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+		seasonStart := time.Now().Add(-48 * time.Hour)
+		cfg := config.Default()
+		cfg.SeasonStart = seasonStart.Add(-20 * 24 * time.Hour)
+		cfg.SeasonLength = 20 * 24 * time.Hour
+		seasons := service.NewSeasonService(tdb.Repositories, tdb.HexLeaderboardService, tdb.ActivityService.PrivacyZoneService, cfg, zap.NewExample())
+		alice, err := tdb.UserRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		hexes := []string{"891e2e6b153ffff", "891e2e6b103ffff"}
+		run := func(endedAt time.Time, h3Indexes ...string) uuid.UUID {
+			resp, err := tdb.ActivityService.CreateActivity(ctx, dto.CreateActivityRequest{
+				UserID:    alice.ID,
+				Duration:  600,
+				Distance:  2000,
+				EndedAt:   &endedAt,
+				H3Indexes: h3Indexes,
+			})
+			require.NoError(t, err)
+			_, err = tdb.ActivityService.JobService.ProcessPending(ctx)
+			require.NoError(t, err)
+			return resp.ID
+		}
+		score := func(hexID string) *float64 {
+			influence, err := tdb.HexInfluenceRepo.FindByUserIDAndHexID(ctx, alice.ID, hexID)
+			if ent.IsNotFound(err) {
+				return nil
+			}
+			require.NoError(t, err)
+			return &influence.Score
+		}
+
+		_, err = seasons.RolloverIfDue(ctx, cfg.SeasonStart.Add(time.Hour))
+		require.NoError(t, err)
+		run(seasonStart.Add(-10*24*time.Hour), hexes[0])
+		require.NotNil(t, score(hexes[0]))
+		season, err := seasons.RolloverIfDue(ctx, time.Now())
+		require.NoError(t, err)
+		require.Equal(t, 2, season.Number)
+		require.Nil(t, score(hexes[0]))
+
+		// The final total is the influence as decayed at the end of the season.
+		standings, err := seasons.GetStandings(ctx, 1, 0, 0)
+		require.NoError(t, err)
+		scoring := service.NewScoringStrategy(cfg)
+		decayed := scoring.Decay(scoring.Visit(0), 10*24*time.Hour)
+		require.Less(t, decayed, scoring.Visit(0))
+		require.InDelta(t, decayed, standings.Standings[0].TotalInfluence, 1e-6)
+
+		// An offline upload of an activity that ended last season adds nothing to this one.
+		run(seasonStart.Add(-time.Hour), hexes[0])
+		require.Nil(t, score(hexes[0]))
+
+		// Deleting an activity replays this season only, last season's territory stays gone.
+		both := run(time.Now().Add(-time.Hour), hexes[0], hexes[1])
+		run(time.Now(), hexes[1])
+		_, err = tdb.ActivityService.DeleteActivity(ctx, both, alice.ID)
+		require.NoError(t, err)
+		require.Nil(t, score(hexes[0]))
+		require.NotNil(t, score(hexes[1]))
+		require.InDelta(t, 1.0, *score(hexes[1]), 1e-6)
+	})
+}
@@ -0,0 +1,341 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/hex/hexconsts"
+	"stride-wars-app/internal/repository"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uber/h3-go/v4"
+	"go.uber.org/zap"
+)
+
+// MinSegmentCells and MaxSegmentCells bound the length of a segment's path.
+const (
+	MinSegmentCells = 2
+	MaxSegmentCells = 200
+)
+
+// DefaultSegmentLeaderboardSize and MaxSegmentLeaderboardSize bound the entries of a segment leaderboard.
+const (
+	DefaultSegmentLeaderboardSize = 10
+	MaxSegmentLeaderboardSize     = 100
+)
+
+// segmentLookupChunk is how many cells are looked up at once when finding candidate segments.
+const segmentLookupChunk = 500
+
+var (
+	ErrSegmentNameRequired    = errors.New("segment name is required")
+	ErrSegmentLength          = errors.New("a segment must have between 2 and 200 h3_indexes")
+	ErrSegmentNotContiguous   = errors.New("consecutive segment cells must be neighbours")
+	ErrSegmentRepeatsCell     = errors.New("a segment cannot visit the same cell twice")
+	ErrSegmentNotOwned        = errors.New("segment does not belong to this user")
+	ErrInvalidLeaderboardSize = errors.New("limit must be between 1 and 100")
+)
+
+// SegmentService manages segments and times the activities that traverse them.
+type SegmentService struct {
+	segmentRepository repository.SegmentRepository
+	effortRepository  repository.SegmentEffortRepository
+	userRepository    repository.UserRepository
+	logger            *zap.Logger
+}
+
+func NewSegmentService(repositories *repository.Repositories, logger *zap.Logger) *SegmentService {
+	return &SegmentService{
+		segmentRepository: repositories.SegmentRepository,
+		effortRepository:  repositories.SegmentEffortRepository,
+		userRepository:    repositories.UserRepository,
+		logger:            logger,
+	}
+}
+
+func (ss *SegmentService) CreateSegment(ctx context.Context, req dto.CreateSegmentRequest) (*dto.SegmentResponse, error) {
+	if (req.UserID == uuid.UUID{}) {
+		return nil, errors.New("UserID is required")
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, ErrSegmentNameRequired
+	}
+	if err := validateSegmentPath(req.H3Indexes); err != nil {
+		return nil, err
+	}
+	if _, err := ss.userRepository.FindByID(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+
+	segment, err := ss.segmentRepository.CreateSegment(ctx, &model.Segment{
+		Name:         name,
+		CreatorID:    req.UserID,
+		H3Indexes:    req.H3Indexes,
+		StartH3Index: req.H3Indexes[0],
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toSegmentResponse(segment), nil
+}
+
+func (ss *SegmentService) GetSegment(ctx context.Context, id uuid.UUID) (*dto.SegmentResponse, error) {
+	segment, err := ss.segmentRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toSegmentResponse(segment), nil
+}
+
+// DeleteSegment removes a segment with all its efforts. Only its creator can delete it.
+func (ss *SegmentService) DeleteSegment(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
+	segment, err := ss.segmentRepository.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if segment.CreatorID != userID {
+		return ErrSegmentNotOwned
+	}
+	return ss.segmentRepository.DeleteByID(ctx, id)
+}
+
+// GetLeaderboard ranks users by their best effort on the segment.
+func (ss *SegmentService) GetLeaderboard(ctx context.Context, id uuid.UUID, limit int) (*dto.SegmentLeaderboardResponse, error) {
+	if limit < 1 || limit > MaxSegmentLeaderboardSize {
+		return nil, ErrInvalidLeaderboardSize
+	}
+	segment, err := ss.segmentRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	best, err := ss.effortRepository.BestEffortsBySegment(ctx, id, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]uuid.UUID, len(best))
+	for i, effort := range best {
+		userIDs[i] = effort.UserID
+	}
+	users, err := ss.userRepository.FindByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	usernames := make(map[uuid.UUID]string, len(users))
+	for _, user := range users {
+		usernames[user.ID] = user.Username
+	}
+
+	resp := &dto.SegmentLeaderboardResponse{
+		SegmentID: segment.ID,
+		Name:      segment.Name,
+		Entries:   make([]dto.SegmentLeaderboardEntry, len(best)),
+	}
+	for i, effort := range best {
+		resp.Entries[i] = dto.SegmentLeaderboardEntry{
+			Rank:           i + 1,
+			UserID:         effort.UserID,
+			Username:       usernames[effort.UserID],
+			ElapsedSeconds: effort.ElapsedSeconds,
+			Efforts:        effort.Efforts,
+		}
+	}
+	return resp, nil
+}
+
+// MatchActivity records an effort for every traversal of a segment by the activity. Only
+// segments starting in a cell the activity visited are considered, using the index on the
+// start cell, so matching does not grow with the total number of segments.
+func (ss *SegmentService) MatchActivity(ctx context.Context, activity *ent.Activity) ([]dto.SegmentEffortResponse, error) {
+	efforts := []dto.SegmentEffortResponse{}
+	timeline, timed := activityTimeline(activity)
+	if len(timeline) < MinSegmentCells {
+		return efforts, nil
+	}
+
+	visited := make([]string, 0, len(timeline))
+	seen := make(map[string]bool, len(timeline))
+	for _, visit := range timeline {
+		if !seen[visit.h3Index] {
+			seen[visit.h3Index] = true
+			visited = append(visited, visit.h3Index)
+		}
+	}
+
+	var candidates []*ent.Segment
+	for start := 0; start < len(visited); start += segmentLookupChunk {
+		end := min(start+segmentLookupChunk, len(visited))
+		segments, err := ss.segmentRepository.FindByStartH3Indexes(ctx, visited[start:end])
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, segments...)
+	}
+
+	var states []*model.SegmentEffort
+	for _, segment := range candidates {
+		for _, traversal := range matchSegment(timeline, segment.H3Indexes) {
+			states = append(states, &model.SegmentEffort{
+				SegmentID:      segment.ID,
+				UserID:         activity.UserID,
+				ActivityID:     activity.ID,
+				ElapsedSeconds: traversal.elapsed.Seconds(),
+				StartedAt:      traversal.startedAt,
+				Estimated:      !timed,
+			})
+			efforts = append(efforts, dto.SegmentEffortResponse{
+				SegmentID:      segment.ID,
+				SegmentName:    segment.Name,
+				ElapsedSeconds: traversal.elapsed.Seconds(),
+				StartedAt:      traversal.startedAt,
+				Estimated:      !timed,
+			})
+		}
+	}
+	if len(states) == 0 {
+		return efforts, nil
+	}
+	if _, err := ss.effortRepository.CreateSegmentEfforts(ctx, states); err != nil {
+		return nil, err
+	}
+	return efforts, nil
+}
+
+// cellVisit is the time an activity entered a cell.
+type cellVisit struct {
+	h3Index string
+	at      time.Time
+}
+
+type segmentTraversal struct {
+	startedAt time.Time
+	elapsed   time.Duration
+}
+
+// activityTimeline lists the cells an activity passed through in order, with the time it
+// entered each, and reports whether the times are real. A timed GPS track gives real times;
+// otherwise the activity's cells are spread evenly between its start and end.
+func activityTimeline(activity *ent.Activity) ([]cellVisit, bool) {
+	var timeline []cellVisit
+	appendVisit := func(h3Index string, at time.Time) {
+		if n := len(timeline); n > 0 && timeline[n-1].h3Index == h3Index {
+			return
+		}
+		timeline = append(timeline, cellVisit{h3Index: h3Index, at: at})
+	}
+
+	timed := len(activity.Track) >= MinSegmentCells
+	for _, point := range activity.Track {
+		if point.Timestamp.IsZero() {
+			timed = false
+			break
+		}
+	}
+	if timed {
+		points := make([]model.TrackPoint, len(activity.Track))
+		copy(points, activity.Track)
+		sort.SliceStable(points, func(i, j int) bool {
+			return points[i].Timestamp.Before(points[j].Timestamp)
+		})
+		for _, point := range points {
+			cell, err := h3.NewLatLng(point.Lat, point.Lng).Cell(hexconsts.DefaultHexResolution)
+			if err != nil {
+				continue
+			}
+			appendVisit(cell.String(), point.Timestamp)
+		}
+		return timeline, true
+	}
+
+	startedAt, endedAt := activityStartedAt(activity), activityEndedAt(activity)
+	n := len(activity.H3Indexes)
+	for i, h3Index := range activity.H3Indexes {
+		at := startedAt
+		if n > 1 {
+			at = startedAt.Add(endedAt.Sub(startedAt) * time.Duration(i) / time.Duration(n-1))
+		}
+		appendVisit(h3Index, at)
+	}
+	return timeline, false
+}
+
+// matchSegment finds every traversal of the path in the timeline. A traversal enters the
+// path's cells in order. Falling back into the previous cell is tolerated, as is skipping a
+// single cell, which happens when GPS points are sparse. The effort runs from entering the
+// first cell to entering the last.
+func matchSegment(timeline []cellVisit, path []string) []segmentTraversal {
+	var traversals []segmentTraversal
+	for i := 0; i < len(timeline); i++ {
+		if timeline[i].h3Index != path[0] {
+			continue
+		}
+
+		next := 1
+		k := i + 1
+	walk:
+		for ; k < len(timeline) && next < len(path); k++ {
+			switch timeline[k].h3Index {
+			case path[next]:
+				next++
+			case path[next-1]:
+			default:
+				if next+1 < len(path) && timeline[k].h3Index == path[next+1] {
+					next += 2
+					continue
+				}
+				break walk
+			}
+		}
+		if next < len(path) {
+			continue
+		}
+
+		traversals = append(traversals, segmentTraversal{
+			startedAt: timeline[i].at,
+			elapsed:   timeline[k-1].at.Sub(timeline[i].at),
+		})
+		i = k - 1
+	}
+	return traversals
+}
+
+func validateSegmentPath(h3Indexes []string) error {
+	if len(h3Indexes) < MinSegmentCells || len(h3Indexes) > MaxSegmentCells {
+		return ErrSegmentLength
+	}
+	seen := make(map[string]bool, len(h3Indexes))
+	for i, h3Index := range h3Indexes {
+		if err := validateH3Index(h3Index); err != nil {
+			return err
+		}
+		if seen[h3Index] {
+			return ErrSegmentRepeatsCell
+		}
+		seen[h3Index] = true
+
+		if i > 0 {
+			previous := h3.Cell(h3.IndexFromString(h3Indexes[i-1]))
+			neighbour, err := previous.IsNeighbor(h3.Cell(h3.IndexFromString(h3Index)))
+			if err != nil || !neighbour {
+				return ErrSegmentNotContiguous
+			}
+		}
+	}
+	return nil
+}
+
+func toSegmentResponse(segment *ent.Segment) *dto.SegmentResponse {
+	return &dto.SegmentResponse{
+		ID:        segment.ID,
+		Name:      segment.Name,
+		CreatorID: segment.CreatorID,
+		H3Indexes: segment.H3Indexes,
+		CreatedAt: segment.CreatedAt,
+	}
+}
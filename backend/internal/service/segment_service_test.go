@@ -0,0 +1,188 @@
+package service_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uber/h3-go/v4"
+
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/hex/hexconsts"
+	"stride-wars-app/internal/repository"
+	"stride-wars-app/internal/service"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// trackCells returns the cells a track passes through, in order.
+func trackCells(t *testing.T, track []dto.TrackPoint) []string {
+	t.Helper()
+	var cells []string
+	for _, point := range track {
+		cell, err := h3.NewLatLng(point.Lat, point.Lng).Cell(hexconsts.DefaultHexResolution)
+		require.NoError(t, err)
+		if len(cells) == 0 || cells[len(cells)-1] != cell.String() {
+			cells = append(cells, cell.String())
+		}
+	}
+	return cells
+}
+
+func TestSegmentService(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: MatchAndLeaderboard
+	// ------------------------
+	t.Run("MatchAndLeaderboard", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		alice, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		bob, err := userRepo.CreateUser(ctx, &model.User{Username: "bob", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		now := time.Now()
+		cells := trackCells(t, straightTrack(now, 20, time.Minute))
+		require.GreaterOrEqual(t, len(cells), 5)
+
+		segment, err := svc.SegmentService.CreateSegment(ctx, dto.CreateSegmentRequest{
+			UserID:    alice.ID,
+			Name:      "North Road",
+			H3Indexes: cells[1:4],
+		})
+		require.NoError(t, err)
+
+		run := func(userID uuid.UUID, startedAt time.Time, interval time.Duration, reverse bool) *dto.CreateActivityResponse {
+			track := straightTrack(startedAt, 20, interval)
+			if reverse {
+				for i, j := 0, len(track)-1; i < j; i, j = i+1, j-1 {
+					track[i].Lat, track[j].Lat = track[j].Lat, track[i].Lat
+				}
+			}
+			h3Indexes := trackCells(t, track)
+			endedAt := track[len(track)-1].Timestamp
+			resp, err := svc.CreateActivity(ctx, dto.CreateActivityRequest{
+				UserID:    userID,
+				Duration:  endedAt.Sub(startedAt).Seconds(),
+				Distance:  1900,
+				H3Indexes: h3Indexes,
+				Track:     track,
+				StartedAt: &startedAt,
+				EndedAt:   &endedAt,
+			})
+			require.NoError(t, err)
+			return resp
+		}
+
+		slow := run(alice.ID, now.Add(-5*time.Hour), time.Minute, false)
+		require.Len(t, slow.SegmentEfforts, 1)
+		require.Equal(t, segment.ID, slow.SegmentEfforts[0].SegmentID)
+		require.Greater(t, slow.SegmentEfforts[0].ElapsedSeconds, 0.0)
+		require.False(t, slow.SegmentEfforts[0].Estimated)
+
+		fast := run(alice.ID, now.Add(-4*time.Hour), 30*time.Second, false)
+		require.Len(t, fast.SegmentEfforts, 1)
+		require.InDelta(t, slow.SegmentEfforts[0].ElapsedSeconds/2, fast.SegmentEfforts[0].ElapsedSeconds, 0.001)
+
+		fastest := run(bob.ID, now.Add(-3*time.Hour), 20*time.Second, false)
+		require.Len(t, fastest.SegmentEfforts, 1)
+
+		// Running the path backwards is not a traversal
+		backwards := run(bob.ID, now.Add(-2*time.Hour), 10*time.Second, true)
+		require.Empty(t, backwards.SegmentEfforts)
+
+		leaderboard, err := svc.SegmentService.GetLeaderboard(ctx, segment.ID, 10)
+		require.NoError(t, err)
+		require.Len(t, leaderboard.Entries, 2)
+		require.Equal(t, bob.ID, leaderboard.Entries[0].UserID)
+		require.Equal(t, "bob", leaderboard.Entries[0].Username)
+		require.Equal(t, alice.ID, leaderboard.Entries[1].UserID)
+		require.Equal(t, 2, leaderboard.Entries[1].Efforts)
+		require.InDelta(t, fast.SegmentEfforts[0].ElapsedSeconds, leaderboard.Entries[1].ElapsedSeconds, 0.001)
+
+		// Deleting an activity removes its efforts
+		_, err = svc.DeleteActivity(ctx, fastest.ID, bob.ID)
+		require.NoError(t, err)
+		leaderboard, err = svc.SegmentService.GetLeaderboard(ctx, segment.ID, 10)
+		require.NoError(t, err)
+		require.Len(t, leaderboard.Entries, 1)
+	})
+
+	// ------------------------
+	// Subtest: MatchWithoutTrack
+	// ------------------------
+	t.Run("MatchWithoutTrack", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		startedAt := time.Now().Add(-time.Hour)
+		cells := trackCells(t, straightTrack(startedAt, 20, time.Minute))
+		_, err = svc.SegmentService.CreateSegment(ctx, dto.CreateSegmentRequest{
+			UserID:    createdUser.ID,
+			Name:      "Whole Road",
+			H3Indexes: cells,
+		})
+		require.NoError(t, err)
+
+		// Cells are spread evenly over the activity, so the whole path takes the whole activity
+		endedAt := startedAt.Add(10 * time.Minute)
+		resp, err := svc.CreateActivity(ctx, dto.CreateActivityRequest{
+			UserID:    createdUser.ID,
+			Duration:  600,
+			Distance:  1900,
+			H3Indexes: cells,
+			StartedAt: &startedAt,
+			EndedAt:   &endedAt,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.SegmentEfforts, 1)
+		require.InDelta(t, 600, resp.SegmentEfforts[0].ElapsedSeconds, 0.001)
+		require.True(t, resp.SegmentEfforts[0].Estimated)
+
+		// Interpolated times are not ranked
+		leaderboard, err := svc.SegmentService.GetLeaderboard(ctx, resp.SegmentEfforts[0].SegmentID, 10)
+		require.NoError(t, err)
+		require.Empty(t, leaderboard.Entries)
+	})
+
+	// ------------------------
+	// Subtest: Validation
+	// ------------------------
+	t.Run("Validation", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		cells := trackCells(t, straightTrack(time.Now(), 20, time.Minute))
+		create := func(name string, h3Indexes []string) error {
+			_, err := svc.SegmentService.CreateSegment(ctx, dto.CreateSegmentRequest{UserID: createdUser.ID, Name: name, H3Indexes: h3Indexes})
+			return err
+		}
+
+		require.ErrorIs(t, create(" ", cells[:2]), service.ErrSegmentNameRequired)
+		require.ErrorIs(t, create("short", cells[:1]), service.ErrSegmentLength)
+		require.ErrorIs(t, create("gap", []string{cells[0], cells[3]}), service.ErrSegmentNotContiguous)
+		require.ErrorIs(t, create("loop", []string{cells[0], cells[1], cells[0]}), service.ErrSegmentRepeatsCell)
+
+		reversed := slices.Clone(cells[:3])
+		slices.Reverse(reversed)
+		require.NoError(t, create("reversed", reversed))
+
+		_, err = svc.SegmentService.GetLeaderboard(ctx, uuid.New(), 0)
+		require.ErrorIs(t, err, service.ErrInvalidLeaderboardSize)
+	})
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"stride-wars-app/internal/config"
 	"stride-wars-app/internal/repository"
 
 	"github.com/supabase-community/supabase-go"
@@ -14,25 +15,57 @@ type Services struct {
 	HexService            *HexService
 	HexLeaderboardService *HexLeaderboardService
 	HexInfluenceService   *HexInfluenceService
+	HexRollupService      *HexRollupService
+	PrivacyZoneService    *PrivacyZoneService
+	SegmentService        *SegmentService
+	DecaySweepService     *DecaySweepService
+	SeasonService         *SeasonService
+	RegionService         *RegionService
+
+	InfluenceHistoryService *InfluenceHistoryService
+	HexCaptureService       *HexCaptureService
+	NotificationService     *NotificationService
+	PushService             *PushService
+	WebhookService          *WebhookService
+
+	ActivitySessionService *ActivitySessionService
 }
 
-func Provide(repositories *repository.Repositories, supabaseClient *supabase.Client, logger *zap.Logger) *Services {
+func Provide(repositories *repository.Repositories, supabaseClient *supabase.Client, cfg config.Config, logger *zap.Logger) *Services {
 	userService := NewUserService(repositories.UserRepository, logger)
+	activityService := NewActivityService(repositories, userService, cfg, logger)
+	scoring := NewScoringStrategy(cfg)
 
 	return &Services{
-		UserService: userService,
-		AuthService: NewAuthService(supabaseClient, logger, userService),
-		ActivityService: NewActivityService(
-			repositories.ActivityRepository,
-			repositories.HexInfluenceRepository,
-			repositories.HexLeaderboardRepository,
-			repositories.HexRepository,
-			userService,
-			logger),
-		HexService: NewHexService(repositories.HexRepository, logger),
+		UserService:     userService,
+		AuthService:     NewAuthService(supabaseClient, logger, userService),
+		ActivityService: activityService,
+		HexService:      NewHexService(repositories.HexRepository, logger),
 		HexLeaderboardService: NewHexLeaderboardService(repositories.HexLeaderboardRepository,
 			repositories.HexInfluenceRepository,
+			repositories.HexCaptureRepository,
+			repositories.SettingRepository,
+			activityService.PrivacyZoneService,
+			activityService.NotificationService,
+			activityService.WebhookService,
+			activityService.HexRollupService,
+			scoring,
+			cfg,
 			logger),
-		HexInfluenceService: NewHexInfluenceService(repositories.HexInfluenceRepository, logger),
+		HexInfluenceService: NewHexInfluenceService(repositories.HexInfluenceRepository, repositories.InfluenceHistoryRepository, scoring, logger),
+		HexRollupService:    activityService.HexRollupService,
+		PrivacyZoneService:  activityService.PrivacyZoneService,
+		SegmentService:      activityService.SegmentService,
+		DecaySweepService:   NewDecaySweepService(repositories, activityService.HexLeaderboardService, scoring, cfg, logger),
+		SeasonService:       NewSeasonService(repositories, activityService.HexLeaderboardService, activityService.PrivacyZoneService, cfg, logger),
+		RegionService:       NewRegionService(repositories, activityService.PrivacyZoneService, scoring, cfg, logger),
+
+		ActivitySessionService: NewActivitySessionService(repositories, activityService, cfg, logger),
+
+		InfluenceHistoryService: NewInfluenceHistoryService(repositories, userService, activityService.PrivacyZoneService, logger),
+		HexCaptureService:       NewHexCaptureService(repositories, userService, activityService.PrivacyZoneService, logger),
+		NotificationService:     activityService.NotificationService,
+		PushService:             NewPushService(repositories, NewExpoPushSender(cfg), cfg, logger),
+		WebhookService:          activityService.WebhookService,
 	}
 }
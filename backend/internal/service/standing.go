@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"stride-wars-app/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// userStanding is a user's place in the standings of a region or a season.
+type userStanding struct {
+	UserID         uuid.UUID
+	Username       string
+	HexesOwned     int
+	TotalInfluence float64
+	Rank           int
+}
+
+// standingTally collects the hexes every user leads and their total influence.
+type standingTally map[uuid.UUID]*userStanding
+
+// of returns the standing of the user, adding it when the user has none yet.
+func (t standingTally) of(userID uuid.UUID) *userStanding {
+	standing, ok := t[userID]
+	if !ok {
+		standing = &userStanding{UserID: userID}
+		t[userID] = standing
+	}
+	return standing
+}
+
+// rank orders the users by the hexes they lead, then by their total influence, and fills in
+// their current usernames.
+func (t standingTally) rank(ctx context.Context, userRepository repository.UserRepository) ([]*userStanding, error) {
+	standings := make([]*userStanding, 0, len(t))
+	userIDs := make([]uuid.UUID, 0, len(t))
+	for userID, standing := range t {
+		standings = append(standings, standing)
+		userIDs = append(userIDs, userID)
+	}
+	if len(userIDs) == 0 {
+		return standings, nil
+	}
+
+	users, err := userRepository.FindByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		t[user.ID].Username = user.Username
+	}
+
+	sort.Slice(standings, func(i, j int) bool {
+		if standings[i].HexesOwned != standings[j].HexesOwned {
+			return standings[i].HexesOwned > standings[j].HexesOwned
+		}
+		if standings[i].TotalInfluence != standings[j].TotalInfluence {
+			return standings[i].TotalInfluence > standings[j].TotalInfluence
+		}
+		return standings[i].UserID.String() < standings[j].UserID.String()
+	})
+	for i, standing := range standings {
+		standing.Rank = i + 1
+	}
+	return standings, nil
+}
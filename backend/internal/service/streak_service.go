@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// StreakMinDistance is the distance in meters an activity must cover to count towards a streak.
+const StreakMinDistance = 1000.0
+
+var streakPeriods = []string{model.PeriodDay, model.PeriodWeek}
+
+// recordedActivity is what progress tracking needs to know about a newly stored activity.
+type recordedActivity struct {
+	StartedAt time.Time
+	Distance  float64
+	NewHexes  int
+}
+
+// StreakService tracks consecutive days and weeks with a qualifying activity. Streaks are
+// updated as activities come in, so reading them never scans the activity history.
+type StreakService struct {
+	repository  repository.StreakRepository
+	userService *UserService
+	logger      *zap.Logger
+}
+
+func NewStreakService(repository repository.StreakRepository, userService *UserService, logger *zap.Logger) *StreakService {
+	return &StreakService{repository: repository, userService: userService, logger: logger}
+}
+
+// RecordActivity extends the user's streaks with a new activity. Periods are evaluated in the
+// user's time zone. An activity uploaded for a period before the last recorded one leaves the
+// streaks unchanged.
+func (ss *StreakService) RecordActivity(ctx context.Context, user *ent.User, activity recordedActivity) error {
+	if activity.Distance < StreakMinDistance {
+		return nil
+	}
+
+	location := UserLocation(user)
+	for _, period := range streakPeriods {
+		key := periodKey(activity.StartedAt, location, period)
+
+		streak, err := ss.repository.FindByUserIDAndPeriod(ctx, user.ID, period)
+		if ent.IsNotFound(err) {
+			_, err = ss.repository.CreateStreak(ctx, &model.Streak{
+				UserID:     user.ID,
+				Period:     period,
+				Current:    1,
+				Longest:    1,
+				LastPeriod: key,
+			})
+			if err != nil {
+				return repository.InsertRace(err)
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		state := toModelStreak(streak)
+		switch {
+		case !key.After(state.LastPeriod):
+			continue
+		case key.Equal(addPeriods(state.LastPeriod, period, 1)):
+			state.Current++
+		default:
+			state.Current = 1
+		}
+		state.LastPeriod = key
+		state.Longest = max(state.Longest, state.Current)
+
+		if _, err := ss.repository.UpdateStreak(ctx, state); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetStreaks returns the user's daily and weekly streaks. A streak stays current until a whole
+// period passes without a qualifying activity.
+func (ss *StreakService) GetStreaks(ctx context.Context, userID uuid.UUID) (*dto.GetStreaksResponse, error) {
+	user, err := ss.userService.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	streaks, err := ss.repository.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	location := UserLocation(user)
+	now := time.Now()
+	resp := &dto.GetStreaksResponse{Streaks: make([]dto.StreakResponse, 0, len(streakPeriods))}
+	for _, period := range streakPeriods {
+		streakResp := dto.StreakResponse{Period: period}
+		for _, streak := range streaks {
+			if streak.Period.String() != period {
+				continue
+			}
+			streakResp.Longest = streak.Longest
+			streakResp.LastPeriodStart, _ = periodBounds(streak.LastPeriod, location, period)
+			if !streak.LastPeriod.Before(addPeriods(periodKey(now, location, period), period, -1)) {
+				streakResp.Current = streak.Current
+			}
+		}
+		resp.Streaks = append(resp.Streaks, streakResp)
+	}
+	return resp, nil
+}
+
+// periodKey identifies the calendar period containing t in location by the local date it
+// starts on, stored as midnight UTC so keys do not depend on the zone they were computed in.
+func periodKey(t time.Time, location *time.Location, period string) time.Time {
+	return calendarDay(periodStart(t.In(location), period), location)
+}
+
+// periodBounds returns the start and end of the period identified by key in location.
+func periodBounds(key time.Time, location *time.Location, period string) (time.Time, time.Time) {
+	start := time.Date(key.Year(), key.Month(), key.Day(), 0, 0, 0, 0, location)
+	return start, addPeriods(start, period, 1)
+}
+
+func toModelStreak(streak *ent.Streak) *model.Streak {
+	return &model.Streak{
+		ID:         streak.ID,
+		UserID:     streak.UserID,
+		Period:     streak.Period.String(),
+		Current:    streak.Current,
+		Longest:    streak.Longest,
+		LastPeriod: streak.LastPeriod,
+	}
+}
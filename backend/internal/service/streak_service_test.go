@@ -0,0 +1,72 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/repository"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestStreakService(t *testing.T) {
+	t.Parallel()
+
+	// ------------------------
+	// Subtest: DailyStreak_Incremental
+	// ------------------------
+	t.Run("DailyStreak_Incremental", func(t *testing.T) {
+		t.Parallel()
+		ctx, client, svc := setupTest(t)
+
+		userRepo := repository.NewUserRepository(client)
+		createdUser, err := userRepo.CreateUser(ctx, &model.User{Username: "alice", ExternalUser: uuid.New()})
+		require.NoError(t, err)
+
+		now := time.Now()
+		record := func(daysAgo int, distance float64) {
+			endedAt := now.AddDate(0, 0, -daysAgo)
+			_, err := svc.CreateActivity(ctx, dto.CreateActivityRequest{
+				UserID:    createdUser.ID,
+				Duration:  600,
+				Distance:  distance,
+				H3Indexes: validH3Indexes,
+				EndedAt:   &endedAt,
+			})
+			require.NoError(t, err)
+		}
+		dailyStreak := func() dto.StreakResponse {
+			streaks, err := svc.StreakService.GetStreaks(ctx, createdUser.ID)
+			require.NoError(t, err)
+			require.Len(t, streaks.Streaks, 2)
+			require.Equal(t, model.PeriodDay, streaks.Streaks[0].Period)
+			return streaks.Streaks[0]
+		}
+
+		record(6, 2000)
+		record(3, 2000)
+		record(2, 2000)
+		record(2, 2000)
+		// Too short to count
+		record(1, 500)
+
+		streak := dailyStreak()
+		require.Equal(t, 0, streak.Current) // yesterday was missed
+		require.Equal(t, 2, streak.Longest)
+
+		record(1, 2000)
+		record(0, 2000)
+		streak = dailyStreak()
+		require.Equal(t, 4, streak.Current)
+		require.Equal(t, 4, streak.Longest)
+
+		// A late upload for an earlier day does not change the streak
+		record(5, 2000)
+		require.Equal(t, 4, dailyStreak().Current)
+	})
+}
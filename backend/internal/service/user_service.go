@@ -7,13 +7,15 @@ import (
 	"stride-wars-app/internal/repository"
 
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
 var (
-	ErrUserNotFound = errors.New("user not found")
+	ErrUserNotFound    = errors.New("user not found")
+	ErrInvalidTimeZone = errors.New("invalid IANA time zone")
 )
 
 type UpdateUsernameRequest struct {
@@ -26,6 +28,11 @@ type UpdateUsernameResponse struct {
 	NewUsername string    `json:"new_username"`
 }
 
+type UpdateTimeZoneRequest struct {
+	UserID   uuid.UUID `json:"user_id"`
+	TimeZone string    `json:"time_zone"`
+}
+
 type UserService struct {
 	repository repository.UserRepository
 	logger     *zap.Logger
@@ -91,3 +98,30 @@ func (s *UserService) UpdateUsername(ctx context.Context, req *UpdateUsernameReq
 	}
 	return updatedUsr, nil
 }
+
+// UpdateTimeZone sets the IANA time zone the user's activities are split into days in.
+func (us *UserService) UpdateTimeZone(ctx context.Context, req *UpdateTimeZoneRequest) (*ent.User, error) {
+	if req.TimeZone == "" {
+		return nil, ErrInvalidTimeZone
+	}
+	if _, err := time.LoadLocation(req.TimeZone); err != nil {
+		return nil, ErrInvalidTimeZone
+	}
+
+	if _, err := us.repository.FindByID(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if _, err := us.repository.UpdateTimeZone(ctx, req.UserID, req.TimeZone); err != nil {
+		return nil, err
+	}
+	return us.repository.FindByID(ctx, req.UserID)
+}
+
+// UserLocation returns the user's time zone, falling back to UTC when it cannot be loaded.
+func UserLocation(user *ent.User) *time.Location {
+	location, err := time.LoadLocation(user.TimeZone)
+	if err != nil || user.TimeZone == "" {
+		return time.UTC
+	}
+	return location
+}
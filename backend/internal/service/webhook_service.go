@@ -0,0 +1,572 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/config"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/repository"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultWebhookDeliveriesLimit = 20
+	MaxWebhookDeliveriesLimit     = 100
+	// MinWebhookSecretLength is the shortest secret a subscription may be given.
+	MinWebhookSecretLength = 16
+)
+
+// Headers sent with every webhook delivery. The signature is the hex HMAC-SHA256 of the
+// timestamp, a dot and the body, keyed with the subscription's secret, prefixed with "sha256=".
+const (
+	WebhookEventHeader     = "X-Stride-Wars-Event"
+	WebhookDeliveryHeader  = "X-Stride-Wars-Delivery"
+	WebhookTimestampHeader = "X-Stride-Wars-Timestamp"
+	WebhookSignatureHeader = "X-Stride-Wars-Signature"
+)
+
+// webhookBatchSize is the number of deliveries a dispatcher claims at a time. They are sent one
+// after another, so the batch is kept small enough to finish well within its lease.
+const webhookBatchSize = 10
+
+// maxWebhookRetryDelay caps the exponential backoff between attempts.
+const maxWebhookRetryDelay = 24 * time.Hour
+
+var (
+	ErrWebhookNotOwned              = errors.New("webhook does not belong to this user")
+	ErrInvalidWebhookURL            = errors.New("url must be an absolute http or https URL")
+	ErrForbiddenWebhookTarget       = errors.New("url must not point to a loopback, private, link-local or metadata address")
+	ErrInvalidWebhookEventTypes     = errors.New("event_types must list at least one of " + strings.Join(model.WebhookEventTypes, ", "))
+	ErrInvalidWebhookSecret         = fmt.Errorf("secret must be at least %d characters long", MinWebhookSecretLength)
+	ErrInvalidWebhookDeliveriesPage = fmt.Errorf("limit must be between 1 and %d and offset must not be negative", MaxWebhookDeliveriesLimit)
+)
+
+// WebhookService manages webhook subscriptions and sends them game events. Producers call Emit in
+// their transaction, which queues a delivery per subscribed webhook, and Dispatch sends the
+// queued deliveries in the background, retrying failures with exponential backoff.
+type WebhookService struct {
+	subscriptionRepository repository.WebhookSubscriptionRepository
+	deliveryRepository     repository.WebhookDeliveryRepository
+	userRepository         repository.UserRepository
+	transactor             repository.Transactor
+	client                 *http.Client
+	allowPrivateTargets    bool
+	timeout                time.Duration
+	maxAttempts            int
+	retryDelay             time.Duration
+	logger                 *zap.Logger
+}
+
+func NewWebhookService(repositories *repository.Repositories, cfg config.Config, logger *zap.Logger) *WebhookService {
+	return &WebhookService{
+		subscriptionRepository: repositories.WebhookSubscriptionRepository,
+		deliveryRepository:     repositories.WebhookDeliveryRepository,
+		userRepository:         repositories.UserRepository,
+		transactor:             repositories.Transactor,
+		client:                 newWebhookClient(cfg),
+		allowPrivateTargets:    cfg.WebhookAllowPrivateTargets,
+		timeout:                cfg.WebhookTimeout,
+		maxAttempts:            cfg.WebhookMaxAttempts,
+		retryDelay:             cfg.WebhookRetryDelay,
+		logger:                 logger,
+	}
+}
+
+// CreateWebhook subscribes a URL to the given event types. The response carries the secret, which
+// is not shown again.
+func (ws *WebhookService) CreateWebhook(ctx context.Context, req dto.CreateWebhookRequest) (*dto.WebhookResponse, error) {
+	target, err := url.Parse(req.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return nil, ErrInvalidWebhookURL
+	}
+	if err := ws.checkTarget(ctx, target); err != nil {
+		return nil, err
+	}
+	var eventTypes []string
+	for _, eventType := range req.EventTypes {
+		if !slices.Contains(model.WebhookEventTypes, eventType) {
+			return nil, ErrInvalidWebhookEventTypes
+		}
+		if !slices.Contains(eventTypes, eventType) {
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+	if len(eventTypes) == 0 {
+		return nil, ErrInvalidWebhookEventTypes
+	}
+	secret := req.Secret
+	if secret == "" {
+		if secret, err = newWebhookSecret(); err != nil {
+			return nil, err
+		}
+	} else if len(secret) < MinWebhookSecretLength {
+		return nil, ErrInvalidWebhookSecret
+	}
+	if _, err := ws.userRepository.FindByID(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+
+	subscription, err := ws.subscriptionRepository.CreateWebhookSubscription(ctx, &model.WebhookSubscription{
+		UserID:     req.UserID,
+		URL:        target.String(),
+		EventTypes: eventTypes,
+		Secret:     secret,
+		CreatedAt:  time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := webhookResponse(subscription)
+	resp.Secret = secret
+	return &resp, nil
+}
+
+// checkTarget refuses a URL whose host is, or currently resolves to, an address that is not
+// public. A name that does not resolve yet is accepted, as send checks every address it connects
+// to anyway.
+func (ws *WebhookService) checkTarget(ctx context.Context, target *url.URL) error {
+	if ws.allowPrivateTargets {
+		return nil
+	}
+	host := strings.ToLower(target.Hostname())
+	if ip, err := netip.ParseAddr(host); err == nil {
+		if !publicWebhookAddr(ip) {
+			return ErrForbiddenWebhookTarget
+		}
+		return nil
+	}
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrForbiddenWebhookTarget
+	}
+	ctx, cancel := context.WithTimeout(ctx, ws.timeout)
+	defer cancel()
+	ips, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return nil
+	}
+	for _, ip := range ips {
+		if !publicWebhookAddr(ip) {
+			return ErrForbiddenWebhookTarget
+		}
+	}
+	return nil
+}
+
+// nonPublicWebhookPrefixes are the ranges netip.Addr does not classify that still lead to
+// internal services, such as the shared address space some cloud metadata endpoints live in.
+var nonPublicWebhookPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+}
+
+// publicWebhookAddr reports whether a webhook may be sent to ip: it must be a global unicast
+// address outside the private ranges, which rules out loopback, link-local addresses including
+// the 169.254.169.254 metadata endpoint, and unique local IPv6 addresses.
+func publicWebhookAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublicWebhookPrefixes {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// newWebhookClient returns the client deliveries are sent with. Unless private targets are
+// allowed, its dialer checks the address of every connection after the receiver's name was
+// resolved, so a name pointed at an internal address after the subscription was created, or
+// between two lookups, is refused. Proxies are not used, as they would connect on our behalf, and
+// redirects are not followed, as they could lead anywhere.
+func newWebhookClient(cfg config.Config) *http.Client {
+	dialer := &net.Dialer{Timeout: cfg.WebhookTimeout}
+	if !cfg.WebhookAllowPrivateTargets {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip, err := netip.ParseAddr(host)
+			if err != nil {
+				return err
+			}
+			if !publicWebhookAddr(ip) {
+				return fmt.Errorf("%w: %s", ErrForbiddenWebhookTarget, ip)
+			}
+			return nil
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   cfg.WebhookTimeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func newWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(secret), nil
+}
+
+func (ws *WebhookService) GetWebhooks(ctx context.Context, userID uuid.UUID) (*dto.GetWebhooksResponse, error) {
+	if _, err := ws.userRepository.FindByID(ctx, userID); err != nil {
+		return nil, err
+	}
+	subscriptions, err := ws.subscriptionRepository.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &dto.GetWebhooksResponse{Webhooks: make([]dto.WebhookResponse, len(subscriptions))}
+	for i, subscription := range subscriptions {
+		resp.Webhooks[i] = webhookResponse(subscription)
+	}
+	return resp, nil
+}
+
+// DeleteWebhook removes a subscription along with its delivery log and unsent deliveries.
+func (ws *WebhookService) DeleteWebhook(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+	if _, err := ws.ownWebhook(ctx, userID, id); err != nil {
+		return err
+	}
+	return ws.transactor.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := ws.deliveryRepository.DeleteBySubscriptionID(ctx, id); err != nil {
+			return err
+		}
+		return ws.subscriptionRepository.DeleteByID(ctx, id)
+	})
+}
+
+// GetDeliveries returns a page of a subscription's delivery log, newest first. A limit of 0 uses
+// DefaultWebhookDeliveriesLimit.
+func (ws *WebhookService) GetDeliveries(ctx context.Context, userID uuid.UUID, id uuid.UUID, limit, offset int) (*dto.GetWebhookDeliveriesResponse, error) {
+	if limit == 0 {
+		limit = DefaultWebhookDeliveriesLimit
+	}
+	if limit < 1 || limit > MaxWebhookDeliveriesLimit || offset < 0 {
+		return nil, ErrInvalidWebhookDeliveriesPage
+	}
+	if _, err := ws.ownWebhook(ctx, userID, id); err != nil {
+		return nil, err
+	}
+
+	// One more than asked for tells whether there is a next page.
+	deliveries, err := ws.deliveryRepository.FindPageBySubscriptionID(ctx, id, limit+1, offset)
+	if err != nil {
+		return nil, err
+	}
+	resp := &dto.GetWebhookDeliveriesResponse{WebhookID: id, Deliveries: []dto.WebhookDeliveryResponse{}}
+	if len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+		next := offset + limit
+		resp.NextOffset = &next
+	}
+	for _, delivery := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, deliveryResponse(delivery))
+	}
+	return resp, nil
+}
+
+func (ws *WebhookService) ownWebhook(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*ent.WebhookSubscription, error) {
+	subscription, err := ws.subscriptionRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if subscription.UserID != userID {
+		return nil, ErrWebhookNotOwned
+	}
+	return subscription, nil
+}
+
+func webhookResponse(subscription *ent.WebhookSubscription) dto.WebhookResponse {
+	return dto.WebhookResponse{
+		ID:         subscription.ID,
+		URL:        subscription.URL,
+		EventTypes: subscription.EventTypes,
+		CreatedAt:  subscription.CreatedAt,
+	}
+}
+
+func deliveryResponse(delivery *ent.WebhookDelivery) dto.WebhookDeliveryResponse {
+	resp := dto.WebhookDeliveryResponse{
+		ID:             delivery.ID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType.String(),
+		Status:         delivery.Status.String(),
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		LastError:      delivery.LastError,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+		Payload:        json.RawMessage(delivery.Payload),
+	}
+	if delivery.Status.String() == model.WebhookDeliveryPending {
+		resp.NextAttemptAt = &delivery.NextAttemptAt
+	}
+	return resp
+}
+
+// Emit queues an event for every webhook subscribed to its type. It runs in the caller's
+// transaction, so the event is only delivered if what it is about is kept.
+func (ws *WebhookService) Emit(ctx context.Context, eventType string, data any) error {
+	subscribed, err := ws.subscriptionRepository.FindByEventType(ctx, eventType)
+	if err != nil {
+		return err
+	}
+	if len(subscribed) == 0 {
+		return nil
+	}
+
+	event := dto.WebhookEvent{ID: uuid.New(), Type: eventType, CreatedAt: time.Now().UTC(), Data: data}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	deliveries := make([]*model.WebhookDelivery, len(subscribed))
+	for i, subscription := range subscribed {
+		deliveries[i] = &model.WebhookDelivery{
+			SubscriptionID: subscription.ID,
+			EventID:        event.ID,
+			EventType:      eventType,
+			Payload:        string(payload),
+			CreatedAt:      event.CreatedAt,
+		}
+	}
+	return ws.deliveryRepository.CreateWebhookDeliveries(ctx, deliveries)
+}
+
+// Dispatch sends the queued deliveries, a batch at a time, until none are due. It returns how
+// many deliveries it attempted.
+func (ws *WebhookService) Dispatch(ctx context.Context) (int, error) {
+	attempted := 0
+	for {
+		deliveries, err := ws.claimBatch(ctx)
+		if err != nil || len(deliveries) == 0 {
+			return attempted, err
+		}
+		subscriptionIDs := make([]uuid.UUID, len(deliveries))
+		for i, delivery := range deliveries {
+			subscriptionIDs[i] = delivery.SubscriptionID
+		}
+		subscriptions, err := ws.subscriptionRepository.FindByIDs(ctx, subscriptionIDs)
+		if err != nil {
+			return attempted, err
+		}
+		subscriptionsByID := make(map[uuid.UUID]*ent.WebhookSubscription, len(subscriptions))
+		for _, subscription := range subscriptions {
+			subscriptionsByID[subscription.ID] = subscription
+		}
+
+		for _, delivery := range deliveries {
+			// A subscription deleted since the delivery was claimed took its deliveries with it.
+			subscription, ok := subscriptionsByID[delivery.SubscriptionID]
+			if !ok {
+				continue
+			}
+			if err := ws.deliver(ctx, subscription, delivery); err != nil {
+				return attempted, err
+			}
+			attempted++
+		}
+	}
+}
+
+// claimBatch locks up to a batch of due deliveries for this dispatcher, for long enough to send
+// all of them.
+func (ws *WebhookService) claimBatch(ctx context.Context) ([]*ent.WebhookDelivery, error) {
+	var deliveries []*ent.WebhookDelivery
+	err := ws.transactor.WithTx(ctx, func(ctx context.Context) error {
+		now := time.Now()
+		var err error
+		deliveries, err = ws.deliveryRepository.FindDue(ctx, now, webhookBatchSize)
+		if err != nil || len(deliveries) == 0 {
+			return err
+		}
+		ids := make([]uuid.UUID, len(deliveries))
+		for i, delivery := range deliveries {
+			ids[i] = delivery.ID
+		}
+		lease := time.Duration(len(deliveries))*ws.timeout + time.Minute
+		_, err = ws.deliveryRepository.ClaimWebhookDeliveries(ctx, ids, now, now.Add(lease))
+		return err
+	})
+	return deliveries, err
+}
+
+// deliver posts a claimed delivery to its subscription's URL and records the outcome. Any 2xx
+// answer counts as delivered; anything else is retried with a doubling delay until the attempts
+// run out.
+func (ws *WebhookService) deliver(ctx context.Context, subscription *ent.WebhookSubscription, delivery *ent.WebhookDelivery) error {
+	responseStatus, sendErr := ws.send(ctx, subscription, delivery)
+	now := time.Now()
+	// Attempts does not count the current one yet, it was added when the delivery was claimed.
+	attempts := delivery.Attempts + 1
+
+	if sendErr == nil {
+		_, err := ws.deliveryRepository.FinishWebhookDelivery(ctx, delivery.ID, model.WebhookDeliveryDelivered, responseStatus, nil, now, now)
+		return err
+	}
+	if ctx.Err() != nil {
+		// Shutting down: leave the delivery to be claimed again once its lease runs out.
+		return ctx.Err()
+	}
+	lastError := sendErr.Error()
+	status, nextAttemptAt := model.WebhookDeliveryPending, now.Add(ws.backoff(attempts))
+	if attempts >= ws.maxAttempts {
+		status, nextAttemptAt = model.WebhookDeliveryFailed, now
+	}
+	ws.logger.Warn("Webhook delivery failed.",
+		zap.Stringer("deliveryID", delivery.ID),
+		zap.Int("attempt", attempts),
+		zap.String("error", lastError))
+	_, err := ws.deliveryRepository.FinishWebhookDelivery(ctx, delivery.ID, status, responseStatus, &lastError, nextAttemptAt, now)
+	return err
+}
+
+// backoff returns the wait after the given failed attempt.
+func (ws *WebhookService) backoff(attempts int) time.Duration {
+	delay := ws.retryDelay
+	for i := 1; i < attempts && delay < maxWebhookRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxWebhookRetryDelay)
+}
+
+// send posts the signed payload and returns the receiver's status code, if it answered.
+func (ws *WebhookService) send(ctx context.Context, subscription *ent.WebhookSubscription, delivery *ent.WebhookDelivery) (*int, error) {
+	body := []byte(delivery.Payload)
+	timestamp := time.Now().Unix()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, delivery.EventType.String())
+	req.Header.Set(WebhookDeliveryHeader, delivery.EventID.String())
+	req.Header.Set(WebhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(subscription.Secret, timestamp, body))
+
+	resp, err := ws.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	// Drain a little of the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	status := resp.StatusCode
+	if status < 200 || status > 299 {
+		return &status, fmt.Errorf("receiver answered %s", resp.Status)
+	}
+	return &status, nil
+}
+
+// SignWebhookPayload returns the signature header value of a delivery body sent at timestamp.
+// Receivers recompute it with their secret to check a delivery came from us and was not replayed
+// from long ago.
+func SignWebhookPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// PurgeFinished deletes delivered and failed deliveries older than the given age.
+func (ws *WebhookService) PurgeFinished(ctx context.Context, age time.Duration) (int, error) {
+	return ws.deliveryRepository.DeleteFinishedBefore(ctx, time.Now().Add(-age))
+}
+
+// activityCreatedData describes a newly stored activity of user.
+func activityCreatedData(activity *ent.Activity, user *ent.User, hexes int) dto.WebhookActivityCreatedData {
+	return dto.WebhookActivityCreatedData{
+		ActivityID:   activity.ID,
+		UserID:       activity.UserID,
+		Username:     user.Username,
+		ActivityType: activity.ActivityType.String(),
+		Duration:     activity.DurationSeconds,
+		Distance:     activity.DistanceMeters,
+		Hexes:        hexes,
+		NewHexes:     activity.NewHexes,
+		StartedAt:    activityStartedAt(activity),
+		EndedAt:      activityEndedAt(activity),
+	}
+}
+
+// hexCapturedData describes captures stored by the hex capture repository, which set their IDs,
+// without the users hidden reports hidden in the captured hexes: their captures are left out and
+// they are removed from the captures they lost, along with their score.
+func hexCapturedData(captures []*model.HexCapture, hidden func(uuid.UUID, string) bool) dto.WebhookHexCapturedData {
+	data := dto.WebhookHexCapturedData{Captures: make([]dto.HexCaptureResponse, 0, len(captures))}
+	for _, capture := range captures {
+		if hidden(capture.NewOwnerID, capture.H3Index) {
+			continue
+		}
+		resp := dto.HexCaptureResponse{
+			ID:              capture.ID,
+			H3Index:         capture.H3Index,
+			PreviousOwnerID: capture.PreviousOwnerID,
+			NewOwnerID:      capture.NewOwnerID,
+			PreviousScore:   capture.PreviousScore,
+			NewScore:        capture.NewScore,
+			ActivityID:      capture.ActivityID,
+			CapturedAt:      capture.CapturedAt,
+		}
+		if capture.PreviousOwnerID != nil && hidden(*capture.PreviousOwnerID, capture.H3Index) {
+			resp.PreviousOwnerID = nil
+			resp.PreviousScore = 0
+		}
+		data.Captures = append(data.Captures, resp)
+	}
+	return data
+}
+
+// redactWebhookLeaderboards removes the users hidden reports hidden in the hexes from the
+// leaderboards, and leaves out the leaderboards no one is left in.
+func redactWebhookLeaderboards(leaderboards []dto.WebhookLeaderboard, hidden func(uuid.UUID, string) bool) []dto.WebhookLeaderboard {
+	redacted := make([]dto.WebhookLeaderboard, 0, len(leaderboards))
+	for _, leaderboard := range leaderboards {
+		topUsers := make([]dto.WebhookLeaderboardEntry, 0, len(leaderboard.TopUsers))
+		for _, u := range leaderboard.TopUsers {
+			if !hidden(u.UserID, leaderboard.H3Index) {
+				topUsers = append(topUsers, u)
+			}
+		}
+		if len(topUsers) > 0 {
+			redacted = append(redacted, dto.WebhookLeaderboard{H3Index: leaderboard.H3Index, TopUsers: topUsers})
+		}
+	}
+	return redacted
+}
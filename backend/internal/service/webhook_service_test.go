@@ -0,0 +1,396 @@
+package service_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"stride-wars-app/ent"
+	"stride-wars-app/ent/model"
+	"stride-wars-app/internal/config"
+	"stride-wars-app/internal/dto"
+	"stride-wars-app/internal/service"
+	"stride-wars-app/internal/testutil"
+)
+
+// webhookReceiver is a local endpoint that records the deliveries it gets and answers them with
+// status.
+type webhookReceiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	status   int
+	requests []receivedWebhook
+}
+
+type receivedWebhook struct {
+	header http.Header
+	body   []byte
+	event  dto.WebhookEvent
+}
+
+func newWebhookReceiver(t *testing.T) *webhookReceiver {
+	receiver := &webhookReceiver{status: http.StatusNoContent}
+	receiver.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var event dto.WebhookEvent
+		require.NoError(t, json.Unmarshal(body, &event))
+
+		receiver.mu.Lock()
+		defer receiver.mu.Unlock()
+		receiver.requests = append(receiver.requests, receivedWebhook{header: r.Header, body: body, event: event})
+		w.WriteHeader(receiver.status)
+	}))
+	t.Cleanup(receiver.Close)
+	return receiver
+}
+
+func (wr *webhookReceiver) setStatus(status int) {
+	wr.mu.Lock()
+	defer wr.mu.Unlock()
+	wr.status = status
+}
+
+func (wr *webhookReceiver) received() []receivedWebhook {
+	wr.mu.Lock()
+	defer wr.mu.Unlock()
+	return append([]receivedWebhook(nil), wr.requests...)
+}
+
+func TestWebhookService(t *testing.T) {
+	t.Parallel()
+
+	createUser := func(t *testing.T, tdb *testutil.TestServices, name string) *ent.User {
+		user, err := tdb.UserRepo.CreateUser(tdb.Ctx, &model.User{Username: name, ExternalUser: uuid.New()})
+		require.NoError(t, err)
+		return user
+	}
+	subscribe := func(t *testing.T, tdb *testutil.TestServices, user *ent.User, url string, eventTypes ...string) *dto.WebhookResponse {
+		webhook, err := tdb.WebhookService.CreateWebhook(tdb.Ctx, dto.CreateWebhookRequest{UserID: user.ID, URL: url, EventTypes: eventTypes})
+		require.NoError(t, err)
+		return webhook
+	}
+	run := func(t *testing.T, tdb *testutil.TestServices, user *ent.User, h3Indexes []string) {
+		_, err := tdb.ActivityService.CreateActivity(tdb.Ctx, dto.CreateActivityRequest{
+			UserID:    user.ID,
+			Duration:  600,
+			Distance:  2000,
+			H3Indexes: h3Indexes,
+		})
+		require.NoError(t, err)
+		_, err = tdb.ActivityService.JobService.ProcessPending(tdb.Ctx)
+		require.NoError(t, err)
+	}
+
+	// ------------------------
+	// Subtest: Subscriptions
+	// ------------------------
+	t.Run("Subscriptions", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+		alice := createUser(t, tdb, "alice")
+
+		for _, req := range []struct {
+			request dto.CreateWebhookRequest
+			err     error
+		}{
+			{dto.CreateWebhookRequest{UserID: alice.ID, URL: "ftp://example.com", EventTypes: []string{model.WebhookHexCaptured}}, service.ErrInvalidWebhookURL},
+			{dto.CreateWebhookRequest{UserID: alice.ID, URL: "/hooks", EventTypes: []string{model.WebhookHexCaptured}}, service.ErrInvalidWebhookURL},
+			{dto.CreateWebhookRequest{UserID: alice.ID, URL: "https://example.com/hooks"}, service.ErrInvalidWebhookEventTypes},
+			{dto.CreateWebhookRequest{UserID: alice.ID, URL: "https://example.com/hooks", EventTypes: []string{"friend_request"}}, service.ErrInvalidWebhookEventTypes},
+			{dto.CreateWebhookRequest{UserID: alice.ID, URL: "https://example.com/hooks", EventTypes: []string{model.WebhookHexCaptured}, Secret: "short"}, service.ErrInvalidWebhookSecret},
+		} {
+			_, err := tdb.WebhookService.CreateWebhook(ctx, req.request)
+			require.ErrorIs(t, err, req.err)
+		}
+
+		webhook := subscribe(t, tdb, alice, "https://example.com/hooks", model.WebhookHexCaptured, model.WebhookHexCaptured)
+		require.Equal(t, []string{model.WebhookHexCaptured}, webhook.EventTypes)
+		require.NotEmpty(t, webhook.Secret)
+
+		webhooks, err := tdb.WebhookService.GetWebhooks(ctx, alice.ID)
+		require.NoError(t, err)
+		require.Len(t, webhooks.Webhooks, 1)
+		require.Empty(t, webhooks.Webhooks[0].Secret)
+
+		require.ErrorIs(t, tdb.WebhookService.DeleteWebhook(ctx, uuid.New(), webhook.ID), service.ErrWebhookNotOwned)
+		require.NoError(t, tdb.WebhookService.DeleteWebhook(ctx, alice.ID, webhook.ID))
+		webhooks, err = tdb.WebhookService.GetWebhooks(ctx, alice.ID)
+		require.NoError(t, err)
+		require.Empty(t, webhooks.Webhooks)
+	})
+
+	// ------------------------
+	// Subtest: SignedDeliveries
+	// ------------------------
+	t.Run("SignedDeliveries", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+		receiver := newWebhookReceiver(t)
+		alice := createUser(t, tdb, "alice")
+		bob := createUser(t, tdb, "bob")
+
+		all := subscribe(t, tdb, alice, receiver.URL+"/all", model.WebhookEventTypes...)
+		capturesOnly := subscribe(t, tdb, alice, receiver.URL+"/captures", model.WebhookHexCaptured)
+
+		// Alice claims two hexes, then bob takes one of them over.
+		run(t, tdb, alice, validH3Indexes[:2])
+		run(t, tdb, bob, []string{validH3Indexes[0], validH3Indexes[0]})
+
+		// Nothing is sent until the dispatcher runs.
+		require.Empty(t, receiver.received())
+		attempted, err := tdb.WebhookService.Dispatch(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 8, attempted)
+
+		secrets := map[string]string{"/all": all.Secret, "/captures": capturesOnly.Secret}
+		eventsByPath := make(map[string][]dto.WebhookEvent)
+		for _, request := range receiver.received() {
+			timestamp, err := strconv.ParseInt(request.header.Get(service.WebhookTimestampHeader), 10, 64)
+			require.NoError(t, err)
+			var path string
+			for p := range secrets {
+				if service.SignWebhookPayload(secrets[p], timestamp, request.body) == request.header.Get(service.WebhookSignatureHeader) {
+					path = p
+				}
+			}
+			require.NotEmpty(t, path, "signature matches no subscription's secret")
+			require.Equal(t, request.event.Type, request.header.Get(service.WebhookEventHeader))
+			require.Equal(t, request.event.ID.String(), request.header.Get(service.WebhookDeliveryHeader))
+			eventsByPath[path] = append(eventsByPath[path], request.event)
+		}
+
+		types := func(events []dto.WebhookEvent) map[string]int {
+			counts := make(map[string]int)
+			for _, event := range events {
+				counts[event.Type]++
+			}
+			return counts
+		}
+		require.Equal(t, map[string]int{
+			model.WebhookActivityCreated:    2,
+			model.WebhookHexCaptured:        2,
+			model.WebhookLeaderboardChanged: 2,
+		}, types(eventsByPath["/all"]))
+		require.Equal(t, map[string]int{model.WebhookHexCaptured: 2}, types(eventsByPath["/captures"]))
+
+		// Bob's capture names alice as the previous owner.
+		var takeover *dto.WebhookHexCapturedData
+		for _, event := range eventsByPath["/captures"] {
+			data, err := json.Marshal(event.Data)
+			require.NoError(t, err)
+			var captured dto.WebhookHexCapturedData
+			require.NoError(t, json.Unmarshal(data, &captured))
+			if len(captured.Captures) == 1 {
+				takeover = &captured
+			}
+		}
+		require.NotNil(t, takeover)
+		require.Equal(t, validH3Indexes[0], takeover.Captures[0].H3Index)
+		require.Equal(t, bob.ID, takeover.Captures[0].NewOwnerID)
+		require.Equal(t, alice.ID, *takeover.Captures[0].PreviousOwnerID)
+		require.NotEqual(t, uuid.Nil, takeover.Captures[0].ID)
+
+		deliveries, err := tdb.WebhookService.GetDeliveries(ctx, alice.ID, capturesOnly.ID, 1, 0)
+		require.NoError(t, err)
+		require.Len(t, deliveries.Deliveries, 1)
+		require.NotNil(t, deliveries.NextOffset)
+		require.Equal(t, model.WebhookDeliveryDelivered, deliveries.Deliveries[0].Status)
+		require.Equal(t, http.StatusNoContent, *deliveries.Deliveries[0].ResponseStatus)
+		require.NotNil(t, deliveries.Deliveries[0].DeliveredAt)
+
+		_, err = tdb.WebhookService.GetDeliveries(ctx, bob.ID, capturesOnly.ID, 0, 0)
+		require.ErrorIs(t, err, service.ErrWebhookNotOwned)
+		_, err = tdb.WebhookService.GetDeliveries(ctx, alice.ID, capturesOnly.ID, 1000, 0)
+		require.ErrorIs(t, err, service.ErrInvalidWebhookDeliveriesPage)
+	})
+
+	// ------------------------
+	// Subtest: RetriesWithBackoff
+	// ------------------------
+	t.Run("RetriesWithBackoff", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+		receiver := newWebhookReceiver(t)
+		receiver.setStatus(http.StatusInternalServerError)
+
+		cfg := config.Default()
+		cfg.WebhookMaxAttempts = 3
+		cfg.WebhookRetryDelay = time.Minute
+		cfg.WebhookAllowPrivateTargets = true
+		webhookService := service.NewWebhookService(tdb.Repositories, cfg, zap.NewExample())
+
+		alice := createUser(t, tdb, "alice")
+		webhook := subscribe(t, tdb, alice, receiver.URL, model.WebhookActivityCreated)
+		run(t, tdb, alice, validH3Indexes[:1])
+		delivery := func() dto.WebhookDeliveryResponse {
+			deliveries, err := webhookService.GetDeliveries(ctx, alice.ID, webhook.ID, 0, 0)
+			require.NoError(t, err)
+			require.Len(t, deliveries.Deliveries, 1)
+			return deliveries.Deliveries[0]
+		}
+		// makeDue moves the pending retry to now, as if its backoff had passed.
+		makeDue := func() {
+			_, err := tdb.Client.WebhookDelivery.Update().SetNextAttemptAt(time.Now().Add(-time.Second)).Save(ctx)
+			require.NoError(t, err)
+		}
+
+		start := time.Now()
+		attempted, err := webhookService.Dispatch(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, attempted)
+		first := delivery()
+		require.Equal(t, model.WebhookDeliveryPending, first.Status)
+		require.Equal(t, http.StatusInternalServerError, *first.ResponseStatus)
+		require.NotNil(t, first.LastError)
+		require.WithinDuration(t, start.Add(time.Minute), *first.NextAttemptAt, 5*time.Second)
+
+		// The retry waits for its backoff.
+		attempted, err = webhookService.Dispatch(ctx)
+		require.NoError(t, err)
+		require.Zero(t, attempted)
+
+		// The delay doubles with every attempt.
+		makeDue()
+		start = time.Now()
+		_, err = webhookService.Dispatch(ctx)
+		require.NoError(t, err)
+		second := delivery()
+		require.Equal(t, 2, second.Attempts)
+		require.WithinDuration(t, start.Add(2*time.Minute), *second.NextAttemptAt, 5*time.Second)
+
+		// The receiver recovers in time for the last attempt.
+		receiver.setStatus(http.StatusOK)
+		makeDue()
+		_, err = webhookService.Dispatch(ctx)
+		require.NoError(t, err)
+		last := delivery()
+		require.Equal(t, model.WebhookDeliveryDelivered, last.Status)
+		require.Equal(t, 3, last.Attempts)
+		require.Nil(t, last.LastError)
+		require.Len(t, receiver.received(), 3)
+
+		// Without the recovery, the delivery is given up on after the last attempt.
+		receiver.setStatus(http.StatusGone)
+		run(t, tdb, alice, validH3Indexes[1:2])
+		for i := 0; i < cfg.WebhookMaxAttempts; i++ {
+			makeDue()
+			_, err = webhookService.Dispatch(ctx)
+			require.NoError(t, err)
+		}
+		deliveries, err := webhookService.GetDeliveries(ctx, alice.ID, webhook.ID, 0, 0)
+		require.NoError(t, err)
+		require.Equal(t, model.WebhookDeliveryFailed, deliveries.Deliveries[0].Status)
+		require.Nil(t, deliveries.Deliveries[0].NextAttemptAt)
+	})
+	// ------------------------
+	// Subtest: PrivateTargets
+	// ------------------------
+	t.Run("PrivateTargets", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+		strict := service.NewWebhookService(tdb.Repositories, config.Default(), zap.NewExample())
+		alice := createUser(t, tdb, "alice")
+
+		for _, url := range []string{
+			"http://127.0.0.1:8080/hooks",
+			"http://localhost/hooks",
+			"http://[::1]/hooks",
+			"http://10.0.0.7/hooks",
+			"http://169.254.169.254/latest/meta-data",
+			"http://[::ffff:192.168.1.1]/hooks",
+		} {
+			_, err := strict.CreateWebhook(ctx, dto.CreateWebhookRequest{UserID: alice.ID, URL: url, EventTypes: []string{model.WebhookActivityCreated}})
+			require.ErrorIs(t, err, service.ErrForbiddenWebhookTarget, url)
+		}
+
+		// A subscription whose address turned private since it was created is refused when
+		// connecting.
+		receiver := newWebhookReceiver(t)
+		webhook := subscribe(t, tdb, alice, receiver.URL, model.WebhookActivityCreated)
+		run(t, tdb, alice, validH3Indexes[:1])
+		attempted, err := strict.Dispatch(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, attempted)
+		require.Empty(t, receiver.received())
+		deliveries, err := strict.GetDeliveries(ctx, alice.ID, webhook.ID, 0, 0)
+		require.NoError(t, err)
+		require.Equal(t, model.WebhookDeliveryPending, deliveries.Deliveries[0].Status)
+		require.Contains(t, *deliveries.Deliveries[0].LastError, service.ErrForbiddenWebhookTarget.Error())
+
+		// Redirects are not followed.
+		require.NoError(t, tdb.WebhookService.DeleteWebhook(ctx, alice.ID, webhook.ID))
+		redirect := httptest.NewServer(http.RedirectHandler(receiver.URL, http.StatusFound))
+		t.Cleanup(redirect.Close)
+		webhook = subscribe(t, tdb, alice, redirect.URL, model.WebhookActivityCreated)
+		run(t, tdb, alice, validH3Indexes[1:2])
+		_, err = tdb.WebhookService.Dispatch(ctx)
+		require.NoError(t, err)
+		require.Empty(t, receiver.received())
+		deliveries, err = tdb.WebhookService.GetDeliveries(ctx, alice.ID, webhook.ID, 0, 0)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusFound, *deliveries.Deliveries[0].ResponseStatus)
+	})
+
+	// ------------------------
+	// Subtest: HiddenPlayersRedacted
+	// ------------------------
+	t.Run("HiddenPlayersRedacted", func(t *testing.T) {
+		t.Parallel()
+		tdb := testutil.NewTestServices(t)
+		ctx := tdb.Ctx
+		receiver := newWebhookReceiver(t)
+		alice := createUser(t, tdb, "alice")
+		bob := createUser(t, tdb, "bob")
+		subscribe(t, tdb, alice, receiver.URL, model.WebhookHexCaptured, model.WebhookLeaderboardChanged)
+
+		// Bob hides the first hex; alice holds it and bob takes it over.
+		privacy := tdb.ActivityService.PrivacyZoneService
+		_, err := privacy.CreateZone(ctx, dto.PrivacyZoneRequest{UserID: bob.ID, H3Indexes: validH3Indexes[:1]})
+		require.NoError(t, err)
+		_, err = privacy.UpdateSettings(ctx, dto.PrivacySettingsRequest{UserID: bob.ID, HideZoneLeaderboards: true})
+		require.NoError(t, err)
+		run(t, tdb, alice, validH3Indexes[:1])
+		run(t, tdb, bob, []string{validH3Indexes[0], validH3Indexes[0], validH3Indexes[1]})
+		_, err = tdb.WebhookService.Dispatch(ctx)
+		require.NoError(t, err)
+
+		var bobCaptures []string
+		for _, request := range receiver.received() {
+			data, err := json.Marshal(request.event.Data)
+			require.NoError(t, err)
+			switch request.event.Type {
+			case model.WebhookHexCaptured:
+				var captured dto.WebhookHexCapturedData
+				require.NoError(t, json.Unmarshal(data, &captured))
+				for _, capture := range captured.Captures {
+					if capture.NewOwnerID == bob.ID {
+						bobCaptures = append(bobCaptures, capture.H3Index)
+					}
+				}
+			case model.WebhookLeaderboardChanged:
+				var changed dto.WebhookLeaderboardChangedData
+				require.NoError(t, json.Unmarshal(data, &changed))
+				for _, leaderboard := range changed.Leaderboards {
+					for _, u := range leaderboard.TopUsers {
+						require.False(t, u.UserID == bob.ID && leaderboard.H3Index == validH3Indexes[0], "bob is hidden in the first hex")
+					}
+				}
+			}
+		}
+		require.Equal(t, validH3Indexes[1:2], bobCaptures)
+	})
+}
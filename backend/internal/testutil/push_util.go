@@ -0,0 +1,47 @@
+package testutil
+
+import (
+	"context"
+	"sync"
+
+	"stride-wars-app/internal/service"
+)
+
+// RecordingPushSender is a service.PushSender that keeps the pushes it is given instead of
+// sending them. Pushes to a token in InvalidTokens are answered like a provider answers a token
+// whose app was uninstalled.
+type RecordingPushSender struct {
+	mu            sync.Mutex
+	InvalidTokens map[string]bool
+	Batches       [][]service.Push
+}
+
+func NewRecordingPushSender() *RecordingPushSender {
+	return &RecordingPushSender{InvalidTokens: make(map[string]bool)}
+}
+
+func (s *RecordingPushSender) Send(_ context.Context, pushes []service.Push) ([]service.PushResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Batches = append(s.Batches, append([]service.Push(nil), pushes...))
+	results := make([]service.PushResult, len(pushes))
+	for i, push := range pushes {
+		if s.InvalidTokens[push.To] {
+			results[i] = service.PushResult{Error: "DeviceNotRegistered", InvalidToken: true}
+		}
+	}
+	return results, nil
+}
+
+// Pushes returns every push sent so far, in order.
+func (s *RecordingPushSender) Pushes() []service.Push {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var pushes []service.Push
+	for _, batch := range s.Batches {
+		pushes = append(pushes, batch...)
+	}
+	return pushes
+}
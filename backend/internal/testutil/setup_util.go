@@ -3,24 +3,46 @@ package testutil
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"math"
 	"testing"
 
 	"stride-wars-app/ent"
 	"stride-wars-app/ent/enttest"
+	"stride-wars-app/internal/config"
 	"stride-wars-app/internal/repository"
 	"stride-wars-app/internal/service"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/schema"
 	"github.com/google/uuid"
+	"github.com/mattn/go-sqlite3"
 	"go.uber.org/zap"
 )
 
+// sqliteDriver is SQLite with the math functions the scoring strategies use in SQL, which
+// Postgres has built in but SQLite only gets when compiled with them.
+const sqliteDriver = "sqlite3_math"
+
+func init() {
+	sql.Register(sqliteDriver, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			if err := conn.RegisterFunc("power", math.Pow, true); err != nil {
+				return err
+			}
+			return conn.RegisterFunc("ln", math.Log, true)
+		},
+	})
+}
+
 // TestServices holds a brand-new in-memory ent.Client plus whichever
 // repos/services you need for your tests.
 type TestServices struct {
-	Ctx    context.Context
-	Client *ent.Client
+	Ctx          context.Context
+	Client       *ent.Client
+	Repositories *repository.Repositories
 
 	UserRepo           repository.UserRepository
 	ActivityRepo       repository.ActivityRepository
@@ -33,50 +55,73 @@ type TestServices struct {
 	HexService            *service.HexService
 	HexInfluenceService   *service.HexInfluenceService
 	HexLeaderboardService *service.HexLeaderboardService
+
+	ActivitySessionService  *service.ActivitySessionService
+	InfluenceHistoryService *service.InfluenceHistoryService
+	HexCaptureService       *service.HexCaptureService
+	NotificationService     *service.NotificationService
+	PushService             *service.PushService
+	WebhookService          *service.WebhookService
+
+	// PushSender records the pushes PushService sends.
+	PushSender *RecordingPushSender
 }
 
 // NewTestServices spins up a fresh in-memory SQLite (cache=private) and
 // migrates the schema. It then constructs all “standard” Repos/Services
 // in one place. Because cache=private + a unique UUID is used, every call
 // to NewTestServices(t) truly gets its own private DB.
-func NewTestServices(t *testing.T) *TestServices {
+func NewTestServices(t testing.TB) *TestServices {
 	t.Helper()
 
 	dbName := fmt.Sprintf("file:ent_%s?mode=memory&cache=private&_fk=1", uuid.New().String())
-	client := enttest.Open(t, "sqlite3", dbName)
+	db, err := sql.Open(sqliteDriver, dbName)
+	if err != nil {
+		t.Fatalf("failed to open test DB: %v", err)
+	}
+	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(entsql.OpenDB(dialect.SQLite, db))))
 
 	ctx := context.Background()
 	if err := client.Schema.Create(ctx, schema.WithForeignKeys(true)); err != nil {
 		t.Fatalf("failed to migrate test DB schema: %v", err)
 	}
 
-	userRepo := repository.NewUserRepository(client)
-	activityRepo := repository.NewActivityRepository(client)
-	hexRepo := repository.NewHexRepository(client)
-	hexInfluenceRepo := repository.NewHexInfluenceRepository(client)
-	hexLeaderboardRepo := repository.NewHexLeaderboardRepository(client)
+	repositories := repository.Provide(client)
+	userRepo := repositories.UserRepository
+	activityRepo := repositories.ActivityRepository
+	hexRepo := repositories.HexRepository
+	hexInfluenceRepo := repositories.HexInfluenceRepository
+	hexLeaderboardRepo := repositories.HexLeaderboardRepository
 
 	logger := zap.NewExample()
+	cfg := config.Default()
+	// Webhook tests deliver to local receivers.
+	cfg.WebhookAllowPrivateTargets = true
+	scoring := service.NewScoringStrategy(cfg)
 	userService := service.NewUserService(userRepo, logger)
-	activityService := service.NewActivityService(
-		activityRepo,
-		hexInfluenceRepo,
-		hexLeaderboardRepo,
-		hexRepo,
-		userService,
-		logger,
-	)
+	activityService := service.NewActivityService(repositories, userService, cfg, logger)
 	hexService := service.NewHexService(hexRepo, logger)
-	hexInfluenceService := service.NewHexInfluenceService(hexInfluenceRepo, logger)
+	hexInfluenceService := service.NewHexInfluenceService(hexInfluenceRepo, repositories.InfluenceHistoryRepository, scoring, logger)
 	hexLeaderboardService := service.NewHexLeaderboardService(
 		hexLeaderboardRepo,
 		hexInfluenceRepo,
+		repositories.HexCaptureRepository,
+		repositories.SettingRepository,
+		activityService.PrivacyZoneService,
+		activityService.NotificationService,
+		activityService.WebhookService,
+		activityService.HexRollupService,
+		scoring,
+		cfg,
 		logger,
 	)
+	activitySessionService := service.NewActivitySessionService(repositories, activityService, cfg, logger)
+	pushSender := NewRecordingPushSender()
 
 	return &TestServices{
 		Ctx:                   ctx,
 		Client:                client,
+		Repositories:          repositories,
 		UserRepo:              userRepo,
 		ActivityRepo:          activityRepo,
 		HexRepo:               hexRepo,
@@ -87,5 +132,13 @@ func NewTestServices(t *testing.T) *TestServices {
 		HexService:            hexService,
 		HexInfluenceService:   hexInfluenceService,
 		HexLeaderboardService: hexLeaderboardService,
+
+		ActivitySessionService:  activitySessionService,
+		InfluenceHistoryService: service.NewInfluenceHistoryService(repositories, userService, activityService.PrivacyZoneService, logger),
+		HexCaptureService:       service.NewHexCaptureService(repositories, userService, activityService.PrivacyZoneService, logger),
+		NotificationService:     activityService.NotificationService,
+		PushService:             service.NewPushService(repositories, pushSender, cfg, logger),
+		PushSender:              pushSender,
+		WebhookService:          activityService.WebhookService,
 	}
 }